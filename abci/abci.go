@@ -1,18 +1,29 @@
 package abci
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
 	"github.com/skip-mev/block-sdk/v2/block/utils"
+
+	"github.com/Fairblock/fairyring/lanes/configurable"
+	keysharetypes "github.com/Fairblock/fairyring/x/keyshare/types"
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
 )
 
 type (
+	// LaneKeeper defines the function the proposal handler uses to read the lane config
+	LaneKeeper interface {
+		EffectiveLaneParams(ctx context.Context) []*peptypes.LaneParams
+	}
+
 	// ProposalHandler is a wrapper around the ABCI++ PrepareProposal and ProcessProposal
 	// handlers.
 	ProposalHandler struct {
@@ -21,6 +32,9 @@ type (
 		txEncoder           sdk.TxEncoder
 		prepareLanesHandler block.PrepareLanesHandler
 		mempool             block.Mempool
+		keyshareKeeper      KeyshareKeeper
+		valStore            baseapp.ValidatorStore
+		laneKeeper          LaneKeeper
 	}
 )
 
@@ -31,6 +45,9 @@ func NewProposalHandler(
 	txDecoder sdk.TxDecoder,
 	txEncoder sdk.TxEncoder,
 	mempool block.Mempool,
+	keyshareKeeper KeyshareKeeper,
+	valStore baseapp.ValidatorStore,
+	laneKeeper LaneKeeper,
 ) *ProposalHandler {
 	return &ProposalHandler{
 		logger:              logger,
@@ -38,6 +55,9 @@ func NewProposalHandler(
 		txEncoder:           txEncoder,
 		prepareLanesHandler: ChainPrepareLanes(mempool.Registry()),
 		mempool:             mempool,
+		keyshareKeeper:      keyshareKeeper,
+		valStore:            valStore,
+		laneKeeper:          laneKeeper,
 	}
 }
 
@@ -46,6 +66,9 @@ func NewProposalHandler(
 // each lane has an boundary on the number of bytes that can be included in the proposal. By default,
 // the default lane will not have a boundary on the number of bytes that can be included in the proposal and
 // will include all valid transactions in the proposal (up to MaxTxBytes).
+// The lanes are run in the order and with the block space share and max tx count of the lane params.
+// When vote extensions are enabled, the decryption key of the height aggregated from the keyshares
+// attached to the votes of the previous height is injected as the first tx of the proposal.
 func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (resp *abci.ResponsePrepareProposal, err error) {
 		if req.Height <= 1 {
@@ -69,8 +92,10 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 		// Get the max gas limit and max block size for the proposal.
 		_, maxGasLimit := proposals.GetBlockLimits(ctx)
 
-		proposal := proposals.NewProposal(h.logger, req.MaxTxBytes, maxGasLimit)
-		prepareLanesHandler := ChainPrepareLanes(h.mempool.Registry())
+		injectedTx := h.aggregatedKeyshareInjection(ctx, req)
+
+		proposal := proposals.NewProposal(h.logger, req.MaxTxBytes-int64(len(injectedTx)), maxGasLimit)
+		prepareLanesHandler := ChainPrepareLanes(h.lanes(ctx))
 		finalProposal, err := prepareLanesHandler(ctx, proposal)
 
 		if err != nil {
@@ -78,6 +103,10 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			return &abci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
 		}
 
+		if injectedTx != nil {
+			finalProposal.Txs = append([][]byte{injectedTx}, finalProposal.Txs...)
+		}
+
 		h.logger.Info(
 			"prepared proposal",
 			"num_txs", len(finalProposal.Txs),
@@ -104,6 +133,8 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 // according to each lane's verification logic. We verify proposals in a greedy fashion.
 // If a lane's portion of the proposal is invalid, we reject the proposal. After a lane's portion
 // of the proposal is verified, we pass the remaining transactions to the next lane in the chain.
+// The lanes are run with the same lane params as in PrepareProposalHandler.
+// An injected aggregated key must match the keyshares of the signed vote extensions it carries.
 func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (resp *abci.ResponseProcessProposal, err error) {
 		if req.Height <= 1 {
@@ -119,6 +150,20 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		}()
 
 		txs := req.Txs
+		if len(txs) > 0 {
+			injection, injected, err := keysharetypes.DecodeAggregatedKeyshareInjection(txs[0])
+			if err == nil && injected {
+				err = h.verifyAggregatedKeyshareInjection(ctx, req.Height, injection)
+			}
+			if err != nil {
+				h.logger.Error("invalid aggregated keyshare injection", "err", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
+			}
+			if injected {
+				txs = txs[1:]
+			}
+		}
+
 		// if len(txs) == 0 {
 		// 	h.logger.Info("accepted empty proposal")
 		// 	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, fmt.Errorf("failed to process an empty proposal: %v", err)
@@ -132,7 +177,7 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		}
 
 		// Build handler that will verify the partial proposals according to each lane's verification logic.
-		processLanesHandler := ChainProcessLanes(h.mempool.Registry())
+		processLanesHandler := ChainProcessLanes(h.lanes(ctx))
 		finalProposal, err := processLanesHandler(ctx, proposals.NewProposalWithContext(ctx, h.logger), decodedTxs)
 		if err != nil {
 			h.logger.Error("failed to validate the proposal", "err", err)
@@ -152,3 +197,126 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
 }
+
+// PreBlocker stores the aggregated key injected in the block before the modules begin the block,
+// so the encrypted txs of the height are decrypted in the same block
+func (h *ProposalHandler) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		res, err := next(ctx, req)
+		if err != nil || len(req.Txs) == 0 {
+			return res, err
+		}
+
+		injection, injected, err := keysharetypes.DecodeAggregatedKeyshareInjection(req.Txs[0])
+		if !injected {
+			return res, nil
+		}
+
+		// the injection was verified in ProcessProposal, a failure leaves no partial state
+		cacheCtx, write := ctx.CacheContext()
+		if err == nil {
+			err = h.keyshareKeeper.ApplyAggregatedKeyshareInjection(cacheCtx, injection)
+		}
+		if err != nil {
+			h.logger.Error("failed to apply the aggregated keyshare injection", "err", err, "height", req.Height)
+			return res, nil
+		}
+		write()
+
+		return res, nil
+	}
+}
+
+// lanes returns the lanes of the mempool in the order of the lane params, the configurable lanes are
+// run with the block space share and max tx count of their params. The lanes without params keep
+// their config and are run after the configured ones.
+func (h *ProposalHandler) lanes(ctx sdk.Context) []block.Lane {
+	registry := h.mempool.Registry()
+
+	unordered := make(map[string]block.Lane, len(registry))
+	for _, lane := range registry {
+		unordered[lane.Name()] = lane
+	}
+
+	lanes := make([]block.Lane, 0, len(registry))
+	for _, params := range h.laneKeeper.EffectiveLaneParams(ctx) {
+		lane, found := unordered[params.Name]
+		if !found {
+			continue
+		}
+		delete(unordered, params.Name)
+
+		if configurableLane, ok := lane.(*configurable.Lane); ok {
+			lane = configurableLane.WithParams(*params)
+		}
+		lanes = append(lanes, lane)
+	}
+
+	for _, lane := range registry {
+		if _, found := unordered[lane.Name()]; found {
+			lanes = append(lanes, lane)
+		}
+	}
+
+	return lanes
+}
+
+// aggregatedKeyshareInjection returns the injected tx with the decryption key of the proposal height,
+// nil if vote extensions are disabled or the vote extensions do not have enough keyshares
+func (h *ProposalHandler) aggregatedKeyshareInjection(ctx sdk.Context, req *abci.RequestPrepareProposal) []byte {
+	if !voteExtensionsEnabled(ctx, req.Height) {
+		return nil
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+		h.logger.Error("failed to validate vote extensions", "err", err, "height", req.Height)
+		return nil
+	}
+
+	injection, aggregated, err := h.keyshareKeeper.AggregateKeyshareVoteExtensions(ctx, uint64(req.Height), req.LocalLastCommit)
+	if err != nil {
+		h.logger.Error("failed to aggregate keyshare vote extensions", "err", err, "height", req.Height)
+		return nil
+	}
+	if !aggregated {
+		return nil
+	}
+
+	injectedTx, err := keysharetypes.EncodeAggregatedKeyshareInjection(injection)
+	if err != nil {
+		h.logger.Error("failed to encode the aggregated keyshare injection", "err", err, "height", req.Height)
+		return nil
+	}
+
+	return injectedTx
+}
+
+// verifyAggregatedKeyshareInjection verifies the signatures of the vote extensions carried by the injection
+// and the aggregated key against their keyshares
+func (h *ProposalHandler) verifyAggregatedKeyshareInjection(
+	ctx sdk.Context,
+	height int64,
+	injection keysharetypes.AggregatedKeyshareInjection,
+) error {
+	if !voteExtensionsEnabled(ctx, height) {
+		return keysharetypes.ErrInvalidKeyshareInjection.Wrap("vote extensions are not enabled")
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(injection.ExtendedCommitInfo); err != nil {
+		return keysharetypes.ErrInvalidKeyshareInjection.Wrap(err.Error())
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, height, ctx.ChainID(), extCommit); err != nil {
+		return keysharetypes.ErrInvalidKeyshareInjection.Wrap(err.Error())
+	}
+
+	_, err := h.keyshareKeeper.VerifyAggregatedKeyshareInjection(ctx, uint64(height), injection)
+	return err
+}
+
+// voteExtensionsEnabled returns true if the proposal of the height carries the vote extensions of the previous height
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,174 @@
+package abci
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	distIBE "github.com/FairBlock/DistributedIBE"
+	bls "github.com/drand/kyber-bls12381"
+
+	keysharetypes "github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+type (
+	// KeyshareKeeper defines the keyshare module functions the vote extension and proposal handlers use
+	KeyshareKeeper interface {
+		GetActivePubKey(ctx context.Context) (val keysharetypes.ActivePubKey, found bool)
+		VerifyKeyshareVoteExtension(
+			ctx sdk.Context,
+			consAddr []byte,
+			height uint64,
+			ext keysharetypes.KeyshareVoteExtension,
+		) (keysharetypes.ValidatorSet, error)
+		AggregateKeyshareVoteExtensions(
+			ctx sdk.Context,
+			height uint64,
+			extCommit abci.ExtendedCommitInfo,
+		) (keysharetypes.AggregatedKeyshareInjection, bool, error)
+		VerifyAggregatedKeyshareInjection(
+			ctx sdk.Context,
+			height uint64,
+			injection keysharetypes.AggregatedKeyshareInjection,
+		) ([]keysharetypes.KeyShare, error)
+		ApplyAggregatedKeyshareInjection(ctx sdk.Context, injection keysharetypes.AggregatedKeyshareInjection) error
+	}
+
+	// LocalKeyshare is the keyshare of the validator running the node, read from the keyshare file
+	LocalKeyshare struct {
+		PubKey        string `json:"pub_key"`
+		KeyShare      string `json:"key_share"`
+		KeyShareIndex uint64 `json:"key_share_index"`
+	}
+
+	// VoteExtensionHandler attaches the keyshare of the validator running the node to its precommit votes
+	// and verifies the keyshares attached to the votes of the other validators
+	VoteExtensionHandler struct {
+		logger         log.Logger
+		keyshareKeeper KeyshareKeeper
+		keyshareFile   string
+	}
+)
+
+// NewVoteExtensionHandler returns a new vote extension handler, the keyshare file is read at every vote
+// so a new keyshare is picked up after a key rotation. No keyshare is attached when the file is empty.
+func NewVoteExtensionHandler(
+	logger log.Logger,
+	keyshareKeeper KeyshareKeeper,
+	keyshareFile string,
+) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:         logger,
+		keyshareKeeper: keyshareKeeper,
+		keyshareFile:   keyshareFile,
+	}
+}
+
+// ExtendVoteHandler attaches the keyshare of the next height derived from the local keyshare, an empty
+// vote extension is returned if the node has no keyshare for the active public key
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		empty := &abci.ResponseExtendVote{VoteExtension: []byte{}}
+		if h.keyshareFile == "" {
+			return empty, nil
+		}
+
+		localKeyshare, err := ReadLocalKeyshare(h.keyshareFile)
+		if err != nil {
+			h.logger.Error("failed to read the local keyshare", "err", err)
+			return empty, nil
+		}
+
+		activePubKey, found := h.keyshareKeeper.GetActivePubKey(ctx)
+		if !found || activePubKey.PublicKey != localKeyshare.PubKey {
+			return empty, nil
+		}
+
+		height := uint64(req.Height) + 1
+		derived, err := localKeyshare.Derive(strconv.FormatUint(height, 10))
+		if err != nil {
+			h.logger.Error("failed to derive the keyshare", "err", err, "height", height)
+			return empty, nil
+		}
+
+		ext := keysharetypes.KeyshareVoteExtension{
+			BlockHeight:   height,
+			KeyShare:      derived,
+			KeyShareIndex: localKeyshare.KeyShareIndex,
+		}
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler accepts empty vote extensions and the ones with a keyshare of the next height
+// verified against the active commitments for a registered validator
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		var ext keysharetypes.KeyshareVoteExtension
+		if err := ext.Unmarshal(req.VoteExtension); err != nil {
+			h.logger.Error("failed to decode the vote extension", "err", err, "validator", fmt.Sprintf("%X", req.ValidatorAddress))
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		if _, err := h.keyshareKeeper.VerifyKeyshareVoteExtension(ctx, req.ValidatorAddress, uint64(req.Height)+1, ext); err != nil {
+			h.logger.Error("invalid keyshare vote extension", "err", err, "validator", fmt.Sprintf("%X", req.ValidatorAddress))
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// ReadLocalKeyshare reads the keyshare of the validator from a json file
+func ReadLocalKeyshare(path string) (LocalKeyshare, error) {
+	var localKeyshare LocalKeyshare
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return localKeyshare, err
+	}
+
+	if err = json.Unmarshal(bz, &localKeyshare); err != nil {
+		return localKeyshare, err
+	}
+
+	return localKeyshare, nil
+}
+
+// Derive derives the keyshare of an identity from the local keyshare
+func (l LocalKeyshare) Derive(id string) (string, error) {
+	shareBytes, err := hex.DecodeString(l.KeyShare)
+	if err != nil {
+		return "", err
+	}
+
+	share := bls.NewKyberScalar()
+	if err = share.UnmarshalBinary(shareBytes); err != nil {
+		return "", err
+	}
+
+	extractedKey := distIBE.Extract(bls.NewBLS12381Suite(), share, uint32(l.KeyShareIndex), []byte(id))
+
+	extractedBytes, err := extractedKey.SK.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(extractedBytes), nil
+}
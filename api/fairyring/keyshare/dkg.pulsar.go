@@ -0,0 +1,3306 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package keyshare
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_DkgRound_3_list)(nil)
+
+type _DkgRound_3_list struct {
+	list *[]string
+}
+
+func (x *_DkgRound_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DkgRound_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DkgRound_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DkgRound_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DkgRound_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DkgRound at list field Participants as it is not of Message kind"))
+}
+
+func (x *_DkgRound_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DkgRound_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DkgRound_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DkgRound_10_list)(nil)
+
+type _DkgRound_10_list struct {
+	list *[]string
+}
+
+func (x *_DkgRound_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DkgRound_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DkgRound_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DkgRound_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DkgRound_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DkgRound at list field Qualified as it is not of Message kind"))
+}
+
+func (x *_DkgRound_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DkgRound_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DkgRound_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DkgRound_14_list)(nil)
+
+type _DkgRound_14_list struct {
+	list *[]string
+}
+
+func (x *_DkgRound_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DkgRound_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DkgRound_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DkgRound_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DkgRound_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DkgRound at list field Dealers as it is not of Message kind"))
+}
+
+func (x *_DkgRound_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DkgRound_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DkgRound_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DkgRound                        protoreflect.MessageDescriptor
+	fd_DkgRound_id                     protoreflect.FieldDescriptor
+	fd_DkgRound_creator                protoreflect.FieldDescriptor
+	fd_DkgRound_participants           protoreflect.FieldDescriptor
+	fd_DkgRound_threshold              protoreflect.FieldDescriptor
+	fd_DkgRound_startHeight            protoreflect.FieldDescriptor
+	fd_DkgRound_dealEndHeight          protoreflect.FieldDescriptor
+	fd_DkgRound_complaintEndHeight     protoreflect.FieldDescriptor
+	fd_DkgRound_justificationEndHeight protoreflect.FieldDescriptor
+	fd_DkgRound_status                 protoreflect.FieldDescriptor
+	fd_DkgRound_qualified              protoreflect.FieldDescriptor
+	fd_DkgRound_publicKey              protoreflect.FieldDescriptor
+	fd_DkgRound_failReason             protoreflect.FieldDescriptor
+	fd_DkgRound_resharing              protoreflect.FieldDescriptor
+	fd_DkgRound_dealers                protoreflect.FieldDescriptor
+	fd_DkgRound_previousThreshold      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_keyshare_dkg_proto_init()
+	md_DkgRound = File_fairyring_keyshare_dkg_proto.Messages().ByName("DkgRound")
+	fd_DkgRound_id = md_DkgRound.Fields().ByName("id")
+	fd_DkgRound_creator = md_DkgRound.Fields().ByName("creator")
+	fd_DkgRound_participants = md_DkgRound.Fields().ByName("participants")
+	fd_DkgRound_threshold = md_DkgRound.Fields().ByName("threshold")
+	fd_DkgRound_startHeight = md_DkgRound.Fields().ByName("startHeight")
+	fd_DkgRound_dealEndHeight = md_DkgRound.Fields().ByName("dealEndHeight")
+	fd_DkgRound_complaintEndHeight = md_DkgRound.Fields().ByName("complaintEndHeight")
+	fd_DkgRound_justificationEndHeight = md_DkgRound.Fields().ByName("justificationEndHeight")
+	fd_DkgRound_status = md_DkgRound.Fields().ByName("status")
+	fd_DkgRound_qualified = md_DkgRound.Fields().ByName("qualified")
+	fd_DkgRound_publicKey = md_DkgRound.Fields().ByName("publicKey")
+	fd_DkgRound_failReason = md_DkgRound.Fields().ByName("failReason")
+	fd_DkgRound_resharing = md_DkgRound.Fields().ByName("resharing")
+	fd_DkgRound_dealers = md_DkgRound.Fields().ByName("dealers")
+	fd_DkgRound_previousThreshold = md_DkgRound.Fields().ByName("previousThreshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_DkgRound)(nil)
+
+type fastReflection_DkgRound DkgRound
+
+func (x *DkgRound) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DkgRound)(x)
+}
+
+func (x *DkgRound) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_keyshare_dkg_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DkgRound_messageType fastReflection_DkgRound_messageType
+var _ protoreflect.MessageType = fastReflection_DkgRound_messageType{}
+
+type fastReflection_DkgRound_messageType struct{}
+
+func (x fastReflection_DkgRound_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DkgRound)(nil)
+}
+func (x fastReflection_DkgRound_messageType) New() protoreflect.Message {
+	return new(fastReflection_DkgRound)
+}
+func (x fastReflection_DkgRound_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DkgRound
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DkgRound) Descriptor() protoreflect.MessageDescriptor {
+	return md_DkgRound
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DkgRound) Type() protoreflect.MessageType {
+	return _fastReflection_DkgRound_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DkgRound) New() protoreflect.Message {
+	return new(fastReflection_DkgRound)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DkgRound) Interface() protoreflect.ProtoMessage {
+	return (*DkgRound)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DkgRound) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DkgRound_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_DkgRound_creator, value) {
+			return
+		}
+	}
+	if len(x.Participants) != 0 {
+		value := protoreflect.ValueOfList(&_DkgRound_3_list{list: &x.Participants})
+		if !f(fd_DkgRound_participants, value) {
+			return
+		}
+	}
+	if x.Threshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Threshold)
+		if !f(fd_DkgRound_threshold, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_DkgRound_startHeight, value) {
+			return
+		}
+	}
+	if x.DealEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DealEndHeight)
+		if !f(fd_DkgRound_dealEndHeight, value) {
+			return
+		}
+	}
+	if x.ComplaintEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ComplaintEndHeight)
+		if !f(fd_DkgRound_complaintEndHeight, value) {
+			return
+		}
+	}
+	if x.JustificationEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JustificationEndHeight)
+		if !f(fd_DkgRound_justificationEndHeight, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_DkgRound_status, value) {
+			return
+		}
+	}
+	if len(x.Qualified) != 0 {
+		value := protoreflect.ValueOfList(&_DkgRound_10_list{list: &x.Qualified})
+		if !f(fd_DkgRound_qualified, value) {
+			return
+		}
+	}
+	if x.PublicKey != "" {
+		value := protoreflect.ValueOfString(x.PublicKey)
+		if !f(fd_DkgRound_publicKey, value) {
+			return
+		}
+	}
+	if x.FailReason != "" {
+		value := protoreflect.ValueOfString(x.FailReason)
+		if !f(fd_DkgRound_failReason, value) {
+			return
+		}
+	}
+	if x.Resharing != false {
+		value := protoreflect.ValueOfBool(x.Resharing)
+		if !f(fd_DkgRound_resharing, value) {
+			return
+		}
+	}
+	if len(x.Dealers) != 0 {
+		value := protoreflect.ValueOfList(&_DkgRound_14_list{list: &x.Dealers})
+		if !f(fd_DkgRound_dealers, value) {
+			return
+		}
+	}
+	if x.PreviousThreshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousThreshold)
+		if !f(fd_DkgRound_previousThreshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DkgRound) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgRound.id":
+		return x.Id != uint64(0)
+	case "fairyring.keyshare.DkgRound.creator":
+		return x.Creator != ""
+	case "fairyring.keyshare.DkgRound.participants":
+		return len(x.Participants) != 0
+	case "fairyring.keyshare.DkgRound.threshold":
+		return x.Threshold != uint64(0)
+	case "fairyring.keyshare.DkgRound.startHeight":
+		return x.StartHeight != uint64(0)
+	case "fairyring.keyshare.DkgRound.dealEndHeight":
+		return x.DealEndHeight != uint64(0)
+	case "fairyring.keyshare.DkgRound.complaintEndHeight":
+		return x.ComplaintEndHeight != uint64(0)
+	case "fairyring.keyshare.DkgRound.justificationEndHeight":
+		return x.JustificationEndHeight != uint64(0)
+	case "fairyring.keyshare.DkgRound.status":
+		return x.Status != ""
+	case "fairyring.keyshare.DkgRound.qualified":
+		return len(x.Qualified) != 0
+	case "fairyring.keyshare.DkgRound.publicKey":
+		return x.PublicKey != ""
+	case "fairyring.keyshare.DkgRound.failReason":
+		return x.FailReason != ""
+	case "fairyring.keyshare.DkgRound.resharing":
+		return x.Resharing != false
+	case "fairyring.keyshare.DkgRound.dealers":
+		return len(x.Dealers) != 0
+	case "fairyring.keyshare.DkgRound.previousThreshold":
+		return x.PreviousThreshold != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgRound"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgRound) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgRound.id":
+		x.Id = uint64(0)
+	case "fairyring.keyshare.DkgRound.creator":
+		x.Creator = ""
+	case "fairyring.keyshare.DkgRound.participants":
+		x.Participants = nil
+	case "fairyring.keyshare.DkgRound.threshold":
+		x.Threshold = uint64(0)
+	case "fairyring.keyshare.DkgRound.startHeight":
+		x.StartHeight = uint64(0)
+	case "fairyring.keyshare.DkgRound.dealEndHeight":
+		x.DealEndHeight = uint64(0)
+	case "fairyring.keyshare.DkgRound.complaintEndHeight":
+		x.ComplaintEndHeight = uint64(0)
+	case "fairyring.keyshare.DkgRound.justificationEndHeight":
+		x.JustificationEndHeight = uint64(0)
+	case "fairyring.keyshare.DkgRound.status":
+		x.Status = ""
+	case "fairyring.keyshare.DkgRound.qualified":
+		x.Qualified = nil
+	case "fairyring.keyshare.DkgRound.publicKey":
+		x.PublicKey = ""
+	case "fairyring.keyshare.DkgRound.failReason":
+		x.FailReason = ""
+	case "fairyring.keyshare.DkgRound.resharing":
+		x.Resharing = false
+	case "fairyring.keyshare.DkgRound.dealers":
+		x.Dealers = nil
+	case "fairyring.keyshare.DkgRound.previousThreshold":
+		x.PreviousThreshold = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgRound"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DkgRound) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.keyshare.DkgRound.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgRound.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgRound.participants":
+		if len(x.Participants) == 0 {
+			return protoreflect.ValueOfList(&_DkgRound_3_list{})
+		}
+		listValue := &_DkgRound_3_list{list: &x.Participants}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.DkgRound.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgRound.startHeight":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgRound.dealEndHeight":
+		value := x.DealEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgRound.complaintEndHeight":
+		value := x.ComplaintEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgRound.justificationEndHeight":
+		value := x.JustificationEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgRound.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgRound.qualified":
+		if len(x.Qualified) == 0 {
+			return protoreflect.ValueOfList(&_DkgRound_10_list{})
+		}
+		listValue := &_DkgRound_10_list{list: &x.Qualified}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.DkgRound.publicKey":
+		value := x.PublicKey
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgRound.failReason":
+		value := x.FailReason
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgRound.resharing":
+		value := x.Resharing
+		return protoreflect.ValueOfBool(value)
+	case "fairyring.keyshare.DkgRound.dealers":
+		if len(x.Dealers) == 0 {
+			return protoreflect.ValueOfList(&_DkgRound_14_list{})
+		}
+		listValue := &_DkgRound_14_list{list: &x.Dealers}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.DkgRound.previousThreshold":
+		value := x.PreviousThreshold
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgRound"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgRound does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgRound) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgRound.id":
+		x.Id = value.Uint()
+	case "fairyring.keyshare.DkgRound.creator":
+		x.Creator = value.Interface().(string)
+	case "fairyring.keyshare.DkgRound.participants":
+		lv := value.List()
+		clv := lv.(*_DkgRound_3_list)
+		x.Participants = *clv.list
+	case "fairyring.keyshare.DkgRound.threshold":
+		x.Threshold = value.Uint()
+	case "fairyring.keyshare.DkgRound.startHeight":
+		x.StartHeight = value.Uint()
+	case "fairyring.keyshare.DkgRound.dealEndHeight":
+		x.DealEndHeight = value.Uint()
+	case "fairyring.keyshare.DkgRound.complaintEndHeight":
+		x.ComplaintEndHeight = value.Uint()
+	case "fairyring.keyshare.DkgRound.justificationEndHeight":
+		x.JustificationEndHeight = value.Uint()
+	case "fairyring.keyshare.DkgRound.status":
+		x.Status = value.Interface().(string)
+	case "fairyring.keyshare.DkgRound.qualified":
+		lv := value.List()
+		clv := lv.(*_DkgRound_10_list)
+		x.Qualified = *clv.list
+	case "fairyring.keyshare.DkgRound.publicKey":
+		x.PublicKey = value.Interface().(string)
+	case "fairyring.keyshare.DkgRound.failReason":
+		x.FailReason = value.Interface().(string)
+	case "fairyring.keyshare.DkgRound.resharing":
+		x.Resharing = value.Bool()
+	case "fairyring.keyshare.DkgRound.dealers":
+		lv := value.List()
+		clv := lv.(*_DkgRound_14_list)
+		x.Dealers = *clv.list
+	case "fairyring.keyshare.DkgRound.previousThreshold":
+		x.PreviousThreshold = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgRound"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgRound) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgRound.participants":
+		if x.Participants == nil {
+			x.Participants = []string{}
+		}
+		value := &_DkgRound_3_list{list: &x.Participants}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.DkgRound.qualified":
+		if x.Qualified == nil {
+			x.Qualified = []string{}
+		}
+		value := &_DkgRound_10_list{list: &x.Qualified}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.DkgRound.dealers":
+		if x.Dealers == nil {
+			x.Dealers = []string{}
+		}
+		value := &_DkgRound_14_list{list: &x.Dealers}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.DkgRound.id":
+		panic(fmt.Errorf("field id of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.creator":
+		panic(fmt.Errorf("field creator of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.threshold":
+		panic(fmt.Errorf("field threshold of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.startHeight":
+		panic(fmt.Errorf("field startHeight of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.dealEndHeight":
+		panic(fmt.Errorf("field dealEndHeight of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.complaintEndHeight":
+		panic(fmt.Errorf("field complaintEndHeight of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.justificationEndHeight":
+		panic(fmt.Errorf("field justificationEndHeight of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.status":
+		panic(fmt.Errorf("field status of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.publicKey":
+		panic(fmt.Errorf("field publicKey of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.failReason":
+		panic(fmt.Errorf("field failReason of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.resharing":
+		panic(fmt.Errorf("field resharing of message fairyring.keyshare.DkgRound is not mutable"))
+	case "fairyring.keyshare.DkgRound.previousThreshold":
+		panic(fmt.Errorf("field previousThreshold of message fairyring.keyshare.DkgRound is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgRound"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DkgRound) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgRound.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgRound.creator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgRound.participants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DkgRound_3_list{list: &list})
+	case "fairyring.keyshare.DkgRound.threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgRound.startHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgRound.dealEndHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgRound.complaintEndHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgRound.justificationEndHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgRound.status":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgRound.qualified":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DkgRound_10_list{list: &list})
+	case "fairyring.keyshare.DkgRound.publicKey":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgRound.failReason":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgRound.resharing":
+		return protoreflect.ValueOfBool(false)
+	case "fairyring.keyshare.DkgRound.dealers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DkgRound_14_list{list: &list})
+	case "fairyring.keyshare.DkgRound.previousThreshold":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgRound"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgRound does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DkgRound) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.keyshare.DkgRound", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DkgRound) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgRound) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DkgRound) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DkgRound) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DkgRound)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Participants) > 0 {
+			for _, s := range x.Participants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.DealEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DealEndHeight))
+		}
+		if x.ComplaintEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComplaintEndHeight))
+		}
+		if x.JustificationEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.JustificationEndHeight))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Qualified) > 0 {
+			for _, s := range x.Qualified {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FailReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Resharing {
+			n += 2
+		}
+		if len(x.Dealers) > 0 {
+			for _, s := range x.Dealers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PreviousThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousThreshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DkgRound)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PreviousThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousThreshold))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Dealers) > 0 {
+			for iNdEx := len(x.Dealers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Dealers[iNdEx])
+				copy(dAtA[i:], x.Dealers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dealers[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.Resharing {
+			i--
+			if x.Resharing {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.FailReason) > 0 {
+			i -= len(x.FailReason)
+			copy(dAtA[i:], x.FailReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailReason)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Qualified) > 0 {
+			for iNdEx := len(x.Qualified) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Qualified[iNdEx])
+				copy(dAtA[i:], x.Qualified[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Qualified[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.JustificationEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JustificationEndHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ComplaintEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComplaintEndHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.DealEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DealEndHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Participants) > 0 {
+			for iNdEx := len(x.Participants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Participants[iNdEx])
+				copy(dAtA[i:], x.Participants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participants[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DkgRound)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DkgRound: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DkgRound: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participants = append(x.Participants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DealEndHeight", wireType)
+				}
+				x.DealEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DealEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComplaintEndHeight", wireType)
+				}
+				x.ComplaintEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComplaintEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JustificationEndHeight", wireType)
+				}
+				x.JustificationEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JustificationEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Qualified", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Qualified = append(x.Qualified, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resharing", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Resharing = bool(v != 0)
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dealers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dealers = append(x.Dealers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousThreshold", wireType)
+				}
+				x.PreviousThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousThreshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DkgDeal_3_list)(nil)
+
+type _DkgDeal_3_list struct {
+	list *[]string
+}
+
+func (x *_DkgDeal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DkgDeal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DkgDeal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DkgDeal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DkgDeal_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DkgDeal at list field Commitments as it is not of Message kind"))
+}
+
+func (x *_DkgDeal_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DkgDeal_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DkgDeal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DkgDeal_4_list)(nil)
+
+type _DkgDeal_4_list struct {
+	list *[]*EncryptedKeyShare
+}
+
+func (x *_DkgDeal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DkgDeal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DkgDeal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EncryptedKeyShare)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DkgDeal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EncryptedKeyShare)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DkgDeal_4_list) AppendMutable() protoreflect.Value {
+	v := new(EncryptedKeyShare)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DkgDeal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DkgDeal_4_list) NewElement() protoreflect.Value {
+	v := new(EncryptedKeyShare)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DkgDeal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DkgDeal                 protoreflect.MessageDescriptor
+	fd_DkgDeal_roundId         protoreflect.FieldDescriptor
+	fd_DkgDeal_dealer          protoreflect.FieldDescriptor
+	fd_DkgDeal_commitments     protoreflect.FieldDescriptor
+	fd_DkgDeal_encryptedShares protoreflect.FieldDescriptor
+	fd_DkgDeal_disqualified    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_keyshare_dkg_proto_init()
+	md_DkgDeal = File_fairyring_keyshare_dkg_proto.Messages().ByName("DkgDeal")
+	fd_DkgDeal_roundId = md_DkgDeal.Fields().ByName("roundId")
+	fd_DkgDeal_dealer = md_DkgDeal.Fields().ByName("dealer")
+	fd_DkgDeal_commitments = md_DkgDeal.Fields().ByName("commitments")
+	fd_DkgDeal_encryptedShares = md_DkgDeal.Fields().ByName("encryptedShares")
+	fd_DkgDeal_disqualified = md_DkgDeal.Fields().ByName("disqualified")
+}
+
+var _ protoreflect.Message = (*fastReflection_DkgDeal)(nil)
+
+type fastReflection_DkgDeal DkgDeal
+
+func (x *DkgDeal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DkgDeal)(x)
+}
+
+func (x *DkgDeal) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_keyshare_dkg_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DkgDeal_messageType fastReflection_DkgDeal_messageType
+var _ protoreflect.MessageType = fastReflection_DkgDeal_messageType{}
+
+type fastReflection_DkgDeal_messageType struct{}
+
+func (x fastReflection_DkgDeal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DkgDeal)(nil)
+}
+func (x fastReflection_DkgDeal_messageType) New() protoreflect.Message {
+	return new(fastReflection_DkgDeal)
+}
+func (x fastReflection_DkgDeal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DkgDeal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DkgDeal) Descriptor() protoreflect.MessageDescriptor {
+	return md_DkgDeal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DkgDeal) Type() protoreflect.MessageType {
+	return _fastReflection_DkgDeal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DkgDeal) New() protoreflect.Message {
+	return new(fastReflection_DkgDeal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DkgDeal) Interface() protoreflect.ProtoMessage {
+	return (*DkgDeal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DkgDeal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RoundId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RoundId)
+		if !f(fd_DkgDeal_roundId, value) {
+			return
+		}
+	}
+	if x.Dealer != "" {
+		value := protoreflect.ValueOfString(x.Dealer)
+		if !f(fd_DkgDeal_dealer, value) {
+			return
+		}
+	}
+	if len(x.Commitments) != 0 {
+		value := protoreflect.ValueOfList(&_DkgDeal_3_list{list: &x.Commitments})
+		if !f(fd_DkgDeal_commitments, value) {
+			return
+		}
+	}
+	if len(x.EncryptedShares) != 0 {
+		value := protoreflect.ValueOfList(&_DkgDeal_4_list{list: &x.EncryptedShares})
+		if !f(fd_DkgDeal_encryptedShares, value) {
+			return
+		}
+	}
+	if x.Disqualified != false {
+		value := protoreflect.ValueOfBool(x.Disqualified)
+		if !f(fd_DkgDeal_disqualified, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DkgDeal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgDeal.roundId":
+		return x.RoundId != uint64(0)
+	case "fairyring.keyshare.DkgDeal.dealer":
+		return x.Dealer != ""
+	case "fairyring.keyshare.DkgDeal.commitments":
+		return len(x.Commitments) != 0
+	case "fairyring.keyshare.DkgDeal.encryptedShares":
+		return len(x.EncryptedShares) != 0
+	case "fairyring.keyshare.DkgDeal.disqualified":
+		return x.Disqualified != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgDeal"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgDeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgDeal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgDeal.roundId":
+		x.RoundId = uint64(0)
+	case "fairyring.keyshare.DkgDeal.dealer":
+		x.Dealer = ""
+	case "fairyring.keyshare.DkgDeal.commitments":
+		x.Commitments = nil
+	case "fairyring.keyshare.DkgDeal.encryptedShares":
+		x.EncryptedShares = nil
+	case "fairyring.keyshare.DkgDeal.disqualified":
+		x.Disqualified = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgDeal"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgDeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DkgDeal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.keyshare.DkgDeal.roundId":
+		value := x.RoundId
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgDeal.dealer":
+		value := x.Dealer
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgDeal.commitments":
+		if len(x.Commitments) == 0 {
+			return protoreflect.ValueOfList(&_DkgDeal_3_list{})
+		}
+		listValue := &_DkgDeal_3_list{list: &x.Commitments}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.DkgDeal.encryptedShares":
+		if len(x.EncryptedShares) == 0 {
+			return protoreflect.ValueOfList(&_DkgDeal_4_list{})
+		}
+		listValue := &_DkgDeal_4_list{list: &x.EncryptedShares}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.DkgDeal.disqualified":
+		value := x.Disqualified
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgDeal"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgDeal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgDeal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgDeal.roundId":
+		x.RoundId = value.Uint()
+	case "fairyring.keyshare.DkgDeal.dealer":
+		x.Dealer = value.Interface().(string)
+	case "fairyring.keyshare.DkgDeal.commitments":
+		lv := value.List()
+		clv := lv.(*_DkgDeal_3_list)
+		x.Commitments = *clv.list
+	case "fairyring.keyshare.DkgDeal.encryptedShares":
+		lv := value.List()
+		clv := lv.(*_DkgDeal_4_list)
+		x.EncryptedShares = *clv.list
+	case "fairyring.keyshare.DkgDeal.disqualified":
+		x.Disqualified = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgDeal"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgDeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgDeal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgDeal.commitments":
+		if x.Commitments == nil {
+			x.Commitments = []string{}
+		}
+		value := &_DkgDeal_3_list{list: &x.Commitments}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.DkgDeal.encryptedShares":
+		if x.EncryptedShares == nil {
+			x.EncryptedShares = []*EncryptedKeyShare{}
+		}
+		value := &_DkgDeal_4_list{list: &x.EncryptedShares}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.DkgDeal.roundId":
+		panic(fmt.Errorf("field roundId of message fairyring.keyshare.DkgDeal is not mutable"))
+	case "fairyring.keyshare.DkgDeal.dealer":
+		panic(fmt.Errorf("field dealer of message fairyring.keyshare.DkgDeal is not mutable"))
+	case "fairyring.keyshare.DkgDeal.disqualified":
+		panic(fmt.Errorf("field disqualified of message fairyring.keyshare.DkgDeal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgDeal"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgDeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DkgDeal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgDeal.roundId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgDeal.dealer":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgDeal.commitments":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DkgDeal_3_list{list: &list})
+	case "fairyring.keyshare.DkgDeal.encryptedShares":
+		list := []*EncryptedKeyShare{}
+		return protoreflect.ValueOfList(&_DkgDeal_4_list{list: &list})
+	case "fairyring.keyshare.DkgDeal.disqualified":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgDeal"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgDeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DkgDeal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.keyshare.DkgDeal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DkgDeal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgDeal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DkgDeal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DkgDeal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DkgDeal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RoundId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundId))
+		}
+		l = len(x.Dealer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Commitments) > 0 {
+			for _, s := range x.Commitments {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EncryptedShares) > 0 {
+			for _, e := range x.EncryptedShares {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Disqualified {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DkgDeal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Disqualified {
+			i--
+			if x.Disqualified {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.EncryptedShares) > 0 {
+			for iNdEx := len(x.EncryptedShares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EncryptedShares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Commitments) > 0 {
+			for iNdEx := len(x.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Commitments[iNdEx])
+				copy(dAtA[i:], x.Commitments[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitments[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Dealer) > 0 {
+			i -= len(x.Dealer)
+			copy(dAtA[i:], x.Dealer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dealer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.RoundId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DkgDeal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DkgDeal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DkgDeal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+				}
+				x.RoundId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dealer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitments = append(x.Commitments, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedShares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedShares = append(x.EncryptedShares, &EncryptedKeyShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EncryptedShares[len(x.EncryptedShares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disqualified", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Disqualified = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DkgComplaint               protoreflect.MessageDescriptor
+	fd_DkgComplaint_roundId       protoreflect.FieldDescriptor
+	fd_DkgComplaint_dealer        protoreflect.FieldDescriptor
+	fd_DkgComplaint_complainer    protoreflect.FieldDescriptor
+	fd_DkgComplaint_justified     protoreflect.FieldDescriptor
+	fd_DkgComplaint_revealedShare protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_keyshare_dkg_proto_init()
+	md_DkgComplaint = File_fairyring_keyshare_dkg_proto.Messages().ByName("DkgComplaint")
+	fd_DkgComplaint_roundId = md_DkgComplaint.Fields().ByName("roundId")
+	fd_DkgComplaint_dealer = md_DkgComplaint.Fields().ByName("dealer")
+	fd_DkgComplaint_complainer = md_DkgComplaint.Fields().ByName("complainer")
+	fd_DkgComplaint_justified = md_DkgComplaint.Fields().ByName("justified")
+	fd_DkgComplaint_revealedShare = md_DkgComplaint.Fields().ByName("revealedShare")
+}
+
+var _ protoreflect.Message = (*fastReflection_DkgComplaint)(nil)
+
+type fastReflection_DkgComplaint DkgComplaint
+
+func (x *DkgComplaint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DkgComplaint)(x)
+}
+
+func (x *DkgComplaint) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_keyshare_dkg_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DkgComplaint_messageType fastReflection_DkgComplaint_messageType
+var _ protoreflect.MessageType = fastReflection_DkgComplaint_messageType{}
+
+type fastReflection_DkgComplaint_messageType struct{}
+
+func (x fastReflection_DkgComplaint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DkgComplaint)(nil)
+}
+func (x fastReflection_DkgComplaint_messageType) New() protoreflect.Message {
+	return new(fastReflection_DkgComplaint)
+}
+func (x fastReflection_DkgComplaint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DkgComplaint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DkgComplaint) Descriptor() protoreflect.MessageDescriptor {
+	return md_DkgComplaint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DkgComplaint) Type() protoreflect.MessageType {
+	return _fastReflection_DkgComplaint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DkgComplaint) New() protoreflect.Message {
+	return new(fastReflection_DkgComplaint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DkgComplaint) Interface() protoreflect.ProtoMessage {
+	return (*DkgComplaint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DkgComplaint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RoundId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RoundId)
+		if !f(fd_DkgComplaint_roundId, value) {
+			return
+		}
+	}
+	if x.Dealer != "" {
+		value := protoreflect.ValueOfString(x.Dealer)
+		if !f(fd_DkgComplaint_dealer, value) {
+			return
+		}
+	}
+	if x.Complainer != "" {
+		value := protoreflect.ValueOfString(x.Complainer)
+		if !f(fd_DkgComplaint_complainer, value) {
+			return
+		}
+	}
+	if x.Justified != false {
+		value := protoreflect.ValueOfBool(x.Justified)
+		if !f(fd_DkgComplaint_justified, value) {
+			return
+		}
+	}
+	if x.RevealedShare != "" {
+		value := protoreflect.ValueOfString(x.RevealedShare)
+		if !f(fd_DkgComplaint_revealedShare, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DkgComplaint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgComplaint.roundId":
+		return x.RoundId != uint64(0)
+	case "fairyring.keyshare.DkgComplaint.dealer":
+		return x.Dealer != ""
+	case "fairyring.keyshare.DkgComplaint.complainer":
+		return x.Complainer != ""
+	case "fairyring.keyshare.DkgComplaint.justified":
+		return x.Justified != false
+	case "fairyring.keyshare.DkgComplaint.revealedShare":
+		return x.RevealedShare != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgComplaint"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgComplaint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgComplaint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgComplaint.roundId":
+		x.RoundId = uint64(0)
+	case "fairyring.keyshare.DkgComplaint.dealer":
+		x.Dealer = ""
+	case "fairyring.keyshare.DkgComplaint.complainer":
+		x.Complainer = ""
+	case "fairyring.keyshare.DkgComplaint.justified":
+		x.Justified = false
+	case "fairyring.keyshare.DkgComplaint.revealedShare":
+		x.RevealedShare = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgComplaint"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgComplaint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DkgComplaint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.keyshare.DkgComplaint.roundId":
+		value := x.RoundId
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.DkgComplaint.dealer":
+		value := x.Dealer
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgComplaint.complainer":
+		value := x.Complainer
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.DkgComplaint.justified":
+		value := x.Justified
+		return protoreflect.ValueOfBool(value)
+	case "fairyring.keyshare.DkgComplaint.revealedShare":
+		value := x.RevealedShare
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgComplaint"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgComplaint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgComplaint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgComplaint.roundId":
+		x.RoundId = value.Uint()
+	case "fairyring.keyshare.DkgComplaint.dealer":
+		x.Dealer = value.Interface().(string)
+	case "fairyring.keyshare.DkgComplaint.complainer":
+		x.Complainer = value.Interface().(string)
+	case "fairyring.keyshare.DkgComplaint.justified":
+		x.Justified = value.Bool()
+	case "fairyring.keyshare.DkgComplaint.revealedShare":
+		x.RevealedShare = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgComplaint"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgComplaint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgComplaint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgComplaint.roundId":
+		panic(fmt.Errorf("field roundId of message fairyring.keyshare.DkgComplaint is not mutable"))
+	case "fairyring.keyshare.DkgComplaint.dealer":
+		panic(fmt.Errorf("field dealer of message fairyring.keyshare.DkgComplaint is not mutable"))
+	case "fairyring.keyshare.DkgComplaint.complainer":
+		panic(fmt.Errorf("field complainer of message fairyring.keyshare.DkgComplaint is not mutable"))
+	case "fairyring.keyshare.DkgComplaint.justified":
+		panic(fmt.Errorf("field justified of message fairyring.keyshare.DkgComplaint is not mutable"))
+	case "fairyring.keyshare.DkgComplaint.revealedShare":
+		panic(fmt.Errorf("field revealedShare of message fairyring.keyshare.DkgComplaint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgComplaint"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgComplaint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DkgComplaint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.DkgComplaint.roundId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.DkgComplaint.dealer":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgComplaint.complainer":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.DkgComplaint.justified":
+		return protoreflect.ValueOfBool(false)
+	case "fairyring.keyshare.DkgComplaint.revealedShare":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.DkgComplaint"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.DkgComplaint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DkgComplaint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.keyshare.DkgComplaint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DkgComplaint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DkgComplaint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DkgComplaint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DkgComplaint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DkgComplaint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RoundId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundId))
+		}
+		l = len(x.Dealer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Complainer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Justified {
+			n += 2
+		}
+		l = len(x.RevealedShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DkgComplaint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RevealedShare) > 0 {
+			i -= len(x.RevealedShare)
+			copy(dAtA[i:], x.RevealedShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RevealedShare)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Justified {
+			i--
+			if x.Justified {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Complainer) > 0 {
+			i -= len(x.Complainer)
+			copy(dAtA[i:], x.Complainer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Complainer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Dealer) > 0 {
+			i -= len(x.Dealer)
+			copy(dAtA[i:], x.Dealer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dealer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.RoundId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DkgComplaint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DkgComplaint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DkgComplaint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+				}
+				x.RoundId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dealer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Complainer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Complainer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Justified", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Justified = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealedShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevealedShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fairyring/keyshare/dkg.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DkgRound is an on-chain distributed key generation round among the registered validators,
+// the round goes through the deal, complaint and justification phases and is finalized
+// in the first block after the justification phase ended.
+// A resharing round moves the shares of the active key from its holders to the participants,
+// keeping the same public key.
+type DkgRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// registered validators taking part in the round, the keyshare index of a participant is its position + 1
+	Participants []string `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	// number of keyshares required to aggregate the generated key
+	Threshold   uint64 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StartHeight uint64 `protobuf:"varint,5,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	// last block height deals are accepted
+	DealEndHeight uint64 `protobuf:"varint,6,opt,name=dealEndHeight,proto3" json:"dealEndHeight,omitempty"`
+	// last block height complaints are accepted
+	ComplaintEndHeight uint64 `protobuf:"varint,7,opt,name=complaintEndHeight,proto3" json:"complaintEndHeight,omitempty"`
+	// last block height justifications are accepted
+	JustificationEndHeight uint64 `protobuf:"varint,8,opt,name=justificationEndHeight,proto3" json:"justificationEndHeight,omitempty"`
+	Status                 string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// dealers whose deals are part of the generated key, set when the round is finalized
+	Qualified []string `protobuf:"bytes,10,rep,name=qualified,proto3" json:"qualified,omitempty"`
+	// generated public key, set when the round is finalized
+	PublicKey  string `protobuf:"bytes,11,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	FailReason string `protobuf:"bytes,12,opt,name=failReason,proto3" json:"failReason,omitempty"`
+	Resharing  bool   `protobuf:"varint,13,opt,name=resharing,proto3" json:"resharing,omitempty"`
+	// addresses dealing in the round, the participants for a dkg round and the active key share holders
+	// for a resharing round, the index of a dealer in the active key is its position + 1
+	Dealers []string `protobuf:"bytes,14,rep,name=dealers,proto3" json:"dealers,omitempty"`
+	// threshold of the reshared active key
+	PreviousThreshold uint64 `protobuf:"varint,15,opt,name=previousThreshold,proto3" json:"previousThreshold,omitempty"`
+}
+
+func (x *DkgRound) Reset() {
+	*x = DkgRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_keyshare_dkg_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DkgRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DkgRound) ProtoMessage() {}
+
+// Deprecated: Use DkgRound.ProtoReflect.Descriptor instead.
+func (*DkgRound) Descriptor() ([]byte, []int) {
+	return file_fairyring_keyshare_dkg_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DkgRound) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DkgRound) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *DkgRound) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *DkgRound) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *DkgRound) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *DkgRound) GetDealEndHeight() uint64 {
+	if x != nil {
+		return x.DealEndHeight
+	}
+	return 0
+}
+
+func (x *DkgRound) GetComplaintEndHeight() uint64 {
+	if x != nil {
+		return x.ComplaintEndHeight
+	}
+	return 0
+}
+
+func (x *DkgRound) GetJustificationEndHeight() uint64 {
+	if x != nil {
+		return x.JustificationEndHeight
+	}
+	return 0
+}
+
+func (x *DkgRound) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DkgRound) GetQualified() []string {
+	if x != nil {
+		return x.Qualified
+	}
+	return nil
+}
+
+func (x *DkgRound) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *DkgRound) GetFailReason() string {
+	if x != nil {
+		return x.FailReason
+	}
+	return ""
+}
+
+func (x *DkgRound) GetResharing() bool {
+	if x != nil {
+		return x.Resharing
+	}
+	return false
+}
+
+func (x *DkgRound) GetDealers() []string {
+	if x != nil {
+		return x.Dealers
+	}
+	return nil
+}
+
+func (x *DkgRound) GetPreviousThreshold() uint64 {
+	if x != nil {
+		return x.PreviousThreshold
+	}
+	return 0
+}
+
+// DkgDeal is the Feldman VSS deal of a participant
+type DkgDeal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId uint64 `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Dealer  string `protobuf:"bytes,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// hex encoded G1 commitments of the dealer polynomial coefficients, starting with the constant term,
+	// which is the commitment of the dealer active keyshare in a resharing round
+	Commitments []string `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// shares of the dealer polynomial encrypted to each participant, in participant order
+	EncryptedShares []*EncryptedKeyShare `protobuf:"bytes,4,rep,name=encryptedShares,proto3" json:"encryptedShares,omitempty"`
+	Disqualified    bool                 `protobuf:"varint,5,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+}
+
+func (x *DkgDeal) Reset() {
+	*x = DkgDeal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_keyshare_dkg_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DkgDeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DkgDeal) ProtoMessage() {}
+
+// Deprecated: Use DkgDeal.ProtoReflect.Descriptor instead.
+func (*DkgDeal) Descriptor() ([]byte, []int) {
+	return file_fairyring_keyshare_dkg_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DkgDeal) GetRoundId() uint64 {
+	if x != nil {
+		return x.RoundId
+	}
+	return 0
+}
+
+func (x *DkgDeal) GetDealer() string {
+	if x != nil {
+		return x.Dealer
+	}
+	return ""
+}
+
+func (x *DkgDeal) GetCommitments() []string {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *DkgDeal) GetEncryptedShares() []*EncryptedKeyShare {
+	if x != nil {
+		return x.EncryptedShares
+	}
+	return nil
+}
+
+func (x *DkgDeal) GetDisqualified() bool {
+	if x != nil {
+		return x.Disqualified
+	}
+	return false
+}
+
+// DkgComplaint is filed by a participant against a dealer whose share does not match the deal commitments
+type DkgComplaint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId    uint64 `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Dealer     string `protobuf:"bytes,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	Complainer string `protobuf:"bytes,3,opt,name=complainer,proto3" json:"complainer,omitempty"`
+	Justified  bool   `protobuf:"varint,4,opt,name=justified,proto3" json:"justified,omitempty"`
+	// hex encoded share revealed by the dealer to answer the complaint
+	RevealedShare string `protobuf:"bytes,5,opt,name=revealedShare,proto3" json:"revealedShare,omitempty"`
+}
+
+func (x *DkgComplaint) Reset() {
+	*x = DkgComplaint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_keyshare_dkg_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DkgComplaint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DkgComplaint) ProtoMessage() {}
+
+// Deprecated: Use DkgComplaint.ProtoReflect.Descriptor instead.
+func (*DkgComplaint) Descriptor() ([]byte, []int) {
+	return file_fairyring_keyshare_dkg_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DkgComplaint) GetRoundId() uint64 {
+	if x != nil {
+		return x.RoundId
+	}
+	return 0
+}
+
+func (x *DkgComplaint) GetDealer() string {
+	if x != nil {
+		return x.Dealer
+	}
+	return ""
+}
+
+func (x *DkgComplaint) GetComplainer() string {
+	if x != nil {
+		return x.Complainer
+	}
+	return ""
+}
+
+func (x *DkgComplaint) GetJustified() bool {
+	if x != nil {
+		return x.Justified
+	}
+	return false
+}
+
+func (x *DkgComplaint) GetRevealedShare() string {
+	if x != nil {
+		return x.RevealedShare
+	}
+	return ""
+}
+
+var File_fairyring_keyshare_dkg_proto protoreflect.FileDescriptor
+
+var file_fairyring_keyshare_dkg_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x2f, 0x64, 0x6b, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12,
+	0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x1a, 0x20, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65,
+	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x04, 0x0a, 0x08, 0x44, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x64, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x74, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x07, 0x44, 0x6b, 0x67, 0x44,
+	0x65, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xa4, 0x01, 0x0a,
+	0x0c, 0x44, 0x6b, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x42, 0xb0, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x08,
+	0x44, 0x6b, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2,
+	0x02, 0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2,
+	0x02, 0x1e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x13, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65,
+	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fairyring_keyshare_dkg_proto_rawDescOnce sync.Once
+	file_fairyring_keyshare_dkg_proto_rawDescData = file_fairyring_keyshare_dkg_proto_rawDesc
+)
+
+func file_fairyring_keyshare_dkg_proto_rawDescGZIP() []byte {
+	file_fairyring_keyshare_dkg_proto_rawDescOnce.Do(func() {
+		file_fairyring_keyshare_dkg_proto_rawDescData = protoimpl.X.CompressGZIP(file_fairyring_keyshare_dkg_proto_rawDescData)
+	})
+	return file_fairyring_keyshare_dkg_proto_rawDescData
+}
+
+var file_fairyring_keyshare_dkg_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_fairyring_keyshare_dkg_proto_goTypes = []interface{}{
+	(*DkgRound)(nil),          // 0: fairyring.keyshare.DkgRound
+	(*DkgDeal)(nil),           // 1: fairyring.keyshare.DkgDeal
+	(*DkgComplaint)(nil),      // 2: fairyring.keyshare.DkgComplaint
+	(*EncryptedKeyShare)(nil), // 3: fairyring.keyshare.EncryptedKeyShare
+}
+var file_fairyring_keyshare_dkg_proto_depIdxs = []int32{
+	3, // 0: fairyring.keyshare.DkgDeal.encryptedShares:type_name -> fairyring.keyshare.EncryptedKeyShare
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fairyring_keyshare_dkg_proto_init() }
+func file_fairyring_keyshare_dkg_proto_init() {
+	if File_fairyring_keyshare_dkg_proto != nil {
+		return
+	}
+	file_fairyring_keyshare_pub_key_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fairyring_keyshare_dkg_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DkgRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_keyshare_dkg_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DkgDeal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_keyshare_dkg_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DkgComplaint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fairyring_keyshare_dkg_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fairyring_keyshare_dkg_proto_goTypes,
+		DependencyIndexes: file_fairyring_keyshare_dkg_proto_depIdxs,
+		MessageInfos:      file_fairyring_keyshare_dkg_proto_msgTypes,
+	}.Build()
+	File_fairyring_keyshare_dkg_proto = out.File
+	file_fairyring_keyshare_dkg_proto_rawDesc = nil
+	file_fairyring_keyshare_dkg_proto_goTypes = nil
+	file_fairyring_keyshare_dkg_proto_depIdxs = nil
+}
@@ -21,6 +21,8 @@ var (
 	fd_GeneralKeyShare_keyShareIndex       protoreflect.FieldDescriptor
 	fd_GeneralKeyShare_receivedTimestamp   protoreflect.FieldDescriptor
 	fd_GeneralKeyShare_receivedBlockHeight protoreflect.FieldDescriptor
+	fd_GeneralKeyShare_power               protoreflect.FieldDescriptor
+	fd_GeneralKeyShare_pubKey              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -33,6 +35,8 @@ func init() {
 	fd_GeneralKeyShare_keyShareIndex = md_GeneralKeyShare.Fields().ByName("keyShareIndex")
 	fd_GeneralKeyShare_receivedTimestamp = md_GeneralKeyShare.Fields().ByName("receivedTimestamp")
 	fd_GeneralKeyShare_receivedBlockHeight = md_GeneralKeyShare.Fields().ByName("receivedBlockHeight")
+	fd_GeneralKeyShare_power = md_GeneralKeyShare.Fields().ByName("power")
+	fd_GeneralKeyShare_pubKey = md_GeneralKeyShare.Fields().ByName("pubKey")
 }
 
 var _ protoreflect.Message = (*fastReflection_GeneralKeyShare)(nil)
@@ -142,6 +146,18 @@ func (x *fastReflection_GeneralKeyShare) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_GeneralKeyShare_power, value) {
+			return
+		}
+	}
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_GeneralKeyShare_pubKey, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -171,6 +187,10 @@ func (x *fastReflection_GeneralKeyShare) Has(fd protoreflect.FieldDescriptor) bo
 		return x.ReceivedTimestamp != uint64(0)
 	case "fairyring.keyshare.GeneralKeyShare.receivedBlockHeight":
 		return x.ReceivedBlockHeight != uint64(0)
+	case "fairyring.keyshare.GeneralKeyShare.power":
+		return x.Power != int64(0)
+	case "fairyring.keyshare.GeneralKeyShare.pubKey":
+		return x.PubKey != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GeneralKeyShare"))
@@ -201,6 +221,10 @@ func (x *fastReflection_GeneralKeyShare) Clear(fd protoreflect.FieldDescriptor)
 		x.ReceivedTimestamp = uint64(0)
 	case "fairyring.keyshare.GeneralKeyShare.receivedBlockHeight":
 		x.ReceivedBlockHeight = uint64(0)
+	case "fairyring.keyshare.GeneralKeyShare.power":
+		x.Power = int64(0)
+	case "fairyring.keyshare.GeneralKeyShare.pubKey":
+		x.PubKey = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GeneralKeyShare"))
@@ -238,6 +262,12 @@ func (x *fastReflection_GeneralKeyShare) Get(descriptor protoreflect.FieldDescri
 	case "fairyring.keyshare.GeneralKeyShare.receivedBlockHeight":
 		value := x.ReceivedBlockHeight
 		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.GeneralKeyShare.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
+	case "fairyring.keyshare.GeneralKeyShare.pubKey":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GeneralKeyShare"))
@@ -272,6 +302,10 @@ func (x *fastReflection_GeneralKeyShare) Set(fd protoreflect.FieldDescriptor, va
 		x.ReceivedTimestamp = value.Uint()
 	case "fairyring.keyshare.GeneralKeyShare.receivedBlockHeight":
 		x.ReceivedBlockHeight = value.Uint()
+	case "fairyring.keyshare.GeneralKeyShare.power":
+		x.Power = value.Int()
+	case "fairyring.keyshare.GeneralKeyShare.pubKey":
+		x.PubKey = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GeneralKeyShare"))
@@ -306,6 +340,10 @@ func (x *fastReflection_GeneralKeyShare) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field receivedTimestamp of message fairyring.keyshare.GeneralKeyShare is not mutable"))
 	case "fairyring.keyshare.GeneralKeyShare.receivedBlockHeight":
 		panic(fmt.Errorf("field receivedBlockHeight of message fairyring.keyshare.GeneralKeyShare is not mutable"))
+	case "fairyring.keyshare.GeneralKeyShare.power":
+		panic(fmt.Errorf("field power of message fairyring.keyshare.GeneralKeyShare is not mutable"))
+	case "fairyring.keyshare.GeneralKeyShare.pubKey":
+		panic(fmt.Errorf("field pubKey of message fairyring.keyshare.GeneralKeyShare is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GeneralKeyShare"))
@@ -333,6 +371,10 @@ func (x *fastReflection_GeneralKeyShare) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "fairyring.keyshare.GeneralKeyShare.receivedBlockHeight":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.GeneralKeyShare.power":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "fairyring.keyshare.GeneralKeyShare.pubKey":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GeneralKeyShare"))
@@ -427,6 +469,13 @@ func (x *fastReflection_GeneralKeyShare) ProtoMethods() *protoiface.Methods {
 		if x.ReceivedBlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.ReceivedBlockHeight))
 		}
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
+		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -456,6 +505,18 @@ func (x *fastReflection_GeneralKeyShare) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.ReceivedBlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceivedBlockHeight))
 			i--
@@ -733,6 +794,57 @@ func (x *fastReflection_GeneralKeyShare) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				}
+				x.Power = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Power |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -777,6 +889,7 @@ var (
 	fd_ValidatorEncryptedKeyShare_receivedTimestamp   protoreflect.FieldDescriptor
 	fd_ValidatorEncryptedKeyShare_receivedBlockHeight protoreflect.FieldDescriptor
 	fd_ValidatorEncryptedKeyShare_identity            protoreflect.FieldDescriptor
+	fd_ValidatorEncryptedKeyShare_power               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -789,6 +902,7 @@ func init() {
 	fd_ValidatorEncryptedKeyShare_receivedTimestamp = md_ValidatorEncryptedKeyShare.Fields().ByName("receivedTimestamp")
 	fd_ValidatorEncryptedKeyShare_receivedBlockHeight = md_ValidatorEncryptedKeyShare.Fields().ByName("receivedBlockHeight")
 	fd_ValidatorEncryptedKeyShare_identity = md_ValidatorEncryptedKeyShare.Fields().ByName("identity")
+	fd_ValidatorEncryptedKeyShare_power = md_ValidatorEncryptedKeyShare.Fields().ByName("power")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValidatorEncryptedKeyShare)(nil)
@@ -898,6 +1012,12 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if x.Power != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Power)
+		if !f(fd_ValidatorEncryptedKeyShare_power, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -927,6 +1047,8 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) Has(fd protoreflect.FieldDes
 		return x.ReceivedBlockHeight != uint64(0)
 	case "fairyring.keyshare.ValidatorEncryptedKeyShare.identity":
 		return x.Identity != ""
+	case "fairyring.keyshare.ValidatorEncryptedKeyShare.power":
+		return x.Power != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ValidatorEncryptedKeyShare"))
@@ -957,6 +1079,8 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) Clear(fd protoreflect.FieldD
 		x.ReceivedBlockHeight = uint64(0)
 	case "fairyring.keyshare.ValidatorEncryptedKeyShare.identity":
 		x.Identity = ""
+	case "fairyring.keyshare.ValidatorEncryptedKeyShare.power":
+		x.Power = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ValidatorEncryptedKeyShare"))
@@ -994,6 +1118,9 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) Get(descriptor protoreflect.
 	case "fairyring.keyshare.ValidatorEncryptedKeyShare.identity":
 		value := x.Identity
 		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.ValidatorEncryptedKeyShare.power":
+		value := x.Power
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ValidatorEncryptedKeyShare"))
@@ -1028,6 +1155,8 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) Set(fd protoreflect.FieldDes
 		x.ReceivedBlockHeight = value.Uint()
 	case "fairyring.keyshare.ValidatorEncryptedKeyShare.identity":
 		x.Identity = value.Interface().(string)
+	case "fairyring.keyshare.ValidatorEncryptedKeyShare.power":
+		x.Power = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ValidatorEncryptedKeyShare"))
@@ -1062,6 +1191,8 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) Mutable(fd protoreflect.Fiel
 		panic(fmt.Errorf("field receivedBlockHeight of message fairyring.keyshare.ValidatorEncryptedKeyShare is not mutable"))
 	case "fairyring.keyshare.ValidatorEncryptedKeyShare.identity":
 		panic(fmt.Errorf("field identity of message fairyring.keyshare.ValidatorEncryptedKeyShare is not mutable"))
+	case "fairyring.keyshare.ValidatorEncryptedKeyShare.power":
+		panic(fmt.Errorf("field power of message fairyring.keyshare.ValidatorEncryptedKeyShare is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ValidatorEncryptedKeyShare"))
@@ -1089,6 +1220,8 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) NewField(fd protoreflect.Fie
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "fairyring.keyshare.ValidatorEncryptedKeyShare.identity":
 		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.ValidatorEncryptedKeyShare.power":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ValidatorEncryptedKeyShare"))
@@ -1183,6 +1316,9 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) ProtoMethods() *protoiface.M
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Power != 0 {
+			n += 1 + runtime.Sov(uint64(x.Power))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1212,6 +1348,11 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Power != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Power))
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.Identity) > 0 {
 			i -= len(x.Identity)
 			copy(dAtA[i:], x.Identity)
@@ -1489,6 +1630,25 @@ func (x *fastReflection_ValidatorEncryptedKeyShare) ProtoMethods() *protoiface.M
 				}
 				x.Identity = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				}
+				x.Power = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Power |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1549,6 +1709,10 @@ type GeneralKeyShare struct {
 	KeyShareIndex       uint64 `protobuf:"varint,5,opt,name=keyShareIndex,proto3" json:"keyShareIndex,omitempty"`
 	ReceivedTimestamp   uint64 `protobuf:"varint,6,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
 	ReceivedBlockHeight uint64 `protobuf:"varint,7,opt,name=receivedBlockHeight,proto3" json:"receivedBlockHeight,omitempty"`
+	// consensus power of the validator when the keyshare was submitted
+	Power int64 `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
+	// active public key the keyshare was verified for when it was submitted
+	PubKey string `protobuf:"bytes,9,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 }
 
 func (x *GeneralKeyShare) Reset() {
@@ -1620,6 +1784,20 @@ func (x *GeneralKeyShare) GetReceivedBlockHeight() uint64 {
 	return 0
 }
 
+func (x *GeneralKeyShare) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *GeneralKeyShare) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
 type ValidatorEncryptedKeyShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1632,6 +1810,8 @@ type ValidatorEncryptedKeyShare struct {
 	ReceivedTimestamp   uint64 `protobuf:"varint,5,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
 	ReceivedBlockHeight uint64 `protobuf:"varint,6,opt,name=receivedBlockHeight,proto3" json:"receivedBlockHeight,omitempty"`
 	Identity            string `protobuf:"bytes,7,opt,name=identity,proto3" json:"identity,omitempty"`
+	// consensus power of the validator when the keyshare was submitted
+	Power int64 `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
 }
 
 func (x *ValidatorEncryptedKeyShare) Reset() {
@@ -1703,6 +1883,13 @@ func (x *ValidatorEncryptedKeyShare) GetIdentity() string {
 	return ""
 }
 
+func (x *ValidatorEncryptedKeyShare) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
 var File_fairyring_keyshare_general_key_share_proto protoreflect.FileDescriptor
 
 var file_fairyring_keyshare_general_key_share_proto_rawDesc = []byte{
@@ -1710,7 +1897,7 @@ var file_fairyring_keyshare_general_key_share_proto_rawDesc = []byte{
 	0x68, 0x61, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79,
 	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x66, 0x61,
 	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x22, 0x83, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53,
+	0x22, 0xb1, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53,
 	0x68, 0x61, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -1726,37 +1913,41 @@ var file_fairyring_keyshare_general_key_share_proto_rawDesc = []byte{
 	0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
-	0xbc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x14, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b,
-	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12,
-	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b,
-	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x46, 0x61, 0x69, 0x72, 0x79,
-	0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x42, 0xbc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x14,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
+	0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x4b,
+	0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65,
+	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
+	0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x46,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -269,18 +269,435 @@ func (x *_GenesisState_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*KeyshareRewardCount
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareRewardCount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareRewardCount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(KeyshareRewardCount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(KeyshareRewardCount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*KeyshareReward
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(KeyshareReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(KeyshareReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*KeyshareSigningInfo
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareSigningInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareSigningInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(KeyshareSigningInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(KeyshareSigningInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*MissedKeysharesBitmap
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MissedKeysharesBitmap)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MissedKeysharesBitmap)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(MissedKeysharesBitmap)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(MissedKeysharesBitmap)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*KeyshareEvidence
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareEvidence)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyshareEvidence)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(KeyshareEvidence)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(KeyshareEvidence)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*DkgRound
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DkgRound)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DkgRound)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(DkgRound)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(DkgRound)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*DkgDeal
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DkgDeal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DkgDeal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(DkgDeal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(DkgDeal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*DkgComplaint
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DkgComplaint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DkgComplaint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(DkgComplaint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(DkgComplaint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                        protoreflect.MessageDescriptor
-	fd_GenesisState_params                 protoreflect.FieldDescriptor
-	fd_GenesisState_port_id                protoreflect.FieldDescriptor
-	fd_GenesisState_validatorSetList       protoreflect.FieldDescriptor
-	fd_GenesisState_keyShareList           protoreflect.FieldDescriptor
-	fd_GenesisState_aggregatedKeyShareList protoreflect.FieldDescriptor
-	fd_GenesisState_activePubKey           protoreflect.FieldDescriptor
-	fd_GenesisState_queuedPubKey           protoreflect.FieldDescriptor
-	fd_GenesisState_authorizedAddressList  protoreflect.FieldDescriptor
-	fd_GenesisState_request_count          protoreflect.FieldDescriptor
-	fd_GenesisState_generalKeyShareList    protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_port_id                   protoreflect.FieldDescriptor
+	fd_GenesisState_validatorSetList          protoreflect.FieldDescriptor
+	fd_GenesisState_keyShareList              protoreflect.FieldDescriptor
+	fd_GenesisState_aggregatedKeyShareList    protoreflect.FieldDescriptor
+	fd_GenesisState_activePubKey              protoreflect.FieldDescriptor
+	fd_GenesisState_queuedPubKey              protoreflect.FieldDescriptor
+	fd_GenesisState_authorizedAddressList     protoreflect.FieldDescriptor
+	fd_GenesisState_request_count             protoreflect.FieldDescriptor
+	fd_GenesisState_generalKeyShareList       protoreflect.FieldDescriptor
+	fd_GenesisState_keyshareRewardCountList   protoreflect.FieldDescriptor
+	fd_GenesisState_keyshareRewardList        protoreflect.FieldDescriptor
+	fd_GenesisState_keyshareSigningInfoList   protoreflect.FieldDescriptor
+	fd_GenesisState_missedKeysharesBitmapList protoreflect.FieldDescriptor
+	fd_GenesisState_keyshareEvidenceList      protoreflect.FieldDescriptor
+	fd_GenesisState_dkgRoundList              protoreflect.FieldDescriptor
+	fd_GenesisState_dkgRoundCount             protoreflect.FieldDescriptor
+	fd_GenesisState_dkgDealList               protoreflect.FieldDescriptor
+	fd_GenesisState_dkgComplaintList          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -296,6 +713,15 @@ func init() {
 	fd_GenesisState_authorizedAddressList = md_GenesisState.Fields().ByName("authorizedAddressList")
 	fd_GenesisState_request_count = md_GenesisState.Fields().ByName("request_count")
 	fd_GenesisState_generalKeyShareList = md_GenesisState.Fields().ByName("generalKeyShareList")
+	fd_GenesisState_keyshareRewardCountList = md_GenesisState.Fields().ByName("keyshareRewardCountList")
+	fd_GenesisState_keyshareRewardList = md_GenesisState.Fields().ByName("keyshareRewardList")
+	fd_GenesisState_keyshareSigningInfoList = md_GenesisState.Fields().ByName("keyshareSigningInfoList")
+	fd_GenesisState_missedKeysharesBitmapList = md_GenesisState.Fields().ByName("missedKeysharesBitmapList")
+	fd_GenesisState_keyshareEvidenceList = md_GenesisState.Fields().ByName("keyshareEvidenceList")
+	fd_GenesisState_dkgRoundList = md_GenesisState.Fields().ByName("dkgRoundList")
+	fd_GenesisState_dkgRoundCount = md_GenesisState.Fields().ByName("dkgRoundCount")
+	fd_GenesisState_dkgDealList = md_GenesisState.Fields().ByName("dkgDealList")
+	fd_GenesisState_dkgComplaintList = md_GenesisState.Fields().ByName("dkgComplaintList")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -423,6 +849,60 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.KeyshareRewardCountList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.KeyshareRewardCountList})
+		if !f(fd_GenesisState_keyshareRewardCountList, value) {
+			return
+		}
+	}
+	if len(x.KeyshareRewardList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.KeyshareRewardList})
+		if !f(fd_GenesisState_keyshareRewardList, value) {
+			return
+		}
+	}
+	if len(x.KeyshareSigningInfoList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.KeyshareSigningInfoList})
+		if !f(fd_GenesisState_keyshareSigningInfoList, value) {
+			return
+		}
+	}
+	if len(x.MissedKeysharesBitmapList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.MissedKeysharesBitmapList})
+		if !f(fd_GenesisState_missedKeysharesBitmapList, value) {
+			return
+		}
+	}
+	if len(x.KeyshareEvidenceList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.KeyshareEvidenceList})
+		if !f(fd_GenesisState_keyshareEvidenceList, value) {
+			return
+		}
+	}
+	if len(x.DkgRoundList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.DkgRoundList})
+		if !f(fd_GenesisState_dkgRoundList, value) {
+			return
+		}
+	}
+	if x.DkgRoundCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgRoundCount)
+		if !f(fd_GenesisState_dkgRoundCount, value) {
+			return
+		}
+	}
+	if len(x.DkgDealList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.DkgDealList})
+		if !f(fd_GenesisState_dkgDealList, value) {
+			return
+		}
+	}
+	if len(x.DkgComplaintList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.DkgComplaintList})
+		if !f(fd_GenesisState_dkgComplaintList, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -458,6 +938,24 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.RequestCount != uint64(0)
 	case "fairyring.keyshare.GenesisState.generalKeyShareList":
 		return len(x.GeneralKeyShareList) != 0
+	case "fairyring.keyshare.GenesisState.keyshareRewardCountList":
+		return len(x.KeyshareRewardCountList) != 0
+	case "fairyring.keyshare.GenesisState.keyshareRewardList":
+		return len(x.KeyshareRewardList) != 0
+	case "fairyring.keyshare.GenesisState.keyshareSigningInfoList":
+		return len(x.KeyshareSigningInfoList) != 0
+	case "fairyring.keyshare.GenesisState.missedKeysharesBitmapList":
+		return len(x.MissedKeysharesBitmapList) != 0
+	case "fairyring.keyshare.GenesisState.keyshareEvidenceList":
+		return len(x.KeyshareEvidenceList) != 0
+	case "fairyring.keyshare.GenesisState.dkgRoundList":
+		return len(x.DkgRoundList) != 0
+	case "fairyring.keyshare.GenesisState.dkgRoundCount":
+		return x.DkgRoundCount != uint64(0)
+	case "fairyring.keyshare.GenesisState.dkgDealList":
+		return len(x.DkgDealList) != 0
+	case "fairyring.keyshare.GenesisState.dkgComplaintList":
+		return len(x.DkgComplaintList) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GenesisState"))
@@ -494,6 +992,24 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.RequestCount = uint64(0)
 	case "fairyring.keyshare.GenesisState.generalKeyShareList":
 		x.GeneralKeyShareList = nil
+	case "fairyring.keyshare.GenesisState.keyshareRewardCountList":
+		x.KeyshareRewardCountList = nil
+	case "fairyring.keyshare.GenesisState.keyshareRewardList":
+		x.KeyshareRewardList = nil
+	case "fairyring.keyshare.GenesisState.keyshareSigningInfoList":
+		x.KeyshareSigningInfoList = nil
+	case "fairyring.keyshare.GenesisState.missedKeysharesBitmapList":
+		x.MissedKeysharesBitmapList = nil
+	case "fairyring.keyshare.GenesisState.keyshareEvidenceList":
+		x.KeyshareEvidenceList = nil
+	case "fairyring.keyshare.GenesisState.dkgRoundList":
+		x.DkgRoundList = nil
+	case "fairyring.keyshare.GenesisState.dkgRoundCount":
+		x.DkgRoundCount = uint64(0)
+	case "fairyring.keyshare.GenesisState.dkgDealList":
+		x.DkgDealList = nil
+	case "fairyring.keyshare.GenesisState.dkgComplaintList":
+		x.DkgComplaintList = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GenesisState"))
@@ -555,6 +1071,57 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_10_list{list: &x.GeneralKeyShareList}
 		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.keyshareRewardCountList":
+		if len(x.KeyshareRewardCountList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.KeyshareRewardCountList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.keyshareRewardList":
+		if len(x.KeyshareRewardList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.KeyshareRewardList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.keyshareSigningInfoList":
+		if len(x.KeyshareSigningInfoList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.KeyshareSigningInfoList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.missedKeysharesBitmapList":
+		if len(x.MissedKeysharesBitmapList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.MissedKeysharesBitmapList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.keyshareEvidenceList":
+		if len(x.KeyshareEvidenceList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.KeyshareEvidenceList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.dkgRoundList":
+		if len(x.DkgRoundList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.DkgRoundList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.dkgRoundCount":
+		value := x.DkgRoundCount
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.GenesisState.dkgDealList":
+		if len(x.DkgDealList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.DkgDealList}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.GenesisState.dkgComplaintList":
+		if len(x.DkgComplaintList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.DkgComplaintList}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GenesisState"))
@@ -605,6 +1172,40 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_10_list)
 		x.GeneralKeyShareList = *clv.list
+	case "fairyring.keyshare.GenesisState.keyshareRewardCountList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.KeyshareRewardCountList = *clv.list
+	case "fairyring.keyshare.GenesisState.keyshareRewardList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.KeyshareRewardList = *clv.list
+	case "fairyring.keyshare.GenesisState.keyshareSigningInfoList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.KeyshareSigningInfoList = *clv.list
+	case "fairyring.keyshare.GenesisState.missedKeysharesBitmapList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.MissedKeysharesBitmapList = *clv.list
+	case "fairyring.keyshare.GenesisState.keyshareEvidenceList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.KeyshareEvidenceList = *clv.list
+	case "fairyring.keyshare.GenesisState.dkgRoundList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.DkgRoundList = *clv.list
+	case "fairyring.keyshare.GenesisState.dkgRoundCount":
+		x.DkgRoundCount = value.Uint()
+	case "fairyring.keyshare.GenesisState.dkgDealList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.DkgDealList = *clv.list
+	case "fairyring.keyshare.GenesisState.dkgComplaintList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.DkgComplaintList = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GenesisState"))
@@ -670,10 +1271,60 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_10_list{list: &x.GeneralKeyShareList}
 		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.keyshareRewardCountList":
+		if x.KeyshareRewardCountList == nil {
+			x.KeyshareRewardCountList = []*KeyshareRewardCount{}
+		}
+		value := &_GenesisState_11_list{list: &x.KeyshareRewardCountList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.keyshareRewardList":
+		if x.KeyshareRewardList == nil {
+			x.KeyshareRewardList = []*KeyshareReward{}
+		}
+		value := &_GenesisState_12_list{list: &x.KeyshareRewardList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.keyshareSigningInfoList":
+		if x.KeyshareSigningInfoList == nil {
+			x.KeyshareSigningInfoList = []*KeyshareSigningInfo{}
+		}
+		value := &_GenesisState_13_list{list: &x.KeyshareSigningInfoList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.missedKeysharesBitmapList":
+		if x.MissedKeysharesBitmapList == nil {
+			x.MissedKeysharesBitmapList = []*MissedKeysharesBitmap{}
+		}
+		value := &_GenesisState_14_list{list: &x.MissedKeysharesBitmapList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.keyshareEvidenceList":
+		if x.KeyshareEvidenceList == nil {
+			x.KeyshareEvidenceList = []*KeyshareEvidence{}
+		}
+		value := &_GenesisState_15_list{list: &x.KeyshareEvidenceList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.dkgRoundList":
+		if x.DkgRoundList == nil {
+			x.DkgRoundList = []*DkgRound{}
+		}
+		value := &_GenesisState_16_list{list: &x.DkgRoundList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.dkgDealList":
+		if x.DkgDealList == nil {
+			x.DkgDealList = []*DkgDeal{}
+		}
+		value := &_GenesisState_18_list{list: &x.DkgDealList}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.keyshare.GenesisState.dkgComplaintList":
+		if x.DkgComplaintList == nil {
+			x.DkgComplaintList = []*DkgComplaint{}
+		}
+		value := &_GenesisState_19_list{list: &x.DkgComplaintList}
+		return protoreflect.ValueOfList(value)
 	case "fairyring.keyshare.GenesisState.port_id":
 		panic(fmt.Errorf("field port_id of message fairyring.keyshare.GenesisState is not mutable"))
 	case "fairyring.keyshare.GenesisState.request_count":
 		panic(fmt.Errorf("field request_count of message fairyring.keyshare.GenesisState is not mutable"))
+	case "fairyring.keyshare.GenesisState.dkgRoundCount":
+		panic(fmt.Errorf("field dkgRoundCount of message fairyring.keyshare.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GenesisState"))
@@ -715,6 +1366,32 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "fairyring.keyshare.GenesisState.generalKeyShareList":
 		list := []*GeneralKeyShare{}
 		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "fairyring.keyshare.GenesisState.keyshareRewardCountList":
+		list := []*KeyshareRewardCount{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "fairyring.keyshare.GenesisState.keyshareRewardList":
+		list := []*KeyshareReward{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "fairyring.keyshare.GenesisState.keyshareSigningInfoList":
+		list := []*KeyshareSigningInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "fairyring.keyshare.GenesisState.missedKeysharesBitmapList":
+		list := []*MissedKeysharesBitmap{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "fairyring.keyshare.GenesisState.keyshareEvidenceList":
+		list := []*KeyshareEvidence{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "fairyring.keyshare.GenesisState.dkgRoundList":
+		list := []*DkgRound{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "fairyring.keyshare.GenesisState.dkgRoundCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.GenesisState.dkgDealList":
+		list := []*DkgDeal{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "fairyring.keyshare.GenesisState.dkgComplaintList":
+		list := []*DkgComplaint{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.GenesisState"))
@@ -804,64 +1481,256 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.AggregatedKeyShareList) > 0 {
-			for _, e := range x.AggregatedKeyShareList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.AggregatedKeyShareList) > 0 {
+			for _, e := range x.AggregatedKeyShareList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ActivePubKey != nil {
+			l = options.Size(x.ActivePubKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.QueuedPubKey != nil {
+			l = options.Size(x.QueuedPubKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AuthorizedAddressList) > 0 {
+			for _, e := range x.AuthorizedAddressList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RequestCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestCount))
+		}
+		if len(x.GeneralKeyShareList) > 0 {
+			for _, e := range x.GeneralKeyShareList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyshareRewardCountList) > 0 {
+			for _, e := range x.KeyshareRewardCountList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyshareRewardList) > 0 {
+			for _, e := range x.KeyshareRewardList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyshareSigningInfoList) > 0 {
+			for _, e := range x.KeyshareSigningInfoList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MissedKeysharesBitmapList) > 0 {
+			for _, e := range x.MissedKeysharesBitmapList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KeyshareEvidenceList) > 0 {
+			for _, e := range x.KeyshareEvidenceList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DkgRoundList) > 0 {
+			for _, e := range x.DkgRoundList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DkgRoundCount != 0 {
+			n += 2 + runtime.Sov(uint64(x.DkgRoundCount))
+		}
+		if len(x.DkgDealList) > 0 {
+			for _, e := range x.DkgDealList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DkgComplaintList) > 0 {
+			for _, e := range x.DkgComplaintList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DkgComplaintList) > 0 {
+			for iNdEx := len(x.DkgComplaintList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DkgComplaintList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.DkgDealList) > 0 {
+			for iNdEx := len(x.DkgDealList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DkgDealList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.DkgRoundCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgRoundCount))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.DkgRoundList) > 0 {
+			for iNdEx := len(x.DkgRoundList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DkgRoundList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.KeyshareEvidenceList) > 0 {
+			for iNdEx := len(x.KeyshareEvidenceList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyshareEvidenceList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.MissedKeysharesBitmapList) > 0 {
+			for iNdEx := len(x.MissedKeysharesBitmapList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MissedKeysharesBitmapList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.KeyshareSigningInfoList) > 0 {
+			for iNdEx := len(x.KeyshareSigningInfoList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyshareSigningInfoList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
 			}
 		}
-		if x.ActivePubKey != nil {
-			l = options.Size(x.ActivePubKey)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.QueuedPubKey != nil {
-			l = options.Size(x.QueuedPubKey)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.AuthorizedAddressList) > 0 {
-			for _, e := range x.AuthorizedAddressList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.KeyshareRewardList) > 0 {
+			for iNdEx := len(x.KeyshareRewardList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyshareRewardList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
 			}
 		}
-		if x.RequestCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.RequestCount))
-		}
-		if len(x.GeneralKeyShareList) > 0 {
-			for _, e := range x.GeneralKeyShareList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.KeyshareRewardCountList) > 0 {
+			for iNdEx := len(x.KeyshareRewardCountList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyshareRewardCountList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
 		if len(x.GeneralKeyShareList) > 0 {
 			for iNdEx := len(x.GeneralKeyShareList) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.GeneralKeyShareList[iNdEx])
@@ -1026,28 +1895,198 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSetList = append(x.ValidatorSetList, &ValidatorSet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorSetList[len(x.ValidatorSetList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyShareList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyShareList = append(x.KeyShareList, &KeyShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyShareList[len(x.KeyShareList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.AggregatedKeyShareList = append(x.AggregatedKeyShareList, &AggregatedKeyShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatedKeyShareList[len(x.AggregatedKeyShareList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1074,18 +2113,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.ActivePubKey == nil {
+					x.ActivePubKey = &ActivePubKey{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActivePubKey); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1095,27 +2134,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PortId = string(dAtA[iNdEx:postIndex])
+				if x.QueuedPubKey == nil {
+					x.QueuedPubKey = &QueuedPubKey{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedPubKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddressList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1142,14 +2185,33 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorSetList = append(x.ValidatorSetList, &ValidatorSet{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorSetList[len(x.ValidatorSetList)-1]); err != nil {
+				x.AuthorizedAddressList = append(x.AuthorizedAddressList, &AuthorizedAddress{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuthorizedAddressList[len(x.AuthorizedAddressList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+				}
+				x.RequestCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyShareList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShareList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1176,14 +2238,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.KeyShareList = append(x.KeyShareList, &KeyShare{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyShareList[len(x.KeyShareList)-1]); err != nil {
+				x.GeneralKeyShareList = append(x.GeneralKeyShareList, &GeneralKeyShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GeneralKeyShareList[len(x.GeneralKeyShareList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyshareRewardCountList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1210,14 +2272,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AggregatedKeyShareList = append(x.AggregatedKeyShareList, &AggregatedKeyShare{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AggregatedKeyShareList[len(x.AggregatedKeyShareList)-1]); err != nil {
+				x.KeyshareRewardCountList = append(x.KeyshareRewardCountList, &KeyshareRewardCount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyshareRewardCountList[len(x.KeyshareRewardCountList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyshareRewardList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1244,16 +2306,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ActivePubKey == nil {
-					x.ActivePubKey = &ActivePubKey{}
+				x.KeyshareRewardList = append(x.KeyshareRewardList, &KeyshareReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyshareRewardList[len(x.KeyshareRewardList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActivePubKey); err != nil {
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyshareSigningInfoList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyshareSigningInfoList = append(x.KeyshareSigningInfoList, &KeyshareSigningInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyshareSigningInfoList[len(x.KeyshareSigningInfoList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedKeysharesBitmapList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1280,16 +2374,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.QueuedPubKey == nil {
-					x.QueuedPubKey = &QueuedPubKey{}
+				x.MissedKeysharesBitmapList = append(x.MissedKeysharesBitmapList, &MissedKeysharesBitmap{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MissedKeysharesBitmapList[len(x.MissedKeysharesBitmapList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedPubKey); err != nil {
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyshareEvidenceList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyshareEvidenceList = append(x.KeyshareEvidenceList, &KeyshareEvidence{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyshareEvidenceList[len(x.KeyshareEvidenceList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddressList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgRoundList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1316,16 +2442,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AuthorizedAddressList = append(x.AuthorizedAddressList, &AuthorizedAddress{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuthorizedAddressList[len(x.AuthorizedAddressList)-1]); err != nil {
+				x.DkgRoundList = append(x.DkgRoundList, &DkgRound{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgRoundList[len(x.DkgRoundList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
+			case 17:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgRoundCount", wireType)
 				}
-				x.RequestCount = 0
+				x.DkgRoundCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1335,14 +2461,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RequestCount |= uint64(b&0x7F) << shift
+					x.DkgRoundCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 10:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShareList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgDealList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1369,8 +2495,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GeneralKeyShareList = append(x.GeneralKeyShareList, &GeneralKeyShare{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GeneralKeyShareList[len(x.GeneralKeyShareList)-1]); err != nil {
+				x.DkgDealList = append(x.DkgDealList, &DkgDeal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgDealList[len(x.DkgDealList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgComplaintList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DkgComplaintList = append(x.DkgComplaintList, &DkgComplaint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DkgComplaintList[len(x.DkgComplaintList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1434,12 +2594,22 @@ type GenesisState struct {
 	ValidatorSetList []*ValidatorSet `protobuf:"bytes,3,rep,name=validatorSetList,proto3" json:"validatorSetList,omitempty"`
 	KeyShareList     []*KeyShare     `protobuf:"bytes,4,rep,name=keyShareList,proto3" json:"keyShareList,omitempty"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	AggregatedKeyShareList []*AggregatedKeyShare `protobuf:"bytes,5,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList,omitempty"`
-	ActivePubKey           *ActivePubKey         `protobuf:"bytes,6,opt,name=activePubKey,proto3" json:"activePubKey,omitempty"`
-	QueuedPubKey           *QueuedPubKey         `protobuf:"bytes,7,opt,name=queuedPubKey,proto3" json:"queuedPubKey,omitempty"`
-	AuthorizedAddressList  []*AuthorizedAddress  `protobuf:"bytes,8,rep,name=authorizedAddressList,proto3" json:"authorizedAddressList,omitempty"`
-	RequestCount           uint64                `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	GeneralKeyShareList    []*GeneralKeyShare    `protobuf:"bytes,10,rep,name=generalKeyShareList,proto3" json:"generalKeyShareList,omitempty"`
+	AggregatedKeyShareList    []*AggregatedKeyShare    `protobuf:"bytes,5,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList,omitempty"`
+	ActivePubKey              *ActivePubKey            `protobuf:"bytes,6,opt,name=activePubKey,proto3" json:"activePubKey,omitempty"`
+	QueuedPubKey              *QueuedPubKey            `protobuf:"bytes,7,opt,name=queuedPubKey,proto3" json:"queuedPubKey,omitempty"`
+	AuthorizedAddressList     []*AuthorizedAddress     `protobuf:"bytes,8,rep,name=authorizedAddressList,proto3" json:"authorizedAddressList,omitempty"`
+	RequestCount              uint64                   `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	GeneralKeyShareList       []*GeneralKeyShare       `protobuf:"bytes,10,rep,name=generalKeyShareList,proto3" json:"generalKeyShareList,omitempty"`
+	KeyshareRewardCountList   []*KeyshareRewardCount   `protobuf:"bytes,11,rep,name=keyshareRewardCountList,proto3" json:"keyshareRewardCountList,omitempty"`
+	KeyshareRewardList        []*KeyshareReward        `protobuf:"bytes,12,rep,name=keyshareRewardList,proto3" json:"keyshareRewardList,omitempty"`
+	KeyshareSigningInfoList   []*KeyshareSigningInfo   `protobuf:"bytes,13,rep,name=keyshareSigningInfoList,proto3" json:"keyshareSigningInfoList,omitempty"`
+	MissedKeysharesBitmapList []*MissedKeysharesBitmap `protobuf:"bytes,14,rep,name=missedKeysharesBitmapList,proto3" json:"missedKeysharesBitmapList,omitempty"`
+	KeyshareEvidenceList      []*KeyshareEvidence      `protobuf:"bytes,15,rep,name=keyshareEvidenceList,proto3" json:"keyshareEvidenceList,omitempty"`
+	DkgRoundList              []*DkgRound              `protobuf:"bytes,16,rep,name=dkgRoundList,proto3" json:"dkgRoundList,omitempty"`
+	// id of the latest dkg round
+	DkgRoundCount    uint64          `protobuf:"varint,17,opt,name=dkgRoundCount,proto3" json:"dkgRoundCount,omitempty"`
+	DkgDealList      []*DkgDeal      `protobuf:"bytes,18,rep,name=dkgDealList,proto3" json:"dkgDealList,omitempty"`
+	DkgComplaintList []*DkgComplaint `protobuf:"bytes,19,rep,name=dkgComplaintList,proto3" json:"dkgComplaintList,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1532,6 +2702,69 @@ func (x *GenesisState) GetGeneralKeyShareList() []*GeneralKeyShare {
 	return nil
 }
 
+func (x *GenesisState) GetKeyshareRewardCountList() []*KeyshareRewardCount {
+	if x != nil {
+		return x.KeyshareRewardCountList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetKeyshareRewardList() []*KeyshareReward {
+	if x != nil {
+		return x.KeyshareRewardList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetKeyshareSigningInfoList() []*KeyshareSigningInfo {
+	if x != nil {
+		return x.KeyshareSigningInfoList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMissedKeysharesBitmapList() []*MissedKeysharesBitmap {
+	if x != nil {
+		return x.MissedKeysharesBitmapList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetKeyshareEvidenceList() []*KeyshareEvidence {
+	if x != nil {
+		return x.KeyshareEvidenceList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDkgRoundList() []*DkgRound {
+	if x != nil {
+		return x.DkgRoundList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDkgRoundCount() uint64 {
+	if x != nil {
+		return x.DkgRoundCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetDkgDealList() []*DkgDeal {
+	if x != nil {
+		return x.DkgDealList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDkgComplaintList() []*DkgComplaint {
+	if x != nil {
+		return x.DkgComplaintList
+	}
+	return nil
+}
+
 var File_fairyring_keyshare_genesis_proto protoreflect.FileDescriptor
 
 var file_fairyring_keyshare_genesis_proto_rawDesc = []byte{
@@ -1558,65 +2791,124 @@ var file_fairyring_keyshare_genesis_proto_rawDesc = []byte{
 	0x72, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x66, 0x61, 0x69, 0x72,
 	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x67,
 	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
-	0x52, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x69, 0x72,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x61, 0x69, 0x72,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x4b,
-	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6b,
-	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x16, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2a, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x2f, 0x64, 0x6b, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x0b, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x64, 0x0a, 0x16, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61,
 	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x4a, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x61, 0x0a,
+	0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x67, 0x0a, 0x17, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x17, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x12, 0x6b,
+	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x4b, 0x65, 0x79,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x12, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x17, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x17, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6d,
+	0x0a, 0x19, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x19, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a,
+	0x14, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x61,
 	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x61, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x4a, 0x0a,
-	0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x61, 0x0a, 0x15, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x5b, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0xb4,
-	0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02,
-	0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02,
-	0x1e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x13, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x0c, 0x64, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x44, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6b, 0x67, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x6b,
+	0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x64,
+	0x6b, 0x67, 0x44, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x44, 0x6b, 0x67, 0x44, 0x65, 0x61, 0x6c, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64, 0x6b, 0x67, 0x44, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x52, 0x0a, 0x10, 0x64, 0x6b, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e,
+	0x44, 0x6b, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x10, 0x64, 0x6b, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0xb4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca,
+	0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1633,30 +2925,46 @@ func file_fairyring_keyshare_genesis_proto_rawDescGZIP() []byte {
 
 var file_fairyring_keyshare_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_fairyring_keyshare_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),       // 0: fairyring.keyshare.GenesisState
-	(*Params)(nil),             // 1: fairyring.keyshare.Params
-	(*ValidatorSet)(nil),       // 2: fairyring.keyshare.ValidatorSet
-	(*KeyShare)(nil),           // 3: fairyring.keyshare.KeyShare
-	(*AggregatedKeyShare)(nil), // 4: fairyring.keyshare.AggregatedKeyShare
-	(*ActivePubKey)(nil),       // 5: fairyring.keyshare.ActivePubKey
-	(*QueuedPubKey)(nil),       // 6: fairyring.keyshare.QueuedPubKey
-	(*AuthorizedAddress)(nil),  // 7: fairyring.keyshare.AuthorizedAddress
-	(*GeneralKeyShare)(nil),    // 8: fairyring.keyshare.GeneralKeyShare
+	(*GenesisState)(nil),          // 0: fairyring.keyshare.GenesisState
+	(*Params)(nil),                // 1: fairyring.keyshare.Params
+	(*ValidatorSet)(nil),          // 2: fairyring.keyshare.ValidatorSet
+	(*KeyShare)(nil),              // 3: fairyring.keyshare.KeyShare
+	(*AggregatedKeyShare)(nil),    // 4: fairyring.keyshare.AggregatedKeyShare
+	(*ActivePubKey)(nil),          // 5: fairyring.keyshare.ActivePubKey
+	(*QueuedPubKey)(nil),          // 6: fairyring.keyshare.QueuedPubKey
+	(*AuthorizedAddress)(nil),     // 7: fairyring.keyshare.AuthorizedAddress
+	(*GeneralKeyShare)(nil),       // 8: fairyring.keyshare.GeneralKeyShare
+	(*KeyshareRewardCount)(nil),   // 9: fairyring.keyshare.KeyshareRewardCount
+	(*KeyshareReward)(nil),        // 10: fairyring.keyshare.KeyshareReward
+	(*KeyshareSigningInfo)(nil),   // 11: fairyring.keyshare.KeyshareSigningInfo
+	(*MissedKeysharesBitmap)(nil), // 12: fairyring.keyshare.MissedKeysharesBitmap
+	(*KeyshareEvidence)(nil),      // 13: fairyring.keyshare.KeyshareEvidence
+	(*DkgRound)(nil),              // 14: fairyring.keyshare.DkgRound
+	(*DkgDeal)(nil),               // 15: fairyring.keyshare.DkgDeal
+	(*DkgComplaint)(nil),          // 16: fairyring.keyshare.DkgComplaint
 }
 var file_fairyring_keyshare_genesis_proto_depIdxs = []int32{
-	1, // 0: fairyring.keyshare.GenesisState.params:type_name -> fairyring.keyshare.Params
-	2, // 1: fairyring.keyshare.GenesisState.validatorSetList:type_name -> fairyring.keyshare.ValidatorSet
-	3, // 2: fairyring.keyshare.GenesisState.keyShareList:type_name -> fairyring.keyshare.KeyShare
-	4, // 3: fairyring.keyshare.GenesisState.aggregatedKeyShareList:type_name -> fairyring.keyshare.AggregatedKeyShare
-	5, // 4: fairyring.keyshare.GenesisState.activePubKey:type_name -> fairyring.keyshare.ActivePubKey
-	6, // 5: fairyring.keyshare.GenesisState.queuedPubKey:type_name -> fairyring.keyshare.QueuedPubKey
-	7, // 6: fairyring.keyshare.GenesisState.authorizedAddressList:type_name -> fairyring.keyshare.AuthorizedAddress
-	8, // 7: fairyring.keyshare.GenesisState.generalKeyShareList:type_name -> fairyring.keyshare.GeneralKeyShare
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	1,  // 0: fairyring.keyshare.GenesisState.params:type_name -> fairyring.keyshare.Params
+	2,  // 1: fairyring.keyshare.GenesisState.validatorSetList:type_name -> fairyring.keyshare.ValidatorSet
+	3,  // 2: fairyring.keyshare.GenesisState.keyShareList:type_name -> fairyring.keyshare.KeyShare
+	4,  // 3: fairyring.keyshare.GenesisState.aggregatedKeyShareList:type_name -> fairyring.keyshare.AggregatedKeyShare
+	5,  // 4: fairyring.keyshare.GenesisState.activePubKey:type_name -> fairyring.keyshare.ActivePubKey
+	6,  // 5: fairyring.keyshare.GenesisState.queuedPubKey:type_name -> fairyring.keyshare.QueuedPubKey
+	7,  // 6: fairyring.keyshare.GenesisState.authorizedAddressList:type_name -> fairyring.keyshare.AuthorizedAddress
+	8,  // 7: fairyring.keyshare.GenesisState.generalKeyShareList:type_name -> fairyring.keyshare.GeneralKeyShare
+	9,  // 8: fairyring.keyshare.GenesisState.keyshareRewardCountList:type_name -> fairyring.keyshare.KeyshareRewardCount
+	10, // 9: fairyring.keyshare.GenesisState.keyshareRewardList:type_name -> fairyring.keyshare.KeyshareReward
+	11, // 10: fairyring.keyshare.GenesisState.keyshareSigningInfoList:type_name -> fairyring.keyshare.KeyshareSigningInfo
+	12, // 11: fairyring.keyshare.GenesisState.missedKeysharesBitmapList:type_name -> fairyring.keyshare.MissedKeysharesBitmap
+	13, // 12: fairyring.keyshare.GenesisState.keyshareEvidenceList:type_name -> fairyring.keyshare.KeyshareEvidence
+	14, // 13: fairyring.keyshare.GenesisState.dkgRoundList:type_name -> fairyring.keyshare.DkgRound
+	15, // 14: fairyring.keyshare.GenesisState.dkgDealList:type_name -> fairyring.keyshare.DkgDeal
+	16, // 15: fairyring.keyshare.GenesisState.dkgComplaintList:type_name -> fairyring.keyshare.DkgComplaint
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_fairyring_keyshare_genesis_proto_init() }
@@ -1671,6 +2979,10 @@ func file_fairyring_keyshare_genesis_proto_init() {
 	file_fairyring_keyshare_pub_key_proto_init()
 	file_fairyring_keyshare_authorized_address_proto_init()
 	file_fairyring_keyshare_general_key_share_proto_init()
+	file_fairyring_keyshare_keyshare_reward_proto_init()
+	file_fairyring_keyshare_keyshare_signing_info_proto_init()
+	file_fairyring_keyshare_keyshare_evidence_proto_init()
+	file_fairyring_keyshare_dkg_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_fairyring_keyshare_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -0,0 +1,1494 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package keyshare
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_KeyshareSigningInfo                        protoreflect.MessageDescriptor
+	fd_KeyshareSigningInfo_validator              protoreflect.FieldDescriptor
+	fd_KeyshareSigningInfo_consAddr               protoreflect.FieldDescriptor
+	fd_KeyshareSigningInfo_startHeight            protoreflect.FieldDescriptor
+	fd_KeyshareSigningInfo_indexOffset            protoreflect.FieldDescriptor
+	fd_KeyshareSigningInfo_jailed                 protoreflect.FieldDescriptor
+	fd_KeyshareSigningInfo_jailedUntil            protoreflect.FieldDescriptor
+	fd_KeyshareSigningInfo_missedKeysharesCounter protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_keyshare_keyshare_signing_info_proto_init()
+	md_KeyshareSigningInfo = File_fairyring_keyshare_keyshare_signing_info_proto.Messages().ByName("KeyshareSigningInfo")
+	fd_KeyshareSigningInfo_validator = md_KeyshareSigningInfo.Fields().ByName("validator")
+	fd_KeyshareSigningInfo_consAddr = md_KeyshareSigningInfo.Fields().ByName("consAddr")
+	fd_KeyshareSigningInfo_startHeight = md_KeyshareSigningInfo.Fields().ByName("startHeight")
+	fd_KeyshareSigningInfo_indexOffset = md_KeyshareSigningInfo.Fields().ByName("indexOffset")
+	fd_KeyshareSigningInfo_jailed = md_KeyshareSigningInfo.Fields().ByName("jailed")
+	fd_KeyshareSigningInfo_jailedUntil = md_KeyshareSigningInfo.Fields().ByName("jailedUntil")
+	fd_KeyshareSigningInfo_missedKeysharesCounter = md_KeyshareSigningInfo.Fields().ByName("missedKeysharesCounter")
+}
+
+var _ protoreflect.Message = (*fastReflection_KeyshareSigningInfo)(nil)
+
+type fastReflection_KeyshareSigningInfo KeyshareSigningInfo
+
+func (x *KeyshareSigningInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KeyshareSigningInfo)(x)
+}
+
+func (x *KeyshareSigningInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KeyshareSigningInfo_messageType fastReflection_KeyshareSigningInfo_messageType
+var _ protoreflect.MessageType = fastReflection_KeyshareSigningInfo_messageType{}
+
+type fastReflection_KeyshareSigningInfo_messageType struct{}
+
+func (x fastReflection_KeyshareSigningInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KeyshareSigningInfo)(nil)
+}
+func (x fastReflection_KeyshareSigningInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_KeyshareSigningInfo)
+}
+func (x fastReflection_KeyshareSigningInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyshareSigningInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KeyshareSigningInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyshareSigningInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KeyshareSigningInfo) Type() protoreflect.MessageType {
+	return _fastReflection_KeyshareSigningInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KeyshareSigningInfo) New() protoreflect.Message {
+	return new(fastReflection_KeyshareSigningInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KeyshareSigningInfo) Interface() protoreflect.ProtoMessage {
+	return (*KeyshareSigningInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KeyshareSigningInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_KeyshareSigningInfo_validator, value) {
+			return
+		}
+	}
+	if x.ConsAddr != "" {
+		value := protoreflect.ValueOfString(x.ConsAddr)
+		if !f(fd_KeyshareSigningInfo_consAddr, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_KeyshareSigningInfo_startHeight, value) {
+			return
+		}
+	}
+	if x.IndexOffset != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IndexOffset)
+		if !f(fd_KeyshareSigningInfo_indexOffset, value) {
+			return
+		}
+	}
+	if x.Jailed != false {
+		value := protoreflect.ValueOfBool(x.Jailed)
+		if !f(fd_KeyshareSigningInfo_jailed, value) {
+			return
+		}
+	}
+	if x.JailedUntil != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JailedUntil)
+		if !f(fd_KeyshareSigningInfo_jailedUntil, value) {
+			return
+		}
+	}
+	if x.MissedKeysharesCounter != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedKeysharesCounter)
+		if !f(fd_KeyshareSigningInfo_missedKeysharesCounter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KeyshareSigningInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.keyshare.KeyshareSigningInfo.validator":
+		return x.Validator != ""
+	case "fairyring.keyshare.KeyshareSigningInfo.consAddr":
+		return x.ConsAddr != ""
+	case "fairyring.keyshare.KeyshareSigningInfo.startHeight":
+		return x.StartHeight != uint64(0)
+	case "fairyring.keyshare.KeyshareSigningInfo.indexOffset":
+		return x.IndexOffset != uint64(0)
+	case "fairyring.keyshare.KeyshareSigningInfo.jailed":
+		return x.Jailed != false
+	case "fairyring.keyshare.KeyshareSigningInfo.jailedUntil":
+		return x.JailedUntil != uint64(0)
+	case "fairyring.keyshare.KeyshareSigningInfo.missedKeysharesCounter":
+		return x.MissedKeysharesCounter != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.KeyshareSigningInfo"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.KeyshareSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyshareSigningInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.KeyshareSigningInfo.validator":
+		x.Validator = ""
+	case "fairyring.keyshare.KeyshareSigningInfo.consAddr":
+		x.ConsAddr = ""
+	case "fairyring.keyshare.KeyshareSigningInfo.startHeight":
+		x.StartHeight = uint64(0)
+	case "fairyring.keyshare.KeyshareSigningInfo.indexOffset":
+		x.IndexOffset = uint64(0)
+	case "fairyring.keyshare.KeyshareSigningInfo.jailed":
+		x.Jailed = false
+	case "fairyring.keyshare.KeyshareSigningInfo.jailedUntil":
+		x.JailedUntil = uint64(0)
+	case "fairyring.keyshare.KeyshareSigningInfo.missedKeysharesCounter":
+		x.MissedKeysharesCounter = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.KeyshareSigningInfo"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.KeyshareSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KeyshareSigningInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.keyshare.KeyshareSigningInfo.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.KeyshareSigningInfo.consAddr":
+		value := x.ConsAddr
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.KeyshareSigningInfo.startHeight":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.KeyshareSigningInfo.indexOffset":
+		value := x.IndexOffset
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.KeyshareSigningInfo.jailed":
+		value := x.Jailed
+		return protoreflect.ValueOfBool(value)
+	case "fairyring.keyshare.KeyshareSigningInfo.jailedUntil":
+		value := x.JailedUntil
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.KeyshareSigningInfo.missedKeysharesCounter":
+		value := x.MissedKeysharesCounter
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.KeyshareSigningInfo"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.KeyshareSigningInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyshareSigningInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.KeyshareSigningInfo.validator":
+		x.Validator = value.Interface().(string)
+	case "fairyring.keyshare.KeyshareSigningInfo.consAddr":
+		x.ConsAddr = value.Interface().(string)
+	case "fairyring.keyshare.KeyshareSigningInfo.startHeight":
+		x.StartHeight = value.Uint()
+	case "fairyring.keyshare.KeyshareSigningInfo.indexOffset":
+		x.IndexOffset = value.Uint()
+	case "fairyring.keyshare.KeyshareSigningInfo.jailed":
+		x.Jailed = value.Bool()
+	case "fairyring.keyshare.KeyshareSigningInfo.jailedUntil":
+		x.JailedUntil = value.Uint()
+	case "fairyring.keyshare.KeyshareSigningInfo.missedKeysharesCounter":
+		x.MissedKeysharesCounter = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.KeyshareSigningInfo"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.KeyshareSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyshareSigningInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.KeyshareSigningInfo.validator":
+		panic(fmt.Errorf("field validator of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	case "fairyring.keyshare.KeyshareSigningInfo.consAddr":
+		panic(fmt.Errorf("field consAddr of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	case "fairyring.keyshare.KeyshareSigningInfo.startHeight":
+		panic(fmt.Errorf("field startHeight of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	case "fairyring.keyshare.KeyshareSigningInfo.indexOffset":
+		panic(fmt.Errorf("field indexOffset of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	case "fairyring.keyshare.KeyshareSigningInfo.jailed":
+		panic(fmt.Errorf("field jailed of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	case "fairyring.keyshare.KeyshareSigningInfo.jailedUntil":
+		panic(fmt.Errorf("field jailedUntil of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	case "fairyring.keyshare.KeyshareSigningInfo.missedKeysharesCounter":
+		panic(fmt.Errorf("field missedKeysharesCounter of message fairyring.keyshare.KeyshareSigningInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.KeyshareSigningInfo"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.KeyshareSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KeyshareSigningInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.KeyshareSigningInfo.validator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.KeyshareSigningInfo.consAddr":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.KeyshareSigningInfo.startHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.KeyshareSigningInfo.indexOffset":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.KeyshareSigningInfo.jailed":
+		return protoreflect.ValueOfBool(false)
+	case "fairyring.keyshare.KeyshareSigningInfo.jailedUntil":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.KeyshareSigningInfo.missedKeysharesCounter":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.KeyshareSigningInfo"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.KeyshareSigningInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KeyshareSigningInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.keyshare.KeyshareSigningInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KeyshareSigningInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyshareSigningInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KeyshareSigningInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KeyshareSigningInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KeyshareSigningInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConsAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.IndexOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.IndexOffset))
+		}
+		if x.Jailed {
+			n += 2
+		}
+		if x.JailedUntil != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailedUntil))
+		}
+		if x.MissedKeysharesCounter != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedKeysharesCounter))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KeyshareSigningInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MissedKeysharesCounter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedKeysharesCounter))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.JailedUntil != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailedUntil))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Jailed {
+			i--
+			if x.Jailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IndexOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IndexOffset))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ConsAddr) > 0 {
+			i -= len(x.ConsAddr)
+			copy(dAtA[i:], x.ConsAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KeyshareSigningInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyshareSigningInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyshareSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConsAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				}
+				x.IndexOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IndexOffset |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Jailed = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				}
+				x.JailedUntil = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailedUntil |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedKeysharesCounter", wireType)
+				}
+				x.MissedKeysharesCounter = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedKeysharesCounter |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MissedKeysharesBitmap           protoreflect.MessageDescriptor
+	fd_MissedKeysharesBitmap_validator protoreflect.FieldDescriptor
+	fd_MissedKeysharesBitmap_bitmap    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_keyshare_keyshare_signing_info_proto_init()
+	md_MissedKeysharesBitmap = File_fairyring_keyshare_keyshare_signing_info_proto.Messages().ByName("MissedKeysharesBitmap")
+	fd_MissedKeysharesBitmap_validator = md_MissedKeysharesBitmap.Fields().ByName("validator")
+	fd_MissedKeysharesBitmap_bitmap = md_MissedKeysharesBitmap.Fields().ByName("bitmap")
+}
+
+var _ protoreflect.Message = (*fastReflection_MissedKeysharesBitmap)(nil)
+
+type fastReflection_MissedKeysharesBitmap MissedKeysharesBitmap
+
+func (x *MissedKeysharesBitmap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MissedKeysharesBitmap)(x)
+}
+
+func (x *MissedKeysharesBitmap) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MissedKeysharesBitmap_messageType fastReflection_MissedKeysharesBitmap_messageType
+var _ protoreflect.MessageType = fastReflection_MissedKeysharesBitmap_messageType{}
+
+type fastReflection_MissedKeysharesBitmap_messageType struct{}
+
+func (x fastReflection_MissedKeysharesBitmap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MissedKeysharesBitmap)(nil)
+}
+func (x fastReflection_MissedKeysharesBitmap_messageType) New() protoreflect.Message {
+	return new(fastReflection_MissedKeysharesBitmap)
+}
+func (x fastReflection_MissedKeysharesBitmap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MissedKeysharesBitmap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MissedKeysharesBitmap) Descriptor() protoreflect.MessageDescriptor {
+	return md_MissedKeysharesBitmap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MissedKeysharesBitmap) Type() protoreflect.MessageType {
+	return _fastReflection_MissedKeysharesBitmap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MissedKeysharesBitmap) New() protoreflect.Message {
+	return new(fastReflection_MissedKeysharesBitmap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MissedKeysharesBitmap) Interface() protoreflect.ProtoMessage {
+	return (*MissedKeysharesBitmap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MissedKeysharesBitmap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_MissedKeysharesBitmap_validator, value) {
+			return
+		}
+	}
+	if len(x.Bitmap) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bitmap)
+		if !f(fd_MissedKeysharesBitmap_bitmap, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MissedKeysharesBitmap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.keyshare.MissedKeysharesBitmap.validator":
+		return x.Validator != ""
+	case "fairyring.keyshare.MissedKeysharesBitmap.bitmap":
+		return len(x.Bitmap) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.MissedKeysharesBitmap"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.MissedKeysharesBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissedKeysharesBitmap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.MissedKeysharesBitmap.validator":
+		x.Validator = ""
+	case "fairyring.keyshare.MissedKeysharesBitmap.bitmap":
+		x.Bitmap = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.MissedKeysharesBitmap"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.MissedKeysharesBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MissedKeysharesBitmap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.keyshare.MissedKeysharesBitmap.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.keyshare.MissedKeysharesBitmap.bitmap":
+		value := x.Bitmap
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.MissedKeysharesBitmap"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.MissedKeysharesBitmap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissedKeysharesBitmap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.keyshare.MissedKeysharesBitmap.validator":
+		x.Validator = value.Interface().(string)
+	case "fairyring.keyshare.MissedKeysharesBitmap.bitmap":
+		x.Bitmap = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.MissedKeysharesBitmap"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.MissedKeysharesBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissedKeysharesBitmap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.MissedKeysharesBitmap.validator":
+		panic(fmt.Errorf("field validator of message fairyring.keyshare.MissedKeysharesBitmap is not mutable"))
+	case "fairyring.keyshare.MissedKeysharesBitmap.bitmap":
+		panic(fmt.Errorf("field bitmap of message fairyring.keyshare.MissedKeysharesBitmap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.MissedKeysharesBitmap"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.MissedKeysharesBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MissedKeysharesBitmap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.keyshare.MissedKeysharesBitmap.validator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.keyshare.MissedKeysharesBitmap.bitmap":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.MissedKeysharesBitmap"))
+		}
+		panic(fmt.Errorf("message fairyring.keyshare.MissedKeysharesBitmap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MissedKeysharesBitmap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.keyshare.MissedKeysharesBitmap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MissedKeysharesBitmap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MissedKeysharesBitmap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MissedKeysharesBitmap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MissedKeysharesBitmap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MissedKeysharesBitmap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Bitmap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MissedKeysharesBitmap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Bitmap) > 0 {
+			i -= len(x.Bitmap)
+			copy(dAtA[i:], x.Bitmap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bitmap)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MissedKeysharesBitmap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MissedKeysharesBitmap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MissedKeysharesBitmap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bitmap = append(x.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bitmap == nil {
+					x.Bitmap = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fairyring/keyshare/keyshare_signing_info.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KeyshareSigningInfo tracks the keyshare liveness of a validator over the signed keyshares window
+type KeyshareSigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ConsAddr  string `protobuf:"bytes,2,opt,name=consAddr,proto3" json:"consAddr,omitempty"`
+	// height the current liveness window of the validator started at
+	StartHeight uint64 `protobuf:"varint,3,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	// number of blocks tracked in the current window, the bitmap index of a block is the offset modulo the window
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=indexOffset,proto3" json:"indexOffset,omitempty"`
+	Jailed      bool   `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// block height until which the validator can not unjail
+	JailedUntil uint64 `protobuf:"varint,6,opt,name=jailedUntil,proto3" json:"jailedUntil,omitempty"`
+	// number of blocks without a keyshare in the current window
+	MissedKeysharesCounter uint64 `protobuf:"varint,7,opt,name=missedKeysharesCounter,proto3" json:"missedKeysharesCounter,omitempty"`
+}
+
+func (x *KeyshareSigningInfo) Reset() {
+	*x = KeyshareSigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyshareSigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyshareSigningInfo) ProtoMessage() {}
+
+// Deprecated: Use KeyshareSigningInfo.ProtoReflect.Descriptor instead.
+func (*KeyshareSigningInfo) Descriptor() ([]byte, []int) {
+	return file_fairyring_keyshare_keyshare_signing_info_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyshareSigningInfo) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *KeyshareSigningInfo) GetConsAddr() string {
+	if x != nil {
+		return x.ConsAddr
+	}
+	return ""
+}
+
+func (x *KeyshareSigningInfo) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *KeyshareSigningInfo) GetIndexOffset() uint64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *KeyshareSigningInfo) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+func (x *KeyshareSigningInfo) GetJailedUntil() uint64 {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return 0
+}
+
+func (x *KeyshareSigningInfo) GetMissedKeysharesCounter() uint64 {
+	if x != nil {
+		return x.MissedKeysharesCounter
+	}
+	return 0
+}
+
+// MissedKeysharesBitmap is the missed keyshares bitmap of a validator over the signed keyshares window
+type MissedKeysharesBitmap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Bitmap    []byte `protobuf:"bytes,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (x *MissedKeysharesBitmap) Reset() {
+	*x = MissedKeysharesBitmap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedKeysharesBitmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedKeysharesBitmap) ProtoMessage() {}
+
+// Deprecated: Use MissedKeysharesBitmap.ProtoReflect.Descriptor instead.
+func (*MissedKeysharesBitmap) Descriptor() ([]byte, []int) {
+	return file_fairyring_keyshare_keyshare_signing_info_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MissedKeysharesBitmap) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *MissedKeysharesBitmap) GetBitmap() []byte {
+	if x != nil {
+		return x.Bitmap
+	}
+	return nil
+}
+
+var File_fairyring_keyshare_keyshare_signing_info_proto protoreflect.FileDescriptor
+
+var file_fairyring_keyshare_keyshare_signing_info_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x13, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x36, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x65,
+	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x15,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x42,
+	0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x42, 0xc0, 0x01, 0x0a, 0x16,
+	0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x18, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b,
+	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12,
+	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b,
+	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x46, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fairyring_keyshare_keyshare_signing_info_proto_rawDescOnce sync.Once
+	file_fairyring_keyshare_keyshare_signing_info_proto_rawDescData = file_fairyring_keyshare_keyshare_signing_info_proto_rawDesc
+)
+
+func file_fairyring_keyshare_keyshare_signing_info_proto_rawDescGZIP() []byte {
+	file_fairyring_keyshare_keyshare_signing_info_proto_rawDescOnce.Do(func() {
+		file_fairyring_keyshare_keyshare_signing_info_proto_rawDescData = protoimpl.X.CompressGZIP(file_fairyring_keyshare_keyshare_signing_info_proto_rawDescData)
+	})
+	return file_fairyring_keyshare_keyshare_signing_info_proto_rawDescData
+}
+
+var file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fairyring_keyshare_keyshare_signing_info_proto_goTypes = []interface{}{
+	(*KeyshareSigningInfo)(nil),   // 0: fairyring.keyshare.KeyshareSigningInfo
+	(*MissedKeysharesBitmap)(nil), // 1: fairyring.keyshare.MissedKeysharesBitmap
+}
+var file_fairyring_keyshare_keyshare_signing_info_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_fairyring_keyshare_keyshare_signing_info_proto_init() }
+func file_fairyring_keyshare_keyshare_signing_info_proto_init() {
+	if File_fairyring_keyshare_keyshare_signing_info_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyshareSigningInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedKeysharesBitmap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fairyring_keyshare_keyshare_signing_info_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fairyring_keyshare_keyshare_signing_info_proto_goTypes,
+		DependencyIndexes: file_fairyring_keyshare_keyshare_signing_info_proto_depIdxs,
+		MessageInfos:      file_fairyring_keyshare_keyshare_signing_info_proto_msgTypes,
+	}.Build()
+	File_fairyring_keyshare_keyshare_signing_info_proto = out.File
+	file_fairyring_keyshare_keyshare_signing_info_proto_rawDesc = nil
+	file_fairyring_keyshare_keyshare_signing_info_proto_goTypes = nil
+	file_fairyring_keyshare_keyshare_signing_info_proto_depIdxs = nil
+}
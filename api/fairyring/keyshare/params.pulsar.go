@@ -61,13 +61,26 @@ func (x *_Params_4_list) IsValid() bool {
 }
 
 var (
-	md_Params                               protoreflect.MessageDescriptor
-	fd_Params_key_expiry                    protoreflect.FieldDescriptor
-	fd_Params_minimum_bonded                protoreflect.FieldDescriptor
-	fd_Params_max_idled_block               protoreflect.FieldDescriptor
-	fd_Params_trusted_addresses             protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_no_keyshare    protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_wrong_keyshare protoreflect.FieldDescriptor
+	md_Params                                       protoreflect.MessageDescriptor
+	fd_Params_key_expiry                            protoreflect.FieldDescriptor
+	fd_Params_minimum_bonded                        protoreflect.FieldDescriptor
+	fd_Params_max_idled_block                       protoreflect.FieldDescriptor
+	fd_Params_trusted_addresses                     protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_no_keyshare            protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_wrong_keyshare         protoreflect.FieldDescriptor
+	fd_Params_key_share_retention_blocks            protoreflect.FieldDescriptor
+	fd_Params_aggregated_key_share_retention_blocks protoreflect.FieldDescriptor
+	fd_Params_max_pruned_entries_per_block          protoreflect.FieldDescriptor
+	fd_Params_minimum_threshold_ratio               protoreflect.FieldDescriptor
+	fd_Params_stake_weighted_threshold_ratio        protoreflect.FieldDescriptor
+	fd_Params_dkg_deal_phase_blocks                 protoreflect.FieldDescriptor
+	fd_Params_dkg_complaint_phase_blocks            protoreflect.FieldDescriptor
+	fd_Params_dkg_justification_phase_blocks        protoreflect.FieldDescriptor
+	fd_Params_signed_keyshares_window               protoreflect.FieldDescriptor
+	fd_Params_min_submitted_per_window              protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_blocks                  protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_equivocation           protoreflect.FieldDescriptor
+	fd_Params_reward_epoch_blocks                   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -79,6 +92,19 @@ func init() {
 	fd_Params_trusted_addresses = md_Params.Fields().ByName("trusted_addresses")
 	fd_Params_slash_fraction_no_keyshare = md_Params.Fields().ByName("slash_fraction_no_keyshare")
 	fd_Params_slash_fraction_wrong_keyshare = md_Params.Fields().ByName("slash_fraction_wrong_keyshare")
+	fd_Params_key_share_retention_blocks = md_Params.Fields().ByName("key_share_retention_blocks")
+	fd_Params_aggregated_key_share_retention_blocks = md_Params.Fields().ByName("aggregated_key_share_retention_blocks")
+	fd_Params_max_pruned_entries_per_block = md_Params.Fields().ByName("max_pruned_entries_per_block")
+	fd_Params_minimum_threshold_ratio = md_Params.Fields().ByName("minimum_threshold_ratio")
+	fd_Params_stake_weighted_threshold_ratio = md_Params.Fields().ByName("stake_weighted_threshold_ratio")
+	fd_Params_dkg_deal_phase_blocks = md_Params.Fields().ByName("dkg_deal_phase_blocks")
+	fd_Params_dkg_complaint_phase_blocks = md_Params.Fields().ByName("dkg_complaint_phase_blocks")
+	fd_Params_dkg_justification_phase_blocks = md_Params.Fields().ByName("dkg_justification_phase_blocks")
+	fd_Params_signed_keyshares_window = md_Params.Fields().ByName("signed_keyshares_window")
+	fd_Params_min_submitted_per_window = md_Params.Fields().ByName("min_submitted_per_window")
+	fd_Params_downtime_jail_blocks = md_Params.Fields().ByName("downtime_jail_blocks")
+	fd_Params_slash_fraction_equivocation = md_Params.Fields().ByName("slash_fraction_equivocation")
+	fd_Params_reward_epoch_blocks = md_Params.Fields().ByName("reward_epoch_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -182,6 +208,84 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.KeyShareRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeyShareRetentionBlocks)
+		if !f(fd_Params_key_share_retention_blocks, value) {
+			return
+		}
+	}
+	if x.AggregatedKeyShareRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AggregatedKeyShareRetentionBlocks)
+		if !f(fd_Params_aggregated_key_share_retention_blocks, value) {
+			return
+		}
+	}
+	if x.MaxPrunedEntriesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedEntriesPerBlock)
+		if !f(fd_Params_max_pruned_entries_per_block, value) {
+			return
+		}
+	}
+	if len(x.MinimumThresholdRatio) != 0 {
+		value := protoreflect.ValueOfBytes(x.MinimumThresholdRatio)
+		if !f(fd_Params_minimum_threshold_ratio, value) {
+			return
+		}
+	}
+	if len(x.StakeWeightedThresholdRatio) != 0 {
+		value := protoreflect.ValueOfBytes(x.StakeWeightedThresholdRatio)
+		if !f(fd_Params_stake_weighted_threshold_ratio, value) {
+			return
+		}
+	}
+	if x.DkgDealPhaseBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgDealPhaseBlocks)
+		if !f(fd_Params_dkg_deal_phase_blocks, value) {
+			return
+		}
+	}
+	if x.DkgComplaintPhaseBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgComplaintPhaseBlocks)
+		if !f(fd_Params_dkg_complaint_phase_blocks, value) {
+			return
+		}
+	}
+	if x.DkgJustificationPhaseBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DkgJustificationPhaseBlocks)
+		if !f(fd_Params_dkg_justification_phase_blocks, value) {
+			return
+		}
+	}
+	if x.SignedKeysharesWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SignedKeysharesWindow)
+		if !f(fd_Params_signed_keyshares_window, value) {
+			return
+		}
+	}
+	if len(x.MinSubmittedPerWindow) != 0 {
+		value := protoreflect.ValueOfBytes(x.MinSubmittedPerWindow)
+		if !f(fd_Params_min_submitted_per_window, value) {
+			return
+		}
+	}
+	if x.DowntimeJailBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DowntimeJailBlocks)
+		if !f(fd_Params_downtime_jail_blocks, value) {
+			return
+		}
+	}
+	if len(x.SlashFractionEquivocation) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFractionEquivocation)
+		if !f(fd_Params_slash_fraction_equivocation, value) {
+			return
+		}
+	}
+	if x.RewardEpochBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RewardEpochBlocks)
+		if !f(fd_Params_reward_epoch_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -209,6 +313,32 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SlashFractionNoKeyshare) != 0
 	case "fairyring.keyshare.Params.slash_fraction_wrong_keyshare":
 		return len(x.SlashFractionWrongKeyshare) != 0
+	case "fairyring.keyshare.Params.key_share_retention_blocks":
+		return x.KeyShareRetentionBlocks != uint64(0)
+	case "fairyring.keyshare.Params.aggregated_key_share_retention_blocks":
+		return x.AggregatedKeyShareRetentionBlocks != uint64(0)
+	case "fairyring.keyshare.Params.max_pruned_entries_per_block":
+		return x.MaxPrunedEntriesPerBlock != uint64(0)
+	case "fairyring.keyshare.Params.minimum_threshold_ratio":
+		return len(x.MinimumThresholdRatio) != 0
+	case "fairyring.keyshare.Params.stake_weighted_threshold_ratio":
+		return len(x.StakeWeightedThresholdRatio) != 0
+	case "fairyring.keyshare.Params.dkg_deal_phase_blocks":
+		return x.DkgDealPhaseBlocks != uint64(0)
+	case "fairyring.keyshare.Params.dkg_complaint_phase_blocks":
+		return x.DkgComplaintPhaseBlocks != uint64(0)
+	case "fairyring.keyshare.Params.dkg_justification_phase_blocks":
+		return x.DkgJustificationPhaseBlocks != uint64(0)
+	case "fairyring.keyshare.Params.signed_keyshares_window":
+		return x.SignedKeysharesWindow != uint64(0)
+	case "fairyring.keyshare.Params.min_submitted_per_window":
+		return len(x.MinSubmittedPerWindow) != 0
+	case "fairyring.keyshare.Params.downtime_jail_blocks":
+		return x.DowntimeJailBlocks != uint64(0)
+	case "fairyring.keyshare.Params.slash_fraction_equivocation":
+		return len(x.SlashFractionEquivocation) != 0
+	case "fairyring.keyshare.Params.reward_epoch_blocks":
+		return x.RewardEpochBlocks != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.Params"))
@@ -237,6 +367,32 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashFractionNoKeyshare = nil
 	case "fairyring.keyshare.Params.slash_fraction_wrong_keyshare":
 		x.SlashFractionWrongKeyshare = nil
+	case "fairyring.keyshare.Params.key_share_retention_blocks":
+		x.KeyShareRetentionBlocks = uint64(0)
+	case "fairyring.keyshare.Params.aggregated_key_share_retention_blocks":
+		x.AggregatedKeyShareRetentionBlocks = uint64(0)
+	case "fairyring.keyshare.Params.max_pruned_entries_per_block":
+		x.MaxPrunedEntriesPerBlock = uint64(0)
+	case "fairyring.keyshare.Params.minimum_threshold_ratio":
+		x.MinimumThresholdRatio = nil
+	case "fairyring.keyshare.Params.stake_weighted_threshold_ratio":
+		x.StakeWeightedThresholdRatio = nil
+	case "fairyring.keyshare.Params.dkg_deal_phase_blocks":
+		x.DkgDealPhaseBlocks = uint64(0)
+	case "fairyring.keyshare.Params.dkg_complaint_phase_blocks":
+		x.DkgComplaintPhaseBlocks = uint64(0)
+	case "fairyring.keyshare.Params.dkg_justification_phase_blocks":
+		x.DkgJustificationPhaseBlocks = uint64(0)
+	case "fairyring.keyshare.Params.signed_keyshares_window":
+		x.SignedKeysharesWindow = uint64(0)
+	case "fairyring.keyshare.Params.min_submitted_per_window":
+		x.MinSubmittedPerWindow = nil
+	case "fairyring.keyshare.Params.downtime_jail_blocks":
+		x.DowntimeJailBlocks = uint64(0)
+	case "fairyring.keyshare.Params.slash_fraction_equivocation":
+		x.SlashFractionEquivocation = nil
+	case "fairyring.keyshare.Params.reward_epoch_blocks":
+		x.RewardEpochBlocks = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.Params"))
@@ -274,6 +430,45 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "fairyring.keyshare.Params.slash_fraction_wrong_keyshare":
 		value := x.SlashFractionWrongKeyshare
 		return protoreflect.ValueOfBytes(value)
+	case "fairyring.keyshare.Params.key_share_retention_blocks":
+		value := x.KeyShareRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.aggregated_key_share_retention_blocks":
+		value := x.AggregatedKeyShareRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.max_pruned_entries_per_block":
+		value := x.MaxPrunedEntriesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.minimum_threshold_ratio":
+		value := x.MinimumThresholdRatio
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.keyshare.Params.stake_weighted_threshold_ratio":
+		value := x.StakeWeightedThresholdRatio
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.keyshare.Params.dkg_deal_phase_blocks":
+		value := x.DkgDealPhaseBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.dkg_complaint_phase_blocks":
+		value := x.DkgComplaintPhaseBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.dkg_justification_phase_blocks":
+		value := x.DkgJustificationPhaseBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.signed_keyshares_window":
+		value := x.SignedKeysharesWindow
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.min_submitted_per_window":
+		value := x.MinSubmittedPerWindow
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.keyshare.Params.downtime_jail_blocks":
+		value := x.DowntimeJailBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.keyshare.Params.slash_fraction_equivocation":
+		value := x.SlashFractionEquivocation
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.keyshare.Params.reward_epoch_blocks":
+		value := x.RewardEpochBlocks
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.Params"))
@@ -308,6 +503,32 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashFractionNoKeyshare = value.Bytes()
 	case "fairyring.keyshare.Params.slash_fraction_wrong_keyshare":
 		x.SlashFractionWrongKeyshare = value.Bytes()
+	case "fairyring.keyshare.Params.key_share_retention_blocks":
+		x.KeyShareRetentionBlocks = value.Uint()
+	case "fairyring.keyshare.Params.aggregated_key_share_retention_blocks":
+		x.AggregatedKeyShareRetentionBlocks = value.Uint()
+	case "fairyring.keyshare.Params.max_pruned_entries_per_block":
+		x.MaxPrunedEntriesPerBlock = value.Uint()
+	case "fairyring.keyshare.Params.minimum_threshold_ratio":
+		x.MinimumThresholdRatio = value.Bytes()
+	case "fairyring.keyshare.Params.stake_weighted_threshold_ratio":
+		x.StakeWeightedThresholdRatio = value.Bytes()
+	case "fairyring.keyshare.Params.dkg_deal_phase_blocks":
+		x.DkgDealPhaseBlocks = value.Uint()
+	case "fairyring.keyshare.Params.dkg_complaint_phase_blocks":
+		x.DkgComplaintPhaseBlocks = value.Uint()
+	case "fairyring.keyshare.Params.dkg_justification_phase_blocks":
+		x.DkgJustificationPhaseBlocks = value.Uint()
+	case "fairyring.keyshare.Params.signed_keyshares_window":
+		x.SignedKeysharesWindow = value.Uint()
+	case "fairyring.keyshare.Params.min_submitted_per_window":
+		x.MinSubmittedPerWindow = value.Bytes()
+	case "fairyring.keyshare.Params.downtime_jail_blocks":
+		x.DowntimeJailBlocks = value.Uint()
+	case "fairyring.keyshare.Params.slash_fraction_equivocation":
+		x.SlashFractionEquivocation = value.Bytes()
+	case "fairyring.keyshare.Params.reward_epoch_blocks":
+		x.RewardEpochBlocks = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.Params"))
@@ -344,6 +565,32 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slash_fraction_no_keyshare of message fairyring.keyshare.Params is not mutable"))
 	case "fairyring.keyshare.Params.slash_fraction_wrong_keyshare":
 		panic(fmt.Errorf("field slash_fraction_wrong_keyshare of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.key_share_retention_blocks":
+		panic(fmt.Errorf("field key_share_retention_blocks of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.aggregated_key_share_retention_blocks":
+		panic(fmt.Errorf("field aggregated_key_share_retention_blocks of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.max_pruned_entries_per_block":
+		panic(fmt.Errorf("field max_pruned_entries_per_block of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.minimum_threshold_ratio":
+		panic(fmt.Errorf("field minimum_threshold_ratio of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.stake_weighted_threshold_ratio":
+		panic(fmt.Errorf("field stake_weighted_threshold_ratio of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.dkg_deal_phase_blocks":
+		panic(fmt.Errorf("field dkg_deal_phase_blocks of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.dkg_complaint_phase_blocks":
+		panic(fmt.Errorf("field dkg_complaint_phase_blocks of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.dkg_justification_phase_blocks":
+		panic(fmt.Errorf("field dkg_justification_phase_blocks of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.signed_keyshares_window":
+		panic(fmt.Errorf("field signed_keyshares_window of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.min_submitted_per_window":
+		panic(fmt.Errorf("field min_submitted_per_window of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.downtime_jail_blocks":
+		panic(fmt.Errorf("field downtime_jail_blocks of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.slash_fraction_equivocation":
+		panic(fmt.Errorf("field slash_fraction_equivocation of message fairyring.keyshare.Params is not mutable"))
+	case "fairyring.keyshare.Params.reward_epoch_blocks":
+		panic(fmt.Errorf("field reward_epoch_blocks of message fairyring.keyshare.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.Params"))
@@ -370,6 +617,32 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "fairyring.keyshare.Params.slash_fraction_wrong_keyshare":
 		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.keyshare.Params.key_share_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.aggregated_key_share_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.max_pruned_entries_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.minimum_threshold_ratio":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.keyshare.Params.stake_weighted_threshold_ratio":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.keyshare.Params.dkg_deal_phase_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.dkg_complaint_phase_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.dkg_justification_phase_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.signed_keyshares_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.min_submitted_per_window":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.keyshare.Params.downtime_jail_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.keyshare.Params.slash_fraction_equivocation":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.keyshare.Params.reward_epoch_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.Params"))
@@ -462,6 +735,49 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.KeyShareRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyShareRetentionBlocks))
+		}
+		if x.AggregatedKeyShareRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregatedKeyShareRetentionBlocks))
+		}
+		if x.MaxPrunedEntriesPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrunedEntriesPerBlock))
+		}
+		l = len(x.MinimumThresholdRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakeWeightedThresholdRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DkgDealPhaseBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgDealPhaseBlocks))
+		}
+		if x.DkgComplaintPhaseBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgComplaintPhaseBlocks))
+		}
+		if x.DkgJustificationPhaseBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.DkgJustificationPhaseBlocks))
+		}
+		if x.SignedKeysharesWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignedKeysharesWindow))
+		}
+		l = len(x.MinSubmittedPerWindow)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeJailBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.DowntimeJailBlocks))
+		}
+		l = len(x.SlashFractionEquivocation)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RewardEpochBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.RewardEpochBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -491,6 +807,87 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RewardEpochBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardEpochBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.SlashFractionEquivocation) > 0 {
+			i -= len(x.SlashFractionEquivocation)
+			copy(dAtA[i:], x.SlashFractionEquivocation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionEquivocation)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.DowntimeJailBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DowntimeJailBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.MinSubmittedPerWindow) > 0 {
+			i -= len(x.MinSubmittedPerWindow)
+			copy(dAtA[i:], x.MinSubmittedPerWindow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSubmittedPerWindow)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.SignedKeysharesWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SignedKeysharesWindow))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.DkgJustificationPhaseBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgJustificationPhaseBlocks))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.DkgComplaintPhaseBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgComplaintPhaseBlocks))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.DkgDealPhaseBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DkgDealPhaseBlocks))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.StakeWeightedThresholdRatio) > 0 {
+			i -= len(x.StakeWeightedThresholdRatio)
+			copy(dAtA[i:], x.StakeWeightedThresholdRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakeWeightedThresholdRatio)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.MinimumThresholdRatio) > 0 {
+			i -= len(x.MinimumThresholdRatio)
+			copy(dAtA[i:], x.MinimumThresholdRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinimumThresholdRatio)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.MaxPrunedEntriesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedEntriesPerBlock))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.AggregatedKeyShareRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregatedKeyShareRetentionBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.KeyShareRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyShareRetentionBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.SlashFractionWrongKeyshare) > 0 {
 			i -= len(x.SlashFractionWrongKeyshare)
 			copy(dAtA[i:], x.SlashFractionWrongKeyshare)
@@ -735,6 +1132,313 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.SlashFractionWrongKeyshare = []byte{}
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyShareRetentionBlocks", wireType)
+				}
+				x.KeyShareRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareRetentionBlocks", wireType)
+				}
+				x.AggregatedKeyShareRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregatedKeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedEntriesPerBlock", wireType)
+				}
+				x.MaxPrunedEntriesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunedEntriesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumThresholdRatio", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinimumThresholdRatio = append(x.MinimumThresholdRatio[:0], dAtA[iNdEx:postIndex]...)
+				if x.MinimumThresholdRatio == nil {
+					x.MinimumThresholdRatio = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeWeightedThresholdRatio", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakeWeightedThresholdRatio = append(x.StakeWeightedThresholdRatio[:0], dAtA[iNdEx:postIndex]...)
+				if x.StakeWeightedThresholdRatio == nil {
+					x.StakeWeightedThresholdRatio = []byte{}
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgDealPhaseBlocks", wireType)
+				}
+				x.DkgDealPhaseBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgDealPhaseBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgComplaintPhaseBlocks", wireType)
+				}
+				x.DkgComplaintPhaseBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgComplaintPhaseBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DkgJustificationPhaseBlocks", wireType)
+				}
+				x.DkgJustificationPhaseBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DkgJustificationPhaseBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignedKeysharesWindow", wireType)
+				}
+				x.SignedKeysharesWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SignedKeysharesWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSubmittedPerWindow", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinSubmittedPerWindow = append(x.MinSubmittedPerWindow[:0], dAtA[iNdEx:postIndex]...)
+				if x.MinSubmittedPerWindow == nil {
+					x.MinSubmittedPerWindow = []byte{}
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailBlocks", wireType)
+				}
+				x.DowntimeJailBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DowntimeJailBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEquivocation", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFractionEquivocation = append(x.SlashFractionEquivocation[:0], dAtA[iNdEx:postIndex]...)
+				if x.SlashFractionEquivocation == nil {
+					x.SlashFractionEquivocation = []byte{}
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEpochBlocks", wireType)
+				}
+				x.RewardEpochBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardEpochBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -789,12 +1493,39 @@ type Params struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyExpiry                  uint64   `protobuf:"varint,1,opt,name=key_expiry,json=keyExpiry,proto3" json:"key_expiry,omitempty"`
-	MinimumBonded              uint64   `protobuf:"varint,2,opt,name=minimum_bonded,json=minimumBonded,proto3" json:"minimum_bonded,omitempty"`
+	KeyExpiry     uint64 `protobuf:"varint,1,opt,name=key_expiry,json=keyExpiry,proto3" json:"key_expiry,omitempty"`
+	MinimumBonded uint64 `protobuf:"varint,2,opt,name=minimum_bonded,json=minimumBonded,proto3" json:"minimum_bonded,omitempty"`
+	// deprecated, keyshare liveness is tracked over the signed keyshares window
 	MaxIdledBlock              uint64   `protobuf:"varint,3,opt,name=max_idled_block,json=maxIdledBlock,proto3" json:"max_idled_block,omitempty"`
 	TrustedAddresses           []string `protobuf:"bytes,4,rep,name=trusted_addresses,json=trustedAddresses,proto3" json:"trusted_addresses,omitempty"`
 	SlashFractionNoKeyshare    []byte   `protobuf:"bytes,5,opt,name=slash_fraction_no_keyshare,json=slashFractionNoKeyshare,proto3" json:"slash_fraction_no_keyshare,omitempty"`
 	SlashFractionWrongKeyshare []byte   `protobuf:"bytes,6,opt,name=slash_fraction_wrong_keyshare,json=slashFractionWrongKeyshare,proto3" json:"slash_fraction_wrong_keyshare,omitempty"`
+	// number of blocks for which individual keyshares are kept, 0 keeps them forever
+	KeyShareRetentionBlocks uint64 `protobuf:"varint,7,opt,name=key_share_retention_blocks,json=keyShareRetentionBlocks,proto3" json:"key_share_retention_blocks,omitempty"`
+	// number of blocks for which aggregated keyshares are kept, 0 keeps them forever
+	AggregatedKeyShareRetentionBlocks uint64 `protobuf:"varint,8,opt,name=aggregated_key_share_retention_blocks,json=aggregatedKeyShareRetentionBlocks,proto3" json:"aggregated_key_share_retention_blocks,omitempty"`
+	// maximum number of entries removed from each pruned store per block
+	MaxPrunedEntriesPerBlock uint64 `protobuf:"varint,9,opt,name=max_pruned_entries_per_block,json=maxPrunedEntriesPerBlock,proto3" json:"max_pruned_entries_per_block,omitempty"`
+	// minimum ratio of the key threshold to the number of validators a new public key can be created with
+	MinimumThresholdRatio []byte `protobuf:"bytes,10,opt,name=minimum_threshold_ratio,json=minimumThresholdRatio,proto3" json:"minimum_threshold_ratio,omitempty"`
+	// minimum ratio of the keyshare validator set power the keyshares of a key have to be backed by before it is aggregated, 0 disables it
+	StakeWeightedThresholdRatio []byte `protobuf:"bytes,11,opt,name=stake_weighted_threshold_ratio,json=stakeWeightedThresholdRatio,proto3" json:"stake_weighted_threshold_ratio,omitempty"`
+	// number of blocks a dkg round accepts deals for
+	DkgDealPhaseBlocks uint64 `protobuf:"varint,12,opt,name=dkg_deal_phase_blocks,json=dkgDealPhaseBlocks,proto3" json:"dkg_deal_phase_blocks,omitempty"`
+	// number of blocks a dkg round accepts complaints for after the deal phase
+	DkgComplaintPhaseBlocks uint64 `protobuf:"varint,13,opt,name=dkg_complaint_phase_blocks,json=dkgComplaintPhaseBlocks,proto3" json:"dkg_complaint_phase_blocks,omitempty"`
+	// number of blocks dealers have to answer complaints after the complaint phase
+	DkgJustificationPhaseBlocks uint64 `protobuf:"varint,14,opt,name=dkg_justification_phase_blocks,json=dkgJustificationPhaseBlocks,proto3" json:"dkg_justification_phase_blocks,omitempty"`
+	// number of blocks the keyshare liveness of a validator is tracked over
+	SignedKeysharesWindow uint64 `protobuf:"varint,15,opt,name=signed_keyshares_window,json=signedKeysharesWindow,proto3" json:"signed_keyshares_window,omitempty"`
+	// minimum ratio of blocks in the window a validator has to submit a keyshare for
+	MinSubmittedPerWindow []byte `protobuf:"bytes,16,opt,name=min_submitted_per_window,json=minSubmittedPerWindow,proto3" json:"min_submitted_per_window,omitempty"`
+	// number of blocks a validator is jailed from the keyshare set for downtime
+	DowntimeJailBlocks uint64 `protobuf:"varint,17,opt,name=downtime_jail_blocks,json=downtimeJailBlocks,proto3" json:"downtime_jail_blocks,omitempty"`
+	// fraction of the stake slashed from a validator producing conflicting keyshares for the same identity
+	SlashFractionEquivocation []byte `protobuf:"bytes,18,opt,name=slash_fraction_equivocation,json=slashFractionEquivocation,proto3" json:"slash_fraction_equivocation,omitempty"`
+	// number of blocks after which the keyshare reward pool is paid out to the keyshare submitters
+	RewardEpochBlocks uint64 `protobuf:"varint,19,opt,name=reward_epoch_blocks,json=rewardEpochBlocks,proto3" json:"reward_epoch_blocks,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -859,6 +1590,97 @@ func (x *Params) GetSlashFractionWrongKeyshare() []byte {
 	return nil
 }
 
+func (x *Params) GetKeyShareRetentionBlocks() uint64 {
+	if x != nil {
+		return x.KeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetAggregatedKeyShareRetentionBlocks() uint64 {
+	if x != nil {
+		return x.AggregatedKeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPrunedEntriesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedEntriesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMinimumThresholdRatio() []byte {
+	if x != nil {
+		return x.MinimumThresholdRatio
+	}
+	return nil
+}
+
+func (x *Params) GetStakeWeightedThresholdRatio() []byte {
+	if x != nil {
+		return x.StakeWeightedThresholdRatio
+	}
+	return nil
+}
+
+func (x *Params) GetDkgDealPhaseBlocks() uint64 {
+	if x != nil {
+		return x.DkgDealPhaseBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetDkgComplaintPhaseBlocks() uint64 {
+	if x != nil {
+		return x.DkgComplaintPhaseBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetDkgJustificationPhaseBlocks() uint64 {
+	if x != nil {
+		return x.DkgJustificationPhaseBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetSignedKeysharesWindow() uint64 {
+	if x != nil {
+		return x.SignedKeysharesWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinSubmittedPerWindow() []byte {
+	if x != nil {
+		return x.MinSubmittedPerWindow
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeJailBlocks() uint64 {
+	if x != nil {
+		return x.DowntimeJailBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetSlashFractionEquivocation() []byte {
+	if x != nil {
+		return x.SlashFractionEquivocation
+	}
+	return nil
+}
+
+func (x *Params) GetRewardEpochBlocks() uint64 {
+	if x != nil {
+		return x.RewardEpochBlocks
+	}
+	return 0
+}
+
 var File_fairyring_keyshare_params_proto protoreflect.FileDescriptor
 
 var file_fairyring_keyshare_params_proto_rawDesc = []byte{
@@ -867,8 +1689,8 @@ var file_fairyring_keyshare_params_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x12, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79,
 	0x73, 0x68, 0x61, 0x72, 0x65, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3,
-	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6b, 0x65, 0x79,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd,
+	0x0f, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6b, 0x65, 0x79,
 	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x15, 0xf2,
 	0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x79, 0x5f, 0x65, 0x78, 0x70,
 	0x69, 0x72, 0x79, 0x22, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12,
@@ -902,23 +1724,112 @@ var file_fairyring_keyshare_params_proto_rawDesc = []byte{
 	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x72,
 	0x6f, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x52, 0x1a, 0x73,
 	0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x72, 0x6f, 0x6e,
-	0x67, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x39, 0xe8, 0xa0, 0x1f, 0x01, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x46,
-	0x61, 0x69, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
-	0x6e, 0x67, 0x2f, 0x78, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0xb3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69,
-	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42,
-	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca, 0x02,
-	0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c,
-	0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x67, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x62, 0x0a, 0x1a, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xf2,
+	0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x22, 0x52, 0x17, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x82, 0x01,
+	0x0a, 0x25, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xf2,
+	0xde, 0x1f, 0x2c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52,
+	0x21, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x67, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x7d, 0x0a, 0x17, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x45, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x22, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x91, 0x01, 0x0a, 0x1e, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x4c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x22, 0x52, 0x1b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x53,
+	0x0a, 0x15, 0x64, 0x6b, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xf2,
+	0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x6b, 0x67, 0x5f, 0x64, 0x65, 0x61,
+	0x6c, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52,
+	0x12, 0x64, 0x6b, 0x67, 0x44, 0x65, 0x61, 0x6c, 0x50, 0x68, 0x61, 0x73, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x62, 0x0a, 0x1a, 0x64, 0x6b, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x64, 0x6b, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74,
+	0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52, 0x17,
+	0x64, 0x6b, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x6e, 0x0a, 0x1e, 0x64, 0x6b, 0x67, 0x5f, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x29, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x6b, 0x67, 0x5f, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52, 0x1b, 0x64, 0x6b, 0x67, 0x4a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x22, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x15, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x7f, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x15, 0x6d,
+	0x69, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x51, 0x0a, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x22, 0x52, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69,
+	0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x1b, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x49, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x19, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x13, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
+	0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x3a, 0x39, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x46, 0x61, 0x69, 0x72, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x78, 0x2f, 0x6b,
+	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xb3,
+	0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03,
+	0x46, 0x4b, 0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e,
+	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x13, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
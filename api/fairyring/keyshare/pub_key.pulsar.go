@@ -554,6 +554,7 @@ var (
 	fd_ActivePubKey_expiry             protoreflect.FieldDescriptor
 	fd_ActivePubKey_numberOfValidators protoreflect.FieldDescriptor
 	fd_ActivePubKey_encryptedKeyShares protoreflect.FieldDescriptor
+	fd_ActivePubKey_threshold          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -564,6 +565,7 @@ func init() {
 	fd_ActivePubKey_expiry = md_ActivePubKey.Fields().ByName("expiry")
 	fd_ActivePubKey_numberOfValidators = md_ActivePubKey.Fields().ByName("numberOfValidators")
 	fd_ActivePubKey_encryptedKeyShares = md_ActivePubKey.Fields().ByName("encryptedKeyShares")
+	fd_ActivePubKey_threshold = md_ActivePubKey.Fields().ByName("threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_ActivePubKey)(nil)
@@ -661,6 +663,12 @@ func (x *fastReflection_ActivePubKey) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Threshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Threshold)
+		if !f(fd_ActivePubKey_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -686,6 +694,8 @@ func (x *fastReflection_ActivePubKey) Has(fd protoreflect.FieldDescriptor) bool
 		return x.NumberOfValidators != uint64(0)
 	case "fairyring.keyshare.ActivePubKey.encryptedKeyShares":
 		return len(x.EncryptedKeyShares) != 0
+	case "fairyring.keyshare.ActivePubKey.threshold":
+		return x.Threshold != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ActivePubKey"))
@@ -712,6 +722,8 @@ func (x *fastReflection_ActivePubKey) Clear(fd protoreflect.FieldDescriptor) {
 		x.NumberOfValidators = uint64(0)
 	case "fairyring.keyshare.ActivePubKey.encryptedKeyShares":
 		x.EncryptedKeyShares = nil
+	case "fairyring.keyshare.ActivePubKey.threshold":
+		x.Threshold = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ActivePubKey"))
@@ -746,6 +758,9 @@ func (x *fastReflection_ActivePubKey) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_ActivePubKey_5_list{list: &x.EncryptedKeyShares}
 		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.ActivePubKey.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ActivePubKey"))
@@ -778,6 +793,8 @@ func (x *fastReflection_ActivePubKey) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_ActivePubKey_5_list)
 		x.EncryptedKeyShares = *clv.list
+	case "fairyring.keyshare.ActivePubKey.threshold":
+		x.Threshold = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ActivePubKey"))
@@ -812,6 +829,8 @@ func (x *fastReflection_ActivePubKey) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field expiry of message fairyring.keyshare.ActivePubKey is not mutable"))
 	case "fairyring.keyshare.ActivePubKey.numberOfValidators":
 		panic(fmt.Errorf("field numberOfValidators of message fairyring.keyshare.ActivePubKey is not mutable"))
+	case "fairyring.keyshare.ActivePubKey.threshold":
+		panic(fmt.Errorf("field threshold of message fairyring.keyshare.ActivePubKey is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ActivePubKey"))
@@ -836,6 +855,8 @@ func (x *fastReflection_ActivePubKey) NewField(fd protoreflect.FieldDescriptor)
 	case "fairyring.keyshare.ActivePubKey.encryptedKeyShares":
 		list := []*EncryptedKeyShare{}
 		return protoreflect.ValueOfList(&_ActivePubKey_5_list{list: &list})
+	case "fairyring.keyshare.ActivePubKey.threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.ActivePubKey"))
@@ -925,6 +946,9 @@ func (x *fastReflection_ActivePubKey) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -954,6 +978,11 @@ func (x *fastReflection_ActivePubKey) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.EncryptedKeyShares) > 0 {
 			for iNdEx := len(x.EncryptedKeyShares) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.EncryptedKeyShares[iNdEx])
@@ -1179,6 +1208,25 @@ func (x *fastReflection_ActivePubKey) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1272,6 +1320,7 @@ var (
 	fd_QueuedPubKey_expiry             protoreflect.FieldDescriptor
 	fd_QueuedPubKey_numberOfValidators protoreflect.FieldDescriptor
 	fd_QueuedPubKey_encryptedKeyShares protoreflect.FieldDescriptor
+	fd_QueuedPubKey_threshold          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1282,6 +1331,7 @@ func init() {
 	fd_QueuedPubKey_expiry = md_QueuedPubKey.Fields().ByName("expiry")
 	fd_QueuedPubKey_numberOfValidators = md_QueuedPubKey.Fields().ByName("numberOfValidators")
 	fd_QueuedPubKey_encryptedKeyShares = md_QueuedPubKey.Fields().ByName("encryptedKeyShares")
+	fd_QueuedPubKey_threshold = md_QueuedPubKey.Fields().ByName("threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueuedPubKey)(nil)
@@ -1379,6 +1429,12 @@ func (x *fastReflection_QueuedPubKey) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Threshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Threshold)
+		if !f(fd_QueuedPubKey_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1404,6 +1460,8 @@ func (x *fastReflection_QueuedPubKey) Has(fd protoreflect.FieldDescriptor) bool
 		return x.NumberOfValidators != uint64(0)
 	case "fairyring.keyshare.QueuedPubKey.encryptedKeyShares":
 		return len(x.EncryptedKeyShares) != 0
+	case "fairyring.keyshare.QueuedPubKey.threshold":
+		return x.Threshold != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.QueuedPubKey"))
@@ -1430,6 +1488,8 @@ func (x *fastReflection_QueuedPubKey) Clear(fd protoreflect.FieldDescriptor) {
 		x.NumberOfValidators = uint64(0)
 	case "fairyring.keyshare.QueuedPubKey.encryptedKeyShares":
 		x.EncryptedKeyShares = nil
+	case "fairyring.keyshare.QueuedPubKey.threshold":
+		x.Threshold = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.QueuedPubKey"))
@@ -1464,6 +1524,9 @@ func (x *fastReflection_QueuedPubKey) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_QueuedPubKey_5_list{list: &x.EncryptedKeyShares}
 		return protoreflect.ValueOfList(listValue)
+	case "fairyring.keyshare.QueuedPubKey.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.QueuedPubKey"))
@@ -1496,6 +1559,8 @@ func (x *fastReflection_QueuedPubKey) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_QueuedPubKey_5_list)
 		x.EncryptedKeyShares = *clv.list
+	case "fairyring.keyshare.QueuedPubKey.threshold":
+		x.Threshold = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.QueuedPubKey"))
@@ -1530,6 +1595,8 @@ func (x *fastReflection_QueuedPubKey) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field expiry of message fairyring.keyshare.QueuedPubKey is not mutable"))
 	case "fairyring.keyshare.QueuedPubKey.numberOfValidators":
 		panic(fmt.Errorf("field numberOfValidators of message fairyring.keyshare.QueuedPubKey is not mutable"))
+	case "fairyring.keyshare.QueuedPubKey.threshold":
+		panic(fmt.Errorf("field threshold of message fairyring.keyshare.QueuedPubKey is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.QueuedPubKey"))
@@ -1554,6 +1621,8 @@ func (x *fastReflection_QueuedPubKey) NewField(fd protoreflect.FieldDescriptor)
 	case "fairyring.keyshare.QueuedPubKey.encryptedKeyShares":
 		list := []*EncryptedKeyShare{}
 		return protoreflect.ValueOfList(&_QueuedPubKey_5_list{list: &list})
+	case "fairyring.keyshare.QueuedPubKey.threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.keyshare.QueuedPubKey"))
@@ -1643,6 +1712,9 @@ func (x *fastReflection_QueuedPubKey) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1672,6 +1744,11 @@ func (x *fastReflection_QueuedPubKey) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.EncryptedKeyShares) > 0 {
 			for iNdEx := len(x.EncryptedKeyShares) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.EncryptedKeyShares[iNdEx])
@@ -1897,6 +1974,25 @@ func (x *fastReflection_QueuedPubKey) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1998,6 +2094,8 @@ type ActivePubKey struct {
 	Expiry             uint64               `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 	NumberOfValidators uint64               `protobuf:"varint,4,opt,name=numberOfValidators,proto3" json:"numberOfValidators,omitempty"`
 	EncryptedKeyShares []*EncryptedKeyShare `protobuf:"bytes,5,rep,name=encryptedKeyShares,proto3" json:"encryptedKeyShares,omitempty"`
+	// number of keyshares required to aggregate a key
+	Threshold uint64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (x *ActivePubKey) Reset() {
@@ -2055,6 +2153,13 @@ func (x *ActivePubKey) GetEncryptedKeyShares() []*EncryptedKeyShare {
 	return nil
 }
 
+func (x *ActivePubKey) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
 type QueuedPubKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2065,6 +2170,8 @@ type QueuedPubKey struct {
 	Expiry             uint64               `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 	NumberOfValidators uint64               `protobuf:"varint,4,opt,name=numberOfValidators,proto3" json:"numberOfValidators,omitempty"`
 	EncryptedKeyShares []*EncryptedKeyShare `protobuf:"bytes,5,rep,name=encryptedKeyShares,proto3" json:"encryptedKeyShares,omitempty"`
+	// number of keyshares required to aggregate a key
+	Threshold uint64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (x *QueuedPubKey) Reset() {
@@ -2122,6 +2229,13 @@ func (x *QueuedPubKey) GetEncryptedKeyShares() []*EncryptedKeyShare {
 	return nil
 }
 
+func (x *QueuedPubKey) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
 var File_fairyring_keyshare_pub_key_proto protoreflect.FileDescriptor
 
 var file_fairyring_keyshare_pub_key_proto_rawDesc = []byte{
@@ -2132,7 +2246,7 @@ var file_fairyring_keyshare_pub_key_proto_rawDesc = []byte{
 	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
 	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xe5, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x83, 0x02,
 	0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c,
 	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
@@ -2147,33 +2261,37 @@ var file_fairyring_keyshare_pub_key_proto_rawDesc = []byte{
 	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e,
 	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72,
 	0x65, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x4f, 0x66, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x42, 0xb3, 0x01,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x0b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46,
-	0x4b, 0x58, 0xaa, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b,
-	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46,
-	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
-	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0xb3, 0x01, 0x0a, 0x16, 0x63, 0x6f,
+	0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x42, 0x0b, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f,
+	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x46, 0x4b, 0x58, 0xaa, 0x02,
+	0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0xca, 0x02, 0x12, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c,
+	0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0xe2, 0x02, 0x1e, 0x46, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x46, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,20 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                = "/fairyring.keyshare.Query/Params"
-	Query_Commitments_FullMethodName           = "/fairyring.keyshare.Query/Commitments"
-	Query_ValidatorSet_FullMethodName          = "/fairyring.keyshare.Query/ValidatorSet"
-	Query_ValidatorSetAll_FullMethodName       = "/fairyring.keyshare.Query/ValidatorSetAll"
-	Query_KeyShare_FullMethodName              = "/fairyring.keyshare.Query/KeyShare"
-	Query_KeyShareAll_FullMethodName           = "/fairyring.keyshare.Query/KeyShareAll"
-	Query_AggregatedKeyShare_FullMethodName    = "/fairyring.keyshare.Query/AggregatedKeyShare"
-	Query_AggregatedKeyShareAll_FullMethodName = "/fairyring.keyshare.Query/AggregatedKeyShareAll"
-	Query_PubKey_FullMethodName                = "/fairyring.keyshare.Query/PubKey"
-	Query_AuthorizedAddress_FullMethodName     = "/fairyring.keyshare.Query/AuthorizedAddress"
-	Query_AuthorizedAddressAll_FullMethodName  = "/fairyring.keyshare.Query/AuthorizedAddressAll"
-	Query_GeneralKeyShare_FullMethodName       = "/fairyring.keyshare.Query/GeneralKeyShare"
-	Query_GeneralKeyShareAll_FullMethodName    = "/fairyring.keyshare.Query/GeneralKeyShareAll"
-	Query_VerifiableRandomness_FullMethodName  = "/fairyring.keyshare.Query/VerifiableRandomness"
+	Query_Params_FullMethodName                 = "/fairyring.keyshare.Query/Params"
+	Query_Commitments_FullMethodName            = "/fairyring.keyshare.Query/Commitments"
+	Query_ValidatorSet_FullMethodName           = "/fairyring.keyshare.Query/ValidatorSet"
+	Query_ValidatorSetAll_FullMethodName        = "/fairyring.keyshare.Query/ValidatorSetAll"
+	Query_KeyShare_FullMethodName               = "/fairyring.keyshare.Query/KeyShare"
+	Query_KeyShareAll_FullMethodName            = "/fairyring.keyshare.Query/KeyShareAll"
+	Query_AggregatedKeyShare_FullMethodName     = "/fairyring.keyshare.Query/AggregatedKeyShare"
+	Query_AggregatedKeyShareAll_FullMethodName  = "/fairyring.keyshare.Query/AggregatedKeyShareAll"
+	Query_PubKey_FullMethodName                 = "/fairyring.keyshare.Query/PubKey"
+	Query_AuthorizedAddress_FullMethodName      = "/fairyring.keyshare.Query/AuthorizedAddress"
+	Query_AuthorizedAddressAll_FullMethodName   = "/fairyring.keyshare.Query/AuthorizedAddressAll"
+	Query_GeneralKeyShare_FullMethodName        = "/fairyring.keyshare.Query/GeneralKeyShare"
+	Query_GeneralKeyShareAll_FullMethodName     = "/fairyring.keyshare.Query/GeneralKeyShareAll"
+	Query_VerifiableRandomness_FullMethodName   = "/fairyring.keyshare.Query/VerifiableRandomness"
+	Query_OldestRetainedHeight_FullMethodName   = "/fairyring.keyshare.Query/OldestRetainedHeight"
+	Query_KeyshareParticipation_FullMethodName  = "/fairyring.keyshare.Query/KeyshareParticipation"
+	Query_DkgRound_FullMethodName               = "/fairyring.keyshare.Query/DkgRound"
+	Query_KeyshareSigningInfo_FullMethodName    = "/fairyring.keyshare.Query/KeyshareSigningInfo"
+	Query_KeyshareSigningInfoAll_FullMethodName = "/fairyring.keyshare.Query/KeyshareSigningInfoAll"
+	Query_KeyshareEvidence_FullMethodName       = "/fairyring.keyshare.Query/KeyshareEvidence"
+	Query_KeyshareEvidenceAll_FullMethodName    = "/fairyring.keyshare.Query/KeyshareEvidenceAll"
+	Query_KeyshareRewards_FullMethodName        = "/fairyring.keyshare.Query/KeyshareRewards"
+	Query_KeyshareRewardPool_FullMethodName     = "/fairyring.keyshare.Query/KeyshareRewardPool"
 )
 
 // QueryClient is the client API for Query service.
@@ -62,6 +71,24 @@ type QueryClient interface {
 	GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error)
 	GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error)
 	VerifiableRandomness(ctx context.Context, in *QueryVerifiableRandomnessQuery, opts ...grpc.CallOption) (*QueryVerifiableRandomnessResponse, error)
+	// Queries the oldest height still retained for keyshares and aggregated keyshares
+	OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the stake weighted keyshare participation for a block height or a general identity
+	KeyshareParticipation(ctx context.Context, in *QueryKeyshareParticipationRequest, opts ...grpc.CallOption) (*QueryKeyshareParticipationResponse, error)
+	// Queries a dkg round with its deals and complaints, the latest round is returned when the id is 0
+	DkgRound(ctx context.Context, in *QueryDkgRoundRequest, opts ...grpc.CallOption) (*QueryDkgRoundResponse, error)
+	// Queries the keyshare liveness of a validator
+	KeyshareSigningInfo(ctx context.Context, in *QueryKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryKeyshareSigningInfoResponse, error)
+	// Queries the keyshare liveness of all validators
+	KeyshareSigningInfoAll(ctx context.Context, in *QueryAllKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryAllKeyshareSigningInfoResponse, error)
+	// Queries the keyshare equivocation evidence of a validator for an identity
+	KeyshareEvidence(ctx context.Context, in *QueryKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryKeyshareEvidenceResponse, error)
+	// Queries a list of keyshare equivocation evidences
+	KeyshareEvidenceAll(ctx context.Context, in *QueryAllKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryAllKeyshareEvidenceResponse, error)
+	// Queries the unclaimed keyshare rewards of a validator
+	KeyshareRewards(ctx context.Context, in *QueryKeyshareRewardsRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardsResponse, error)
+	// Queries the keyshare reward pool to be paid out at the end of the epoch
+	KeyshareRewardPool(ctx context.Context, in *QueryKeyshareRewardPoolRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardPoolResponse, error)
 }
 
 type queryClient struct {
@@ -198,6 +225,87 @@ func (c *queryClient) VerifiableRandomness(ctx context.Context, in *QueryVerifia
 	return out, nil
 }
 
+func (c *queryClient) OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error) {
+	out := new(QueryOldestRetainedHeightResponse)
+	err := c.cc.Invoke(ctx, Query_OldestRetainedHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareParticipation(ctx context.Context, in *QueryKeyshareParticipationRequest, opts ...grpc.CallOption) (*QueryKeyshareParticipationResponse, error) {
+	out := new(QueryKeyshareParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareParticipation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DkgRound(ctx context.Context, in *QueryDkgRoundRequest, opts ...grpc.CallOption) (*QueryDkgRoundResponse, error) {
+	out := new(QueryDkgRoundResponse)
+	err := c.cc.Invoke(ctx, Query_DkgRound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareSigningInfo(ctx context.Context, in *QueryKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryKeyshareSigningInfoResponse, error) {
+	out := new(QueryKeyshareSigningInfoResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareSigningInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareSigningInfoAll(ctx context.Context, in *QueryAllKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryAllKeyshareSigningInfoResponse, error) {
+	out := new(QueryAllKeyshareSigningInfoResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareSigningInfoAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareEvidence(ctx context.Context, in *QueryKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryKeyshareEvidenceResponse, error) {
+	out := new(QueryKeyshareEvidenceResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareEvidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareEvidenceAll(ctx context.Context, in *QueryAllKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryAllKeyshareEvidenceResponse, error) {
+	out := new(QueryAllKeyshareEvidenceResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareEvidenceAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareRewards(ctx context.Context, in *QueryKeyshareRewardsRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardsResponse, error) {
+	out := new(QueryKeyshareRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KeyshareRewardPool(ctx context.Context, in *QueryKeyshareRewardPoolRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardPoolResponse, error) {
+	out := new(QueryKeyshareRewardPoolResponse)
+	err := c.cc.Invoke(ctx, Query_KeyshareRewardPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -225,6 +333,24 @@ type QueryServer interface {
 	GeneralKeyShare(context.Context, *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error)
 	GeneralKeyShareAll(context.Context, *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error)
 	VerifiableRandomness(context.Context, *QueryVerifiableRandomnessQuery) (*QueryVerifiableRandomnessResponse, error)
+	// Queries the oldest height still retained for keyshares and aggregated keyshares
+	OldestRetainedHeight(context.Context, *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the stake weighted keyshare participation for a block height or a general identity
+	KeyshareParticipation(context.Context, *QueryKeyshareParticipationRequest) (*QueryKeyshareParticipationResponse, error)
+	// Queries a dkg round with its deals and complaints, the latest round is returned when the id is 0
+	DkgRound(context.Context, *QueryDkgRoundRequest) (*QueryDkgRoundResponse, error)
+	// Queries the keyshare liveness of a validator
+	KeyshareSigningInfo(context.Context, *QueryKeyshareSigningInfoRequest) (*QueryKeyshareSigningInfoResponse, error)
+	// Queries the keyshare liveness of all validators
+	KeyshareSigningInfoAll(context.Context, *QueryAllKeyshareSigningInfoRequest) (*QueryAllKeyshareSigningInfoResponse, error)
+	// Queries the keyshare equivocation evidence of a validator for an identity
+	KeyshareEvidence(context.Context, *QueryKeyshareEvidenceRequest) (*QueryKeyshareEvidenceResponse, error)
+	// Queries a list of keyshare equivocation evidences
+	KeyshareEvidenceAll(context.Context, *QueryAllKeyshareEvidenceRequest) (*QueryAllKeyshareEvidenceResponse, error)
+	// Queries the unclaimed keyshare rewards of a validator
+	KeyshareRewards(context.Context, *QueryKeyshareRewardsRequest) (*QueryKeyshareRewardsResponse, error)
+	// Queries the keyshare reward pool to be paid out at the end of the epoch
+	KeyshareRewardPool(context.Context, *QueryKeyshareRewardPoolRequest) (*QueryKeyshareRewardPoolResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -274,6 +400,33 @@ func (UnimplementedQueryServer) GeneralKeyShareAll(context.Context, *QueryAllGen
 func (UnimplementedQueryServer) VerifiableRandomness(context.Context, *QueryVerifiableRandomnessQuery) (*QueryVerifiableRandomnessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifiableRandomness not implemented")
 }
+func (UnimplementedQueryServer) OldestRetainedHeight(context.Context, *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OldestRetainedHeight not implemented")
+}
+func (UnimplementedQueryServer) KeyshareParticipation(context.Context, *QueryKeyshareParticipationRequest) (*QueryKeyshareParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareParticipation not implemented")
+}
+func (UnimplementedQueryServer) DkgRound(context.Context, *QueryDkgRoundRequest) (*QueryDkgRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DkgRound not implemented")
+}
+func (UnimplementedQueryServer) KeyshareSigningInfo(context.Context, *QueryKeyshareSigningInfoRequest) (*QueryKeyshareSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareSigningInfo not implemented")
+}
+func (UnimplementedQueryServer) KeyshareSigningInfoAll(context.Context, *QueryAllKeyshareSigningInfoRequest) (*QueryAllKeyshareSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareSigningInfoAll not implemented")
+}
+func (UnimplementedQueryServer) KeyshareEvidence(context.Context, *QueryKeyshareEvidenceRequest) (*QueryKeyshareEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareEvidence not implemented")
+}
+func (UnimplementedQueryServer) KeyshareEvidenceAll(context.Context, *QueryAllKeyshareEvidenceRequest) (*QueryAllKeyshareEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareEvidenceAll not implemented")
+}
+func (UnimplementedQueryServer) KeyshareRewards(context.Context, *QueryKeyshareRewardsRequest) (*QueryKeyshareRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareRewards not implemented")
+}
+func (UnimplementedQueryServer) KeyshareRewardPool(context.Context, *QueryKeyshareRewardPoolRequest) (*QueryKeyshareRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareRewardPool not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -539,6 +692,168 @@ func _Query_VerifiableRandomness_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OldestRetainedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOldestRetainedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OldestRetainedHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, req.(*QueryOldestRetainedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareParticipation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareParticipation(ctx, req.(*QueryKeyshareParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DkgRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDkgRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DkgRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DkgRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DkgRound(ctx, req.(*QueryDkgRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareSigningInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareSigningInfo(ctx, req.(*QueryKeyshareSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareSigningInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyshareSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareSigningInfoAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareSigningInfoAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareSigningInfoAll(ctx, req.(*QueryAllKeyshareSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareEvidence(ctx, req.(*QueryKeyshareEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareEvidenceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyshareEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareEvidenceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareEvidenceAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareEvidenceAll(ctx, req.(*QueryAllKeyshareEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareRewards(ctx, req.(*QueryKeyshareRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KeyshareRewardPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareRewardPool(ctx, req.(*QueryKeyshareRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -602,6 +917,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifiableRandomness",
 			Handler:    _Query_VerifiableRandomness_Handler,
 		},
+		{
+			MethodName: "OldestRetainedHeight",
+			Handler:    _Query_OldestRetainedHeight_Handler,
+		},
+		{
+			MethodName: "KeyshareParticipation",
+			Handler:    _Query_KeyshareParticipation_Handler,
+		},
+		{
+			MethodName: "DkgRound",
+			Handler:    _Query_DkgRound_Handler,
+		},
+		{
+			MethodName: "KeyshareSigningInfo",
+			Handler:    _Query_KeyshareSigningInfo_Handler,
+		},
+		{
+			MethodName: "KeyshareSigningInfoAll",
+			Handler:    _Query_KeyshareSigningInfoAll_Handler,
+		},
+		{
+			MethodName: "KeyshareEvidence",
+			Handler:    _Query_KeyshareEvidence_Handler,
+		},
+		{
+			MethodName: "KeyshareEvidenceAll",
+			Handler:    _Query_KeyshareEvidenceAll_Handler,
+		},
+		{
+			MethodName: "KeyshareRewards",
+			Handler:    _Query_KeyshareRewards_Handler,
+		},
+		{
+			MethodName: "KeyshareRewardPool",
+			Handler:    _Query_KeyshareRewardPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fairyring/keyshare/query.proto",
@@ -30,6 +30,15 @@ const (
 	Msg_DeleteAuthorizedAddress_FullMethodName = "/fairyring.keyshare.Msg/DeleteAuthorizedAddress"
 	Msg_CreateGeneralKeyShare_FullMethodName   = "/fairyring.keyshare.Msg/CreateGeneralKeyShare"
 	Msg_SubmitEncryptedKeyshare_FullMethodName = "/fairyring.keyshare.Msg/SubmitEncryptedKeyshare"
+	Msg_StartDkg_FullMethodName                = "/fairyring.keyshare.Msg/StartDkg"
+	Msg_SubmitDkgDeal_FullMethodName           = "/fairyring.keyshare.Msg/SubmitDkgDeal"
+	Msg_SubmitDkgComplaint_FullMethodName      = "/fairyring.keyshare.Msg/SubmitDkgComplaint"
+	Msg_SubmitDkgJustification_FullMethodName  = "/fairyring.keyshare.Msg/SubmitDkgJustification"
+	Msg_StartResharing_FullMethodName          = "/fairyring.keyshare.Msg/StartResharing"
+	Msg_Unjail_FullMethodName                  = "/fairyring.keyshare.Msg/Unjail"
+	Msg_SubmitKeyshareEvidence_FullMethodName  = "/fairyring.keyshare.Msg/SubmitKeyshareEvidence"
+	Msg_ClaimKeyshareRewards_FullMethodName    = "/fairyring.keyshare.Msg/ClaimKeyshareRewards"
+	Msg_SendKeyshareBatch_FullMethodName       = "/fairyring.keyshare.Msg/SendKeyshareBatch"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +59,15 @@ type MsgClient interface {
 	DeleteAuthorizedAddress(ctx context.Context, in *MsgDeleteAuthorizedAddress, opts ...grpc.CallOption) (*MsgDeleteAuthorizedAddressResponse, error)
 	CreateGeneralKeyShare(ctx context.Context, in *MsgCreateGeneralKeyShare, opts ...grpc.CallOption) (*MsgCreateGeneralKeyShareResponse, error)
 	SubmitEncryptedKeyshare(ctx context.Context, in *MsgSubmitEncryptedKeyshare, opts ...grpc.CallOption) (*MsgSubmitEncryptedKeyshareResponse, error)
+	StartDkg(ctx context.Context, in *MsgStartDkg, opts ...grpc.CallOption) (*MsgStartDkgResponse, error)
+	SubmitDkgDeal(ctx context.Context, in *MsgSubmitDkgDeal, opts ...grpc.CallOption) (*MsgSubmitDkgDealResponse, error)
+	SubmitDkgComplaint(ctx context.Context, in *MsgSubmitDkgComplaint, opts ...grpc.CallOption) (*MsgSubmitDkgComplaintResponse, error)
+	SubmitDkgJustification(ctx context.Context, in *MsgSubmitDkgJustification, opts ...grpc.CallOption) (*MsgSubmitDkgJustificationResponse, error)
+	StartResharing(ctx context.Context, in *MsgStartResharing, opts ...grpc.CallOption) (*MsgStartResharingResponse, error)
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	SubmitKeyshareEvidence(ctx context.Context, in *MsgSubmitKeyshareEvidence, opts ...grpc.CallOption) (*MsgSubmitKeyshareEvidenceResponse, error)
+	ClaimKeyshareRewards(ctx context.Context, in *MsgClaimKeyshareRewards, opts ...grpc.CallOption) (*MsgClaimKeyshareRewardsResponse, error)
+	SendKeyshareBatch(ctx context.Context, in *MsgSendKeyshareBatch, opts ...grpc.CallOption) (*MsgSendKeyshareBatchResponse, error)
 }
 
 type msgClient struct {
@@ -159,6 +177,87 @@ func (c *msgClient) SubmitEncryptedKeyshare(ctx context.Context, in *MsgSubmitEn
 	return out, nil
 }
 
+func (c *msgClient) StartDkg(ctx context.Context, in *MsgStartDkg, opts ...grpc.CallOption) (*MsgStartDkgResponse, error) {
+	out := new(MsgStartDkgResponse)
+	err := c.cc.Invoke(ctx, Msg_StartDkg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDkgDeal(ctx context.Context, in *MsgSubmitDkgDeal, opts ...grpc.CallOption) (*MsgSubmitDkgDealResponse, error) {
+	out := new(MsgSubmitDkgDealResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitDkgDeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDkgComplaint(ctx context.Context, in *MsgSubmitDkgComplaint, opts ...grpc.CallOption) (*MsgSubmitDkgComplaintResponse, error) {
+	out := new(MsgSubmitDkgComplaintResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitDkgComplaint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDkgJustification(ctx context.Context, in *MsgSubmitDkgJustification, opts ...grpc.CallOption) (*MsgSubmitDkgJustificationResponse, error) {
+	out := new(MsgSubmitDkgJustificationResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitDkgJustification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartResharing(ctx context.Context, in *MsgStartResharing, opts ...grpc.CallOption) (*MsgStartResharingResponse, error) {
+	out := new(MsgStartResharingResponse)
+	err := c.cc.Invoke(ctx, Msg_StartResharing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, Msg_Unjail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitKeyshareEvidence(ctx context.Context, in *MsgSubmitKeyshareEvidence, opts ...grpc.CallOption) (*MsgSubmitKeyshareEvidenceResponse, error) {
+	out := new(MsgSubmitKeyshareEvidenceResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitKeyshareEvidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimKeyshareRewards(ctx context.Context, in *MsgClaimKeyshareRewards, opts ...grpc.CallOption) (*MsgClaimKeyshareRewardsResponse, error) {
+	out := new(MsgClaimKeyshareRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimKeyshareRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendKeyshareBatch(ctx context.Context, in *MsgSendKeyshareBatch, opts ...grpc.CallOption) (*MsgSendKeyshareBatchResponse, error) {
+	out := new(MsgSendKeyshareBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_SendKeyshareBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -177,6 +276,15 @@ type MsgServer interface {
 	DeleteAuthorizedAddress(context.Context, *MsgDeleteAuthorizedAddress) (*MsgDeleteAuthorizedAddressResponse, error)
 	CreateGeneralKeyShare(context.Context, *MsgCreateGeneralKeyShare) (*MsgCreateGeneralKeyShareResponse, error)
 	SubmitEncryptedKeyshare(context.Context, *MsgSubmitEncryptedKeyshare) (*MsgSubmitEncryptedKeyshareResponse, error)
+	StartDkg(context.Context, *MsgStartDkg) (*MsgStartDkgResponse, error)
+	SubmitDkgDeal(context.Context, *MsgSubmitDkgDeal) (*MsgSubmitDkgDealResponse, error)
+	SubmitDkgComplaint(context.Context, *MsgSubmitDkgComplaint) (*MsgSubmitDkgComplaintResponse, error)
+	SubmitDkgJustification(context.Context, *MsgSubmitDkgJustification) (*MsgSubmitDkgJustificationResponse, error)
+	StartResharing(context.Context, *MsgStartResharing) (*MsgStartResharingResponse, error)
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	SubmitKeyshareEvidence(context.Context, *MsgSubmitKeyshareEvidence) (*MsgSubmitKeyshareEvidenceResponse, error)
+	ClaimKeyshareRewards(context.Context, *MsgClaimKeyshareRewards) (*MsgClaimKeyshareRewardsResponse, error)
+	SendKeyshareBatch(context.Context, *MsgSendKeyshareBatch) (*MsgSendKeyshareBatchResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -217,6 +325,33 @@ func (UnimplementedMsgServer) CreateGeneralKeyShare(context.Context, *MsgCreateG
 func (UnimplementedMsgServer) SubmitEncryptedKeyshare(context.Context, *MsgSubmitEncryptedKeyshare) (*MsgSubmitEncryptedKeyshareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEncryptedKeyshare not implemented")
 }
+func (UnimplementedMsgServer) StartDkg(context.Context, *MsgStartDkg) (*MsgStartDkgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDkg not implemented")
+}
+func (UnimplementedMsgServer) SubmitDkgDeal(context.Context, *MsgSubmitDkgDeal) (*MsgSubmitDkgDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDkgDeal not implemented")
+}
+func (UnimplementedMsgServer) SubmitDkgComplaint(context.Context, *MsgSubmitDkgComplaint) (*MsgSubmitDkgComplaintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDkgComplaint not implemented")
+}
+func (UnimplementedMsgServer) SubmitDkgJustification(context.Context, *MsgSubmitDkgJustification) (*MsgSubmitDkgJustificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDkgJustification not implemented")
+}
+func (UnimplementedMsgServer) StartResharing(context.Context, *MsgStartResharing) (*MsgStartResharingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartResharing not implemented")
+}
+func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
+func (UnimplementedMsgServer) SubmitKeyshareEvidence(context.Context, *MsgSubmitKeyshareEvidence) (*MsgSubmitKeyshareEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitKeyshareEvidence not implemented")
+}
+func (UnimplementedMsgServer) ClaimKeyshareRewards(context.Context, *MsgClaimKeyshareRewards) (*MsgClaimKeyshareRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimKeyshareRewards not implemented")
+}
+func (UnimplementedMsgServer) SendKeyshareBatch(context.Context, *MsgSendKeyshareBatch) (*MsgSendKeyshareBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendKeyshareBatch not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -428,6 +563,168 @@ func _Msg_SubmitEncryptedKeyshare_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StartDkg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartDkg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartDkg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StartDkg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartDkg(ctx, req.(*MsgStartDkg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDkgDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDkgDeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDkgDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitDkgDeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDkgDeal(ctx, req.(*MsgSubmitDkgDeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDkgComplaint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDkgComplaint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDkgComplaint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitDkgComplaint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDkgComplaint(ctx, req.(*MsgSubmitDkgComplaint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDkgJustification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDkgJustification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDkgJustification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitDkgJustification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDkgJustification(ctx, req.(*MsgSubmitDkgJustification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartResharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartResharing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartResharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StartResharing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartResharing(ctx, req.(*MsgStartResharing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitKeyshareEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitKeyshareEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitKeyshareEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitKeyshareEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitKeyshareEvidence(ctx, req.(*MsgSubmitKeyshareEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimKeyshareRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimKeyshareRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimKeyshareRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimKeyshareRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimKeyshareRewards(ctx, req.(*MsgClaimKeyshareRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendKeyshareBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendKeyshareBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendKeyshareBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendKeyshareBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendKeyshareBatch(ctx, req.(*MsgSendKeyshareBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -479,6 +776,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEncryptedKeyshare",
 			Handler:    _Msg_SubmitEncryptedKeyshare_Handler,
 		},
+		{
+			MethodName: "StartDkg",
+			Handler:    _Msg_StartDkg_Handler,
+		},
+		{
+			MethodName: "SubmitDkgDeal",
+			Handler:    _Msg_SubmitDkgDeal_Handler,
+		},
+		{
+			MethodName: "SubmitDkgComplaint",
+			Handler:    _Msg_SubmitDkgComplaint_Handler,
+		},
+		{
+			MethodName: "SubmitDkgJustification",
+			Handler:    _Msg_SubmitDkgJustification_Handler,
+		},
+		{
+			MethodName: "StartResharing",
+			Handler:    _Msg_StartResharing_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
+		{
+			MethodName: "SubmitKeyshareEvidence",
+			Handler:    _Msg_SubmitKeyshareEvidence_Handler,
+		},
+		{
+			MethodName: "ClaimKeyshareRewards",
+			Handler:    _Msg_ClaimKeyshareRewards_Handler,
+		},
+		{
+			MethodName: "SendKeyshareBatch",
+			Handler:    _Msg_SendKeyshareBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fairyring/keyshare/tx.proto",
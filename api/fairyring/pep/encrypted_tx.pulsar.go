@@ -23,6 +23,8 @@ var (
 	fd_EncryptedTx_chargedGas             protoreflect.FieldDescriptor
 	fd_EncryptedTx_processedAtChainHeight protoreflect.FieldDescriptor
 	fd_EncryptedTx_expired                protoreflect.FieldDescriptor
+	fd_EncryptedTx_cancelled              protoreflect.FieldDescriptor
+	fd_EncryptedTx_refunded               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +37,8 @@ func init() {
 	fd_EncryptedTx_chargedGas = md_EncryptedTx.Fields().ByName("chargedGas")
 	fd_EncryptedTx_processedAtChainHeight = md_EncryptedTx.Fields().ByName("processedAtChainHeight")
 	fd_EncryptedTx_expired = md_EncryptedTx.Fields().ByName("expired")
+	fd_EncryptedTx_cancelled = md_EncryptedTx.Fields().ByName("cancelled")
+	fd_EncryptedTx_refunded = md_EncryptedTx.Fields().ByName("refunded")
 }
 
 var _ protoreflect.Message = (*fastReflection_EncryptedTx)(nil)
@@ -144,6 +148,18 @@ func (x *fastReflection_EncryptedTx) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Cancelled != false {
+		value := protoreflect.ValueOfBool(x.Cancelled)
+		if !f(fd_EncryptedTx_cancelled, value) {
+			return
+		}
+	}
+	if x.Refunded != nil {
+		value := protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
+		if !f(fd_EncryptedTx_refunded, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +189,10 @@ func (x *fastReflection_EncryptedTx) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ProcessedAtChainHeight != uint64(0)
 	case "fairyring.pep.EncryptedTx.expired":
 		return x.Expired != false
+	case "fairyring.pep.EncryptedTx.cancelled":
+		return x.Cancelled != false
+	case "fairyring.pep.EncryptedTx.refunded":
+		return x.Refunded != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTx"))
@@ -203,6 +223,10 @@ func (x *fastReflection_EncryptedTx) Clear(fd protoreflect.FieldDescriptor) {
 		x.ProcessedAtChainHeight = uint64(0)
 	case "fairyring.pep.EncryptedTx.expired":
 		x.Expired = false
+	case "fairyring.pep.EncryptedTx.cancelled":
+		x.Cancelled = false
+	case "fairyring.pep.EncryptedTx.refunded":
+		x.Refunded = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTx"))
@@ -240,6 +264,12 @@ func (x *fastReflection_EncryptedTx) Get(descriptor protoreflect.FieldDescriptor
 	case "fairyring.pep.EncryptedTx.expired":
 		value := x.Expired
 		return protoreflect.ValueOfBool(value)
+	case "fairyring.pep.EncryptedTx.cancelled":
+		value := x.Cancelled
+		return protoreflect.ValueOfBool(value)
+	case "fairyring.pep.EncryptedTx.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTx"))
@@ -274,6 +304,10 @@ func (x *fastReflection_EncryptedTx) Set(fd protoreflect.FieldDescriptor, value
 		x.ProcessedAtChainHeight = value.Uint()
 	case "fairyring.pep.EncryptedTx.expired":
 		x.Expired = value.Bool()
+	case "fairyring.pep.EncryptedTx.cancelled":
+		x.Cancelled = value.Bool()
+	case "fairyring.pep.EncryptedTx.refunded":
+		x.Refunded = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTx"))
@@ -299,6 +333,11 @@ func (x *fastReflection_EncryptedTx) Mutable(fd protoreflect.FieldDescriptor) pr
 			x.ChargedGas = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.ChargedGas.ProtoReflect())
+	case "fairyring.pep.EncryptedTx.refunded":
+		if x.Refunded == nil {
+			x.Refunded = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
 	case "fairyring.pep.EncryptedTx.targetHeight":
 		panic(fmt.Errorf("field targetHeight of message fairyring.pep.EncryptedTx is not mutable"))
 	case "fairyring.pep.EncryptedTx.index":
@@ -311,6 +350,8 @@ func (x *fastReflection_EncryptedTx) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field processedAtChainHeight of message fairyring.pep.EncryptedTx is not mutable"))
 	case "fairyring.pep.EncryptedTx.expired":
 		panic(fmt.Errorf("field expired of message fairyring.pep.EncryptedTx is not mutable"))
+	case "fairyring.pep.EncryptedTx.cancelled":
+		panic(fmt.Errorf("field cancelled of message fairyring.pep.EncryptedTx is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTx"))
@@ -339,6 +380,11 @@ func (x *fastReflection_EncryptedTx) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "fairyring.pep.EncryptedTx.expired":
 		return protoreflect.ValueOfBool(false)
+	case "fairyring.pep.EncryptedTx.cancelled":
+		return protoreflect.ValueOfBool(false)
+	case "fairyring.pep.EncryptedTx.refunded":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTx"))
@@ -432,6 +478,13 @@ func (x *fastReflection_EncryptedTx) ProtoMethods() *protoiface.Methods {
 		if x.Expired {
 			n += 2
 		}
+		if x.Cancelled {
+			n += 2
+		}
+		if x.Refunded != nil {
+			l = options.Size(x.Refunded)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -461,6 +514,30 @@ func (x *fastReflection_EncryptedTx) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Refunded != nil {
+			encoded, err := options.Marshal(x.Refunded)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Cancelled {
+			i--
+			if x.Cancelled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.Expired {
 			i--
 			if x.Expired {
@@ -740,6 +817,62 @@ func (x *fastReflection_EncryptedTx) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Expired = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Cancelled = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Refunded == nil {
+					x.Refunded = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refunded); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1276,6 +1409,8 @@ var (
 	fd_GeneralEncryptedTx_data       protoreflect.FieldDescriptor
 	fd_GeneralEncryptedTx_creator    protoreflect.FieldDescriptor
 	fd_GeneralEncryptedTx_chargedGas protoreflect.FieldDescriptor
+	fd_GeneralEncryptedTx_cancelled  protoreflect.FieldDescriptor
+	fd_GeneralEncryptedTx_refunded   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1286,6 +1421,8 @@ func init() {
 	fd_GeneralEncryptedTx_data = md_GeneralEncryptedTx.Fields().ByName("data")
 	fd_GeneralEncryptedTx_creator = md_GeneralEncryptedTx.Fields().ByName("creator")
 	fd_GeneralEncryptedTx_chargedGas = md_GeneralEncryptedTx.Fields().ByName("chargedGas")
+	fd_GeneralEncryptedTx_cancelled = md_GeneralEncryptedTx.Fields().ByName("cancelled")
+	fd_GeneralEncryptedTx_refunded = md_GeneralEncryptedTx.Fields().ByName("refunded")
 }
 
 var _ protoreflect.Message = (*fastReflection_GeneralEncryptedTx)(nil)
@@ -1383,6 +1520,18 @@ func (x *fastReflection_GeneralEncryptedTx) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.Cancelled != false {
+		value := protoreflect.ValueOfBool(x.Cancelled)
+		if !f(fd_GeneralEncryptedTx_cancelled, value) {
+			return
+		}
+	}
+	if x.Refunded != nil {
+		value := protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
+		if !f(fd_GeneralEncryptedTx_refunded, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1408,6 +1557,10 @@ func (x *fastReflection_GeneralEncryptedTx) Has(fd protoreflect.FieldDescriptor)
 		return x.Creator != ""
 	case "fairyring.pep.GeneralEncryptedTx.chargedGas":
 		return x.ChargedGas != nil
+	case "fairyring.pep.GeneralEncryptedTx.cancelled":
+		return x.Cancelled != false
+	case "fairyring.pep.GeneralEncryptedTx.refunded":
+		return x.Refunded != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GeneralEncryptedTx"))
@@ -1434,6 +1587,10 @@ func (x *fastReflection_GeneralEncryptedTx) Clear(fd protoreflect.FieldDescripto
 		x.Creator = ""
 	case "fairyring.pep.GeneralEncryptedTx.chargedGas":
 		x.ChargedGas = nil
+	case "fairyring.pep.GeneralEncryptedTx.cancelled":
+		x.Cancelled = false
+	case "fairyring.pep.GeneralEncryptedTx.refunded":
+		x.Refunded = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GeneralEncryptedTx"))
@@ -1465,6 +1622,12 @@ func (x *fastReflection_GeneralEncryptedTx) Get(descriptor protoreflect.FieldDes
 	case "fairyring.pep.GeneralEncryptedTx.chargedGas":
 		value := x.ChargedGas
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.GeneralEncryptedTx.cancelled":
+		value := x.Cancelled
+		return protoreflect.ValueOfBool(value)
+	case "fairyring.pep.GeneralEncryptedTx.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GeneralEncryptedTx"))
@@ -1495,6 +1658,10 @@ func (x *fastReflection_GeneralEncryptedTx) Set(fd protoreflect.FieldDescriptor,
 		x.Creator = value.Interface().(string)
 	case "fairyring.pep.GeneralEncryptedTx.chargedGas":
 		x.ChargedGas = value.Message().Interface().(*v1beta1.Coin)
+	case "fairyring.pep.GeneralEncryptedTx.cancelled":
+		x.Cancelled = value.Bool()
+	case "fairyring.pep.GeneralEncryptedTx.refunded":
+		x.Refunded = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GeneralEncryptedTx"))
@@ -1520,6 +1687,11 @@ func (x *fastReflection_GeneralEncryptedTx) Mutable(fd protoreflect.FieldDescrip
 			x.ChargedGas = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.ChargedGas.ProtoReflect())
+	case "fairyring.pep.GeneralEncryptedTx.refunded":
+		if x.Refunded == nil {
+			x.Refunded = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
 	case "fairyring.pep.GeneralEncryptedTx.identity":
 		panic(fmt.Errorf("field identity of message fairyring.pep.GeneralEncryptedTx is not mutable"))
 	case "fairyring.pep.GeneralEncryptedTx.index":
@@ -1528,6 +1700,8 @@ func (x *fastReflection_GeneralEncryptedTx) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field data of message fairyring.pep.GeneralEncryptedTx is not mutable"))
 	case "fairyring.pep.GeneralEncryptedTx.creator":
 		panic(fmt.Errorf("field creator of message fairyring.pep.GeneralEncryptedTx is not mutable"))
+	case "fairyring.pep.GeneralEncryptedTx.cancelled":
+		panic(fmt.Errorf("field cancelled of message fairyring.pep.GeneralEncryptedTx is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GeneralEncryptedTx"))
@@ -1552,6 +1726,11 @@ func (x *fastReflection_GeneralEncryptedTx) NewField(fd protoreflect.FieldDescri
 	case "fairyring.pep.GeneralEncryptedTx.chargedGas":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.GeneralEncryptedTx.cancelled":
+		return protoreflect.ValueOfBool(false)
+	case "fairyring.pep.GeneralEncryptedTx.refunded":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GeneralEncryptedTx"))
@@ -1640,6 +1819,13 @@ func (x *fastReflection_GeneralEncryptedTx) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.ChargedGas)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Cancelled {
+			n += 2
+		}
+		if x.Refunded != nil {
+			l = options.Size(x.Refunded)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1669,6 +1855,30 @@ func (x *fastReflection_GeneralEncryptedTx) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Refunded != nil {
+			encoded, err := options.Marshal(x.Refunded)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Cancelled {
+			i--
+			if x.Cancelled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.ChargedGas != nil {
 			encoded, err := options.Marshal(x.ChargedGas)
 			if err != nil {
@@ -1909,6 +2119,62 @@ func (x *fastReflection_GeneralEncryptedTx) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Cancelled = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Refunded == nil {
+					x.Refunded = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refunded); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2446,6 +2712,8 @@ var (
 	fd_GenEncTxExecutionQueue_pubkey        protoreflect.FieldDescriptor
 	fd_GenEncTxExecutionQueue_tx_list       protoreflect.FieldDescriptor
 	fd_GenEncTxExecutionQueue_aggr_keyshare protoreflect.FieldDescriptor
+	fd_GenEncTxExecutionQueue_expiry        protoreflect.FieldDescriptor
+	fd_GenEncTxExecutionQueue_expired       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2457,6 +2725,8 @@ func init() {
 	fd_GenEncTxExecutionQueue_pubkey = md_GenEncTxExecutionQueue.Fields().ByName("pubkey")
 	fd_GenEncTxExecutionQueue_tx_list = md_GenEncTxExecutionQueue.Fields().ByName("tx_list")
 	fd_GenEncTxExecutionQueue_aggr_keyshare = md_GenEncTxExecutionQueue.Fields().ByName("aggr_keyshare")
+	fd_GenEncTxExecutionQueue_expiry = md_GenEncTxExecutionQueue.Fields().ByName("expiry")
+	fd_GenEncTxExecutionQueue_expired = md_GenEncTxExecutionQueue.Fields().ByName("expired")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenEncTxExecutionQueue)(nil)
@@ -2560,6 +2830,18 @@ func (x *fastReflection_GenEncTxExecutionQueue) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.Expiry != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Expiry)
+		if !f(fd_GenEncTxExecutionQueue_expiry, value) {
+			return
+		}
+	}
+	if x.Expired != false {
+		value := protoreflect.ValueOfBool(x.Expired)
+		if !f(fd_GenEncTxExecutionQueue_expired, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2587,6 +2869,10 @@ func (x *fastReflection_GenEncTxExecutionQueue) Has(fd protoreflect.FieldDescrip
 		return x.TxList != nil
 	case "fairyring.pep.GenEncTxExecutionQueue.aggr_keyshare":
 		return x.AggrKeyshare != ""
+	case "fairyring.pep.GenEncTxExecutionQueue.expiry":
+		return x.Expiry != uint64(0)
+	case "fairyring.pep.GenEncTxExecutionQueue.expired":
+		return x.Expired != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenEncTxExecutionQueue"))
@@ -2615,6 +2901,10 @@ func (x *fastReflection_GenEncTxExecutionQueue) Clear(fd protoreflect.FieldDescr
 		x.TxList = nil
 	case "fairyring.pep.GenEncTxExecutionQueue.aggr_keyshare":
 		x.AggrKeyshare = ""
+	case "fairyring.pep.GenEncTxExecutionQueue.expiry":
+		x.Expiry = uint64(0)
+	case "fairyring.pep.GenEncTxExecutionQueue.expired":
+		x.Expired = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenEncTxExecutionQueue"))
@@ -2649,6 +2939,12 @@ func (x *fastReflection_GenEncTxExecutionQueue) Get(descriptor protoreflect.Fiel
 	case "fairyring.pep.GenEncTxExecutionQueue.aggr_keyshare":
 		value := x.AggrKeyshare
 		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.GenEncTxExecutionQueue.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.GenEncTxExecutionQueue.expired":
+		value := x.Expired
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenEncTxExecutionQueue"))
@@ -2681,6 +2977,10 @@ func (x *fastReflection_GenEncTxExecutionQueue) Set(fd protoreflect.FieldDescrip
 		x.TxList = value.Message().Interface().(*GeneralEncryptedTxArray)
 	case "fairyring.pep.GenEncTxExecutionQueue.aggr_keyshare":
 		x.AggrKeyshare = value.Interface().(string)
+	case "fairyring.pep.GenEncTxExecutionQueue.expiry":
+		x.Expiry = value.Uint()
+	case "fairyring.pep.GenEncTxExecutionQueue.expired":
+		x.Expired = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenEncTxExecutionQueue"))
@@ -2716,6 +3016,10 @@ func (x *fastReflection_GenEncTxExecutionQueue) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field pubkey of message fairyring.pep.GenEncTxExecutionQueue is not mutable"))
 	case "fairyring.pep.GenEncTxExecutionQueue.aggr_keyshare":
 		panic(fmt.Errorf("field aggr_keyshare of message fairyring.pep.GenEncTxExecutionQueue is not mutable"))
+	case "fairyring.pep.GenEncTxExecutionQueue.expiry":
+		panic(fmt.Errorf("field expiry of message fairyring.pep.GenEncTxExecutionQueue is not mutable"))
+	case "fairyring.pep.GenEncTxExecutionQueue.expired":
+		panic(fmt.Errorf("field expired of message fairyring.pep.GenEncTxExecutionQueue is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenEncTxExecutionQueue"))
@@ -2742,6 +3046,10 @@ func (x *fastReflection_GenEncTxExecutionQueue) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "fairyring.pep.GenEncTxExecutionQueue.aggr_keyshare":
 		return protoreflect.ValueOfString("")
+	case "fairyring.pep.GenEncTxExecutionQueue.expiry":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.GenEncTxExecutionQueue.expired":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenEncTxExecutionQueue"))
@@ -2835,6 +3143,12 @@ func (x *fastReflection_GenEncTxExecutionQueue) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		if x.Expired {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2864,6 +3178,21 @@ func (x *fastReflection_GenEncTxExecutionQueue) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Expired {
+			i--
+			if x.Expired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.AggrKeyshare) > 0 {
 			i -= len(x.AggrKeyshare)
 			copy(dAtA[i:], x.AggrKeyshare)
@@ -3158,6 +3487,45 @@ func (x *fastReflection_GenEncTxExecutionQueue) ProtoMethods() *protoiface.Metho
 				}
 				x.AggrKeyshare = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expired = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3193,314 +3561,4779 @@ func (x *fastReflection_GenEncTxExecutionQueue) ProtoMethods() *protoiface.Metho
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: fairyring/pep/encrypted_tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EncryptedTxQueueEntry               protoreflect.MessageDescriptor
+	fd_EncryptedTxQueueEntry_sequence      protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_identity      protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_targetHeight  protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_index         protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_data          protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_creator       protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_chargedGas    protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_pubkey        protoreflect.FieldDescriptor
+	fd_EncryptedTxQueueEntry_aggr_keyshare protoreflect.FieldDescriptor
 )
 
-type EncryptedTx struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_fairyring_pep_encrypted_tx_proto_init()
+	md_EncryptedTxQueueEntry = File_fairyring_pep_encrypted_tx_proto.Messages().ByName("EncryptedTxQueueEntry")
+	fd_EncryptedTxQueueEntry_sequence = md_EncryptedTxQueueEntry.Fields().ByName("sequence")
+	fd_EncryptedTxQueueEntry_identity = md_EncryptedTxQueueEntry.Fields().ByName("identity")
+	fd_EncryptedTxQueueEntry_targetHeight = md_EncryptedTxQueueEntry.Fields().ByName("targetHeight")
+	fd_EncryptedTxQueueEntry_index = md_EncryptedTxQueueEntry.Fields().ByName("index")
+	fd_EncryptedTxQueueEntry_data = md_EncryptedTxQueueEntry.Fields().ByName("data")
+	fd_EncryptedTxQueueEntry_creator = md_EncryptedTxQueueEntry.Fields().ByName("creator")
+	fd_EncryptedTxQueueEntry_chargedGas = md_EncryptedTxQueueEntry.Fields().ByName("chargedGas")
+	fd_EncryptedTxQueueEntry_pubkey = md_EncryptedTxQueueEntry.Fields().ByName("pubkey")
+	fd_EncryptedTxQueueEntry_aggr_keyshare = md_EncryptedTxQueueEntry.Fields().ByName("aggr_keyshare")
+}
 
-	TargetHeight           uint64        `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
-	Index                  uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Data                   string        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+var _ protoreflect.Message = (*fastReflection_EncryptedTxQueueEntry)(nil)
+
+type fastReflection_EncryptedTxQueueEntry EncryptedTxQueueEntry
+
+func (x *EncryptedTxQueueEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EncryptedTxQueueEntry)(x)
+}
+
+func (x *EncryptedTxQueueEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EncryptedTxQueueEntry_messageType fastReflection_EncryptedTxQueueEntry_messageType
+var _ protoreflect.MessageType = fastReflection_EncryptedTxQueueEntry_messageType{}
+
+type fastReflection_EncryptedTxQueueEntry_messageType struct{}
+
+func (x fastReflection_EncryptedTxQueueEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EncryptedTxQueueEntry)(nil)
+}
+func (x fastReflection_EncryptedTxQueueEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxQueueEntry)
+}
+func (x fastReflection_EncryptedTxQueueEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxQueueEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EncryptedTxQueueEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxQueueEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EncryptedTxQueueEntry) Type() protoreflect.MessageType {
+	return _fastReflection_EncryptedTxQueueEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EncryptedTxQueueEntry) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxQueueEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EncryptedTxQueueEntry) Interface() protoreflect.ProtoMessage {
+	return (*EncryptedTxQueueEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EncryptedTxQueueEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_EncryptedTxQueueEntry_sequence, value) {
+			return
+		}
+	}
+	if x.Identity != "" {
+		value := protoreflect.ValueOfString(x.Identity)
+		if !f(fd_EncryptedTxQueueEntry_identity, value) {
+			return
+		}
+	}
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_EncryptedTxQueueEntry_targetHeight, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_EncryptedTxQueueEntry_index, value) {
+			return
+		}
+	}
+	if x.Data != "" {
+		value := protoreflect.ValueOfString(x.Data)
+		if !f(fd_EncryptedTxQueueEntry_data, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EncryptedTxQueueEntry_creator, value) {
+			return
+		}
+	}
+	if x.ChargedGas != nil {
+		value := protoreflect.ValueOfMessage(x.ChargedGas.ProtoReflect())
+		if !f(fd_EncryptedTxQueueEntry_chargedGas, value) {
+			return
+		}
+	}
+	if x.Pubkey != "" {
+		value := protoreflect.ValueOfString(x.Pubkey)
+		if !f(fd_EncryptedTxQueueEntry_pubkey, value) {
+			return
+		}
+	}
+	if x.AggrKeyshare != "" {
+		value := protoreflect.ValueOfString(x.AggrKeyshare)
+		if !f(fd_EncryptedTxQueueEntry_aggr_keyshare, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EncryptedTxQueueEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxQueueEntry.sequence":
+		return x.Sequence != uint64(0)
+	case "fairyring.pep.EncryptedTxQueueEntry.identity":
+		return x.Identity != ""
+	case "fairyring.pep.EncryptedTxQueueEntry.targetHeight":
+		return x.TargetHeight != uint64(0)
+	case "fairyring.pep.EncryptedTxQueueEntry.index":
+		return x.Index != uint64(0)
+	case "fairyring.pep.EncryptedTxQueueEntry.data":
+		return x.Data != ""
+	case "fairyring.pep.EncryptedTxQueueEntry.creator":
+		return x.Creator != ""
+	case "fairyring.pep.EncryptedTxQueueEntry.chargedGas":
+		return x.ChargedGas != nil
+	case "fairyring.pep.EncryptedTxQueueEntry.pubkey":
+		return x.Pubkey != ""
+	case "fairyring.pep.EncryptedTxQueueEntry.aggr_keyshare":
+		return x.AggrKeyshare != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxQueueEntry"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxQueueEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxQueueEntry.sequence":
+		x.Sequence = uint64(0)
+	case "fairyring.pep.EncryptedTxQueueEntry.identity":
+		x.Identity = ""
+	case "fairyring.pep.EncryptedTxQueueEntry.targetHeight":
+		x.TargetHeight = uint64(0)
+	case "fairyring.pep.EncryptedTxQueueEntry.index":
+		x.Index = uint64(0)
+	case "fairyring.pep.EncryptedTxQueueEntry.data":
+		x.Data = ""
+	case "fairyring.pep.EncryptedTxQueueEntry.creator":
+		x.Creator = ""
+	case "fairyring.pep.EncryptedTxQueueEntry.chargedGas":
+		x.ChargedGas = nil
+	case "fairyring.pep.EncryptedTxQueueEntry.pubkey":
+		x.Pubkey = ""
+	case "fairyring.pep.EncryptedTxQueueEntry.aggr_keyshare":
+		x.AggrKeyshare = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxQueueEntry"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EncryptedTxQueueEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.EncryptedTxQueueEntry.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.identity":
+		value := x.Identity
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.data":
+		value := x.Data
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.chargedGas":
+		value := x.ChargedGas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.EncryptedTxQueueEntry.pubkey":
+		value := x.Pubkey
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxQueueEntry.aggr_keyshare":
+		value := x.AggrKeyshare
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxQueueEntry"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxQueueEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxQueueEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxQueueEntry.sequence":
+		x.Sequence = value.Uint()
+	case "fairyring.pep.EncryptedTxQueueEntry.identity":
+		x.Identity = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxQueueEntry.targetHeight":
+		x.TargetHeight = value.Uint()
+	case "fairyring.pep.EncryptedTxQueueEntry.index":
+		x.Index = value.Uint()
+	case "fairyring.pep.EncryptedTxQueueEntry.data":
+		x.Data = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxQueueEntry.creator":
+		x.Creator = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxQueueEntry.chargedGas":
+		x.ChargedGas = value.Message().Interface().(*v1beta1.Coin)
+	case "fairyring.pep.EncryptedTxQueueEntry.pubkey":
+		x.Pubkey = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxQueueEntry.aggr_keyshare":
+		x.AggrKeyshare = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxQueueEntry"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxQueueEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxQueueEntry.chargedGas":
+		if x.ChargedGas == nil {
+			x.ChargedGas = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ChargedGas.ProtoReflect())
+	case "fairyring.pep.EncryptedTxQueueEntry.sequence":
+		panic(fmt.Errorf("field sequence of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.identity":
+		panic(fmt.Errorf("field identity of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.index":
+		panic(fmt.Errorf("field index of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.data":
+		panic(fmt.Errorf("field data of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.creator":
+		panic(fmt.Errorf("field creator of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.pubkey":
+		panic(fmt.Errorf("field pubkey of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	case "fairyring.pep.EncryptedTxQueueEntry.aggr_keyshare":
+		panic(fmt.Errorf("field aggr_keyshare of message fairyring.pep.EncryptedTxQueueEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxQueueEntry"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EncryptedTxQueueEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxQueueEntry.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxQueueEntry.identity":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxQueueEntry.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxQueueEntry.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxQueueEntry.data":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxQueueEntry.creator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxQueueEntry.chargedGas":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.EncryptedTxQueueEntry.pubkey":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxQueueEntry.aggr_keyshare":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxQueueEntry"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxQueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EncryptedTxQueueEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.EncryptedTxQueueEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EncryptedTxQueueEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxQueueEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EncryptedTxQueueEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EncryptedTxQueueEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EncryptedTxQueueEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Identity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChargedGas != nil {
+			l = options.Size(x.ChargedGas)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Pubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AggrKeyshare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxQueueEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AggrKeyshare) > 0 {
+			i -= len(x.AggrKeyshare)
+			copy(dAtA[i:], x.AggrKeyshare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AggrKeyshare)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Pubkey) > 0 {
+			i -= len(x.Pubkey)
+			copy(dAtA[i:], x.Pubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pubkey)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ChargedGas != nil {
+			encoded, err := options.Marshal(x.ChargedGas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Identity) > 0 {
+			i -= len(x.Identity)
+			copy(dAtA[i:], x.Identity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identity)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxQueueEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxQueueEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ChargedGas == nil {
+					x.ChargedGas = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChargedGas); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EncryptedTxExecutionResult_10_list)(nil)
+
+type _EncryptedTxExecutionResult_10_list struct {
+	list *[]string
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EncryptedTxExecutionResult at list field MsgTypes as it is not of Message kind"))
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EncryptedTxExecutionResult_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EncryptedTxExecutionResult                       protoreflect.MessageDescriptor
+	fd_EncryptedTxExecutionResult_identity              protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_targetHeight          protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_index                 protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_creator               protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_status                protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_failReason            protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_gasUsed               protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_feeCharged            protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_feeRefunded           protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_msgTypes              protoreflect.FieldDescriptor
+	fd_EncryptedTxExecutionResult_executedAtChainHeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_encrypted_tx_proto_init()
+	md_EncryptedTxExecutionResult = File_fairyring_pep_encrypted_tx_proto.Messages().ByName("EncryptedTxExecutionResult")
+	fd_EncryptedTxExecutionResult_identity = md_EncryptedTxExecutionResult.Fields().ByName("identity")
+	fd_EncryptedTxExecutionResult_targetHeight = md_EncryptedTxExecutionResult.Fields().ByName("targetHeight")
+	fd_EncryptedTxExecutionResult_index = md_EncryptedTxExecutionResult.Fields().ByName("index")
+	fd_EncryptedTxExecutionResult_creator = md_EncryptedTxExecutionResult.Fields().ByName("creator")
+	fd_EncryptedTxExecutionResult_status = md_EncryptedTxExecutionResult.Fields().ByName("status")
+	fd_EncryptedTxExecutionResult_failReason = md_EncryptedTxExecutionResult.Fields().ByName("failReason")
+	fd_EncryptedTxExecutionResult_gasUsed = md_EncryptedTxExecutionResult.Fields().ByName("gasUsed")
+	fd_EncryptedTxExecutionResult_feeCharged = md_EncryptedTxExecutionResult.Fields().ByName("feeCharged")
+	fd_EncryptedTxExecutionResult_feeRefunded = md_EncryptedTxExecutionResult.Fields().ByName("feeRefunded")
+	fd_EncryptedTxExecutionResult_msgTypes = md_EncryptedTxExecutionResult.Fields().ByName("msgTypes")
+	fd_EncryptedTxExecutionResult_executedAtChainHeight = md_EncryptedTxExecutionResult.Fields().ByName("executedAtChainHeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_EncryptedTxExecutionResult)(nil)
+
+type fastReflection_EncryptedTxExecutionResult EncryptedTxExecutionResult
+
+func (x *EncryptedTxExecutionResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EncryptedTxExecutionResult)(x)
+}
+
+func (x *EncryptedTxExecutionResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EncryptedTxExecutionResult_messageType fastReflection_EncryptedTxExecutionResult_messageType
+var _ protoreflect.MessageType = fastReflection_EncryptedTxExecutionResult_messageType{}
+
+type fastReflection_EncryptedTxExecutionResult_messageType struct{}
+
+func (x fastReflection_EncryptedTxExecutionResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EncryptedTxExecutionResult)(nil)
+}
+func (x fastReflection_EncryptedTxExecutionResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxExecutionResult)
+}
+func (x fastReflection_EncryptedTxExecutionResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxExecutionResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EncryptedTxExecutionResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxExecutionResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EncryptedTxExecutionResult) Type() protoreflect.MessageType {
+	return _fastReflection_EncryptedTxExecutionResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EncryptedTxExecutionResult) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxExecutionResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EncryptedTxExecutionResult) Interface() protoreflect.ProtoMessage {
+	return (*EncryptedTxExecutionResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EncryptedTxExecutionResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Identity != "" {
+		value := protoreflect.ValueOfString(x.Identity)
+		if !f(fd_EncryptedTxExecutionResult_identity, value) {
+			return
+		}
+	}
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_EncryptedTxExecutionResult_targetHeight, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_EncryptedTxExecutionResult_index, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EncryptedTxExecutionResult_creator, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_EncryptedTxExecutionResult_status, value) {
+			return
+		}
+	}
+	if x.FailReason != "" {
+		value := protoreflect.ValueOfString(x.FailReason)
+		if !f(fd_EncryptedTxExecutionResult_failReason, value) {
+			return
+		}
+	}
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_EncryptedTxExecutionResult_gasUsed, value) {
+			return
+		}
+	}
+	if x.FeeCharged != nil {
+		value := protoreflect.ValueOfMessage(x.FeeCharged.ProtoReflect())
+		if !f(fd_EncryptedTxExecutionResult_feeCharged, value) {
+			return
+		}
+	}
+	if x.FeeRefunded != nil {
+		value := protoreflect.ValueOfMessage(x.FeeRefunded.ProtoReflect())
+		if !f(fd_EncryptedTxExecutionResult_feeRefunded, value) {
+			return
+		}
+	}
+	if len(x.MsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_EncryptedTxExecutionResult_10_list{list: &x.MsgTypes})
+		if !f(fd_EncryptedTxExecutionResult_msgTypes, value) {
+			return
+		}
+	}
+	if x.ExecutedAtChainHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutedAtChainHeight)
+		if !f(fd_EncryptedTxExecutionResult_executedAtChainHeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EncryptedTxExecutionResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxExecutionResult.identity":
+		return x.Identity != ""
+	case "fairyring.pep.EncryptedTxExecutionResult.targetHeight":
+		return x.TargetHeight != uint64(0)
+	case "fairyring.pep.EncryptedTxExecutionResult.index":
+		return x.Index != uint64(0)
+	case "fairyring.pep.EncryptedTxExecutionResult.creator":
+		return x.Creator != ""
+	case "fairyring.pep.EncryptedTxExecutionResult.status":
+		return x.Status != ""
+	case "fairyring.pep.EncryptedTxExecutionResult.failReason":
+		return x.FailReason != ""
+	case "fairyring.pep.EncryptedTxExecutionResult.gasUsed":
+		return x.GasUsed != uint64(0)
+	case "fairyring.pep.EncryptedTxExecutionResult.feeCharged":
+		return x.FeeCharged != nil
+	case "fairyring.pep.EncryptedTxExecutionResult.feeRefunded":
+		return x.FeeRefunded != nil
+	case "fairyring.pep.EncryptedTxExecutionResult.msgTypes":
+		return len(x.MsgTypes) != 0
+	case "fairyring.pep.EncryptedTxExecutionResult.executedAtChainHeight":
+		return x.ExecutedAtChainHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxExecutionResult"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxExecutionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxExecutionResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxExecutionResult.identity":
+		x.Identity = ""
+	case "fairyring.pep.EncryptedTxExecutionResult.targetHeight":
+		x.TargetHeight = uint64(0)
+	case "fairyring.pep.EncryptedTxExecutionResult.index":
+		x.Index = uint64(0)
+	case "fairyring.pep.EncryptedTxExecutionResult.creator":
+		x.Creator = ""
+	case "fairyring.pep.EncryptedTxExecutionResult.status":
+		x.Status = ""
+	case "fairyring.pep.EncryptedTxExecutionResult.failReason":
+		x.FailReason = ""
+	case "fairyring.pep.EncryptedTxExecutionResult.gasUsed":
+		x.GasUsed = uint64(0)
+	case "fairyring.pep.EncryptedTxExecutionResult.feeCharged":
+		x.FeeCharged = nil
+	case "fairyring.pep.EncryptedTxExecutionResult.feeRefunded":
+		x.FeeRefunded = nil
+	case "fairyring.pep.EncryptedTxExecutionResult.msgTypes":
+		x.MsgTypes = nil
+	case "fairyring.pep.EncryptedTxExecutionResult.executedAtChainHeight":
+		x.ExecutedAtChainHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxExecutionResult"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxExecutionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EncryptedTxExecutionResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.EncryptedTxExecutionResult.identity":
+		value := x.Identity
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.failReason":
+		value := x.FailReason
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.gasUsed":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.feeCharged":
+		value := x.FeeCharged
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.EncryptedTxExecutionResult.feeRefunded":
+		value := x.FeeRefunded
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.EncryptedTxExecutionResult.msgTypes":
+		if len(x.MsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_EncryptedTxExecutionResult_10_list{})
+		}
+		listValue := &_EncryptedTxExecutionResult_10_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.pep.EncryptedTxExecutionResult.executedAtChainHeight":
+		value := x.ExecutedAtChainHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxExecutionResult"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxExecutionResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxExecutionResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxExecutionResult.identity":
+		x.Identity = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxExecutionResult.targetHeight":
+		x.TargetHeight = value.Uint()
+	case "fairyring.pep.EncryptedTxExecutionResult.index":
+		x.Index = value.Uint()
+	case "fairyring.pep.EncryptedTxExecutionResult.creator":
+		x.Creator = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxExecutionResult.status":
+		x.Status = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxExecutionResult.failReason":
+		x.FailReason = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxExecutionResult.gasUsed":
+		x.GasUsed = value.Uint()
+	case "fairyring.pep.EncryptedTxExecutionResult.feeCharged":
+		x.FeeCharged = value.Message().Interface().(*v1beta1.Coin)
+	case "fairyring.pep.EncryptedTxExecutionResult.feeRefunded":
+		x.FeeRefunded = value.Message().Interface().(*v1beta1.Coin)
+	case "fairyring.pep.EncryptedTxExecutionResult.msgTypes":
+		lv := value.List()
+		clv := lv.(*_EncryptedTxExecutionResult_10_list)
+		x.MsgTypes = *clv.list
+	case "fairyring.pep.EncryptedTxExecutionResult.executedAtChainHeight":
+		x.ExecutedAtChainHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxExecutionResult"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxExecutionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxExecutionResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxExecutionResult.feeCharged":
+		if x.FeeCharged == nil {
+			x.FeeCharged = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FeeCharged.ProtoReflect())
+	case "fairyring.pep.EncryptedTxExecutionResult.feeRefunded":
+		if x.FeeRefunded == nil {
+			x.FeeRefunded = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FeeRefunded.ProtoReflect())
+	case "fairyring.pep.EncryptedTxExecutionResult.msgTypes":
+		if x.MsgTypes == nil {
+			x.MsgTypes = []string{}
+		}
+		value := &_EncryptedTxExecutionResult_10_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.pep.EncryptedTxExecutionResult.identity":
+		panic(fmt.Errorf("field identity of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.index":
+		panic(fmt.Errorf("field index of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.creator":
+		panic(fmt.Errorf("field creator of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.status":
+		panic(fmt.Errorf("field status of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.failReason":
+		panic(fmt.Errorf("field failReason of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.gasUsed":
+		panic(fmt.Errorf("field gasUsed of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	case "fairyring.pep.EncryptedTxExecutionResult.executedAtChainHeight":
+		panic(fmt.Errorf("field executedAtChainHeight of message fairyring.pep.EncryptedTxExecutionResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxExecutionResult"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxExecutionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EncryptedTxExecutionResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxExecutionResult.identity":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxExecutionResult.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxExecutionResult.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxExecutionResult.creator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxExecutionResult.status":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxExecutionResult.failReason":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxExecutionResult.gasUsed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxExecutionResult.feeCharged":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.EncryptedTxExecutionResult.feeRefunded":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.EncryptedTxExecutionResult.msgTypes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EncryptedTxExecutionResult_10_list{list: &list})
+	case "fairyring.pep.EncryptedTxExecutionResult.executedAtChainHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxExecutionResult"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxExecutionResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EncryptedTxExecutionResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.EncryptedTxExecutionResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EncryptedTxExecutionResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxExecutionResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EncryptedTxExecutionResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EncryptedTxExecutionResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EncryptedTxExecutionResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Identity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FailReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		if x.FeeCharged != nil {
+			l = options.Size(x.FeeCharged)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeRefunded != nil {
+			l = options.Size(x.FeeRefunded)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgTypes) > 0 {
+			for _, s := range x.MsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExecutedAtChainHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutedAtChainHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxExecutionResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExecutedAtChainHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutedAtChainHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.MsgTypes) > 0 {
+			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypes[iNdEx])
+				copy(dAtA[i:], x.MsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.FeeRefunded != nil {
+			encoded, err := options.Marshal(x.FeeRefunded)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.FeeCharged != nil {
+			encoded, err := options.Marshal(x.FeeCharged)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.FailReason) > 0 {
+			i -= len(x.FailReason)
+			copy(dAtA[i:], x.FailReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailReason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Identity) > 0 {
+			i -= len(x.Identity)
+			copy(dAtA[i:], x.Identity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identity)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxExecutionResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxExecutionResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCharged", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeCharged == nil {
+					x.FeeCharged = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeCharged); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRefunded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeRefunded == nil {
+					x.FeeRefunded = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeRefunded); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutedAtChainHeight", wireType)
+				}
+				x.ExecutedAtChainHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutedAtChainHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EncryptedTxCreatorIndex              protoreflect.MessageDescriptor
+	fd_EncryptedTxCreatorIndex_targetHeight protoreflect.FieldDescriptor
+	fd_EncryptedTxCreatorIndex_req_id       protoreflect.FieldDescriptor
+	fd_EncryptedTxCreatorIndex_index        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_encrypted_tx_proto_init()
+	md_EncryptedTxCreatorIndex = File_fairyring_pep_encrypted_tx_proto.Messages().ByName("EncryptedTxCreatorIndex")
+	fd_EncryptedTxCreatorIndex_targetHeight = md_EncryptedTxCreatorIndex.Fields().ByName("targetHeight")
+	fd_EncryptedTxCreatorIndex_req_id = md_EncryptedTxCreatorIndex.Fields().ByName("req_id")
+	fd_EncryptedTxCreatorIndex_index = md_EncryptedTxCreatorIndex.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_EncryptedTxCreatorIndex)(nil)
+
+type fastReflection_EncryptedTxCreatorIndex EncryptedTxCreatorIndex
+
+func (x *EncryptedTxCreatorIndex) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EncryptedTxCreatorIndex)(x)
+}
+
+func (x *EncryptedTxCreatorIndex) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EncryptedTxCreatorIndex_messageType fastReflection_EncryptedTxCreatorIndex_messageType
+var _ protoreflect.MessageType = fastReflection_EncryptedTxCreatorIndex_messageType{}
+
+type fastReflection_EncryptedTxCreatorIndex_messageType struct{}
+
+func (x fastReflection_EncryptedTxCreatorIndex_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EncryptedTxCreatorIndex)(nil)
+}
+func (x fastReflection_EncryptedTxCreatorIndex_messageType) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxCreatorIndex)
+}
+func (x fastReflection_EncryptedTxCreatorIndex_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxCreatorIndex
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EncryptedTxCreatorIndex) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxCreatorIndex
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EncryptedTxCreatorIndex) Type() protoreflect.MessageType {
+	return _fastReflection_EncryptedTxCreatorIndex_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EncryptedTxCreatorIndex) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxCreatorIndex)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EncryptedTxCreatorIndex) Interface() protoreflect.ProtoMessage {
+	return (*EncryptedTxCreatorIndex)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EncryptedTxCreatorIndex) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_EncryptedTxCreatorIndex_targetHeight, value) {
+			return
+		}
+	}
+	if x.ReqId != "" {
+		value := protoreflect.ValueOfString(x.ReqId)
+		if !f(fd_EncryptedTxCreatorIndex_req_id, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_EncryptedTxCreatorIndex_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EncryptedTxCreatorIndex) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxCreatorIndex.targetHeight":
+		return x.TargetHeight != uint64(0)
+	case "fairyring.pep.EncryptedTxCreatorIndex.req_id":
+		return x.ReqId != ""
+	case "fairyring.pep.EncryptedTxCreatorIndex.index":
+		return x.Index != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxCreatorIndex"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxCreatorIndex does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxCreatorIndex) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxCreatorIndex.targetHeight":
+		x.TargetHeight = uint64(0)
+	case "fairyring.pep.EncryptedTxCreatorIndex.req_id":
+		x.ReqId = ""
+	case "fairyring.pep.EncryptedTxCreatorIndex.index":
+		x.Index = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxCreatorIndex"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxCreatorIndex does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EncryptedTxCreatorIndex) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.EncryptedTxCreatorIndex.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxCreatorIndex.req_id":
+		value := x.ReqId
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxCreatorIndex.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxCreatorIndex"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxCreatorIndex does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxCreatorIndex) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxCreatorIndex.targetHeight":
+		x.TargetHeight = value.Uint()
+	case "fairyring.pep.EncryptedTxCreatorIndex.req_id":
+		x.ReqId = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxCreatorIndex.index":
+		x.Index = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxCreatorIndex"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxCreatorIndex does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxCreatorIndex) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxCreatorIndex.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.EncryptedTxCreatorIndex is not mutable"))
+	case "fairyring.pep.EncryptedTxCreatorIndex.req_id":
+		panic(fmt.Errorf("field req_id of message fairyring.pep.EncryptedTxCreatorIndex is not mutable"))
+	case "fairyring.pep.EncryptedTxCreatorIndex.index":
+		panic(fmt.Errorf("field index of message fairyring.pep.EncryptedTxCreatorIndex is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxCreatorIndex"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxCreatorIndex does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EncryptedTxCreatorIndex) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxCreatorIndex.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxCreatorIndex.req_id":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxCreatorIndex.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxCreatorIndex"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxCreatorIndex does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EncryptedTxCreatorIndex) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.EncryptedTxCreatorIndex", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EncryptedTxCreatorIndex) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxCreatorIndex) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EncryptedTxCreatorIndex) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EncryptedTxCreatorIndex) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EncryptedTxCreatorIndex)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		l = len(x.ReqId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxCreatorIndex)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ReqId) > 0 {
+			i -= len(x.ReqId)
+			copy(dAtA[i:], x.ReqId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReqId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxCreatorIndex)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxCreatorIndex: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxCreatorIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReqId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EncryptedTxWithStatus              protoreflect.MessageDescriptor
+	fd_EncryptedTxWithStatus_targetHeight protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_req_id       protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_identity     protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_index        protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_data         protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_creator      protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_chargedGas   protoreflect.FieldDescriptor
+	fd_EncryptedTxWithStatus_status       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_encrypted_tx_proto_init()
+	md_EncryptedTxWithStatus = File_fairyring_pep_encrypted_tx_proto.Messages().ByName("EncryptedTxWithStatus")
+	fd_EncryptedTxWithStatus_targetHeight = md_EncryptedTxWithStatus.Fields().ByName("targetHeight")
+	fd_EncryptedTxWithStatus_req_id = md_EncryptedTxWithStatus.Fields().ByName("req_id")
+	fd_EncryptedTxWithStatus_identity = md_EncryptedTxWithStatus.Fields().ByName("identity")
+	fd_EncryptedTxWithStatus_index = md_EncryptedTxWithStatus.Fields().ByName("index")
+	fd_EncryptedTxWithStatus_data = md_EncryptedTxWithStatus.Fields().ByName("data")
+	fd_EncryptedTxWithStatus_creator = md_EncryptedTxWithStatus.Fields().ByName("creator")
+	fd_EncryptedTxWithStatus_chargedGas = md_EncryptedTxWithStatus.Fields().ByName("chargedGas")
+	fd_EncryptedTxWithStatus_status = md_EncryptedTxWithStatus.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_EncryptedTxWithStatus)(nil)
+
+type fastReflection_EncryptedTxWithStatus EncryptedTxWithStatus
+
+func (x *EncryptedTxWithStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EncryptedTxWithStatus)(x)
+}
+
+func (x *EncryptedTxWithStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EncryptedTxWithStatus_messageType fastReflection_EncryptedTxWithStatus_messageType
+var _ protoreflect.MessageType = fastReflection_EncryptedTxWithStatus_messageType{}
+
+type fastReflection_EncryptedTxWithStatus_messageType struct{}
+
+func (x fastReflection_EncryptedTxWithStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EncryptedTxWithStatus)(nil)
+}
+func (x fastReflection_EncryptedTxWithStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxWithStatus)
+}
+func (x fastReflection_EncryptedTxWithStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxWithStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EncryptedTxWithStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxWithStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EncryptedTxWithStatus) Type() protoreflect.MessageType {
+	return _fastReflection_EncryptedTxWithStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EncryptedTxWithStatus) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxWithStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EncryptedTxWithStatus) Interface() protoreflect.ProtoMessage {
+	return (*EncryptedTxWithStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EncryptedTxWithStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_EncryptedTxWithStatus_targetHeight, value) {
+			return
+		}
+	}
+	if x.ReqId != "" {
+		value := protoreflect.ValueOfString(x.ReqId)
+		if !f(fd_EncryptedTxWithStatus_req_id, value) {
+			return
+		}
+	}
+	if x.Identity != "" {
+		value := protoreflect.ValueOfString(x.Identity)
+		if !f(fd_EncryptedTxWithStatus_identity, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_EncryptedTxWithStatus_index, value) {
+			return
+		}
+	}
+	if x.Data != "" {
+		value := protoreflect.ValueOfString(x.Data)
+		if !f(fd_EncryptedTxWithStatus_data, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EncryptedTxWithStatus_creator, value) {
+			return
+		}
+	}
+	if x.ChargedGas != nil {
+		value := protoreflect.ValueOfMessage(x.ChargedGas.ProtoReflect())
+		if !f(fd_EncryptedTxWithStatus_chargedGas, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_EncryptedTxWithStatus_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EncryptedTxWithStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxWithStatus.targetHeight":
+		return x.TargetHeight != uint64(0)
+	case "fairyring.pep.EncryptedTxWithStatus.req_id":
+		return x.ReqId != ""
+	case "fairyring.pep.EncryptedTxWithStatus.identity":
+		return x.Identity != ""
+	case "fairyring.pep.EncryptedTxWithStatus.index":
+		return x.Index != uint64(0)
+	case "fairyring.pep.EncryptedTxWithStatus.data":
+		return x.Data != ""
+	case "fairyring.pep.EncryptedTxWithStatus.creator":
+		return x.Creator != ""
+	case "fairyring.pep.EncryptedTxWithStatus.chargedGas":
+		return x.ChargedGas != nil
+	case "fairyring.pep.EncryptedTxWithStatus.status":
+		return x.Status != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxWithStatus"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxWithStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxWithStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxWithStatus.targetHeight":
+		x.TargetHeight = uint64(0)
+	case "fairyring.pep.EncryptedTxWithStatus.req_id":
+		x.ReqId = ""
+	case "fairyring.pep.EncryptedTxWithStatus.identity":
+		x.Identity = ""
+	case "fairyring.pep.EncryptedTxWithStatus.index":
+		x.Index = uint64(0)
+	case "fairyring.pep.EncryptedTxWithStatus.data":
+		x.Data = ""
+	case "fairyring.pep.EncryptedTxWithStatus.creator":
+		x.Creator = ""
+	case "fairyring.pep.EncryptedTxWithStatus.chargedGas":
+		x.ChargedGas = nil
+	case "fairyring.pep.EncryptedTxWithStatus.status":
+		x.Status = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxWithStatus"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxWithStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EncryptedTxWithStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.EncryptedTxWithStatus.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxWithStatus.req_id":
+		value := x.ReqId
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxWithStatus.identity":
+		value := x.Identity
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxWithStatus.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxWithStatus.data":
+		value := x.Data
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxWithStatus.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxWithStatus.chargedGas":
+		value := x.ChargedGas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.EncryptedTxWithStatus.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxWithStatus"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxWithStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxWithStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxWithStatus.targetHeight":
+		x.TargetHeight = value.Uint()
+	case "fairyring.pep.EncryptedTxWithStatus.req_id":
+		x.ReqId = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxWithStatus.identity":
+		x.Identity = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxWithStatus.index":
+		x.Index = value.Uint()
+	case "fairyring.pep.EncryptedTxWithStatus.data":
+		x.Data = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxWithStatus.creator":
+		x.Creator = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxWithStatus.chargedGas":
+		x.ChargedGas = value.Message().Interface().(*v1beta1.Coin)
+	case "fairyring.pep.EncryptedTxWithStatus.status":
+		x.Status = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxWithStatus"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxWithStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxWithStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxWithStatus.chargedGas":
+		if x.ChargedGas == nil {
+			x.ChargedGas = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ChargedGas.ProtoReflect())
+	case "fairyring.pep.EncryptedTxWithStatus.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	case "fairyring.pep.EncryptedTxWithStatus.req_id":
+		panic(fmt.Errorf("field req_id of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	case "fairyring.pep.EncryptedTxWithStatus.identity":
+		panic(fmt.Errorf("field identity of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	case "fairyring.pep.EncryptedTxWithStatus.index":
+		panic(fmt.Errorf("field index of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	case "fairyring.pep.EncryptedTxWithStatus.data":
+		panic(fmt.Errorf("field data of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	case "fairyring.pep.EncryptedTxWithStatus.creator":
+		panic(fmt.Errorf("field creator of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	case "fairyring.pep.EncryptedTxWithStatus.status":
+		panic(fmt.Errorf("field status of message fairyring.pep.EncryptedTxWithStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxWithStatus"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxWithStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EncryptedTxWithStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxWithStatus.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxWithStatus.req_id":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxWithStatus.identity":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxWithStatus.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxWithStatus.data":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxWithStatus.creator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxWithStatus.chargedGas":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.EncryptedTxWithStatus.status":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxWithStatus"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxWithStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EncryptedTxWithStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.EncryptedTxWithStatus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EncryptedTxWithStatus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxWithStatus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EncryptedTxWithStatus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EncryptedTxWithStatus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EncryptedTxWithStatus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		l = len(x.ReqId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Identity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChargedGas != nil {
+			l = options.Size(x.ChargedGas)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxWithStatus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ChargedGas != nil {
+			encoded, err := options.Marshal(x.ChargedGas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Identity) > 0 {
+			i -= len(x.Identity)
+			copy(dAtA[i:], x.Identity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identity)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReqId) > 0 {
+			i -= len(x.ReqId)
+			copy(dAtA[i:], x.ReqId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReqId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxWithStatus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxWithStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxWithStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReqId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ChargedGas == nil {
+					x.ChargedGas = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChargedGas); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EncryptedTxOrder_3_list)(nil)
+
+type _EncryptedTxOrder_3_list struct {
+	list *[]uint64
+}
+
+func (x *_EncryptedTxOrder_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EncryptedTxOrder_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_EncryptedTxOrder_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EncryptedTxOrder_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EncryptedTxOrder_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EncryptedTxOrder at list field Indexes as it is not of Message kind"))
+}
+
+func (x *_EncryptedTxOrder_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EncryptedTxOrder_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_EncryptedTxOrder_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EncryptedTxOrder              protoreflect.MessageDescriptor
+	fd_EncryptedTxOrder_targetHeight protoreflect.FieldDescriptor
+	fd_EncryptedTxOrder_seed         protoreflect.FieldDescriptor
+	fd_EncryptedTxOrder_indexes      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_encrypted_tx_proto_init()
+	md_EncryptedTxOrder = File_fairyring_pep_encrypted_tx_proto.Messages().ByName("EncryptedTxOrder")
+	fd_EncryptedTxOrder_targetHeight = md_EncryptedTxOrder.Fields().ByName("targetHeight")
+	fd_EncryptedTxOrder_seed = md_EncryptedTxOrder.Fields().ByName("seed")
+	fd_EncryptedTxOrder_indexes = md_EncryptedTxOrder.Fields().ByName("indexes")
+}
+
+var _ protoreflect.Message = (*fastReflection_EncryptedTxOrder)(nil)
+
+type fastReflection_EncryptedTxOrder EncryptedTxOrder
+
+func (x *EncryptedTxOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EncryptedTxOrder)(x)
+}
+
+func (x *EncryptedTxOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EncryptedTxOrder_messageType fastReflection_EncryptedTxOrder_messageType
+var _ protoreflect.MessageType = fastReflection_EncryptedTxOrder_messageType{}
+
+type fastReflection_EncryptedTxOrder_messageType struct{}
+
+func (x fastReflection_EncryptedTxOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EncryptedTxOrder)(nil)
+}
+func (x fastReflection_EncryptedTxOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxOrder)
+}
+func (x fastReflection_EncryptedTxOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxOrder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EncryptedTxOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_EncryptedTxOrder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EncryptedTxOrder) Type() protoreflect.MessageType {
+	return _fastReflection_EncryptedTxOrder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EncryptedTxOrder) New() protoreflect.Message {
+	return new(fastReflection_EncryptedTxOrder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EncryptedTxOrder) Interface() protoreflect.ProtoMessage {
+	return (*EncryptedTxOrder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EncryptedTxOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_EncryptedTxOrder_targetHeight, value) {
+			return
+		}
+	}
+	if x.Seed != "" {
+		value := protoreflect.ValueOfString(x.Seed)
+		if !f(fd_EncryptedTxOrder_seed, value) {
+			return
+		}
+	}
+	if len(x.Indexes) != 0 {
+		value := protoreflect.ValueOfList(&_EncryptedTxOrder_3_list{list: &x.Indexes})
+		if !f(fd_EncryptedTxOrder_indexes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EncryptedTxOrder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxOrder.targetHeight":
+		return x.TargetHeight != uint64(0)
+	case "fairyring.pep.EncryptedTxOrder.seed":
+		return x.Seed != ""
+	case "fairyring.pep.EncryptedTxOrder.indexes":
+		return len(x.Indexes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxOrder"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxOrder) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxOrder.targetHeight":
+		x.TargetHeight = uint64(0)
+	case "fairyring.pep.EncryptedTxOrder.seed":
+		x.Seed = ""
+	case "fairyring.pep.EncryptedTxOrder.indexes":
+		x.Indexes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxOrder"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EncryptedTxOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.EncryptedTxOrder.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.EncryptedTxOrder.seed":
+		value := x.Seed
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.EncryptedTxOrder.indexes":
+		if len(x.Indexes) == 0 {
+			return protoreflect.ValueOfList(&_EncryptedTxOrder_3_list{})
+		}
+		listValue := &_EncryptedTxOrder_3_list{list: &x.Indexes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxOrder"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxOrder does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxOrder.targetHeight":
+		x.TargetHeight = value.Uint()
+	case "fairyring.pep.EncryptedTxOrder.seed":
+		x.Seed = value.Interface().(string)
+	case "fairyring.pep.EncryptedTxOrder.indexes":
+		lv := value.List()
+		clv := lv.(*_EncryptedTxOrder_3_list)
+		x.Indexes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxOrder"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxOrder.indexes":
+		if x.Indexes == nil {
+			x.Indexes = []uint64{}
+		}
+		value := &_EncryptedTxOrder_3_list{list: &x.Indexes}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.pep.EncryptedTxOrder.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.EncryptedTxOrder is not mutable"))
+	case "fairyring.pep.EncryptedTxOrder.seed":
+		panic(fmt.Errorf("field seed of message fairyring.pep.EncryptedTxOrder is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxOrder"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EncryptedTxOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.EncryptedTxOrder.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.EncryptedTxOrder.seed":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.EncryptedTxOrder.indexes":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EncryptedTxOrder_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.EncryptedTxOrder"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.EncryptedTxOrder does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EncryptedTxOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.EncryptedTxOrder", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EncryptedTxOrder) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EncryptedTxOrder) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EncryptedTxOrder) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EncryptedTxOrder) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EncryptedTxOrder)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		l = len(x.Seed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Indexes) > 0 {
+			l = 0
+			for _, e := range x.Indexes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxOrder)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Indexes) > 0 {
+			var pksize2 int
+			for _, num := range x.Indexes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Indexes {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Seed) > 0 {
+			i -= len(x.Seed)
+			copy(dAtA[i:], x.Seed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seed)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EncryptedTxOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxOrder: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EncryptedTxOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Indexes = append(x.Indexes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Indexes) == 0 {
+						x.Indexes = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Indexes = append(x.Indexes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fairyring/pep/encrypted_tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EncryptedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHeight           uint64        `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index                  uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data                   string        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	Creator                string        `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
 	ChargedGas             *v1beta1.Coin `protobuf:"bytes,5,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
 	ProcessedAtChainHeight uint64        `protobuf:"varint,6,opt,name=processedAtChainHeight,proto3" json:"processedAtChainHeight,omitempty"`
 	Expired                bool          `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	Cancelled              bool          `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Refunded               *v1beta1.Coin `protobuf:"bytes,9,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (x *EncryptedTx) Reset() {
+	*x = EncryptedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncryptedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedTx) ProtoMessage() {}
+
+// Deprecated: Use EncryptedTx.ProtoReflect.Descriptor instead.
+func (*EncryptedTx) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EncryptedTx) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *EncryptedTx) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EncryptedTx) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *EncryptedTx) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EncryptedTx) GetChargedGas() *v1beta1.Coin {
+	if x != nil {
+		return x.ChargedGas
+	}
+	return nil
+}
+
+func (x *EncryptedTx) GetProcessedAtChainHeight() uint64 {
+	if x != nil {
+		return x.ProcessedAtChainHeight
+	}
+	return 0
+}
+
+func (x *EncryptedTx) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
 }
 
-func (x *EncryptedTx) Reset() {
-	*x = EncryptedTx{}
+func (x *EncryptedTx) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *EncryptedTx) GetRefunded() *v1beta1.Coin {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+type EncryptedTxArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedTx []*EncryptedTx `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
+}
+
+func (x *EncryptedTxArray) Reset() {
+	*x = EncryptedTxArray{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[0]
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncryptedTxArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedTxArray) ProtoMessage() {}
+
+// Deprecated: Use EncryptedTxArray.ProtoReflect.Descriptor instead.
+func (*EncryptedTxArray) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EncryptedTxArray) GetEncryptedTx() []*EncryptedTx {
+	if x != nil {
+		return x.EncryptedTx
+	}
+	return nil
+}
+
+type GeneralEncryptedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity   string        `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Index      uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data       string        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Creator    string        `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChargedGas *v1beta1.Coin `protobuf:"bytes,5,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+	Cancelled  bool          `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Refunded   *v1beta1.Coin `protobuf:"bytes,7,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (x *GeneralEncryptedTx) Reset() {
+	*x = GeneralEncryptedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneralEncryptedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralEncryptedTx) ProtoMessage() {}
+
+// Deprecated: Use GeneralEncryptedTx.ProtoReflect.Descriptor instead.
+func (*GeneralEncryptedTx) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GeneralEncryptedTx) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GeneralEncryptedTx) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GeneralEncryptedTx) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *GeneralEncryptedTx) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *GeneralEncryptedTx) GetChargedGas() *v1beta1.Coin {
+	if x != nil {
+		return x.ChargedGas
+	}
+	return nil
+}
+
+func (x *GeneralEncryptedTx) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *GeneralEncryptedTx) GetRefunded() *v1beta1.Coin {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+type GeneralEncryptedTxArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedTx []*GeneralEncryptedTx `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
+}
+
+func (x *GeneralEncryptedTxArray) Reset() {
+	*x = GeneralEncryptedTxArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneralEncryptedTxArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralEncryptedTxArray) ProtoMessage() {}
+
+// Deprecated: Use GeneralEncryptedTxArray.ProtoReflect.Descriptor instead.
+func (*GeneralEncryptedTxArray) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GeneralEncryptedTxArray) GetEncryptedTx() []*GeneralEncryptedTx {
+	if x != nil {
+		return x.EncryptedTx
+	}
+	return nil
+}
+
+type GenEncTxExecutionQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator      string                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	RequestId    string                   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Identity     string                   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Pubkey       string                   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	TxList       *GeneralEncryptedTxArray `protobuf:"bytes,5,opt,name=tx_list,json=txList,proto3" json:"tx_list,omitempty"`
+	AggrKeyshare string                   `protobuf:"bytes,6,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
+	// expiry is the expiry height of the public key the identity is bound to,
+	// the request expires if its decryption key is not delivered by then
+	Expiry  uint64 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Expired bool   `protobuf:"varint,8,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *GenEncTxExecutionQueue) Reset() {
+	*x = GenEncTxExecutionQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenEncTxExecutionQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenEncTxExecutionQueue) ProtoMessage() {}
+
+// Deprecated: Use GenEncTxExecutionQueue.ProtoReflect.Descriptor instead.
+func (*GenEncTxExecutionQueue) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenEncTxExecutionQueue) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *GenEncTxExecutionQueue) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GenEncTxExecutionQueue) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *GenEncTxExecutionQueue) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *GenEncTxExecutionQueue) GetTxList() *GeneralEncryptedTxArray {
+	if x != nil {
+		return x.TxList
+	}
+	return nil
+}
+
+func (x *GenEncTxExecutionQueue) GetAggrKeyshare() string {
+	if x != nil {
+		return x.AggrKeyshare
+	}
+	return ""
+}
+
+func (x *GenEncTxExecutionQueue) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *GenEncTxExecutionQueue) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+// EncryptedTxQueueEntry is an encrypted tx that did not fit in the per block
+// execution budget and is carried over to the following blocks
+type EncryptedTxQueueEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence     uint64        `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Identity     string        `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	TargetHeight uint64        `protobuf:"varint,3,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index        uint64        `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Data         string        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Creator      string        `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChargedGas   *v1beta1.Coin `protobuf:"bytes,7,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+	Pubkey       string        `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	AggrKeyshare string        `protobuf:"bytes,9,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
+}
+
+func (x *EncryptedTxQueueEntry) Reset() {
+	*x = EncryptedTxQueueEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EncryptedTx) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EncryptedTxQueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedTxQueueEntry) ProtoMessage() {}
+
+// Deprecated: Use EncryptedTxQueueEntry.ProtoReflect.Descriptor instead.
+func (*EncryptedTxQueueEntry) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{5}
 }
 
-func (*EncryptedTx) ProtoMessage() {}
+func (x *EncryptedTxQueueEntry) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
 
-// Deprecated: Use EncryptedTx.ProtoReflect.Descriptor instead.
-func (*EncryptedTx) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{0}
+func (x *EncryptedTxQueueEntry) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
 }
 
-func (x *EncryptedTx) GetTargetHeight() uint64 {
+func (x *EncryptedTxQueueEntry) GetTargetHeight() uint64 {
 	if x != nil {
 		return x.TargetHeight
 	}
 	return 0
 }
 
-func (x *EncryptedTx) GetIndex() uint64 {
+func (x *EncryptedTxQueueEntry) GetIndex() uint64 {
 	if x != nil {
 		return x.Index
 	}
 	return 0
 }
 
-func (x *EncryptedTx) GetData() string {
+func (x *EncryptedTxQueueEntry) GetData() string {
 	if x != nil {
 		return x.Data
 	}
 	return ""
 }
 
-func (x *EncryptedTx) GetCreator() string {
+func (x *EncryptedTxQueueEntry) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *EncryptedTx) GetChargedGas() *v1beta1.Coin {
+func (x *EncryptedTxQueueEntry) GetChargedGas() *v1beta1.Coin {
 	if x != nil {
 		return x.ChargedGas
 	}
 	return nil
 }
 
-func (x *EncryptedTx) GetProcessedAtChainHeight() uint64 {
+func (x *EncryptedTxQueueEntry) GetPubkey() string {
 	if x != nil {
-		return x.ProcessedAtChainHeight
+		return x.Pubkey
 	}
-	return 0
+	return ""
 }
 
-func (x *EncryptedTx) GetExpired() bool {
+func (x *EncryptedTxQueueEntry) GetAggrKeyshare() string {
 	if x != nil {
-		return x.Expired
+		return x.AggrKeyshare
 	}
-	return false
+	return ""
 }
 
-type EncryptedTxArray struct {
+// EncryptedTxExecutionResult is the outcome of executing a decrypted encrypted tx
+type EncryptedTxExecutionResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EncryptedTx []*EncryptedTx `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
-}
-
-func (x *EncryptedTxArray) Reset() {
-	*x = EncryptedTxArray{}
+	Identity              string        `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	TargetHeight          uint64        `protobuf:"varint,2,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index                 uint64        `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Creator               string        `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status                string        `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	FailReason            string        `protobuf:"bytes,6,opt,name=failReason,proto3" json:"failReason,omitempty"`
+	GasUsed               uint64        `protobuf:"varint,7,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	FeeCharged            *v1beta1.Coin `protobuf:"bytes,8,opt,name=feeCharged,proto3" json:"feeCharged,omitempty"`
+	FeeRefunded           *v1beta1.Coin `protobuf:"bytes,9,opt,name=feeRefunded,proto3" json:"feeRefunded,omitempty"`
+	MsgTypes              []string      `protobuf:"bytes,10,rep,name=msgTypes,proto3" json:"msgTypes,omitempty"`
+	ExecutedAtChainHeight uint64        `protobuf:"varint,11,opt,name=executedAtChainHeight,proto3" json:"executedAtChainHeight,omitempty"`
+}
+
+func (x *EncryptedTxExecutionResult) Reset() {
+	*x = EncryptedTxExecutionResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[1]
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EncryptedTxArray) String() string {
+func (x *EncryptedTxExecutionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptedTxArray) ProtoMessage() {}
+func (*EncryptedTxExecutionResult) ProtoMessage() {}
 
-// Deprecated: Use EncryptedTxArray.ProtoReflect.Descriptor instead.
-func (*EncryptedTxArray) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EncryptedTxExecutionResult.ProtoReflect.Descriptor instead.
+func (*EncryptedTxExecutionResult) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *EncryptedTxArray) GetEncryptedTx() []*EncryptedTx {
+func (x *EncryptedTxExecutionResult) GetIdentity() string {
 	if x != nil {
-		return x.EncryptedTx
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-type GeneralEncryptedTx struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Identity   string        `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	Index      uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Data       string        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Creator    string        `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
-	ChargedGas *v1beta1.Coin `protobuf:"bytes,5,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+func (x *EncryptedTxExecutionResult) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
 }
 
-func (x *GeneralEncryptedTx) Reset() {
-	*x = GeneralEncryptedTx{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EncryptedTxExecutionResult) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
 	}
+	return 0
 }
 
-func (x *GeneralEncryptedTx) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EncryptedTxExecutionResult) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
 }
 
-func (*GeneralEncryptedTx) ProtoMessage() {}
-
-// Deprecated: Use GeneralEncryptedTx.ProtoReflect.Descriptor instead.
-func (*GeneralEncryptedTx) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{2}
+func (x *EncryptedTxExecutionResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *GeneralEncryptedTx) GetIdentity() string {
+func (x *EncryptedTxExecutionResult) GetFailReason() string {
 	if x != nil {
-		return x.Identity
+		return x.FailReason
 	}
 	return ""
 }
 
-func (x *GeneralEncryptedTx) GetIndex() uint64 {
+func (x *EncryptedTxExecutionResult) GetGasUsed() uint64 {
 	if x != nil {
-		return x.Index
+		return x.GasUsed
 	}
 	return 0
 }
 
-func (x *GeneralEncryptedTx) GetData() string {
+func (x *EncryptedTxExecutionResult) GetFeeCharged() *v1beta1.Coin {
 	if x != nil {
-		return x.Data
+		return x.FeeCharged
 	}
-	return ""
+	return nil
 }
 
-func (x *GeneralEncryptedTx) GetCreator() string {
+func (x *EncryptedTxExecutionResult) GetFeeRefunded() *v1beta1.Coin {
 	if x != nil {
-		return x.Creator
+		return x.FeeRefunded
 	}
-	return ""
+	return nil
 }
 
-func (x *GeneralEncryptedTx) GetChargedGas() *v1beta1.Coin {
+func (x *EncryptedTxExecutionResult) GetMsgTypes() []string {
 	if x != nil {
-		return x.ChargedGas
+		return x.MsgTypes
 	}
 	return nil
 }
 
-type GeneralEncryptedTxArray struct {
+func (x *EncryptedTxExecutionResult) GetExecutedAtChainHeight() uint64 {
+	if x != nil {
+		return x.ExecutedAtChainHeight
+	}
+	return 0
+}
+
+// EncryptedTxCreatorIndex points to an encrypted tx submitted by a creator,
+// req_id is only set for general encrypted txs
+type EncryptedTxCreatorIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EncryptedTx []*GeneralEncryptedTx `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	ReqId        string `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Index        uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *GeneralEncryptedTxArray) Reset() {
-	*x = GeneralEncryptedTxArray{}
+func (x *EncryptedTxCreatorIndex) Reset() {
+	*x = EncryptedTxCreatorIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[3]
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GeneralEncryptedTxArray) String() string {
+func (x *EncryptedTxCreatorIndex) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneralEncryptedTxArray) ProtoMessage() {}
+func (*EncryptedTxCreatorIndex) ProtoMessage() {}
 
-// Deprecated: Use GeneralEncryptedTxArray.ProtoReflect.Descriptor instead.
-func (*GeneralEncryptedTxArray) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EncryptedTxCreatorIndex.ProtoReflect.Descriptor instead.
+func (*EncryptedTxCreatorIndex) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GeneralEncryptedTxArray) GetEncryptedTx() []*GeneralEncryptedTx {
+func (x *EncryptedTxCreatorIndex) GetTargetHeight() uint64 {
 	if x != nil {
-		return x.EncryptedTx
+		return x.TargetHeight
 	}
-	return nil
+	return 0
 }
 
-type GenEncTxExecutionQueue struct {
+func (x *EncryptedTxCreatorIndex) GetReqId() string {
+	if x != nil {
+		return x.ReqId
+	}
+	return ""
+}
+
+func (x *EncryptedTxCreatorIndex) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// EncryptedTxWithStatus is a height-targeted or general encrypted tx along with its current status
+type EncryptedTxWithStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	RequestId    string                   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Identity     string                   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
-	Pubkey       string                   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	TxList       *GeneralEncryptedTxArray `protobuf:"bytes,5,opt,name=tx_list,json=txList,proto3" json:"tx_list,omitempty"`
-	AggrKeyshare string                   `protobuf:"bytes,6,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
+	TargetHeight uint64        `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	ReqId        string        `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Identity     string        `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Index        uint64        `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Data         string        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Creator      string        `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChargedGas   *v1beta1.Coin `protobuf:"bytes,7,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+	Status       string        `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GenEncTxExecutionQueue) Reset() {
-	*x = GenEncTxExecutionQueue{}
+func (x *EncryptedTxWithStatus) Reset() {
+	*x = EncryptedTxWithStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[4]
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenEncTxExecutionQueue) String() string {
+func (x *EncryptedTxWithStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenEncTxExecutionQueue) ProtoMessage() {}
+func (*EncryptedTxWithStatus) ProtoMessage() {}
 
-// Deprecated: Use GenEncTxExecutionQueue.ProtoReflect.Descriptor instead.
-func (*GenEncTxExecutionQueue) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EncryptedTxWithStatus.ProtoReflect.Descriptor instead.
+func (*EncryptedTxWithStatus) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GenEncTxExecutionQueue) GetCreator() string {
+func (x *EncryptedTxWithStatus) GetTargetHeight() uint64 {
 	if x != nil {
-		return x.Creator
+		return x.TargetHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *GenEncTxExecutionQueue) GetRequestId() string {
+func (x *EncryptedTxWithStatus) GetReqId() string {
 	if x != nil {
-		return x.RequestId
+		return x.ReqId
 	}
 	return ""
 }
 
-func (x *GenEncTxExecutionQueue) GetIdentity() string {
+func (x *EncryptedTxWithStatus) GetIdentity() string {
 	if x != nil {
 		return x.Identity
 	}
 	return ""
 }
 
-func (x *GenEncTxExecutionQueue) GetPubkey() string {
+func (x *EncryptedTxWithStatus) GetIndex() uint64 {
 	if x != nil {
-		return x.Pubkey
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EncryptedTxWithStatus) GetData() string {
+	if x != nil {
+		return x.Data
 	}
 	return ""
 }
 
-func (x *GenEncTxExecutionQueue) GetTxList() *GeneralEncryptedTxArray {
+func (x *EncryptedTxWithStatus) GetCreator() string {
 	if x != nil {
-		return x.TxList
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EncryptedTxWithStatus) GetChargedGas() *v1beta1.Coin {
+	if x != nil {
+		return x.ChargedGas
 	}
 	return nil
 }
 
-func (x *GenEncTxExecutionQueue) GetAggrKeyshare() string {
+func (x *EncryptedTxWithStatus) GetStatus() string {
 	if x != nil {
-		return x.AggrKeyshare
+		return x.Status
+	}
+	return ""
+}
+
+// EncryptedTxOrder is the permutation the encrypted txs of a target height were executed in,
+// seed is the hex encoded hash of the aggregated key of the height the permutation is derived from
+type EncryptedTxOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHeight uint64   `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Seed         string   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Indexes      []uint64 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (x *EncryptedTxOrder) Reset() {
+	*x = EncryptedTxOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_encrypted_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncryptedTxOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedTxOrder) ProtoMessage() {}
+
+// Deprecated: Use EncryptedTxOrder.ProtoReflect.Descriptor instead.
+func (*EncryptedTxOrder) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_encrypted_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EncryptedTxOrder) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *EncryptedTxOrder) GetSeed() string {
+	if x != nil {
+		return x.Seed
 	}
 	return ""
 }
 
+func (x *EncryptedTxOrder) GetIndexes() []uint64 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
 var File_fairyring_pep_encrypted_tx_proto protoreflect.FileDescriptor
 
 var file_fairyring_pep_encrypted_tx_proto_rawDesc = []byte{
@@ -3510,7 +8343,7 @@ var file_fairyring_pep_encrypted_tx_proto_rawDesc = []byte{
 	0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x02, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72,
 	0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
 	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
 	0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69,
@@ -3526,55 +8359,145 @@ var file_fairyring_pep_encrypted_tx_proto_rawDesc = []byte{
 	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x63,
 	0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67,
 	0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x56, 0x0a, 0x10,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79,
-	0x12, 0x42, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54,
-	0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x65, 0x64, 0x54, 0x78, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72,
-	0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x22, 0x64, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x12, 0x49, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
-	0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x22, 0xeb, 0x01, 0x0a,
-	0x16, 0x47, 0x65, 0x6e, 0x45, 0x6e, 0x63, 0x54, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x06, 0x74,
-	0x78, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x5f, 0x6b, 0x65,
-	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67,
-	0x67, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x9a, 0x01, 0x0a, 0x11, 0x63,
-	0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
-	0x42, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2f, 0x70, 0x65, 0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x0d, 0x46, 0x61, 0x69,
-	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70, 0xca, 0x02, 0x0d, 0x46, 0x61, 0x69,
-	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0xe2, 0x02, 0x19, 0x46, 0x61, 0x69,
-	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
-	0x6e, 0x67, 0x3a, 0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x22, 0x56, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x42, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x22, 0x84, 0x02, 0x0a, 0x12, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x22, 0x64, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x49, 0x0a, 0x0b, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x22, 0x9d, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x45, 0x6e,
+	0x63, 0x54, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x3f,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x4b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0xaf, 0x02, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x67, 0x67, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72,
+	0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xa8, 0x03, 0x0a, 0x1a, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x65,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x6a, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22,
+	0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x85, 0x02, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x65, 0x71, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47,
+	0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x42, 0x9a, 0x01,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x65, 0x70, 0x42, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x0d,
+	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70, 0xca, 0x02, 0x0d,
+	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0xe2, 0x02, 0x19,
+	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -3589,26 +8512,37 @@ func file_fairyring_pep_encrypted_tx_proto_rawDescGZIP() []byte {
 	return file_fairyring_pep_encrypted_tx_proto_rawDescData
 }
 
-var file_fairyring_pep_encrypted_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_fairyring_pep_encrypted_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_fairyring_pep_encrypted_tx_proto_goTypes = []interface{}{
-	(*EncryptedTx)(nil),             // 0: fairyring.pep.EncryptedTx
-	(*EncryptedTxArray)(nil),        // 1: fairyring.pep.EncryptedTxArray
-	(*GeneralEncryptedTx)(nil),      // 2: fairyring.pep.GeneralEncryptedTx
-	(*GeneralEncryptedTxArray)(nil), // 3: fairyring.pep.GeneralEncryptedTxArray
-	(*GenEncTxExecutionQueue)(nil),  // 4: fairyring.pep.GenEncTxExecutionQueue
-	(*v1beta1.Coin)(nil),            // 5: cosmos.base.v1beta1.Coin
+	(*EncryptedTx)(nil),                // 0: fairyring.pep.EncryptedTx
+	(*EncryptedTxArray)(nil),           // 1: fairyring.pep.EncryptedTxArray
+	(*GeneralEncryptedTx)(nil),         // 2: fairyring.pep.GeneralEncryptedTx
+	(*GeneralEncryptedTxArray)(nil),    // 3: fairyring.pep.GeneralEncryptedTxArray
+	(*GenEncTxExecutionQueue)(nil),     // 4: fairyring.pep.GenEncTxExecutionQueue
+	(*EncryptedTxQueueEntry)(nil),      // 5: fairyring.pep.EncryptedTxQueueEntry
+	(*EncryptedTxExecutionResult)(nil), // 6: fairyring.pep.EncryptedTxExecutionResult
+	(*EncryptedTxCreatorIndex)(nil),    // 7: fairyring.pep.EncryptedTxCreatorIndex
+	(*EncryptedTxWithStatus)(nil),      // 8: fairyring.pep.EncryptedTxWithStatus
+	(*EncryptedTxOrder)(nil),           // 9: fairyring.pep.EncryptedTxOrder
+	(*v1beta1.Coin)(nil),               // 10: cosmos.base.v1beta1.Coin
 }
 var file_fairyring_pep_encrypted_tx_proto_depIdxs = []int32{
-	5, // 0: fairyring.pep.EncryptedTx.chargedGas:type_name -> cosmos.base.v1beta1.Coin
-	0, // 1: fairyring.pep.EncryptedTxArray.encryptedTx:type_name -> fairyring.pep.EncryptedTx
-	5, // 2: fairyring.pep.GeneralEncryptedTx.chargedGas:type_name -> cosmos.base.v1beta1.Coin
-	2, // 3: fairyring.pep.GeneralEncryptedTxArray.encryptedTx:type_name -> fairyring.pep.GeneralEncryptedTx
-	3, // 4: fairyring.pep.GenEncTxExecutionQueue.tx_list:type_name -> fairyring.pep.GeneralEncryptedTxArray
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	10, // 0: fairyring.pep.EncryptedTx.chargedGas:type_name -> cosmos.base.v1beta1.Coin
+	10, // 1: fairyring.pep.EncryptedTx.refunded:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 2: fairyring.pep.EncryptedTxArray.encryptedTx:type_name -> fairyring.pep.EncryptedTx
+	10, // 3: fairyring.pep.GeneralEncryptedTx.chargedGas:type_name -> cosmos.base.v1beta1.Coin
+	10, // 4: fairyring.pep.GeneralEncryptedTx.refunded:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 5: fairyring.pep.GeneralEncryptedTxArray.encryptedTx:type_name -> fairyring.pep.GeneralEncryptedTx
+	3,  // 6: fairyring.pep.GenEncTxExecutionQueue.tx_list:type_name -> fairyring.pep.GeneralEncryptedTxArray
+	10, // 7: fairyring.pep.EncryptedTxQueueEntry.chargedGas:type_name -> cosmos.base.v1beta1.Coin
+	10, // 8: fairyring.pep.EncryptedTxExecutionResult.feeCharged:type_name -> cosmos.base.v1beta1.Coin
+	10, // 9: fairyring.pep.EncryptedTxExecutionResult.feeRefunded:type_name -> cosmos.base.v1beta1.Coin
+	10, // 10: fairyring.pep.EncryptedTxWithStatus.chargedGas:type_name -> cosmos.base.v1beta1.Coin
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_fairyring_pep_encrypted_tx_proto_init() }
@@ -3677,6 +8611,66 @@ func file_fairyring_pep_encrypted_tx_proto_init() {
 				return nil
 			}
 		}
+		file_fairyring_pep_encrypted_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedTxQueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_encrypted_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedTxExecutionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_encrypted_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedTxCreatorIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_encrypted_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedTxWithStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_encrypted_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedTxOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3684,7 +8678,7 @@ func file_fairyring_pep_encrypted_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fairyring_pep_encrypted_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -219,6 +219,57 @@ func (x *_GenesisState_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*EncryptedTxQueueEntry
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EncryptedTxQueueEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EncryptedTxQueueEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(EncryptedTxQueueEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(EncryptedTxQueueEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                        protoreflect.MessageDescriptor
 	fd_GenesisState_params                 protoreflect.FieldDescriptor
@@ -230,6 +281,7 @@ var (
 	fd_GenesisState_queuedPubKey           protoreflect.FieldDescriptor
 	fd_GenesisState_request_count          protoreflect.FieldDescriptor
 	fd_GenesisState_requestIdList          protoreflect.FieldDescriptor
+	fd_GenesisState_encryptedTxQueue       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -244,6 +296,7 @@ func init() {
 	fd_GenesisState_queuedPubKey = md_GenesisState.Fields().ByName("queuedPubKey")
 	fd_GenesisState_request_count = md_GenesisState.Fields().ByName("request_count")
 	fd_GenesisState_requestIdList = md_GenesisState.Fields().ByName("requestIdList")
+	fd_GenesisState_encryptedTxQueue = md_GenesisState.Fields().ByName("encryptedTxQueue")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -365,6 +418,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.EncryptedTxQueue) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.EncryptedTxQueue})
+		if !f(fd_GenesisState_encryptedTxQueue, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -398,6 +457,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.RequestCount != uint64(0)
 	case "fairyring.pep.GenesisState.requestIdList":
 		return len(x.RequestIdList) != 0
+	case "fairyring.pep.GenesisState.encryptedTxQueue":
+		return len(x.EncryptedTxQueue) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenesisState"))
@@ -432,6 +493,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.RequestCount = uint64(0)
 	case "fairyring.pep.GenesisState.requestIdList":
 		x.RequestIdList = nil
+	case "fairyring.pep.GenesisState.encryptedTxQueue":
+		x.EncryptedTxQueue = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenesisState"))
@@ -487,6 +550,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_10_list{list: &x.RequestIdList}
 		return protoreflect.ValueOfList(listValue)
+	case "fairyring.pep.GenesisState.encryptedTxQueue":
+		if len(x.EncryptedTxQueue) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.EncryptedTxQueue}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenesisState"))
@@ -533,6 +602,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_10_list)
 		x.RequestIdList = *clv.list
+	case "fairyring.pep.GenesisState.encryptedTxQueue":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.EncryptedTxQueue = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenesisState"))
@@ -592,6 +665,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_10_list{list: &x.RequestIdList}
 		return protoreflect.ValueOfList(value)
+	case "fairyring.pep.GenesisState.encryptedTxQueue":
+		if x.EncryptedTxQueue == nil {
+			x.EncryptedTxQueue = []*EncryptedTxQueueEntry{}
+		}
+		value := &_GenesisState_11_list{list: &x.EncryptedTxQueue}
+		return protoreflect.ValueOfList(value)
 	case "fairyring.pep.GenesisState.port_id":
 		panic(fmt.Errorf("field port_id of message fairyring.pep.GenesisState is not mutable"))
 	case "fairyring.pep.GenesisState.request_count":
@@ -634,6 +713,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "fairyring.pep.GenesisState.requestIdList":
 		list := []*RequestId{}
 		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "fairyring.pep.GenesisState.encryptedTxQueue":
+		list := []*EncryptedTxQueueEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.GenesisState"))
@@ -746,6 +828,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.EncryptedTxQueue) > 0 {
+			for _, e := range x.EncryptedTxQueue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -775,6 +863,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EncryptedTxQueue) > 0 {
+			for iNdEx := len(x.EncryptedTxQueue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EncryptedTxQueue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if len(x.RequestIdList) > 0 {
 			for iNdEx := len(x.RequestIdList) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RequestIdList[iNdEx])
@@ -1237,6 +1341,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedTxQueue = append(x.EncryptedTxQueue, &EncryptedTxQueueEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EncryptedTxQueue[len(x.EncryptedTxQueue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1297,11 +1435,12 @@ type GenesisState struct {
 	EncryptedTxArray []*EncryptedTxArray `protobuf:"bytes,3,rep,name=encryptedTxArray,proto3" json:"encryptedTxArray,omitempty"`
 	PepNonceList     []*PepNonce         `protobuf:"bytes,4,rep,name=pepNonceList,proto3" json:"pepNonceList,omitempty"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	AggregatedKeyShareList []*AggregatedKeyShare   `protobuf:"bytes,6,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList,omitempty"`
-	ActivePubKey           *common.ActivePublicKey `protobuf:"bytes,7,opt,name=activePubKey,proto3" json:"activePubKey,omitempty"`
-	QueuedPubKey           *common.QueuedPublicKey `protobuf:"bytes,8,opt,name=queuedPubKey,proto3" json:"queuedPubKey,omitempty"`
-	RequestCount           uint64                  `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	RequestIdList          []*RequestId            `protobuf:"bytes,10,rep,name=requestIdList,proto3" json:"requestIdList,omitempty"`
+	AggregatedKeyShareList []*AggregatedKeyShare    `protobuf:"bytes,6,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList,omitempty"`
+	ActivePubKey           *common.ActivePublicKey  `protobuf:"bytes,7,opt,name=activePubKey,proto3" json:"activePubKey,omitempty"`
+	QueuedPubKey           *common.QueuedPublicKey  `protobuf:"bytes,8,opt,name=queuedPubKey,proto3" json:"queuedPubKey,omitempty"`
+	RequestCount           uint64                   `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	RequestIdList          []*RequestId             `protobuf:"bytes,10,rep,name=requestIdList,proto3" json:"requestIdList,omitempty"`
+	EncryptedTxQueue       []*EncryptedTxQueueEntry `protobuf:"bytes,11,rep,name=encryptedTxQueue,proto3" json:"encryptedTxQueue,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1387,6 +1526,13 @@ func (x *GenesisState) GetRequestIdList() []*RequestId {
 	return nil
 }
 
+func (x *GenesisState) GetEncryptedTxQueue() []*EncryptedTxQueueEntry {
+	if x != nil {
+		return x.EncryptedTxQueue
+	}
+	return nil
+}
+
 var File_fairyring_pep_genesis_proto protoreflect.FileDescriptor
 
 var file_fairyring_pep_genesis_proto_rawDesc = []byte{
@@ -1408,7 +1554,7 @@ var file_fairyring_pep_genesis_proto_rawDesc = []byte{
 	0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70,
 	0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xdd, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x6f, 0x22, 0xb5, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
 	0x65, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
@@ -1446,17 +1592,22 @@ var file_fairyring_pep_genesis_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
 	0x65, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x42, 0x04, 0xc8, 0xde,
 	0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x96, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x0d,
-	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70, 0xca, 0x02, 0x0d,
-	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0xe2, 0x02, 0x19,
-	0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46, 0x61, 0x69, 0x72,
-	0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x12, 0x56, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x96, 0x01, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0xa2,
+	0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x65, 0x70, 0xca, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x5c, 0x50, 0x65, 0x70, 0xe2, 0x02, 0x19, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x5c, 0x50, 0x65, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x50,
+	0x65, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1481,6 +1632,7 @@ var file_fairyring_pep_genesis_proto_goTypes = []interface{}{
 	(*common.ActivePublicKey)(nil), // 5: fairyring.common.ActivePublicKey
 	(*common.QueuedPublicKey)(nil), // 6: fairyring.common.QueuedPublicKey
 	(*RequestId)(nil),              // 7: fairyring.pep.RequestId
+	(*EncryptedTxQueueEntry)(nil),  // 8: fairyring.pep.EncryptedTxQueueEntry
 }
 var file_fairyring_pep_genesis_proto_depIdxs = []int32{
 	1, // 0: fairyring.pep.GenesisState.params:type_name -> fairyring.pep.Params
@@ -1490,11 +1642,12 @@ var file_fairyring_pep_genesis_proto_depIdxs = []int32{
 	5, // 4: fairyring.pep.GenesisState.activePubKey:type_name -> fairyring.common.ActivePublicKey
 	6, // 5: fairyring.pep.GenesisState.queuedPubKey:type_name -> fairyring.common.QueuedPublicKey
 	7, // 6: fairyring.pep.GenesisState.requestIdList:type_name -> fairyring.pep.RequestId
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	8, // 7: fairyring.pep.GenesisState.encryptedTxQueue:type_name -> fairyring.pep.EncryptedTxQueueEntry
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_fairyring_pep_genesis_proto_init() }
@@ -112,14 +112,74 @@ func (x *_Params_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]*LaneParams
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LaneParams)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LaneParams)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	v := new(LaneParams)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := new(LaneParams)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                         protoreflect.MessageDescriptor
-	fd_Params_keyshare_channel_id     protoreflect.FieldDescriptor
-	fd_Params_is_source_chain         protoreflect.FieldDescriptor
-	fd_Params_trusted_counter_parties protoreflect.FieldDescriptor
-	fd_Params_trusted_addresses       protoreflect.FieldDescriptor
-	fd_Params_min_gas_price           protoreflect.FieldDescriptor
-	fd_Params_private_keyshare_price  protoreflect.FieldDescriptor
+	md_Params                                       protoreflect.MessageDescriptor
+	fd_Params_keyshare_channel_id                   protoreflect.FieldDescriptor
+	fd_Params_is_source_chain                       protoreflect.FieldDescriptor
+	fd_Params_trusted_counter_parties               protoreflect.FieldDescriptor
+	fd_Params_trusted_addresses                     protoreflect.FieldDescriptor
+	fd_Params_min_gas_price                         protoreflect.FieldDescriptor
+	fd_Params_private_keyshare_price                protoreflect.FieldDescriptor
+	fd_Params_max_encrypted_tx_gas_per_block        protoreflect.FieldDescriptor
+	fd_Params_max_encrypted_tx_per_block            protoreflect.FieldDescriptor
+	fd_Params_expired_tx_refund_fraction            protoreflect.FieldDescriptor
+	fd_Params_encrypted_tx_retention_blocks         protoreflect.FieldDescriptor
+	fd_Params_aggregated_key_share_retention_blocks protoreflect.FieldDescriptor
+	fd_Params_max_pruned_entries_per_block          protoreflect.FieldDescriptor
+	fd_Params_keyshare_reward_ratio                 protoreflect.FieldDescriptor
+	fd_Params_lanes                                 protoreflect.FieldDescriptor
+	fd_Params_encrypted_tx_ordering                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -131,6 +191,15 @@ func init() {
 	fd_Params_trusted_addresses = md_Params.Fields().ByName("trusted_addresses")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_private_keyshare_price = md_Params.Fields().ByName("private_keyshare_price")
+	fd_Params_max_encrypted_tx_gas_per_block = md_Params.Fields().ByName("max_encrypted_tx_gas_per_block")
+	fd_Params_max_encrypted_tx_per_block = md_Params.Fields().ByName("max_encrypted_tx_per_block")
+	fd_Params_expired_tx_refund_fraction = md_Params.Fields().ByName("expired_tx_refund_fraction")
+	fd_Params_encrypted_tx_retention_blocks = md_Params.Fields().ByName("encrypted_tx_retention_blocks")
+	fd_Params_aggregated_key_share_retention_blocks = md_Params.Fields().ByName("aggregated_key_share_retention_blocks")
+	fd_Params_max_pruned_entries_per_block = md_Params.Fields().ByName("max_pruned_entries_per_block")
+	fd_Params_keyshare_reward_ratio = md_Params.Fields().ByName("keyshare_reward_ratio")
+	fd_Params_lanes = md_Params.Fields().ByName("lanes")
+	fd_Params_encrypted_tx_ordering = md_Params.Fields().ByName("encrypted_tx_ordering")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -234,6 +303,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxEncryptedTxGasPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxEncryptedTxGasPerBlock)
+		if !f(fd_Params_max_encrypted_tx_gas_per_block, value) {
+			return
+		}
+	}
+	if x.MaxEncryptedTxPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxEncryptedTxPerBlock)
+		if !f(fd_Params_max_encrypted_tx_per_block, value) {
+			return
+		}
+	}
+	if len(x.ExpiredTxRefundFraction) != 0 {
+		value := protoreflect.ValueOfBytes(x.ExpiredTxRefundFraction)
+		if !f(fd_Params_expired_tx_refund_fraction, value) {
+			return
+		}
+	}
+	if x.EncryptedTxRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EncryptedTxRetentionBlocks)
+		if !f(fd_Params_encrypted_tx_retention_blocks, value) {
+			return
+		}
+	}
+	if x.AggregatedKeyShareRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AggregatedKeyShareRetentionBlocks)
+		if !f(fd_Params_aggregated_key_share_retention_blocks, value) {
+			return
+		}
+	}
+	if x.MaxPrunedEntriesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedEntriesPerBlock)
+		if !f(fd_Params_max_pruned_entries_per_block, value) {
+			return
+		}
+	}
+	if len(x.KeyshareRewardRatio) != 0 {
+		value := protoreflect.ValueOfBytes(x.KeyshareRewardRatio)
+		if !f(fd_Params_keyshare_reward_ratio, value) {
+			return
+		}
+	}
+	if len(x.Lanes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.Lanes})
+		if !f(fd_Params_lanes, value) {
+			return
+		}
+	}
+	if x.EncryptedTxOrdering != "" {
+		value := protoreflect.ValueOfString(x.EncryptedTxOrdering)
+		if !f(fd_Params_encrypted_tx_ordering, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -261,6 +384,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != nil
 	case "fairyring.pep.Params.private_keyshare_price":
 		return x.PrivateKeysharePrice != nil
+	case "fairyring.pep.Params.max_encrypted_tx_gas_per_block":
+		return x.MaxEncryptedTxGasPerBlock != uint64(0)
+	case "fairyring.pep.Params.max_encrypted_tx_per_block":
+		return x.MaxEncryptedTxPerBlock != uint64(0)
+	case "fairyring.pep.Params.expired_tx_refund_fraction":
+		return len(x.ExpiredTxRefundFraction) != 0
+	case "fairyring.pep.Params.encrypted_tx_retention_blocks":
+		return x.EncryptedTxRetentionBlocks != uint64(0)
+	case "fairyring.pep.Params.aggregated_key_share_retention_blocks":
+		return x.AggregatedKeyShareRetentionBlocks != uint64(0)
+	case "fairyring.pep.Params.max_pruned_entries_per_block":
+		return x.MaxPrunedEntriesPerBlock != uint64(0)
+	case "fairyring.pep.Params.keyshare_reward_ratio":
+		return len(x.KeyshareRewardRatio) != 0
+	case "fairyring.pep.Params.lanes":
+		return len(x.Lanes) != 0
+	case "fairyring.pep.Params.encrypted_tx_ordering":
+		return x.EncryptedTxOrdering != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.Params"))
@@ -289,6 +430,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = nil
 	case "fairyring.pep.Params.private_keyshare_price":
 		x.PrivateKeysharePrice = nil
+	case "fairyring.pep.Params.max_encrypted_tx_gas_per_block":
+		x.MaxEncryptedTxGasPerBlock = uint64(0)
+	case "fairyring.pep.Params.max_encrypted_tx_per_block":
+		x.MaxEncryptedTxPerBlock = uint64(0)
+	case "fairyring.pep.Params.expired_tx_refund_fraction":
+		x.ExpiredTxRefundFraction = nil
+	case "fairyring.pep.Params.encrypted_tx_retention_blocks":
+		x.EncryptedTxRetentionBlocks = uint64(0)
+	case "fairyring.pep.Params.aggregated_key_share_retention_blocks":
+		x.AggregatedKeyShareRetentionBlocks = uint64(0)
+	case "fairyring.pep.Params.max_pruned_entries_per_block":
+		x.MaxPrunedEntriesPerBlock = uint64(0)
+	case "fairyring.pep.Params.keyshare_reward_ratio":
+		x.KeyshareRewardRatio = nil
+	case "fairyring.pep.Params.lanes":
+		x.Lanes = nil
+	case "fairyring.pep.Params.encrypted_tx_ordering":
+		x.EncryptedTxOrdering = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.Params"))
@@ -329,6 +488,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "fairyring.pep.Params.private_keyshare_price":
 		value := x.PrivateKeysharePrice
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.Params.max_encrypted_tx_gas_per_block":
+		value := x.MaxEncryptedTxGasPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.Params.max_encrypted_tx_per_block":
+		value := x.MaxEncryptedTxPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.Params.expired_tx_refund_fraction":
+		value := x.ExpiredTxRefundFraction
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.pep.Params.encrypted_tx_retention_blocks":
+		value := x.EncryptedTxRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.Params.aggregated_key_share_retention_blocks":
+		value := x.AggregatedKeyShareRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.Params.max_pruned_entries_per_block":
+		value := x.MaxPrunedEntriesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.Params.keyshare_reward_ratio":
+		value := x.KeyshareRewardRatio
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.pep.Params.lanes":
+		if len(x.Lanes) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.Lanes}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.pep.Params.encrypted_tx_ordering":
+		value := x.EncryptedTxOrdering
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.Params"))
@@ -365,6 +554,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Message().Interface().(*v1beta1.Coin)
 	case "fairyring.pep.Params.private_keyshare_price":
 		x.PrivateKeysharePrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fairyring.pep.Params.max_encrypted_tx_gas_per_block":
+		x.MaxEncryptedTxGasPerBlock = value.Uint()
+	case "fairyring.pep.Params.max_encrypted_tx_per_block":
+		x.MaxEncryptedTxPerBlock = value.Uint()
+	case "fairyring.pep.Params.expired_tx_refund_fraction":
+		x.ExpiredTxRefundFraction = value.Bytes()
+	case "fairyring.pep.Params.encrypted_tx_retention_blocks":
+		x.EncryptedTxRetentionBlocks = value.Uint()
+	case "fairyring.pep.Params.aggregated_key_share_retention_blocks":
+		x.AggregatedKeyShareRetentionBlocks = value.Uint()
+	case "fairyring.pep.Params.max_pruned_entries_per_block":
+		x.MaxPrunedEntriesPerBlock = value.Uint()
+	case "fairyring.pep.Params.keyshare_reward_ratio":
+		x.KeyshareRewardRatio = value.Bytes()
+	case "fairyring.pep.Params.lanes":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.Lanes = *clv.list
+	case "fairyring.pep.Params.encrypted_tx_ordering":
+		x.EncryptedTxOrdering = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.Params"))
@@ -407,10 +616,32 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.PrivateKeysharePrice = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.PrivateKeysharePrice.ProtoReflect())
+	case "fairyring.pep.Params.lanes":
+		if x.Lanes == nil {
+			x.Lanes = []*LaneParams{}
+		}
+		value := &_Params_16_list{list: &x.Lanes}
+		return protoreflect.ValueOfList(value)
 	case "fairyring.pep.Params.keyshare_channel_id":
 		panic(fmt.Errorf("field keyshare_channel_id of message fairyring.pep.Params is not mutable"))
 	case "fairyring.pep.Params.is_source_chain":
 		panic(fmt.Errorf("field is_source_chain of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.max_encrypted_tx_gas_per_block":
+		panic(fmt.Errorf("field max_encrypted_tx_gas_per_block of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.max_encrypted_tx_per_block":
+		panic(fmt.Errorf("field max_encrypted_tx_per_block of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.expired_tx_refund_fraction":
+		panic(fmt.Errorf("field expired_tx_refund_fraction of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.encrypted_tx_retention_blocks":
+		panic(fmt.Errorf("field encrypted_tx_retention_blocks of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.aggregated_key_share_retention_blocks":
+		panic(fmt.Errorf("field aggregated_key_share_retention_blocks of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.max_pruned_entries_per_block":
+		panic(fmt.Errorf("field max_pruned_entries_per_block of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.keyshare_reward_ratio":
+		panic(fmt.Errorf("field keyshare_reward_ratio of message fairyring.pep.Params is not mutable"))
+	case "fairyring.pep.Params.encrypted_tx_ordering":
+		panic(fmt.Errorf("field encrypted_tx_ordering of message fairyring.pep.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.Params"))
@@ -440,6 +671,25 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "fairyring.pep.Params.private_keyshare_price":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.Params.max_encrypted_tx_gas_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.Params.max_encrypted_tx_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.Params.expired_tx_refund_fraction":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.pep.Params.encrypted_tx_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.Params.aggregated_key_share_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.Params.max_pruned_entries_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.Params.keyshare_reward_ratio":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.pep.Params.lanes":
+		list := []*LaneParams{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "fairyring.pep.Params.encrypted_tx_ordering":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.Params"))
@@ -536,6 +786,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.PrivateKeysharePrice)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxEncryptedTxGasPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxEncryptedTxGasPerBlock))
+		}
+		if x.MaxEncryptedTxPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxEncryptedTxPerBlock))
+		}
+		l = len(x.ExpiredTxRefundFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EncryptedTxRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.EncryptedTxRetentionBlocks))
+		}
+		if x.AggregatedKeyShareRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregatedKeyShareRetentionBlocks))
+		}
+		if x.MaxPrunedEntriesPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrunedEntriesPerBlock))
+		}
+		l = len(x.KeyshareRewardRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Lanes) > 0 {
+			for _, e := range x.Lanes {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.EncryptedTxOrdering)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -565,6 +848,72 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EncryptedTxOrdering) > 0 {
+			i -= len(x.EncryptedTxOrdering)
+			copy(dAtA[i:], x.EncryptedTxOrdering)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncryptedTxOrdering)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.Lanes) > 0 {
+			for iNdEx := len(x.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Lanes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.KeyshareRewardRatio) > 0 {
+			i -= len(x.KeyshareRewardRatio)
+			copy(dAtA[i:], x.KeyshareRewardRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyshareRewardRatio)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.MaxPrunedEntriesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedEntriesPerBlock))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.AggregatedKeyShareRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregatedKeyShareRetentionBlocks))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.EncryptedTxRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EncryptedTxRetentionBlocks))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.ExpiredTxRefundFraction) > 0 {
+			i -= len(x.ExpiredTxRefundFraction)
+			copy(dAtA[i:], x.ExpiredTxRefundFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpiredTxRefundFraction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.MaxEncryptedTxPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEncryptedTxPerBlock))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxEncryptedTxGasPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEncryptedTxGasPerBlock))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.PrivateKeysharePrice != nil {
 			encoded, err := options.Marshal(x.PrivateKeysharePrice)
 			if err != nil {
@@ -874,113 +1223,890 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedTxGasPerBlock", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.MaxEncryptedTxGasPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxEncryptedTxGasPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedTxPerBlock", wireType)
+				}
+				x.MaxEncryptedTxPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxEncryptedTxPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiredTxRefundFraction", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ExpiredTxRefundFraction = append(x.ExpiredTxRefundFraction[:0], dAtA[iNdEx:postIndex]...)
+				if x.ExpiredTxRefundFraction == nil {
+					x.ExpiredTxRefundFraction = []byte{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_TrustedCounterParty               protoreflect.MessageDescriptor
-	fd_TrustedCounterParty_client_id     protoreflect.FieldDescriptor
-	fd_TrustedCounterParty_connection_id protoreflect.FieldDescriptor
-	fd_TrustedCounterParty_channel_id    protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_fairyring_pep_params_proto_init()
-	md_TrustedCounterParty = File_fairyring_pep_params_proto.Messages().ByName("TrustedCounterParty")
-	fd_TrustedCounterParty_client_id = md_TrustedCounterParty.Fields().ByName("client_id")
-	fd_TrustedCounterParty_connection_id = md_TrustedCounterParty.Fields().ByName("connection_id")
-	fd_TrustedCounterParty_channel_id = md_TrustedCounterParty.Fields().ByName("channel_id")
-}
-
-var _ protoreflect.Message = (*fastReflection_TrustedCounterParty)(nil)
-
-type fastReflection_TrustedCounterParty TrustedCounterParty
-
-func (x *TrustedCounterParty) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TrustedCounterParty)(x)
-}
-
-func (x *TrustedCounterParty) slowProtoReflect() protoreflect.Message {
-	mi := &file_fairyring_pep_params_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_TrustedCounterParty_messageType fastReflection_TrustedCounterParty_messageType
-var _ protoreflect.MessageType = fastReflection_TrustedCounterParty_messageType{}
-
-type fastReflection_TrustedCounterParty_messageType struct{}
-
-func (x fastReflection_TrustedCounterParty_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TrustedCounterParty)(nil)
-}
-func (x fastReflection_TrustedCounterParty_messageType) New() protoreflect.Message {
-	return new(fastReflection_TrustedCounterParty)
-}
-func (x fastReflection_TrustedCounterParty_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TrustedCounterParty
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_TrustedCounterParty) Descriptor() protoreflect.MessageDescriptor {
-	return md_TrustedCounterParty
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TrustedCounterParty) Type() protoreflect.MessageType {
-	return _fastReflection_TrustedCounterParty_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxRetentionBlocks", wireType)
+				}
+				x.EncryptedTxRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EncryptedTxRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareRetentionBlocks", wireType)
+				}
+				x.AggregatedKeyShareRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregatedKeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedEntriesPerBlock", wireType)
+				}
+				x.MaxPrunedEntriesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunedEntriesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyshareRewardRatio", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyshareRewardRatio = append(x.KeyshareRewardRatio[:0], dAtA[iNdEx:postIndex]...)
+				if x.KeyshareRewardRatio == nil {
+					x.KeyshareRewardRatio = []byte{}
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lanes = append(x.Lanes, &LaneParams{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Lanes[len(x.Lanes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxOrdering", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedTxOrdering = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TrustedCounterParty               protoreflect.MessageDescriptor
+	fd_TrustedCounterParty_client_id     protoreflect.FieldDescriptor
+	fd_TrustedCounterParty_connection_id protoreflect.FieldDescriptor
+	fd_TrustedCounterParty_channel_id    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_params_proto_init()
+	md_TrustedCounterParty = File_fairyring_pep_params_proto.Messages().ByName("TrustedCounterParty")
+	fd_TrustedCounterParty_client_id = md_TrustedCounterParty.Fields().ByName("client_id")
+	fd_TrustedCounterParty_connection_id = md_TrustedCounterParty.Fields().ByName("connection_id")
+	fd_TrustedCounterParty_channel_id = md_TrustedCounterParty.Fields().ByName("channel_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrustedCounterParty)(nil)
+
+type fastReflection_TrustedCounterParty TrustedCounterParty
+
+func (x *TrustedCounterParty) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrustedCounterParty)(x)
+}
+
+func (x *TrustedCounterParty) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrustedCounterParty_messageType fastReflection_TrustedCounterParty_messageType
+var _ protoreflect.MessageType = fastReflection_TrustedCounterParty_messageType{}
+
+type fastReflection_TrustedCounterParty_messageType struct{}
+
+func (x fastReflection_TrustedCounterParty_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrustedCounterParty)(nil)
+}
+func (x fastReflection_TrustedCounterParty_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrustedCounterParty)
+}
+func (x fastReflection_TrustedCounterParty_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrustedCounterParty
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrustedCounterParty) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrustedCounterParty
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrustedCounterParty) Type() protoreflect.MessageType {
+	return _fastReflection_TrustedCounterParty_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
 func (x *fastReflection_TrustedCounterParty) New() protoreflect.Message {
 	return new(fastReflection_TrustedCounterParty)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TrustedCounterParty) Interface() protoreflect.ProtoMessage {
-	return (*TrustedCounterParty)(x)
+func (x *fastReflection_TrustedCounterParty) Interface() protoreflect.ProtoMessage {
+	return (*TrustedCounterParty)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrustedCounterParty) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClientId != "" {
+		value := protoreflect.ValueOfString(x.ClientId)
+		if !f(fd_TrustedCounterParty_client_id, value) {
+			return
+		}
+	}
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_TrustedCounterParty_connection_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_TrustedCounterParty_channel_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrustedCounterParty) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.TrustedCounterParty.client_id":
+		return x.ClientId != ""
+	case "fairyring.pep.TrustedCounterParty.connection_id":
+		return x.ConnectionId != ""
+	case "fairyring.pep.TrustedCounterParty.channel_id":
+		return x.ChannelId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedCounterParty) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.TrustedCounterParty.client_id":
+		x.ClientId = ""
+	case "fairyring.pep.TrustedCounterParty.connection_id":
+		x.ConnectionId = ""
+	case "fairyring.pep.TrustedCounterParty.channel_id":
+		x.ChannelId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrustedCounterParty) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.TrustedCounterParty.client_id":
+		value := x.ClientId
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.TrustedCounterParty.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.TrustedCounterParty.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedCounterParty) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.TrustedCounterParty.client_id":
+		x.ClientId = value.Interface().(string)
+	case "fairyring.pep.TrustedCounterParty.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "fairyring.pep.TrustedCounterParty.channel_id":
+		x.ChannelId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedCounterParty) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.TrustedCounterParty.client_id":
+		panic(fmt.Errorf("field client_id of message fairyring.pep.TrustedCounterParty is not mutable"))
+	case "fairyring.pep.TrustedCounterParty.connection_id":
+		panic(fmt.Errorf("field connection_id of message fairyring.pep.TrustedCounterParty is not mutable"))
+	case "fairyring.pep.TrustedCounterParty.channel_id":
+		panic(fmt.Errorf("field channel_id of message fairyring.pep.TrustedCounterParty is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrustedCounterParty) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.TrustedCounterParty.client_id":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.TrustedCounterParty.connection_id":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.TrustedCounterParty.channel_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrustedCounterParty) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.TrustedCounterParty", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrustedCounterParty) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustedCounterParty) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrustedCounterParty) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrustedCounterParty)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrustedCounterParty)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClientId) > 0 {
+			i -= len(x.ClientId)
+			copy(dAtA[i:], x.ClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrustedCounterParty)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedCounterParty: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedCounterParty: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LaneParams                 protoreflect.MessageDescriptor
+	fd_LaneParams_name            protoreflect.FieldDescriptor
+	fd_LaneParams_max_block_space protoreflect.FieldDescriptor
+	fd_LaneParams_max_txs         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_params_proto_init()
+	md_LaneParams = File_fairyring_pep_params_proto.Messages().ByName("LaneParams")
+	fd_LaneParams_name = md_LaneParams.Fields().ByName("name")
+	fd_LaneParams_max_block_space = md_LaneParams.Fields().ByName("max_block_space")
+	fd_LaneParams_max_txs = md_LaneParams.Fields().ByName("max_txs")
+}
+
+var _ protoreflect.Message = (*fastReflection_LaneParams)(nil)
+
+type fastReflection_LaneParams LaneParams
+
+func (x *LaneParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LaneParams)(x)
+}
+
+func (x *LaneParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LaneParams_messageType fastReflection_LaneParams_messageType
+var _ protoreflect.MessageType = fastReflection_LaneParams_messageType{}
+
+type fastReflection_LaneParams_messageType struct{}
+
+func (x fastReflection_LaneParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LaneParams)(nil)
+}
+func (x fastReflection_LaneParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_LaneParams)
+}
+func (x fastReflection_LaneParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LaneParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LaneParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_LaneParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LaneParams) Type() protoreflect.MessageType {
+	return _fastReflection_LaneParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LaneParams) New() protoreflect.Message {
+	return new(fastReflection_LaneParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LaneParams) Interface() protoreflect.ProtoMessage {
+	return (*LaneParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -988,22 +2114,22 @@ func (x *fastReflection_TrustedCounterParty) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TrustedCounterParty) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClientId != "" {
-		value := protoreflect.ValueOfString(x.ClientId)
-		if !f(fd_TrustedCounterParty_client_id, value) {
+func (x *fastReflection_LaneParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_LaneParams_name, value) {
 			return
 		}
 	}
-	if x.ConnectionId != "" {
-		value := protoreflect.ValueOfString(x.ConnectionId)
-		if !f(fd_TrustedCounterParty_connection_id, value) {
+	if len(x.MaxBlockSpace) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxBlockSpace)
+		if !f(fd_LaneParams_max_block_space, value) {
 			return
 		}
 	}
-	if x.ChannelId != "" {
-		value := protoreflect.ValueOfString(x.ChannelId)
-		if !f(fd_TrustedCounterParty_channel_id, value) {
+	if x.MaxTxs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTxs)
+		if !f(fd_LaneParams_max_txs, value) {
 			return
 		}
 	}
@@ -1020,19 +2146,19 @@ func (x *fastReflection_TrustedCounterParty) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TrustedCounterParty) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LaneParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "fairyring.pep.TrustedCounterParty.client_id":
-		return x.ClientId != ""
-	case "fairyring.pep.TrustedCounterParty.connection_id":
-		return x.ConnectionId != ""
-	case "fairyring.pep.TrustedCounterParty.channel_id":
-		return x.ChannelId != ""
+	case "fairyring.pep.LaneParams.name":
+		return x.Name != ""
+	case "fairyring.pep.LaneParams.max_block_space":
+		return len(x.MaxBlockSpace) != 0
+	case "fairyring.pep.LaneParams.max_txs":
+		return x.MaxTxs != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.LaneParams"))
 		}
-		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fairyring.pep.LaneParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1042,19 +2168,19 @@ func (x *fastReflection_TrustedCounterParty) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedCounterParty) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LaneParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "fairyring.pep.TrustedCounterParty.client_id":
-		x.ClientId = ""
-	case "fairyring.pep.TrustedCounterParty.connection_id":
-		x.ConnectionId = ""
-	case "fairyring.pep.TrustedCounterParty.channel_id":
-		x.ChannelId = ""
+	case "fairyring.pep.LaneParams.name":
+		x.Name = ""
+	case "fairyring.pep.LaneParams.max_block_space":
+		x.MaxBlockSpace = nil
+	case "fairyring.pep.LaneParams.max_txs":
+		x.MaxTxs = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.LaneParams"))
 		}
-		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fairyring.pep.LaneParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1064,22 +2190,22 @@ func (x *fastReflection_TrustedCounterParty) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TrustedCounterParty) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LaneParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "fairyring.pep.TrustedCounterParty.client_id":
-		value := x.ClientId
-		return protoreflect.ValueOfString(value)
-	case "fairyring.pep.TrustedCounterParty.connection_id":
-		value := x.ConnectionId
-		return protoreflect.ValueOfString(value)
-	case "fairyring.pep.TrustedCounterParty.channel_id":
-		value := x.ChannelId
+	case "fairyring.pep.LaneParams.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.LaneParams.max_block_space":
+		value := x.MaxBlockSpace
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.pep.LaneParams.max_txs":
+		value := x.MaxTxs
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.LaneParams"))
 		}
-		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message fairyring.pep.LaneParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1093,19 +2219,19 @@ func (x *fastReflection_TrustedCounterParty) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedCounterParty) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LaneParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "fairyring.pep.TrustedCounterParty.client_id":
-		x.ClientId = value.Interface().(string)
-	case "fairyring.pep.TrustedCounterParty.connection_id":
-		x.ConnectionId = value.Interface().(string)
-	case "fairyring.pep.TrustedCounterParty.channel_id":
-		x.ChannelId = value.Interface().(string)
+	case "fairyring.pep.LaneParams.name":
+		x.Name = value.Interface().(string)
+	case "fairyring.pep.LaneParams.max_block_space":
+		x.MaxBlockSpace = value.Bytes()
+	case "fairyring.pep.LaneParams.max_txs":
+		x.MaxTxs = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.LaneParams"))
 		}
-		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fairyring.pep.LaneParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1119,48 +2245,48 @@ func (x *fastReflection_TrustedCounterParty) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedCounterParty) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LaneParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fairyring.pep.TrustedCounterParty.client_id":
-		panic(fmt.Errorf("field client_id of message fairyring.pep.TrustedCounterParty is not mutable"))
-	case "fairyring.pep.TrustedCounterParty.connection_id":
-		panic(fmt.Errorf("field connection_id of message fairyring.pep.TrustedCounterParty is not mutable"))
-	case "fairyring.pep.TrustedCounterParty.channel_id":
-		panic(fmt.Errorf("field channel_id of message fairyring.pep.TrustedCounterParty is not mutable"))
+	case "fairyring.pep.LaneParams.name":
+		panic(fmt.Errorf("field name of message fairyring.pep.LaneParams is not mutable"))
+	case "fairyring.pep.LaneParams.max_block_space":
+		panic(fmt.Errorf("field max_block_space of message fairyring.pep.LaneParams is not mutable"))
+	case "fairyring.pep.LaneParams.max_txs":
+		panic(fmt.Errorf("field max_txs of message fairyring.pep.LaneParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.LaneParams"))
 		}
-		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fairyring.pep.LaneParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TrustedCounterParty) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LaneParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "fairyring.pep.TrustedCounterParty.client_id":
-		return protoreflect.ValueOfString("")
-	case "fairyring.pep.TrustedCounterParty.connection_id":
-		return protoreflect.ValueOfString("")
-	case "fairyring.pep.TrustedCounterParty.channel_id":
+	case "fairyring.pep.LaneParams.name":
 		return protoreflect.ValueOfString("")
+	case "fairyring.pep.LaneParams.max_block_space":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.pep.LaneParams.max_txs":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.TrustedCounterParty"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.LaneParams"))
 		}
-		panic(fmt.Errorf("message fairyring.pep.TrustedCounterParty does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message fairyring.pep.LaneParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TrustedCounterParty) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LaneParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.TrustedCounterParty", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.LaneParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1168,7 +2294,7 @@ func (x *fastReflection_TrustedCounterParty) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TrustedCounterParty) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LaneParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1179,7 +2305,7 @@ func (x *fastReflection_TrustedCounterParty) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TrustedCounterParty) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LaneParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1191,7 +2317,7 @@ func (x *fastReflection_TrustedCounterParty) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TrustedCounterParty) IsValid() bool {
+func (x *fastReflection_LaneParams) IsValid() bool {
 	return x != nil
 }
 
@@ -1201,9 +2327,9 @@ func (x *fastReflection_TrustedCounterParty) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LaneParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TrustedCounterParty)
+		x := input.Message.Interface().(*LaneParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1215,17 +2341,16 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClientId)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ConnectionId)
+		l = len(x.MaxBlockSpace)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ChannelId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MaxTxs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTxs))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1237,7 +2362,7 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TrustedCounterParty)
+		x := input.Message.Interface().(*LaneParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1256,24 +2381,22 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ChannelId) > 0 {
-			i -= len(x.ChannelId)
-			copy(dAtA[i:], x.ChannelId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+		if x.MaxTxs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxs))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.ConnectionId) > 0 {
-			i -= len(x.ConnectionId)
-			copy(dAtA[i:], x.ConnectionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+		if len(x.MaxBlockSpace) > 0 {
+			i -= len(x.MaxBlockSpace)
+			copy(dAtA[i:], x.MaxBlockSpace)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBlockSpace)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ClientId) > 0 {
-			i -= len(x.ClientId)
-			copy(dAtA[i:], x.ClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientId)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1288,7 +2411,7 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TrustedCounterParty)
+		x := input.Message.Interface().(*LaneParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1320,15 +2443,15 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedCounterParty: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LaneParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustedCounterParty: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LaneParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1356,13 +2479,13 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ClientId = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSpace", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1372,29 +2495,31 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				x.MaxBlockSpace = append(x.MaxBlockSpace[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxBlockSpace == nil {
+					x.MaxBlockSpace = []byte{}
+				}
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
 				}
-				var stringLen uint64
+				x.MaxTxs = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1404,24 +2529,11 @@ func (x *fastReflection_TrustedCounterParty) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.MaxTxs |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ChannelId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1482,6 +2594,27 @@ type Params struct {
 	TrustedAddresses      []string               `protobuf:"bytes,4,rep,name=trusted_addresses,json=trustedAddresses,proto3" json:"trusted_addresses,omitempty"`
 	MinGasPrice           *v1beta1.Coin          `protobuf:"bytes,5,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
 	PrivateKeysharePrice  *v1beta1.Coin          `protobuf:"bytes,6,opt,name=private_keyshare_price,json=privateKeysharePrice,proto3" json:"private_keyshare_price,omitempty"`
+	// max_encrypted_tx_gas_per_block is the maximum gas that encrypted tx execution may use in a single block, 0 means unlimited
+	MaxEncryptedTxGasPerBlock uint64 `protobuf:"varint,7,opt,name=max_encrypted_tx_gas_per_block,json=maxEncryptedTxGasPerBlock,proto3" json:"max_encrypted_tx_gas_per_block,omitempty"`
+	// max_encrypted_tx_per_block is the maximum number of encrypted txs executed in a single block, 0 means unlimited
+	MaxEncryptedTxPerBlock uint64 `protobuf:"varint,8,opt,name=max_encrypted_tx_per_block,json=maxEncryptedTxPerBlock,proto3" json:"max_encrypted_tx_per_block,omitempty"`
+	// expired_tx_refund_fraction is the fraction of the charged gas refunded for encrypted txs
+	// that expired or were discarded because their decryption key was never delivered
+	ExpiredTxRefundFraction []byte `protobuf:"bytes,9,opt,name=expired_tx_refund_fraction,json=expiredTxRefundFraction,proto3" json:"expired_tx_refund_fraction,omitempty"`
+	// number of heights below the last executed height for which encrypted txs are kept, 0 keeps them forever
+	EncryptedTxRetentionBlocks uint64 `protobuf:"varint,10,opt,name=encrypted_tx_retention_blocks,json=encryptedTxRetentionBlocks,proto3" json:"encrypted_tx_retention_blocks,omitempty"`
+	// number of heights below the last executed height for which aggregated keyshares are kept, 0 keeps them forever
+	AggregatedKeyShareRetentionBlocks uint64 `protobuf:"varint,11,opt,name=aggregated_key_share_retention_blocks,json=aggregatedKeyShareRetentionBlocks,proto3" json:"aggregated_key_share_retention_blocks,omitempty"`
+	// maximum number of entries removed from each pruned store per block
+	MaxPrunedEntriesPerBlock uint64 `protobuf:"varint,12,opt,name=max_pruned_entries_per_block,json=maxPrunedEntriesPerBlock,proto3" json:"max_pruned_entries_per_block,omitempty"`
+	// share of the encrypted tx fees and private keyshare fees sent to the keyshare reward pool
+	KeyshareRewardRatio []byte `protobuf:"bytes,13,opt,name=keyshare_reward_ratio,json=keyshareRewardRatio,proto3" json:"keyshare_reward_ratio,omitempty"`
+	// lanes defines the order, block space share and max tx count of the block lanes,
+	// the registered lanes that are not listed keep their default config
+	Lanes []*LaneParams `protobuf:"bytes,16,rep,name=lanes,proto3" json:"lanes,omitempty"`
+	// encrypted_tx_ordering is the order the encrypted txs of a target height are executed in,
+	// "submission" executes them by index and "randomized" permutes them with a seed derived from the aggregated key
+	EncryptedTxOrdering string `protobuf:"bytes,17,opt,name=encrypted_tx_ordering,json=encryptedTxOrdering,proto3" json:"encrypted_tx_ordering,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1546,6 +2679,69 @@ func (x *Params) GetPrivateKeysharePrice() *v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetMaxEncryptedTxGasPerBlock() uint64 {
+	if x != nil {
+		return x.MaxEncryptedTxGasPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxEncryptedTxPerBlock() uint64 {
+	if x != nil {
+		return x.MaxEncryptedTxPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetExpiredTxRefundFraction() []byte {
+	if x != nil {
+		return x.ExpiredTxRefundFraction
+	}
+	return nil
+}
+
+func (x *Params) GetEncryptedTxRetentionBlocks() uint64 {
+	if x != nil {
+		return x.EncryptedTxRetentionBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetAggregatedKeyShareRetentionBlocks() uint64 {
+	if x != nil {
+		return x.AggregatedKeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPrunedEntriesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedEntriesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetKeyshareRewardRatio() []byte {
+	if x != nil {
+		return x.KeyshareRewardRatio
+	}
+	return nil
+}
+
+func (x *Params) GetLanes() []*LaneParams {
+	if x != nil {
+		return x.Lanes
+	}
+	return nil
+}
+
+func (x *Params) GetEncryptedTxOrdering() string {
+	if x != nil {
+		return x.EncryptedTxOrdering
+	}
+	return ""
+}
+
 type TrustedCounterParty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1597,6 +2793,60 @@ func (x *TrustedCounterParty) GetChannelId() string {
 	return ""
 }
 
+// LaneParams defines the block space share and max tx count of a block lane
+type LaneParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// share of the block space the lane can consume, 0 means the block space left by the previous lanes
+	MaxBlockSpace []byte `protobuf:"bytes,2,opt,name=max_block_space,json=maxBlockSpace,proto3" json:"max_block_space,omitempty"`
+	// maximum number of txs the lane includes in a block, 0 means unlimited
+	MaxTxs uint64 `protobuf:"varint,3,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+}
+
+func (x *LaneParams) Reset() {
+	*x = LaneParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LaneParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaneParams) ProtoMessage() {}
+
+// Deprecated: Use LaneParams.ProtoReflect.Descriptor instead.
+func (*LaneParams) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LaneParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LaneParams) GetMaxBlockSpace() []byte {
+	if x != nil {
+		return x.MaxBlockSpace
+	}
+	return nil
+}
+
+func (x *LaneParams) GetMaxTxs() uint64 {
+	if x != nil {
+		return x.MaxTxs
+	}
+	return 0
+}
+
 var File_fairyring_pep_params_proto protoreflect.FileDescriptor
 
 var file_fairyring_pep_params_proto_rawDesc = []byte{
@@ -1607,7 +2857,7 @@ var file_fairyring_pep_params_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
 	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
 	0x4e, 0x0a, 0x13, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
 	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xf2, 0xde,
 	0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
@@ -1640,26 +2890,99 @@ var file_fairyring_pep_params_proto_rawDesc = []byte{
 	0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
 	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
 	0x22, 0x52, 0x14, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x66, 0x61,
-	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x78, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x22, 0x76, 0x0a, 0x13, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x42, 0x95, 0x01, 0x0a,
-	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
-	0x65, 0x70, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65,
-	0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70, 0xca, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0xe2, 0x02, 0x19, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a,
-	0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x29, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x61, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x85, 0x01, 0x0a, 0x1a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x48, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x17, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x54, 0x78, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x6b, 0x0a, 0x1d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x28, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0x52, 0x1a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x82, 0x01,
+	0x0a, 0x25, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30, 0xf2,
+	0xde, 0x1f, 0x2c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52,
+	0x21, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x67, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x77, 0x0a, 0x15, 0x6b,
+	0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x52,
+	0x13, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x41, 0x0a, 0x05, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x65, 0x70, 0x2e, 0x4c, 0x61, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x10,
+	0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x22,
+	0x52, 0x05, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x1b, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x16, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x78, 0x2f,
+	0x70, 0x65, 0x70, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f,
+	0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x22, 0x76, 0x0a, 0x13, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xb4,
+	0x01, 0x0a, 0x0a, 0x4c, 0x61, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x65, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2,
+	0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x73, 0x22, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x54, 0x78, 0x73, 0x42, 0x95, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x42, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69,
+	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58,
+	0xaa, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70,
+	0xca, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70,
+	0xe2, 0x02, 0x19, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1674,21 +2997,23 @@ func file_fairyring_pep_params_proto_rawDescGZIP() []byte {
 	return file_fairyring_pep_params_proto_rawDescData
 }
 
-var file_fairyring_pep_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fairyring_pep_params_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_fairyring_pep_params_proto_goTypes = []interface{}{
 	(*Params)(nil),              // 0: fairyring.pep.Params
 	(*TrustedCounterParty)(nil), // 1: fairyring.pep.TrustedCounterParty
-	(*v1beta1.Coin)(nil),        // 2: cosmos.base.v1beta1.Coin
+	(*LaneParams)(nil),          // 2: fairyring.pep.LaneParams
+	(*v1beta1.Coin)(nil),        // 3: cosmos.base.v1beta1.Coin
 }
 var file_fairyring_pep_params_proto_depIdxs = []int32{
 	1, // 0: fairyring.pep.Params.trusted_counter_parties:type_name -> fairyring.pep.TrustedCounterParty
-	2, // 1: fairyring.pep.Params.min_gas_price:type_name -> cosmos.base.v1beta1.Coin
-	2, // 2: fairyring.pep.Params.private_keyshare_price:type_name -> cosmos.base.v1beta1.Coin
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: fairyring.pep.Params.min_gas_price:type_name -> cosmos.base.v1beta1.Coin
+	3, // 2: fairyring.pep.Params.private_keyshare_price:type_name -> cosmos.base.v1beta1.Coin
+	2, // 3: fairyring.pep.Params.lanes:type_name -> fairyring.pep.LaneParams
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_fairyring_pep_params_proto_init() }
@@ -1721,6 +3046,18 @@ func file_fairyring_pep_params_proto_init() {
 				return nil
 			}
 		}
+		file_fairyring_pep_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LaneParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1728,7 +3065,7 @@ func file_fairyring_pep_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fairyring_pep_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -4,7 +4,7 @@ package pep
 import (
 	_ "cosmossdk.io/api/amino"
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
-	_ "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	common "github.com/Fairblock/fairyring/api/fairyring/common"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -10973,913 +10973,9465 @@ func (x *fastReflection_QueryDecryptDataResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
+var (
+	md_QueryEncryptedTxQueueDepthRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxQueueDepthRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxQueueDepthRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxQueueDepthRequest)(nil)
+
+type fastReflection_QueryEncryptedTxQueueDepthRequest QueryEncryptedTxQueueDepthRequest
+
+func (x *QueryEncryptedTxQueueDepthRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxQueueDepthRequest)(x)
+}
+
+func (x *QueryEncryptedTxQueueDepthRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxQueueDepthRequest_messageType fastReflection_QueryEncryptedTxQueueDepthRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxQueueDepthRequest_messageType{}
+
+type fastReflection_QueryEncryptedTxQueueDepthRequest_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxQueueDepthRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxQueueDepthRequest)(nil)
+}
+func (x fastReflection_QueryEncryptedTxQueueDepthRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxQueueDepthRequest)
+}
+func (x fastReflection_QueryEncryptedTxQueueDepthRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxQueueDepthRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxQueueDepthRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxQueueDepthRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxQueueDepthRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxQueueDepthRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxQueueDepthRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxQueueDepthRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxQueueDepthRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxQueueDepthRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxQueueDepthRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxQueueDepthRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxQueueDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEncryptedTxQueueDepthResponse       protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxQueueDepthResponse_depth protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxQueueDepthResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxQueueDepthResponse")
+	fd_QueryEncryptedTxQueueDepthResponse_depth = md_QueryEncryptedTxQueueDepthResponse.Fields().ByName("depth")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxQueueDepthResponse)(nil)
+
+type fastReflection_QueryEncryptedTxQueueDepthResponse QueryEncryptedTxQueueDepthResponse
+
+func (x *QueryEncryptedTxQueueDepthResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxQueueDepthResponse)(x)
+}
+
+func (x *QueryEncryptedTxQueueDepthResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxQueueDepthResponse_messageType fastReflection_QueryEncryptedTxQueueDepthResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxQueueDepthResponse_messageType{}
+
+type fastReflection_QueryEncryptedTxQueueDepthResponse_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxQueueDepthResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxQueueDepthResponse)(nil)
+}
+func (x fastReflection_QueryEncryptedTxQueueDepthResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxQueueDepthResponse)
+}
+func (x fastReflection_QueryEncryptedTxQueueDepthResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxQueueDepthResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxQueueDepthResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxQueueDepthResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxQueueDepthResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxQueueDepthResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Depth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Depth)
+		if !f(fd_QueryEncryptedTxQueueDepthResponse_depth, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxQueueDepthResponse.depth":
+		return x.Depth != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxQueueDepthResponse.depth":
+		x.Depth = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxQueueDepthResponse.depth":
+		value := x.Depth
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxQueueDepthResponse.depth":
+		x.Depth = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxQueueDepthResponse.depth":
+		panic(fmt.Errorf("field depth of message fairyring.pep.QueryEncryptedTxQueueDepthResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxQueueDepthResponse.depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxQueueDepthResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxQueueDepthResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxQueueDepthResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxQueueDepthResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxQueueDepthResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Depth != 0 {
+			n += 1 + runtime.Sov(uint64(x.Depth))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxQueueDepthResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Depth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Depth))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxQueueDepthResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxQueueDepthResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxQueueDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+				}
+				x.Depth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Depth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOldestRetainedHeightRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryOldestRetainedHeightRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryOldestRetainedHeightRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOldestRetainedHeightRequest)(nil)
+
+type fastReflection_QueryOldestRetainedHeightRequest QueryOldestRetainedHeightRequest
+
+func (x *QueryOldestRetainedHeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOldestRetainedHeightRequest)(x)
+}
+
+func (x *QueryOldestRetainedHeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOldestRetainedHeightRequest_messageType fastReflection_QueryOldestRetainedHeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOldestRetainedHeightRequest_messageType{}
+
+type fastReflection_QueryOldestRetainedHeightRequest_messageType struct{}
+
+func (x fastReflection_QueryOldestRetainedHeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOldestRetainedHeightRequest)(nil)
+}
+func (x fastReflection_QueryOldestRetainedHeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOldestRetainedHeightRequest)
+}
+func (x fastReflection_QueryOldestRetainedHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOldestRetainedHeightRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOldestRetainedHeightRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOldestRetainedHeightRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOldestRetainedHeightRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOldestRetainedHeightRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryOldestRetainedHeightRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOldestRetainedHeightRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOldestRetainedHeightRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOldestRetainedHeightRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOldestRetainedHeightRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOldestRetainedHeightRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOldestRetainedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryOldestRetainedHeightResponse                          protoreflect.MessageDescriptor
+	fd_QueryOldestRetainedHeightResponse_encryptedTxHeight        protoreflect.FieldDescriptor
+	fd_QueryOldestRetainedHeightResponse_aggregatedKeyShareHeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryOldestRetainedHeightResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryOldestRetainedHeightResponse")
+	fd_QueryOldestRetainedHeightResponse_encryptedTxHeight = md_QueryOldestRetainedHeightResponse.Fields().ByName("encryptedTxHeight")
+	fd_QueryOldestRetainedHeightResponse_aggregatedKeyShareHeight = md_QueryOldestRetainedHeightResponse.Fields().ByName("aggregatedKeyShareHeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryOldestRetainedHeightResponse)(nil)
+
+type fastReflection_QueryOldestRetainedHeightResponse QueryOldestRetainedHeightResponse
+
+func (x *QueryOldestRetainedHeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOldestRetainedHeightResponse)(x)
+}
+
+func (x *QueryOldestRetainedHeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryOldestRetainedHeightResponse_messageType fastReflection_QueryOldestRetainedHeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOldestRetainedHeightResponse_messageType{}
+
+type fastReflection_QueryOldestRetainedHeightResponse_messageType struct{}
+
+func (x fastReflection_QueryOldestRetainedHeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOldestRetainedHeightResponse)(nil)
+}
+func (x fastReflection_QueryOldestRetainedHeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOldestRetainedHeightResponse)
+}
+func (x fastReflection_QueryOldestRetainedHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOldestRetainedHeightResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOldestRetainedHeightResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOldestRetainedHeightResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOldestRetainedHeightResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOldestRetainedHeightResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EncryptedTxHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EncryptedTxHeight)
+		if !f(fd_QueryOldestRetainedHeightResponse_encryptedTxHeight, value) {
+			return
+		}
+	}
+	if x.AggregatedKeyShareHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AggregatedKeyShareHeight)
+		if !f(fd_QueryOldestRetainedHeightResponse_aggregatedKeyShareHeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.encryptedTxHeight":
+		return x.EncryptedTxHeight != uint64(0)
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.aggregatedKeyShareHeight":
+		return x.AggregatedKeyShareHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.encryptedTxHeight":
+		x.EncryptedTxHeight = uint64(0)
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.aggregatedKeyShareHeight":
+		x.AggregatedKeyShareHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.encryptedTxHeight":
+		value := x.EncryptedTxHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.aggregatedKeyShareHeight":
+		value := x.AggregatedKeyShareHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.encryptedTxHeight":
+		x.EncryptedTxHeight = value.Uint()
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.aggregatedKeyShareHeight":
+		x.AggregatedKeyShareHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.encryptedTxHeight":
+		panic(fmt.Errorf("field encryptedTxHeight of message fairyring.pep.QueryOldestRetainedHeightResponse is not mutable"))
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.aggregatedKeyShareHeight":
+		panic(fmt.Errorf("field aggregatedKeyShareHeight of message fairyring.pep.QueryOldestRetainedHeightResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.encryptedTxHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QueryOldestRetainedHeightResponse.aggregatedKeyShareHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryOldestRetainedHeightResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryOldestRetainedHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryOldestRetainedHeightResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryOldestRetainedHeightResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryOldestRetainedHeightResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EncryptedTxHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EncryptedTxHeight))
+		}
+		if x.AggregatedKeyShareHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.AggregatedKeyShareHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOldestRetainedHeightResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AggregatedKeyShareHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AggregatedKeyShareHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EncryptedTxHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EncryptedTxHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryOldestRetainedHeightResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOldestRetainedHeightResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOldestRetainedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxHeight", wireType)
+				}
+				x.EncryptedTxHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EncryptedTxHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareHeight", wireType)
+				}
+				x.AggregatedKeyShareHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AggregatedKeyShareHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLanesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryLanesRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryLanesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLanesRequest)(nil)
+
+type fastReflection_QueryLanesRequest QueryLanesRequest
+
+func (x *QueryLanesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLanesRequest)(x)
+}
+
+func (x *QueryLanesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLanesRequest_messageType fastReflection_QueryLanesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLanesRequest_messageType{}
+
+type fastReflection_QueryLanesRequest_messageType struct{}
+
+func (x fastReflection_QueryLanesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLanesRequest)(nil)
+}
+func (x fastReflection_QueryLanesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLanesRequest)
+}
+func (x fastReflection_QueryLanesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLanesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLanesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLanesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLanesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLanesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLanesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLanesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLanesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLanesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLanesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLanesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLanesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLanesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLanesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryLanesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLanesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLanesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLanesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLanesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLanesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLanesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLanesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLanesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryLanesResponse_1_list)(nil)
+
+type _QueryLanesResponse_1_list struct {
+	list *[]*LaneParams
+}
+
+func (x *_QueryLanesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLanesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLanesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LaneParams)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLanesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LaneParams)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLanesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LaneParams)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLanesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLanesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LaneParams)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLanesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryLanesResponse       protoreflect.MessageDescriptor
+	fd_QueryLanesResponse_lanes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryLanesResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryLanesResponse")
+	fd_QueryLanesResponse_lanes = md_QueryLanesResponse.Fields().ByName("lanes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLanesResponse)(nil)
+
+type fastReflection_QueryLanesResponse QueryLanesResponse
+
+func (x *QueryLanesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLanesResponse)(x)
+}
+
+func (x *QueryLanesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLanesResponse_messageType fastReflection_QueryLanesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLanesResponse_messageType{}
+
+type fastReflection_QueryLanesResponse_messageType struct{}
+
+func (x fastReflection_QueryLanesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLanesResponse)(nil)
+}
+func (x fastReflection_QueryLanesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLanesResponse)
+}
+func (x fastReflection_QueryLanesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLanesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLanesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLanesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLanesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLanesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLanesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLanesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLanesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLanesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLanesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Lanes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLanesResponse_1_list{list: &x.Lanes})
+		if !f(fd_QueryLanesResponse_lanes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLanesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryLanesResponse.lanes":
+		return len(x.Lanes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryLanesResponse.lanes":
+		x.Lanes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLanesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryLanesResponse.lanes":
+		if len(x.Lanes) == 0 {
+			return protoreflect.ValueOfList(&_QueryLanesResponse_1_list{})
+		}
+		listValue := &_QueryLanesResponse_1_list{list: &x.Lanes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryLanesResponse.lanes":
+		lv := value.List()
+		clv := lv.(*_QueryLanesResponse_1_list)
+		x.Lanes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryLanesResponse.lanes":
+		if x.Lanes == nil {
+			x.Lanes = []*LaneParams{}
+		}
+		value := &_QueryLanesResponse_1_list{list: &x.Lanes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLanesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryLanesResponse.lanes":
+		list := []*LaneParams{}
+		return protoreflect.ValueOfList(&_QueryLanesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryLanesResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryLanesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLanesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryLanesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLanesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLanesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLanesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLanesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLanesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Lanes) > 0 {
+			for _, e := range x.Lanes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLanesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Lanes) > 0 {
+			for iNdEx := len(x.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Lanes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLanesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLanesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLanesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lanes = append(x.Lanes, &LaneParams{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Lanes[len(x.Lanes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEncryptedTxOrderRequest              protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxOrderRequest_targetHeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxOrderRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxOrderRequest")
+	fd_QueryEncryptedTxOrderRequest_targetHeight = md_QueryEncryptedTxOrderRequest.Fields().ByName("targetHeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxOrderRequest)(nil)
+
+type fastReflection_QueryEncryptedTxOrderRequest QueryEncryptedTxOrderRequest
+
+func (x *QueryEncryptedTxOrderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxOrderRequest)(x)
+}
+
+func (x *QueryEncryptedTxOrderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxOrderRequest_messageType fastReflection_QueryEncryptedTxOrderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxOrderRequest_messageType{}
+
+type fastReflection_QueryEncryptedTxOrderRequest_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxOrderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxOrderRequest)(nil)
+}
+func (x fastReflection_QueryEncryptedTxOrderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxOrderRequest)
+}
+func (x fastReflection_QueryEncryptedTxOrderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxOrderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxOrderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxOrderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxOrderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxOrderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_QueryEncryptedTxOrderRequest_targetHeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderRequest.targetHeight":
+		return x.TargetHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderRequest.targetHeight":
+		x.TargetHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderRequest.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderRequest.targetHeight":
+		x.TargetHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderRequest.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.QueryEncryptedTxOrderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderRequest.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxOrderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxOrderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxOrderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxOrderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxOrderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxOrderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEncryptedTxOrderResponse_2_list)(nil)
+
+type _QueryEncryptedTxOrderResponse_2_list struct {
+	list *[]uint64
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryEncryptedTxOrderResponse at list field RecomputedIndexes as it is not of Message kind"))
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_QueryEncryptedTxOrderResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEncryptedTxOrderResponse                    protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxOrderResponse_order              protoreflect.FieldDescriptor
+	fd_QueryEncryptedTxOrderResponse_recomputed_indexes protoreflect.FieldDescriptor
+	fd_QueryEncryptedTxOrderResponse_valid              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxOrderResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxOrderResponse")
+	fd_QueryEncryptedTxOrderResponse_order = md_QueryEncryptedTxOrderResponse.Fields().ByName("order")
+	fd_QueryEncryptedTxOrderResponse_recomputed_indexes = md_QueryEncryptedTxOrderResponse.Fields().ByName("recomputed_indexes")
+	fd_QueryEncryptedTxOrderResponse_valid = md_QueryEncryptedTxOrderResponse.Fields().ByName("valid")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxOrderResponse)(nil)
+
+type fastReflection_QueryEncryptedTxOrderResponse QueryEncryptedTxOrderResponse
+
+func (x *QueryEncryptedTxOrderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxOrderResponse)(x)
+}
+
+func (x *QueryEncryptedTxOrderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxOrderResponse_messageType fastReflection_QueryEncryptedTxOrderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxOrderResponse_messageType{}
+
+type fastReflection_QueryEncryptedTxOrderResponse_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxOrderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxOrderResponse)(nil)
+}
+func (x fastReflection_QueryEncryptedTxOrderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxOrderResponse)
+}
+func (x fastReflection_QueryEncryptedTxOrderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxOrderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxOrderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxOrderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxOrderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxOrderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Order != nil {
+		value := protoreflect.ValueOfMessage(x.Order.ProtoReflect())
+		if !f(fd_QueryEncryptedTxOrderResponse_order, value) {
+			return
+		}
+	}
+	if len(x.RecomputedIndexes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEncryptedTxOrderResponse_2_list{list: &x.RecomputedIndexes})
+		if !f(fd_QueryEncryptedTxOrderResponse_recomputed_indexes, value) {
+			return
+		}
+	}
+	if x.Valid != false {
+		value := protoreflect.ValueOfBool(x.Valid)
+		if !f(fd_QueryEncryptedTxOrderResponse_valid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.order":
+		return x.Order != nil
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.recomputed_indexes":
+		return len(x.RecomputedIndexes) != 0
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.valid":
+		return x.Valid != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.order":
+		x.Order = nil
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.recomputed_indexes":
+		x.RecomputedIndexes = nil
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.valid":
+		x.Valid = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.order":
+		value := x.Order
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.recomputed_indexes":
+		if len(x.RecomputedIndexes) == 0 {
+			return protoreflect.ValueOfList(&_QueryEncryptedTxOrderResponse_2_list{})
+		}
+		listValue := &_QueryEncryptedTxOrderResponse_2_list{list: &x.RecomputedIndexes}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.valid":
+		value := x.Valid
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.order":
+		x.Order = value.Message().Interface().(*EncryptedTxOrder)
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.recomputed_indexes":
+		lv := value.List()
+		clv := lv.(*_QueryEncryptedTxOrderResponse_2_list)
+		x.RecomputedIndexes = *clv.list
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.valid":
+		x.Valid = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.order":
+		if x.Order == nil {
+			x.Order = new(EncryptedTxOrder)
+		}
+		return protoreflect.ValueOfMessage(x.Order.ProtoReflect())
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.recomputed_indexes":
+		if x.RecomputedIndexes == nil {
+			x.RecomputedIndexes = []uint64{}
+		}
+		value := &_QueryEncryptedTxOrderResponse_2_list{list: &x.RecomputedIndexes}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.valid":
+		panic(fmt.Errorf("field valid of message fairyring.pep.QueryEncryptedTxOrderResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.order":
+		m := new(EncryptedTxOrder)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.recomputed_indexes":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_QueryEncryptedTxOrderResponse_2_list{list: &list})
+	case "fairyring.pep.QueryEncryptedTxOrderResponse.valid":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxOrderResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxOrderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxOrderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxOrderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxOrderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Order != nil {
+			l = options.Size(x.Order)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RecomputedIndexes) > 0 {
+			l = 0
+			for _, e := range x.RecomputedIndexes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Valid {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxOrderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Valid {
+			i--
+			if x.Valid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.RecomputedIndexes) > 0 {
+			var pksize2 int
+			for _, num := range x.RecomputedIndexes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.RecomputedIndexes {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Order != nil {
+			encoded, err := options.Marshal(x.Order)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxOrderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxOrderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Order == nil {
+					x.Order = &EncryptedTxOrder{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Order); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.RecomputedIndexes = append(x.RecomputedIndexes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.RecomputedIndexes) == 0 {
+						x.RecomputedIndexes = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.RecomputedIndexes = append(x.RecomputedIndexes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecomputedIndexes", wireType)
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Valid = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEncryptedTxResultRequest              protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxResultRequest_targetHeight protoreflect.FieldDescriptor
+	fd_QueryEncryptedTxResultRequest_index        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxResultRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxResultRequest")
+	fd_QueryEncryptedTxResultRequest_targetHeight = md_QueryEncryptedTxResultRequest.Fields().ByName("targetHeight")
+	fd_QueryEncryptedTxResultRequest_index = md_QueryEncryptedTxResultRequest.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxResultRequest)(nil)
+
+type fastReflection_QueryEncryptedTxResultRequest QueryEncryptedTxResultRequest
+
+func (x *QueryEncryptedTxResultRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxResultRequest)(x)
+}
+
+func (x *QueryEncryptedTxResultRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxResultRequest_messageType fastReflection_QueryEncryptedTxResultRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxResultRequest_messageType{}
+
+type fastReflection_QueryEncryptedTxResultRequest_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxResultRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxResultRequest)(nil)
+}
+func (x fastReflection_QueryEncryptedTxResultRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxResultRequest)
+}
+func (x fastReflection_QueryEncryptedTxResultRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxResultRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxResultRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxResultRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxResultRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxResultRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxResultRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TargetHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetHeight)
+		if !f(fd_QueryEncryptedTxResultRequest_targetHeight, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_QueryEncryptedTxResultRequest_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultRequest.targetHeight":
+		return x.TargetHeight != uint64(0)
+	case "fairyring.pep.QueryEncryptedTxResultRequest.index":
+		return x.Index != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultRequest.targetHeight":
+		x.TargetHeight = uint64(0)
+	case "fairyring.pep.QueryEncryptedTxResultRequest.index":
+		x.Index = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultRequest.targetHeight":
+		value := x.TargetHeight
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QueryEncryptedTxResultRequest.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultRequest.targetHeight":
+		x.TargetHeight = value.Uint()
+	case "fairyring.pep.QueryEncryptedTxResultRequest.index":
+		x.Index = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultRequest.targetHeight":
+		panic(fmt.Errorf("field targetHeight of message fairyring.pep.QueryEncryptedTxResultRequest is not mutable"))
+	case "fairyring.pep.QueryEncryptedTxResultRequest.index":
+		panic(fmt.Errorf("field index of message fairyring.pep.QueryEncryptedTxResultRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxResultRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultRequest.targetHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QueryEncryptedTxResultRequest.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxResultRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxResultRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxResultRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxResultRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxResultRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxResultRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TargetHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetHeight))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxResultRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TargetHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxResultRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxResultRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+				}
+				x.TargetHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEncryptedTxResultResponse        protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxResultResponse_result protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxResultResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxResultResponse")
+	fd_QueryEncryptedTxResultResponse_result = md_QueryEncryptedTxResultResponse.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxResultResponse)(nil)
+
+type fastReflection_QueryEncryptedTxResultResponse QueryEncryptedTxResultResponse
+
+func (x *QueryEncryptedTxResultResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxResultResponse)(x)
+}
+
+func (x *QueryEncryptedTxResultResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxResultResponse_messageType fastReflection_QueryEncryptedTxResultResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxResultResponse_messageType{}
+
+type fastReflection_QueryEncryptedTxResultResponse_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxResultResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxResultResponse)(nil)
+}
+func (x fastReflection_QueryEncryptedTxResultResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxResultResponse)
+}
+func (x fastReflection_QueryEncryptedTxResultResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxResultResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxResultResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxResultResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxResultResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxResultResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxResultResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_QueryEncryptedTxResultResponse_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultResponse.result":
+		return x.Result != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultResponse.result":
+		x.Result = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultResponse.result":
+		x.Result = value.Message().Interface().(*EncryptedTxExecutionResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultResponse.result":
+		if x.Result == nil {
+			x.Result = new(EncryptedTxExecutionResult)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxResultResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxResultResponse.result":
+		m := new(EncryptedTxExecutionResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxResultResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxResultResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxResultResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxResultResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxResultResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxResultResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxResultResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxResultResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxResultResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxResultResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &EncryptedTxExecutionResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGeneralEncryptedTxResultRequest        protoreflect.MessageDescriptor
+	fd_QueryGeneralEncryptedTxResultRequest_req_id protoreflect.FieldDescriptor
+	fd_QueryGeneralEncryptedTxResultRequest_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryGeneralEncryptedTxResultRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryGeneralEncryptedTxResultRequest")
+	fd_QueryGeneralEncryptedTxResultRequest_req_id = md_QueryGeneralEncryptedTxResultRequest.Fields().ByName("req_id")
+	fd_QueryGeneralEncryptedTxResultRequest_index = md_QueryGeneralEncryptedTxResultRequest.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGeneralEncryptedTxResultRequest)(nil)
+
+type fastReflection_QueryGeneralEncryptedTxResultRequest QueryGeneralEncryptedTxResultRequest
+
+func (x *QueryGeneralEncryptedTxResultRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGeneralEncryptedTxResultRequest)(x)
+}
+
+func (x *QueryGeneralEncryptedTxResultRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGeneralEncryptedTxResultRequest_messageType fastReflection_QueryGeneralEncryptedTxResultRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGeneralEncryptedTxResultRequest_messageType{}
+
+type fastReflection_QueryGeneralEncryptedTxResultRequest_messageType struct{}
+
+func (x fastReflection_QueryGeneralEncryptedTxResultRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGeneralEncryptedTxResultRequest)(nil)
+}
+func (x fastReflection_QueryGeneralEncryptedTxResultRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGeneralEncryptedTxResultRequest)
+}
+func (x fastReflection_QueryGeneralEncryptedTxResultRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGeneralEncryptedTxResultRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGeneralEncryptedTxResultRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGeneralEncryptedTxResultRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGeneralEncryptedTxResultRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGeneralEncryptedTxResultRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReqId != "" {
+		value := protoreflect.ValueOfString(x.ReqId)
+		if !f(fd_QueryGeneralEncryptedTxResultRequest_req_id, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_QueryGeneralEncryptedTxResultRequest_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.req_id":
+		return x.ReqId != ""
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.index":
+		return x.Index != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.req_id":
+		x.ReqId = ""
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.index":
+		x.Index = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.req_id":
+		value := x.ReqId
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.req_id":
+		x.ReqId = value.Interface().(string)
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.index":
+		x.Index = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.req_id":
+		panic(fmt.Errorf("field req_id of message fairyring.pep.QueryGeneralEncryptedTxResultRequest is not mutable"))
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.index":
+		panic(fmt.Errorf("field index of message fairyring.pep.QueryGeneralEncryptedTxResultRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.req_id":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.QueryGeneralEncryptedTxResultRequest.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryGeneralEncryptedTxResultRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGeneralEncryptedTxResultRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGeneralEncryptedTxResultRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ReqId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGeneralEncryptedTxResultRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ReqId) > 0 {
+			i -= len(x.ReqId)
+			copy(dAtA[i:], x.ReqId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReqId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGeneralEncryptedTxResultRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGeneralEncryptedTxResultRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGeneralEncryptedTxResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReqId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGeneralEncryptedTxResultResponse        protoreflect.MessageDescriptor
+	fd_QueryGeneralEncryptedTxResultResponse_result protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryGeneralEncryptedTxResultResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryGeneralEncryptedTxResultResponse")
+	fd_QueryGeneralEncryptedTxResultResponse_result = md_QueryGeneralEncryptedTxResultResponse.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGeneralEncryptedTxResultResponse)(nil)
+
+type fastReflection_QueryGeneralEncryptedTxResultResponse QueryGeneralEncryptedTxResultResponse
+
+func (x *QueryGeneralEncryptedTxResultResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGeneralEncryptedTxResultResponse)(x)
+}
+
+func (x *QueryGeneralEncryptedTxResultResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGeneralEncryptedTxResultResponse_messageType fastReflection_QueryGeneralEncryptedTxResultResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGeneralEncryptedTxResultResponse_messageType{}
+
+type fastReflection_QueryGeneralEncryptedTxResultResponse_messageType struct{}
+
+func (x fastReflection_QueryGeneralEncryptedTxResultResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGeneralEncryptedTxResultResponse)(nil)
+}
+func (x fastReflection_QueryGeneralEncryptedTxResultResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGeneralEncryptedTxResultResponse)
+}
+func (x fastReflection_QueryGeneralEncryptedTxResultResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGeneralEncryptedTxResultResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGeneralEncryptedTxResultResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGeneralEncryptedTxResultResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGeneralEncryptedTxResultResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGeneralEncryptedTxResultResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_QueryGeneralEncryptedTxResultResponse_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultResponse.result":
+		return x.Result != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultResponse.result":
+		x.Result = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultResponse.result":
+		x.Result = value.Message().Interface().(*EncryptedTxExecutionResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultResponse.result":
+		if x.Result == nil {
+			x.Result = new(EncryptedTxExecutionResult)
+		}
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryGeneralEncryptedTxResultResponse.result":
+		m := new(EncryptedTxExecutionResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryGeneralEncryptedTxResultResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryGeneralEncryptedTxResultResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryGeneralEncryptedTxResultResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGeneralEncryptedTxResultResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGeneralEncryptedTxResultResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Result != nil {
+			l = options.Size(x.Result)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGeneralEncryptedTxResultResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGeneralEncryptedTxResultResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGeneralEncryptedTxResultResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGeneralEncryptedTxResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Result == nil {
+					x.Result = &EncryptedTxExecutionResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEncryptedTxByCreatorRequest            protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxByCreatorRequest_creator    protoreflect.FieldDescriptor
+	fd_QueryEncryptedTxByCreatorRequest_status     protoreflect.FieldDescriptor
+	fd_QueryEncryptedTxByCreatorRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxByCreatorRequest = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxByCreatorRequest")
+	fd_QueryEncryptedTxByCreatorRequest_creator = md_QueryEncryptedTxByCreatorRequest.Fields().ByName("creator")
+	fd_QueryEncryptedTxByCreatorRequest_status = md_QueryEncryptedTxByCreatorRequest.Fields().ByName("status")
+	fd_QueryEncryptedTxByCreatorRequest_pagination = md_QueryEncryptedTxByCreatorRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxByCreatorRequest)(nil)
+
+type fastReflection_QueryEncryptedTxByCreatorRequest QueryEncryptedTxByCreatorRequest
+
+func (x *QueryEncryptedTxByCreatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxByCreatorRequest)(x)
+}
+
+func (x *QueryEncryptedTxByCreatorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxByCreatorRequest_messageType fastReflection_QueryEncryptedTxByCreatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxByCreatorRequest_messageType{}
+
+type fastReflection_QueryEncryptedTxByCreatorRequest_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxByCreatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxByCreatorRequest)(nil)
+}
+func (x fastReflection_QueryEncryptedTxByCreatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxByCreatorRequest)
+}
+func (x fastReflection_QueryEncryptedTxByCreatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxByCreatorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxByCreatorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxByCreatorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxByCreatorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxByCreatorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QueryEncryptedTxByCreatorRequest_creator, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_QueryEncryptedTxByCreatorRequest_status, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryEncryptedTxByCreatorRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.creator":
+		return x.Creator != ""
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.status":
+		return x.Status != ""
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.creator":
+		x.Creator = ""
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.status":
+		x.Status = ""
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.status":
+		x.Status = value.Interface().(string)
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.creator":
+		panic(fmt.Errorf("field creator of message fairyring.pep.QueryEncryptedTxByCreatorRequest is not mutable"))
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.status":
+		panic(fmt.Errorf("field status of message fairyring.pep.QueryEncryptedTxByCreatorRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.status":
+		return protoreflect.ValueOfString("")
+	case "fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxByCreatorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxByCreatorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxByCreatorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxByCreatorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxByCreatorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxByCreatorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryEncryptedTxByCreatorResponse_1_list)(nil)
+
+type _QueryEncryptedTxByCreatorResponse_1_list struct {
+	list *[]*EncryptedTxWithStatus
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EncryptedTxWithStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EncryptedTxWithStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(EncryptedTxWithStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) NewElement() protoreflect.Value {
+	v := new(EncryptedTxWithStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEncryptedTxByCreatorResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEncryptedTxByCreatorResponse             protoreflect.MessageDescriptor
+	fd_QueryEncryptedTxByCreatorResponse_encryptedTx protoreflect.FieldDescriptor
+	fd_QueryEncryptedTxByCreatorResponse_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QueryEncryptedTxByCreatorResponse = File_fairyring_pep_query_proto.Messages().ByName("QueryEncryptedTxByCreatorResponse")
+	fd_QueryEncryptedTxByCreatorResponse_encryptedTx = md_QueryEncryptedTxByCreatorResponse.Fields().ByName("encryptedTx")
+	fd_QueryEncryptedTxByCreatorResponse_pagination = md_QueryEncryptedTxByCreatorResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEncryptedTxByCreatorResponse)(nil)
+
+type fastReflection_QueryEncryptedTxByCreatorResponse QueryEncryptedTxByCreatorResponse
+
+func (x *QueryEncryptedTxByCreatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxByCreatorResponse)(x)
+}
+
+func (x *QueryEncryptedTxByCreatorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEncryptedTxByCreatorResponse_messageType fastReflection_QueryEncryptedTxByCreatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEncryptedTxByCreatorResponse_messageType{}
+
+type fastReflection_QueryEncryptedTxByCreatorResponse_messageType struct{}
+
+func (x fastReflection_QueryEncryptedTxByCreatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEncryptedTxByCreatorResponse)(nil)
+}
+func (x fastReflection_QueryEncryptedTxByCreatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxByCreatorResponse)
+}
+func (x fastReflection_QueryEncryptedTxByCreatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxByCreatorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEncryptedTxByCreatorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEncryptedTxByCreatorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEncryptedTxByCreatorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEncryptedTxByCreatorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.EncryptedTx) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEncryptedTxByCreatorResponse_1_list{list: &x.EncryptedTx})
+		if !f(fd_QueryEncryptedTxByCreatorResponse_encryptedTx, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryEncryptedTxByCreatorResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx":
+		return len(x.EncryptedTx) != 0
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx":
+		x.EncryptedTx = nil
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx":
+		if len(x.EncryptedTx) == 0 {
+			return protoreflect.ValueOfList(&_QueryEncryptedTxByCreatorResponse_1_list{})
+		}
+		listValue := &_QueryEncryptedTxByCreatorResponse_1_list{list: &x.EncryptedTx}
+		return protoreflect.ValueOfList(listValue)
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx":
+		lv := value.List()
+		clv := lv.(*_QueryEncryptedTxByCreatorResponse_1_list)
+		x.EncryptedTx = *clv.list
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx":
+		if x.EncryptedTx == nil {
+			x.EncryptedTx = []*EncryptedTxWithStatus{}
+		}
+		value := &_QueryEncryptedTxByCreatorResponse_1_list{list: &x.EncryptedTx}
+		return protoreflect.ValueOfList(value)
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx":
+		list := []*EncryptedTxWithStatus{}
+		return protoreflect.ValueOfList(&_QueryEncryptedTxByCreatorResponse_1_list{list: &list})
+	case "fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QueryEncryptedTxByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QueryEncryptedTxByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QueryEncryptedTxByCreatorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEncryptedTxByCreatorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEncryptedTxByCreatorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.EncryptedTx) > 0 {
+			for _, e := range x.EncryptedTx {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxByCreatorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.EncryptedTx) > 0 {
+			for iNdEx := len(x.EncryptedTx) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EncryptedTx[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEncryptedTxByCreatorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxByCreatorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEncryptedTxByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedTx = append(x.EncryptedTx, &EncryptedTxWithStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EncryptedTx[len(x.EncryptedTx)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateEncryptedTxRequest          protoreflect.MessageDescriptor
+	fd_QuerySimulateEncryptedTxRequest_tx_bytes protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxRequest_identity protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QuerySimulateEncryptedTxRequest = File_fairyring_pep_query_proto.Messages().ByName("QuerySimulateEncryptedTxRequest")
+	fd_QuerySimulateEncryptedTxRequest_tx_bytes = md_QuerySimulateEncryptedTxRequest.Fields().ByName("tx_bytes")
+	fd_QuerySimulateEncryptedTxRequest_identity = md_QuerySimulateEncryptedTxRequest.Fields().ByName("identity")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateEncryptedTxRequest)(nil)
+
+type fastReflection_QuerySimulateEncryptedTxRequest QuerySimulateEncryptedTxRequest
+
+func (x *QuerySimulateEncryptedTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateEncryptedTxRequest)(x)
+}
+
+func (x *QuerySimulateEncryptedTxRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateEncryptedTxRequest_messageType fastReflection_QuerySimulateEncryptedTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateEncryptedTxRequest_messageType{}
+
+type fastReflection_QuerySimulateEncryptedTxRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateEncryptedTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateEncryptedTxRequest)(nil)
+}
+func (x fastReflection_QuerySimulateEncryptedTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateEncryptedTxRequest)
+}
+func (x fastReflection_QuerySimulateEncryptedTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateEncryptedTxRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateEncryptedTxRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateEncryptedTxRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateEncryptedTxRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateEncryptedTxRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_QuerySimulateEncryptedTxRequest_tx_bytes, value) {
+			return
+		}
+	}
+	if x.Identity != "" {
+		value := protoreflect.ValueOfString(x.Identity)
+		if !f(fd_QuerySimulateEncryptedTxRequest_identity, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.tx_bytes":
+		return len(x.TxBytes) != 0
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.identity":
+		return x.Identity != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.tx_bytes":
+		x.TxBytes = nil
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.identity":
+		x.Identity = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.identity":
+		value := x.Identity
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.tx_bytes":
+		x.TxBytes = value.Bytes()
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.identity":
+		x.Identity = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message fairyring.pep.QuerySimulateEncryptedTxRequest is not mutable"))
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.identity":
+		panic(fmt.Errorf("field identity of message fairyring.pep.QuerySimulateEncryptedTxRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "fairyring.pep.QuerySimulateEncryptedTxRequest.identity":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxRequest"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QuerySimulateEncryptedTxRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateEncryptedTxRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateEncryptedTxRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Identity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateEncryptedTxRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Identity) > 0 {
+			i -= len(x.Identity)
+			copy(dAtA[i:], x.Identity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identity)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateEncryptedTxRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateEncryptedTxRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateEncryptedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateEncryptedTxResponse                 protoreflect.MessageDescriptor
+	fd_QuerySimulateEncryptedTxResponse_underlying_gas  protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_decryption_gas  protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_total_gas       protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_gas_limit       protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_ciphertext_size protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_escrow          protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_fee             protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_fee_deducted    protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_fee_refunded    protoreflect.FieldDescriptor
+	fd_QuerySimulateEncryptedTxResponse_out_of_gas      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fairyring_pep_query_proto_init()
+	md_QuerySimulateEncryptedTxResponse = File_fairyring_pep_query_proto.Messages().ByName("QuerySimulateEncryptedTxResponse")
+	fd_QuerySimulateEncryptedTxResponse_underlying_gas = md_QuerySimulateEncryptedTxResponse.Fields().ByName("underlying_gas")
+	fd_QuerySimulateEncryptedTxResponse_decryption_gas = md_QuerySimulateEncryptedTxResponse.Fields().ByName("decryption_gas")
+	fd_QuerySimulateEncryptedTxResponse_total_gas = md_QuerySimulateEncryptedTxResponse.Fields().ByName("total_gas")
+	fd_QuerySimulateEncryptedTxResponse_gas_limit = md_QuerySimulateEncryptedTxResponse.Fields().ByName("gas_limit")
+	fd_QuerySimulateEncryptedTxResponse_ciphertext_size = md_QuerySimulateEncryptedTxResponse.Fields().ByName("ciphertext_size")
+	fd_QuerySimulateEncryptedTxResponse_escrow = md_QuerySimulateEncryptedTxResponse.Fields().ByName("escrow")
+	fd_QuerySimulateEncryptedTxResponse_fee = md_QuerySimulateEncryptedTxResponse.Fields().ByName("fee")
+	fd_QuerySimulateEncryptedTxResponse_fee_deducted = md_QuerySimulateEncryptedTxResponse.Fields().ByName("fee_deducted")
+	fd_QuerySimulateEncryptedTxResponse_fee_refunded = md_QuerySimulateEncryptedTxResponse.Fields().ByName("fee_refunded")
+	fd_QuerySimulateEncryptedTxResponse_out_of_gas = md_QuerySimulateEncryptedTxResponse.Fields().ByName("out_of_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateEncryptedTxResponse)(nil)
+
+type fastReflection_QuerySimulateEncryptedTxResponse QuerySimulateEncryptedTxResponse
+
+func (x *QuerySimulateEncryptedTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateEncryptedTxResponse)(x)
+}
+
+func (x *QuerySimulateEncryptedTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_fairyring_pep_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateEncryptedTxResponse_messageType fastReflection_QuerySimulateEncryptedTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateEncryptedTxResponse_messageType{}
+
+type fastReflection_QuerySimulateEncryptedTxResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateEncryptedTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateEncryptedTxResponse)(nil)
+}
+func (x fastReflection_QuerySimulateEncryptedTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateEncryptedTxResponse)
+}
+func (x fastReflection_QuerySimulateEncryptedTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateEncryptedTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateEncryptedTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateEncryptedTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateEncryptedTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateEncryptedTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UnderlyingGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnderlyingGas)
+		if !f(fd_QuerySimulateEncryptedTxResponse_underlying_gas, value) {
+			return
+		}
+	}
+	if x.DecryptionGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DecryptionGas)
+		if !f(fd_QuerySimulateEncryptedTxResponse_decryption_gas, value) {
+			return
+		}
+	}
+	if x.TotalGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalGas)
+		if !f(fd_QuerySimulateEncryptedTxResponse_total_gas, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_QuerySimulateEncryptedTxResponse_gas_limit, value) {
+			return
+		}
+	}
+	if x.CiphertextSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CiphertextSize)
+		if !f(fd_QuerySimulateEncryptedTxResponse_ciphertext_size, value) {
+			return
+		}
+	}
+	if x.Escrow != nil {
+		value := protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+		if !f(fd_QuerySimulateEncryptedTxResponse_escrow, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_QuerySimulateEncryptedTxResponse_fee, value) {
+			return
+		}
+	}
+	if x.FeeDeducted != nil {
+		value := protoreflect.ValueOfMessage(x.FeeDeducted.ProtoReflect())
+		if !f(fd_QuerySimulateEncryptedTxResponse_fee_deducted, value) {
+			return
+		}
+	}
+	if x.FeeRefunded != nil {
+		value := protoreflect.ValueOfMessage(x.FeeRefunded.ProtoReflect())
+		if !f(fd_QuerySimulateEncryptedTxResponse_fee_refunded, value) {
+			return
+		}
+	}
+	if x.OutOfGas != false {
+		value := protoreflect.ValueOfBool(x.OutOfGas)
+		if !f(fd_QuerySimulateEncryptedTxResponse_out_of_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.underlying_gas":
+		return x.UnderlyingGas != uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.decryption_gas":
+		return x.DecryptionGas != uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.total_gas":
+		return x.TotalGas != uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.ciphertext_size":
+		return x.CiphertextSize != uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.escrow":
+		return x.Escrow != nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee":
+		return x.Fee != nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted":
+		return x.FeeDeducted != nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded":
+		return x.FeeRefunded != nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.out_of_gas":
+		return x.OutOfGas != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.underlying_gas":
+		x.UnderlyingGas = uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.decryption_gas":
+		x.DecryptionGas = uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.total_gas":
+		x.TotalGas = uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.gas_limit":
+		x.GasLimit = uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.ciphertext_size":
+		x.CiphertextSize = uint64(0)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.escrow":
+		x.Escrow = nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee":
+		x.Fee = nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted":
+		x.FeeDeducted = nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded":
+		x.FeeRefunded = nil
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.out_of_gas":
+		x.OutOfGas = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.underlying_gas":
+		value := x.UnderlyingGas
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.decryption_gas":
+		value := x.DecryptionGas
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.total_gas":
+		value := x.TotalGas
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.ciphertext_size":
+		value := x.CiphertextSize
+		return protoreflect.ValueOfUint64(value)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted":
+		value := x.FeeDeducted
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded":
+		value := x.FeeRefunded
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.out_of_gas":
+		value := x.OutOfGas
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.underlying_gas":
+		x.UnderlyingGas = value.Uint()
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.decryption_gas":
+		x.DecryptionGas = value.Uint()
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.total_gas":
+		x.TotalGas = value.Uint()
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.gas_limit":
+		x.GasLimit = value.Uint()
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.ciphertext_size":
+		x.CiphertextSize = value.Uint()
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.escrow":
+		x.Escrow = value.Message().Interface().(*v1beta11.Coin)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee":
+		x.Fee = value.Message().Interface().(*v1beta11.Coin)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted":
+		x.FeeDeducted = value.Message().Interface().(*v1beta11.Coin)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded":
+		x.FeeRefunded = value.Message().Interface().(*v1beta11.Coin)
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.out_of_gas":
+		x.OutOfGas = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.escrow":
+		if x.Escrow == nil {
+			x.Escrow = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted":
+		if x.FeeDeducted == nil {
+			x.FeeDeducted = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FeeDeducted.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded":
+		if x.FeeRefunded == nil {
+			x.FeeRefunded = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FeeRefunded.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.underlying_gas":
+		panic(fmt.Errorf("field underlying_gas of message fairyring.pep.QuerySimulateEncryptedTxResponse is not mutable"))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.decryption_gas":
+		panic(fmt.Errorf("field decryption_gas of message fairyring.pep.QuerySimulateEncryptedTxResponse is not mutable"))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.total_gas":
+		panic(fmt.Errorf("field total_gas of message fairyring.pep.QuerySimulateEncryptedTxResponse is not mutable"))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message fairyring.pep.QuerySimulateEncryptedTxResponse is not mutable"))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.ciphertext_size":
+		panic(fmt.Errorf("field ciphertext_size of message fairyring.pep.QuerySimulateEncryptedTxResponse is not mutable"))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.out_of_gas":
+		panic(fmt.Errorf("field out_of_gas of message fairyring.pep.QuerySimulateEncryptedTxResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.underlying_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.decryption_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.total_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.ciphertext_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.escrow":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fairyring.pep.QuerySimulateEncryptedTxResponse.out_of_gas":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fairyring.pep.QuerySimulateEncryptedTxResponse"))
+		}
+		panic(fmt.Errorf("message fairyring.pep.QuerySimulateEncryptedTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fairyring.pep.QuerySimulateEncryptedTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateEncryptedTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateEncryptedTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UnderlyingGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnderlyingGas))
+		}
+		if x.DecryptionGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecryptionGas))
+		}
+		if x.TotalGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalGas))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.CiphertextSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.CiphertextSize))
+		}
+		if x.Escrow != nil {
+			l = options.Size(x.Escrow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeDeducted != nil {
+			l = options.Size(x.FeeDeducted)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeRefunded != nil {
+			l = options.Size(x.FeeRefunded)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OutOfGas {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateEncryptedTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OutOfGas {
+			i--
+			if x.OutOfGas {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.FeeRefunded != nil {
+			encoded, err := options.Marshal(x.FeeRefunded)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.FeeDeducted != nil {
+			encoded, err := options.Marshal(x.FeeDeducted)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Escrow != nil {
+			encoded, err := options.Marshal(x.Escrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.CiphertextSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CiphertextSize))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TotalGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DecryptionGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecryptionGas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UnderlyingGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnderlyingGas))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateEncryptedTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateEncryptedTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnderlyingGas", wireType)
+				}
+				x.UnderlyingGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnderlyingGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecryptionGas", wireType)
+				}
+				x.DecryptionGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecryptionGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+				}
+				x.TotalGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CiphertextSize", wireType)
+				}
+				x.CiphertextSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CiphertextSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Escrow == nil {
+					x.Escrow = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeDeducted == nil {
+					x.FeeDeducted = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDeducted); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRefunded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeRefunded == nil {
+					x.FeeRefunded = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeRefunded); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutOfGas", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OutOfGas = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: fairyring/pep/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryKeyshareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReqId string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+}
+
+func (x *QueryKeyshareRequest) Reset() {
+	*x = QueryKeyshareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryKeyshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryKeyshareRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryKeyshareRequest.ProtoReflect.Descriptor instead.
+func (*QueryKeyshareRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryKeyshareRequest) GetReqId() string {
+	if x != nil {
+		return x.ReqId
+	}
+	return ""
+}
+
+type QueryKeyshareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyshare *GenEncTxExecutionQueue `protobuf:"bytes,1,opt,name=keyshare,proto3" json:"keyshare,omitempty"`
+}
+
+func (x *QueryKeyshareResponse) Reset() {
+	*x = QueryKeyshareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryKeyshareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryKeyshareResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryKeyshareResponse.ProtoReflect.Descriptor instead.
+func (*QueryKeyshareResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryKeyshareResponse) GetKeyshare() *GenEncTxExecutionQueue {
+	if x != nil {
+		return x.Keyshare
+	}
+	return nil
+}
+
+type QueryAllKeyshareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllKeyshareRequest) Reset() {
+	*x = QueryAllKeyshareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllKeyshareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllKeyshareRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllKeyshareRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllKeyshareRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryAllKeyshareRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllKeyshareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyshares  []*GenEncTxExecutionQueue `protobuf:"bytes,1,rep,name=keyshares,proto3" json:"keyshares,omitempty"`
+	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllKeyshareResponse) Reset() {
+	*x = QueryAllKeyshareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllKeyshareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllKeyshareResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllKeyshareResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllKeyshareResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryAllKeyshareResponse) GetKeyshares() []*GenEncTxExecutionQueue {
+	if x != nil {
+		return x.Keyshares
+	}
+	return nil
+}
+
+func (x *QueryAllKeyshareResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryGetEncryptedTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index        uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *QueryGetEncryptedTxRequest) Reset() {
+	*x = QueryGetEncryptedTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetEncryptedTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetEncryptedTxRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetEncryptedTxRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetEncryptedTxRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryGetEncryptedTxRequest) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *QueryGetEncryptedTxRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type QueryGetEncryptedTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedTx *EncryptedTx `protobuf:"bytes,1,opt,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
+}
+
+func (x *QueryGetEncryptedTxResponse) Reset() {
+	*x = QueryGetEncryptedTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetEncryptedTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetEncryptedTxResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetEncryptedTxResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetEncryptedTxResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryGetEncryptedTxResponse) GetEncryptedTx() *EncryptedTx {
+	if x != nil {
+		return x.EncryptedTx
+	}
+	return nil
+}
+
+type QueryAllEncryptedTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllEncryptedTxRequest) Reset() {
+	*x = QueryAllEncryptedTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllEncryptedTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllEncryptedTxRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllEncryptedTxRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllEncryptedTxRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryAllEncryptedTxRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllEncryptedTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedTxArray []*EncryptedTxArray   `protobuf:"bytes,1,rep,name=encryptedTxArray,proto3" json:"encryptedTxArray,omitempty"`
+	Pagination       *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllEncryptedTxResponse) Reset() {
+	*x = QueryAllEncryptedTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllEncryptedTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllEncryptedTxResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllEncryptedTxResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllEncryptedTxResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryAllEncryptedTxResponse) GetEncryptedTxArray() []*EncryptedTxArray {
+	if x != nil {
+		return x.EncryptedTxArray
+	}
+	return nil
+}
+
+func (x *QueryAllEncryptedTxResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllEncryptedTxFromHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+}
+
+func (x *QueryAllEncryptedTxFromHeightRequest) Reset() {
+	*x = QueryAllEncryptedTxFromHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllEncryptedTxFromHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllEncryptedTxFromHeightRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllEncryptedTxFromHeightRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllEncryptedTxFromHeightRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryAllEncryptedTxFromHeightRequest) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+type QueryAllEncryptedTxFromHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedTxArray *EncryptedTxArray `protobuf:"bytes,1,opt,name=encryptedTxArray,proto3" json:"encryptedTxArray,omitempty"`
+}
+
+func (x *QueryAllEncryptedTxFromHeightResponse) Reset() {
+	*x = QueryAllEncryptedTxFromHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllEncryptedTxFromHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllEncryptedTxFromHeightResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllEncryptedTxFromHeightResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllEncryptedTxFromHeightResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryAllEncryptedTxFromHeightResponse) GetEncryptedTxArray() *EncryptedTxArray {
+	if x != nil {
+		return x.EncryptedTxArray
+	}
+	return nil
+}
+
+type QueryLatestHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryLatestHeightRequest) Reset() {
+	*x = QueryLatestHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLatestHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLatestHeightRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLatestHeightRequest.ProtoReflect.Descriptor instead.
+func (*QueryLatestHeightRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{12}
+}
+
+type QueryLatestHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryLatestHeightResponse) Reset() {
+	*x = QueryLatestHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLatestHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLatestHeightResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLatestHeightResponse.ProtoReflect.Descriptor instead.
+func (*QueryLatestHeightResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryLatestHeightResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type QueryGetPepNonceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryGetPepNonceRequest) Reset() {
+	*x = QueryGetPepNonceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPepNonceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPepNonceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPepNonceRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPepNonceRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryGetPepNonceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type QueryGetPepNonceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PepNonce *PepNonce `protobuf:"bytes,1,opt,name=pepNonce,proto3" json:"pepNonce,omitempty"`
+}
+
+func (x *QueryGetPepNonceResponse) Reset() {
+	*x = QueryGetPepNonceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPepNonceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPepNonceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPepNonceResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPepNonceResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryGetPepNonceResponse) GetPepNonce() *PepNonce {
+	if x != nil {
+		return x.PepNonce
+	}
+	return nil
+}
+
+type QueryAllPepNonceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPepNonceRequest) Reset() {
+	*x = QueryAllPepNonceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPepNonceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPepNonceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPepNonceRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllPepNonceRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryAllPepNonceRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllPepNonceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PepNonce   []*PepNonce           `protobuf:"bytes,1,rep,name=pepNonce,proto3" json:"pepNonce,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPepNonceResponse) Reset() {
+	*x = QueryAllPepNonceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fairyring_pep_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPepNonceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPepNonceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPepNonceResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllPepNonceResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryAllPepNonceResponse) GetPepNonce() []*PepNonce {
+	if x != nil {
+		return x.PepNonce
+	}
+	return nil
+}
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (x *QueryAllPepNonceResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryPubKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryPubKeyRequest) Reset() {
+	*x = QueryPubKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[0]
+		mi := &file_fairyring_pep_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryPubKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryPubKeyRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryPubKeyRequest.ProtoReflect.Descriptor instead.
+func (*QueryPubKeyRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{18}
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+type QueryPubKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ActivePubKey *common.ActivePublicKey `protobuf:"bytes,1,opt,name=activePubKey,proto3" json:"activePubKey,omitempty"`
+	QueuedPubKey *common.QueuedPublicKey `protobuf:"bytes,2,opt,name=queuedPubKey,proto3" json:"queuedPubKey,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryPubKeyResponse) Reset() {
+	*x = QueryPubKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[1]
+		mi := &file_fairyring_pep_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryPubKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryPubKeyResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryPubKeyResponse.ProtoReflect.Descriptor instead.
+func (*QueryPubKeyResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryPubKeyResponse) GetActivePubKey() *common.ActivePublicKey {
 	if x != nil {
-		return x.Params
+		return x.ActivePubKey
 	}
 	return nil
 }
 
-type QueryKeyshareRequest struct {
+func (x *QueryPubKeyResponse) GetQueuedPubKey() *common.QueuedPublicKey {
+	if x != nil {
+		return x.QueuedPubKey
+	}
+	return nil
+}
+
+type QueryShowPrivateKeyshareReqRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReqId string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	ReqId string `protobuf:"bytes,1,opt,name=reqId,proto3" json:"reqId,omitempty"`
 }
 
-func (x *QueryKeyshareRequest) Reset() {
-	*x = QueryKeyshareRequest{}
+func (x *QueryShowPrivateKeyshareReqRequest) Reset() {
+	*x = QueryShowPrivateKeyshareReqRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[2]
+		mi := &file_fairyring_pep_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryKeyshareRequest) String() string {
+func (x *QueryShowPrivateKeyshareReqRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryKeyshareRequest) ProtoMessage() {}
+func (*QueryShowPrivateKeyshareReqRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryKeyshareRequest.ProtoReflect.Descriptor instead.
-func (*QueryKeyshareRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryShowPrivateKeyshareReqRequest.ProtoReflect.Descriptor instead.
+func (*QueryShowPrivateKeyshareReqRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryKeyshareRequest) GetReqId() string {
+func (x *QueryShowPrivateKeyshareReqRequest) GetReqId() string {
 	if x != nil {
 		return x.ReqId
 	}
 	return ""
 }
 
-type QueryKeyshareResponse struct {
+type QueryShowPrivateKeyshareReqResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyshare *GenEncTxExecutionQueue `protobuf:"bytes,1,opt,name=keyshare,proto3" json:"keyshare,omitempty"`
+	Creator            string                      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ReqId              string                      `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Pubkey             string                      `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	EncryptedKeyshares []*common.EncryptedKeyshare `protobuf:"bytes,5,rep,name=encrypted_keyshares,json=encryptedKeyshares,proto3" json:"encrypted_keyshares,omitempty"`
 }
 
-func (x *QueryKeyshareResponse) Reset() {
-	*x = QueryKeyshareResponse{}
+func (x *QueryShowPrivateKeyshareReqResponse) Reset() {
+	*x = QueryShowPrivateKeyshareReqResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[3]
+		mi := &file_fairyring_pep_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryKeyshareResponse) String() string {
+func (x *QueryShowPrivateKeyshareReqResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryKeyshareResponse) ProtoMessage() {}
+func (*QueryShowPrivateKeyshareReqResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryKeyshareResponse.ProtoReflect.Descriptor instead.
-func (*QueryKeyshareResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryShowPrivateKeyshareReqResponse.ProtoReflect.Descriptor instead.
+func (*QueryShowPrivateKeyshareReqResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryKeyshareResponse) GetKeyshare() *GenEncTxExecutionQueue {
+func (x *QueryShowPrivateKeyshareReqResponse) GetCreator() string {
 	if x != nil {
-		return x.Keyshare
+		return x.Creator
 	}
-	return nil
-}
-
-type QueryAllKeyshareRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	return ""
 }
 
-func (x *QueryAllKeyshareRequest) Reset() {
-	*x = QueryAllKeyshareRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryShowPrivateKeyshareReqResponse) GetReqId() string {
+	if x != nil {
+		return x.ReqId
 	}
+	return ""
 }
 
-func (x *QueryAllKeyshareRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryAllKeyshareRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllKeyshareRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllKeyshareRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{4}
+func (x *QueryShowPrivateKeyshareReqResponse) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
 }
 
-func (x *QueryAllKeyshareRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryShowPrivateKeyshareReqResponse) GetEncryptedKeyshares() []*common.EncryptedKeyshare {
 	if x != nil {
-		return x.Pagination
+		return x.EncryptedKeyshares
 	}
 	return nil
 }
 
-type QueryAllKeyshareResponse struct {
+type QueryDecryptDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyshares  []*GenEncTxExecutionQueue `protobuf:"bytes,1,rep,name=keyshares,proto3" json:"keyshares,omitempty"`
-	Pagination *v1beta1.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pubkey        string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	AggrKeyshare  string `protobuf:"bytes,2,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
+	EncryptedData string `protobuf:"bytes,3,opt,name=encrypted_data,json=encryptedData,proto3" json:"encrypted_data,omitempty"`
 }
 
-func (x *QueryAllKeyshareResponse) Reset() {
-	*x = QueryAllKeyshareResponse{}
+func (x *QueryDecryptDataRequest) Reset() {
+	*x = QueryDecryptDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[5]
+		mi := &file_fairyring_pep_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllKeyshareResponse) String() string {
+func (x *QueryDecryptDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllKeyshareResponse) ProtoMessage() {}
+func (*QueryDecryptDataRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllKeyshareResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllKeyshareResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryDecryptDataRequest.ProtoReflect.Descriptor instead.
+func (*QueryDecryptDataRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAllKeyshareResponse) GetKeyshares() []*GenEncTxExecutionQueue {
+func (x *QueryDecryptDataRequest) GetPubkey() string {
 	if x != nil {
-		return x.Keyshares
+		return x.Pubkey
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryAllKeyshareResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryDecryptDataRequest) GetAggrKeyshare() string {
 	if x != nil {
-		return x.Pagination
+		return x.AggrKeyshare
 	}
-	return nil
+	return ""
 }
 
-type QueryGetEncryptedTxRequest struct {
+func (x *QueryDecryptDataRequest) GetEncryptedData() string {
+	if x != nil {
+		return x.EncryptedData
+	}
+	return ""
+}
+
+type QueryDecryptDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
-	Index        uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	DecryptedData string `protobuf:"bytes,1,opt,name=decrypted_data,json=decryptedData,proto3" json:"decrypted_data,omitempty"`
 }
 
-func (x *QueryGetEncryptedTxRequest) Reset() {
-	*x = QueryGetEncryptedTxRequest{}
+func (x *QueryDecryptDataResponse) Reset() {
+	*x = QueryDecryptDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[6]
+		mi := &file_fairyring_pep_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetEncryptedTxRequest) String() string {
+func (x *QueryDecryptDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetEncryptedTxRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryGetEncryptedTxRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetEncryptedTxRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{6}
-}
+func (*QueryDecryptDataResponse) ProtoMessage() {}
 
-func (x *QueryGetEncryptedTxRequest) GetTargetHeight() uint64 {
-	if x != nil {
-		return x.TargetHeight
-	}
-	return 0
+// Deprecated: Use QueryDecryptDataResponse.ProtoReflect.Descriptor instead.
+func (*QueryDecryptDataResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryGetEncryptedTxRequest) GetIndex() uint64 {
+func (x *QueryDecryptDataResponse) GetDecryptedData() string {
 	if x != nil {
-		return x.Index
+		return x.DecryptedData
 	}
-	return 0
+	return ""
 }
 
-type QueryGetEncryptedTxResponse struct {
+type QueryEncryptedTxQueueDepthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	EncryptedTx *EncryptedTx `protobuf:"bytes,1,opt,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
 }
 
-func (x *QueryGetEncryptedTxResponse) Reset() {
-	*x = QueryGetEncryptedTxResponse{}
+func (x *QueryEncryptedTxQueueDepthRequest) Reset() {
+	*x = QueryEncryptedTxQueueDepthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[7]
+		mi := &file_fairyring_pep_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetEncryptedTxResponse) String() string {
+func (x *QueryEncryptedTxQueueDepthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetEncryptedTxResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryGetEncryptedTxResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetEncryptedTxResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{7}
-}
+func (*QueryEncryptedTxQueueDepthRequest) ProtoMessage() {}
 
-func (x *QueryGetEncryptedTxResponse) GetEncryptedTx() *EncryptedTx {
-	if x != nil {
-		return x.EncryptedTx
-	}
-	return nil
+// Deprecated: Use QueryEncryptedTxQueueDepthRequest.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxQueueDepthRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{24}
 }
 
-type QueryAllEncryptedTxRequest struct {
+type QueryEncryptedTxQueueDepthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func (x *QueryAllEncryptedTxRequest) Reset() {
-	*x = QueryAllEncryptedTxRequest{}
+func (x *QueryEncryptedTxQueueDepthResponse) Reset() {
+	*x = QueryEncryptedTxQueueDepthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[8]
+		mi := &file_fairyring_pep_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllEncryptedTxRequest) String() string {
+func (x *QueryEncryptedTxQueueDepthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllEncryptedTxRequest) ProtoMessage() {}
+func (*QueryEncryptedTxQueueDepthResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllEncryptedTxRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllEncryptedTxRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryEncryptedTxQueueDepthResponse.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxQueueDepthResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryAllEncryptedTxRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryEncryptedTxQueueDepthResponse) GetDepth() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Depth
 	}
-	return nil
+	return 0
 }
 
-type QueryAllEncryptedTxResponse struct {
+type QueryOldestRetainedHeightRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	EncryptedTxArray []*EncryptedTxArray   `protobuf:"bytes,1,rep,name=encryptedTxArray,proto3" json:"encryptedTxArray,omitempty"`
-	Pagination       *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllEncryptedTxResponse) Reset() {
-	*x = QueryAllEncryptedTxResponse{}
+func (x *QueryOldestRetainedHeightRequest) Reset() {
+	*x = QueryOldestRetainedHeightRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[9]
+		mi := &file_fairyring_pep_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllEncryptedTxResponse) String() string {
+func (x *QueryOldestRetainedHeightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllEncryptedTxResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryAllEncryptedTxResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllEncryptedTxResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *QueryAllEncryptedTxResponse) GetEncryptedTxArray() []*EncryptedTxArray {
-	if x != nil {
-		return x.EncryptedTxArray
-	}
-	return nil
-}
+func (*QueryOldestRetainedHeightRequest) ProtoMessage() {}
 
-func (x *QueryAllEncryptedTxResponse) GetPagination() *v1beta1.PageResponse {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+// Deprecated: Use QueryOldestRetainedHeightRequest.ProtoReflect.Descriptor instead.
+func (*QueryOldestRetainedHeightRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{26}
 }
 
-type QueryAllEncryptedTxFromHeightRequest struct {
+type QueryOldestRetainedHeightResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	EncryptedTxHeight        uint64 `protobuf:"varint,1,opt,name=encryptedTxHeight,proto3" json:"encryptedTxHeight,omitempty"`
+	AggregatedKeyShareHeight uint64 `protobuf:"varint,2,opt,name=aggregatedKeyShareHeight,proto3" json:"aggregatedKeyShareHeight,omitempty"`
 }
 
-func (x *QueryAllEncryptedTxFromHeightRequest) Reset() {
-	*x = QueryAllEncryptedTxFromHeightRequest{}
+func (x *QueryOldestRetainedHeightResponse) Reset() {
+	*x = QueryOldestRetainedHeightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[10]
+		mi := &file_fairyring_pep_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllEncryptedTxFromHeightRequest) String() string {
+func (x *QueryOldestRetainedHeightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllEncryptedTxFromHeightRequest) ProtoMessage() {}
+func (*QueryOldestRetainedHeightResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllEncryptedTxFromHeightRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllEncryptedTxFromHeightRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryOldestRetainedHeightResponse.ProtoReflect.Descriptor instead.
+func (*QueryOldestRetainedHeightResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryAllEncryptedTxFromHeightRequest) GetTargetHeight() uint64 {
+func (x *QueryOldestRetainedHeightResponse) GetEncryptedTxHeight() uint64 {
 	if x != nil {
-		return x.TargetHeight
+		return x.EncryptedTxHeight
 	}
 	return 0
 }
 
-type QueryAllEncryptedTxFromHeightResponse struct {
+func (x *QueryOldestRetainedHeightResponse) GetAggregatedKeyShareHeight() uint64 {
+	if x != nil {
+		return x.AggregatedKeyShareHeight
+	}
+	return 0
+}
+
+type QueryLanesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	EncryptedTxArray *EncryptedTxArray `protobuf:"bytes,1,opt,name=encryptedTxArray,proto3" json:"encryptedTxArray,omitempty"`
 }
 
-func (x *QueryAllEncryptedTxFromHeightResponse) Reset() {
-	*x = QueryAllEncryptedTxFromHeightResponse{}
+func (x *QueryLanesRequest) Reset() {
+	*x = QueryLanesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[11]
+		mi := &file_fairyring_pep_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllEncryptedTxFromHeightResponse) String() string {
+func (x *QueryLanesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllEncryptedTxFromHeightResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryAllEncryptedTxFromHeightResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllEncryptedTxFromHeightResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{11}
-}
+func (*QueryLanesRequest) ProtoMessage() {}
 
-func (x *QueryAllEncryptedTxFromHeightResponse) GetEncryptedTxArray() *EncryptedTxArray {
-	if x != nil {
-		return x.EncryptedTxArray
-	}
-	return nil
+// Deprecated: Use QueryLanesRequest.ProtoReflect.Descriptor instead.
+func (*QueryLanesRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{28}
 }
 
-type QueryLatestHeightRequest struct {
+type QueryLanesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// lanes in the order the proposals are built in
+	Lanes []*LaneParams `protobuf:"bytes,1,rep,name=lanes,proto3" json:"lanes,omitempty"`
 }
 
-func (x *QueryLatestHeightRequest) Reset() {
-	*x = QueryLatestHeightRequest{}
+func (x *QueryLanesResponse) Reset() {
+	*x = QueryLanesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[12]
+		mi := &file_fairyring_pep_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLatestHeightRequest) String() string {
+func (x *QueryLanesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLatestHeightRequest) ProtoMessage() {}
+func (*QueryLanesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLatestHeightRequest.ProtoReflect.Descriptor instead.
-func (*QueryLatestHeightRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryLanesResponse.ProtoReflect.Descriptor instead.
+func (*QueryLanesResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{29}
 }
 
-type QueryLatestHeightResponse struct {
+func (x *QueryLanesResponse) GetLanes() []*LaneParams {
+	if x != nil {
+		return x.Lanes
+	}
+	return nil
+}
+
+type QueryEncryptedTxOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
 }
 
-func (x *QueryLatestHeightResponse) Reset() {
-	*x = QueryLatestHeightResponse{}
+func (x *QueryEncryptedTxOrderRequest) Reset() {
+	*x = QueryEncryptedTxOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[13]
+		mi := &file_fairyring_pep_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLatestHeightResponse) String() string {
+func (x *QueryEncryptedTxOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLatestHeightResponse) ProtoMessage() {}
+func (*QueryEncryptedTxOrderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryLatestHeightResponse.ProtoReflect.Descriptor instead.
-func (*QueryLatestHeightResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryEncryptedTxOrderRequest.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryLatestHeightResponse) GetHeight() uint64 {
+func (x *QueryEncryptedTxOrderRequest) GetTargetHeight() uint64 {
 	if x != nil {
-		return x.Height
+		return x.TargetHeight
 	}
 	return 0
 }
 
-type QueryGetPepNonceRequest struct {
+type QueryEncryptedTxOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Order *EncryptedTxOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// recomputed_indexes is the permutation derived again from the aggregated key and the encrypted txs of the height
+	RecomputedIndexes []uint64 `protobuf:"varint,2,rep,packed,name=recomputed_indexes,json=recomputedIndexes,proto3" json:"recomputed_indexes,omitempty"`
+	// valid is true when the recomputed permutation matches the recorded one
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (x *QueryGetPepNonceRequest) Reset() {
-	*x = QueryGetPepNonceRequest{}
+func (x *QueryEncryptedTxOrderResponse) Reset() {
+	*x = QueryEncryptedTxOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[14]
+		mi := &file_fairyring_pep_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPepNonceRequest) String() string {
+func (x *QueryEncryptedTxOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPepNonceRequest) ProtoMessage() {}
+func (*QueryEncryptedTxOrderResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPepNonceRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPepNonceRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryEncryptedTxOrderResponse.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxOrderResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryGetPepNonceRequest) GetAddress() string {
+func (x *QueryEncryptedTxOrderResponse) GetOrder() *EncryptedTxOrder {
 	if x != nil {
-		return x.Address
+		return x.Order
 	}
-	return ""
+	return nil
 }
 
-type QueryGetPepNonceResponse struct {
+func (x *QueryEncryptedTxOrderResponse) GetRecomputedIndexes() []uint64 {
+	if x != nil {
+		return x.RecomputedIndexes
+	}
+	return nil
+}
+
+func (x *QueryEncryptedTxOrderResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type QueryEncryptedTxResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PepNonce *PepNonce `protobuf:"bytes,1,opt,name=pepNonce,proto3" json:"pepNonce,omitempty"`
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index        uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *QueryGetPepNonceResponse) Reset() {
-	*x = QueryGetPepNonceResponse{}
+func (x *QueryEncryptedTxResultRequest) Reset() {
+	*x = QueryEncryptedTxResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[15]
+		mi := &file_fairyring_pep_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPepNonceResponse) String() string {
+func (x *QueryEncryptedTxResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPepNonceResponse) ProtoMessage() {}
+func (*QueryEncryptedTxResultRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPepNonceResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPepNonceResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryEncryptedTxResultRequest.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxResultRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryGetPepNonceResponse) GetPepNonce() *PepNonce {
+func (x *QueryEncryptedTxResultRequest) GetTargetHeight() uint64 {
 	if x != nil {
-		return x.PepNonce
+		return x.TargetHeight
 	}
-	return nil
+	return 0
 }
 
-type QueryAllPepNonceRequest struct {
+func (x *QueryEncryptedTxResultRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type QueryEncryptedTxResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Result *EncryptedTxExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *QueryAllPepNonceRequest) Reset() {
-	*x = QueryAllPepNonceRequest{}
+func (x *QueryEncryptedTxResultResponse) Reset() {
+	*x = QueryEncryptedTxResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[16]
+		mi := &file_fairyring_pep_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPepNonceRequest) String() string {
+func (x *QueryEncryptedTxResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPepNonceRequest) ProtoMessage() {}
+func (*QueryEncryptedTxResultResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPepNonceRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllPepNonceRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryEncryptedTxResultResponse.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxResultResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryAllPepNonceRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryEncryptedTxResultResponse) GetResult() *EncryptedTxExecutionResult {
 	if x != nil {
-		return x.Pagination
+		return x.Result
 	}
 	return nil
 }
 
-type QueryAllPepNonceResponse struct {
+type QueryGeneralEncryptedTxResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PepNonce   []*PepNonce           `protobuf:"bytes,1,rep,name=pepNonce,proto3" json:"pepNonce,omitempty"`
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ReqId string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *QueryAllPepNonceResponse) Reset() {
-	*x = QueryAllPepNonceResponse{}
+func (x *QueryGeneralEncryptedTxResultRequest) Reset() {
+	*x = QueryGeneralEncryptedTxResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[17]
+		mi := &file_fairyring_pep_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPepNonceResponse) String() string {
+func (x *QueryGeneralEncryptedTxResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPepNonceResponse) ProtoMessage() {}
+func (*QueryGeneralEncryptedTxResultRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPepNonceResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllPepNonceResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryGeneralEncryptedTxResultRequest.ProtoReflect.Descriptor instead.
+func (*QueryGeneralEncryptedTxResultRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryAllPepNonceResponse) GetPepNonce() []*PepNonce {
+func (x *QueryGeneralEncryptedTxResultRequest) GetReqId() string {
 	if x != nil {
-		return x.PepNonce
+		return x.ReqId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryAllPepNonceResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryGeneralEncryptedTxResultRequest) GetIndex() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-type QueryPubKeyRequest struct {
+type QueryGeneralEncryptedTxResultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Result *EncryptedTxExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *QueryPubKeyRequest) Reset() {
-	*x = QueryPubKeyRequest{}
+func (x *QueryGeneralEncryptedTxResultResponse) Reset() {
+	*x = QueryGeneralEncryptedTxResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[18]
+		mi := &file_fairyring_pep_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPubKeyRequest) String() string {
+func (x *QueryGeneralEncryptedTxResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPubKeyRequest) ProtoMessage() {}
+func (*QueryGeneralEncryptedTxResultResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryPubKeyRequest.ProtoReflect.Descriptor instead.
-func (*QueryPubKeyRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueryGeneralEncryptedTxResultResponse.ProtoReflect.Descriptor instead.
+func (*QueryGeneralEncryptedTxResultResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{35}
 }
 
-type QueryPubKeyResponse struct {
+func (x *QueryGeneralEncryptedTxResultResponse) GetResult() *EncryptedTxExecutionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type QueryEncryptedTxByCreatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivePubKey *common.ActivePublicKey `protobuf:"bytes,1,opt,name=activePubKey,proto3" json:"activePubKey,omitempty"`
-	QueuedPubKey *common.QueuedPublicKey `protobuf:"bytes,2,opt,name=queuedPubKey,proto3" json:"queuedPubKey,omitempty"`
+	Creator    string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status     string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryPubKeyResponse) Reset() {
-	*x = QueryPubKeyResponse{}
+func (x *QueryEncryptedTxByCreatorRequest) Reset() {
+	*x = QueryEncryptedTxByCreatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[19]
+		mi := &file_fairyring_pep_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryPubKeyResponse) String() string {
+func (x *QueryEncryptedTxByCreatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryPubKeyResponse) ProtoMessage() {}
+func (*QueryEncryptedTxByCreatorRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryPubKeyResponse.ProtoReflect.Descriptor instead.
-func (*QueryPubKeyResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryEncryptedTxByCreatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxByCreatorRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *QueryPubKeyResponse) GetActivePubKey() *common.ActivePublicKey {
+func (x *QueryEncryptedTxByCreatorRequest) GetCreator() string {
 	if x != nil {
-		return x.ActivePubKey
+		return x.Creator
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryPubKeyResponse) GetQueuedPubKey() *common.QueuedPublicKey {
+func (x *QueryEncryptedTxByCreatorRequest) GetStatus() string {
 	if x != nil {
-		return x.QueuedPubKey
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryEncryptedTxByCreatorRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type QueryShowPrivateKeyshareReqRequest struct {
+type QueryEncryptedTxByCreatorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReqId string `protobuf:"bytes,1,opt,name=reqId,proto3" json:"reqId,omitempty"`
+	EncryptedTx []*EncryptedTxWithStatus `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx,omitempty"`
+	Pagination  *v1beta1.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryShowPrivateKeyshareReqRequest) Reset() {
-	*x = QueryShowPrivateKeyshareReqRequest{}
+func (x *QueryEncryptedTxByCreatorResponse) Reset() {
+	*x = QueryEncryptedTxByCreatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[20]
+		mi := &file_fairyring_pep_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryShowPrivateKeyshareReqRequest) String() string {
+func (x *QueryEncryptedTxByCreatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryShowPrivateKeyshareReqRequest) ProtoMessage() {}
+func (*QueryEncryptedTxByCreatorResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryShowPrivateKeyshareReqRequest.ProtoReflect.Descriptor instead.
-func (*QueryShowPrivateKeyshareReqRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use QueryEncryptedTxByCreatorResponse.ProtoReflect.Descriptor instead.
+func (*QueryEncryptedTxByCreatorResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *QueryShowPrivateKeyshareReqRequest) GetReqId() string {
+func (x *QueryEncryptedTxByCreatorResponse) GetEncryptedTx() []*EncryptedTxWithStatus {
 	if x != nil {
-		return x.ReqId
+		return x.EncryptedTx
 	}
-	return ""
+	return nil
 }
 
-type QueryShowPrivateKeyshareReqResponse struct {
+func (x *QueryEncryptedTxByCreatorResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QuerySimulateEncryptedTxRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator            string                      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ReqId              string                      `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
-	Pubkey             string                      `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	EncryptedKeyshares []*common.EncryptedKeyshare `protobuf:"bytes,5,rep,name=encrypted_keyshares,json=encryptedKeyshares,proto3" json:"encrypted_keyshares,omitempty"`
+	// tx_bytes is the plaintext signed underlying tx, as it would be encrypted
+	TxBytes  []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *QueryShowPrivateKeyshareReqResponse) Reset() {
-	*x = QueryShowPrivateKeyshareReqResponse{}
+func (x *QuerySimulateEncryptedTxRequest) Reset() {
+	*x = QuerySimulateEncryptedTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[21]
+		mi := &file_fairyring_pep_query_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
-
-func (x *QueryShowPrivateKeyshareReqResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryShowPrivateKeyshareReqResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryShowPrivateKeyshareReqResponse.ProtoReflect.Descriptor instead.
-func (*QueryShowPrivateKeyshareReqResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *QueryShowPrivateKeyshareReqResponse) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+
+func (x *QuerySimulateEncryptedTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryShowPrivateKeyshareReqResponse) GetReqId() string {
-	if x != nil {
-		return x.ReqId
-	}
-	return ""
+func (*QuerySimulateEncryptedTxRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateEncryptedTxRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateEncryptedTxRequest) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *QueryShowPrivateKeyshareReqResponse) GetPubkey() string {
+func (x *QuerySimulateEncryptedTxRequest) GetTxBytes() []byte {
 	if x != nil {
-		return x.Pubkey
+		return x.TxBytes
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryShowPrivateKeyshareReqResponse) GetEncryptedKeyshares() []*common.EncryptedKeyshare {
+func (x *QuerySimulateEncryptedTxRequest) GetIdentity() string {
 	if x != nil {
-		return x.EncryptedKeyshares
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-type QueryDecryptDataRequest struct {
+type QuerySimulateEncryptedTxResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pubkey        string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	AggrKeyshare  string `protobuf:"bytes,2,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
-	EncryptedData string `protobuf:"bytes,3,opt,name=encrypted_data,json=encryptedData,proto3" json:"encrypted_data,omitempty"`
-}
-
-func (x *QueryDecryptDataRequest) Reset() {
-	*x = QueryDecryptDataRequest{}
+	UnderlyingGas  uint64         `protobuf:"varint,1,opt,name=underlying_gas,json=underlyingGas,proto3" json:"underlying_gas,omitempty"`
+	DecryptionGas  uint64         `protobuf:"varint,2,opt,name=decryption_gas,json=decryptionGas,proto3" json:"decryption_gas,omitempty"`
+	TotalGas       uint64         `protobuf:"varint,3,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	GasLimit       uint64         `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	CiphertextSize uint64         `protobuf:"varint,5,opt,name=ciphertext_size,json=ciphertextSize,proto3" json:"ciphertext_size,omitempty"`
+	Escrow         *v1beta11.Coin `protobuf:"bytes,6,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	Fee            *v1beta11.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeDeducted    *v1beta11.Coin `protobuf:"bytes,8,opt,name=fee_deducted,json=feeDeducted,proto3" json:"fee_deducted,omitempty"`
+	FeeRefunded    *v1beta11.Coin `protobuf:"bytes,9,opt,name=fee_refunded,json=feeRefunded,proto3" json:"fee_refunded,omitempty"`
+	// out_of_gas is true when the execution ran out of the simulation gas limit,
+	// the gas limit of the underlying tx capped by the maximum simulation gas
+	OutOfGas bool `protobuf:"varint,10,opt,name=out_of_gas,json=outOfGas,proto3" json:"out_of_gas,omitempty"`
+}
+
+func (x *QuerySimulateEncryptedTxResponse) Reset() {
+	*x = QuerySimulateEncryptedTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[22]
+		mi := &file_fairyring_pep_query_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryDecryptDataRequest) String() string {
+func (x *QuerySimulateEncryptedTxResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryDecryptDataRequest) ProtoMessage() {}
+func (*QuerySimulateEncryptedTxResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryDecryptDataRequest.ProtoReflect.Descriptor instead.
-func (*QueryDecryptDataRequest) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QuerySimulateEncryptedTxResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateEncryptedTxResponse) Descriptor() ([]byte, []int) {
+	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *QueryDecryptDataRequest) GetPubkey() string {
+func (x *QuerySimulateEncryptedTxResponse) GetUnderlyingGas() uint64 {
 	if x != nil {
-		return x.Pubkey
+		return x.UnderlyingGas
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryDecryptDataRequest) GetAggrKeyshare() string {
+func (x *QuerySimulateEncryptedTxResponse) GetDecryptionGas() uint64 {
 	if x != nil {
-		return x.AggrKeyshare
+		return x.DecryptionGas
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryDecryptDataRequest) GetEncryptedData() string {
+func (x *QuerySimulateEncryptedTxResponse) GetTotalGas() uint64 {
 	if x != nil {
-		return x.EncryptedData
+		return x.TotalGas
 	}
-	return ""
+	return 0
 }
 
-type QueryDecryptDataResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QuerySimulateEncryptedTxResponse) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
 
-	DecryptedData string `protobuf:"bytes,1,opt,name=decrypted_data,json=decryptedData,proto3" json:"decrypted_data,omitempty"`
+func (x *QuerySimulateEncryptedTxResponse) GetCiphertextSize() uint64 {
+	if x != nil {
+		return x.CiphertextSize
+	}
+	return 0
 }
 
-func (x *QueryDecryptDataResponse) Reset() {
-	*x = QueryDecryptDataResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_fairyring_pep_query_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySimulateEncryptedTxResponse) GetEscrow() *v1beta11.Coin {
+	if x != nil {
+		return x.Escrow
 	}
+	return nil
 }
 
-func (x *QueryDecryptDataResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySimulateEncryptedTxResponse) GetFee() *v1beta11.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
 }
 
-func (*QueryDecryptDataResponse) ProtoMessage() {}
+func (x *QuerySimulateEncryptedTxResponse) GetFeeDeducted() *v1beta11.Coin {
+	if x != nil {
+		return x.FeeDeducted
+	}
+	return nil
+}
 
-// Deprecated: Use QueryDecryptDataResponse.ProtoReflect.Descriptor instead.
-func (*QueryDecryptDataResponse) Descriptor() ([]byte, []int) {
-	return file_fairyring_pep_query_proto_rawDescGZIP(), []int{23}
+func (x *QuerySimulateEncryptedTxResponse) GetFeeRefunded() *v1beta11.Coin {
+	if x != nil {
+		return x.FeeRefunded
+	}
+	return nil
 }
 
-func (x *QueryDecryptDataResponse) GetDecryptedData() string {
+func (x *QuerySimulateEncryptedTxResponse) GetOutOfGas() bool {
 	if x != nil {
-		return x.DecryptedData
+		return x.OutOfGas
 	}
-	return ""
+	return false
 }
 
 var File_fairyring_pep_query_proto protoreflect.FileDescriptor
@@ -12052,129 +20604,334 @@ var file_fairyring_pep_query_proto_rawDesc = []byte{
 	0x72, 0x79, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
 	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64,
-	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x32, 0xf7, 0x0d, 0x0a,
-	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6e, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
-	0x15, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x29, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
-	0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x45,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x21,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3a, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x22, 0x0a,
+	0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8d, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x6c, 0x64, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3a, 0x0a, 0x18, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x13, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
+	0x61, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05,
+	0x6c, 0x61, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x4c, 0x61, 0x6e, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x05, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x22, 0x42, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54,
+	0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xa1, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x69, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x53, 0x0a, 0x24, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x70, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xba, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58,
+	0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45,
 	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65,
-	0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f,
-	0x74, 0x78, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x0e, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x6c, 0x6c, 0x12, 0x29, 0x2e, 0x66,
-	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x66, 0x61,
-	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x12, 0xb9, 0x01, 0x0a, 0x18, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x6c, 0x6c, 0x46, 0x72, 0x6f, 0x6d, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x66, 0x61, 0x69,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xe5, 0x03, 0x0a, 0x20, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x61, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e,
+	0x67, 0x47, 0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x66, 0x65,
+	0x65, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65, 0x64, 0x12, 0x42,
+	0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x47, 0x61, 0x73,
+	0x32, 0xcb, 0x18, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6e, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f,
+	0x70, 0x65, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x29, 0x2e, 0x66, 0x61, 0x69,
 	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x46, 0x72,
-	0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79,
-	0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x65, 0x64, 0x5f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70,
-	0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x87,
-	0x01, 0x0a, 0x08, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x66, 0x61,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0x8c, 0x01,
+	0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x6c, 0x6c,
+	0x12, 0x29, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x61,
 	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x70, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2f, 0x70, 0x65, 0x70, 0x2f, 0x70, 0x65, 0x70, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x70,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x79, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x12, 0xb9, 0x01, 0x0a,
+	0x18, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x41, 0x6c, 0x6c, 0x46,
+	0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x46, 0x72, 0x6f,
+	0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x66,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2f, 0x70, 0x65, 0x70, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x87, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x70, 0x65, 0x70, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x80, 0x01, 0x0a,
+	0x0b, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x26, 0x2e, 0x66,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x70,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x70, 0x65, 0x70, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x6f, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x12, 0x82, 0x01, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x23, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f,
+	0x70, 0x65, 0x70, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x7b, 0x72, 0x65,
+	0x71, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x41, 0x6c, 0x6c, 0x12, 0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
 	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c,
-	0x6c, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x70, 0x4e, 0x6f, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1a, 0x12, 0x18, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65,
-	0x70, 0x2f, 0x70, 0x65, 0x70, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x06, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x75,
-	0x62, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2f, 0x70, 0x65, 0x70, 0x2f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x82, 0x01, 0x0a,
-	0x0b, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x23, 0x2e, 0x66,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65,
+	0x70, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0xc3, 0x01, 0x0a, 0x16, 0x53,
+	0x68, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x31, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x77, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x68,
+	0x6f, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x46, 0x61, 0x69, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x73,
+	0x68, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x49, 0x64, 0x7d,
+	0x12, 0xb7, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x12, 0x4f, 0x2f, 0x46, 0x61, 0x69, 0x72,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f,
+	0x70, 0x65, 0x70, 0x2f, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x7b, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x7b, 0x61, 0x67, 0x67, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x7d, 0x12, 0xad, 0x01, 0x0a, 0x15, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x30, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65,
+	0x70, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0xa8, 0x01, 0x0a, 0x14, 0x4f,
+	0x6c, 0x64, 0x65, 0x73, 0x74, 0x52, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2f, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x6c, 0x64, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x6f,
+	0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0xb3, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x2e, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b,
+	0x12, 0x39, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70,
+	0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0xca, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x66, 0x61,
+	0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x7b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x2f, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65,
+	0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x54, 0x78, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x66,
+	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x6a,
+	0x0a, 0x05, 0x4c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x61, 0x69, 0x72,
+	0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
+	0x61, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2f, 0x70, 0x65, 0x70, 0x2f, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x10, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x2b, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x66,
 	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65,
-	0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
-	0x20, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f,
-	0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64,
-	0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0e, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x41, 0x6c, 0x6c, 0x12, 0x26, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x4b, 0x65, 0x79,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66,
+	0x72, 0x79, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x32, 0x12, 0x30, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70,
+	0x65, 0x70, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x54, 0x78, 0x12, 0x2e, 0x2e, 0x66,
 	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
-	0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x6b, 0x65,
-	0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0xc3, 0x01, 0x0a, 0x16, 0x53, 0x68, 0x6f, 0x77, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x31, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65,
-	0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c,
-	0x12, 0x3a, 0x2f, 0x46, 0x61, 0x69, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x61, 0x69,
-	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x5f,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x5f, 0x72, 0x65, 0x71, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x49, 0x64, 0x7d, 0x12, 0xb7, 0x01, 0x0a,
-	0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x2e, 0x66,
+	0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66,
 	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x70, 0x65, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x51, 0x12, 0x4f, 0x2f, 0x46, 0x61, 0x69, 0x72, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f,
-	0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x7b, 0x61, 0x67, 0x67, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x7d, 0x42, 0x94, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66,
-	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x65, 0x70, 0x42, 0x0a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69,
-	0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58,
-	0xaa, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70,
-	0xca, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70,
-	0xe2, 0x02, 0x19, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46,
-	0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x65, 0x70, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x42, 0x94,
+	0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x65, 0x70, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70,
+	0x65, 0x70, 0xa2, 0x02, 0x03, 0x46, 0x50, 0x58, 0xaa, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x70, 0xca, 0x02, 0x0d, 0x46, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0xe2, 0x02, 0x19, 0x46, 0x61, 0x69, 0x72, 0x79,
+	0x72, 0x69, 0x6e, 0x67, 0x5c, 0x50, 0x65, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x46, 0x61, 0x69, 0x72, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x3a, 0x3a, 0x50, 0x65, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12189,7 +20946,7 @@ func file_fairyring_pep_query_proto_rawDescGZIP() []byte {
 	return file_fairyring_pep_query_proto_rawDescData
 }
 
-var file_fairyring_pep_query_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_fairyring_pep_query_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_fairyring_pep_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                    // 0: fairyring.pep.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                   // 1: fairyring.pep.QueryParamsResponse
@@ -12215,64 +20972,112 @@ var file_fairyring_pep_query_proto_goTypes = []interface{}{
 	(*QueryShowPrivateKeyshareReqResponse)(nil),   // 21: fairyring.pep.QueryShowPrivateKeyshareReqResponse
 	(*QueryDecryptDataRequest)(nil),               // 22: fairyring.pep.QueryDecryptDataRequest
 	(*QueryDecryptDataResponse)(nil),              // 23: fairyring.pep.QueryDecryptDataResponse
-	(*Params)(nil),                                // 24: fairyring.pep.Params
-	(*GenEncTxExecutionQueue)(nil),                // 25: fairyring.pep.GenEncTxExecutionQueue
-	(*v1beta1.PageRequest)(nil),                   // 26: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),                  // 27: cosmos.base.query.v1beta1.PageResponse
-	(*EncryptedTx)(nil),                           // 28: fairyring.pep.EncryptedTx
-	(*EncryptedTxArray)(nil),                      // 29: fairyring.pep.EncryptedTxArray
-	(*PepNonce)(nil),                              // 30: fairyring.pep.PepNonce
-	(*common.ActivePublicKey)(nil),                // 31: fairyring.common.ActivePublicKey
-	(*common.QueuedPublicKey)(nil),                // 32: fairyring.common.QueuedPublicKey
-	(*common.EncryptedKeyshare)(nil),              // 33: fairyring.common.EncryptedKeyshare
+	(*QueryEncryptedTxQueueDepthRequest)(nil),     // 24: fairyring.pep.QueryEncryptedTxQueueDepthRequest
+	(*QueryEncryptedTxQueueDepthResponse)(nil),    // 25: fairyring.pep.QueryEncryptedTxQueueDepthResponse
+	(*QueryOldestRetainedHeightRequest)(nil),      // 26: fairyring.pep.QueryOldestRetainedHeightRequest
+	(*QueryOldestRetainedHeightResponse)(nil),     // 27: fairyring.pep.QueryOldestRetainedHeightResponse
+	(*QueryLanesRequest)(nil),                     // 28: fairyring.pep.QueryLanesRequest
+	(*QueryLanesResponse)(nil),                    // 29: fairyring.pep.QueryLanesResponse
+	(*QueryEncryptedTxOrderRequest)(nil),          // 30: fairyring.pep.QueryEncryptedTxOrderRequest
+	(*QueryEncryptedTxOrderResponse)(nil),         // 31: fairyring.pep.QueryEncryptedTxOrderResponse
+	(*QueryEncryptedTxResultRequest)(nil),         // 32: fairyring.pep.QueryEncryptedTxResultRequest
+	(*QueryEncryptedTxResultResponse)(nil),        // 33: fairyring.pep.QueryEncryptedTxResultResponse
+	(*QueryGeneralEncryptedTxResultRequest)(nil),  // 34: fairyring.pep.QueryGeneralEncryptedTxResultRequest
+	(*QueryGeneralEncryptedTxResultResponse)(nil), // 35: fairyring.pep.QueryGeneralEncryptedTxResultResponse
+	(*QueryEncryptedTxByCreatorRequest)(nil),      // 36: fairyring.pep.QueryEncryptedTxByCreatorRequest
+	(*QueryEncryptedTxByCreatorResponse)(nil),     // 37: fairyring.pep.QueryEncryptedTxByCreatorResponse
+	(*QuerySimulateEncryptedTxRequest)(nil),       // 38: fairyring.pep.QuerySimulateEncryptedTxRequest
+	(*QuerySimulateEncryptedTxResponse)(nil),      // 39: fairyring.pep.QuerySimulateEncryptedTxResponse
+	(*Params)(nil),                                // 40: fairyring.pep.Params
+	(*GenEncTxExecutionQueue)(nil),                // 41: fairyring.pep.GenEncTxExecutionQueue
+	(*v1beta1.PageRequest)(nil),                   // 42: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                  // 43: cosmos.base.query.v1beta1.PageResponse
+	(*EncryptedTx)(nil),                           // 44: fairyring.pep.EncryptedTx
+	(*EncryptedTxArray)(nil),                      // 45: fairyring.pep.EncryptedTxArray
+	(*PepNonce)(nil),                              // 46: fairyring.pep.PepNonce
+	(*common.ActivePublicKey)(nil),                // 47: fairyring.common.ActivePublicKey
+	(*common.QueuedPublicKey)(nil),                // 48: fairyring.common.QueuedPublicKey
+	(*common.EncryptedKeyshare)(nil),              // 49: fairyring.common.EncryptedKeyshare
+	(*LaneParams)(nil),                            // 50: fairyring.pep.LaneParams
+	(*EncryptedTxOrder)(nil),                      // 51: fairyring.pep.EncryptedTxOrder
+	(*EncryptedTxExecutionResult)(nil),            // 52: fairyring.pep.EncryptedTxExecutionResult
+	(*EncryptedTxWithStatus)(nil),                 // 53: fairyring.pep.EncryptedTxWithStatus
+	(*v1beta11.Coin)(nil),                         // 54: cosmos.base.v1beta1.Coin
 }
 var file_fairyring_pep_query_proto_depIdxs = []int32{
-	24, // 0: fairyring.pep.QueryParamsResponse.params:type_name -> fairyring.pep.Params
-	25, // 1: fairyring.pep.QueryKeyshareResponse.keyshare:type_name -> fairyring.pep.GenEncTxExecutionQueue
-	26, // 2: fairyring.pep.QueryAllKeyshareRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	25, // 3: fairyring.pep.QueryAllKeyshareResponse.keyshares:type_name -> fairyring.pep.GenEncTxExecutionQueue
-	27, // 4: fairyring.pep.QueryAllKeyshareResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	28, // 5: fairyring.pep.QueryGetEncryptedTxResponse.encryptedTx:type_name -> fairyring.pep.EncryptedTx
-	26, // 6: fairyring.pep.QueryAllEncryptedTxRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	29, // 7: fairyring.pep.QueryAllEncryptedTxResponse.encryptedTxArray:type_name -> fairyring.pep.EncryptedTxArray
-	27, // 8: fairyring.pep.QueryAllEncryptedTxResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	29, // 9: fairyring.pep.QueryAllEncryptedTxFromHeightResponse.encryptedTxArray:type_name -> fairyring.pep.EncryptedTxArray
-	30, // 10: fairyring.pep.QueryGetPepNonceResponse.pepNonce:type_name -> fairyring.pep.PepNonce
-	26, // 11: fairyring.pep.QueryAllPepNonceRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	30, // 12: fairyring.pep.QueryAllPepNonceResponse.pepNonce:type_name -> fairyring.pep.PepNonce
-	27, // 13: fairyring.pep.QueryAllPepNonceResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	31, // 14: fairyring.pep.QueryPubKeyResponse.activePubKey:type_name -> fairyring.common.ActivePublicKey
-	32, // 15: fairyring.pep.QueryPubKeyResponse.queuedPubKey:type_name -> fairyring.common.QueuedPublicKey
-	33, // 16: fairyring.pep.QueryShowPrivateKeyshareReqResponse.encrypted_keyshares:type_name -> fairyring.common.EncryptedKeyshare
-	0,  // 17: fairyring.pep.Query.Params:input_type -> fairyring.pep.QueryParamsRequest
-	6,  // 18: fairyring.pep.Query.EncryptedTx:input_type -> fairyring.pep.QueryGetEncryptedTxRequest
-	8,  // 19: fairyring.pep.Query.EncryptedTxAll:input_type -> fairyring.pep.QueryAllEncryptedTxRequest
-	10, // 20: fairyring.pep.Query.EncryptedTxAllFromHeight:input_type -> fairyring.pep.QueryAllEncryptedTxFromHeightRequest
-	12, // 21: fairyring.pep.Query.LatestHeight:input_type -> fairyring.pep.QueryLatestHeightRequest
-	14, // 22: fairyring.pep.Query.PepNonce:input_type -> fairyring.pep.QueryGetPepNonceRequest
-	16, // 23: fairyring.pep.Query.PepNonceAll:input_type -> fairyring.pep.QueryAllPepNonceRequest
-	18, // 24: fairyring.pep.Query.PubKey:input_type -> fairyring.pep.QueryPubKeyRequest
-	2,  // 25: fairyring.pep.Query.KeyshareReq:input_type -> fairyring.pep.QueryKeyshareRequest
-	4,  // 26: fairyring.pep.Query.KeyshareReqAll:input_type -> fairyring.pep.QueryAllKeyshareRequest
-	20, // 27: fairyring.pep.Query.ShowPrivateKeyshareReq:input_type -> fairyring.pep.QueryShowPrivateKeyshareReqRequest
-	22, // 28: fairyring.pep.Query.DecryptData:input_type -> fairyring.pep.QueryDecryptDataRequest
-	1,  // 29: fairyring.pep.Query.Params:output_type -> fairyring.pep.QueryParamsResponse
-	7,  // 30: fairyring.pep.Query.EncryptedTx:output_type -> fairyring.pep.QueryGetEncryptedTxResponse
-	9,  // 31: fairyring.pep.Query.EncryptedTxAll:output_type -> fairyring.pep.QueryAllEncryptedTxResponse
-	11, // 32: fairyring.pep.Query.EncryptedTxAllFromHeight:output_type -> fairyring.pep.QueryAllEncryptedTxFromHeightResponse
-	13, // 33: fairyring.pep.Query.LatestHeight:output_type -> fairyring.pep.QueryLatestHeightResponse
-	15, // 34: fairyring.pep.Query.PepNonce:output_type -> fairyring.pep.QueryGetPepNonceResponse
-	17, // 35: fairyring.pep.Query.PepNonceAll:output_type -> fairyring.pep.QueryAllPepNonceResponse
-	19, // 36: fairyring.pep.Query.PubKey:output_type -> fairyring.pep.QueryPubKeyResponse
-	3,  // 37: fairyring.pep.Query.KeyshareReq:output_type -> fairyring.pep.QueryKeyshareResponse
-	5,  // 38: fairyring.pep.Query.KeyshareReqAll:output_type -> fairyring.pep.QueryAllKeyshareResponse
-	21, // 39: fairyring.pep.Query.ShowPrivateKeyshareReq:output_type -> fairyring.pep.QueryShowPrivateKeyshareReqResponse
-	23, // 40: fairyring.pep.Query.DecryptData:output_type -> fairyring.pep.QueryDecryptDataResponse
-	29, // [29:41] is the sub-list for method output_type
-	17, // [17:29] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	40, // 0: fairyring.pep.QueryParamsResponse.params:type_name -> fairyring.pep.Params
+	41, // 1: fairyring.pep.QueryKeyshareResponse.keyshare:type_name -> fairyring.pep.GenEncTxExecutionQueue
+	42, // 2: fairyring.pep.QueryAllKeyshareRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	41, // 3: fairyring.pep.QueryAllKeyshareResponse.keyshares:type_name -> fairyring.pep.GenEncTxExecutionQueue
+	43, // 4: fairyring.pep.QueryAllKeyshareResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 5: fairyring.pep.QueryGetEncryptedTxResponse.encryptedTx:type_name -> fairyring.pep.EncryptedTx
+	42, // 6: fairyring.pep.QueryAllEncryptedTxRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	45, // 7: fairyring.pep.QueryAllEncryptedTxResponse.encryptedTxArray:type_name -> fairyring.pep.EncryptedTxArray
+	43, // 8: fairyring.pep.QueryAllEncryptedTxResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	45, // 9: fairyring.pep.QueryAllEncryptedTxFromHeightResponse.encryptedTxArray:type_name -> fairyring.pep.EncryptedTxArray
+	46, // 10: fairyring.pep.QueryGetPepNonceResponse.pepNonce:type_name -> fairyring.pep.PepNonce
+	42, // 11: fairyring.pep.QueryAllPepNonceRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	46, // 12: fairyring.pep.QueryAllPepNonceResponse.pepNonce:type_name -> fairyring.pep.PepNonce
+	43, // 13: fairyring.pep.QueryAllPepNonceResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	47, // 14: fairyring.pep.QueryPubKeyResponse.activePubKey:type_name -> fairyring.common.ActivePublicKey
+	48, // 15: fairyring.pep.QueryPubKeyResponse.queuedPubKey:type_name -> fairyring.common.QueuedPublicKey
+	49, // 16: fairyring.pep.QueryShowPrivateKeyshareReqResponse.encrypted_keyshares:type_name -> fairyring.common.EncryptedKeyshare
+	50, // 17: fairyring.pep.QueryLanesResponse.lanes:type_name -> fairyring.pep.LaneParams
+	51, // 18: fairyring.pep.QueryEncryptedTxOrderResponse.order:type_name -> fairyring.pep.EncryptedTxOrder
+	52, // 19: fairyring.pep.QueryEncryptedTxResultResponse.result:type_name -> fairyring.pep.EncryptedTxExecutionResult
+	52, // 20: fairyring.pep.QueryGeneralEncryptedTxResultResponse.result:type_name -> fairyring.pep.EncryptedTxExecutionResult
+	42, // 21: fairyring.pep.QueryEncryptedTxByCreatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	53, // 22: fairyring.pep.QueryEncryptedTxByCreatorResponse.encryptedTx:type_name -> fairyring.pep.EncryptedTxWithStatus
+	43, // 23: fairyring.pep.QueryEncryptedTxByCreatorResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	54, // 24: fairyring.pep.QuerySimulateEncryptedTxResponse.escrow:type_name -> cosmos.base.v1beta1.Coin
+	54, // 25: fairyring.pep.QuerySimulateEncryptedTxResponse.fee:type_name -> cosmos.base.v1beta1.Coin
+	54, // 26: fairyring.pep.QuerySimulateEncryptedTxResponse.fee_deducted:type_name -> cosmos.base.v1beta1.Coin
+	54, // 27: fairyring.pep.QuerySimulateEncryptedTxResponse.fee_refunded:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 28: fairyring.pep.Query.Params:input_type -> fairyring.pep.QueryParamsRequest
+	6,  // 29: fairyring.pep.Query.EncryptedTx:input_type -> fairyring.pep.QueryGetEncryptedTxRequest
+	8,  // 30: fairyring.pep.Query.EncryptedTxAll:input_type -> fairyring.pep.QueryAllEncryptedTxRequest
+	10, // 31: fairyring.pep.Query.EncryptedTxAllFromHeight:input_type -> fairyring.pep.QueryAllEncryptedTxFromHeightRequest
+	12, // 32: fairyring.pep.Query.LatestHeight:input_type -> fairyring.pep.QueryLatestHeightRequest
+	14, // 33: fairyring.pep.Query.PepNonce:input_type -> fairyring.pep.QueryGetPepNonceRequest
+	16, // 34: fairyring.pep.Query.PepNonceAll:input_type -> fairyring.pep.QueryAllPepNonceRequest
+	18, // 35: fairyring.pep.Query.PubKey:input_type -> fairyring.pep.QueryPubKeyRequest
+	2,  // 36: fairyring.pep.Query.KeyshareReq:input_type -> fairyring.pep.QueryKeyshareRequest
+	4,  // 37: fairyring.pep.Query.KeyshareReqAll:input_type -> fairyring.pep.QueryAllKeyshareRequest
+	20, // 38: fairyring.pep.Query.ShowPrivateKeyshareReq:input_type -> fairyring.pep.QueryShowPrivateKeyshareReqRequest
+	22, // 39: fairyring.pep.Query.DecryptData:input_type -> fairyring.pep.QueryDecryptDataRequest
+	24, // 40: fairyring.pep.Query.EncryptedTxQueueDepth:input_type -> fairyring.pep.QueryEncryptedTxQueueDepthRequest
+	26, // 41: fairyring.pep.Query.OldestRetainedHeight:input_type -> fairyring.pep.QueryOldestRetainedHeightRequest
+	32, // 42: fairyring.pep.Query.EncryptedTxResult:input_type -> fairyring.pep.QueryEncryptedTxResultRequest
+	34, // 43: fairyring.pep.Query.GeneralEncryptedTxResult:input_type -> fairyring.pep.QueryGeneralEncryptedTxResultRequest
+	36, // 44: fairyring.pep.Query.EncryptedTxByCreator:input_type -> fairyring.pep.QueryEncryptedTxByCreatorRequest
+	28, // 45: fairyring.pep.Query.Lanes:input_type -> fairyring.pep.QueryLanesRequest
+	30, // 46: fairyring.pep.Query.EncryptedTxOrder:input_type -> fairyring.pep.QueryEncryptedTxOrderRequest
+	38, // 47: fairyring.pep.Query.SimulateEncryptedTx:input_type -> fairyring.pep.QuerySimulateEncryptedTxRequest
+	1,  // 48: fairyring.pep.Query.Params:output_type -> fairyring.pep.QueryParamsResponse
+	7,  // 49: fairyring.pep.Query.EncryptedTx:output_type -> fairyring.pep.QueryGetEncryptedTxResponse
+	9,  // 50: fairyring.pep.Query.EncryptedTxAll:output_type -> fairyring.pep.QueryAllEncryptedTxResponse
+	11, // 51: fairyring.pep.Query.EncryptedTxAllFromHeight:output_type -> fairyring.pep.QueryAllEncryptedTxFromHeightResponse
+	13, // 52: fairyring.pep.Query.LatestHeight:output_type -> fairyring.pep.QueryLatestHeightResponse
+	15, // 53: fairyring.pep.Query.PepNonce:output_type -> fairyring.pep.QueryGetPepNonceResponse
+	17, // 54: fairyring.pep.Query.PepNonceAll:output_type -> fairyring.pep.QueryAllPepNonceResponse
+	19, // 55: fairyring.pep.Query.PubKey:output_type -> fairyring.pep.QueryPubKeyResponse
+	3,  // 56: fairyring.pep.Query.KeyshareReq:output_type -> fairyring.pep.QueryKeyshareResponse
+	5,  // 57: fairyring.pep.Query.KeyshareReqAll:output_type -> fairyring.pep.QueryAllKeyshareResponse
+	21, // 58: fairyring.pep.Query.ShowPrivateKeyshareReq:output_type -> fairyring.pep.QueryShowPrivateKeyshareReqResponse
+	23, // 59: fairyring.pep.Query.DecryptData:output_type -> fairyring.pep.QueryDecryptDataResponse
+	25, // 60: fairyring.pep.Query.EncryptedTxQueueDepth:output_type -> fairyring.pep.QueryEncryptedTxQueueDepthResponse
+	27, // 61: fairyring.pep.Query.OldestRetainedHeight:output_type -> fairyring.pep.QueryOldestRetainedHeightResponse
+	33, // 62: fairyring.pep.Query.EncryptedTxResult:output_type -> fairyring.pep.QueryEncryptedTxResultResponse
+	35, // 63: fairyring.pep.Query.GeneralEncryptedTxResult:output_type -> fairyring.pep.QueryGeneralEncryptedTxResultResponse
+	37, // 64: fairyring.pep.Query.EncryptedTxByCreator:output_type -> fairyring.pep.QueryEncryptedTxByCreatorResponse
+	29, // 65: fairyring.pep.Query.Lanes:output_type -> fairyring.pep.QueryLanesResponse
+	31, // 66: fairyring.pep.Query.EncryptedTxOrder:output_type -> fairyring.pep.QueryEncryptedTxOrderResponse
+	39, // 67: fairyring.pep.Query.SimulateEncryptedTx:output_type -> fairyring.pep.QuerySimulateEncryptedTxResponse
+	48, // [48:68] is the sub-list for method output_type
+	28, // [28:48] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_fairyring_pep_query_proto_init() }
@@ -12573,6 +21378,198 @@ func file_fairyring_pep_query_proto_init() {
 				return nil
 			}
 		}
+		file_fairyring_pep_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxQueueDepthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxQueueDepthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOldestRetainedHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOldestRetainedHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLanesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLanesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGeneralEncryptedTxResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGeneralEncryptedTxResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxByCreatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEncryptedTxByCreatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateEncryptedTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fairyring_pep_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateEncryptedTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -12580,7 +21577,7 @@ func file_fairyring_pep_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fairyring_pep_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
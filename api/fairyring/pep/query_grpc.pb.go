@@ -31,6 +31,14 @@ const (
 	Query_KeyshareReqAll_FullMethodName           = "/fairyring.pep.Query/KeyshareReqAll"
 	Query_ShowPrivateKeyshareReq_FullMethodName   = "/fairyring.pep.Query/ShowPrivateKeyshareReq"
 	Query_DecryptData_FullMethodName              = "/fairyring.pep.Query/DecryptData"
+	Query_EncryptedTxQueueDepth_FullMethodName    = "/fairyring.pep.Query/EncryptedTxQueueDepth"
+	Query_OldestRetainedHeight_FullMethodName     = "/fairyring.pep.Query/OldestRetainedHeight"
+	Query_EncryptedTxResult_FullMethodName        = "/fairyring.pep.Query/EncryptedTxResult"
+	Query_GeneralEncryptedTxResult_FullMethodName = "/fairyring.pep.Query/GeneralEncryptedTxResult"
+	Query_EncryptedTxByCreator_FullMethodName     = "/fairyring.pep.Query/EncryptedTxByCreator"
+	Query_Lanes_FullMethodName                    = "/fairyring.pep.Query/Lanes"
+	Query_EncryptedTxOrder_FullMethodName         = "/fairyring.pep.Query/EncryptedTxOrder"
+	Query_SimulateEncryptedTx_FullMethodName      = "/fairyring.pep.Query/SimulateEncryptedTx"
 )
 
 // QueryClient is the client API for Query service.
@@ -59,6 +67,22 @@ type QueryClient interface {
 	ShowPrivateKeyshareReq(ctx context.Context, in *QueryShowPrivateKeyshareReqRequest, opts ...grpc.CallOption) (*QueryShowPrivateKeyshareReqResponse, error)
 	// Queries a list of DecryptData items.
 	DecryptData(ctx context.Context, in *QueryDecryptDataRequest, opts ...grpc.CallOption) (*QueryDecryptDataResponse, error)
+	// Queries the number of encrypted txs waiting in the carry-over execution queue.
+	EncryptedTxQueueDepth(ctx context.Context, in *QueryEncryptedTxQueueDepthRequest, opts ...grpc.CallOption) (*QueryEncryptedTxQueueDepthResponse, error)
+	// Queries the oldest height still retained for encrypted txs and aggregated keyshares
+	OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the execution result of a height-targeted encrypted tx
+	EncryptedTxResult(ctx context.Context, in *QueryEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryEncryptedTxResultResponse, error)
+	// Queries the execution result of a general encrypted tx
+	GeneralEncryptedTxResult(ctx context.Context, in *QueryGeneralEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryGeneralEncryptedTxResultResponse, error)
+	// Queries a list of encrypted txs submitted by a creator, optionally filtered by status
+	EncryptedTxByCreator(ctx context.Context, in *QueryEncryptedTxByCreatorRequest, opts ...grpc.CallOption) (*QueryEncryptedTxByCreatorResponse, error)
+	// Queries the effective lane configuration used to build and verify block proposals
+	Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error)
+	// Queries the recorded execution order of the encrypted txs of a target height along with the recomputed one
+	EncryptedTxOrder(ctx context.Context, in *QueryEncryptedTxOrderRequest, opts ...grpc.CallOption) (*QueryEncryptedTxOrderResponse, error)
+	// Estimates the gas and fee of an encrypted tx from its plaintext signed underlying tx
+	SimulateEncryptedTx(ctx context.Context, in *QuerySimulateEncryptedTxRequest, opts ...grpc.CallOption) (*QuerySimulateEncryptedTxResponse, error)
 }
 
 type queryClient struct {
@@ -177,6 +201,78 @@ func (c *queryClient) DecryptData(ctx context.Context, in *QueryDecryptDataReque
 	return out, nil
 }
 
+func (c *queryClient) EncryptedTxQueueDepth(ctx context.Context, in *QueryEncryptedTxQueueDepthRequest, opts ...grpc.CallOption) (*QueryEncryptedTxQueueDepthResponse, error) {
+	out := new(QueryEncryptedTxQueueDepthResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptedTxQueueDepth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error) {
+	out := new(QueryOldestRetainedHeightResponse)
+	err := c.cc.Invoke(ctx, Query_OldestRetainedHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedTxResult(ctx context.Context, in *QueryEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryEncryptedTxResultResponse, error) {
+	out := new(QueryEncryptedTxResultResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptedTxResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GeneralEncryptedTxResult(ctx context.Context, in *QueryGeneralEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryGeneralEncryptedTxResultResponse, error) {
+	out := new(QueryGeneralEncryptedTxResultResponse)
+	err := c.cc.Invoke(ctx, Query_GeneralEncryptedTxResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedTxByCreator(ctx context.Context, in *QueryEncryptedTxByCreatorRequest, opts ...grpc.CallOption) (*QueryEncryptedTxByCreatorResponse, error) {
+	out := new(QueryEncryptedTxByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptedTxByCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error) {
+	out := new(QueryLanesResponse)
+	err := c.cc.Invoke(ctx, Query_Lanes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedTxOrder(ctx context.Context, in *QueryEncryptedTxOrderRequest, opts ...grpc.CallOption) (*QueryEncryptedTxOrderResponse, error) {
+	out := new(QueryEncryptedTxOrderResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptedTxOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateEncryptedTx(ctx context.Context, in *QuerySimulateEncryptedTxRequest, opts ...grpc.CallOption) (*QuerySimulateEncryptedTxResponse, error) {
+	out := new(QuerySimulateEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateEncryptedTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -203,6 +299,22 @@ type QueryServer interface {
 	ShowPrivateKeyshareReq(context.Context, *QueryShowPrivateKeyshareReqRequest) (*QueryShowPrivateKeyshareReqResponse, error)
 	// Queries a list of DecryptData items.
 	DecryptData(context.Context, *QueryDecryptDataRequest) (*QueryDecryptDataResponse, error)
+	// Queries the number of encrypted txs waiting in the carry-over execution queue.
+	EncryptedTxQueueDepth(context.Context, *QueryEncryptedTxQueueDepthRequest) (*QueryEncryptedTxQueueDepthResponse, error)
+	// Queries the oldest height still retained for encrypted txs and aggregated keyshares
+	OldestRetainedHeight(context.Context, *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the execution result of a height-targeted encrypted tx
+	EncryptedTxResult(context.Context, *QueryEncryptedTxResultRequest) (*QueryEncryptedTxResultResponse, error)
+	// Queries the execution result of a general encrypted tx
+	GeneralEncryptedTxResult(context.Context, *QueryGeneralEncryptedTxResultRequest) (*QueryGeneralEncryptedTxResultResponse, error)
+	// Queries a list of encrypted txs submitted by a creator, optionally filtered by status
+	EncryptedTxByCreator(context.Context, *QueryEncryptedTxByCreatorRequest) (*QueryEncryptedTxByCreatorResponse, error)
+	// Queries the effective lane configuration used to build and verify block proposals
+	Lanes(context.Context, *QueryLanesRequest) (*QueryLanesResponse, error)
+	// Queries the recorded execution order of the encrypted txs of a target height along with the recomputed one
+	EncryptedTxOrder(context.Context, *QueryEncryptedTxOrderRequest) (*QueryEncryptedTxOrderResponse, error)
+	// Estimates the gas and fee of an encrypted tx from its plaintext signed underlying tx
+	SimulateEncryptedTx(context.Context, *QuerySimulateEncryptedTxRequest) (*QuerySimulateEncryptedTxResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -246,6 +358,30 @@ func (UnimplementedQueryServer) ShowPrivateKeyshareReq(context.Context, *QuerySh
 func (UnimplementedQueryServer) DecryptData(context.Context, *QueryDecryptDataRequest) (*QueryDecryptDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecryptData not implemented")
 }
+func (UnimplementedQueryServer) EncryptedTxQueueDepth(context.Context, *QueryEncryptedTxQueueDepthRequest) (*QueryEncryptedTxQueueDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxQueueDepth not implemented")
+}
+func (UnimplementedQueryServer) OldestRetainedHeight(context.Context, *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OldestRetainedHeight not implemented")
+}
+func (UnimplementedQueryServer) EncryptedTxResult(context.Context, *QueryEncryptedTxResultRequest) (*QueryEncryptedTxResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxResult not implemented")
+}
+func (UnimplementedQueryServer) GeneralEncryptedTxResult(context.Context, *QueryGeneralEncryptedTxResultRequest) (*QueryGeneralEncryptedTxResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralEncryptedTxResult not implemented")
+}
+func (UnimplementedQueryServer) EncryptedTxByCreator(context.Context, *QueryEncryptedTxByCreatorRequest) (*QueryEncryptedTxByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxByCreator not implemented")
+}
+func (UnimplementedQueryServer) Lanes(context.Context, *QueryLanesRequest) (*QueryLanesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lanes not implemented")
+}
+func (UnimplementedQueryServer) EncryptedTxOrder(context.Context, *QueryEncryptedTxOrderRequest) (*QueryEncryptedTxOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxOrder not implemented")
+}
+func (UnimplementedQueryServer) SimulateEncryptedTx(context.Context, *QuerySimulateEncryptedTxRequest) (*QuerySimulateEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEncryptedTx not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -475,6 +611,150 @@ func _Query_DecryptData_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EncryptedTxQueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxQueueDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxQueueDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptedTxQueueDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxQueueDepth(ctx, req.(*QueryEncryptedTxQueueDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OldestRetainedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOldestRetainedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OldestRetainedHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, req.(*QueryOldestRetainedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptedTxResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxResult(ctx, req.(*QueryEncryptedTxResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeneralEncryptedTxResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGeneralEncryptedTxResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralEncryptedTxResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GeneralEncryptedTxResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralEncryptedTxResult(ctx, req.(*QueryGeneralEncryptedTxResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptedTxByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxByCreator(ctx, req.(*QueryEncryptedTxByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lanes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLanesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lanes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Lanes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lanes(ctx, req.(*QueryLanesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptedTxOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxOrder(ctx, req.(*QueryEncryptedTxOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateEncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateEncryptedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateEncryptedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateEncryptedTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateEncryptedTx(ctx, req.(*QuerySimulateEncryptedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -530,6 +810,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecryptData",
 			Handler:    _Query_DecryptData_Handler,
 		},
+		{
+			MethodName: "EncryptedTxQueueDepth",
+			Handler:    _Query_EncryptedTxQueueDepth_Handler,
+		},
+		{
+			MethodName: "OldestRetainedHeight",
+			Handler:    _Query_OldestRetainedHeight_Handler,
+		},
+		{
+			MethodName: "EncryptedTxResult",
+			Handler:    _Query_EncryptedTxResult_Handler,
+		},
+		{
+			MethodName: "GeneralEncryptedTxResult",
+			Handler:    _Query_GeneralEncryptedTxResult_Handler,
+		},
+		{
+			MethodName: "EncryptedTxByCreator",
+			Handler:    _Query_EncryptedTxByCreator_Handler,
+		},
+		{
+			MethodName: "Lanes",
+			Handler:    _Query_Lanes_Handler,
+		},
+		{
+			MethodName: "EncryptedTxOrder",
+			Handler:    _Query_EncryptedTxOrder_Handler,
+		},
+		{
+			MethodName: "SimulateEncryptedTx",
+			Handler:    _Query_SimulateEncryptedTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fairyring/pep/query.proto",
@@ -29,6 +29,7 @@ const (
 	Msg_GetPrivateKeyshares_FullMethodName      = "/fairyring.pep.Msg/GetPrivateKeyshares"
 	Msg_RegisterContract_FullMethodName         = "/fairyring.pep.Msg/RegisterContract"
 	Msg_UnregisterContract_FullMethodName       = "/fairyring.pep.Msg/UnregisterContract"
+	Msg_CancelEncryptedTx_FullMethodName        = "/fairyring.pep.Msg/CancelEncryptedTx"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +49,7 @@ type MsgClient interface {
 	GetPrivateKeyshares(ctx context.Context, in *MsgGetPrivateKeyshares, opts ...grpc.CallOption) (*MsgGetPrivateKeysharesResponse, error)
 	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
 	UnregisterContract(ctx context.Context, in *MsgUnregisterContract, opts ...grpc.CallOption) (*MsgUnregisterContractResponse, error)
+	CancelEncryptedTx(ctx context.Context, in *MsgCancelEncryptedTx, opts ...grpc.CallOption) (*MsgCancelEncryptedTxResponse, error)
 }
 
 type msgClient struct {
@@ -148,6 +150,15 @@ func (c *msgClient) UnregisterContract(ctx context.Context, in *MsgUnregisterCon
 	return out, nil
 }
 
+func (c *msgClient) CancelEncryptedTx(ctx context.Context, in *MsgCancelEncryptedTx, opts ...grpc.CallOption) (*MsgCancelEncryptedTxResponse, error) {
+	out := new(MsgCancelEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelEncryptedTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -165,6 +176,7 @@ type MsgServer interface {
 	GetPrivateKeyshares(context.Context, *MsgGetPrivateKeyshares) (*MsgGetPrivateKeysharesResponse, error)
 	RegisterContract(context.Context, *MsgRegisterContract) (*MsgRegisterContractResponse, error)
 	UnregisterContract(context.Context, *MsgUnregisterContract) (*MsgUnregisterContractResponse, error)
+	CancelEncryptedTx(context.Context, *MsgCancelEncryptedTx) (*MsgCancelEncryptedTxResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -202,6 +214,9 @@ func (UnimplementedMsgServer) RegisterContract(context.Context, *MsgRegisterCont
 func (UnimplementedMsgServer) UnregisterContract(context.Context, *MsgUnregisterContract) (*MsgUnregisterContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterContract not implemented")
 }
+func (UnimplementedMsgServer) CancelEncryptedTx(context.Context, *MsgCancelEncryptedTx) (*MsgCancelEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEncryptedTx not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -395,6 +410,24 @@ func _Msg_UnregisterContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelEncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelEncryptedTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelEncryptedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelEncryptedTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelEncryptedTx(ctx, req.(*MsgCancelEncryptedTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -442,6 +475,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterContract",
 			Handler:    _Msg_UnregisterContract_Handler,
 		},
+		{
+			MethodName: "CancelEncryptedTx",
+			Handler:    _Msg_CancelEncryptedTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fairyring/pep/tx.proto",
@@ -5,6 +5,7 @@ import (
 	circuitante "cosmossdk.io/x/circuit/ante"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	keysharelane "github.com/Fairblock/fairyring/lanes/keyshare"
 	pepante "github.com/Fairblock/fairyring/x/pep/ante"
 	pepkeeper "github.com/Fairblock/fairyring/x/pep/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,7 @@ type FairyringHandlerOptions struct {
 	// FreeLane              block.Lane
 	BaseOptions           ante.HandlerOptions
 	KeyShareLane          pepante.KeyShareLane
+	KeyshareFactory       keysharelane.Factory
 	TxDecoder             sdk.TxDecoder
 	TxEncoder             sdk.TxEncoder
 	PepKeeper             pepkeeper.Keeper
@@ -55,6 +57,17 @@ func NewFairyringAnteHandler(options FairyringHandlerOptions) sdk.AnteHandler {
 		panic("circuit keeper is required for ante builder")
 	}
 
+	var feeDecorator sdk.AnteDecorator = ante.NewDeductFeeDecorator(
+		options.BaseOptions.AccountKeeper,
+		options.BaseOptions.BankKeeper,
+		options.BaseOptions.FeegrantKeeper,
+		options.BaseOptions.TxFeeChecker,
+	)
+	// keyshare submissions can be exempt from fees by the keyshare lane submission config
+	if options.KeyshareFactory != nil {
+		feeDecorator = keysharelane.NewFeeExemptDecorator(options.KeyshareFactory, feeDecorator)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit),
@@ -66,12 +79,7 @@ func NewFairyringAnteHandler(options FairyringHandlerOptions) sdk.AnteHandler {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.BaseOptions.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.BaseOptions.AccountKeeper),
-		ante.NewDeductFeeDecorator(
-			options.BaseOptions.AccountKeeper,
-			options.BaseOptions.BankKeeper,
-			options.BaseOptions.FeegrantKeeper,
-			options.BaseOptions.TxFeeChecker,
-		),
+		feeDecorator,
 		ante.NewSetPubKeyDecorator(options.BaseOptions.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.BaseOptions.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SigGasConsumer),
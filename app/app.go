@@ -94,6 +94,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/spf13/cast"
 
 	fairyabci "github.com/Fairblock/fairyring/abci"
 	ibcconsumertypes "github.com/cosmos/interchain-security/v3/x/ccv/consumer/types"
@@ -366,14 +367,15 @@ func New(
 	// ------------------------- Begin Custom Code -------------------------------- //
 	// ---------------------------------------------------------------------------- //
 	// STEP 1-3: Create the Block SDK lanes.
-	keyshareLane, defaultLane := CreateLanes(app)
+	keyshareLane, encryptedLane, defaultLane := CreateLanes(app)
 
 	// STEP 4: Construct a mempool based off the lanes. Note that the order of the lanes
 	// matters. Blocks are constructed from the top lane to the bottom lane. The top lane
 	// is the first lane in the array and the bottom lane is the last lane in the array.
+	// The lanes are reordered by the lane params of the pep module when building blocks.
 	mempool, err := block.NewLanedMempool(
 		app.Logger(),
-		[]block.Lane{keyshareLane, defaultLane},
+		ConfigurableLanes(app, keyshareLane, encryptedLane, defaultLane),
 	)
 	if err != nil {
 		panic(err)
@@ -407,6 +409,7 @@ func New(
 		TxDecoder:             app.txConfig.TxDecoder(),
 		TxEncoder:             app.txConfig.TxEncoder(),
 		KeyShareLane:          keyshareLane,
+		KeyshareFactory:       keyshareLane.Factory,
 		PepKeeper:             app.PepKeeper,
 	}
 	anteHandler := NewFairyringAnteHandler(options)
@@ -419,6 +422,9 @@ func New(
 	keyshareLane.WithOptions(
 		opt...,
 	)
+	encryptedLane.WithOptions(
+		opt...,
+	)
 	// freeLane.WithOptions(
 	// 	opt...,
 	// )
@@ -433,9 +439,24 @@ func New(
 		app.txConfig.TxDecoder(),
 		app.txConfig.TxEncoder(),
 		mempool,
+		app.KeyshareKeeper,
+		app.StakingKeeper,
+		app.PepKeeper,
 	)
 	app.App.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
 	app.App.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+	// The aggregated key injected by the proposer is stored before the modules begin the block.
+	app.App.SetPreBlocker(proposalHandler.PreBlocker(app.App.PreBlocker))
+
+	// Validators attach the keyshare of the next height to their votes, the keyshares are
+	// aggregated by the next proposer once vote extensions are enabled in the consensus params.
+	voteExtHandler := fairyabci.NewVoteExtensionHandler(
+		app.Logger(),
+		app.KeyshareKeeper,
+		cast.ToString(appOpts.Get(FlagVoteExtensionKeyshareFile)),
+	)
+	app.App.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	app.App.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
 
 	// Step 7: Set the custom CheckTx handler on BaseApp. This is only required if you
 	// use the keyshare lane.
@@ -509,6 +530,17 @@ func New(
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		"v0.9.0-to-0.10.0-release",
+		func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// run the pep 3 to 4 and keyshare 2 to 3 migrations,
+			// they default the new params and have to start from the versions of the previous release
+			fromVM[peptypes.ModuleName] = 3
+			fromVM[keysharemoduletypes.ModuleName] = 2
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
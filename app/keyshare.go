@@ -11,6 +11,10 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 )
 
+// FlagVoteExtensionKeyshareFile is the app config of the json file with the keyshare of the validator
+// attached to its votes, no keyshare is attached when it is empty
+const FlagVoteExtensionKeyshareFile = "keyshare.vote-extension-keyshare-file"
+
 // registerKeyshareModule register Keyshare keepers and non dependency inject modules.
 func (app *App) registerKeyshareModule() (porttypes.IBCModule, error) {
 	// set up non depinject support modules store keys
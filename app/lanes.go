@@ -3,17 +3,21 @@ package app
 import (
 	"cosmossdk.io/math"
 
+	"github.com/Fairblock/fairyring/lanes/configurable"
+	encryptedlane "github.com/Fairblock/fairyring/lanes/encrypted"
 	keysharelane "github.com/Fairblock/fairyring/lanes/keyshare"
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
 	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/base"
 	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
 )
 
 // CreateLanes walks through the process of creating the lanes for the block sdk. In this function
-// we create three separate lanes - Keyshare, Free, and Default - and then return them.
+// we create three separate lanes - Keyshare, Encrypted, and Default - and then return them.
 //
 // NOTE: Application Developers should closely replicate this function in their own application.
-func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
+func CreateLanes(app *App) (*keysharelane.KeyShareLane, *encryptedlane.EncryptedTxLane, *base.BaseLane) {
 	// 1. Create the signer extractor. This is used to extract the expected signers from
 	// a transaction. Each lane can have a different signer extractor if needed.
 	signerAdapter := signerextraction.NewDefaultAdapter()
@@ -22,8 +26,9 @@ func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
 	// transactions the lane can store, the maximum block space the lane can consume, and
 	// the signer extractor used to extract the expected signers from a transaction.
 	//
-	// IMPORTANT NOTE: If the block sdk module is utilized to store lanes, than the maximum
-	// block space will be replaced with what is in state / in the genesis file.
+	// IMPORTANT NOTE: The order, maximum block space and maximum number of txs per block of the
+	// lanes are replaced with the lane params of the pep module, the maximum block space of
+	// these configurations is only used for the lanes the params do not list.
 
 	// Create a keyshare configuration that accepts 1000 transactions and consumes 20% of the
 	// block space.
@@ -36,6 +41,25 @@ func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
 		MaxTxs:          10000,
 	}
 
+	// Keyshare submissions of registered validators and authorized addresses can consume
+	// half of the keyshare lane block space, they pay fees like any other transaction.
+	submissionConfig := keysharelane.SubmissionConfig{
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.5"),
+		FeeExempt:       false,
+		MaxFeeExemptGas: 500000,
+	}
+
+	// Create an encrypted tx configuration that accepts 10000 transactions and consumes 20% of the
+	// block space.
+	encryptedConfig := base.LaneConfig{
+		Logger:          app.Logger(),
+		TxEncoder:       app.txConfig.TxEncoder(),
+		TxDecoder:       app.txConfig.TxDecoder(),
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.2"),
+		SignerExtractor: signerAdapter,
+		MaxTxs:          10000,
+	}
+
 	// Create a free configuration that accepts 1000 transactions and consumes 20% of the
 	// block space.
 	// freeConfig := base.LaneConfig{
@@ -47,13 +71,13 @@ func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
 	// 	MaxTxs:          1000,
 	// }
 
-	// Create a default configuration that accepts 1000 transactions and consumes 60% of the
-	// block space.
+	// Create a default configuration that accepts 10000 transactions and consumes the block
+	// space left by the other lanes.
 	defaultConfig := base.LaneConfig{
 		Logger:          app.Logger(),
 		TxEncoder:       app.txConfig.TxEncoder(),
 		TxDecoder:       app.txConfig.TxDecoder(),
-		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.7"),
+		MaxBlockSpace:   math.LegacyZeroDec(),
 		SignerExtractor: signerAdapter,
 		MaxTxs:          10000,
 	}
@@ -62,7 +86,12 @@ func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
 	// a transaction belongs in the lane.
 
 	// Create the final match handler for the keyshare lane.
-	factory := keysharelane.NewDefaultKeyshareFactory(app.txConfig.TxDecoder(), signerAdapter)
+	factory := keysharelane.NewDefaultKeyshareFactory(
+		app.txConfig.TxDecoder(),
+		signerAdapter,
+		app.KeyshareKeeper,
+		submissionConfig,
+	)
 	keyshareMatchHandler := factory.MatchHandler()
 
 	// Create the final match handler for the free lane.
@@ -78,6 +107,11 @@ func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
 		keyshareMatchHandler,
 	)
 
+	encryptedLane := encryptedlane.NewEncryptedTxLane(
+		encryptedConfig,
+		app.PepKeeper,
+	)
+
 	// freeLane := freelane.NewFreeLane(
 	// 	freeConfig,
 	// 	base.DefaultTxPriority(),
@@ -89,5 +123,32 @@ func CreateLanes(app *App) (*keysharelane.KeyShareLane, *base.BaseLane) {
 		defaultMatchHandler,
 	)
 
-	return keyshareLane, defaultLane
+	return keyshareLane, encryptedLane, defaultLane
+}
+
+// ConfigurableLanes wraps the lanes so the proposal handler runs them with the order, maximum block
+// space and maximum number of txs per block of the lane params. The lanes are registered in the pep
+// keeper with their default config.
+func ConfigurableLanes(
+	app *App,
+	keyshareLane *keysharelane.KeyShareLane,
+	encryptedLane *encryptedlane.EncryptedTxLane,
+	defaultLane *base.BaseLane,
+) []block.Lane {
+	lanes := []*configurable.Lane{
+		configurable.NewLane(keyshareLane.BaseLane, keysharelane.NewProposalHandler(keyshareLane.BaseLane, keyshareLane.Factory)),
+		configurable.NewLane(encryptedLane.BaseLane, encryptedlane.NewProposalHandler(encryptedLane.BaseLane, app.PepKeeper)),
+		configurable.NewLane(defaultLane, base.NewDefaultProposalHandler(defaultLane)),
+	}
+
+	registry := make([]block.Lane, len(lanes))
+	registered := make([]*peptypes.LaneParams, len(lanes))
+	for i, lane := range lanes {
+		params := lane.Params()
+		registry[i] = lane
+		registered[i] = &params
+	}
+	app.PepKeeper.SetRegisteredLanes(registered)
+
+	return registry
 }
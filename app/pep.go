@@ -39,6 +39,7 @@ func (app *App) registerPepModule() (porttypes.IBCModule, error) {
 		contractKeeper,
 		app.WasmKeeper,
 	)
+	app.PepKeeper.SetTxSimulationHandlers(app.MsgServiceRouter(), app.txConfig)
 
 	// register IBC modules
 	if err := app.RegisterModules(
@@ -47,6 +48,7 @@ func (app *App) registerPepModule() (porttypes.IBCModule, error) {
 			app.PepKeeper,
 			app.AccountKeeper,
 			app.BankKeeper,
+			app.FeeGrantKeeper,
 			app.MsgServiceRouter(),
 			app.txConfig,
 			app.SimCheck,
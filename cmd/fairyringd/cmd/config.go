@@ -40,8 +40,14 @@ func initCometBFTConfig() *cmtcfg.Config {
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
+	type KeyshareConfig struct {
+		VoteExtensionKeyshareFile string `mapstructure:"vote-extension-keyshare-file"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		Keyshare KeyshareConfig `mapstructure:"keyshare"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -63,10 +69,23 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:   *srvCfg,
+		Keyshare: KeyshareConfig{},
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                        Keyshare Configuration                           ###
+###############################################################################
+
+[keyshare]
+
+# Path to the json file with the keyshare of the validator for the active public key:
+# {"pub_key": "<hex>", "key_share": "<hex>", "key_share_index": <index>}
+# The keyshare of the next height is attached to the precommit votes once vote extensions are enabled.
+# Leave empty to not attach keyshares.
+vote-extension-keyshare-file = "{{ .Keyshare.VoteExtensionKeyshareFile }}"
+`
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -0,0 +1,198 @@
+package configurable
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
+)
+
+type (
+	// LaneHandler defines the proposal handlers of a lane.
+	LaneHandler interface {
+		PrepareLaneHandler() base.PrepareLaneHandler
+		ProcessLaneHandler() base.ProcessLaneHandler
+	}
+
+	// LimitedLaneHandler is implemented by the lane handlers verifying a partial proposal against the lane limits.
+	LimitedLaneHandler interface {
+		ProcessLaneHandlerWithLimits(limit proposals.LaneLimits) base.ProcessLaneHandler
+	}
+
+	// Lane wraps a lane so it is run with the block space share and max tx count of its lane params.
+	// The lane params are set by the proposal handler at each proposal, the max block space of the
+	// lane config is the default share of the lane.
+	Lane struct {
+		*base.BaseLane
+
+		handler LaneHandler
+		params  peptypes.LaneParams
+	}
+)
+
+var _ block.Lane = (*Lane)(nil)
+
+// NewLane returns a new configurable lane running the proposal handlers of the wrapped lane.
+func NewLane(lane *base.BaseLane, handler LaneHandler) *Lane {
+	return &Lane{
+		BaseLane: lane,
+		handler:  handler,
+		params: peptypes.LaneParams{
+			Name:          lane.Name(),
+			MaxBlockSpace: lane.GetMaxBlockSpace(),
+		},
+	}
+}
+
+// WithParams returns a copy of the lane run with the given lane params.
+func (l *Lane) WithParams(params peptypes.LaneParams) *Lane {
+	lane := *l
+	lane.params = params
+	return &lane
+}
+
+// Params returns the lane params the lane is run with.
+func (l *Lane) Params() peptypes.LaneParams {
+	return l.params
+}
+
+// GetMaxBlockSpace returns the block space share of the lane params.
+func (l *Lane) GetMaxBlockSpace() math.LegacyDec {
+	return l.params.MaxBlockSpace
+}
+
+// PrepareLane selects the transactions of the lane within the block space share and max tx count of
+// the lane params and adds them to the proposal. The transactions beyond the max tx count stay in the
+// mempool.
+func (l *Lane) PrepareLane(
+	ctx sdk.Context,
+	proposal proposals.Proposal,
+	next block.PrepareLanesHandler,
+) (proposals.Proposal, error) {
+	l.Logger().Info("preparing lane", "lane", l.Name())
+
+	limit := proposal.GetLaneLimits(l.GetMaxBlockSpace())
+
+	cacheCtx, write := ctx.CacheContext()
+	txsToInclude, txsToRemove, err := l.handler.PrepareLaneHandler()(cacheCtx, proposal, limit)
+	if err != nil {
+		l.Logger().Error("failed to prepare lane", "lane", l.Name(), "err", err)
+		return proposal, err
+	}
+
+	if maxTxs := l.params.MaxTxs; maxTxs != 0 && uint64(len(txsToInclude)) > maxTxs {
+		// The state of the dropped transactions must not be written, the selected ones
+		// are verified again on the original context.
+		txsToInclude = txsToInclude[:maxTxs]
+		for _, tx := range txsToInclude {
+			if err := l.VerifyTx(ctx, tx, false); err != nil {
+				return proposal, err
+			}
+		}
+	} else {
+		write()
+	}
+
+	if err := utils.RemoveTxsFromLane(txsToRemove, l); err != nil {
+		l.Logger().Error("failed to remove transactions from lane", "lane", l.Name(), "err", err)
+	}
+
+	txsWithInfo, err := l.getTxsInfo(ctx, txsToInclude)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := proposal.UpdateProposal(l, txsWithInfo); err != nil {
+		l.Logger().Error(
+			"failed to update proposal",
+			"lane", l.Name(),
+			"err", err,
+			"num_txs_to_add", len(txsToInclude),
+			"lane_max_block_size", limit.MaxTxBytes,
+			"lane_max_gas_limit", limit.MaxGasLimit,
+		)
+		return proposal, err
+	}
+
+	l.Logger().Info(
+		"lane prepared",
+		"lane", l.Name(),
+		"num_txs_added", len(txsToInclude),
+		"num_txs_removed", len(txsToRemove),
+		"lane_max_block_size", limit.MaxTxBytes,
+		"lane_max_gas_limit", limit.MaxGasLimit,
+		"lane_max_txs", l.params.MaxTxs,
+	)
+
+	return next(ctx, proposal)
+}
+
+// ProcessLane verifies the transactions of the lane in the proposal, it returns an error if they exceed
+// the block space share or max tx count of the lane params.
+func (l *Lane) ProcessLane(
+	ctx sdk.Context,
+	proposal proposals.Proposal,
+	txs []sdk.Tx,
+	next block.ProcessLanesHandler,
+) (proposals.Proposal, error) {
+	l.Logger().Info("processing lane", "lane", l.Name(), "num_txs_to_verify", len(txs))
+
+	if len(txs) == 0 {
+		return next(ctx, proposal, txs)
+	}
+
+	processLaneHandler := l.handler.ProcessLaneHandler()
+	if handler, ok := l.handler.(LimitedLaneHandler); ok {
+		processLaneHandler = handler.ProcessLaneHandlerWithLimits(proposal.GetLaneLimits(l.GetMaxBlockSpace()))
+	}
+
+	txsFromLane, remainingTxs, err := processLaneHandler(ctx, txs)
+	if err != nil {
+		l.Logger().Error("failed to process lane", "lane", l.Name(), "err", err)
+		return proposal, err
+	}
+
+	if maxTxs := l.params.MaxTxs; maxTxs != 0 && uint64(len(txsFromLane)) > maxTxs {
+		return proposal, fmt.Errorf("lane %s has %d txs, max txs: %d", l.Name(), len(txsFromLane), maxTxs)
+	}
+
+	txsWithInfo, err := l.getTxsInfo(ctx, txsFromLane)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := proposal.UpdateProposal(l, txsWithInfo); err != nil {
+		l.Logger().Error("failed to update proposal", "lane", l.Name(), "num_txs_verified", len(txsFromLane), "err", err)
+		return proposal, err
+	}
+
+	l.Logger().Info(
+		"lane processed",
+		"lane", l.Name(),
+		"num_txs_verified", len(txsFromLane),
+		"num_txs_remaining", len(remainingTxs),
+	)
+
+	return next(ctx, proposal, remainingTxs)
+}
+
+// getTxsInfo returns the info of the transactions.
+func (l *Lane) getTxsInfo(ctx sdk.Context, txs []sdk.Tx) ([]utils.TxWithInfo, error) {
+	txsWithInfo := make([]utils.TxWithInfo, len(txs))
+	for i, tx := range txs {
+		txInfo, err := l.GetTxInfo(ctx, tx)
+		if err != nil {
+			l.Logger().Error("failed to get tx info", "lane", l.Name(), "err", err)
+			return nil, err
+		}
+		txsWithInfo[i] = txInfo
+	}
+	return txsWithInfo, nil
+}
@@ -0,0 +1,81 @@
+package configurable_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+
+	"github.com/Fairblock/fairyring/lanes/configurable"
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
+)
+
+func TestConfigurableLane(t *testing.T) {
+	encCfg := testutils.CreateTestEncodingConfig()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 100000, MaxGas: -1}})
+	accounts := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+
+	defaultLane := defaultlane.NewDefaultLane(
+		base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       encCfg.TxConfig.TxEncoder(),
+			TxDecoder:       encCfg.TxConfig.TxDecoder(),
+			MaxBlockSpace:   math.LegacyZeroDec(),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxTxs:          100,
+		},
+		base.DefaultMatchHandler(),
+	)
+	lane := configurable.NewLane(defaultLane, base.NewDefaultProposalHandler(defaultLane))
+	require.Equal(t, peptypes.LaneParams{Name: defaultLane.Name(), MaxBlockSpace: math.LegacyZeroDec()}, lane.Params())
+
+	txs := make([]sdk.Tx, len(accounts))
+	for i, account := range accounts {
+		tx, err := testutils.CreateTx(encCfg.TxConfig, account, 0, 0, []sdk.Msg{&banktypes.MsgSend{FromAddress: account.Address.String()}})
+		require.NoError(t, err)
+		require.NoError(t, lane.Insert(ctx, tx))
+		txs[i] = tx
+	}
+
+	prepareNext := func(_ sdk.Context, p proposals.Proposal) (proposals.Proposal, error) { return p, nil }
+	processNext := func(_ sdk.Context, p proposals.Proposal, _ []sdk.Tx) (proposals.Proposal, error) { return p, nil }
+
+	// the max tx count of the params limits the selected txs, the others stay in the mempool
+	limited := lane.WithParams(peptypes.LaneParams{Name: lane.Name(), MaxBlockSpace: math.LegacyMustNewDecFromStr("0.5"), MaxTxs: 2})
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), limited.GetMaxBlockSpace())
+	require.Equal(t, math.LegacyZeroDec(), lane.GetMaxBlockSpace())
+
+	proposal, err := limited.PrepareLane(ctx, proposals.NewProposalWithContext(ctx, log.NewNopLogger()), prepareNext)
+	require.NoError(t, err)
+	require.Len(t, proposal.Txs, 2)
+	require.Equal(t, 3, lane.CountTx())
+
+	_, err = limited.ProcessLane(ctx, proposals.NewProposalWithContext(ctx, log.NewNopLogger()), txs[:2], processNext)
+	require.NoError(t, err)
+
+	_, err = limited.ProcessLane(ctx, proposals.NewProposalWithContext(ctx, log.NewNopLogger()), txs, processNext)
+	require.ErrorContains(t, err, "max txs: 2")
+
+	// the block space share of the params limits the lane
+	tight := lane.WithParams(peptypes.LaneParams{Name: lane.Name(), MaxBlockSpace: math.LegacyMustNewDecFromStr("0.001")})
+	proposal, err = tight.PrepareLane(ctx, proposals.NewProposalWithContext(ctx, log.NewNopLogger()), prepareNext)
+	require.NoError(t, err)
+	require.Empty(t, proposal.Txs)
+
+	_, err = tight.ProcessLane(ctx, proposals.NewProposalWithContext(ctx, log.NewNopLogger()), txs[:1], processNext)
+	require.ErrorContains(t, err, "partial proposal is too large")
+}
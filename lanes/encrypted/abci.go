@@ -0,0 +1,166 @@
+package encrypted
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+)
+
+// Implements the encrypted tx lane's PrepareLaneHandler and ProcessLaneHandler.
+type ProposalHandler struct {
+	lane      *base.BaseLane
+	pepKeeper PepKeeper
+}
+
+// NewProposalHandler returns a new encrypted tx proposal handler.
+func NewProposalHandler(lane *base.BaseLane, pepKeeper PepKeeper) *ProposalHandler {
+	return &ProposalHandler{
+		lane:      lane,
+		pepKeeper: pepKeeper,
+	}
+}
+
+// PrepareLaneHandler selects the encrypted txs with the highest fees that fit in the lane limits.
+// Encrypted txs targeting an executed height are removed from the mempool, the ones targeting
+// a height beyond the queued key expiry stay in it.
+func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal proposals.Proposal, limit proposals.LaneLimits) ([]sdk.Tx, []sdk.Tx, error) {
+		var (
+			txsToInclude []sdk.Tx
+			txsToRemove  []sdk.Tx
+
+			totalSize int64
+			totalGas  uint64
+		)
+
+		executedHeight, maxHeight, heightErr := h.pepKeeper.EncryptedTxTargetHeightRange(ctx)
+
+		for iterator := h.lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			tx := iterator.Tx()
+
+			txInfo, err := h.lane.GetTxInfo(ctx, tx)
+			if err != nil {
+				h.lane.Logger().Info("failed to get hash of encrypted tx", "err", err)
+
+				txsToRemove = append(txsToRemove, tx)
+				continue
+			}
+
+			if !h.lane.Match(ctx, tx) {
+				h.lane.Logger().Info("failed to select encrypted tx for lane; tx does not match lane", "tx_hash", txInfo.Hash)
+
+				txsToRemove = append(txsToRemove, tx)
+				continue
+			}
+
+			if proposal.Contains(txInfo.Hash) {
+				continue
+			}
+
+			if err := ValidateTargetHeights(tx, executedHeight, maxHeight, heightErr); err != nil {
+				h.lane.Logger().Info("failed to select encrypted tx for lane", "tx_hash", txInfo.Hash, "err", err)
+
+				if isExecuted(tx, executedHeight) {
+					txsToRemove = append(txsToRemove, tx)
+				}
+				continue
+			}
+
+			if totalSize+txInfo.Size > limit.MaxTxBytes || totalGas+txInfo.GasLimit > limit.MaxGasLimit {
+				h.lane.Logger().Info(
+					"failed to select encrypted tx for lane; tx does not fit in the lane",
+					"tx_hash", txInfo.Hash,
+					"tx_size", txInfo.Size,
+					"total_size", totalSize,
+					"max_tx_bytes", limit.MaxTxBytes,
+					"tx_gas", txInfo.GasLimit,
+					"total_gas", totalGas,
+					"max_gas", limit.MaxGasLimit,
+				)
+				continue
+			}
+
+			if err := h.lane.VerifyTx(ctx, tx, false); err != nil {
+				h.lane.Logger().Info("failed to verify encrypted tx", "tx_hash", txInfo.Hash, "err", err)
+
+				txsToRemove = append(txsToRemove, tx)
+				continue
+			}
+
+			txsToInclude = append(txsToInclude, tx)
+			totalSize += txInfo.Size
+			totalGas += txInfo.GasLimit
+		}
+
+		return txsToInclude, txsToRemove, nil
+	}
+}
+
+// ProcessLaneHandler ensures that if encrypted txs are present in a proposal,
+//   - they are contiguous in the partial proposal
+//   - they do not target an executed height or a height beyond the queued key expiry
+//   - they pass the ante handler
+func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
+	return func(ctx sdk.Context, partialProposal []sdk.Tx) ([]sdk.Tx, []sdk.Tx, error) {
+		if len(partialProposal) == 0 {
+			return nil, nil, nil
+		}
+
+		countEncryptedTxs := 0
+
+		executedHeight, maxHeight, heightErr := h.pepKeeper.EncryptedTxTargetHeightRange(ctx)
+
+		for index, tx := range partialProposal {
+			if !h.lane.Match(ctx, tx) {
+				for _, remaining := range partialProposal[index:] {
+					if h.lane.Match(ctx, remaining) {
+						return nil, nil, fmt.Errorf("misplaced encrypted transactions in lane %s", h.lane.Name())
+					}
+				}
+				break
+			}
+
+			countEncryptedTxs++
+
+			if err := ValidateTargetHeights(tx, executedHeight, maxHeight, heightErr); err != nil {
+				return nil, nil, fmt.Errorf("invalid encrypted tx: %w", err)
+			}
+
+			if err := h.lane.VerifyTx(ctx, tx, false); err != nil {
+				return nil, nil, fmt.Errorf("invalid encrypted tx; failed to execute ante handler: %w", err)
+			}
+		}
+
+		return partialProposal[:countEncryptedTxs], partialProposal[countEncryptedTxs:], nil
+	}
+}
+
+// ValidateTargetHeights returns an error if an encrypted tx of the transaction targets an executed height
+// or a height beyond the queued key expiry. heightErr is the error returned when looking up the range.
+func ValidateTargetHeights(tx sdk.Tx, executedHeight, maxHeight uint64, heightErr error) error {
+	for _, target := range TargetHeights(tx) {
+		if target <= executedHeight {
+			return fmt.Errorf("target height %d is already executed, latest executed height: %d", target, executedHeight)
+		}
+		if heightErr != nil {
+			return heightErr
+		}
+		if target > maxHeight {
+			return fmt.Errorf("target height %d is beyond the queued key expiry: %d", target, maxHeight)
+		}
+	}
+	return nil
+}
+
+// isExecuted returns true if an encrypted tx of the transaction targets an executed height.
+func isExecuted(tx sdk.Tx, executedHeight uint64) bool {
+	for _, target := range TargetHeights(tx) {
+		if target <= executedHeight {
+			return true
+		}
+	}
+	return false
+}
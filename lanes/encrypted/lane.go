@@ -0,0 +1,91 @@
+package encrypted
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/base"
+
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
+)
+
+const (
+	// LaneName defines the name of the encrypted tx lane.
+	LaneName = "encrypted"
+)
+
+type (
+	// PepKeeper defines the pep module functions the encrypted tx lane uses to read the heights
+	// encrypted txs can target.
+	PepKeeper interface {
+		EncryptedTxTargetHeightRange(ctx sdk.Context) (uint64, uint64, error)
+	}
+
+	// EncryptedTxLane defines the lane that is responsible for processing MsgSubmitEncryptedTx
+	// and MsgSubmitGeneralEncryptedTx transactions, ordered by the fee paid on the outer tx.
+	EncryptedTxLane struct {
+		*base.BaseLane
+	}
+)
+
+// NewEncryptedTxLane returns a new encrypted tx lane.
+func NewEncryptedTxLane(cfg base.LaneConfig, pepKeeper PepKeeper) *EncryptedTxLane {
+	options := []base.LaneOption{
+		base.WithMatchHandler(MatchHandler()),
+		base.WithMempoolConfigs[string](cfg, TxPriority()),
+	}
+
+	baseLane, err := base.NewBaseLane(
+		cfg,
+		LaneName,
+		options...,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	handler := NewProposalHandler(baseLane, pepKeeper)
+	baseLane.WithOptions(
+		base.WithPrepareLaneHandler(handler.PrepareLaneHandler()),
+		base.WithProcessLaneHandler(handler.ProcessLaneHandler()),
+	)
+
+	return &EncryptedTxLane{
+		BaseLane: baseLane,
+	}
+}
+
+// MatchHandler matches the transactions that only contain encrypted tx submissions.
+func MatchHandler() base.MatchHandler {
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		return IsEncryptedTx(tx)
+	}
+}
+
+// IsEncryptedTx returns true if all the messages of the transaction submit an encrypted tx.
+func IsEncryptedTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *peptypes.MsgSubmitEncryptedTx, *peptypes.MsgSubmitGeneralEncryptedTx:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// TargetHeights returns the target heights of the MsgSubmitEncryptedTx messages of the transaction.
+func TargetHeights(tx sdk.Tx) []uint64 {
+	var heights []uint64
+	for _, msg := range tx.GetMsgs() {
+		if encryptedTx, ok := msg.(*peptypes.MsgSubmitEncryptedTx); ok {
+			heights = append(heights, encryptedTx.TargetBlockHeight)
+		}
+	}
+	return heights
+}
@@ -0,0 +1,112 @@
+package encrypted_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+
+	"github.com/Fairblock/fairyring/lanes/encrypted"
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
+)
+
+// mockPepKeeper returns a fixed target height range
+type mockPepKeeper struct {
+	executedHeight uint64
+	maxHeight      uint64
+}
+
+func (m *mockPepKeeper) EncryptedTxTargetHeightRange(_ sdk.Context) (uint64, uint64, error) {
+	return m.executedHeight, m.maxHeight, nil
+}
+
+func TestEncryptedTxLane(t *testing.T) {
+	encCfg := testutils.CreateTestEncodingConfig()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 100000, MaxGas: -1}})
+	accounts := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 4)
+
+	pepKeeper := &mockPepKeeper{executedHeight: 10, maxHeight: 20}
+
+	newTx := func(account testutils.Account, fee int64, msgs ...sdk.Msg) sdk.Tx {
+		tx, err := testutils.CreateTx(encCfg.TxConfig, account, 0, 0, msgs, sdk.NewInt64Coin("ufairy", fee))
+		require.NoError(t, err)
+		return tx
+	}
+	encryptedTx := func(account testutils.Account, fee int64, target uint64) sdk.Tx {
+		return newTx(account, fee, &peptypes.MsgSubmitEncryptedTx{Creator: account.Address.String(), TargetBlockHeight: target})
+	}
+
+	lowFee := encryptedTx(accounts[0], 100, 15)
+	highFee := encryptedTx(accounts[1], 300, 12)
+	generalTx := newTx(accounts[2], 200, &peptypes.MsgSubmitGeneralEncryptedTx{Creator: accounts[2].Address.String(), ReqId: "id"})
+	executed := encryptedTx(accounts[3], 1000, 10)
+	other := newTx(accounts[3], 1000, &banktypes.MsgSend{})
+
+	require.True(t, encrypted.IsEncryptedTx(generalTx))
+	require.False(t, encrypted.IsEncryptedTx(newTx(accounts[0], 100,
+		&peptypes.MsgSubmitEncryptedTx{Creator: accounts[0].Address.String()},
+		&banktypes.MsgSend{},
+	)))
+
+	// encrypted txs are ordered by the fee paid on the outer tx
+	priority := encrypted.TxPriority()
+	require.Equal(t, 1, priority.Compare(priority.GetTxPriority(ctx, highFee), priority.GetTxPriority(ctx, lowFee)))
+
+	lane := encrypted.NewEncryptedTxLane(
+		base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       encCfg.TxConfig.TxEncoder(),
+			TxDecoder:       encCfg.TxConfig.TxDecoder(),
+			MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.5"),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxTxs:          100,
+		},
+		pepKeeper,
+	)
+	for _, tx := range []sdk.Tx{lowFee, highFee, generalTx, executed} {
+		require.NoError(t, lane.Insert(ctx, tx))
+	}
+
+	// the highest fees are selected first, the tx targeting an executed height is removed
+	handler := encrypted.NewProposalHandler(lane.BaseLane, pepKeeper)
+	proposal := proposals.NewProposalWithContext(ctx, log.NewNopLogger())
+	included, removed, err := handler.PrepareLaneHandler()(ctx, proposal, proposal.GetLaneLimits(lane.GetMaxBlockSpace()))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Tx{highFee, generalTx, lowFee}, included)
+	require.Equal(t, []sdk.Tx{executed}, removed)
+
+	// the txs that do not fit in the lane limits stay in the mempool
+	included, removed, err = handler.PrepareLaneHandler()(ctx, proposal, proposals.LaneLimits{MaxTxBytes: 10, MaxGasLimit: 1000})
+	require.NoError(t, err)
+	require.Empty(t, included)
+	require.Equal(t, []sdk.Tx{executed}, removed)
+
+	processLane := handler.ProcessLaneHandler()
+	included, remaining, err := processLane(ctx, []sdk.Tx{highFee, lowFee, other})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Tx{highFee, lowFee}, included)
+	require.Equal(t, []sdk.Tx{other}, remaining)
+
+	_, _, err = processLane(ctx, []sdk.Tx{highFee, other, lowFee})
+	require.ErrorContains(t, err, "misplaced encrypted transactions")
+
+	_, _, err = processLane(ctx, []sdk.Tx{executed})
+	require.ErrorContains(t, err, "already executed")
+
+	_, _, err = processLane(ctx, []sdk.Tx{encryptedTx(accounts[0], 100, 21)})
+	require.ErrorContains(t, err, "beyond the queued key expiry")
+
+}
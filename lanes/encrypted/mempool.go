@@ -0,0 +1,38 @@
+package encrypted
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/base"
+)
+
+// TxPriority returns a TxPriority over encrypted tx submissions ordered by the fee paid on the
+// outer tx. Fees that are not comparable, i.e. paid in different denoms, have the same priority.
+func TxPriority() base.TxPriority[string] {
+	return base.TxPriority[string]{
+		GetTxPriority: func(_ context.Context, tx sdk.Tx) string {
+			feeTx, ok := tx.(sdk.FeeTx)
+			if !ok {
+				return ""
+			}
+
+			return feeTx.GetFee().String()
+		},
+		Compare: func(a, b string) int {
+			aCoins, _ := sdk.ParseCoinsNormalized(a)
+			bCoins, _ := sdk.ParseCoinsNormalized(b)
+
+			switch {
+			case aCoins.IsAllGT(bCoins):
+				return 1
+			case bCoins.IsAllGT(aCoins):
+				return -1
+			default:
+				return 0
+			}
+		},
+		MinValue: "",
+	}
+}
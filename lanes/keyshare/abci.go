@@ -25,15 +25,23 @@ func NewProposalHandler(lane *base.BaseLane, factory Factory) *ProposalHandler {
 
 // PrepareLaneHandler will attempt to select the keyshare transactions that are valid
 // and include them in the proposal. It will return an empty partial proposal
-// if no valid keyshare transactions are found.
+// if no valid keyshare transactions are found. Keyshare submission transactions are
+// limited to their share of the lane block space and to one submission per validator
+// and identity.
 func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 	return func(ctx sdk.Context, proposal proposals.Proposal, limit proposals.LaneLimits) ([]sdk.Tx, []sdk.Tx, error) {
 		// Define all of the info we need to select transactions for the partial proposal.
 		var (
 			txsToInclude []sdk.Tx
 			txsToRemove  []sdk.Tx
+
+			totalSize      int64
+			submissionSize int64
+			submissions    = newSubmissionTracker()
 		)
 
+		maxSubmissionSize := h.maxSubmissionSize(limit)
+
 		// Attempt to select the valid keyshare txs
 		for iterator := h.lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
 			tmpKeyshareTx := iterator.Tx()
@@ -54,11 +62,21 @@ func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 			}
 
 			keyshareTxSize := int64(len(keyshareTxBz))
-			if keyshareTxSize <= limit.MaxTxBytes {
+			if totalSize+keyshareTxSize <= limit.MaxTxBytes {
+				submissionInfo, err := h.factory.GetSubmissionInfo(tmpKeyshareTx)
+				if err != nil {
+					txsToRemove = append(txsToRemove, tmpKeyshareTx)
+					continue
+				}
+
+				if submissionInfo != nil && submissionSize+keyshareTxSize > maxSubmissionSize {
+					continue
+				}
+
 				// Verify the keyshare transaction
 				if err := h.VerifyTx(cacheCtx, tmpKeyshareTx); err != nil {
 					h.lane.Logger().Info(
-						"failed to verify aggregate keyshare tx",
+						"failed to verify keyshare tx",
 						"tx_hash", hash,
 						"err", err,
 					)
@@ -66,17 +84,25 @@ func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 					continue
 				}
 
-				// Build the partial proposal by selecting the keyshare transaction
-				keyshareInfo, err := h.factory.GetKeyShareInfo(tmpKeyshareTx)
-				if keyshareInfo == nil || err != nil {
-					txsToRemove = append(txsToRemove, tmpKeyshareTx)
-					continue
+				if submissionInfo != nil {
+					// A validator only gets one submission per identity in the block, the other
+					// submissions stay in the mempool.
+					validator, err := h.factory.GetSubmissionValidator(ctx, submissionInfo.Submitter)
+					if err != nil {
+						txsToRemove = append(txsToRemove, tmpKeyshareTx)
+						continue
+					}
+					if err := submissions.add(validator, submissionInfo.Identities); err != nil {
+						continue
+					}
+					submissionSize += keyshareTxSize
 				}
 
 				// At this point, and all the keyshare transactions are valid.
 				// So we select them bid and also mark these transactions as seen and
 				// update the total size selected thus far.
 				txsToInclude = append(txsToInclude, tmpKeyshareTx)
+				totalSize += keyshareTxSize
 				// Write the cache context to the original context when we know we have a
 				// valid top of block bundle.
 				write()
@@ -98,14 +124,34 @@ func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 //   - they are the first transaction in the partial proposal
 //   - there are no other aggregate keyshare transactions in the proposal
 //   - block proposals that include transactions from the keyshare lane are valid
+//   - each validator submits at most one keyshare per identity
+//   - keyshare submission transactions fit in their share of the lane block space
+//
+// The lane limits are derived from the max block space of the lane config.
 func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 	return func(ctx sdk.Context, partialProposal []sdk.Tx) ([]sdk.Tx, []sdk.Tx, error) {
-		var countKeyshareTxs = 0
+		proposal := proposals.NewProposalWithContext(ctx, h.lane.Logger())
+		limit := proposal.GetLaneLimits(h.lane.GetMaxBlockSpace())
+
+		return h.ProcessLaneHandlerWithLimits(limit)(ctx, partialProposal)
+	}
+}
+
+// ProcessLaneHandlerWithLimits returns the ProcessLaneHandler of the lane run with the given lane limits.
+func (h *ProposalHandler) ProcessLaneHandlerWithLimits(limit proposals.LaneLimits) base.ProcessLaneHandler {
+	return func(ctx sdk.Context, partialProposal []sdk.Tx) ([]sdk.Tx, []sdk.Tx, error) {
+		var (
+			countKeyshareTxs = 0
+			submissionSize   int64
+			submissions      = newSubmissionTracker()
+		)
 
 		if len(partialProposal) == 0 {
 			return nil, nil, nil
 		}
 
+		maxSubmissionSize := h.maxSubmissionSize(limit)
+
 		for index, keyshareTx := range partialProposal {
 			if !h.lane.Match(ctx, keyshareTx) {
 				for _, tx := range partialProposal[index:] {
@@ -114,13 +160,36 @@ func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 					}
 				}
 			} else {
-				_, err := h.factory.GetKeyShareInfo(keyshareTx)
+				if err := h.VerifyTx(ctx, keyshareTx); err != nil {
+					return nil, nil, fmt.Errorf("invalid keyshare tx: %w", err)
+				}
+
+				submissionInfo, err := h.factory.GetSubmissionInfo(keyshareTx)
 				if err != nil {
-					return nil, nil, fmt.Errorf("failed to get keyshare info for lane %s: %w", h.lane.Name(), err)
+					return nil, nil, fmt.Errorf("failed to get keyshare submission info for lane %s: %w", h.lane.Name(), err)
 				}
 
-				if err := h.VerifyTx(ctx, keyshareTx); err != nil {
-					return nil, nil, fmt.Errorf("invalid keyshare tx: %w", err)
+				if submissionInfo != nil {
+					keyshareTxBz, _, err := GetTxHashStr(h.lane.TxEncoder(), keyshareTx)
+					if err != nil {
+						return nil, nil, fmt.Errorf("failed to encode keyshare submission tx: %w", err)
+					}
+
+					submissionSize += int64(len(keyshareTxBz))
+					if submissionSize > maxSubmissionSize {
+						return nil, nil, fmt.Errorf(
+							"keyshare submission txs exceed their block space in lane %s: %d bytes, max: %d",
+							h.lane.Name(), submissionSize, maxSubmissionSize,
+						)
+					}
+
+					validator, err := h.factory.GetSubmissionValidator(ctx, submissionInfo.Submitter)
+					if err != nil {
+						return nil, nil, fmt.Errorf("invalid keyshare submission tx: %w", err)
+					}
+					if err := submissions.add(validator, submissionInfo.Identities); err != nil {
+						return nil, nil, err
+					}
 				}
 
 				countKeyshareTxs = countKeyshareTxs + 1
@@ -131,18 +200,57 @@ func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 	}
 }
 
+// maxSubmissionSize returns the bytes of the lane limits keyshare submission transactions can use.
+func (h *ProposalHandler) maxSubmissionSize(limit proposals.LaneLimits) int64 {
+	if maxBlockSpace := h.factory.SubmissionConfig().MaxBlockSpace; !maxBlockSpace.IsNil() && maxBlockSpace.IsPositive() {
+		return maxBlockSpace.MulInt64(limit.MaxTxBytes).TruncateInt().Int64()
+	}
+	return limit.MaxTxBytes
+}
+
 // VerifyTx will verify that the keyshare transaction is valid.
 // It will return an error if the transaction is invalid.
 func (h *ProposalHandler) VerifyTx(ctx sdk.Context, keyshareTx sdk.Tx) error {
-	_, err := h.factory.GetKeyShareInfo(keyshareTx)
-	if err != nil {
-		return fmt.Errorf("failed to get keyshare info: %w", err)
+	if !h.factory.IsKeyshareTx(keyshareTx) {
+		return fmt.Errorf("failed to get keyshare info: not an aggregate keyshare or keyshare submission tx")
 	}
 
 	// verify the keyshare transaction
-	err = h.lane.VerifyTx(ctx, keyshareTx, false)
+	err := h.lane.VerifyTx(ctx, keyshareTx, false)
 	if err != nil {
 		return fmt.Errorf("invalid keyshare tx; failed to execute ante handler: %w", err)
 	}
 	return nil
 }
+
+// submissionTracker tracks the identities each validator submitted a keyshare for in a proposal.
+type submissionTracker map[string]map[string]struct{}
+
+func newSubmissionTracker() submissionTracker {
+	return make(submissionTracker)
+}
+
+// add records the submissions of the validator, it returns an error without recording any of them
+// if the validator already submitted a keyshare for one of the identities.
+func (t submissionTracker) add(validator string, identities []string) error {
+	seen, ok := t[validator]
+	if !ok {
+		seen = make(map[string]struct{}, len(identities))
+	}
+
+	added := make(map[string]struct{}, len(identities))
+	for _, identity := range identities {
+		_, found := seen[identity]
+		if _, dup := added[identity]; found || dup {
+			return fmt.Errorf("validator %s submitted more than one keyshare for %s in the block", validator, identity)
+		}
+		added[identity] = struct{}{}
+	}
+
+	for identity := range added {
+		seen[identity] = struct{}{}
+	}
+	t[validator] = seen
+
+	return nil
+}
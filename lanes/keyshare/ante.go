@@ -0,0 +1,54 @@
+package keyshare
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.AnteDecorator = FeeExemptDecorator{}
+
+// FeeExemptDecorator wraps the fee decorator of the ante handler. The keyshare submission transactions
+// of registered validators and authorized addresses skip the fee decorator when the submission config
+// of the factory exempts them from fees.
+type FeeExemptDecorator struct {
+	factory      Factory
+	feeDecorator sdk.AnteDecorator
+}
+
+// NewFeeExemptDecorator returns a new fee exempt decorator wrapping the fee decorator.
+func NewFeeExemptDecorator(factory Factory, feeDecorator sdk.AnteDecorator) FeeExemptDecorator {
+	return FeeExemptDecorator{
+		factory:      factory,
+		feeDecorator: feeDecorator,
+	}
+}
+
+// AnteHandle skips the fee decorator for fee exempt keyshare submission transactions.
+func (d FeeExemptDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.IsFeeExempt(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// IsFeeExempt returns true if the transaction is a keyshare submission transaction of a registered validator
+// or an authorized address within the fee exempt gas limit. A zero max fee exempt gas does not limit the gas.
+func (d FeeExemptDecorator) IsFeeExempt(ctx sdk.Context, tx sdk.Tx) bool {
+	cfg := d.factory.SubmissionConfig()
+	if !cfg.FeeExempt {
+		return false
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || (cfg.MaxFeeExemptGas != 0 && feeTx.GetGas() > cfg.MaxFeeExemptGas) {
+		return false
+	}
+
+	submissionInfo, err := d.factory.GetSubmissionInfo(tx)
+	if submissionInfo == nil || err != nil {
+		return false
+	}
+
+	_, err = d.factory.GetSubmissionValidator(ctx, submissionInfo.Submitter)
+	return err == nil
+}
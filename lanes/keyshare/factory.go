@@ -3,34 +3,76 @@ package keyshare
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	keysharetypes "github.com/Fairblock/fairyring/x/keyshare/types"
 	peptypes "github.com/Fairblock/fairyring/x/pep/types"
 	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block/base"
 )
 
 type (
-	// Factory defines the interface for processing AggregateKeyShare transactions. It is
-	// a wrapper around all of the functionality that each application chain must implement
-	// in order for Aggregated keyshare processing to work.
+	// Factory defines the interface for processing AggregateKeyShare transactions and keyshare
+	// submission transactions. It is a wrapper around all of the functionality that each application
+	// chain must implement in order for keyshare processing to work.
 	Factory interface {
-		// IsKeyshareTx defines a function that checks if a transaction qualifies as AggregateKeyshare Tx.
+		// IsKeyshareTx defines a function that checks if a transaction qualifies as AggregateKeyshare Tx
+		// or as keyshare submission Tx.
 		IsKeyshareTx(tx sdk.Tx) bool
 
 		// GetKeyShareInfo defines a function that returns the Aggregated KeyShare info from the Tx
 		GetKeyShareInfo(tx sdk.Tx) (*peptypes.AggregatedKeyShare, error)
 
+		// GetSubmissionInfo defines a function that returns the keyshare submission info from the Tx,
+		// nil if the Tx is not a keyshare submission Tx.
+		GetSubmissionInfo(tx sdk.Tx) (*SubmissionInfo, error)
+
+		// GetSubmissionValidator defines a function that returns the registered validator the submitter
+		// submits keyshares for, the submitter is the validator or the address it authorized.
+		GetSubmissionValidator(ctx sdk.Context, submitter string) (string, error)
+
+		// SubmissionConfig returns the configuration of the keyshare submission transactions.
+		SubmissionConfig() SubmissionConfig
+
 		// MatchHandler defines a function that checks if a transaction matches the keyshare lane.
 		MatchHandler() base.MatchHandler
 	}
 
+	// KeyshareKeeper defines the keyshare module functions required by the keyshare lane.
+	KeyshareKeeper interface {
+		GetKeyshareSubmitter(ctx sdk.Context, creator string) (keysharetypes.ValidatorSet, error)
+	}
+
+	// SubmissionInfo defines the keyshare submission info of a transaction.
+	SubmissionInfo struct {
+		// Submitter is the creator of the submission messages.
+		Submitter string
+		// Identities are the block heights and identities the keyshares are submitted for.
+		Identities []string
+	}
+
+	// SubmissionConfig defines how the keyshare lane handles keyshare submission transactions.
+	SubmissionConfig struct {
+		// MaxBlockSpace is the share of the keyshare lane block space the submission transactions
+		// can consume, the aggregated keyshare transactions can always use the rest.
+		MaxBlockSpace math.LegacyDec
+		// FeeExempt lets the submission transactions of registered validators and authorized
+		// addresses skip the fee deduction.
+		FeeExempt bool
+		// MaxFeeExemptGas is the maximum gas limit of a fee exempt submission transaction.
+		MaxFeeExemptGas uint64
+	}
+
 	// DefaultKeyshareFactory defines a default implmentation for the keyshare factory interface
-	// for processing aggregate keyshare transactions.
+	// for processing aggregate keyshare and keyshare submission transactions.
 	DefaultKeyshareFactory struct {
-		txDecoder       sdk.TxDecoder
-		signerExtractor signer_extraction.Adapter
+		txDecoder        sdk.TxDecoder
+		signerExtractor  signer_extraction.Adapter
+		keyshareKeeper   KeyshareKeeper
+		submissionConfig SubmissionConfig
 	}
 
 	// TxWithTimeoutHeight is used to extract timeouts from sdk.Tx transactions. In the case where,
@@ -45,25 +87,30 @@ type (
 var _ Factory = (*DefaultKeyshareFactory)(nil)
 
 // NewDefaultKeyshareFactory returns a default keyshare factory interface implementation.
-func NewDefaultKeyshareFactory(txDecoder sdk.TxDecoder, extractor signer_extraction.Adapter) Factory {
+func NewDefaultKeyshareFactory(
+	txDecoder sdk.TxDecoder,
+	extractor signer_extraction.Adapter,
+	keyshareKeeper KeyshareKeeper,
+	submissionConfig SubmissionConfig,
+) Factory {
 	return &DefaultKeyshareFactory{
-		txDecoder:       txDecoder,
-		signerExtractor: extractor,
+		txDecoder:        txDecoder,
+		signerExtractor:  extractor,
+		keyshareKeeper:   keyshareKeeper,
+		submissionConfig: submissionConfig,
 	}
 }
 
 func (config *DefaultKeyshareFactory) IsKeyshareTx(tx sdk.Tx) bool {
 	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return false
-	}
-
-	switch msgs[0].(type) {
-	case *peptypes.MsgCreateAggregatedKeyShare:
-		return true
+	if len(msgs) == 1 {
+		if _, ok := msgs[0].(*peptypes.MsgCreateAggregatedKeyShare); ok {
+			return true
+		}
 	}
 
-	return false
+	submissionInfo, err := config.GetSubmissionInfo(tx)
+	return submissionInfo != nil && err == nil
 }
 
 func (config *DefaultKeyshareFactory) GetKeyShareInfo(tx sdk.Tx) (*peptypes.AggregatedKeyShare, error) {
@@ -93,6 +140,84 @@ func GetAggregateKeyshareMsgFromTx(tx sdk.Tx) (*peptypes.MsgCreateAggregatedKeyS
 	return nil, errors.New("invalid MsgCreateAggregatedKeyShare transaction")
 }
 
+// GetSubmissionInfo returns the submitter and the identities of a keyshare submission transaction.
+// Every message of the transaction has to be a keyshare submission of the same submitter.
+func (config *DefaultKeyshareFactory) GetSubmissionInfo(tx sdk.Tx) (*SubmissionInfo, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	info := &SubmissionInfo{}
+	for _, msg := range msgs {
+		var (
+			submitter  string
+			identities []string
+		)
+
+		switch m := msg.(type) {
+		case *keysharetypes.MsgSendKeyshare:
+			submitter = m.Creator
+			identities = []string{BlockIdentity(m.BlockHeight)}
+		case *keysharetypes.MsgCreateGeneralKeyShare:
+			submitter = m.Creator
+			identities = []string{GeneralIdentity(m.IdType, m.IdValue)}
+		case *keysharetypes.MsgSubmitEncryptedKeyshare:
+			submitter = m.Creator
+			identities = []string{EncryptedIdentity(m.Identity)}
+		case *keysharetypes.MsgSendKeyshareBatch:
+			submitter = m.Creator
+			for _, item := range m.Keyshares {
+				if item.IsGeneral() {
+					identities = append(identities, GeneralIdentity(item.IdType, item.IdValue))
+				} else {
+					identities = append(identities, BlockIdentity(item.BlockHeight))
+				}
+			}
+		default:
+			return nil, nil
+		}
+
+		if info.Submitter != "" && info.Submitter != submitter {
+			return nil, errors.New("invalid keyshare submission transaction; messages of different submitters")
+		}
+		info.Submitter = submitter
+		info.Identities = append(info.Identities, identities...)
+	}
+
+	return info, nil
+}
+
+// GetSubmissionValidator returns the registered validator the submitter submits keyshares for.
+func (config *DefaultKeyshareFactory) GetSubmissionValidator(ctx sdk.Context, submitter string) (string, error) {
+	validator, err := config.keyshareKeeper.GetKeyshareSubmitter(ctx, submitter)
+	if err != nil {
+		return "", err
+	}
+
+	return validator.Validator, nil
+}
+
+// SubmissionConfig returns the configuration of the keyshare submission transactions.
+func (config *DefaultKeyshareFactory) SubmissionConfig() SubmissionConfig {
+	return config.submissionConfig
+}
+
+// BlockIdentity returns the identity of the keyshares of a block height.
+func BlockIdentity(height uint64) string {
+	return "height/" + strconv.FormatUint(height, 10)
+}
+
+// GeneralIdentity returns the identity of the general keyshares of an id type and id value.
+func GeneralIdentity(idType, idValue string) string {
+	return "general/" + idType + "/" + idValue
+}
+
+// EncryptedIdentity returns the identity of the encrypted keyshares of a private identity.
+func EncryptedIdentity(identity string) string {
+	return "encrypted/" + identity
+}
+
 // GetTimeoutHeight returns the timeout height of the transaction.
 func (config *DefaultKeyshareFactory) GetTimeoutHeight(tx sdk.Tx) (uint64, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
@@ -104,9 +229,21 @@ func (config *DefaultKeyshareFactory) GetTimeoutHeight(tx sdk.Tx) (uint64, error
 }
 
 // MatchHandler defines a default function that checks if a transaction matches the keyshare lane.
+// Keyshare submission transactions only match when they are sent by a registered validator
+// or an address authorized by one.
 func (config *DefaultKeyshareFactory) MatchHandler() base.MatchHandler {
 	return func(ctx sdk.Context, tx sdk.Tx) bool {
 		ksInfo, err := config.GetKeyShareInfo(tx)
-		return ksInfo != nil && err == nil
+		if ksInfo != nil && err == nil {
+			return true
+		}
+
+		submissionInfo, err := config.GetSubmissionInfo(tx)
+		if submissionInfo == nil || err != nil {
+			return false
+		}
+
+		_, err = config.GetSubmissionValidator(ctx, submissionInfo.Submitter)
+		return err == nil
 	}
 }
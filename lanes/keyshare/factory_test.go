@@ -0,0 +1,142 @@
+package keyshare_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+
+	"github.com/Fairblock/fairyring/lanes/keyshare"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	keysharetypes "github.com/Fairblock/fairyring/x/keyshare/types"
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
+)
+
+// mockKeyshareKeeper maps the registered validators and authorized addresses to the validator they submit for
+type mockKeyshareKeeper map[string]string
+
+func (m mockKeyshareKeeper) GetKeyshareSubmitter(_ sdk.Context, creator string) (keysharetypes.ValidatorSet, error) {
+	validator, found := m[creator]
+	if !found {
+		return keysharetypes.ValidatorSet{}, keysharetypes.ErrAddrIsNotValidatorOrAuthorized
+	}
+	return keysharetypes.ValidatorSet{Validator: validator}, nil
+}
+
+func TestKeyshareSubmissionLane(t *testing.T) {
+	encCfg := testutils.CreateTestEncodingConfig()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	validator, authorized, unregistered := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	factory := keyshare.NewDefaultKeyshareFactory(
+		encCfg.TxConfig.TxDecoder(),
+		signer_extraction.NewDefaultAdapter(),
+		mockKeyshareKeeper{validator: validator, authorized: validator},
+		keyshare.SubmissionConfig{
+			MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.5"),
+			FeeExempt:       true,
+			MaxFeeExemptGas: 100000,
+		},
+	)
+
+	newTx := func(gas uint64, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+
+	sendKeyshare := func(creator string, height uint64) sdk.Tx {
+		return newTx(100000, &keysharetypes.MsgSendKeyshare{Creator: creator, BlockHeight: height})
+	}
+
+	aggregatedTx := newTx(100000, &peptypes.MsgCreateAggregatedKeyShare{Creator: unregistered, Height: 10})
+	batchTx := newTx(100000, &keysharetypes.MsgSendKeyshareBatch{
+		Creator: authorized,
+		Keyshares: []*keysharetypes.KeyshareBatchItem{
+			{BlockHeight: 11},
+			{IdType: "private-gov-identity", IdValue: "id"},
+		},
+	})
+
+	info, err := factory.GetSubmissionInfo(batchTx)
+	require.NoError(t, err)
+	require.Equal(t, authorized, info.Submitter)
+	require.Equal(t, []string{keyshare.BlockIdentity(11), keyshare.GeneralIdentity("private-gov-identity", "id")}, info.Identities)
+
+	info, err = factory.GetSubmissionInfo(newTx(100000, &keysharetypes.MsgSendKeyshare{Creator: validator}, &banktypes.MsgSend{}))
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	_, err = factory.GetSubmissionInfo(newTx(100000,
+		&keysharetypes.MsgSendKeyshare{Creator: validator},
+		&keysharetypes.MsgCreateGeneralKeyShare{Creator: authorized},
+	))
+	require.Error(t, err)
+
+	// only the submissions of registered validators and authorized addresses match the lane
+	match := factory.MatchHandler()
+	require.True(t, match(ctx, aggregatedTx))
+	require.True(t, match(ctx, batchTx))
+	require.True(t, match(ctx, sendKeyshare(validator, 11)))
+	require.False(t, match(ctx, sendKeyshare(unregistered, 11)))
+	require.False(t, match(ctx, newTx(100000, &banktypes.MsgSend{})))
+
+	// submissions are ordered after the aggregated keyshares
+	priority := keyshare.TxPriority(factory)
+	require.Equal(t, 1, priority.Compare(priority.GetTxPriority(ctx, aggregatedTx), priority.GetTxPriority(ctx, batchTx)))
+
+	// fee exemption is limited to the submissions of registered submitters within the gas limit
+	feeExempt := keyshare.NewFeeExemptDecorator(factory, nil)
+	require.True(t, feeExempt.IsFeeExempt(ctx, sendKeyshare(validator, 11)))
+	require.False(t, feeExempt.IsFeeExempt(ctx, sendKeyshare(unregistered, 11)))
+	require.False(t, feeExempt.IsFeeExempt(ctx, newTx(200000, &keysharetypes.MsgSendKeyshare{Creator: validator})))
+	require.False(t, feeExempt.IsFeeExempt(ctx, aggregatedTx))
+
+	lane := keyshare.NewKeyShareLane(
+		base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       encCfg.TxConfig.TxEncoder(),
+			TxDecoder:       encCfg.TxConfig.TxDecoder(),
+			MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.3"),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxTxs:          100,
+		},
+		factory,
+		factory.MatchHandler(),
+	)
+	handler := keyshare.NewProposalHandler(lane.BaseLane, factory)
+	processLane := handler.ProcessLaneHandler()
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 1000000, MaxGas: -1}})
+
+	other := newTx(100000, &banktypes.MsgSend{})
+	included, remaining, err := processLane(ctx, []sdk.Tx{aggregatedTx, sendKeyshare(validator, 12), batchTx, other})
+	require.NoError(t, err)
+	require.Len(t, included, 3)
+	require.Equal(t, []sdk.Tx{other}, remaining)
+
+	// the keyshare of the authorized address counts for its validator
+	_, _, err = processLane(ctx, []sdk.Tx{sendKeyshare(validator, 11), batchTx})
+	require.ErrorContains(t, err, "submitted more than one keyshare")
+
+	// the submissions can not use more than their share of the lane block space
+	submission := sendKeyshare(validator, 12)
+	submissionBz, err := encCfg.TxConfig.TxEncoder()(submission)
+	require.NoError(t, err)
+	limit := proposals.LaneLimits{MaxTxBytes: int64(len(submissionBz)) * 2}
+	_, _, err = handler.ProcessLaneHandlerWithLimits(limit)(ctx, []sdk.Tx{aggregatedTx, submission})
+	require.NoError(t, err)
+	_, _, err = handler.ProcessLaneHandlerWithLimits(limit)(ctx, []sdk.Tx{submission, batchTx})
+	require.ErrorContains(t, err, "exceed their block space")
+}
@@ -2,6 +2,7 @@ package keyshare
 
 import (
 	"context"
+	"math"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,14 +10,18 @@ import (
 	"github.com/skip-mev/block-sdk/v2/block/base"
 )
 
-// TxPriority returns a TxPriority over AggregatedKeyShare transactions only. It
-// is to be used in the AggregatedKeyShare index only.
+// submissionPriority is the priority of the keyshare submission transactions,
+// they are ordered after every AggregatedKeyShare transaction.
+var submissionPriority = strconv.FormatUint(math.MaxUint64, 10)
+
+// TxPriority returns a TxPriority over AggregatedKeyShare and keyshare submission transactions.
+// AggregatedKeyShare transactions are ordered by height. It is to be used in the keyshare lane only.
 func TxPriority(config Factory) base.TxPriority[string] {
 	return base.TxPriority[string]{
 		GetTxPriority: func(goCtx context.Context, tx sdk.Tx) string {
 			ksInfo, err := config.GetKeyShareInfo(tx)
-			if err != nil {
-				panic(err)
+			if ksInfo == nil || err != nil {
+				return submissionPriority
 			}
 
 			return strconv.FormatUint(ksInfo.Height, 10)
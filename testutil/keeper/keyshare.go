@@ -66,6 +66,8 @@ func KeyshareKeeper(t testing.TB) (keeper.Keeper, sdk.Context, pepkeeper.Keeper,
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	scopedKeeper := capabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	portKeeper := portkeeper.NewKeeper(scopedKeeper)
+	// the module claims the port capability created by the ibc scoped keeper on InitGenesis
+	moduleScopedKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
 
 	pepCapabilityKeeper := capabilitykeeper.NewKeeper(appCodec, pepStoreKey, pepMemStoreKey)
 	pepScopedKeeper := pepCapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
@@ -75,7 +77,10 @@ func KeyshareKeeper(t testing.TB) (keeper.Keeper, sdk.Context, pepkeeper.Keeper,
 		appCodec,
 		runtime.NewKVStoreService(authStoreKey),
 		authtypes.ProtoBaseAccount,
-		make(map[string][]string, 0),
+		map[string][]string{
+			stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+			stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		},
 		address.NewBech32Codec("cosmos"),
 		sdk.Bech32PrefixAccAddr,
 		authority.String(),
@@ -126,7 +131,7 @@ func KeyshareKeeper(t testing.TB) (keeper.Keeper, sdk.Context, pepkeeper.Keeper,
 				PortKeeper: &portKeeper,
 			}
 		},
-		scopedKeeper,
+		moduleScopedKeeper,
 		//func(string) capabilitykeeper.ScopedKeeper {
 		//	return scopeModule
 		//},
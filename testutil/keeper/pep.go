@@ -3,7 +3,10 @@ package keeper
 import (
 	"testing"
 
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	keeper2 "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -26,16 +29,37 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	"github.com/stretchr/testify/require"
 
+	kstypes "github.com/Fairblock/fairyring/x/keyshare/types"
 	"github.com/Fairblock/fairyring/x/pep/keeper"
 	"github.com/Fairblock/fairyring/x/pep/types"
 )
 
 func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, ctx, _ := PepKeeperWithBank(t)
+	return k, ctx
+}
+
+// PepKeeperWithBank returns a pep keeper and the bank keeper holding the balance of its module account
+func PepKeeperWithBank(t testing.TB) (keeper.Keeper, sdk.Context, bankkeeper.BaseKeeper) {
+	k, ctx, _, bankKeeper, _ := PepKeeperWithAccounts(t)
+	return k, ctx, bankKeeper
+}
+
+// PepKeeperWithAccounts returns a pep keeper together with the account, bank and feegrant keepers
+// the pep module settles the fees of encrypted txs with
+func PepKeeperWithAccounts(t testing.TB) (
+	keeper.Keeper,
+	sdk.Context,
+	keeper2.AccountKeeper,
+	bankkeeper.BaseKeeper,
+	feegrantkeeper.Keeper,
+) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
 	bankStoreKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
 	authStoreKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	feegrantStoreKey := storetypes.NewKVStoreKey(feegrant.StoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
@@ -43,6 +67,7 @@ func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	stateStore.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(authStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(feegrantStoreKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -54,6 +79,8 @@ func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 
 	// Register module account and other types
 	authtypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	feegrant.RegisterInterfaces(registry)
 	types.RegisterInterfaces(registry)
 
 	scopedKeeper := capabilityKeeper.ScopeToModule(ibcexported.ModuleName)
@@ -64,7 +91,11 @@ func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		appCodec,
 		runtime.NewKVStoreService(authStoreKey),
 		authtypes.ProtoBaseAccount,
-		make(map[string][]string, 0),
+		map[string][]string{
+			authtypes.FeeCollectorName: nil,
+			kstypes.ModuleName:         nil,
+			types.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		},
 		address.NewBech32Codec("cosmos"),
 		sdk.Bech32PrefixAccAddr,
 		authority.String(),
@@ -72,7 +103,7 @@ func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 
 	// Create the module account for the 'pep' module
 	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner)
-	accountKeeper.SetModuleAccount(ctx, moduleAcc)
+	accountKeeper.SetModuleAccount(ctx, accountKeeper.NewAccount(ctx, moduleAcc).(sdk.ModuleAccountI))
 
 	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec, runtime.NewKVStoreService(bankStoreKey),
@@ -82,6 +113,8 @@ func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		log.NewNopLogger(),
 	)
 
+	feegrantKeeper := feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(feegrantStoreKey), accountKeeper)
+
 	k := keeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(storeKey),
@@ -104,5 +137,5 @@ func PepKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, ctx, accountKeeper, bankKeeper, feegrantKeeper
 }
@@ -27,6 +27,7 @@ type GenerateResult struct {
 	KeyShareEncryptedKeyShares []*types.EncryptedKeyShare
 	Commitments                []string
 	MasterPublicKey            string
+	Threshold                  uint64
 }
 
 func GeneratePubKeyAndShares(totalNumberOfValidator uint32) (*GenerateResult, error) {
@@ -99,6 +100,7 @@ func GeneratePubKeyAndShares(totalNumberOfValidator uint32) (*GenerateResult, er
 	result.GeneratedShare = sharesList
 	result.KeyShareEncryptedKeyShares = encShares
 	result.Commitments = keyShareCommitments
+	result.Threshold = uint64(t)
 
 	return &result, nil
 }
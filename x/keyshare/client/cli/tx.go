@@ -36,6 +36,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateGeneralKeyShare())
 	cmd.AddCommand(CmdOverrideLatestPubKey())
 	cmd.AddCommand(CmdSubmitEncryptedKeyShare())
+	cmd.AddCommand(CmdStartDkg())
+	cmd.AddCommand(CmdStartResharing())
+	cmd.AddCommand(CmdSubmitDkgDeal())
+	cmd.AddCommand(CmdSubmitDkgComplaint())
+	cmd.AddCommand(CmdSubmitDkgJustification())
+	cmd.AddCommand(CmdUnjail())
+	cmd.AddCommand(CmdSubmitKeyshareEvidence())
+	cmd.AddCommand(CmdClaimKeyshareRewards())
+	cmd.AddCommand(CmdSendKeyshareBatch())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdClaimKeyshareRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-keyshare-rewards",
+		Short: "Claim the rewards earned by your validator for submitting keyshares",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimKeyshareRewards(
+				clientCtx.GetFromAddress().String(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
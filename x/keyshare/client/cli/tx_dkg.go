@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdStartDkg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-dkg [threshold]",
+		Short: "Start a distributed key generation round among the registered validators",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			threshold, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStartDkg(
+				clientCtx.GetFromAddress().String(),
+				threshold,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStartResharing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-resharing [threshold]",
+		Short: "Start a round resharing the active key to the registered validators with a new threshold",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			threshold, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStartResharing(
+				clientCtx.GetFromAddress().String(),
+				threshold,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDkgDeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-deal [round-id] [commitments] [encrypted-shares]",
+		Short: "Submit the coefficient commitments and the encrypted shares of your dkg deal",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			roundID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			commitments := strings.Split(args[1], listSeparator)
+
+			var encryptedShares []*types.EncryptedKeyShare
+			if err := json.Unmarshal([]byte(args[2]), &encryptedShares); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDkgDeal(
+				clientCtx.GetFromAddress().String(),
+				roundID,
+				commitments,
+				encryptedShares,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDkgComplaint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-complaint [round-id] [dealer]",
+		Short: "Complain about a dkg deal whose share does not match its commitments",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			roundID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDkgComplaint(
+				clientCtx.GetFromAddress().String(),
+				roundID,
+				args[1],
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDkgJustification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-justification [round-id] [complainer] [share]",
+		Short: "Answer a complaint against your dkg deal by revealing the share of the complainer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			roundID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDkgJustification(
+				clientCtx.GetFromAddress().String(),
+				roundID,
+				args[1],
+				args[2],
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
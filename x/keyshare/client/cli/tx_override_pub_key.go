@@ -15,9 +15,9 @@ import (
 
 func CmdOverrideLatestPubKey() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "override-latest-pub-key [public-key] [commitments] [number-of-validators] [encrypted-key-shares]",
+		Use:   "override-latest-pub-key [public-key] [commitments] [number-of-validators] [threshold] [encrypted-key-shares]",
 		Short: "Override a latest public key",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 
 			// Get value arguments
@@ -31,9 +31,14 @@ func CmdOverrideLatestPubKey() *cobra.Command {
 				return err
 			}
 
+			threshold, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
 			encryptedShares := make([]*types.EncryptedKeyShare, numberOfValidators)
 
-			if err := json.Unmarshal([]byte(args[3]), &encryptedShares); err != nil {
+			if err := json.Unmarshal([]byte(args[4]), &encryptedShares); err != nil {
 				return err
 			}
 
@@ -47,6 +52,7 @@ func CmdOverrideLatestPubKey() *cobra.Command {
 				argPublicKey,
 				commitments,
 				numberOfValidators,
+				threshold,
 				encryptedShares,
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
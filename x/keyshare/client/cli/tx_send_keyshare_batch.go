@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSendKeyshareBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-keyshare-batch [keyshares]",
+		Short: "Broadcast the keyshares for several heights and general identities in one message",
+		Long: `Broadcast the keyshares for several heights and general identities in one message.
+The keyshares are a JSON array: [{"keyShare":"...","keyShareIndex":1,"blockHeight":100},{"keyShare":"...","keyShareIndex":1,"idType":"private-gov-identity","idValue":"..."}],
+a keyshare with an id type is a general keyshare.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var keyshares []*types.KeyshareBatchItem
+			if err := json.Unmarshal([]byte(args[0]), &keyshares); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendKeyshareBatch(
+				clientCtx.GetFromAddress().String(),
+				keyshares,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
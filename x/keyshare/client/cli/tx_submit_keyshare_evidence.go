@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSubmitKeyshareEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-keyshare-evidence [validator] [identity] [first-keyshare] [second-keyshare]",
+		Short: "Submit two conflicting keyshares of a validator for the same identity",
+		Long: `Submit two conflicting keyshares of a validator for the same identity.
+Each keyshare is a JSON object: {"keyshare":"...","keyShareIndex":1,"submitter":"fairy1...","signature":"..."},
+the signature can be left empty for the keyshare stored on-chain.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var first, second types.SignedKeyshare
+			if err := json.Unmarshal([]byte(args[2]), &first); err != nil {
+				return err
+			}
+			if err := json.Unmarshal([]byte(args[3]), &second); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitKeyshareEvidence(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				&first,
+				&second,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	distIBE "github.com/FairBlock/DistributedIBE"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	peptypes "github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+)
+
+// SetPendingAggregation marks a block height or a general identity to be aggregated in EndBlock
+func (k Keeper) SetPendingAggregation(ctx sdk.Context, pending types.PendingAggregation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PendingAggregationKeyPrefix))
+
+	b := k.cdc.MustMarshal(&pending)
+	store.Set(types.PendingAggregationKey(pending), b)
+}
+
+// RemovePendingAggregation removes a block height or a general identity from the pending aggregations
+func (k Keeper) RemovePendingAggregation(ctx sdk.Context, pending types.PendingAggregation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PendingAggregationKeyPrefix))
+	store.Delete(types.PendingAggregationKey(pending))
+}
+
+// GetAllPendingAggregation returns the pending aggregations, block heights first in ascending order
+func (k Keeper) GetAllPendingAggregation(ctx sdk.Context) (list []types.PendingAggregation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PendingAggregationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingAggregation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// verifiedKeyshare is a keyshare verified when it was submitted
+type verifiedKeyshare struct {
+	keyShare      string
+	keyShareIndex uint64
+	power         int64
+	pubKey        string
+}
+
+// AggregatePendingKeyshares aggregates the keyshares of every block height and general identity that received
+// a keyshare in the current block. Each is aggregated once, after all the keyshares of the block are stored,
+// so the aggregated key does not depend on the order of the keyshare txs and no submitter pays for it.
+func (k Keeper) AggregatePendingKeyshares(ctx sdk.Context) {
+	pendingList := k.GetAllPendingAggregation(ctx)
+	if len(pendingList) == 0 {
+		return
+	}
+
+	for _, pending := range pendingList {
+		k.RemovePendingAggregation(ctx, pending)
+	}
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return
+	}
+
+	authorized := k.authorizedTargets(ctx)
+	validators := k.GetAllValidatorSet(ctx)
+
+	for _, pending := range pendingList {
+		// a failed aggregation leaves no partial state
+		cacheCtx, write := ctx.CacheContext()
+
+		var err error
+		if pending.IsGeneral() {
+			err = k.aggregateGeneralKeyShares(cacheCtx, pending, activePubKey, validators, authorized)
+		} else {
+			err = k.aggregateBlockKeyShares(cacheCtx, pending.Height, activePubKey, validators, authorized)
+		}
+		if err != nil {
+			k.Logger().Error(fmt.Sprintf("Error while aggregating keyshares for %s %s %d: %s", pending.IdType, pending.IdValue, pending.Height, err.Error()))
+			continue
+		}
+
+		write()
+	}
+}
+
+// aggregateBlockKeyShares aggregates the decryption key of a block height and hands it to the pep module
+func (k Keeper) aggregateBlockKeyShares(
+	ctx sdk.Context,
+	height uint64,
+	activePubKey types.ActivePubKey,
+	validators []types.ValidatorSet,
+	authorized map[string]string,
+) error {
+	if _, found := k.GetAggregatedKeyShare(ctx, height); found {
+		return nil
+	}
+
+	keyshares := make([]verifiedKeyshare, 0, len(validators))
+	for _, v := range validators {
+		keyShare, found := k.GetKeyShare(ctx, v.Validator, height)
+		if target, ok := authorized[v.Validator]; ok && !found {
+			keyShare, found = k.GetKeyShare(ctx, target, height)
+		}
+		if found {
+			keyshares = append(keyshares, verifiedKeyshare{
+				keyShare:      keyShare.KeyShare,
+				keyShareIndex: keyShare.KeyShareIndex,
+				power:         keyShare.Power,
+				pubKey:        keyShare.PubKey,
+			})
+		}
+	}
+
+	skHex, aggregated, err := k.aggregateKeyShares(ctx, activePubKey, keyshares)
+	if err != nil || !aggregated {
+		return err
+	}
+
+	k.setBlockAggregatedKeyShare(ctx, height, skHex, activePubKey.PublicKey)
+
+	return nil
+}
+
+// setBlockAggregatedKeyShare stores the aggregated decryption key of a block height and hands it to the pep module
+func (k Keeper) setBlockAggregatedKeyShare(ctx sdk.Context, height uint64, skHex, pubKey string) {
+	k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{
+		Height: height,
+		Data:   skHex,
+	})
+
+	k.SetAggregatedKeyShareLength(ctx, k.GetAggregatedKeyShareLength(ctx)+1)
+
+	k.Logger().Info(fmt.Sprintf("Aggregated Decryption Key for Block %d: %s", height, skHex))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyShareAggregatedEventType,
+			sdk.NewAttribute(types.KeyShareAggregatedEventBlockHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.KeyShareAggregatedEventData, skHex),
+			sdk.NewAttribute(types.KeyShareAggregatedEventPubKey, pubKey),
+		),
+	)
+
+	k.pepKeeper.SetAggregatedKeyShare(
+		ctx,
+		peptypes.AggregatedKeyShare{
+			Height: height,
+			Data:   skHex,
+		},
+	)
+
+	latestHeight, err := strconv.ParseUint(k.pepKeeper.GetLatestHeight(ctx), 10, 64)
+	if err != nil {
+		latestHeight = 0
+	}
+
+	if latestHeight < height {
+		k.pepKeeper.SetLatestHeight(ctx, strconv.FormatUint(height, 10))
+	}
+
+	k.Logger().Info(fmt.Sprintf("[ProcessUnconfirmedTxs] Aggregated Key Added, height: %d", height))
+}
+
+// aggregateGeneralKeyShares aggregates the decryption key of a general identity
+// and sends it to the requester of the identity
+func (k Keeper) aggregateGeneralKeyShares(
+	ctx sdk.Context,
+	pending types.PendingAggregation,
+	activePubKey types.ActivePubKey,
+	validators []types.ValidatorSet,
+	authorized map[string]string,
+) error {
+	switch pending.IdType {
+	case PrivateGovIdentity:
+		keyShareReq, found := k.GetKeyShareRequest(ctx, pending.IdValue)
+		if !found {
+			return types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", pending.IdValue)
+		}
+		if keyShareReq.AggrKeyshare != "" {
+			return nil
+		}
+	default:
+		return types.ErrUnsupportedIDType.Wrapf(", supported id types: %v", SupportedIDTypes)
+	}
+
+	keyshares := make([]verifiedKeyshare, 0, len(validators))
+	for _, v := range validators {
+		keyShare, found := k.GetGeneralKeyShare(ctx, v.Validator, pending.IdType, pending.IdValue)
+		if target, ok := authorized[v.Validator]; ok && !found {
+			keyShare, found = k.GetGeneralKeyShare(ctx, target, pending.IdType, pending.IdValue)
+		}
+		if found {
+			keyshares = append(keyshares, verifiedKeyshare{
+				keyShare:      keyShare.KeyShare,
+				keyShareIndex: keyShare.KeyShareIndex,
+				power:         keyShare.Power,
+				pubKey:        keyShare.PubKey,
+			})
+		}
+	}
+
+	skHex, aggregated, err := k.aggregateKeyShares(ctx, activePubKey, keyshares)
+	if err != nil || !aggregated {
+		return err
+	}
+
+	k.Logger().Info(fmt.Sprintf("Aggregated General Decryption Key for ID Type %s, ID: %s | %s", pending.IdType, pending.IdValue, skHex))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GeneralKeyShareAggregatedEventType,
+			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventIDType, pending.IdType),
+			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventIDValue, pending.IdValue),
+			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventData, skHex),
+			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventPubKey, activePubKey.PublicKey),
+		),
+	)
+
+	keyShareReq, _ := k.GetKeyShareRequest(ctx, pending.IdValue)
+	keyShareReq.AggrKeyshare = skHex
+	k.SetKeyShareRequest(ctx, keyShareReq)
+	timeoutTimestamp := ctx.BlockTime().Add(time.Second * 20).UnixNano()
+
+	if keyShareReq.IbcInfo != nil {
+		if keyShareReq.IbcInfo.ChannelID != "" {
+			_, err := k.TransmitAggrKeyshareDataPacket(
+				ctx,
+				types.AggrKeyshareDataPacketData{
+					Identity:     keyShareReq.Identity,
+					Pubkey:       keyShareReq.Pubkey,
+					AggrKeyshare: keyShareReq.AggrKeyshare,
+					AggrHeight:   strconv.FormatInt(ctx.BlockHeight(), 10),
+					ProposalId:   keyShareReq.ProposalId,
+					RequestId:    keyShareReq.RequestId,
+					Retries:      0,
+				},
+				keyShareReq.IbcInfo.PortID,
+				keyShareReq.IbcInfo.ChannelID,
+				clienttypes.ZeroHeight(),
+				uint64(timeoutTimestamp),
+			)
+			return err
+		}
+		return nil
+	}
+
+	if keyShareReq.ProposalId != "" {
+		id, err := strconv.ParseUint(keyShareReq.ProposalId, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		proposal, found := k.govKeeper.GetProposal(ctx, id)
+		if !found {
+			return errors.New("proposal not found")
+		}
+
+		proposal.AggrKeyshare = keyShareReq.AggrKeyshare
+		k.govKeeper.SetProposal(ctx, proposal)
+		return nil
+	}
+
+	entry, _ := k.pepKeeper.GetEntry(ctx, keyShareReq.RequestId)
+	entry.AggrKeyshare = keyShareReq.AggrKeyshare
+	k.pepKeeper.SetExecutionQueueEntry(ctx, entry)
+	k.pepKeeper.SetEntry(ctx, entry)
+
+	return nil
+}
+
+// aggregateKeyShares interpolates the keyshares verified for the active public key in keyshare index order,
+// it returns false when there are not enough keyshares or keyshare power to aggregate.
+// The keyshares were verified when they were submitted, so they are not verified again.
+func (k Keeper) aggregateKeyShares(
+	ctx sdk.Context,
+	activePubKey types.ActivePubKey,
+	keyshares []verifiedKeyshare,
+) (string, bool, error) {
+	var participatingPower int64
+	shares := make([]verifiedKeyshare, 0, len(keyshares))
+	seen := make(map[uint64]bool, len(keyshares))
+	for _, s := range keyshares {
+		// keyshares submitted for a previous key can not be combined with the active one
+		if s.pubKey != activePubKey.PublicKey || seen[s.keyShareIndex] {
+			continue
+		}
+		seen[s.keyShareIndex] = true
+		shares = append(shares, s)
+		participatingPower += s.power
+	}
+
+	if uint64(len(shares)) < activePubKey.Threshold || !k.HasSufficientParticipation(ctx, participatingPower) {
+		return "", false, nil
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].keyShareIndex < shares[j].keyShareIndex
+	})
+
+	suite := bls.NewBLS12381Suite()
+	indexes := make([]uint32, len(shares))
+	for i, s := range shares {
+		indexes[i] = uint32(s.keyShareIndex)
+	}
+
+	sk := suite.G2().Point().Null()
+	for _, s := range shares {
+		point, err := parseKeySharePoint(suite.G2().Point(), s.keyShare)
+		if err != nil {
+			return "", false, err
+		}
+		lagrangeCoef := distIBE.LagrangeCoefficient(suite, uint32(s.keyShareIndex), indexes)
+		sk = sk.Add(sk, point.Mul(lagrangeCoef, point))
+	}
+
+	skByte, err := sk.MarshalBinary()
+	if err != nil {
+		return "", false, err
+	}
+
+	return hex.EncodeToString(skByte), true, nil
+}
+
+// parseKeySharePoint parses a hex encoded keyshare into the given point
+func parseKeySharePoint(point kyber.Point, keyShareHex string) (kyber.Point, error) {
+	keyShareBytes, err := hex.DecodeString(keyShareHex)
+	if err != nil {
+		return nil, types.ErrDecodingKeyShare.Wrap(err.Error())
+	}
+	if err = point.UnmarshalBinary(keyShareBytes); err != nil {
+		return nil, types.ErrUnmarshallingKeyShare.Wrap(err.Error())
+	}
+	return point, nil
+}
+
+// authorizedTargets maps every validator to the address it authorized to submit its keyshares
+func (k Keeper) authorizedTargets(ctx sdk.Context) map[string]string {
+	authorized := make(map[string]string)
+	for _, a := range k.GetAllAuthorizedAddress(ctx) {
+		if a.IsAuthorized {
+			authorized[a.AuthorizedBy] = a.Target
+		}
+	}
+	return authorized
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/shares"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestAggregatePendingKeyshares(t *testing.T) {
+	k, ctx, pk, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	suite := bls.NewBLS12381Suite()
+
+	out, _ := SetupTestGeneralKeyShare(t, ctx, k, 3, 3)
+	for _, s := range out.GeneratedShare {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: s.ValidatorAddress, Validator: s.ValidatorAddress, IsActive: true})
+	}
+
+	send := func(i int, height uint64, id string) {
+		derived, err := shares.DeriveShare(out.GeneratedShare[i].Share, uint32(i+1), id)
+		require.NoError(t, err)
+		res, err := srv.SendKeyshare(ctx, &types.MsgSendKeyshare{
+			Creator:       out.GeneratedShare[i].ValidatorAddress,
+			Message:       derived,
+			KeyShareIndex: uint64(i + 1),
+			BlockHeight:   height,
+		})
+		require.NoError(t, err)
+		require.True(t, res.Success)
+	}
+
+	// the keyshares of the third and first validators reach the threshold of 2, in any submission order
+	send(2, 1, "1")
+	send(0, 1, "1")
+
+	// a single keyshare for the next height, the second one was verified for a previous key
+	send(1, 2, "2")
+	k.SetKeyShare(ctx, types.KeyShare{
+		Validator:     out.GeneratedShare[0].ValidatorAddress,
+		BlockHeight:   2,
+		KeyShare:      "invalid",
+		KeyShareIndex: 1,
+		PubKey:        "previous",
+	})
+
+	_, found := k.GetAggregatedKeyShare(ctx, 1)
+	require.False(t, found)
+	require.Len(t, k.GetAllPendingAggregation(ctx), 2)
+
+	k.AggregatePendingKeyshares(ctx)
+	require.Empty(t, k.GetAllPendingAggregation(ctx))
+
+	aggregated, found := k.GetAggregatedKeyShare(ctx, 1)
+	require.True(t, found)
+	pepAggregated, found := pk.GetAggregatedKeyShare(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, aggregated.Data, pepAggregated.Data)
+	require.Equal(t, "1", pk.GetLatestHeight(ctx))
+
+	_, found = k.GetAggregatedKeyShare(ctx, 2)
+	require.False(t, found)
+
+	// the aggregated key is the private key of the identity: e(g1, sk) = e(pk, H(id))
+	skBytes, err := hex.DecodeString(aggregated.Data)
+	require.NoError(t, err)
+	sk := suite.G2().Point()
+	require.NoError(t, sk.UnmarshalBinary(skBytes))
+
+	pkBytes, err := hex.DecodeString(out.MasterPublicKey)
+	require.NoError(t, err)
+	masterPublicKey := suite.G1().Point()
+	require.NoError(t, masterPublicKey.UnmarshalBinary(pkBytes))
+
+	qid := suite.G2().Point().(kyber.HashablePoint).Hash([]byte("1"))
+	require.True(t, suite.Pair(suite.G1().Point().Base(), sk).Equal(suite.Pair(masterPublicKey, qid)))
+}
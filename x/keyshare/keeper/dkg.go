@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// GetDkgRoundCount returns the id of the latest dkg round, 0 if no round was started yet
+func (k Keeper) GetDkgRoundCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+
+	b := store.Get(types.KeyPrefix(types.DkgRoundCountKey))
+	if b == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(b)
+}
+
+// SetDkgRoundCount sets the id of the latest dkg round
+func (k Keeper) SetDkgRoundCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, count)
+	store.Set(types.KeyPrefix(types.DkgRoundCountKey), b)
+}
+
+// SetDkgRound set a specific dkgRound in the store from its index
+func (k Keeper) SetDkgRound(ctx context.Context, round types.DkgRound) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgRoundKeyPrefix))
+
+	b := k.cdc.MustMarshal(&round)
+	store.Set(types.DkgRoundKey(round.Id), b)
+}
+
+// GetDkgRound returns a dkgRound from its index
+func (k Keeper) GetDkgRound(ctx context.Context, id uint64) (val types.DkgRound, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgRoundKeyPrefix))
+
+	b := store.Get(types.DkgRoundKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDkgRound returns all dkgRound
+func (k Keeper) GetAllDkgRound(ctx context.Context) (list []types.DkgRound) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgRoundKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgRound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetLatestDkgRound returns the most recently started dkgRound
+func (k Keeper) GetLatestDkgRound(ctx context.Context) (val types.DkgRound, found bool) {
+	count := k.GetDkgRoundCount(ctx)
+	if count == 0 {
+		return val, false
+	}
+	return k.GetDkgRound(ctx, count)
+}
+
+// SetDkgDeal set a specific dkgDeal in the store from its index
+func (k Keeper) SetDkgDeal(ctx context.Context, deal types.DkgDeal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgDealKeyPrefix))
+
+	b := k.cdc.MustMarshal(&deal)
+	store.Set(types.DkgDealKey(deal.RoundId, deal.Dealer), b)
+}
+
+// GetDkgDeal returns a dkgDeal from its index
+func (k Keeper) GetDkgDeal(ctx context.Context, roundID uint64, dealer string) (val types.DkgDeal, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgDealKeyPrefix))
+
+	b := store.Get(types.DkgDealKey(roundID, dealer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDkgDeal returns all dkgDeal of a round
+func (k Keeper) GetAllDkgDeal(ctx context.Context, roundID uint64) (list []types.DkgDeal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgDealKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.DkgRoundKey(roundID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgDeal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDkgComplaint set a specific dkgComplaint in the store from its index
+func (k Keeper) SetDkgComplaint(ctx context.Context, complaint types.DkgComplaint) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgComplaintKeyPrefix))
+
+	b := k.cdc.MustMarshal(&complaint)
+	store.Set(types.DkgComplaintKey(complaint.RoundId, complaint.Dealer, complaint.Complainer), b)
+}
+
+// GetDkgComplaint returns a dkgComplaint from its index
+func (k Keeper) GetDkgComplaint(
+	ctx context.Context,
+	roundID uint64,
+	dealer string,
+	complainer string,
+) (val types.DkgComplaint, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgComplaintKeyPrefix))
+
+	b := store.Get(types.DkgComplaintKey(roundID, dealer, complainer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDkgComplaint returns all dkgComplaint of a round
+func (k Keeper) GetAllDkgComplaint(ctx context.Context, roundID uint64) (list []types.DkgComplaint) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DkgComplaintKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.DkgRoundKey(roundID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgComplaint
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bls "github.com/drand/kyber-bls12381"
+)
+
+// HandleKeyshareEvidence stores the evidence of conflicting keyshares, slashes the validator
+// and jails it from the keyshare set. A validator is only punished once per identity.
+func (k Keeper) HandleKeyshareEvidence(ctx sdk.Context, evidence types.KeyshareEvidence) error {
+	if _, found := k.GetKeyshareEvidence(ctx, evidence.Validator, evidence.Identity); found {
+		return types.ErrKeyshareEvidenceAlreadyExists.Wrapf("validator: %s, identity: %s", evidence.Validator, evidence.Identity)
+	}
+
+	evidence.Height = uint64(ctx.BlockHeight())
+
+	info, found := k.GetKeyshareSigningInfo(ctx, evidence.Validator)
+	if validatorInfo, registered := k.GetValidatorSet(ctx, evidence.Validator); registered {
+		if !found {
+			info = k.newKeyshareSigningInfo(validatorInfo, evidence.Height)
+		}
+		info.ConsAddr = validatorInfo.ConsAddr
+	} else if !found {
+		return types.ErrValidatorNotRegistered.Wrap(evidence.Validator)
+	}
+
+	k.SetKeyshareEvidence(ctx, evidence)
+
+	if k.slashKeyshareValidator(ctx, evidence.Validator, info.ConsAddr, k.GetParams(ctx).SlashFractionEquivocation) {
+		telemetry.IncrCounter(1, types.KeyTotalEquivocationSlashed)
+	}
+
+	k.jailKeyshareValidator(ctx, &info)
+	k.SetKeyshareSigningInfo(ctx, info)
+	k.SetMissedKeysharesBitmap(ctx, info.Validator, make([]byte, bitmapSize(k.GetParams(ctx).SignedKeysharesWindow)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareEquivocationEventType,
+			sdk.NewAttribute(types.KeyshareEquivocationEventValidator, evidence.Validator),
+			sdk.NewAttribute(types.KeyshareEquivocationEventIdentity, evidence.Identity),
+			sdk.NewAttribute(types.KeyshareEquivocationEventReporter, evidence.Reporter),
+			sdk.NewAttribute(types.KeyshareEquivocationEventJailedUntil, strconv.FormatUint(info.JailedUntil, 10)),
+		),
+	)
+
+	return nil
+}
+
+// verifyEvidenceKeyshare checks one side of a keyshare evidence was produced by the validator for the active key,
+// a signed keyshare is authenticated by the signature of its submitter and an unsigned one has to be the keyshare
+// stored on-chain for the identity
+func (k Keeper) verifyEvidenceKeyshare(
+	ctx sdk.Context,
+	validator string,
+	identity string,
+	keyshare types.SignedKeyshare,
+	activePubKey types.ActivePubKey,
+	commitments types.Commitments,
+) error {
+	if keyshare.Submitter != validator {
+		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, keyshare.Submitter)
+		if !found || !authorizedAddrInfo.IsAuthorized || authorizedAddrInfo.AuthorizedBy != validator {
+			return types.ErrInvalidKeyshareEvidence.Wrapf("submitter %s does not submit keyshares for %s", keyshare.Submitter, validator)
+		}
+	}
+
+	if len(keyshare.Signature) == 0 {
+		stored, found := k.onChainKeyshare(ctx, keyshare.Submitter, identity)
+		if !found || stored.ConflictsWith(keyshare) {
+			return types.ErrInvalidKeyshareEvidence.Wrap("unsigned keyshare does not match the keyshare stored on-chain")
+		}
+		if !isActiveKeyshare(stored, identity, commitments) {
+			return types.ErrInvalidKeyshareEvidence.Wrap("keyshare stored on-chain was not produced for the active key")
+		}
+		return nil
+	}
+
+	submitterAddr, err := sdk.AccAddressFromBech32(keyshare.Submitter)
+	if err != nil {
+		return err
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, submitterAddr)
+	if account == nil || account.GetPubKey() == nil {
+		return types.ErrInvalidKeyshareSignature.Wrapf("public key of submitter %s is unknown", keyshare.Submitter)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(keyshare.Signature)
+	if err != nil {
+		return types.ErrInvalidKeyshareSignature.Wrap(err.Error())
+	}
+
+	signBytes := types.KeyshareSignBytes(ctx.ChainID(), activePubKey.PublicKey, identity, keyshare.Keyshare, keyshare.KeyShareIndex)
+	if !account.GetPubKey().VerifySignature(signBytes, signature) {
+		return types.ErrInvalidKeyshareSignature.Wrapf("signature of submitter %s does not match the keyshare", keyshare.Submitter)
+	}
+
+	return nil
+}
+
+// onChainKeyshare returns the keyshare stored for the identity, the block height of a keyshare
+// or the id value of a general keyshare
+func (k Keeper) onChainKeyshare(ctx sdk.Context, submitter, identity string) (types.SignedKeyshare, bool) {
+	if height, err := strconv.ParseUint(identity, 10, 64); err == nil {
+		keyShare, found := k.GetKeyShare(ctx, submitter, height)
+		return types.SignedKeyshare{
+			Keyshare:      keyShare.KeyShare,
+			KeyShareIndex: keyShare.KeyShareIndex,
+			Submitter:     keyShare.Validator,
+		}, found
+	}
+
+	generalKeyShare, found := k.GetGeneralKeyShare(ctx, submitter, PrivateGovIdentity, identity)
+	return types.SignedKeyshare{
+		Keyshare:      generalKeyShare.KeyShare,
+		KeyShareIndex: generalKeyShare.KeyShareIndex,
+		Submitter:     generalKeyShare.Validator,
+	}, found
+}
+
+// isActiveKeyshare returns true if the keyshare is valid for the identity under the active commitments
+func isActiveKeyshare(keyshare types.SignedKeyshare, identity string, commitments types.Commitments) bool {
+	if keyshare.KeyShareIndex < 1 || keyshare.KeyShareIndex > uint64(len(commitments.Commitments)) {
+		return false
+	}
+
+	_, _, err := parseKeyShareCommitment(
+		bls.NewBLS12381Suite(),
+		keyshare.Keyshare,
+		commitments.Commitments[keyshare.KeyShareIndex-1],
+		uint32(keyshare.KeyShareIndex),
+		identity,
+	)
+	return err == nil
+}
+
+// conflictingKeyshareEvidence returns the evidence of a keyshare submitted on-chain conflicting with the one already
+// stored for the identity, keyshares stored for a replaced key do not conflict
+func conflictingKeyshareEvidence(
+	validator string,
+	identity string,
+	stored types.SignedKeyshare,
+	submitted types.SignedKeyshare,
+	commitments types.Commitments,
+) (types.KeyshareEvidence, bool) {
+	if !stored.ConflictsWith(submitted) || !isActiveKeyshare(stored, identity, commitments) {
+		return types.KeyshareEvidence{}, false
+	}
+
+	return types.KeyshareEvidence{
+		Validator: validator,
+		Identity:  identity,
+		First:     &stored,
+		Second:    &submitted,
+	}, true
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/testutil/shares"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestKeyshareEquivocation(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+
+	out, creator := SetupTestGeneralKeyShare(t, ctx, k, 10, 10)
+
+	// the cons address can not be decoded, so the validator is jailed without being slashed in this test
+	k.SetValidatorSet(ctx, types.ValidatorSet{Index: creator, Validator: creator, ConsAddr: "invalid", IsActive: true})
+
+	derived, err := shares.DeriveShare(out.GeneratedShare[0].Share, 1, "1")
+	require.NoError(t, err)
+	keyshare := &types.MsgSendKeyshare{Creator: creator, Message: derived, KeyShareIndex: 1, BlockHeight: 1}
+
+	// submitting the same keyshare again is not an equivocation
+	for i := 0; i < 2; i++ {
+		res, err := srv.SendKeyshare(ctx, keyshare)
+		require.NoError(t, err)
+		require.True(t, res.Success)
+	}
+
+	conflicting, err := shares.DeriveShare(out.GeneratedShare[1].Share, 2, "1")
+	require.NoError(t, err)
+	res, err := srv.SendKeyshare(ctx, &types.MsgSendKeyshare{Creator: creator, Message: conflicting, KeyShareIndex: 2, BlockHeight: 1})
+	require.NoError(t, err)
+	require.False(t, res.Success)
+
+	evidence, found := k.GetKeyshareEvidence(ctx, creator, "1")
+	require.True(t, found)
+	require.Equal(t, derived, evidence.First.Keyshare)
+	require.Equal(t, conflicting, evidence.Second.Keyshare)
+
+	_, found = k.GetValidatorSet(ctx, creator)
+	require.False(t, found)
+	info, found := k.GetKeyshareSigningInfo(ctx, creator)
+	require.True(t, found)
+	require.True(t, info.Jailed)
+
+	// the validator and its authorized address both submitted a keyshare for the second height
+	authorized := sample.AccAddress()
+	k.SetAuthorizedAddress(ctx, types.AuthorizedAddress{Target: authorized, IsAuthorized: true, AuthorizedBy: creator})
+
+	derived, err = shares.DeriveShare(out.GeneratedShare[0].Share, 1, "2")
+	require.NoError(t, err)
+	k.SetKeyShare(ctx, types.KeyShare{Validator: creator, BlockHeight: 2, KeyShare: derived, KeyShareIndex: 1})
+	conflicting, err = shares.DeriveShare(out.GeneratedShare[1].Share, 2, "2")
+	require.NoError(t, err)
+	k.SetKeyShare(ctx, types.KeyShare{Validator: authorized, BlockHeight: 2, KeyShare: conflicting, KeyShareIndex: 2})
+
+	reporter := sample.AccAddress()
+	onChain := &types.SignedKeyshare{Keyshare: derived, KeyShareIndex: 1, Submitter: creator}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSubmitKeyshareEvidence
+		err     error
+	}{
+		{
+			desc: "EvidenceAlreadyExists",
+			request: &types.MsgSubmitKeyshareEvidence{
+				Creator: reporter, Validator: creator, Identity: "1",
+				First:  &types.SignedKeyshare{Keyshare: derived, KeyShareIndex: 1, Submitter: creator},
+				Second: &types.SignedKeyshare{Keyshare: conflicting, KeyShareIndex: 2, Submitter: creator},
+			},
+			err: types.ErrKeyshareEvidenceAlreadyExists,
+		},
+		{
+			desc: "NotConflicting",
+			request: &types.MsgSubmitKeyshareEvidence{
+				Creator: reporter, Validator: creator, Identity: "2",
+				First: onChain, Second: onChain,
+			},
+			err: types.ErrInvalidKeyshareEvidence,
+		},
+		{
+			desc: "UnsignedNotOnChain",
+			request: &types.MsgSubmitKeyshareEvidence{
+				Creator: reporter, Validator: creator, Identity: "2",
+				First:  onChain,
+				Second: &types.SignedKeyshare{Keyshare: conflicting, KeyShareIndex: 2, Submitter: creator},
+			},
+			err: types.ErrInvalidKeyshareEvidence,
+		},
+		{
+			desc: "SubmitterOfAnotherValidator",
+			request: &types.MsgSubmitKeyshareEvidence{
+				Creator: reporter, Validator: creator, Identity: "2",
+				First:  onChain,
+				Second: &types.SignedKeyshare{Keyshare: conflicting, KeyShareIndex: 2, Submitter: sample.AccAddress(), Signature: "c2lnbmF0dXJl"},
+			},
+			err: types.ErrInvalidKeyshareEvidence,
+		},
+		{
+			desc: "UnknownSubmitterPubKey",
+			request: &types.MsgSubmitKeyshareEvidence{
+				Creator: reporter, Validator: creator, Identity: "2",
+				First:  onChain,
+				Second: &types.SignedKeyshare{Keyshare: conflicting, KeyShareIndex: 2, Submitter: creator, Signature: "c2lnbmF0dXJl"},
+			},
+			err: types.ErrInvalidKeyshareSignature,
+		},
+		{
+			desc: "ConflictingOnChainKeyshares",
+			request: &types.MsgSubmitKeyshareEvidence{
+				Creator: reporter, Validator: creator, Identity: "2",
+				First:  onChain,
+				Second: &types.SignedKeyshare{Keyshare: conflicting, KeyShareIndex: 2, Submitter: authorized},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SubmitKeyshareEvidence(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			evidence, found := k.GetKeyshareEvidence(ctx, creator, "2")
+			require.True(t, found)
+			require.Equal(t, reporter, evidence.Reporter)
+		})
+	}
+
+	all, err := k.KeyshareEvidenceAll(ctx, &types.QueryAllKeyshareEvidenceRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Evidence, 2)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/math"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+)
+
+// ValidatePubKeyThreshold checks the threshold a public key is created with against the MinimumThresholdRatio param
+// and verifies that the keyshare commitments are consistent with the public key for that threshold
+func (k Keeper) ValidatePubKeyThreshold(
+	ctx context.Context,
+	publicKey string,
+	commitments []string,
+	threshold uint64,
+) error {
+	if err := k.ValidateThresholdRatio(ctx, threshold, uint64(len(commitments))); err != nil {
+		return err
+	}
+
+	return verifyCommitmentsThreshold(publicKey, commitments, threshold)
+}
+
+// ValidateThresholdRatio checks that the threshold is within the number of validators
+// and not below the MinimumThresholdRatio param
+func (k Keeper) ValidateThresholdRatio(ctx context.Context, threshold, numberOfValidators uint64) error {
+	if threshold == 0 || threshold > numberOfValidators {
+		return types.ErrInvalidThreshold.Wrapf("expected threshold between 1 and number of validators: %d, got: %d", numberOfValidators, threshold)
+	}
+
+	minimumRatio := k.GetParams(ctx).MinimumThresholdRatio
+	ratio := math.LegacyNewDec(int64(threshold)).QuoInt64(int64(numberOfValidators))
+	if ratio.LT(minimumRatio) {
+		return types.ErrThresholdBelowMinimum.Wrapf("threshold: %d of %d validators, minimum ratio: %s", threshold, numberOfValidators, minimumRatio.String())
+	}
+
+	return nil
+}
+
+// verifyCommitmentsThreshold recovers the public polynomial from the first threshold commitments,
+// its constant term has to be the public key and every other commitment has to lie on it,
+// which is only possible when the shares were generated with a polynomial of degree threshold - 1
+func verifyCommitmentsThreshold(publicKey string, commitments []string, threshold uint64) error {
+	suite := bls.NewBLS12381Suite()
+
+	pubKeyBytes, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return types.ErrInvalidPubKey.Wrap(err.Error())
+	}
+	pubKeyPoint := suite.G1().Point()
+	if err = pubKeyPoint.UnmarshalBinary(pubKeyBytes); err != nil {
+		return types.ErrInvalidPubKey.Wrap(err.Error())
+	}
+
+	pubShares := make([]*share.PubShare, len(commitments))
+	for i, c := range commitments {
+		commitmentBytes, err := hex.DecodeString(c)
+		if err != nil {
+			return types.ErrDecodingCommitment.Wrap(err.Error())
+		}
+		commitmentPoint := suite.G1().Point()
+		if err = commitmentPoint.UnmarshalBinary(commitmentBytes); err != nil {
+			return types.ErrUnmarshallingCommitment.Wrap(err.Error())
+		}
+		// commitment i is the commitment of the keyshare with index i + 1
+		pubShares[i] = &share.PubShare{I: i, V: commitmentPoint}
+	}
+
+	t := int(threshold)
+	pubPoly, err := share.RecoverPubPoly(suite.G1(), pubShares[:t], t, len(pubShares))
+	if err != nil {
+		return types.ErrCommitmentsNotMatchThreshold.Wrap(err.Error())
+	}
+
+	if !pubPoly.Commit().Equal(pubKeyPoint) {
+		return types.ErrCommitmentsNotMatchThreshold.Wrapf("public key does not match the first %d commitments", t)
+	}
+
+	for i := t; i < len(pubShares); i++ {
+		if !pubPoly.Eval(i).V.Equal(pubShares[i].V) {
+			return types.ErrCommitmentsNotMatchThreshold.Wrapf("commitment of keyshare index %d does not match threshold %d", i+1, t)
+		}
+	}
+
+	return nil
+}
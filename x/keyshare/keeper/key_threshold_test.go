@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePubKeyThreshold(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+
+	// 4 validators with a threshold of 3
+	out, err := random.GeneratePubKeyAndShares(4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), out.Threshold)
+
+	other, err := random.GeneratePubKeyAndShares(4)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc        string
+		publicKey   string
+		commitments []string
+		threshold   uint64
+		minRatio    math.LegacyDec
+		err         error
+	}{
+		{
+			desc:        "Valid",
+			publicKey:   out.MasterPublicKey,
+			commitments: out.Commitments,
+			threshold:   3,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+		},
+		{
+			desc:        "ZeroThreshold",
+			publicKey:   out.MasterPublicKey,
+			commitments: out.Commitments,
+			threshold:   0,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+			err:         types.ErrInvalidThreshold,
+		},
+		{
+			desc:        "ThresholdAboveNumberOfCommitments",
+			publicKey:   out.MasterPublicKey,
+			commitments: out.Commitments,
+			threshold:   5,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+			err:         types.ErrInvalidThreshold,
+		},
+		{
+			desc:        "BelowMinimumRatio",
+			publicKey:   out.MasterPublicKey,
+			commitments: out.Commitments,
+			threshold:   2,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+			err:         types.ErrThresholdBelowMinimum,
+		},
+		{
+			desc:        "LowerThresholdThanGenerated",
+			publicKey:   out.MasterPublicKey,
+			commitments: out.Commitments,
+			threshold:   2,
+			minRatio:    math.LegacyNewDecWithPrec(5, 1),
+			err:         types.ErrCommitmentsNotMatchThreshold,
+		},
+		{
+			desc:        "HigherThresholdThanGenerated",
+			publicKey:   out.MasterPublicKey,
+			commitments: out.Commitments,
+			threshold:   4,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+		},
+		{
+			desc:        "CommitmentsOfAnotherKey",
+			publicKey:   out.MasterPublicKey,
+			commitments: other.Commitments,
+			threshold:   3,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+			err:         types.ErrCommitmentsNotMatchThreshold,
+		},
+		{
+			desc:        "MixedCommitments",
+			publicKey:   out.MasterPublicKey,
+			commitments: append(append([]string{}, out.Commitments[:3]...), other.Commitments[3]),
+			threshold:   3,
+			minRatio:    types.DefaultMinimumThresholdRatio,
+			err:         types.ErrCommitmentsNotMatchThreshold,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := k.GetParams(ctx)
+			params.MinimumThresholdRatio = tc.minRatio
+			require.NoError(t, k.SetParams(ctx, params))
+
+			err := k.ValidatePubKeyThreshold(ctx, tc.publicKey, tc.commitments, tc.threshold)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
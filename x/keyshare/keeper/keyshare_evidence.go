@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetKeyshareEvidence set a specific keyshareEvidence in the store from its index
+func (k Keeper) SetKeyshareEvidence(ctx context.Context, evidence types.KeyshareEvidence) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareEvidenceKeyPrefix))
+
+	b := k.cdc.MustMarshal(&evidence)
+	store.Set(types.KeyshareEvidenceKey(evidence.Validator, evidence.Identity), b)
+}
+
+// GetKeyshareEvidence returns a keyshareEvidence from its index
+func (k Keeper) GetKeyshareEvidence(
+	ctx context.Context,
+	validator string,
+	identity string,
+) (val types.KeyshareEvidence, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareEvidenceKeyPrefix))
+
+	b := store.Get(types.KeyshareEvidenceKey(validator, identity))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllKeyshareEvidence returns all keyshareEvidence
+func (k Keeper) GetAllKeyshareEvidence(ctx context.Context) (list []types.KeyshareEvidence) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareEvidenceKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyshareEvidence
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SetKeyshareRewardCount set the number of keyshares a validator submitted in the current reward epoch
+func (k Keeper) SetKeyshareRewardCount(ctx context.Context, count types.KeyshareRewardCount) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardCountKeyPrefix))
+
+	b := k.cdc.MustMarshal(&count)
+	store.Set(types.KeyshareRewardKey(count.Validator), b)
+}
+
+// GetKeyshareRewardCount returns the number of keyshares a validator submitted in the current reward epoch
+func (k Keeper) GetKeyshareRewardCount(ctx context.Context, validator string) (val types.KeyshareRewardCount, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardCountKeyPrefix))
+
+	b := store.Get(types.KeyshareRewardKey(validator))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllKeyshareRewardCount returns the keyshare counts of all validators in the current reward epoch
+func (k Keeper) GetAllKeyshareRewardCount(ctx context.Context) (list []types.KeyshareRewardCount) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardCountKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyshareRewardCount
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveKeyshareRewardCount removes the keyshare count of a validator
+func (k Keeper) RemoveKeyshareRewardCount(ctx context.Context, validator string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardCountKeyPrefix))
+	store.Delete(types.KeyshareRewardKey(validator))
+}
+
+// IncreaseKeyshareRewardCount counts a new valid keyshare of the validator in the current reward epoch
+func (k Keeper) IncreaseKeyshareRewardCount(ctx context.Context, validator string) {
+	count, _ := k.GetKeyshareRewardCount(ctx, validator)
+	count.Validator = validator
+	count.Count++
+	k.SetKeyshareRewardCount(ctx, count)
+}
+
+// SetKeyshareReward set the unclaimed keyshare rewards of a validator
+func (k Keeper) SetKeyshareReward(ctx context.Context, reward types.KeyshareReward) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardKeyPrefix))
+
+	b := k.cdc.MustMarshal(&reward)
+	store.Set(types.KeyshareRewardKey(reward.Validator), b)
+}
+
+// GetKeyshareReward returns the unclaimed keyshare rewards of a validator
+func (k Keeper) GetKeyshareReward(ctx context.Context, validator string) (val types.KeyshareReward, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardKeyPrefix))
+
+	b := store.Get(types.KeyshareRewardKey(validator))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllKeyshareReward returns the unclaimed keyshare rewards of all validators
+func (k Keeper) GetAllKeyshareReward(ctx context.Context) (list []types.KeyshareReward) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyshareReward
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveKeyshareReward removes the unclaimed keyshare rewards of a validator
+func (k Keeper) RemoveKeyshareReward(ctx context.Context, validator string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareRewardKeyPrefix))
+	store.Delete(types.KeyshareRewardKey(validator))
+}
+
+// UnclaimedKeyshareRewards returns the total rewards paid out in previous epochs and not claimed yet
+func (k Keeper) UnclaimedKeyshareRewards(ctx context.Context) sdk.Coins {
+	unclaimed := sdk.NewCoins()
+	for _, reward := range k.GetAllKeyshareReward(ctx) {
+		unclaimed = unclaimed.Add(reward.Amount...)
+	}
+	return unclaimed
+}
+
+// KeyshareRewardPoolBalance returns the part of the module balance paid out at the end of the current epoch,
+// the pep module funds it with its share of the encrypted tx and private keyshare fees
+func (k Keeper) KeyshareRewardPoolBalance(ctx context.Context) sdk.Coins {
+	balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	pool, hasNeg := balance.SafeSub(k.UnclaimedKeyshareRewards(ctx)...)
+	if hasNeg {
+		return sdk.NewCoins()
+	}
+	return pool
+}
+
+// NextKeyshareRewardEpoch returns the height the current reward epoch ends at
+func (k Keeper) NextKeyshareRewardEpoch(ctx sdk.Context) uint64 {
+	epoch := k.GetParams(ctx).RewardEpochBlocks
+	height := uint64(ctx.BlockHeight())
+	return height - height%epoch + epoch
+}
+
+// DistributeKeyshareRewards pays out the reward pool at the end of each epoch, in proportion to the number of
+// valid keyshares and general keyshares every validator submitted during the epoch.
+// The rounding remainder stays in the pool for the next epoch.
+func (k Keeper) DistributeKeyshareRewards(ctx sdk.Context) {
+	if uint64(ctx.BlockHeight())%k.GetParams(ctx).RewardEpochBlocks != 0 {
+		return
+	}
+
+	counts := k.GetAllKeyshareRewardCount(ctx)
+	for _, c := range counts {
+		k.RemoveKeyshareRewardCount(ctx, c.Validator)
+	}
+
+	var total uint64
+	for _, c := range counts {
+		total += c.Count
+	}
+
+	pool := k.KeyshareRewardPoolBalance(ctx)
+	if total == 0 || pool.IsZero() {
+		return
+	}
+
+	totalInt := math.NewIntFromUint64(total)
+	for _, c := range counts {
+		earned := sdk.NewCoins()
+		for _, coin := range pool {
+			earned = earned.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(math.NewIntFromUint64(c.Count)).Quo(totalInt)))
+		}
+		if earned.IsZero() {
+			continue
+		}
+
+		reward, _ := k.GetKeyshareReward(ctx, c.Validator)
+		reward.Validator = c.Validator
+		reward.Amount = reward.Amount.Add(earned...)
+		k.SetKeyshareReward(ctx, reward)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareRewardsDistributedEventType,
+			sdk.NewAttribute(types.KeyshareRewardsDistributedEventPool, pool.String()),
+			sdk.NewAttribute(types.KeyshareRewardsDistributedEventKeyshares, strconv.FormatUint(total, 10)),
+			sdk.NewAttribute(types.KeyshareRewardsDistributedEventValidators, strconv.Itoa(len(counts))),
+		),
+	)
+}
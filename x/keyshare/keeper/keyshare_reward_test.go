@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/shares"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestKeyshareRewardCount(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+
+	out, creator := SetupTestGeneralKeyShare(t, ctx, k, 10, 10)
+
+	for _, height := range []uint64{1, 2} {
+		derived, err := shares.DeriveShare(out.GeneratedShare[0].Share, 1, strconv.FormatUint(height, 10))
+		require.NoError(t, err)
+		keyshare := &types.MsgSendKeyshare{Creator: creator, Message: derived, KeyShareIndex: 1, BlockHeight: height}
+
+		// the same keyshare submitted again is only counted once
+		for i := 0; i < 2; i++ {
+			res, err := srv.SendKeyshare(ctx, keyshare)
+			require.NoError(t, err)
+			require.True(t, res.Success)
+		}
+	}
+
+	res, err := k.KeyshareRewards(ctx, &types.QueryKeyshareRewardsRequest{Validator: creator})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.EpochKeyshares)
+	require.True(t, res.Rewards.IsZero())
+
+	_, err = srv.ClaimKeyshareRewards(ctx, &types.MsgClaimKeyshareRewards{Creator: creator})
+	require.ErrorIs(t, err, types.ErrNoKeyshareRewards)
+
+	_, err = k.KeyshareRewards(ctx, nil)
+	require.Error(t, err)
+}
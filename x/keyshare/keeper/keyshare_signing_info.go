@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetKeyshareSigningInfo set a specific keyshareSigningInfo in the store from its index
+func (k Keeper) SetKeyshareSigningInfo(ctx context.Context, info types.KeyshareSigningInfo) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareSigningInfoKeyPrefix))
+
+	b := k.cdc.MustMarshal(&info)
+	store.Set(types.KeyshareSigningInfoKey(info.Validator), b)
+}
+
+// GetKeyshareSigningInfo returns a keyshareSigningInfo from its index
+func (k Keeper) GetKeyshareSigningInfo(
+	ctx context.Context,
+	validator string,
+) (val types.KeyshareSigningInfo, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareSigningInfoKeyPrefix))
+
+	b := store.Get(types.KeyshareSigningInfoKey(validator))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllKeyshareSigningInfo returns all keyshareSigningInfo
+func (k Keeper) GetAllKeyshareSigningInfo(ctx context.Context) (list []types.KeyshareSigningInfo) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareSigningInfoKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyshareSigningInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetMissedKeysharesBitmap set the missed keyshares bitmap of a validator
+func (k Keeper) SetMissedKeysharesBitmap(ctx context.Context, validator string, bitmap []byte) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MissedKeysharesBitmapKeyPrefix))
+	store.Set(types.KeyshareSigningInfoKey(validator), bitmap)
+}
+
+// GetMissedKeysharesBitmap returns the missed keyshares bitmap of a validator
+func (k Keeper) GetMissedKeysharesBitmap(ctx context.Context, validator string) []byte {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MissedKeysharesBitmapKeyPrefix))
+	return store.Get(types.KeyshareSigningInfoKey(validator))
+}
+
+// GetAllMissedKeysharesBitmap returns the missed keyshares bitmaps of all validators
+func (k Keeper) GetAllMissedKeysharesBitmap(ctx context.Context) (list []types.MissedKeysharesBitmap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MissedKeysharesBitmapKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.MissedKeysharesBitmap{
+			Validator: strings.TrimSuffix(string(iterator.Key()), "/"),
+			Bitmap:    iterator.Value(),
+		})
+	}
+
+	return
+}
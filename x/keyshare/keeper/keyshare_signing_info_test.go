@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestKeyshareLiveness(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.SignedKeysharesWindow = 10
+	params.MinSubmittedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	params.DowntimeJailBlocks = 20
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the cons address can not be decoded, so the idle validator is jailed without being slashed in this test
+	idle := types.ValidatorSet{Index: sample.AccAddress(), ConsAddr: "invalid", IsActive: true}
+	idle.Validator = idle.Index
+	live := types.ValidatorSet{Index: sample.AccAddress(), ConsAddr: "invalid", IsActive: true}
+	live.Validator = live.Index
+	k.SetValidatorSet(ctx, idle)
+	k.SetValidatorSet(ctx, live)
+
+	// nothing is tracked without an active public key
+	ctx = ctx.WithBlockHeight(1)
+	k.ProcessKeyshareLiveness(ctx)
+	_, found := k.GetKeyshareSigningInfo(ctx, idle.Validator)
+	require.False(t, found)
+
+	k.SetActivePubKey(ctx, types.ActivePubKey{
+		PublicKey:          "pubkey",
+		Expiry:             1000,
+		EncryptedKeyShares: []*types.EncryptedKeyShare{{Validator: idle.Validator}, {Validator: live.Validator}},
+	})
+
+	var jailedAt int64
+	for height := int64(1); height <= 12 && jailedAt == 0; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.SetKeyShare(ctx, types.KeyShare{Validator: live.Validator, BlockHeight: uint64(height), KeyShareIndex: 2})
+		// a keyshare of the index held by another validator does not count
+		k.SetKeyShare(ctx, types.KeyShare{Validator: idle.Validator, BlockHeight: uint64(height), KeyShareIndex: 2})
+		k.ProcessKeyshareLiveness(ctx)
+
+		if _, found := k.GetValidatorSet(ctx, idle.Validator); !found {
+			jailedAt = height
+		}
+	}
+
+	// the full window has to be tracked before jailing
+	require.Equal(t, int64(11), jailedAt)
+
+	info, found := k.GetKeyshareSigningInfo(ctx, idle.Validator)
+	require.True(t, found)
+	require.True(t, info.Jailed)
+	require.Equal(t, uint64(jailedAt)+params.DowntimeJailBlocks, info.JailedUntil)
+	require.Equal(t, uint64(0), info.MissedKeysharesCounter)
+
+	info, found = k.GetKeyshareSigningInfo(ctx, live.Validator)
+	require.True(t, found)
+	require.False(t, info.Jailed)
+	require.Equal(t, uint64(0), info.MissedKeysharesCounter)
+	_, found = k.GetValidatorSet(ctx, live.Validator)
+	require.True(t, found)
+
+	_, err := srv.RegisterValidator(ctx, &types.MsgRegisterValidator{Creator: idle.Validator})
+	require.ErrorIs(t, err, types.ErrValidatorJailed)
+
+	for _, tc := range []struct {
+		desc    string
+		height  int64
+		creator string
+		err     error
+	}{
+		{
+			desc:    "SigningInfoNotFound",
+			height:  jailedAt,
+			creator: sample.AccAddress(),
+			err:     types.ErrSigningInfoNotFound,
+		},
+		{
+			desc:    "NotJailed",
+			height:  jailedAt,
+			creator: live.Validator,
+			err:     types.ErrValidatorNotJailed,
+		},
+		{
+			desc:    "StillJailed",
+			height:  jailedAt + 1,
+			creator: idle.Validator,
+			err:     types.ErrValidatorStillJailed,
+		},
+		{
+			desc:    "NotStaking",
+			height:  jailedAt + int64(params.DowntimeJailBlocks),
+			creator: idle.Validator,
+			err:     types.ErrAccountNotStaking,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.Unjail(ctx.WithBlockHeight(tc.height), &types.MsgUnjail{Creator: tc.creator})
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	res, err := k.KeyshareSigningInfoAll(ctx, &types.QueryAllKeyshareSigningInfoRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.SigningInfo, 2)
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProcessKeyshareLiveness tracks the keyshare submissions of every registered validator for the current block,
+// a keyshare submitted by the address authorized by a validator counts for the validator.
+// Nothing is tracked while there is no active public key.
+func (k Keeper) ProcessKeyshareLiveness(ctx sdk.Context) {
+	ak, found := k.GetActivePubKey(ctx)
+	if !found || len(ak.PublicKey) == 0 {
+		return
+	}
+
+	authorized := k.authorizedTargets(ctx)
+
+	height := uint64(ctx.BlockHeight())
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		keyShare, submitted := k.GetKeyShare(ctx, eachValidator.Validator, height)
+		if target, ok := authorized[eachValidator.Validator]; ok && !submitted {
+			keyShare, submitted = k.GetKeyShare(ctx, target, height)
+		}
+
+		// only the keyshare of the index held by the validator counts
+		if submitted {
+			assigned, found := assignedKeyShareIndex(ak, eachValidator.Validator)
+			submitted = found && assigned == keyShare.KeyShareIndex
+		}
+		k.HandleKeyshareLiveness(ctx, eachValidator, submitted)
+	}
+}
+
+// HandleKeyshareLiveness records whether the validator submitted a keyshare for the current block
+// in its missed keyshares bitmap, same as the signed blocks window of x/slashing.
+// A validator missing more than the allowed keyshares of a full window is slashed and jailed from the keyshare set.
+func (k Keeper) HandleKeyshareLiveness(ctx sdk.Context, validator types.ValidatorSet, submitted bool) {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	info, found := k.GetKeyshareSigningInfo(ctx, validator.Validator)
+	if !found {
+		info = k.newKeyshareSigningInfo(validator, height)
+	}
+
+	window := params.SignedKeysharesWindow
+	bitmap := k.GetMissedKeysharesBitmap(ctx, validator.Validator)
+	// the bitmap is reset when the window param changed
+	if uint64(len(bitmap)) != bitmapSize(window) {
+		bitmap = make([]byte, bitmapSize(window))
+		info.IndexOffset = 0
+		info.MissedKeysharesCounter = 0
+	}
+
+	index := info.IndexOffset % window
+	info.IndexOffset++
+
+	previouslyMissed := bitmap[index/8]&(1<<(index%8)) != 0
+
+	switch {
+	case !previouslyMissed && !submitted:
+		bitmap[index/8] |= 1 << (index % 8)
+		info.MissedKeysharesCounter++
+	case previouslyMissed && submitted:
+		bitmap[index/8] &^= 1 << (index % 8)
+		info.MissedKeysharesCounter--
+	}
+
+	minSubmitted := params.MinSubmittedPerWindow.MulInt64(int64(window)).TruncateInt().Uint64()
+	maxMissed := window - minSubmitted
+
+	if height >= info.StartHeight+window && info.MissedKeysharesCounter > maxMissed {
+		k.jailForDowntime(ctx, validator, &info)
+		bitmap = make([]byte, bitmapSize(window))
+	}
+
+	k.SetKeyshareSigningInfo(ctx, info)
+	k.SetMissedKeysharesBitmap(ctx, validator.Validator, bitmap)
+}
+
+// jailForDowntime slashes the validator, removes it from the keyshare set and starts its jail period
+func (k Keeper) jailForDowntime(ctx sdk.Context, validator types.ValidatorSet, info *types.KeyshareSigningInfo) {
+	missed := info.MissedKeysharesCounter
+
+	if k.slashKeyshareValidator(ctx, validator.Validator, validator.ConsAddr, k.GetParams(ctx).SlashFractionNoKeyshare) {
+		telemetry.IncrCounter(1, types.KeyTotalIdleValSlashed)
+	}
+
+	k.jailKeyshareValidator(ctx, info)
+	telemetry.IncrCounter(1, types.KeyTotalIdleValJailed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareLivenessEventType,
+			sdk.NewAttribute(types.KeyshareLivenessEventValidator, validator.Validator),
+			sdk.NewAttribute(types.KeyshareLivenessEventMissedKeyshares, strconv.FormatUint(missed, 10)),
+			sdk.NewAttribute(types.KeyshareLivenessEventJailedUntil, strconv.FormatUint(info.JailedUntil, 10)),
+		),
+	)
+}
+
+// slashKeyshareValidator slashes the validator with the hex encoded cons address by the given fraction,
+// it returns false when the cons address can not be decoded
+func (k Keeper) slashKeyshareValidator(ctx sdk.Context, validator, consAddrHex string, fraction math.LegacyDec) bool {
+	savedConsAddrByte, err := hex.DecodeString(consAddrHex)
+	if err != nil {
+		k.Logger().Error(fmt.Sprintf("Error while decoding validator %s cons addr: %s", validator, err.Error()))
+		return false
+	}
+
+	var consAddr sdk.ConsAddress
+	if err = consAddr.Unmarshal(savedConsAddrByte); err != nil {
+		k.Logger().Error(fmt.Sprintf("Error while unmarshaling validator %s cons addr: %s", validator, err.Error()))
+		return false
+	}
+
+	k.slashingKeeper.Slash(
+		ctx,
+		consAddr,
+		fraction,
+		types.SlashPower,
+		ctx.BlockHeight()-1,
+	)
+	return true
+}
+
+// jailKeyshareValidator removes the validator from the keyshare set until the end of its jail period,
+// the caller is responsible for storing the signing info
+func (k Keeper) jailKeyshareValidator(ctx sdk.Context, info *types.KeyshareSigningInfo) {
+	k.RemoveValidatorSet(ctx, info.Validator)
+
+	info.Jailed = true
+	info.JailedUntil = uint64(ctx.BlockHeight()) + k.GetParams(ctx).DowntimeJailBlocks
+	info.IndexOffset = 0
+	info.MissedKeysharesCounter = 0
+}
+
+// ResetKeyshareSigningInfo starts a new liveness window for the validator at the current height
+func (k Keeper) ResetKeyshareSigningInfo(ctx sdk.Context, validator types.ValidatorSet) {
+	k.SetKeyshareSigningInfo(ctx, k.newKeyshareSigningInfo(validator, uint64(ctx.BlockHeight())))
+	k.SetMissedKeysharesBitmap(ctx, validator.Validator, make([]byte, bitmapSize(k.GetParams(ctx).SignedKeysharesWindow)))
+}
+
+func (k Keeper) newKeyshareSigningInfo(validator types.ValidatorSet, height uint64) types.KeyshareSigningInfo {
+	return types.KeyshareSigningInfo{
+		Validator:   validator.Validator,
+		ConsAddr:    validator.ConsAddr,
+		StartHeight: height,
+	}
+}
+
+// bitmapSize returns the number of bytes needed to track a window of blocks
+func bitmapSize(window uint64) uint64 {
+	return (window + 7) / 8
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestMigrations(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	m := keeper.NewMigrator(k)
+
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	// the params added after version 2 are set to their defaults
+	params := k.GetParams(ctx)
+	require.Equal(t, uint64(463000), params.KeyExpiry)
+	require.Equal(t, types.DefaultMaxPrunedEntriesPerBlock, params.MaxPrunedEntriesPerBlock)
+	require.Equal(t, types.DefaultMinimumThresholdRatio, params.MinimumThresholdRatio)
+	require.Equal(t, types.DefaultRewardEpochBlocks, params.RewardEpochBlocks)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimKeyshareRewards sends the keyshare rewards earned by a validator to its account
+func (k msgServer) ClaimKeyshareRewards(goCtx context.Context, msg *types.MsgClaimKeyshareRewards) (*types.MsgClaimKeyshareRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reward, found := k.GetKeyshareReward(ctx, msg.Creator)
+	if !found || reward.Amount.IsZero() {
+		return nil, types.ErrNoKeyshareRewards.Wrap(msg.Creator)
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, reward.Amount); err != nil {
+		return nil, err
+	}
+
+	k.RemoveKeyshareReward(ctx, msg.Creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareRewardsClaimedEventType,
+			sdk.NewAttribute(types.KeyshareRewardsClaimedEventValidator, msg.Creator),
+			sdk.NewAttribute(types.KeyshareRewardsClaimedEventAmount, reward.Amount.String()),
+		),
+	)
+
+	return &types.MsgClaimKeyshareRewardsResponse{Amount: reward.Amount}, nil
+}
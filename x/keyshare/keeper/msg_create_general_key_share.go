@@ -3,16 +3,8 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"strconv"
-	"time"
-
-	"cosmossdk.io/math"
-
-	distIBE "github.com/FairBlock/DistributedIBE"
-	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	bls "github.com/drand/kyber-bls12381"
 
 	"github.com/Fairblock/fairyring/x/keyshare/types"
 
@@ -28,26 +20,22 @@ var SupportedIDTypes = []string{PrivateGovIdentity}
 func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCreateGeneralKeyShare) (*types.MsgCreateGeneralKeyShareResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check if validator is registered
-	validatorInfo, found := k.GetValidatorSet(ctx, msg.Creator)
-
-	if !found {
-		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, msg.Creator)
-		if !found || !authorizedAddrInfo.IsAuthorized {
-			return nil, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(msg.Creator)
-		}
-
-		authorizedByValInfo, found := k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
-		if !found {
-			return nil, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
-		}
-		validatorInfo = authorizedByValInfo
-
-		// If the sender is in the validator set & authorized another address to submit key share
-	} else if count := k.GetAuthorizedCount(ctx, msg.Creator); count != 0 {
-		return nil, types.ErrAuthorizedAnotherAddress
+	validatorInfo, err := k.keyshareSubmitter(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 
+	return k.createGeneralKeyShare(ctx, msg, validatorInfo, k.parsedActiveCommitments(ctx))
+}
+
+// createGeneralKeyShare verifies and stores the general keyshare of the validator for an identity,
+// the keyshares of the identity are aggregated in EndBlock
+func (k Keeper) createGeneralKeyShare(
+	ctx sdk.Context,
+	msg *types.MsgCreateGeneralKeyShare,
+	validatorInfo types.ValidatorSet,
+	commitments *parsedCommitments,
+) (*types.MsgCreateGeneralKeyShareResponse, error) {
 	isSupportedIDType := false
 	for _, v := range SupportedIDTypes {
 		if v == msg.IdType {
@@ -81,23 +69,52 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 	}
 
 	// Setup
-	suite := bls.NewBLS12381Suite()
-
-	commitments, found := k.GetActiveCommitments(ctx)
-	if !found {
+	if commitments == nil {
 		return nil, types.ErrCommitmentsNotFound
 	}
 
-	commitmentsLen := uint64(len(commitments.Commitments))
+	commitmentsLen := commitments.Len()
 	if msg.KeyShareIndex > commitmentsLen {
 		return nil, types.ErrInvalidKeyShareIndex.Wrap(fmt.Sprintf("Expect Index within: %d, got: %d", commitmentsLen, msg.KeyShareIndex))
 	}
 
+	// A general keyshare different from the one already submitted for the identity is handled as equivocation
+	stored, alreadySubmitted := k.GetGeneralKeyShare(ctx, msg.Creator, msg.IdType, msg.IdValue)
+	if alreadySubmitted {
+		evidence, conflicting := conflictingKeyshareEvidence(
+			validatorInfo.Validator,
+			msg.IdValue,
+			types.SignedKeyshare{Keyshare: stored.KeyShare, KeyShareIndex: stored.KeyShareIndex, Submitter: msg.Creator},
+			types.SignedKeyshare{Keyshare: msg.KeyShare, KeyShareIndex: msg.KeyShareIndex, Submitter: msg.Creator},
+			commitments.commitments,
+		)
+		if conflicting {
+			if err := k.HandleKeyshareEvidence(ctx, evidence); err != nil {
+				return nil, err
+			}
+
+			return &types.MsgCreateGeneralKeyShareResponse{
+				Creator:             msg.Creator,
+				IdType:              msg.IdType,
+				IdValue:             msg.IdValue,
+				KeyShare:            msg.KeyShare,
+				KeyShareIndex:       msg.KeyShareIndex,
+				ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+				Success:             false,
+				ErrorMessage:        "Conflicting General KeyShare",
+			}, nil
+		}
+	}
+
+	if err := k.verifyAssignedKeyShareIndex(ctx, validatorInfo.Validator, msg.KeyShareIndex); err != nil {
+		return nil, err
+	}
+
 	// Parse the keyshare & commitment then verify it
-	_, _, err := parseKeyShareCommitment(suite, msg.KeyShare, commitments.Commitments[msg.KeyShareIndex-1], uint32(msg.KeyShareIndex), msg.IdValue)
+	_, _, err := commitments.parseKeyShare(msg.KeyShare, msg.KeyShareIndex, msg.IdValue)
 	if err != nil {
 		k.Logger().Error(fmt.Sprintf("Error in parsing & verifying general keyshare & commitment: %s", err.Error()))
-		k.Logger().Error(fmt.Sprintf("General KeyShare is: %v | Commitment is: %v | Index: %d", msg.KeyShare, commitments.Commitments, msg.KeyShareIndex))
+		k.Logger().Error(fmt.Sprintf("General KeyShare is: %v | Commitment is: %v | Index: %d", msg.KeyShare, commitments.commitments.Commitments, msg.KeyShareIndex))
 		// Invalid Share, slash validator
 		var consAddr sdk.ConsAddress
 
@@ -130,6 +147,12 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		}, nil
 	}
 
+	// Get the active public key the keyshare is verified for
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return nil, types.ErrPubKeyNotFound
+	}
+
 	generalKeyShare := types.GeneralKeyShare{
 		Validator:           msg.Creator,
 		IdType:              msg.IdType,
@@ -138,36 +161,19 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		KeyShareIndex:       msg.KeyShareIndex,
 		ReceivedTimestamp:   uint64(ctx.BlockTime().Unix()),
 		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+		Power:               k.GetValidatorPower(ctx, validatorInfo.Validator),
+		PubKey:              activePubKey.PublicKey,
 	}
 
 	// Save the new general key share to state
 	k.SetGeneralKeyShare(ctx, generalKeyShare)
-	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatInt(ctx.BlockHeight(), 10))
-
-	validatorList := k.GetAllValidatorSet(ctx)
-
-	// Get all the general key shares for the provided id value & id type
-	var stateGeneralKeyShares []types.GeneralKeyShare
-
-	for _, eachValidator := range validatorList {
-		eachGeneralKeyShare, found := k.GetGeneralKeyShare(ctx, eachValidator.Validator, msg.IdType, msg.IdValue)
-		if !found {
-			continue
-		}
-		stateGeneralKeyShares = append(stateGeneralKeyShares, eachGeneralKeyShare)
-	}
-
-	// Get the active public key for aggregating
-	activePubKey, found := k.GetActivePubKey(ctx)
-
-	if !found {
-		return nil, types.ErrPubKeyNotFound
+	if !alreadySubmitted {
+		k.IncreaseKeyshareRewardCount(ctx, validatorInfo.Validator)
 	}
+	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatInt(ctx.BlockHeight(), 10))
 
-	expectedThreshold := math.LegacyNewDecFromInt(
-		math.NewInt(types.KeyAggregationThresholdNumerator)).Quo(
-		math.LegacyNewDecFromInt(math.NewInt(types.KeyAggregationThresholdDenominator))).MulInt64(
-		int64(activePubKey.NumberOfValidators)).Ceil().TruncateInt64()
+	// the keyshares of the identity are aggregated in EndBlock
+	k.SetPendingAggregation(ctx, types.PendingAggregation{IdType: msg.IdType, IdValue: msg.IdValue})
 
 	// Emit KeyShare Submitted Event
 	ctx.EventManager().EmitEvent(
@@ -181,154 +187,6 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		),
 	)
 
-	// If there is not enough keyshares to aggregate OR there is already an aggregated key
-	// Only continue the code if there is enough keyshare to aggregate & no aggregated key for current height
-	if int64(len(stateGeneralKeyShares)) < expectedThreshold {
-		return &types.MsgCreateGeneralKeyShareResponse{
-			Creator:             msg.Creator,
-			IdType:              msg.IdType,
-			IdValue:             msg.IdValue,
-			KeyShare:            msg.KeyShare,
-			KeyShareIndex:       msg.KeyShareIndex,
-			ReceivedBlockHeight: uint64(ctx.BlockHeight()),
-			Success:             true,
-		}, nil
-	}
-
-	// Check if target general keyshare already aggregated a key
-	switch msg.IdType {
-	case PrivateGovIdentity:
-		keyShareReq, found := k.GetKeyShareRequest(ctx, msg.IdValue)
-		if !found {
-			return nil, types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", msg.IdValue)
-		}
-
-		if keyShareReq.AggrKeyshare != "" {
-			return &types.MsgCreateGeneralKeyShareResponse{
-				Creator:             msg.Creator,
-				IdType:              msg.IdType,
-				IdValue:             msg.IdValue,
-				KeyShare:            msg.KeyShare,
-				KeyShareIndex:       msg.KeyShareIndex,
-				ReceivedBlockHeight: uint64(ctx.BlockHeight()),
-				Success:             true,
-			}, nil
-		}
-	}
-
-	// Parse & append all the keyshare for aggregation
-	var listOfShares []distIBE.ExtractedKey
-	var listOfCommitment []distIBE.Commitment
-
-	for _, eachKeyShare := range stateGeneralKeyShares {
-		if eachKeyShare.KeyShareIndex > commitmentsLen {
-			k.Logger().Error(fmt.Sprintf("KeyShareIndex: %d should not higher or equals to commitments length: %d", eachKeyShare.KeyShareIndex, commitmentsLen))
-			continue
-		}
-		keyShare, commitment, err := parseKeyShareCommitment(suite, eachKeyShare.KeyShare, commitments.Commitments[eachKeyShare.KeyShareIndex-1], uint32(eachKeyShare.KeyShareIndex), msg.IdValue)
-		if err != nil {
-			k.Logger().Error(err.Error())
-			continue
-		}
-
-		listOfShares = append(
-			listOfShares,
-			*keyShare,
-		)
-		listOfCommitment = append(
-			listOfCommitment,
-			*commitment,
-		)
-	}
-
-	// Aggregate key
-	SK, _ := distIBE.AggregateSK(suite, listOfShares, listOfCommitment, []byte(msg.IdValue))
-	skByte, err := SK.MarshalBinary()
-	if err != nil {
-		return nil, err
-	}
-	skHex := hex.EncodeToString(skByte)
-
-	k.Logger().Info(fmt.Sprintf("Aggregated General Decryption Key for ID Type %s, ID: %s | %s", msg.IdType, msg.IdValue, skHex))
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.GeneralKeyShareAggregatedEventType,
-			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventIDType, msg.IdType),
-			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventIDValue, msg.IdValue),
-			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventData, skHex),
-			sdk.NewAttribute(types.GeneralKeyShareAggregatedEventPubKey, activePubKey.PublicKey),
-		),
-	)
-
-	switch msg.IdType {
-	case PrivateGovIdentity:
-		val := types.MsgCreateGeneralKeyShareResponse{
-			Creator:             msg.Creator,
-			IdType:              msg.IdType,
-			IdValue:             msg.IdValue,
-			KeyShare:            msg.KeyShare,
-			KeyShareIndex:       msg.KeyShareIndex,
-			ReceivedBlockHeight: uint64(ctx.BlockHeight()),
-		}
-		keyShareReq, found := k.GetKeyShareRequest(ctx, msg.IdValue)
-		if !found {
-			return nil, types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", msg.IdValue)
-		}
-		if keyShareReq.AggrKeyshare != "" {
-			return nil, types.ErrAggKeyAlreadyExists.Wrapf(", identity: %s, Aggregated key: %s", msg.IdValue, keyShareReq.AggrKeyshare)
-		}
-		keyShareReq.AggrKeyshare = skHex
-		k.SetKeyShareRequest(ctx, keyShareReq)
-		timeoutTimestamp := ctx.BlockTime().Add(time.Second * 20).UnixNano()
-
-		if keyShareReq.IbcInfo != nil {
-			if keyShareReq.IbcInfo.ChannelID != "" {
-				_, err := k.TransmitAggrKeyshareDataPacket(
-					ctx,
-					types.AggrKeyshareDataPacketData{
-						Identity:     keyShareReq.Identity,
-						Pubkey:       keyShareReq.Pubkey,
-						AggrKeyshare: keyShareReq.AggrKeyshare,
-						AggrHeight:   strconv.FormatInt(ctx.BlockHeight(), 10),
-						ProposalId:   keyShareReq.ProposalId,
-						RequestId:    keyShareReq.RequestId,
-						Retries:      0,
-					},
-					keyShareReq.IbcInfo.PortID,
-					keyShareReq.IbcInfo.ChannelID,
-					clienttypes.ZeroHeight(),
-					uint64(timeoutTimestamp),
-				)
-
-				if err != nil {
-					return nil, err
-				}
-			}
-		} else {
-			if keyShareReq.ProposalId != "" {
-				id, err := strconv.ParseUint(keyShareReq.ProposalId, 10, 64)
-				if err != nil {
-					val.Success = false
-					return &val, err
-				}
-
-				proposal, found := k.govKeeper.GetProposal(ctx, id)
-				if !found {
-					val.Success = false
-					return &val, errors.New("proposal not found")
-				}
-
-				proposal.AggrKeyshare = keyShareReq.AggrKeyshare
-				k.govKeeper.SetProposal(ctx, proposal)
-			} else {
-				val, _ := k.pepKeeper.GetEntry(ctx, keyShareReq.RequestId)
-				val.AggrKeyshare = keyShareReq.AggrKeyshare
-				k.pepKeeper.SetExecutionQueueEntry(ctx, val)
-				k.pepKeeper.SetEntry(ctx, val)
-			}
-		}
-	}
-
 	return &types.MsgCreateGeneralKeyShareResponse{
 		Creator:             msg.Creator,
 		IdType:              msg.IdType,
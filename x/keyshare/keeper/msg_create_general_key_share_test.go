@@ -36,6 +36,7 @@ func SetupTestGeneralKeyShare(t *testing.T, ctx sdk.Context, k keeper.Keeper, nu
 		Creator:            creator,
 		Expiry:             123456,
 		NumberOfValidators: pubkeyNumberOfValidator,
+		Threshold:          out.Threshold,
 		EncryptedKeyShares: out.KeyShareEncryptedKeyShares,
 	})
 
@@ -98,6 +99,7 @@ func TestGeneralKeyShareMsgServerCreateAggregated(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, expected.Creator, rst.Validator)
 
+		k.AggregatePendingKeyshares(wctx)
 		entry, found := k.GetKeyShareRequest(ctx, idVal)
 		require.True(t, found)
 		require.NotEmpty(t, entry.AggrKeyshare)
@@ -156,6 +158,7 @@ func TestGeneralKeyShareMsgServerCreateNotAggregated(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, expected.Creator, rst.Validator)
 
+		k.AggregatePendingKeyshares(wctx)
 		entry, found := k.GetKeyShareRequest(ctx, idVal)
 		require.True(t, found)
 		require.Empty(t, entry.AggrKeyshare)
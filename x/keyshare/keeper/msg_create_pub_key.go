@@ -27,6 +27,10 @@ func (k msgServer) CreateLatestPubKey(goCtx context.Context, msg *types.MsgCreat
 		return nil, types.ErrQueuedKeyAlreadyExists.Wrap(msg.Creator)
 	}
 
+	if err := k.ValidatePubKeyThreshold(ctx, msg.PublicKey, msg.Commitments, msg.Threshold); err != nil {
+		return nil, err
+	}
+
 	commitments := types.Commitments{
 		Commitments: msg.Commitments,
 	}
@@ -42,6 +46,7 @@ func (k msgServer) CreateLatestPubKey(goCtx context.Context, msg *types.MsgCreat
 		PublicKey:          msg.PublicKey,
 		Expiry:             expHeight,
 		NumberOfValidators: msg.NumberOfValidators,
+		Threshold:          msg.Threshold,
 		EncryptedKeyShares: msg.EncryptedKeyShares,
 	}
 
@@ -76,6 +81,7 @@ func (k msgServer) CreateLatestPubKey(goCtx context.Context, msg *types.MsgCreat
 			sdk.NewAttribute(types.QueuedPubKeyCreatedEventCreator, msg.Creator),
 			sdk.NewAttribute(types.QueuedPubKeyCreatedEventPubkey, msg.PublicKey),
 			sdk.NewAttribute(types.QueuedPubKeyCreatedEventNumberOfValidators, strconv.FormatUint(msg.NumberOfValidators, 10)),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventThreshold, strconv.FormatUint(msg.Threshold, 10)),
 			sdk.NewAttribute(types.QueuedPubKeyCreatedEventEncryptedShares, string(encryptedKeyShares)),
 		),
 	)
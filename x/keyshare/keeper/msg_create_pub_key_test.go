@@ -36,6 +36,7 @@ func TestLatestPubKeyMsgServerCreate(t *testing.T) {
 		Creator:            creator,
 		Expiry:             123456,
 		NumberOfValidators: 1,
+		Threshold:          out.Threshold,
 		EncryptedKeyShares: out.KeyShareEncryptedKeyShares,
 	})
 
@@ -61,7 +62,9 @@ func TestLatestPubKeyMsgServerCreate(t *testing.T) {
 			request: &types.MsgCreateLatestPubKey{
 				PublicKey:          out.MasterPublicKey,
 				Creator:            creator,
+				Commitments:        out.Commitments,
 				NumberOfValidators: 1,
+				Threshold:          out.Threshold,
 				EncryptedKeyShares: out.KeyShareEncryptedKeyShares,
 			},
 		},
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StartDkg starts a distributed key generation round among the registered validators
+func (k msgServer) StartDkg(goCtx context.Context, msg *types.MsgStartDkg) (*types.MsgStartDkgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participants, err := k.newDkgRoundParticipants(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateThresholdRatio(ctx, msg.Threshold, uint64(len(participants))); err != nil {
+		return nil, err
+	}
+
+	round := k.startDkgRound(ctx, types.DkgRound{
+		Creator:      msg.Creator,
+		Participants: participants,
+		Threshold:    msg.Threshold,
+		Dealers:      participants,
+	})
+
+	return &types.MsgStartDkgResponse{RoundId: round.Id}, nil
+}
+
+// SubmitDkgDeal stores the commitments and the encrypted shares of a participant polynomial
+func (k msgServer) SubmitDkgDeal(goCtx context.Context, msg *types.MsgSubmitDkgDeal) (*types.MsgSubmitDkgDealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, err := k.getDkgRoundInPhase(ctx, msg.RoundId, types.DkgPhaseDeal)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerIndex := round.DealerIndex(msg.Creator)
+	if dealerIndex == 0 {
+		return nil, types.ErrNotDkgParticipant.Wrapf("%s is not a dealer of the round", msg.Creator)
+	}
+
+	if _, found := k.GetDkgDeal(ctx, round.Id, msg.Creator); found {
+		return nil, types.ErrDkgDealAlreadyExists.Wrap(msg.Creator)
+	}
+
+	if uint64(len(msg.Commitments)) != round.Threshold {
+		return nil, types.ErrInvalidDkgDeal.Wrapf("expected %d coefficient commitments, got: %d", round.Threshold, len(msg.Commitments))
+	}
+
+	if _, err := parseDkgCommitments(msg.Commitments); err != nil {
+		return nil, err
+	}
+
+	// a resharing deal shares the active keyshare of the dealer, so its constant term
+	// has to be the commitment of that keyshare
+	if round.Resharing {
+		activeCommitments, found := k.GetActiveCommitments(ctx)
+		if !found || uint64(len(activeCommitments.Commitments)) < dealerIndex {
+			return nil, types.ErrCommitmentsNotFound
+		}
+		if msg.Commitments[0] != activeCommitments.Commitments[dealerIndex-1] {
+			return nil, types.ErrInvalidDkgDeal.Wrapf("expected the constant term to commit to the active keyshare %d", dealerIndex)
+		}
+	}
+
+	if len(msg.EncryptedShares) != len(round.Participants) {
+		return nil, types.ErrInvalidDkgDeal.Wrapf("expected %d encrypted shares, got: %d", len(round.Participants), len(msg.EncryptedShares))
+	}
+
+	for i, s := range msg.EncryptedShares {
+		if s.Validator != round.Participants[i] {
+			return nil, types.ErrInvalidDkgDeal.Wrapf("expected encrypted share %d for participant: %s, got: %s", i, round.Participants[i], s.Validator)
+		}
+	}
+
+	k.SetDkgDeal(ctx, types.DkgDeal{
+		RoundId:         round.Id,
+		Dealer:          msg.Creator,
+		Commitments:     msg.Commitments,
+		EncryptedShares: msg.EncryptedShares,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgDealSubmittedEventType,
+			sdk.NewAttribute(types.DkgDealSubmittedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgDealSubmittedEventDealer, msg.Creator),
+		),
+	)
+
+	return &types.MsgSubmitDkgDealResponse{}, nil
+}
+
+// SubmitDkgComplaint records a complaint of a participant whose decrypted share does not match the deal commitments
+func (k msgServer) SubmitDkgComplaint(goCtx context.Context, msg *types.MsgSubmitDkgComplaint) (*types.MsgSubmitDkgComplaintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, err := k.getDkgRoundInPhase(ctx, msg.RoundId, types.DkgPhaseComplaint)
+	if err != nil {
+		return nil, err
+	}
+
+	if round.ParticipantIndex(msg.Creator) == 0 {
+		return nil, types.ErrNotDkgParticipant.Wrap(msg.Creator)
+	}
+
+	if _, found := k.GetDkgDeal(ctx, round.Id, msg.Dealer); !found {
+		return nil, types.ErrDkgDealNotFound.Wrap(msg.Dealer)
+	}
+
+	if _, found := k.GetDkgComplaint(ctx, round.Id, msg.Dealer, msg.Creator); found {
+		return nil, types.ErrDkgComplaintAlreadyExists.Wrap(msg.Dealer)
+	}
+
+	k.SetDkgComplaint(ctx, types.DkgComplaint{
+		RoundId:    round.Id,
+		Dealer:     msg.Dealer,
+		Complainer: msg.Creator,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgComplaintSubmittedEventType,
+			sdk.NewAttribute(types.DkgComplaintSubmittedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgComplaintSubmittedEventDealer, msg.Dealer),
+			sdk.NewAttribute(types.DkgComplaintSubmittedEventComplainer, msg.Creator),
+		),
+	)
+
+	return &types.MsgSubmitDkgComplaintResponse{}, nil
+}
+
+// SubmitDkgJustification answers a complaint by revealing the share the dealer sent to the complainer,
+// a revealed share that does not match the deal commitments disqualifies and slashes the dealer
+func (k msgServer) SubmitDkgJustification(goCtx context.Context, msg *types.MsgSubmitDkgJustification) (*types.MsgSubmitDkgJustificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, err := k.getDkgRoundInPhase(ctx, msg.RoundId, types.DkgPhaseJustification)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, found := k.GetDkgDeal(ctx, round.Id, msg.Creator)
+	if !found {
+		return nil, types.ErrDkgDealNotFound.Wrap(msg.Creator)
+	}
+
+	complaint, found := k.GetDkgComplaint(ctx, round.Id, msg.Creator, msg.Complainer)
+	if !found || complaint.Justified {
+		return nil, types.ErrDkgComplaintNotFound.Wrapf("dealer: %s, complainer: %s", msg.Creator, msg.Complainer)
+	}
+
+	if deal.Disqualified {
+		return nil, types.ErrInvalidDkgDeal.Wrapf("dealer %s is already disqualified", msg.Creator)
+	}
+
+	if err := verifyDkgShare(deal.Commitments, round.ParticipantIndex(msg.Complainer), msg.Share); err != nil {
+		k.Logger().Error(fmt.Sprintf("Invalid dkg justification from %s: %s", msg.Creator, err.Error()))
+		k.disqualifyDkgDealer(ctx, round, deal, err.Error())
+		return &types.MsgSubmitDkgJustificationResponse{}, nil
+	}
+
+	complaint.Justified = true
+	complaint.RevealedShare = msg.Share
+	k.SetDkgComplaint(ctx, complaint)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgJustificationSubmittedEventType,
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventDealer, msg.Creator),
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventComplainer, msg.Complainer),
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventShare, msg.Share),
+		),
+	)
+
+	return &types.MsgSubmitDkgJustificationResponse{}, nil
+}
+
+// newDkgRoundParticipants checks that a new round can be started by the creator
+// and returns the registered validators taking part in it
+func (k Keeper) newDkgRoundParticipants(ctx sdk.Context, creator string) ([]string, error) {
+	if !contains(k.GetParams(ctx).TrustedAddresses, creator) {
+		return nil, types.ErrAddressNotTrusted.Wrap(creator)
+	}
+
+	if latest, found := k.GetLatestDkgRound(ctx); found && latest.Status == types.DkgRoundStatusInProgress {
+		return nil, types.ErrDkgRoundInProgress.Wrapf("round id: %d", latest.Id)
+	}
+
+	if _, found := k.GetQueuedPubKey(ctx); found {
+		return nil, types.ErrQueuedKeyAlreadyExists.Wrap(creator)
+	}
+
+	// the validator set is iterated in address order, so every node derives the same keyshare indexes
+	validatorSet := k.GetAllValidatorSet(ctx)
+	participants := make([]string, 0, len(validatorSet))
+	for _, v := range validatorSet {
+		participants = append(participants, v.Validator)
+	}
+
+	if len(participants) == 0 {
+		return nil, types.ErrNotEnoughDkgParticipants
+	}
+
+	return participants, nil
+}
+
+// startDkgRound stores the round with the next id and the phase deadlines counted from the current block
+func (k Keeper) startDkgRound(ctx sdk.Context, round types.DkgRound) types.DkgRound {
+	params := k.GetParams(ctx)
+	startHeight := uint64(ctx.BlockHeight())
+
+	round.Id = k.GetDkgRoundCount(ctx) + 1
+	round.StartHeight = startHeight
+	round.DealEndHeight = startHeight + params.DkgDealPhaseBlocks
+	round.ComplaintEndHeight = round.DealEndHeight + params.DkgComplaintPhaseBlocks
+	round.JustificationEndHeight = round.ComplaintEndHeight + params.DkgJustificationPhaseBlocks
+	round.Status = types.DkgRoundStatusInProgress
+
+	k.SetDkgRound(ctx, round)
+	k.SetDkgRoundCount(ctx, round.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgRoundStartedEventType,
+			sdk.NewAttribute(types.DkgRoundStartedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgRoundStartedEventResharing, strconv.FormatBool(round.Resharing)),
+			sdk.NewAttribute(types.DkgRoundStartedEventThreshold, strconv.FormatUint(round.Threshold, 10)),
+			sdk.NewAttribute(types.DkgRoundStartedEventParticipants, strings.Join(round.Participants, ",")),
+			sdk.NewAttribute(types.DkgRoundStartedEventDealers, strings.Join(round.Dealers, ",")),
+			sdk.NewAttribute(types.DkgRoundStartedEventDealEnd, strconv.FormatUint(round.DealEndHeight, 10)),
+			sdk.NewAttribute(types.DkgRoundStartedEventComplaintEnd, strconv.FormatUint(round.ComplaintEndHeight, 10)),
+			sdk.NewAttribute(types.DkgRoundStartedEventJustificationEnd, strconv.FormatUint(round.JustificationEndHeight, 10)),
+		),
+	)
+
+	return round
+}
+
+// getDkgRoundInPhase returns the round if it is in the given phase at the current block height
+func (k Keeper) getDkgRoundInPhase(ctx sdk.Context, roundID uint64, phase string) (types.DkgRound, error) {
+	round, found := k.GetDkgRound(ctx, roundID)
+	if !found {
+		return round, types.ErrDkgRoundNotFound.Wrapf("round id: %d", roundID)
+	}
+
+	if current := round.Phase(uint64(ctx.BlockHeight())); current != phase {
+		return round, types.ErrInvalidDkgPhase.Wrapf("expected phase: %s, round %d is in phase: %s", phase, roundID, current)
+	}
+
+	return round, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+	"github.com/drand/kyber/util/random"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestDkgRound(t *testing.T) {
+	k, ctx, pk, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	suite := bls.NewBLS12381Suite()
+
+	creator := sample.AccAddress()
+	params := types.DefaultParams()
+	params.TrustedAddresses = []string{creator}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for i := 0; i < 3; i++ {
+		address := sample.AccAddress()
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: address, Validator: address, IsActive: true})
+	}
+
+	_, err := srv.StartDkg(ctx, &types.MsgStartDkg{Creator: sample.AccAddress(), Threshold: 2})
+	require.ErrorIs(t, err, types.ErrAddressNotTrusted)
+
+	_, err = srv.StartDkg(ctx, &types.MsgStartDkg{Creator: creator, Threshold: 1})
+	require.ErrorIs(t, err, types.ErrThresholdBelowMinimum)
+
+	ctx = ctx.WithBlockHeight(1)
+	res, err := srv.StartDkg(ctx, &types.MsgStartDkg{Creator: creator, Threshold: 2})
+	require.NoError(t, err)
+
+	_, err = srv.StartDkg(ctx, &types.MsgStartDkg{Creator: creator, Threshold: 2})
+	require.ErrorIs(t, err, types.ErrDkgRoundInProgress)
+
+	round, found := k.GetDkgRound(ctx, res.RoundId)
+	require.True(t, found)
+	require.Len(t, round.Participants, 3)
+
+	// every participant deals a polynomial of degree threshold - 1
+	priPolys := make([]*share.PriPoly, len(round.Participants))
+	for i, dealer := range round.Participants {
+		priPolys[i] = share.NewPriPoly(suite.G1(), int(round.Threshold), nil, random.New())
+		_, commits := priPolys[i].Commit(suite.G1().Point().Base()).Info()
+
+		encryptedShares := make([]*types.EncryptedKeyShare, len(round.Participants))
+		for j, p := range round.Participants {
+			encryptedShares[j] = &types.EncryptedKeyShare{
+				Validator: p,
+				Data:      base64.StdEncoding.EncodeToString([]byte("encrypted share")),
+			}
+		}
+
+		_, err = srv.SubmitDkgDeal(ctx, &types.MsgSubmitDkgDeal{
+			Creator:         dealer,
+			RoundId:         round.Id,
+			Commitments:     encodePoints(t, commits),
+			EncryptedShares: encryptedShares,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = srv.SubmitDkgDeal(ctx, &types.MsgSubmitDkgDeal{Creator: round.Participants[0], RoundId: round.Id})
+	require.ErrorIs(t, err, types.ErrDkgDealAlreadyExists)
+
+	_, err = srv.SubmitDkgComplaint(ctx, &types.MsgSubmitDkgComplaint{
+		Creator: round.Participants[1],
+		RoundId: round.Id,
+		Dealer:  round.Participants[0],
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDkgPhase)
+
+	// complaint phase
+	ctx = ctx.WithBlockHeight(int64(round.DealEndHeight + 1))
+	for _, c := range []struct{ complainer, dealer string }{
+		{round.Participants[1], round.Participants[0]},
+		{round.Participants[0], round.Participants[2]},
+	} {
+		_, err = srv.SubmitDkgComplaint(ctx, &types.MsgSubmitDkgComplaint{
+			Creator: c.complainer,
+			RoundId: round.Id,
+			Dealer:  c.dealer,
+		})
+		require.NoError(t, err)
+	}
+
+	// justification phase, the first dealer answers with the share of the complainer
+	ctx = ctx.WithBlockHeight(int64(round.ComplaintEndHeight + 1))
+	revealed, err := priPolys[0].Eval(1).V.MarshalBinary()
+	require.NoError(t, err)
+	_, err = srv.SubmitDkgJustification(ctx, &types.MsgSubmitDkgJustification{
+		Creator:    round.Participants[0],
+		RoundId:    round.Id,
+		Complainer: round.Participants[1],
+		Share:      hex.EncodeToString(revealed),
+	})
+	require.NoError(t, err)
+
+	complaint, found := k.GetDkgComplaint(ctx, round.Id, round.Participants[0], round.Participants[1])
+	require.True(t, found)
+	require.True(t, complaint.Justified)
+
+	// the third dealer does not answer, it left the validator set so it is not slashed in this test
+	k.RemoveValidatorSet(ctx, round.Participants[2])
+
+	ctx = ctx.WithBlockHeight(int64(round.JustificationEndHeight))
+	k.ProcessDkgRound(ctx)
+	_, found = k.GetQueuedPubKey(ctx)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(int64(round.JustificationEndHeight + 1))
+	k.ProcessDkgRound(ctx)
+
+	round, _ = k.GetDkgRound(ctx, round.Id)
+	require.Equal(t, types.DkgRoundStatusFinalized, round.Status)
+	require.Equal(t, round.Participants[:2], round.Qualified)
+
+	deal, _ := k.GetDkgDeal(ctx, round.Id, round.Participants[2])
+	require.True(t, deal.Disqualified)
+
+	// the generated key is the sum of the qualified polynomials
+	qualPoly, err := priPolys[0].Add(priPolys[1])
+	require.NoError(t, err)
+	expectedPubKey, err := suite.G1().Point().Mul(qualPoly.Secret(), nil).MarshalBinary()
+	require.NoError(t, err)
+
+	queuedPubKey, found := k.GetQueuedPubKey(ctx)
+	require.True(t, found)
+	require.Equal(t, hex.EncodeToString(expectedPubKey), queuedPubKey.PublicKey)
+	require.Equal(t, round.Threshold, queuedPubKey.Threshold)
+	require.Equal(t, uint64(3), queuedPubKey.NumberOfValidators)
+
+	pepQueuedPubKey, found := pk.GetQueuedPubKey(ctx)
+	require.True(t, found)
+	require.Equal(t, queuedPubKey.PublicKey, pepQueuedPubKey.PublicKey)
+
+	commitments, found := k.GetQueuedCommitments(ctx)
+	require.True(t, found)
+	require.NoError(t, k.ValidatePubKeyThreshold(ctx, queuedPubKey.PublicKey, commitments.Commitments, queuedPubKey.Threshold))
+	for i, c := range commitments.Commitments {
+		expected, err := suite.G1().Point().Mul(qualPoly.Eval(i).V, nil).MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(expected), c)
+	}
+
+	queryRes, err := k.DkgRound(ctx, &types.QueryDkgRoundRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DkgRoundStatusFinalized, queryRes.Phase)
+	require.Len(t, queryRes.Deals, 3)
+	require.Len(t, queryRes.Complaints, 2)
+}
+
+func encodePoints(t *testing.T, points []kyber.Point) []string {
+	encoded := make([]string, len(points))
+	for i, p := range points {
+		b, err := p.MarshalBinary()
+		require.NoError(t, err)
+		encoded[i] = hex.EncodeToString(b)
+	}
+	return encoded
+}
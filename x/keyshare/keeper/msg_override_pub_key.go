@@ -22,6 +22,10 @@ func (k msgServer) OverrideLatestPubKey(goCtx context.Context, msg *types.MsgOve
 		return nil, types.ErrAddressNotTrusted.Wrap(msg.Creator)
 	}
 
+	if err := k.ValidatePubKeyThreshold(ctx, msg.PublicKey, msg.Commitments, msg.Threshold); err != nil {
+		return nil, err
+	}
+
 	commitments := types.Commitments{
 		Commitments: msg.Commitments,
 	}
@@ -66,6 +70,7 @@ func (k msgServer) OverrideLatestPubKey(goCtx context.Context, msg *types.MsgOve
 			PublicKey:          msg.PublicKey,
 			Expiry:             expHeight,
 			NumberOfValidators: msg.NumberOfValidators,
+			Threshold:          msg.Threshold,
 			EncryptedKeyShares: msg.EncryptedKeyShares,
 		},
 	)
@@ -86,6 +91,7 @@ func (k msgServer) OverrideLatestPubKey(goCtx context.Context, msg *types.MsgOve
 			sdk.NewAttribute(types.PubKeyOverrodeEventCreator, msg.Creator),
 			sdk.NewAttribute(types.PubKeyOverrodeEventPubkey, msg.PublicKey),
 			sdk.NewAttribute(types.PubKeyOverrodeEventNumberOfValidators, strconv.FormatUint(msg.NumberOfValidators, 10)),
+			sdk.NewAttribute(types.PubKeyOverrodeEventThreshold, strconv.FormatUint(msg.Threshold, 10)),
 			sdk.NewAttribute(types.PubKeyOverrodeEventEncryptedShares, string(encryptedKeyShares)),
 		),
 	)
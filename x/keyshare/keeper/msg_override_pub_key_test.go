@@ -48,6 +48,7 @@ func TestOverrideLatestPubKeyMsgServer(t *testing.T) {
 				Creator:            creator,
 				Commitments:        out.Commitments,
 				NumberOfValidators: 1,
+				Threshold:          out.Threshold,
 				EncryptedKeyShares: out.KeyShareEncryptedKeyShares,
 			},
 		},
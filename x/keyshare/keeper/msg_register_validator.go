@@ -20,6 +20,10 @@ func (k msgServer) RegisterValidator(goCtx context.Context, msg *types.MsgRegist
 		return nil, types.ErrValidatorAlreadyRegistered.Wrap(msg.Creator)
 	}
 
+	if info, found := k.GetKeyshareSigningInfo(ctx, msg.Creator); found && info.Jailed {
+		return nil, types.ErrValidatorJailed.Wrapf("jailed until height: %d, unjail to register again", info.JailedUntil)
+	}
+
 	accAddr, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return nil, err
@@ -52,6 +56,7 @@ func (k msgServer) RegisterValidator(goCtx context.Context, msg *types.MsgRegist
 
 	// This is to prevent the validator be slashed immediately after registering
 	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatInt(ctx.BlockHeight(), 10))
+	k.ResetKeyshareSigningInfo(ctx, validator)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.RegisteredValidatorEventType,
@@ -6,12 +6,9 @@ import (
 	"fmt"
 	"strconv"
 
-	"cosmossdk.io/math"
 	distIBE "github.com/FairBlock/DistributedIBE"
 	"github.com/Fairblock/fairyring/x/keyshare/types"
-	peptypes "github.com/Fairblock/fairyring/x/pep/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
-	"github.com/hashicorp/go-metrics"
 
 	"github.com/drand/kyber"
 	bls "github.com/drand/kyber-bls12381"
@@ -25,26 +22,83 @@ import (
 func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshare) (*types.MsgSendKeyshareResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	validatorInfo, err := k.keyshareSubmitter(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.sendKeyshare(ctx, msg, validatorInfo, k.parsedActiveCommitments(ctx))
+}
+
+// GetKeyshareSubmitter returns the registered validator the address submits keyshares for,
+// the address is either the validator itself or the address it authorized
+func (k Keeper) GetKeyshareSubmitter(ctx sdk.Context, creator string) (types.ValidatorSet, error) {
+	return k.keyshareSubmitter(ctx, creator)
+}
+
+// keyshareSubmitter returns the validator a keyshare is submitted for, the sender is either
+// the validator itself or the address it authorized to submit its keyshares
+func (k Keeper) keyshareSubmitter(ctx sdk.Context, creator string) (types.ValidatorSet, error) {
 	// check if validator is registered
-	validatorInfo, found := k.GetValidatorSet(ctx, msg.Creator)
+	validatorInfo, found := k.GetValidatorSet(ctx, creator)
 
 	if !found {
-		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, msg.Creator)
+		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, creator)
 		if !found || !authorizedAddrInfo.IsAuthorized {
-			return nil, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(msg.Creator)
+			return validatorInfo, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(creator)
 		}
 
 		authorizedByValInfo, found := k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
 		if !found {
-			return nil, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
+			return validatorInfo, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
 		}
 		validatorInfo = authorizedByValInfo
 
 		// If the sender is in the validator set & authorized another address to submit key share
-	} else if count := k.GetAuthorizedCount(ctx, msg.Creator); count != 0 {
-		return nil, types.ErrAuthorizedAnotherAddress
+	} else if count := k.GetAuthorizedCount(ctx, creator); count != 0 {
+		return validatorInfo, types.ErrAuthorizedAnotherAddress
+	}
+
+	return validatorInfo, nil
+}
+
+// assignedKeyShareIndex returns the index of the keyshare the validator holds for the active public key,
+// the holder of the keyshare with index i + 1 is the validator of encrypted key share i
+func assignedKeyShareIndex(activePubKey types.ActivePubKey, validator string) (uint64, bool) {
+	for i, encShare := range activePubKey.EncryptedKeyShares {
+		if encShare.Validator == validator {
+			return uint64(i + 1), true
+		}
+	}
+	return 0, false
+}
+
+// verifyAssignedKeyShareIndex returns an error when the keyshare index is not the one held by the validator,
+// a keyshare of another index verifies against the commitments but was not derived by the validator
+func (k Keeper) verifyAssignedKeyShareIndex(ctx sdk.Context, validator string, index uint64) error {
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return types.ErrPubKeyNotFound
+	}
+
+	assigned, found := assignedKeyShareIndex(activePubKey, validator)
+	if !found {
+		return types.ErrInvalidKeyShareIndex.Wrapf("validator %s does not hold a keyshare of the active public key", validator)
+	}
+	if assigned != index {
+		return types.ErrInvalidKeyShareIndex.Wrapf("validator %s holds keyshare index %d, got: %d", validator, assigned, index)
 	}
+	return nil
+}
 
+// sendKeyshare verifies and stores the keyshare of the validator for a height,
+// the keyshares of the height are aggregated in EndBlock
+func (k Keeper) sendKeyshare(
+	ctx sdk.Context,
+	msg *types.MsgSendKeyshare,
+	validatorInfo types.ValidatorSet,
+	commitments *parsedCommitments,
+) (*types.MsgSendKeyshareResponse, error) {
 	if uint64(ctx.BlockHeight()) > msg.BlockHeight {
 		return nil, types.ErrInvalidBlockHeight.Wrapf("key share height is lower than the current block height, expected height: %d, got: %d", ctx.BlockHeight(), msg.BlockHeight)
 	}
@@ -54,25 +108,54 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 	}
 
 	// Setup
-	suite := bls.NewBLS12381Suite()
 	ibeID := strconv.FormatUint(msg.BlockHeight, 10)
 
-	commitments, found := k.GetActiveCommitments(ctx)
-	if !found {
+	if commitments == nil {
 		return nil, types.ErrCommitmentsNotFound
 	}
 
-	commitmentsLen := uint64(len(commitments.Commitments))
+	commitmentsLen := commitments.Len()
 	if msg.KeyShareIndex > commitmentsLen {
 		return nil, types.ErrInvalidKeyShareIndex.Wrap(fmt.Sprintf("Expect Index within: %d, got: %d", commitmentsLen, msg.KeyShareIndex))
 	}
 
+	// A keyshare different from the one already submitted for the height is handled as equivocation
+	stored, alreadySubmitted := k.GetKeyShare(ctx, msg.Creator, msg.BlockHeight)
+	if alreadySubmitted {
+		evidence, conflicting := conflictingKeyshareEvidence(
+			validatorInfo.Validator,
+			ibeID,
+			types.SignedKeyshare{Keyshare: stored.KeyShare, KeyShareIndex: stored.KeyShareIndex, Submitter: msg.Creator},
+			types.SignedKeyshare{Keyshare: msg.Message, KeyShareIndex: msg.KeyShareIndex, Submitter: msg.Creator},
+			commitments.commitments,
+		)
+		if conflicting {
+			if err := k.HandleKeyshareEvidence(ctx, evidence); err != nil {
+				return nil, err
+			}
+
+			return &types.MsgSendKeyshareResponse{
+				Creator:             msg.Creator,
+				Keyshare:            msg.Message,
+				KeyshareIndex:       msg.KeyShareIndex,
+				ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+				BlockHeight:         msg.BlockHeight,
+				Success:             false,
+				ErrorMessage:        "Conflicting KeyShare",
+			}, nil
+		}
+	}
+
+	if err := k.verifyAssignedKeyShareIndex(ctx, validatorInfo.Validator, msg.KeyShareIndex); err != nil {
+		return nil, err
+	}
+
 	// Parse the keyshare & commitment then verify it
-	_, _, err := parseKeyShareCommitment(suite, msg.Message, commitments.Commitments[msg.KeyShareIndex-1], uint32(msg.KeyShareIndex), ibeID)
+	_, _, err := commitments.parseKeyShare(msg.Message, msg.KeyShareIndex, ibeID)
 	if err != nil {
 		defer telemetry.IncrCounter(1, types.KeyTotalInvalidKeyShareSubmitted)
 		k.Logger().Error(fmt.Sprintf("Error in parsing & verifying keyshare & commitment: %s", err.Error()))
-		k.Logger().Error(fmt.Sprintf("KeyShare is: %v | Commitment is: %v | Index: %d", msg.Message, commitments.Commitments, msg.KeyShareIndex))
+		k.Logger().Error(fmt.Sprintf("KeyShare is: %v | Commitment is: %v | Index: %d", msg.Message, commitments.commitments.Commitments, msg.KeyShareIndex))
 		// Invalid Share, slash validator
 		var consAddr sdk.ConsAddress
 
@@ -104,6 +187,12 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 		}, nil
 	}
 
+	// Get the active public key the keyshare is verified for
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return nil, types.ErrPubKeyNotFound
+	}
+
 	keyShare := types.KeyShare{
 		Validator:           msg.Creator,
 		BlockHeight:         msg.BlockHeight,
@@ -111,37 +200,22 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 		KeyShareIndex:       msg.KeyShareIndex,
 		ReceivedTimestamp:   uint64(ctx.BlockTime().Unix()),
 		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+		Power:               k.GetValidatorPower(ctx, validatorInfo.Validator),
+		PubKey:              activePubKey.PublicKey,
 	}
 
 	// Save the new keyshare to state
 	k.SetKeyShare(ctx, keyShare)
+	if !alreadySubmitted {
+		k.IncreaseKeyshareRewardCount(ctx, validatorInfo.Validator)
+	}
 
 	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatUint(msg.BlockHeight, 10))
 
-	validatorList := k.GetAllValidatorSet(ctx)
-
-	// Get all the keyshares for the provided block height in state
-	var stateKeyShares []types.KeyShare
-
-	for _, eachValidator := range validatorList {
-		eachKeyShare, found := k.GetKeyShare(ctx, eachValidator.Validator, msg.BlockHeight)
-		if !found {
-			continue
-		}
-		stateKeyShares = append(stateKeyShares, eachKeyShare)
-	}
-
-	// Get the active public key for aggregating
-	activePubKey, found := k.GetActivePubKey(ctx)
+	// the keyshares of the height are aggregated in EndBlock
+	k.SetPendingAggregation(ctx, types.PendingAggregation{Height: msg.BlockHeight})
 
-	if !found {
-		return nil, types.ErrPubKeyNotFound
-	}
-
-	expectedThreshold := math.LegacyNewDecFromInt(
-		math.NewInt(types.KeyAggregationThresholdNumerator)).Quo(
-		math.LegacyNewDecFromInt(math.NewInt(types.KeyAggregationThresholdDenominator))).MulInt64(
-		int64(activePubKey.NumberOfValidators)).Ceil().TruncateInt64()
+	defer telemetry.IncrCounter(1, types.KeyTotalValidKeyShareSubmitted)
 
 	// Emit KeyShare Submitted Event
 	ctx.EventManager().EmitEvent(
@@ -154,109 +228,99 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 		),
 	)
 
-	// Check if there is an aggregated key exists
-	aggrKeyData, found := k.GetAggregatedKeyShare(ctx, msg.BlockHeight)
-
-	// If there is not enough keyshares to aggregate OR there is already an aggregated key
-	// Only continue the code if there is enough keyshare to aggregate & no aggregated key for current height
-	if int64(len(stateKeyShares)) < expectedThreshold || found {
-		defer telemetry.IncrCounterWithLabels([]string{types.KeyTotalValidKeyShareSubmitted}, 1, []metrics.Label{telemetry.NewLabel("aggrkey", aggrKeyData.Data)})
-		return &types.MsgSendKeyshareResponse{
-			Creator:             msg.Creator,
-			Keyshare:            msg.Message,
-			KeyshareIndex:       msg.KeyShareIndex,
-			ReceivedBlockHeight: uint64(ctx.BlockHeight()),
-			BlockHeight:         msg.BlockHeight,
-			Success:             true,
-		}, nil
-	}
-
-	// Parse & append all the keyshare for aggregation
-	var listOfShares []distIBE.ExtractedKey
-	var listOfCommitment []distIBE.Commitment
+	return &types.MsgSendKeyshareResponse{
+		Creator:             msg.Creator,
+		Keyshare:            msg.Message,
+		KeyshareIndex:       msg.KeyShareIndex,
+		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+		BlockHeight:         msg.BlockHeight,
+		Success:             true,
+	}, nil
+}
 
-	for _, eachKeyShare := range stateKeyShares {
-		if eachKeyShare.KeyShareIndex > commitmentsLen {
-			k.Logger().Error(fmt.Sprintf("KeyShareIndex: %d should not higher or equals to commitments length: %d", eachKeyShare.KeyShareIndex, commitmentsLen))
-			continue
-		}
-		keyShare, commitment, err := parseKeyShareCommitment(suite, eachKeyShare.KeyShare, commitments.Commitments[eachKeyShare.KeyShareIndex-1], uint32(eachKeyShare.KeyShareIndex), ibeID)
-		if err != nil {
-			k.Logger().Error(err.Error())
-			continue
-		}
+// parsedCommitments holds the active keyshare commitments and parses each of them only once,
+// so the keyshares of a batch and the keyshares aggregated for an identity share the parsed points
+type parsedCommitments struct {
+	suite       pairing.Suite
+	commitments types.Commitments
+	points      map[uint64]kyber.Point
+}
 
-		listOfShares = append(
-			listOfShares,
-			*keyShare,
-		)
-		listOfCommitment = append(
-			listOfCommitment,
-			*commitment,
-		)
+// parsedActiveCommitments returns the active keyshare commitments, nil if there is no active key
+func (k Keeper) parsedActiveCommitments(ctx sdk.Context) *parsedCommitments {
+	commitments, found := k.GetActiveCommitments(ctx)
+	if !found {
+		return nil
 	}
 
-	// Aggregate key
-	SK, _ := distIBE.AggregateSK(suite, listOfShares, listOfCommitment, []byte(ibeID))
-	skByte, err := SK.MarshalBinary()
-	if err != nil {
-		return nil, err
+	return &parsedCommitments{
+		suite:       bls.NewBLS12381Suite(),
+		commitments: commitments,
+		points:      make(map[uint64]kyber.Point),
 	}
-	skHex := hex.EncodeToString(skByte)
+}
 
-	k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{
-		Height: msg.BlockHeight,
-		Data:   skHex,
-	})
+// Len returns the number of keyshare commitments
+func (c *parsedCommitments) Len() uint64 {
+	return uint64(len(c.commitments.Commitments))
+}
 
-	k.SetAggregatedKeyShareLength(ctx, k.GetAggregatedKeyShareLength(ctx)+1)
+// parseKeyShare parses a keyshare and verifies it against the commitment of its keyshare index
+func (c *parsedCommitments) parseKeyShare(keyShareHex string, index uint64, id string) (*distIBE.ExtractedKey, *distIBE.Commitment, error) {
+	if index < 1 || index > c.Len() {
+		return nil, nil, types.ErrInvalidKeyShareIndex.Wrapf("Expect Index within: %d, got: %d", c.Len(), index)
+	}
 
-	k.Logger().Info(fmt.Sprintf("Aggregated Decryption Key for Block %d: %s", msg.BlockHeight, skHex))
+	commitmentPoint, ok := c.points[index]
+	if !ok {
+		var err error
+		commitmentPoint, err = parseCommitment(c.suite, c.commitments.Commitments[index-1])
+		if err != nil {
+			return nil, nil, err
+		}
+		c.points[index] = commitmentPoint
+	}
 
-	defer telemetry.IncrCounterWithLabels([]string{types.KeyTotalValidKeyShareSubmitted}, 1, []metrics.Label{telemetry.NewLabel("aggrkey", skHex)})
+	return verifyKeyShare(c.suite, keyShareHex, commitmentPoint, uint32(index), id)
+}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.KeyShareAggregatedEventType,
-			sdk.NewAttribute(types.KeyShareAggregatedEventBlockHeight, strconv.FormatUint(msg.BlockHeight, 10)),
-			sdk.NewAttribute(types.KeyShareAggregatedEventData, skHex),
-			sdk.NewAttribute(types.KeyShareAggregatedEventPubKey, activePubKey.PublicKey),
-		),
-	)
+// parseKeyShareCommitment parses a keyshare and extracts the keys and commitment
+func parseKeyShareCommitment(
+	suite pairing.Suite,
+	keyShareHex string,
+	commitmentHex string,
+	index uint32,
+	id string,
+) (*distIBE.ExtractedKey, *distIBE.Commitment, error) {
+	commitmentPoint, err := parseCommitment(suite, commitmentHex)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	k.pepKeeper.SetAggregatedKeyShare(
-		ctx,
-		peptypes.AggregatedKeyShare{
-			Height: msg.BlockHeight,
-			Data:   skHex,
-		},
-	)
+	return verifyKeyShare(suite, keyShareHex, commitmentPoint, index, id)
+}
 
-	latestHeight, err := strconv.ParseUint(k.pepKeeper.GetLatestHeight(ctx), 10, 64)
+// parseCommitment parses a hex encoded keyshare commitment
+func parseCommitment(suite pairing.Suite, commitmentHex string) (kyber.Point, error) {
+	newByteCommitment, err := hex.DecodeString(commitmentHex)
 	if err != nil {
-		latestHeight = 0
+		return nil, types.ErrDecodingCommitment.Wrap(err.Error())
 	}
 
-	if latestHeight < msg.BlockHeight {
-		k.pepKeeper.SetLatestHeight(ctx, strconv.FormatUint(msg.BlockHeight, 10))
+	newCommitmentPoint := suite.G1().Point()
+	err = newCommitmentPoint.UnmarshalBinary(newByteCommitment)
+	if err != nil {
+		return nil, types.ErrUnmarshallingCommitment.Wrap(err.Error())
 	}
 
-	k.Logger().Info(fmt.Sprintf("[ProcessUnconfirmedTxs] Aggregated Key Added, height: %d", msg.BlockHeight))
-
-	return &types.MsgSendKeyshareResponse{
-		Creator:             msg.Creator,
-		Keyshare:            msg.Message,
-		KeyshareIndex:       msg.KeyShareIndex,
-		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
-		BlockHeight:         msg.BlockHeight,
-		Success:             true,
-	}, nil
+	return newCommitmentPoint, nil
 }
 
-// parseKeyShareCommitment parses a keyshare and extracts the keys and commitment
-func parseKeyShareCommitment(
+// verifyKeyShare parses a keyshare and verifies it for the identity against the parsed commitment
+func verifyKeyShare(
 	suite pairing.Suite,
 	keyShareHex string,
-	commitmentHex string,
+	commitmentPoint kyber.Point,
 	index uint32,
 	id string,
 ) (*distIBE.ExtractedKey, *distIBE.Commitment, error) {
@@ -271,24 +335,13 @@ func parseKeyShareCommitment(
 		return nil, nil, types.ErrUnmarshallingKeyShare.Wrap(err.Error())
 	}
 
-	newByteCommitment, err := hex.DecodeString(commitmentHex)
-	if err != nil {
-		return nil, nil, types.ErrDecodingCommitment.Wrap(err.Error())
-	}
-
-	newCommitmentPoint := suite.G1().Point()
-	err = newCommitmentPoint.UnmarshalBinary(newByteCommitment)
-	if err != nil {
-		return nil, nil, types.ErrUnmarshallingCommitment.Wrap(err.Error())
-	}
-
 	newExtractedKey := distIBE.ExtractedKey{
 		SK:    newSharePoint,
 		Index: index,
 	}
 
 	newCommitment := distIBE.Commitment{
-		SP:    newCommitmentPoint,
+		SP:    commitmentPoint,
 		Index: index,
 	}
 
@@ -45,6 +45,11 @@ func TestSendKeyShareMsgServerCreateAggregated(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, expected.Creator, rst.Validator)
 
+	// the keyshares are aggregated in EndBlock
+	_, found = k.GetAggregatedKeyShare(wctx, idUint)
+	require.False(t, found)
+
+	k.AggregatePendingKeyshares(wctx)
 	_, found = k.GetAggregatedKeyShare(wctx, idUint)
 	require.True(t, found)
 
@@ -79,10 +84,36 @@ func TestSendKeyShareMsgServerCreateNotAggregated(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, expected.Creator, rst.Validator)
 
+	k.AggregatePendingKeyshares(wctx)
 	_, found = k.GetAggregatedKeyShare(wctx, idUint)
 	require.False(t, found)
 }
 
+func TestSendKeyShareMsgServerAssignedIndex(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	out, creator := SetupTestGeneralKeyShare(t, wctx, k, 3, 3)
+
+	// the keyshare of index 2 verifies against the commitments but is not held by the creator
+	derived, err := shares.DeriveShare(out.GeneratedShare[1].Share, 2, "1")
+	require.NoError(t, err)
+
+	_, err = srv.SendKeyshare(wctx, &types.MsgSendKeyshare{
+		Creator:       creator,
+		Message:       derived,
+		KeyShareIndex: 2,
+		BlockHeight:   1,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidKeyShareIndex)
+
+	_, found := k.GetKeyShare(wctx, creator, 1)
+	require.False(t, found)
+	_, found = k.GetKeyshareRewardCount(wctx, creator)
+	require.False(t, found)
+}
+
 func TestSendKeyShareMsgServerFailCases(t *testing.T) {
 	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
 	srv := keeper.NewMsgServerImpl(k)
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendKeyshareBatch registers the keyshares of a validator for several heights and general identities,
+// the active commitments are parsed once for the whole batch
+func (k msgServer) SendKeyshareBatch(goCtx context.Context, msg *types.MsgSendKeyshareBatch) (*types.MsgSendKeyshareBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.keyshareSubmitter(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	commitments := k.parsedActiveCommitments(ctx)
+	if commitments == nil {
+		return nil, types.ErrCommitmentsNotFound
+	}
+
+	results := make([]*types.KeyshareBatchResult, len(msg.Keyshares))
+	for i, item := range msg.Keyshares {
+		result := &types.KeyshareBatchResult{
+			BlockHeight: item.BlockHeight,
+			IdType:      item.IdType,
+			IdValue:     item.IdValue,
+		}
+
+		// every keyshare runs in its own cache context, so a rejected keyshare leaves no partial state
+		cacheCtx, write := ctx.CacheContext()
+		success, errorMessage, err := k.sendBatchKeyshare(cacheCtx, msg.Creator, *item, commitments)
+		if err != nil {
+			result.ErrorMessage = err.Error()
+		} else {
+			write()
+			result.Success = success
+			result.ErrorMessage = errorMessage
+		}
+
+		results[i] = result
+	}
+
+	return &types.MsgSendKeyshareBatchResponse{Results: results}, nil
+}
+
+// sendBatchKeyshare handles a keyshare of the batch as a MsgSendKeyshare or a MsgCreateGeneralKeyShare,
+// the validator is looked up for every keyshare as a previous one may have jailed it
+func (k Keeper) sendBatchKeyshare(
+	ctx sdk.Context,
+	creator string,
+	item types.KeyshareBatchItem,
+	commitments *parsedCommitments,
+) (bool, string, error) {
+	validatorInfo, err := k.keyshareSubmitter(ctx, creator)
+	if err != nil {
+		return false, "", err
+	}
+
+	if item.IsGeneral() {
+		res, err := k.createGeneralKeyShare(ctx, types.NewMsgCreateGeneralKeyShare(
+			creator,
+			item.IdType,
+			item.IdValue,
+			item.KeyShare,
+			item.KeyShareIndex,
+		), validatorInfo, commitments)
+		if err != nil {
+			return false, "", err
+		}
+		return res.Success, res.ErrorMessage, nil
+	}
+
+	res, err := k.sendKeyshare(ctx, types.NewMsgSendKeyshare(
+		creator,
+		item.KeyShare,
+		item.KeyShareIndex,
+		item.BlockHeight,
+	), validatorInfo, commitments)
+	if err != nil {
+		return false, "", err
+	}
+	return res.Success, res.ErrorMessage, nil
+}
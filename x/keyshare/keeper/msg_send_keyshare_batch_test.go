@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/testutil/shares"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestSendKeyshareBatch(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+
+	_, err := srv.SendKeyshareBatch(ctx, &types.MsgSendKeyshareBatch{Creator: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrAddrIsNotValidatorOrAuthorized)
+
+	out, creator := SetupTestGeneralKeyShare(t, ctx, k, 10, 10)
+
+	// the cons address can not be decoded, so an invalid keyshare fails without slashing in this test
+	k.SetValidatorSet(ctx, types.ValidatorSet{Index: creator, Validator: creator, ConsAddr: "invalid", IsActive: true})
+
+	idVal := random.RandHex(32)
+	k.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: idVal, Pubkey: out.MasterPublicKey, RequestId: idVal})
+
+	derive := func(id string) string {
+		derived, err := shares.DeriveShare(out.GeneratedShare[0].Share, 1, id)
+		require.NoError(t, err)
+		return derived
+	}
+
+	res, err := srv.SendKeyshareBatch(ctx, &types.MsgSendKeyshareBatch{
+		Creator: creator,
+		Keyshares: []*types.KeyshareBatchItem{
+			{KeyShare: derive("1"), KeyShareIndex: 1, BlockHeight: 1},
+			{KeyShare: derive("2"), KeyShareIndex: 1, BlockHeight: 2},
+			// outside of the height window
+			{KeyShare: derive("3"), KeyShareIndex: 1, BlockHeight: 3},
+			{KeyShare: derive(idVal), KeyShareIndex: 1, IdType: keeper.PrivateGovIdentity, IdValue: idVal},
+			// keyshare of another identity
+			{KeyShare: derive("1"), KeyShareIndex: 1, IdType: keeper.PrivateGovIdentity, IdValue: idVal},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 5)
+
+	for i, success := range []bool{true, true, false, true, false} {
+		require.Equal(t, success, res.Results[i].Success, res.Results[i].ErrorMessage)
+	}
+	require.Contains(t, res.Results[2].ErrorMessage, types.ErrInvalidBlockHeight.Error())
+
+	for _, height := range []uint64{1, 2} {
+		_, found := k.GetKeyShare(ctx, creator, height)
+		require.True(t, found)
+	}
+	_, found := k.GetKeyShare(ctx, creator, 3)
+	require.False(t, found)
+
+	generalKeyShare, found := k.GetGeneralKeyShare(ctx, creator, keeper.PrivateGovIdentity, idVal)
+	require.True(t, found)
+	require.Equal(t, derive(idVal), generalKeyShare.KeyShare)
+
+	count, found := k.GetKeyshareRewardCount(ctx, creator)
+	require.True(t, found)
+	require.Equal(t, uint64(3), count.Count)
+}
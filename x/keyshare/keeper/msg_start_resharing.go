@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StartResharing starts a round moving the shares of the active key from its holders to the registered
+// validators with a new threshold, the public key stays the same so pending encrypted txs and
+// general identity requests can still be decrypted. Resharing to the same validators refreshes the shares.
+func (k msgServer) StartResharing(goCtx context.Context, msg *types.MsgStartResharing) (*types.MsgStartResharingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participants, err := k.newDkgRoundParticipants(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return nil, types.ErrPubKeyNotFound
+	}
+
+	if _, found := k.GetActiveCommitments(ctx); !found {
+		return nil, types.ErrCommitmentsNotFound
+	}
+
+	// the holder of the active keyshare with index i + 1 is the validator of encrypted key share i
+	dealers := make([]string, len(activePubKey.EncryptedKeyShares))
+	for i, encShare := range activePubKey.EncryptedKeyShares {
+		dealers[i] = encShare.Validator
+	}
+
+	if uint64(len(dealers)) < activePubKey.Threshold || activePubKey.Threshold == 0 {
+		return nil, types.ErrNotEnoughDkgParticipants.Wrapf("%d active keyshare holders, threshold: %d", len(dealers), activePubKey.Threshold)
+	}
+
+	if err := k.ValidateThresholdRatio(ctx, msg.Threshold, uint64(len(participants))); err != nil {
+		return nil, err
+	}
+
+	round := k.startDkgRound(ctx, types.DkgRound{
+		Creator:           msg.Creator,
+		Participants:      participants,
+		Threshold:         msg.Threshold,
+		Resharing:         true,
+		Dealers:           dealers,
+		PreviousThreshold: activePubKey.Threshold,
+	})
+
+	return &types.MsgStartResharingResponse{RoundId: round.Id}, nil
+}
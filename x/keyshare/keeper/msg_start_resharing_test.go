@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+	kyberrandom "github.com/drand/kyber/util/random"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestResharingRound(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	suite := bls.NewBLS12381Suite()
+
+	creator := sample.AccAddress()
+	params := types.DefaultParams()
+	params.TrustedAddresses = []string{creator}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := srv.StartResharing(ctx, &types.MsgStartResharing{Creator: creator, Threshold: 3})
+	require.ErrorIs(t, err, types.ErrNotEnoughDkgParticipants)
+
+	// 3 holders of the active key with a threshold of 2
+	out, err := random.GeneratePubKeyAndShares(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), out.Threshold)
+
+	k.SetActivePubKey(ctx, types.ActivePubKey{
+		PublicKey:          out.MasterPublicKey,
+		Creator:            creator,
+		Expiry:             1000,
+		NumberOfValidators: 3,
+		Threshold:          out.Threshold,
+		EncryptedKeyShares: out.KeyShareEncryptedKeyShares,
+	})
+	k.SetActiveCommitments(ctx, types.Commitments{Commitments: out.Commitments})
+
+	// the key is reshared to 4 validators, one of the previous holders stays in the set
+	k.SetValidatorSet(ctx, types.ValidatorSet{Index: out.GeneratedShare[0].ValidatorAddress, Validator: out.GeneratedShare[0].ValidatorAddress, IsActive: true})
+	for i := 0; i < 3; i++ {
+		address := sample.AccAddress()
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: address, Validator: address, IsActive: true})
+	}
+
+	_, err = srv.StartResharing(ctx, &types.MsgStartResharing{Creator: creator, Threshold: 2})
+	require.ErrorIs(t, err, types.ErrThresholdBelowMinimum)
+
+	ctx = ctx.WithBlockHeight(1)
+	res, err := srv.StartResharing(ctx, &types.MsgStartResharing{Creator: creator, Threshold: 3})
+	require.NoError(t, err)
+
+	round, found := k.GetDkgRound(ctx, res.RoundId)
+	require.True(t, found)
+	require.True(t, round.Resharing)
+	require.Len(t, round.Participants, 4)
+	require.Equal(t, uint64(2), round.PreviousThreshold)
+
+	encryptedShares := make([]*types.EncryptedKeyShare, len(round.Participants))
+	for j, p := range round.Participants {
+		encryptedShares[j] = &types.EncryptedKeyShare{
+			Validator: p,
+			Data:      base64.StdEncoding.EncodeToString([]byte("encrypted share")),
+		}
+	}
+
+	// a deal not sharing the active keyshare of the dealer is rejected
+	unrelated := share.NewPriPoly(suite.G1(), int(round.Threshold), nil, kyberrandom.New())
+	_, commits := unrelated.Commit(suite.G1().Point().Base()).Info()
+	_, err = srv.SubmitDkgDeal(ctx, &types.MsgSubmitDkgDeal{
+		Creator:         round.Dealers[0],
+		RoundId:         round.Id,
+		Commitments:     encodePoints(t, commits),
+		EncryptedShares: encryptedShares,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDkgDeal)
+
+	// the first two holders reshare their keyshare, which is enough for the previous threshold
+	for i, dealer := range round.Dealers[:2] {
+		shareBytes, err := hex.DecodeString(out.GeneratedShare[i].Share)
+		require.NoError(t, err)
+		secret := suite.G1().Scalar()
+		require.NoError(t, secret.UnmarshalBinary(shareBytes))
+
+		priPoly := share.NewPriPoly(suite.G1(), int(round.Threshold), secret, kyberrandom.New())
+		_, commits := priPoly.Commit(suite.G1().Point().Base()).Info()
+
+		_, err = srv.SubmitDkgDeal(ctx, &types.MsgSubmitDkgDeal{
+			Creator:         dealer,
+			RoundId:         round.Id,
+			Commitments:     encodePoints(t, commits),
+			EncryptedShares: encryptedShares,
+		})
+		require.NoError(t, err)
+	}
+
+	// keyshares submitted with the previous shares and not aggregated yet
+	nextHeight := round.JustificationEndHeight + 2
+	authorized := sample.AccAddress()
+	k.SetAuthorizedAddress(ctx, types.AuthorizedAddress{Target: authorized, IsAuthorized: true, AuthorizedBy: round.Participants[1]})
+	k.SetKeyShare(ctx, types.KeyShare{Validator: round.Participants[0], BlockHeight: nextHeight})
+	k.SetKeyShare(ctx, types.KeyShare{Validator: authorized, BlockHeight: nextHeight})
+	k.SetPendingAggregation(ctx, types.PendingAggregation{Height: nextHeight})
+
+	k.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "pending"})
+	k.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "aggregated", AggrKeyshare: "aggregated key"})
+	for _, identity := range []string{"pending", "aggregated"} {
+		k.SetGeneralKeyShare(ctx, types.GeneralKeyShare{Validator: round.Participants[0], IdType: keeper.PrivateGovIdentity, IdValue: identity})
+	}
+	k.SetPrivateKeyShare(ctx, types.ValidatorEncryptedKeyShare{Validator: round.Participants[0], Identity: "private", Requester: creator})
+
+	ctx = ctx.WithBlockHeight(int64(round.JustificationEndHeight + 1))
+	k.ProcessDkgRound(ctx)
+
+	round, _ = k.GetDkgRound(ctx, round.Id)
+	require.Equal(t, types.DkgRoundStatusFinalized, round.Status, round.FailReason)
+	require.Equal(t, round.Dealers[:2], round.Qualified)
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	require.True(t, found)
+	require.Equal(t, out.MasterPublicKey, activePubKey.PublicKey)
+	require.Equal(t, uint64(3), activePubKey.Threshold)
+	require.Equal(t, uint64(4), activePubKey.NumberOfValidators)
+	require.Equal(t, uint64(1000), activePubKey.Expiry)
+
+	commitments, found := k.GetActiveCommitments(ctx)
+	require.True(t, found)
+	require.Len(t, commitments.Commitments, 4)
+	require.NoError(t, k.ValidatePubKeyThreshold(ctx, activePubKey.PublicKey, commitments.Commitments, activePubKey.Threshold))
+
+	_, found = k.GetKeyShare(ctx, round.Participants[0], nextHeight)
+	require.False(t, found)
+	_, found = k.GetKeyShare(ctx, authorized, nextHeight)
+	require.False(t, found)
+	require.Empty(t, k.GetAllPendingAggregation(ctx))
+	require.Empty(t, k.GetAllPrivateKeyShare(ctx))
+
+	generalKeyShares := k.GetAllGeneralKeyShare(ctx)
+	require.Len(t, generalKeyShares, 1)
+	require.Equal(t, "aggregated", generalKeyShares[0].IdValue)
+}
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"time"
 
-	"cosmossdk.io/math"
 	commontypes "github.com/Fairblock/fairyring/x/common/types"
 	"github.com/Fairblock/fairyring/x/keyshare/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,7 +16,7 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check if validator is registered
-	_, found := k.GetValidatorSet(ctx, msg.Creator)
+	validatorInfo, found := k.GetValidatorSet(ctx, msg.Creator)
 
 	if !found {
 		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, msg.Creator)
@@ -25,10 +24,11 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 			return nil, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(msg.Creator)
 		}
 
-		_, found = k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
+		authorizedByValInfo, found := k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
 		if !found {
 			return nil, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
 		}
+		validatorInfo = authorizedByValInfo
 
 		// If the sender is in the validator set & authorized another address to submit key share
 	} else if count := k.GetAuthorizedCount(ctx, msg.Creator); count != 0 {
@@ -58,6 +58,7 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 		ReceivedTimestamp:   msg.ReceivedTimestamp,
 		ReceivedBlockHeight: msg.ReceivedBlockHeight,
 		Identity:            msg.Identity,
+		Power:               k.GetValidatorPower(ctx, validatorInfo.Validator),
 	}
 
 	// Save the new private keyshare to state
@@ -68,6 +69,7 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 
 	// Get all the private keyshares for the provided id value & id type
 	var stateEncryptedKeyShares []types.ValidatorEncryptedKeyShare
+	var participatingPower int64
 
 	for _, eachValidator := range validatorList {
 		eachEncKeyShare, found := k.GetPrivateKeyShare(ctx, eachValidator.Validator, msg.Identity, msg.Requester)
@@ -75,6 +77,7 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 			continue
 		}
 		stateEncryptedKeyShares = append(stateEncryptedKeyShares, eachEncKeyShare)
+		participatingPower += eachEncKeyShare.Power
 	}
 
 	// Get the active public key for aggregating
@@ -84,10 +87,7 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 		return nil, types.ErrPubKeyNotFound
 	}
 
-	expectedThreshold := math.LegacyNewDecFromInt(
-		math.NewInt(types.KeyAggregationThresholdNumerator)).Quo(
-		math.LegacyNewDecFromInt(math.NewInt(types.KeyAggregationThresholdDenominator))).MulInt64(
-		int64(activePubKey.NumberOfValidators)).Ceil().TruncateInt64()
+	expectedThreshold := int64(activePubKey.Threshold)
 
 	// Emit KeyShare Submitted Event
 	ctx.EventManager().EmitEvent(
@@ -102,7 +102,7 @@ func (k msgServer) SubmitEncryptedKeyshare(goCtx context.Context, msg *types.Msg
 
 	// If there is not enough keyshares to aggregate OR there is already an aggregated key
 	// Only continue the code if there is enough keyshare to aggregate & no aggregated key for current height
-	if int64(len(stateEncryptedKeyShares)) < expectedThreshold {
+	if int64(len(stateEncryptedKeyShares)) < expectedThreshold || !k.HasSufficientParticipation(ctx, participatingPower) {
 		return &types.MsgSubmitEncryptedKeyshareResponse{}, nil
 	}
 
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubmitKeyshareEvidence handles the evidence of conflicting keyshares of a validator seen off-chain
+func (k msgServer) SubmitKeyshareEvidence(goCtx context.Context, msg *types.MsgSubmitKeyshareEvidence) (*types.MsgSubmitKeyshareEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetKeyshareEvidence(ctx, msg.Validator, msg.Identity); found {
+		return nil, types.ErrKeyshareEvidenceAlreadyExists.Wrapf("validator: %s, identity: %s", msg.Validator, msg.Identity)
+	}
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return nil, types.ErrPubKeyNotFound
+	}
+
+	commitments, found := k.GetActiveCommitments(ctx)
+	if !found {
+		return nil, types.ErrCommitmentsNotFound
+	}
+
+	if !msg.First.ConflictsWith(*msg.Second) {
+		return nil, types.ErrInvalidKeyshareEvidence.Wrap("keyshares are not conflicting")
+	}
+
+	for _, keyshare := range []*types.SignedKeyshare{msg.First, msg.Second} {
+		if err := k.verifyEvidenceKeyshare(ctx, msg.Validator, msg.Identity, *keyshare, activePubKey, commitments); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.HandleKeyshareEvidence(ctx, types.KeyshareEvidence{
+		Validator: msg.Validator,
+		Identity:  msg.Identity,
+		First:     msg.First,
+		Second:    msg.Second,
+		Reporter:  msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitKeyshareEvidenceResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Unjail adds a validator jailed for missing keyshares back to the validator set once its jail period ended
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	info, found := k.GetKeyshareSigningInfo(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrSigningInfoNotFound.Wrap(msg.Creator)
+	}
+
+	if !info.Jailed {
+		return nil, types.ErrValidatorNotJailed.Wrap(msg.Creator)
+	}
+
+	if uint64(ctx.BlockHeight()) < info.JailedUntil {
+		return nil, types.ErrValidatorStillJailed.Wrapf("jailed until height: %d", info.JailedUntil)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingValidator, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(accAddr))
+	if err != nil {
+		return nil, types.ErrAccountNotStaking.Wrap(msg.Creator)
+	}
+
+	if !stakingValidator.IsBonded() {
+		return nil, types.ErrAccountNotStaking.Wrapf("validator %s is not bonded", msg.Creator)
+	}
+
+	bonded := stakingValidator.GetBondedTokens().Uint64()
+	minBonded := k.MinimumBonded(ctx)
+	if bonded < minBonded {
+		return nil, types.ErrInsufficientBondedAmount.Wrap(
+			fmt.Sprintf("Expected minimum bonded: %d, Got: %d", minBonded, bonded),
+		)
+	}
+
+	validator := types.ValidatorSet{
+		Index:     msg.Creator,
+		Validator: msg.Creator,
+		ConsAddr:  info.ConsAddr,
+		IsActive:  true,
+	}
+
+	k.SetValidatorSet(ctx, validator)
+	k.ResetKeyshareSigningInfo(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.UnjailedValidatorEventType,
+			sdk.NewAttribute(types.UnjailedValidatorEventCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgUnjailResponse{}, nil
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commontypes "github.com/Fairblock/fairyring/x/common/types"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+)
+
+// ProcessDkgRound finalizes the latest dkg round once its justification phase ended.
+// Dealers with unanswered complaints are disqualified and slashed, the deals of the remaining
+// dealers are combined into the queued public key and the keyshare commitments,
+// or into the new keyshare commitments of the active key for a resharing round.
+func (k Keeper) ProcessDkgRound(ctx sdk.Context) {
+	round, found := k.GetLatestDkgRound(ctx)
+	if !found || round.Phase(uint64(ctx.BlockHeight())) != types.DkgPhaseFinalizing {
+		return
+	}
+
+	deals := make(map[string]types.DkgDeal)
+	for _, deal := range k.GetAllDkgDeal(ctx, round.Id) {
+		deals[deal.Dealer] = deal
+	}
+
+	for _, complaint := range k.GetAllDkgComplaint(ctx, round.Id) {
+		deal := deals[complaint.Dealer]
+		if complaint.Justified || deal.Disqualified {
+			continue
+		}
+		deal = k.disqualifyDkgDealer(ctx, round, deal, fmt.Sprintf("complaint of %s not answered", complaint.Complainer))
+		deals[deal.Dealer] = deal
+	}
+
+	// qualified dealers are kept in dealer order, so the generated key does not depend on the store order
+	var qualified []types.DkgDeal
+	for _, d := range round.Dealers {
+		if deal, ok := deals[d]; ok && !deal.Disqualified {
+			qualified = append(qualified, deal)
+			round.Qualified = append(round.Qualified, d)
+		}
+	}
+
+	finalize := k.finalizeDkgRound
+	if round.Resharing {
+		finalize = k.finalizeResharingRound
+	}
+
+	if err := finalize(ctx, &round, qualified); err != nil {
+		k.Logger().Error(fmt.Sprintf("DKG round %d failed: %s", round.Id, err.Error()))
+		round.Status = types.DkgRoundStatusFailed
+		round.FailReason = err.Error()
+	} else {
+		round.Status = types.DkgRoundStatusFinalized
+	}
+
+	k.SetDkgRound(ctx, round)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgRoundFinalizedEventType,
+			sdk.NewAttribute(types.DkgRoundFinalizedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgRoundFinalizedEventStatus, round.Status),
+			sdk.NewAttribute(types.DkgRoundFinalizedEventPubkey, round.PublicKey),
+			sdk.NewAttribute(types.DkgRoundFinalizedEventQualified, strings.Join(round.Qualified, ",")),
+			sdk.NewAttribute(types.DkgRoundFinalizedEventReason, round.FailReason),
+		),
+	)
+}
+
+// finalizeDkgRound sums the public polynomials of the qualified deals, the constant term is the
+// public key and the evaluation at each participant index is the commitment of its keyshare
+func (k Keeper) finalizeDkgRound(ctx sdk.Context, round *types.DkgRound, qualified []types.DkgDeal) error {
+	if uint64(len(qualified)) < round.Threshold {
+		return types.ErrNotEnoughDkgParticipants.Wrapf("%d qualified dealers, threshold: %d", len(qualified), round.Threshold)
+	}
+
+	if _, found := k.GetQueuedPubKey(ctx); found {
+		return types.ErrQueuedKeyAlreadyExists.Wrap("queued key created while the round was in progress")
+	}
+
+	var pubPoly *share.PubPoly
+	for _, deal := range qualified {
+		dealPoly, err := parseDkgCommitments(deal.Commitments)
+		if err != nil {
+			return err
+		}
+		if pubPoly == nil {
+			pubPoly = dealPoly
+			continue
+		}
+		if pubPoly, err = pubPoly.Add(dealPoly); err != nil {
+			return err
+		}
+	}
+
+	publicKey, err := pubPoly.Commit().MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	// the keyshare of a participant is the sum of the shares of the qualified deals,
+	// participants derive it from the encrypted shares of the round deals
+	commitments, encryptedKeyShares, err := participantCommitments(round.Participants, pubPoly)
+	if err != nil {
+		return err
+	}
+
+	round.PublicKey = hex.EncodeToString(publicKey)
+
+	params := k.GetParams(ctx)
+	expHeight := params.KeyExpiry + uint64(ctx.BlockHeight())
+	ak, found := k.GetActivePubKey(ctx)
+	if found {
+		expHeight = ak.Expiry + params.KeyExpiry
+	}
+
+	k.SetQueuedCommitments(ctx, types.Commitments{Commitments: commitments})
+
+	k.SetQueuedPubKey(ctx, types.QueuedPubKey{
+		Creator:            round.Creator,
+		PublicKey:          round.PublicKey,
+		Expiry:             expHeight,
+		NumberOfValidators: uint64(len(round.Participants)),
+		Threshold:          round.Threshold,
+		EncryptedKeyShares: encryptedKeyShares,
+	})
+
+	k.pepKeeper.SetQueuedPubKey(
+		ctx,
+		commontypes.QueuedPublicKey{
+			Creator:   round.Creator,
+			PublicKey: round.PublicKey,
+			Expiry:    expHeight,
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.QueuedPubKeyCreatedEventType,
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventActivePubkeyExpiryHeight, strconv.FormatUint(ak.Expiry, 10)),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventExpiryHeight, strconv.FormatUint(expHeight, 10)),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventCreator, round.Creator),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventPubkey, round.PublicKey),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventNumberOfValidators, strconv.Itoa(len(round.Participants))),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventThreshold, strconv.FormatUint(round.Threshold, 10)),
+		),
+	)
+
+	return nil
+}
+
+// participantCommitments evaluates the public polynomial at the keyshare index of every participant
+func participantCommitments(participants []string, pubPoly *share.PubPoly) ([]string, []*types.EncryptedKeyShare, error) {
+	commitments := make([]string, len(participants))
+	encryptedKeyShares := make([]*types.EncryptedKeyShare, len(participants))
+	for i, p := range participants {
+		commitment, err := pubPoly.Eval(i).V.MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		commitments[i] = hex.EncodeToString(commitment)
+		encryptedKeyShares[i] = &types.EncryptedKeyShare{Validator: p}
+	}
+	return commitments, encryptedKeyShares, nil
+}
+
+// disqualifyDkgDealer excludes the deal from the round and slashes its dealer
+func (k Keeper) disqualifyDkgDealer(ctx sdk.Context, round types.DkgRound, deal types.DkgDeal, reason string) types.DkgDeal {
+	deal.Disqualified = true
+	k.SetDkgDeal(ctx, deal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgDealerDisqualifiedEventType,
+			sdk.NewAttribute(types.DkgDealerDisqualifiedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgDealerDisqualifiedEventDealer, deal.Dealer),
+			sdk.NewAttribute(types.DkgDealerDisqualifiedEventReason, reason),
+		),
+	)
+
+	validatorInfo, found := k.GetValidatorSet(ctx, deal.Dealer)
+	if !found {
+		k.Logger().Error(fmt.Sprintf("Unable to slash dkg dealer %s, validator is no longer registered", deal.Dealer))
+		return deal
+	}
+
+	savedConsAddrByte, err := hex.DecodeString(validatorInfo.ConsAddr)
+	if err != nil {
+		k.Logger().Error(fmt.Sprintf("Error while decoding validator %s cons addr: %s", deal.Dealer, err.Error()))
+		return deal
+	}
+
+	var consAddr sdk.ConsAddress
+	if err = consAddr.Unmarshal(savedConsAddrByte); err != nil {
+		k.Logger().Error(fmt.Sprintf("Error while unmarshaling validator %s cons addr: %s", deal.Dealer, err.Error()))
+		return deal
+	}
+
+	k.slashingKeeper.Slash(
+		ctx,
+		consAddr,
+		k.GetParams(ctx).SlashFractionWrongKeyshare,
+		types.SlashPower,
+		ctx.BlockHeight()-1,
+	)
+
+	return deal
+}
+
+// parseDkgCommitments returns the public polynomial of a deal from its coefficient commitments
+func parseDkgCommitments(commitments []string) (*share.PubPoly, error) {
+	suite := bls.NewBLS12381Suite()
+
+	points := make([]kyber.Point, len(commitments))
+	for i, c := range commitments {
+		commitmentBytes, err := hex.DecodeString(c)
+		if err != nil {
+			return nil, types.ErrDecodingCommitment.Wrap(err.Error())
+		}
+		point := suite.G1().Point()
+		if err = point.UnmarshalBinary(commitmentBytes); err != nil {
+			return nil, types.ErrUnmarshallingCommitment.Wrap(err.Error())
+		}
+		points[i] = point
+	}
+
+	return share.NewPubPoly(suite.G1(), nil, points), nil
+}
+
+// verifyDkgShare checks the share of the participant with the given keyshare index against the deal commitments
+func verifyDkgShare(commitments []string, index uint64, shareHex string) error {
+	if index == 0 {
+		return types.ErrNotDkgParticipant.Wrap("complainer is not a participant of the round")
+	}
+
+	pubPoly, err := parseDkgCommitments(commitments)
+	if err != nil {
+		return err
+	}
+
+	suite := bls.NewBLS12381Suite()
+	shareBytes, err := hex.DecodeString(shareHex)
+	if err != nil {
+		return types.ErrInvalidShare.Wrap(err.Error())
+	}
+	shareScalar := suite.G1().Scalar()
+	if err = shareScalar.UnmarshalBinary(shareBytes); err != nil {
+		return types.ErrInvalidShare.Wrap(err.Error())
+	}
+
+	if !pubPoly.Check(&share.PriShare{I: int(index - 1), V: shareScalar}) {
+		return types.ErrInvalidShare.Wrapf("share of keyshare index %d does not match the deal commitments", index)
+	}
+
+	return nil
+}
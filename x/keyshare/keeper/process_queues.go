@@ -62,6 +62,7 @@ func (k Keeper) ProcessPepRequestQueue(ctx sdk.Context) error {
 			RequestId: req.GetRequestId(),
 			Identity:  keyshareRequest.Identity,
 			Pubkey:    keyshareRequest.Pubkey,
+			Expiry:    activePubKey.Expiry,
 		}
 
 		k.pepKeeper.SetEntry(ctx, entry)
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/share"
+)
+
+// finalizeResharingRound interpolates the deals of the first previous threshold qualified dealers at zero,
+// every coefficient of the new public polynomial is the Lagrange combination of the deal coefficients,
+// so its constant term is the active public key and the active key gets the new keyshare commitments
+func (k Keeper) finalizeResharingRound(ctx sdk.Context, round *types.DkgRound, qualified []types.DkgDeal) error {
+	if uint64(len(qualified)) < round.PreviousThreshold {
+		return types.ErrNotEnoughDkgParticipants.Wrapf("%d qualified dealers, previous threshold: %d", len(qualified), round.PreviousThreshold)
+	}
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return types.ErrPubKeyNotFound
+	}
+
+	suite := bls.NewBLS12381Suite()
+	t := int(round.PreviousThreshold)
+	used := qualified[:t]
+	round.Qualified = round.Qualified[:t]
+
+	dealPolys := make([]*share.PubPoly, t)
+	for i, deal := range used {
+		dealPoly, err := parseDkgCommitments(deal.Commitments)
+		if err != nil {
+			return err
+		}
+		dealPolys[i] = dealPoly
+	}
+
+	coefficients := make([]kyber.Point, round.Threshold)
+	for c := range coefficients {
+		pubShares := make([]*share.PubShare, t)
+		for i, deal := range used {
+			_, commits := dealPolys[i].Info()
+			pubShares[i] = &share.PubShare{I: int(round.DealerIndex(deal.Dealer) - 1), V: commits[c]}
+		}
+		coefficient, err := share.RecoverCommit(suite.G1(), pubShares, t, len(round.Dealers))
+		if err != nil {
+			return err
+		}
+		coefficients[c] = coefficient
+	}
+
+	pubPoly := share.NewPubPoly(suite.G1(), nil, coefficients)
+	publicKey, err := pubPoly.Commit().MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	round.PublicKey = hex.EncodeToString(publicKey)
+	if round.PublicKey != activePubKey.PublicKey {
+		return types.ErrInvalidPubKey.Wrapf("reshared public key: %s does not match the active public key: %s", round.PublicKey, activePubKey.PublicKey)
+	}
+
+	commitments, encryptedKeyShares, err := participantCommitments(round.Participants, pubPoly)
+	if err != nil {
+		return err
+	}
+
+	// the reshared keyshares are verified against the same public key as the previous ones,
+	// so every keyshare not aggregated yet is dropped instead of being combined with them
+	k.purgePendingKeyShares(ctx, round)
+
+	participants := make(map[string]bool, len(round.Participants))
+	for _, p := range round.Participants {
+		participants[p] = true
+	}
+
+	// same as overriding the key, validators registered after the round started do not hold a keyshare
+	for _, v := range k.GetAllValidatorSet(ctx) {
+		if !participants[v.Validator] {
+			k.RemoveValidatorSet(ctx, v.Validator)
+		}
+	}
+
+	k.SetActiveCommitments(ctx, types.Commitments{Commitments: commitments})
+
+	activePubKey.NumberOfValidators = uint64(len(round.Participants))
+	activePubKey.Threshold = round.Threshold
+	activePubKey.EncryptedKeyShares = encryptedKeyShares
+	k.SetActivePubKey(ctx, activePubKey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PubKeyResharedEventType,
+			sdk.NewAttribute(types.PubKeyResharedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.PubKeyResharedEventPubkey, round.PublicKey),
+			sdk.NewAttribute(types.PubKeyResharedEventNumberOfValidators, strconv.Itoa(len(round.Participants))),
+			sdk.NewAttribute(types.PubKeyResharedEventThreshold, strconv.FormatUint(round.Threshold, 10)),
+		),
+	)
+
+	return nil
+}
+
+// purgePendingKeyShares removes the keyshares submitted with the previous shares of the active key
+// that are not aggregated yet: the keyshares for the next height, including the ones of authorized addresses,
+// the general and private keyshares of undelivered requests and the pending aggregations
+func (k Keeper) purgePendingKeyShares(ctx sdk.Context, round *types.DkgRound) {
+	submitters := make(map[string]bool)
+	for _, d := range round.Dealers {
+		submitters[d] = true
+	}
+	for _, p := range round.Participants {
+		submitters[p] = true
+	}
+	for _, v := range k.GetAllValidatorSet(ctx) {
+		submitters[v.Validator] = true
+	}
+	for _, a := range k.GetAllAuthorizedAddress(ctx) {
+		submitters[a.Target] = true
+	}
+
+	// keyshares can only be submitted up to the next height
+	nextHeight := uint64(ctx.BlockHeight()) + 1
+	for submitter := range submitters {
+		k.RemoveKeyShare(ctx, submitter, nextHeight)
+	}
+
+	for _, pending := range k.GetAllPendingAggregation(ctx) {
+		k.RemovePendingAggregation(ctx, pending)
+	}
+
+	for _, generalKeyShare := range k.GetAllGeneralKeyShare(ctx) {
+		if keyShareReq, found := k.GetKeyShareRequest(ctx, generalKeyShare.IdValue); found && keyShareReq.AggrKeyshare != "" {
+			continue
+		}
+		k.RemoveGeneralKeyShare(ctx, generalKeyShare.Validator, generalKeyShare.IdType, generalKeyShare.IdValue)
+	}
+
+	for _, privateKeyShare := range k.GetAllPrivateKeyShare(ctx) {
+		if k.privateKeySharesDelivered(ctx, privateKeyShare.Identity, privateKeyShare.Requester) {
+			continue
+		}
+		k.RemovePrivateKeyShare(ctx, privateKeyShare.Validator, privateKeyShare.Identity, privateKeyShare.Requester)
+	}
+}
+
+// privateKeySharesDelivered returns true when the encrypted keyshares of the identity were already sent to the requester
+func (k Keeper) privateKeySharesDelivered(ctx sdk.Context, identity, requester string) bool {
+	keyShareReq, found := k.GetPrivateKeyShareRequest(ctx, identity)
+	if !found {
+		return false
+	}
+	for _, entry := range keyShareReq.EncryptedKeyshares {
+		if entry.Requester == requester && len(entry.PrivateKeyshares) != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneHistoricalData removes the keyshares and aggregated keyshares of heights that are
+// older than their retention window, removing at most MaxPrunedEntriesPerBlock entries of each store
+func (k Keeper) PruneHistoricalData(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	if cutoff, ok := pruneCutoff(height, params.KeyShareRetentionBlocks); ok {
+		pruned := k.PruneKeyShares(ctx, cutoff, params.MaxPrunedEntriesPerBlock)
+		if pruned > 0 {
+			k.Logger().Info("pruned keyshares", "entries", pruned, "cutoff", cutoff)
+		}
+	}
+
+	if cutoff, ok := pruneCutoff(height, params.AggregatedKeyShareRetentionBlocks); ok {
+		pruned := k.PruneAggregatedKeyShares(ctx, cutoff, params.MaxPrunedEntriesPerBlock)
+		if pruned > 0 {
+			k.Logger().Info("pruned aggregated keyshares", "entries", pruned, "cutoff", cutoff)
+		}
+	}
+}
+
+// PruneKeyShares removes up to limit keyshares of the validator set with a block height lower than cutoff
+func (k Keeper) PruneKeyShares(ctx context.Context, cutoff, limit uint64) uint64 {
+	var pruned uint64
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		if pruned >= limit {
+			break
+		}
+		pruned += pruneHeightIndexedStore(k.validatorKeyShareStore(ctx, eachValidator.Validator), cutoff, limit-pruned)
+	}
+	return pruned
+}
+
+// PruneAggregatedKeyShares removes up to limit aggregated keyshares with a height lower than cutoff
+func (k Keeper) PruneAggregatedKeyShares(ctx context.Context, cutoff, limit uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AggregatedKeyShareKeyPrefix))
+	return pruneHeightIndexedStore(store, cutoff, limit)
+}
+
+// GetOldestKeyShareHeight returns the lowest block height that still has a keyshare
+// of the validator set stored
+func (k Keeper) GetOldestKeyShareHeight(ctx context.Context) uint64 {
+	var oldest uint64
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		height, found := oldestHeightInStore(k.validatorKeyShareStore(ctx, eachValidator.Validator))
+		if found && (oldest == 0 || height < oldest) {
+			oldest = height
+		}
+	}
+	return oldest
+}
+
+// GetOldestAggregatedKeyShareHeight returns the lowest height that still has an aggregated keyshare stored
+func (k Keeper) GetOldestAggregatedKeyShareHeight(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AggregatedKeyShareKeyPrefix))
+	height, _ := oldestHeightInStore(store)
+	return height
+}
+
+// validatorKeyShareStore returns the keyshare store of a single validator, keyed by block height
+func (k Keeper) validatorKeyShareStore(ctx context.Context, validator string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyShareKeyPrefix))
+	return prefix.NewStore(store, []byte(validator+"/"))
+}
+
+// pruneCutoff returns the height below which entries can be pruned,
+// a retention of 0 disables pruning
+func pruneCutoff(height, retention uint64) (uint64, bool) {
+	if retention == 0 || height <= retention {
+		return 0, false
+	}
+	return height - retention, true
+}
+
+// pruneHeightIndexedStore deletes up to limit entries of a store keyed by big endian heights
+// whose height is lower than cutoff
+func pruneHeightIndexedStore(store prefix.Store, cutoff, limit uint64) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		key := iterator.Key()
+		if len(key) < 8 || binary.BigEndian.Uint64(key[:8]) >= cutoff {
+			break
+		}
+		keys = append(keys, key)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return uint64(len(keys))
+}
+
+// oldestHeightInStore returns the height of the first entry of a store keyed by big endian heights
+func oldestHeightInStore(store prefix.Store) (uint64, bool) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	if !iterator.Valid() || len(iterator.Key()) < 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(iterator.Key()[:8]), true
+}
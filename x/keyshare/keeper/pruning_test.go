@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneHistoricalData(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress()}
+	for _, validator := range validators {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: validator, Validator: validator})
+		for h := uint64(1); h <= 10; h++ {
+			k.SetKeyShare(ctx, types.KeyShare{Validator: validator, BlockHeight: h, KeyShare: random.RandHex(10)})
+		}
+	}
+	for h := uint64(1); h <= 10; h++ {
+		k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{Height: h, Data: random.RandHex(32)})
+	}
+
+	require.Equal(t, uint64(1), k.GetOldestKeyShareHeight(ctx))
+	require.Equal(t, uint64(1), k.GetOldestAggregatedKeyShareHeight(ctx))
+
+	params := k.GetParams(ctx)
+	params.KeyShareRetentionBlocks = 4
+	params.AggregatedKeyShareRetentionBlocks = 2
+	params.MaxPrunedEntriesPerBlock = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(10)
+	k.PruneHistoricalData(ctx)
+
+	// heights 1..5 of both validators are pruned, using up the whole budget
+	require.Equal(t, uint64(6), k.GetOldestKeyShareHeight(ctx))
+	for _, validator := range validators {
+		_, found := k.GetKeyShare(ctx, validator, 5)
+		require.False(t, found)
+		_, found = k.GetKeyShare(ctx, validator, 6)
+		require.True(t, found)
+	}
+	require.Equal(t, uint64(8), k.GetOldestAggregatedKeyShareHeight(ctx))
+
+	resp, err := k.OldestRetainedHeight(ctx, &types.QueryOldestRetainedHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryOldestRetainedHeightResponse{
+		KeyShareHeight:           6,
+		AggregatedKeyShareHeight: 8,
+	}, resp)
+}
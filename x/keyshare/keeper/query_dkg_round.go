@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DkgRound returns a dkg round with its current phase, deals and complaints,
+// the latest round is returned when no id is given
+func (k Keeper) DkgRound(goCtx context.Context, req *types.QueryDkgRoundRequest) (*types.QueryDkgRoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := req.Id
+	if id == 0 {
+		id = k.GetDkgRoundCount(ctx)
+	}
+
+	round, found := k.GetDkgRound(ctx, id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "dkg round not found")
+	}
+
+	return &types.QueryDkgRoundResponse{
+		Round:      round,
+		Phase:      round.Phase(uint64(ctx.BlockHeight())),
+		Deals:      k.GetAllDkgDeal(ctx, round.Id),
+		Complaints: k.GetAllDkgComplaint(ctx, round.Id),
+	}, nil
+}
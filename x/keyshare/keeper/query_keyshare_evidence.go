@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeyshareEvidenceAll returns the evidence of all validators punished for conflicting keyshares
+func (k Keeper) KeyshareEvidenceAll(c context.Context, req *types.QueryAllKeyshareEvidenceRequest) (*types.QueryAllKeyshareEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var evidences []types.KeyshareEvidence
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	evidenceStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareEvidenceKeyPrefix))
+
+	pageRes, err := query.Paginate(evidenceStore, req.Pagination, func(key []byte, value []byte) error {
+		var evidence types.KeyshareEvidence
+		if err := k.cdc.Unmarshal(value, &evidence); err != nil {
+			return err
+		}
+
+		evidences = append(evidences, evidence)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllKeyshareEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
+}
+
+// KeyshareEvidence returns the evidence of conflicting keyshares of a validator for an identity
+func (k Keeper) KeyshareEvidence(c context.Context, req *types.QueryKeyshareEvidenceRequest) (*types.QueryKeyshareEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetKeyshareEvidence(
+		ctx,
+		req.Validator,
+		req.Identity,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryKeyshareEvidenceResponse{Evidence: val}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeyshareParticipation returns the keyshares submitted so far for a block height or a general identity,
+// the power behind them and the power required to aggregate the key
+func (k Keeper) KeyshareParticipation(goCtx context.Context, req *types.QueryKeyshareParticipationRequest) (*types.QueryKeyshareParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if (req.Height == 0) == (req.Identity == "") {
+		return nil, status.Error(codes.InvalidArgument, "either height or identity has to be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		submitted          uint64
+		participatingPower int64
+		aggregated         bool
+	)
+
+	if req.Height != 0 {
+		submitted, participatingPower = k.GetBlockKeyshareParticipation(ctx, req.Height)
+		_, aggregated = k.GetAggregatedKeyShare(ctx, req.Height)
+	} else {
+		submitted, participatingPower = k.GetGeneralKeyshareParticipation(ctx, PrivateGovIdentity, req.Identity)
+		keyShareReq, found := k.GetKeyShareRequest(ctx, req.Identity)
+		aggregated = found && keyShareReq.AggrKeyshare != ""
+	}
+
+	activePubKey, _ := k.GetActivePubKey(ctx)
+	totalPower := k.GetTotalKeysharePower(ctx)
+
+	ratio := math.LegacyZeroDec()
+	if totalPower > 0 {
+		ratio = math.LegacyNewDec(participatingPower).QuoInt64(totalPower)
+	}
+
+	return &types.QueryKeyshareParticipationResponse{
+		SubmittedKeyshares: submitted,
+		Threshold:          activePubKey.Threshold,
+		ParticipatingPower: participatingPower,
+		TotalPower:         totalPower,
+		RequiredPower:      k.GetRequiredParticipationPower(ctx, totalPower),
+		ParticipationRatio: ratio,
+		Aggregated:         aggregated,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/keyshare/keeper"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeyshareParticipationQuery(t *testing.T) {
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	for _, validator := range validators {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: validator, Validator: validator})
+	}
+
+	k.SetActivePubKey(ctx, types.ActivePubKey{
+		PublicKey:          random.RandHex(96),
+		NumberOfValidators: 3,
+		Threshold:          2,
+	})
+
+	k.SetKeyShare(ctx, types.KeyShare{Validator: validators[0], BlockHeight: 10, KeyShare: random.RandHex(10), Power: 30})
+	k.SetKeyShare(ctx, types.KeyShare{Validator: validators[1], BlockHeight: 10, KeyShare: random.RandHex(10), Power: 20})
+	k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{Height: 10, Data: random.RandHex(32)})
+
+	identity := "identity"
+	k.SetGeneralKeyShare(ctx, types.GeneralKeyShare{
+		Validator: validators[2],
+		IdType:    keeper.PrivateGovIdentity,
+		IdValue:   identity,
+		KeyShare:  random.RandHex(10),
+		Power:     15,
+	})
+
+	for _, tc := range []struct {
+		desc       string
+		request    *types.QueryKeyshareParticipationRequest
+		submitted  uint64
+		power      int64
+		aggregated bool
+		err        error
+	}{
+		{
+			desc:       "Height",
+			request:    &types.QueryKeyshareParticipationRequest{Height: 10},
+			submitted:  2,
+			power:      50,
+			aggregated: true,
+		},
+		{
+			desc:    "HeightWithoutKeyshares",
+			request: &types.QueryKeyshareParticipationRequest{Height: 11},
+		},
+		{
+			desc:      "Identity",
+			request:   &types.QueryKeyshareParticipationRequest{Identity: identity},
+			submitted: 1,
+			power:     15,
+		},
+		{
+			desc:    "HeightAndIdentity",
+			request: &types.QueryKeyshareParticipationRequest{Height: 10, Identity: identity},
+			err:     status.Error(codes.InvalidArgument, "either height or identity has to be provided"),
+		},
+		{
+			desc:    "NoHeightOrIdentity",
+			request: &types.QueryKeyshareParticipationRequest{},
+			err:     status.Error(codes.InvalidArgument, "either height or identity has to be provided"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := k.KeyshareParticipation(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.submitted, response.SubmittedKeyshares)
+			require.Equal(t, tc.power, response.ParticipatingPower)
+			require.Equal(t, tc.aggregated, response.Aggregated)
+			require.Equal(t, uint64(2), response.Threshold)
+			// the stake weighted threshold is disabled by default
+			require.Equal(t, int64(0), response.RequiredPower)
+		})
+	}
+
+	params := k.GetParams(ctx)
+	params.StakeWeightedThresholdRatio = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, int64(50), k.GetRequiredParticipationPower(ctx, 100))
+	require.Equal(t, int64(51), k.GetRequiredParticipationPower(ctx, 101))
+	require.True(t, k.HasSufficientParticipation(ctx, 0))
+}
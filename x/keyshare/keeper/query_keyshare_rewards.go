@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeyshareRewards returns the unclaimed rewards of a validator and its keyshares in the current epoch
+func (k Keeper) KeyshareRewards(c context.Context, req *types.QueryKeyshareRewardsRequest) (*types.QueryKeyshareRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reward, _ := k.GetKeyshareReward(ctx, req.Validator)
+	count, _ := k.GetKeyshareRewardCount(ctx, req.Validator)
+
+	return &types.QueryKeyshareRewardsResponse{
+		Rewards:        reward.Amount,
+		EpochKeyshares: count.Count,
+	}, nil
+}
+
+// KeyshareRewardPool returns the pool paid out at the end of the current epoch
+func (k Keeper) KeyshareRewardPool(c context.Context, req *types.QueryKeyshareRewardPoolRequest) (*types.QueryKeyshareRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryKeyshareRewardPoolResponse{
+		Pool:            k.KeyshareRewardPoolBalance(ctx),
+		Unclaimed:       k.UnclaimedKeyshareRewards(ctx),
+		NextEpochHeight: k.NextKeyshareRewardEpoch(ctx),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeyshareSigningInfoAll returns the keyshare liveness info of all tracked validators
+func (k Keeper) KeyshareSigningInfoAll(c context.Context, req *types.QueryAllKeyshareSigningInfoRequest) (*types.QueryAllKeyshareSigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var signingInfos []types.KeyshareSigningInfo
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	signingInfoStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyshareSigningInfoKeyPrefix))
+
+	pageRes, err := query.Paginate(signingInfoStore, req.Pagination, func(key []byte, value []byte) error {
+		var signingInfo types.KeyshareSigningInfo
+		if err := k.cdc.Unmarshal(value, &signingInfo); err != nil {
+			return err
+		}
+
+		signingInfos = append(signingInfos, signingInfo)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllKeyshareSigningInfoResponse{SigningInfo: signingInfos, Pagination: pageRes}, nil
+}
+
+// KeyshareSigningInfo returns the keyshare liveness info of a validator
+func (k Keeper) KeyshareSigningInfo(c context.Context, req *types.QueryKeyshareSigningInfoRequest) (*types.QueryKeyshareSigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetKeyshareSigningInfo(
+		ctx,
+		req.Validator,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryKeyshareSigningInfoResponse{SigningInfo: val}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorPower returns the current consensus power of a keyshare validator, 0 if it is not bonded
+func (k Keeper) GetValidatorPower(ctx context.Context, validator string) int64 {
+	accAddr, err := sdk.AccAddressFromBech32(validator)
+	if err != nil {
+		return 0
+	}
+
+	stakingValidator, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(accAddr))
+	if err != nil {
+		return 0
+	}
+
+	return stakingValidator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+}
+
+// GetTotalKeysharePower returns the current consensus power of all the validators in the keyshare validator set
+func (k Keeper) GetTotalKeysharePower(ctx context.Context) (total int64) {
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		total += k.GetValidatorPower(ctx, eachValidator.Validator)
+	}
+	return total
+}
+
+// GetRequiredParticipationPower returns the power the keyshares of a key have to be backed by
+// before the key is aggregated, 0 when the stake weighted threshold is disabled
+func (k Keeper) GetRequiredParticipationPower(ctx context.Context, totalPower int64) int64 {
+	ratio := k.GetParams(ctx).StakeWeightedThresholdRatio
+	if ratio.IsNil() || ratio.IsZero() {
+		return 0
+	}
+	return ratio.MulInt64(totalPower).Ceil().TruncateInt64()
+}
+
+// HasSufficientParticipation returns true if the given keyshare power is enough to aggregate a key
+func (k Keeper) HasSufficientParticipation(ctx context.Context, participatingPower int64) bool {
+	return participatingPower >= k.GetRequiredParticipationPower(ctx, k.GetTotalKeysharePower(ctx))
+}
+
+// GetBlockKeyshareParticipation returns the number of keyshares submitted for a block height
+// and the power they were submitted with
+func (k Keeper) GetBlockKeyshareParticipation(ctx context.Context, height uint64) (count uint64, power int64) {
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		keyShare, found := k.GetKeyShare(ctx, eachValidator.Validator, height)
+		if !found {
+			continue
+		}
+		count++
+		power += keyShare.Power
+	}
+	return count, power
+}
+
+// GetGeneralKeyshareParticipation returns the number of general keyshares submitted for an identity
+// and the power they were submitted with
+func (k Keeper) GetGeneralKeyshareParticipation(ctx context.Context, idType, idValue string) (count uint64, power int64) {
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		generalKeyShare, found := k.GetGeneralKeyShare(ctx, eachValidator.Validator, idType, idValue)
+		if !found {
+			continue
+		}
+		count++
+		power += generalKeyShare.Power
+	}
+	return count, power
+}
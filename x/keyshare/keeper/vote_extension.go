@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyKeyshareVoteExtension verifies the keyshare a validator attached to its vote for a block height,
+// it returns the registered validator of the consensus address
+func (k Keeper) VerifyKeyshareVoteExtension(
+	ctx sdk.Context,
+	consAddr []byte,
+	height uint64,
+	ext types.KeyshareVoteExtension,
+) (types.ValidatorSet, error) {
+	return k.verifyKeyshareVoteExtension(ctx, consAddr, height, ext, k.parsedActiveCommitments(ctx))
+}
+
+func (k Keeper) verifyKeyshareVoteExtension(
+	ctx sdk.Context,
+	consAddr []byte,
+	height uint64,
+	ext types.KeyshareVoteExtension,
+	commitments *parsedCommitments,
+) (types.ValidatorSet, error) {
+	if ext.BlockHeight != height {
+		return types.ValidatorSet{}, types.ErrInvalidBlockHeight.Wrapf("expected keyshare for height: %d, got: %d", height, ext.BlockHeight)
+	}
+
+	validatorInfo, found := k.validatorByConsAddr(ctx, consAddr)
+	if !found {
+		return validatorInfo, types.ErrInvalidVoteExtension.Wrapf("validator %X is not registered", consAddr)
+	}
+
+	if commitments == nil {
+		return validatorInfo, types.ErrCommitmentsNotFound
+	}
+
+	if err := k.verifyAssignedKeyShareIndex(ctx, validatorInfo.Validator, ext.KeyShareIndex); err != nil {
+		return validatorInfo, err
+	}
+
+	if _, _, err := commitments.parseKeyShare(ext.KeyShare, ext.KeyShareIndex, strconv.FormatUint(height, 10)); err != nil {
+		return validatorInfo, err
+	}
+
+	return validatorInfo, nil
+}
+
+// validatorByConsAddr returns the registered validator with the given consensus address
+func (k Keeper) validatorByConsAddr(ctx sdk.Context, consAddr []byte) (types.ValidatorSet, bool) {
+	consHex := hex.EncodeToString(consAddr)
+	for _, v := range k.GetAllValidatorSet(ctx) {
+		if v.ConsAddr == consHex {
+			return v, true
+		}
+	}
+	return types.ValidatorSet{}, false
+}
+
+// keyshareVoteExtensions returns the keyshares of a block height attached to the commit votes of the extended commit,
+// the vote extensions that are empty or do not verify are skipped
+func (k Keeper) keyshareVoteExtensions(
+	ctx sdk.Context,
+	height uint64,
+	extCommit abci.ExtendedCommitInfo,
+	pubKey string,
+) []types.KeyShare {
+	commitments := k.parsedActiveCommitments(ctx)
+	seen := make(map[string]bool, len(extCommit.Votes))
+
+	keyshares := make([]types.KeyShare, 0, len(extCommit.Votes))
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var ext types.KeyshareVoteExtension
+		if err := ext.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		validatorInfo, err := k.verifyKeyshareVoteExtension(ctx, vote.Validator.Address, height, ext, commitments)
+		if err != nil || seen[validatorInfo.Validator] {
+			continue
+		}
+		seen[validatorInfo.Validator] = true
+
+		keyshares = append(keyshares, types.KeyShare{
+			Validator:           validatorInfo.Validator,
+			BlockHeight:         height,
+			KeyShare:            ext.KeyShare,
+			KeyShareIndex:       ext.KeyShareIndex,
+			ReceivedTimestamp:   uint64(ctx.BlockTime().Unix()),
+			ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+			Power:               k.GetValidatorPower(ctx, validatorInfo.Validator),
+			PubKey:              pubKey,
+		})
+	}
+
+	return keyshares
+}
+
+// aggregateKeyshareVoteExtensions aggregates the decryption key of a block height from the vote extensions
+func (k Keeper) aggregateKeyshareVoteExtensions(
+	ctx sdk.Context,
+	height uint64,
+	extCommit abci.ExtendedCommitInfo,
+) ([]types.KeyShare, string, bool, error) {
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return nil, "", false, nil
+	}
+
+	keyshares := k.keyshareVoteExtensions(ctx, height, extCommit, activePubKey.PublicKey)
+
+	verified := make([]verifiedKeyshare, len(keyshares))
+	for i, keyShare := range keyshares {
+		verified[i] = verifiedKeyshare{
+			keyShare:      keyShare.KeyShare,
+			keyShareIndex: keyShare.KeyShareIndex,
+			power:         keyShare.Power,
+			pubKey:        keyShare.PubKey,
+		}
+	}
+
+	skHex, aggregated, err := k.aggregateKeyShares(ctx, activePubKey, verified)
+	return keyshares, skHex, aggregated, err
+}
+
+// AggregateKeyshareVoteExtensions aggregates the decryption key of a block height from the keyshares
+// attached to the votes of the previous height, it returns false when there are not enough keyshares
+// or the key of the height is already aggregated
+func (k Keeper) AggregateKeyshareVoteExtensions(
+	ctx sdk.Context,
+	height uint64,
+	extCommit abci.ExtendedCommitInfo,
+) (types.AggregatedKeyshareInjection, bool, error) {
+	if _, found := k.GetAggregatedKeyShare(ctx, height); found {
+		return types.AggregatedKeyshareInjection{}, false, nil
+	}
+
+	_, skHex, aggregated, err := k.aggregateKeyshareVoteExtensions(ctx, height, extCommit)
+	if err != nil || !aggregated {
+		return types.AggregatedKeyshareInjection{}, false, err
+	}
+
+	extCommitBytes, err := extCommit.Marshal()
+	if err != nil {
+		return types.AggregatedKeyshareInjection{}, false, err
+	}
+
+	return types.AggregatedKeyshareInjection{
+		BlockHeight:        height,
+		Data:               skHex,
+		ExtendedCommitInfo: extCommitBytes,
+	}, true, nil
+}
+
+// VerifyAggregatedKeyshareInjection verifies the aggregated key injected by the proposer of a block height
+// against the vote extensions it was aggregated from, it returns the keyshares of the vote extensions
+func (k Keeper) VerifyAggregatedKeyshareInjection(
+	ctx sdk.Context,
+	height uint64,
+	injection types.AggregatedKeyshareInjection,
+) ([]types.KeyShare, error) {
+	if injection.BlockHeight != height {
+		return nil, types.ErrInvalidKeyshareInjection.Wrapf("expected height: %d, got: %d", height, injection.BlockHeight)
+	}
+
+	if _, found := k.GetAggregatedKeyShare(ctx, height); found {
+		return nil, types.ErrAggKeyAlreadyExists.Wrapf("height: %d", height)
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(injection.ExtendedCommitInfo); err != nil {
+		return nil, types.ErrInvalidKeyshareInjection.Wrap(err.Error())
+	}
+
+	keyshares, skHex, aggregated, err := k.aggregateKeyshareVoteExtensions(ctx, height, extCommit)
+	if err != nil {
+		return nil, err
+	}
+	if !aggregated {
+		return nil, types.ErrInvalidKeyshareInjection.Wrap("not enough keyshares in the vote extensions")
+	}
+	if skHex != injection.Data {
+		return nil, types.ErrInvalidKeyshareInjection.Wrap("aggregated key does not match the vote extensions")
+	}
+
+	return keyshares, nil
+}
+
+// ApplyAggregatedKeyshareInjection stores the keyshares of the vote extensions and the aggregated key
+// injected in the current block, the keyshares count for the liveness and rewards of their validators
+func (k Keeper) ApplyAggregatedKeyshareInjection(ctx sdk.Context, injection types.AggregatedKeyshareInjection) error {
+	height := uint64(ctx.BlockHeight())
+
+	keyshares, err := k.VerifyAggregatedKeyshareInjection(ctx, height, injection)
+	if err != nil {
+		return err
+	}
+
+	for _, keyShare := range keyshares {
+		if _, found := k.GetKeyShare(ctx, keyShare.Validator, height); found {
+			continue
+		}
+
+		k.SetKeyShare(ctx, keyShare)
+		k.IncreaseKeyshareRewardCount(ctx, keyShare.Validator)
+		k.SetLastSubmittedHeight(ctx, keyShare.Validator, strconv.FormatUint(height, 10))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.SendKeyshareEventType,
+				sdk.NewAttribute(types.SendKeyshareEventValidator, keyShare.Validator),
+				sdk.NewAttribute(types.SendKeyshareEventKeyshareBlockHeight, strconv.FormatUint(height, 10)),
+				sdk.NewAttribute(types.SendKeyshareEventReceivedBlockHeight, strconv.FormatUint(height, 10)),
+				sdk.NewAttribute(types.SendKeyshareEventMessage, keyShare.KeyShare),
+				sdk.NewAttribute(types.SendKeyshareEventIndex, strconv.FormatUint(keyShare.KeyShareIndex, 10)),
+			),
+		)
+	}
+
+	activePubKey, _ := k.GetActivePubKey(ctx)
+	k.setBlockAggregatedKeyShare(ctx, height, injection.Data, activePubKey.PublicKey)
+
+	k.Logger().Info(fmt.Sprintf("Aggregated Decryption Key for Block %d from %d vote extensions", height, len(keyshares)))
+
+	return nil
+}
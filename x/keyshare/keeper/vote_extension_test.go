@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/shares"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+)
+
+func TestKeyshareVoteExtensions(t *testing.T) {
+	k, ctx, pk, _ := keepertest.KeyshareKeeper(t)
+	ctx = ctx.WithBlockHeight(5)
+
+	out, _ := SetupTestGeneralKeyShare(t, ctx, k, 3, 3)
+
+	consAddrs := make([][]byte, len(out.GeneratedShare))
+	for i, s := range out.GeneratedShare {
+		consAddrs[i] = []byte{byte(i + 1), 0xfa, 0x1b}
+		k.SetValidatorSet(ctx, types.ValidatorSet{
+			Index:     s.ValidatorAddress,
+			Validator: s.ValidatorAddress,
+			ConsAddr:  hex.EncodeToString(consAddrs[i]),
+			IsActive:  true,
+		})
+	}
+
+	vote := func(i int, height uint64) abci.ExtendedVoteInfo {
+		derived, err := shares.DeriveShare(out.GeneratedShare[i].Share, uint32(i+1), "5")
+		require.NoError(t, err)
+		ext := types.KeyshareVoteExtension{BlockHeight: height, KeyShare: derived, KeyShareIndex: uint64(i + 1)}
+		bz, err := ext.Marshal()
+		require.NoError(t, err)
+		return abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Address: consAddrs[i], Power: 1},
+			VoteExtension: bz,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		}
+	}
+
+	_, err := k.VerifyKeyshareVoteExtension(ctx, []byte("unknown"), 5, types.KeyshareVoteExtension{})
+	require.ErrorIs(t, err, types.ErrInvalidBlockHeight)
+
+	// a keyshare derived for another height is invalid
+	invalid := vote(2, 6)
+	var invalidExt types.KeyshareVoteExtension
+	require.NoError(t, invalidExt.Unmarshal(invalid.VoteExtension))
+	_, err = k.VerifyKeyshareVoteExtension(ctx, consAddrs[2], 6, invalidExt)
+	require.ErrorIs(t, err, types.ErrInvalidShare)
+
+	// a valid keyshare of the index held by another validator is rejected
+	copied := vote(1, 5)
+	var copiedExt types.KeyshareVoteExtension
+	require.NoError(t, copiedExt.Unmarshal(copied.VoteExtension))
+	_, err = k.VerifyKeyshareVoteExtension(ctx, consAddrs[2], 5, copiedExt)
+	require.ErrorIs(t, err, types.ErrInvalidKeyShareIndex)
+
+	// a single valid keyshare is below the threshold of 2
+	_, aggregated, err := k.AggregateKeyshareVoteExtensions(ctx, 5, abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{vote(0, 5), invalid},
+	})
+	require.NoError(t, err)
+	require.False(t, aggregated)
+
+	injection, aggregated, err := k.AggregateKeyshareVoteExtensions(ctx, 5, abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{vote(0, 5), invalid, vote(1, 5)},
+	})
+	require.NoError(t, err)
+	require.True(t, aggregated)
+
+	tampered := injection
+	tampered.Data = injection.Data[:len(injection.Data)-2] + "00"
+	require.ErrorIs(t, k.ApplyAggregatedKeyshareInjection(ctx, tampered), types.ErrInvalidKeyshareInjection)
+
+	require.NoError(t, k.ApplyAggregatedKeyshareInjection(ctx, injection))
+
+	aggregatedKey, found := k.GetAggregatedKeyShare(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, injection.Data, aggregatedKey.Data)
+	pepAggregated, found := pk.GetAggregatedKeyShare(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, injection.Data, pepAggregated.Data)
+	require.Equal(t, "5", pk.GetLatestHeight(ctx))
+
+	// the keyshares of the vote extensions count for their validators
+	for i, submitted := range []bool{true, true, false} {
+		_, found := k.GetKeyShare(ctx, out.GeneratedShare[i].ValidatorAddress, 5)
+		require.Equal(t, submitted, found)
+	}
+	count, found := k.GetKeyshareRewardCount(ctx, out.GeneratedShare[0].ValidatorAddress)
+	require.True(t, found)
+	require.Equal(t, uint64(1), count.Count)
+
+	// the key of the height is only injected once
+	_, aggregated, err = k.AggregateKeyshareVoteExtensions(ctx, 5, abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{vote(0, 5), vote(1, 5)},
+	})
+	require.NoError(t, err)
+	require.False(t, aggregated)
+	require.ErrorIs(t, k.ApplyAggregatedKeyshareInjection(ctx, injection), types.ErrAggKeyAlreadyExists)
+}
@@ -9,13 +9,15 @@ import (
 
 // MigrateStore migrates the x/keyshare module state from the consensus version 1 to version 2.
 func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
-	currParams := types.NewParams(
-		463000,
-		[]string{"fairy1r6q07ne3deq64ezcjwkedcfe6669f0ewpwnxy9"},
-		types.DefaultMinimumBonded,
-		types.DefaultSlashFractionNoKeyShare,
-		types.DefaultSlashFractionWrongKeyShare,
-		types.DefaultMaxIdledBlock)
+	// params added after version 2 are set by the later migrations
+	currParams := types.Params{
+		KeyExpiry:                  463000,
+		TrustedAddresses:           []string{"fairy1r6q07ne3deq64ezcjwkedcfe6669f0ewpwnxy9"},
+		MinimumBonded:              types.DefaultMinimumBonded,
+		SlashFractionNoKeyshare:    types.DefaultSlashFractionNoKeyShare,
+		SlashFractionWrongKeyshare: types.DefaultSlashFractionWrongKeyShare,
+		MaxIdledBlock:              types.DefaultMaxIdledBlock,
+	}
 
 	bz, err := cdc.Marshal(&currParams)
 	if err != nil {
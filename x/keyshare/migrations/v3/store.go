@@ -0,0 +1,107 @@
+package v3
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// legacy threshold the keys created before version 3 are aggregated with
+const (
+	legacyThresholdNumerator   = 2
+	legacyThresholdDenominator = 3
+)
+
+// MigrateStore migrates the x/keyshare module state from the consensus version 2 to version 3.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	currentParamsBytes, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+	var currentParams types.Params
+	if err = cdc.Unmarshal(currentParamsBytes, &currentParams); err != nil {
+		return err
+	}
+
+	currParams := types.NewParams(
+		currentParams.KeyExpiry,
+		currentParams.TrustedAddresses,
+		currentParams.MinimumBonded,
+		currentParams.SlashFractionNoKeyshare,
+		currentParams.SlashFractionWrongKeyshare,
+		currentParams.MaxIdledBlock,
+		types.DefaultKeyShareRetentionBlocks,
+		types.DefaultAggregatedKeyShareRetentionBlocks,
+		types.DefaultMaxPrunedEntriesPerBlock,
+		types.DefaultMinimumThresholdRatio,
+		types.DefaultStakeWeightedThresholdRatio,
+		types.DefaultDkgDealPhaseBlocks,
+		types.DefaultDkgComplaintPhaseBlocks,
+		types.DefaultDkgJustificationPhaseBlocks,
+		types.DefaultSignedKeysharesWindow,
+		types.DefaultMinSubmittedPerWindow,
+		types.DefaultDowntimeJailBlocks,
+		types.DefaultSlashFractionEquivocation,
+		types.DefaultRewardEpochBlocks,
+	)
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	if err = store.Set(types.ParamsKey, bz); err != nil {
+		return err
+	}
+
+	// record the threshold the existing keys were aggregated with before it became part of the key
+	activeKeyBytes, err := store.Get(types.KeyPrefix(types.ActivePubKeyPrefix))
+	if err != nil {
+		return err
+	}
+	if activeKeyBytes != nil {
+		var activeKey types.ActivePubKey
+		if err = cdc.Unmarshal(activeKeyBytes, &activeKey); err != nil {
+			return err
+		}
+		activeKey.Threshold = legacyThreshold(activeKey.NumberOfValidators)
+		if err = setKey(store, cdc, types.ActivePubKeyPrefix, &activeKey); err != nil {
+			return err
+		}
+	}
+
+	queuedKeyBytes, err := store.Get(types.KeyPrefix(types.QueuedPubKeyPrefix))
+	if err != nil {
+		return err
+	}
+	if queuedKeyBytes != nil {
+		var queuedKey types.QueuedPubKey
+		if err = cdc.Unmarshal(queuedKeyBytes, &queuedKey); err != nil {
+			return err
+		}
+		queuedKey.Threshold = legacyThreshold(queuedKey.NumberOfValidators)
+		if err = setKey(store, cdc, types.QueuedPubKeyPrefix, &queuedKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func legacyThreshold(numberOfValidators uint64) uint64 {
+	return uint64(math.LegacyNewDecFromInt(
+		math.NewInt(legacyThresholdNumerator)).Quo(
+		math.LegacyNewDecFromInt(math.NewInt(legacyThresholdDenominator))).MulInt64(
+		int64(numberOfValidators)).Ceil().TruncateInt64())
+}
+
+func setKey(store store.KVStore, cdc codec.BinaryCodec, keyPrefix string, key codec.ProtoMarshaler) error {
+	bz, err := cdc.Marshal(key)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.KeyPrefix(keyPrefix), bz)
+}
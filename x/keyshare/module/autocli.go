@@ -82,6 +82,55 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "show-commitments",
 					Short:     "Show the active & queued commitments",
 				},
+				{
+					RpcMethod: "OldestRetainedHeight",
+					Use:       "oldest-retained-height",
+					Short:     "Show the oldest height still retained for keyshares and aggregated keyshares",
+				},
+				{
+					RpcMethod: "KeyshareParticipation",
+					Use:       "keyshare-participation",
+					Short:     "Show the keyshare stake weighted participation for a block height (--height) or a general identity (--identity)",
+				},
+				{
+					RpcMethod:      "DkgRound",
+					Use:            "show-dkg-round [id]",
+					Short:          "Show a dkg round with its deals and complaints, id 0 shows the latest round",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "KeyshareSigningInfoAll",
+					Use:       "list-keyshare-signing-info",
+					Short:     "List the keyshare liveness info of all validators",
+				},
+				{
+					RpcMethod:      "KeyshareSigningInfo",
+					Use:            "show-keyshare-signing-info [validator]",
+					Short:          "Show the keyshare liveness info of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod: "KeyshareEvidenceAll",
+					Use:       "list-keyshare-evidence",
+					Short:     "List the evidence of validators punished for conflicting keyshares",
+				},
+				{
+					RpcMethod:      "KeyshareEvidence",
+					Use:            "show-keyshare-evidence [validator] [identity]",
+					Short:          "Show the conflicting keyshares evidence of a validator for an identity",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}, {ProtoField: "identity"}},
+				},
+				{
+					RpcMethod:      "KeyshareRewards",
+					Use:            "keyshare-rewards [validator]",
+					Short:          "Show the unclaimed keyshare rewards of a validator and its keyshares in the current epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod: "KeyshareRewardPool",
+					Use:       "keyshare-reward-pool",
+					Short:     "Show the keyshare reward pool paid out at the end of the current epoch",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
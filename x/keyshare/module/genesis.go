@@ -39,6 +39,40 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	k.SetRequestCount(ctx, genState.RequestCount)
 
+	// Set the keyshare counts of the current reward epoch and the unclaimed rewards,
+	// the reward pool is the module balance minus the unclaimed rewards
+	for _, elem := range genState.KeyshareRewardCountList {
+		k.SetKeyshareRewardCount(ctx, elem)
+	}
+	for _, elem := range genState.KeyshareRewardList {
+		k.SetKeyshareReward(ctx, elem)
+	}
+
+	// Set the keyshare liveness of the validators
+	for _, elem := range genState.KeyshareSigningInfoList {
+		k.SetKeyshareSigningInfo(ctx, elem)
+	}
+	for _, elem := range genState.MissedKeysharesBitmapList {
+		k.SetMissedKeysharesBitmap(ctx, elem.Validator, elem.Bitmap)
+	}
+	// Set all the handled keyshare evidence, so the same equivocation can not be slashed twice
+	for _, elem := range genState.KeyshareEvidenceList {
+		k.SetKeyshareEvidence(ctx, elem)
+	}
+
+	// Set all the dkg rounds with their deals and complaints, a round in progress
+	// continues from its current phase after the import
+	for _, elem := range genState.DkgRoundList {
+		k.SetDkgRound(ctx, elem)
+	}
+	k.SetDkgRoundCount(ctx, genState.DkgRoundCount)
+	for _, elem := range genState.DkgDealList {
+		k.SetDkgDeal(ctx, elem)
+	}
+	for _, elem := range genState.DkgComplaintList {
+		k.SetDkgComplaint(ctx, elem)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -77,6 +111,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.AuthorizedAddressList = k.GetAllAuthorizedAddress(ctx)
 	genesis.GeneralKeyShareList = k.GetAllGeneralKeyShare(ctx)
+	genesis.KeyshareRewardCountList = k.GetAllKeyshareRewardCount(ctx)
+	genesis.KeyshareRewardList = k.GetAllKeyshareReward(ctx)
+	genesis.KeyshareSigningInfoList = k.GetAllKeyshareSigningInfo(ctx)
+	genesis.MissedKeysharesBitmapList = k.GetAllMissedKeysharesBitmap(ctx)
+	genesis.KeyshareEvidenceList = k.GetAllKeyshareEvidence(ctx)
+
+	genesis.DkgRoundList = k.GetAllDkgRound(ctx)
+	genesis.DkgRoundCount = k.GetDkgRoundCount(ctx)
+	for _, round := range genesis.DkgRoundList {
+		genesis.DkgDealList = append(genesis.DkgDealList, k.GetAllDkgDeal(ctx, round.Id)...)
+		genesis.DkgComplaintList = append(genesis.DkgComplaintList, k.GetAllDkgComplaint(ctx, round.Id)...)
+	}
 	// this line is used by starport scaffolding # genesis/module/export
 
 	genesis.RequestCount, _ = strconv.ParseUint(k.GetRequestCount(ctx), 10, 64)
@@ -8,6 +8,7 @@ import (
 	keyshare "github.com/Fairblock/fairyring/x/keyshare/module"
 	"github.com/Fairblock/fairyring/x/keyshare/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,10 +24,118 @@ func TestGenesis(t *testing.T) {
 				Height: 1,
 			},
 		},
+		KeyshareRewardCountList: []types.KeyshareRewardCount{
+			{
+				Validator: "0",
+				Count:     1,
+			},
+			{
+				Validator: "1",
+				Count:     2,
+			},
+		},
+		KeyshareRewardList: []types.KeyshareReward{
+			{
+				Validator: "0",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("ufairy", 10)),
+			},
+			{
+				Validator: "1",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("ufairy", 20)),
+			},
+		},
+		KeyshareSigningInfoList: []types.KeyshareSigningInfo{
+			{
+				Validator:              "0",
+				StartHeight:            1,
+				IndexOffset:            3,
+				MissedKeysharesCounter: 1,
+			},
+			{
+				Validator:   "1",
+				Jailed:      true,
+				JailedUntil: 10,
+			},
+		},
+		MissedKeysharesBitmapList: []types.MissedKeysharesBitmap{
+			{
+				Validator: "0",
+				Bitmap:    []byte{0b100, 0},
+			},
+			{
+				Validator: "1",
+				Bitmap:    []byte{0, 0},
+			},
+		},
+		KeyshareEvidenceList: []types.KeyshareEvidence{
+			{
+				Validator: "0",
+				Identity:  "10",
+				First: &types.SignedKeyshare{
+					Keyshare:      "a",
+					KeyShareIndex: 1,
+					Submitter:     "0",
+				},
+				Second: &types.SignedKeyshare{
+					Keyshare:      "b",
+					KeyShareIndex: 1,
+					Submitter:     "0",
+				},
+				Height: 11,
+			},
+			{
+				Validator: "1",
+				Identity:  "10",
+				Reporter:  "2",
+				Height:    12,
+			},
+		},
+		DkgRoundList: []types.DkgRound{
+			{
+				Id:           1,
+				Participants: []string{"0", "1"},
+				Threshold:    2,
+				Status:       types.DkgRoundStatusFinalized,
+				Qualified:    []string{"0", "1"},
+			},
+			{
+				Id:           2,
+				Participants: []string{"0", "1"},
+				Threshold:    2,
+				Status:       types.DkgRoundStatusInProgress,
+				Resharing:    true,
+			},
+		},
+		DkgRoundCount: 2,
+		DkgDealList: []types.DkgDeal{
+			{
+				RoundId:     1,
+				Dealer:      "0",
+				Commitments: []string{"a"},
+			},
+			{
+				RoundId:     2,
+				Dealer:      "1",
+				Commitments: []string{"b"},
+			},
+		},
+		DkgComplaintList: []types.DkgComplaint{
+			{
+				RoundId:    1,
+				Dealer:     "0",
+				Complainer: "1",
+				Justified:  true,
+			},
+			{
+				RoundId:    2,
+				Dealer:     "1",
+				Complainer: "0",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
-	k, ctx := keepertest.KeyshareKeeper(t)
+	k, ctx, _, _ := keepertest.KeyshareKeeper(t)
 	keyshare.InitGenesis(ctx, k, genesisState)
 	got := keyshare.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
@@ -37,5 +146,14 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PortId, got.PortId)
 
 	require.ElementsMatch(t, genesisState.AggregatedKeyShareList, got.AggregatedKeyShareList)
+	require.ElementsMatch(t, genesisState.KeyshareRewardCountList, got.KeyshareRewardCountList)
+	require.ElementsMatch(t, genesisState.KeyshareRewardList, got.KeyshareRewardList)
+	require.ElementsMatch(t, genesisState.KeyshareSigningInfoList, got.KeyshareSigningInfoList)
+	require.ElementsMatch(t, genesisState.MissedKeysharesBitmapList, got.MissedKeysharesBitmapList)
+	require.ElementsMatch(t, genesisState.KeyshareEvidenceList, got.KeyshareEvidenceList)
+	require.ElementsMatch(t, genesisState.DkgRoundList, got.DkgRoundList)
+	require.Equal(t, genesisState.DkgRoundCount, got.DkgRoundCount)
+	require.ElementsMatch(t, genesisState.DkgDealList, got.DkgDealList)
+	require.ElementsMatch(t, genesisState.DkgComplaintList, got.DkgComplaintList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -2,13 +2,10 @@ package keyshare
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"strconv"
 
 	commontypes "github.com/Fairblock/fairyring/x/common/types"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -40,7 +37,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/keyshare module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -139,6 +136,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -253,51 +253,12 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 func (am AppModule) EndBlock(cctx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(cctx)
 
-	validators := am.keeper.GetAllValidatorSet(ctx)
-	params := am.keeper.GetParams(ctx)
-
-	for _, eachValidator := range validators {
-		lastSubmittedHeight := am.keeper.GetLastSubmittedHeight(ctx, eachValidator.Validator)
-		am.keeper.Logger().Info(fmt.Sprintf("Last submitted: %s: %d", eachValidator.Validator, lastSubmittedHeight))
-		// Validator will be slashed if their last submitted height is N block ago
-		// Lets say N is 10, and last submitted height is 0, current height is 10
-		// then he/she will be slashed
-		if lastSubmittedHeight+params.GetMaxIdledBlock() > uint64(ctx.BlockHeight()) {
-			continue
-		}
-
-		savedConsAddrByte, err := hex.DecodeString(eachValidator.ConsAddr)
-		if err != nil {
-			am.keeper.Logger().Error(fmt.Sprintf("Error while decoding validator %s cons addr: %s", eachValidator.Validator, err.Error()))
-			continue
-		}
-
-		var consAddr sdk.ConsAddress
-		err = consAddr.Unmarshal(savedConsAddrByte)
-		if err != nil {
-			am.keeper.Logger().Error(fmt.Sprintf("Error while unmarshaling validator %s cons addr: %s", eachValidator.Validator, err.Error()))
-			continue
-		}
-
-		if val, found := am.keeper.GetActivePubKey(ctx); !found || len(val.PublicKey) == 0 {
-			// Not slashing validator if there is no active public key
-			am.keeper.SetLastSubmittedHeight(ctx, eachValidator.Validator, strconv.FormatInt(ctx.BlockHeight(), 10))
-			continue
-		}
+	am.keeper.PruneHistoricalData(ctx)
+	am.keeper.AggregatePendingKeyshares(ctx)
+	am.keeper.ProcessDkgRound(ctx)
 
-		am.keeper.SlashingKeeper().Slash(
-			ctx,
-			consAddr,
-			params.SlashFractionNoKeyshare,
-			types.SlashPower,
-			ctx.BlockHeight()-1,
-		)
-
-		// After being slashed, his/her last submitted height will be set to the current block
-		// So he/she won't be slashed in the next block instead he/she will be slashed if he didn't submit for N block again.
-		am.keeper.SetLastSubmittedHeight(ctx, eachValidator.Validator, strconv.FormatInt(ctx.BlockHeight(), 10))
-		telemetry.IncrCounter(1, types.KeyTotalIdleValSlashed)
-	}
+	am.keeper.ProcessKeyshareLiveness(ctx)
+	am.keeper.DistributeKeyshareRewards(ctx)
 
 	return nil
 }
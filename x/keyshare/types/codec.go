@@ -39,6 +39,25 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitEncryptedKeyshare{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgStartDkg{},
+		&MsgSubmitDkgDeal{},
+		&MsgSubmitDkgComplaint{},
+		&MsgSubmitDkgJustification{},
+		&MsgStartResharing{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnjail{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitKeyshareEvidence{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimKeyshareRewards{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendKeyshareBatch{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,1674 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/dkg.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DkgRound is an on-chain distributed key generation round among the registered validators,
+// the round goes through the deal, complaint and justification phases and is finalized
+// in the first block after the justification phase ended.
+// A resharing round moves the shares of the active key from its holders to the participants,
+// keeping the same public key.
+type DkgRound struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// registered validators taking part in the round, the keyshare index of a participant is its position + 1
+	Participants []string `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	// number of keyshares required to aggregate the generated key
+	Threshold   uint64 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StartHeight uint64 `protobuf:"varint,5,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	// last block height deals are accepted
+	DealEndHeight uint64 `protobuf:"varint,6,opt,name=dealEndHeight,proto3" json:"dealEndHeight,omitempty"`
+	// last block height complaints are accepted
+	ComplaintEndHeight uint64 `protobuf:"varint,7,opt,name=complaintEndHeight,proto3" json:"complaintEndHeight,omitempty"`
+	// last block height justifications are accepted
+	JustificationEndHeight uint64 `protobuf:"varint,8,opt,name=justificationEndHeight,proto3" json:"justificationEndHeight,omitempty"`
+	Status                 string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// dealers whose deals are part of the generated key, set when the round is finalized
+	Qualified []string `protobuf:"bytes,10,rep,name=qualified,proto3" json:"qualified,omitempty"`
+	// generated public key, set when the round is finalized
+	PublicKey  string `protobuf:"bytes,11,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	FailReason string `protobuf:"bytes,12,opt,name=failReason,proto3" json:"failReason,omitempty"`
+	Resharing  bool   `protobuf:"varint,13,opt,name=resharing,proto3" json:"resharing,omitempty"`
+	// addresses dealing in the round, the participants for a dkg round and the active key share holders
+	// for a resharing round, the index of a dealer in the active key is its position + 1
+	Dealers []string `protobuf:"bytes,14,rep,name=dealers,proto3" json:"dealers,omitempty"`
+	// threshold of the reshared active key
+	PreviousThreshold uint64 `protobuf:"varint,15,opt,name=previousThreshold,proto3" json:"previousThreshold,omitempty"`
+}
+
+func (m *DkgRound) Reset()         { *m = DkgRound{} }
+func (m *DkgRound) String() string { return proto.CompactTextString(m) }
+func (*DkgRound) ProtoMessage()    {}
+func (*DkgRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{0}
+}
+func (m *DkgRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgRound.Merge(m, src)
+}
+func (m *DkgRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgRound proto.InternalMessageInfo
+
+func (m *DkgRound) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DkgRound) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DkgRound) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *DkgRound) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DkgRound) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetDealEndHeight() uint64 {
+	if m != nil {
+		return m.DealEndHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetComplaintEndHeight() uint64 {
+	if m != nil {
+		return m.ComplaintEndHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetJustificationEndHeight() uint64 {
+	if m != nil {
+		return m.JustificationEndHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DkgRound) GetQualified() []string {
+	if m != nil {
+		return m.Qualified
+	}
+	return nil
+}
+
+func (m *DkgRound) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *DkgRound) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *DkgRound) GetResharing() bool {
+	if m != nil {
+		return m.Resharing
+	}
+	return false
+}
+
+func (m *DkgRound) GetDealers() []string {
+	if m != nil {
+		return m.Dealers
+	}
+	return nil
+}
+
+func (m *DkgRound) GetPreviousThreshold() uint64 {
+	if m != nil {
+		return m.PreviousThreshold
+	}
+	return 0
+}
+
+// DkgDeal is the Feldman VSS deal of a participant
+type DkgDeal struct {
+	RoundId uint64 `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Dealer  string `protobuf:"bytes,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// hex encoded G1 commitments of the dealer polynomial coefficients, starting with the constant term,
+	// which is the commitment of the dealer active keyshare in a resharing round
+	Commitments []string `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// shares of the dealer polynomial encrypted to each participant, in participant order
+	EncryptedShares []*EncryptedKeyShare `protobuf:"bytes,4,rep,name=encryptedShares,proto3" json:"encryptedShares,omitempty"`
+	Disqualified    bool                 `protobuf:"varint,5,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+}
+
+func (m *DkgDeal) Reset()         { *m = DkgDeal{} }
+func (m *DkgDeal) String() string { return proto.CompactTextString(m) }
+func (*DkgDeal) ProtoMessage()    {}
+func (*DkgDeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{1}
+}
+func (m *DkgDeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgDeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgDeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgDeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgDeal.Merge(m, src)
+}
+func (m *DkgDeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgDeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgDeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgDeal proto.InternalMessageInfo
+
+func (m *DkgDeal) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *DkgDeal) GetDealer() string {
+	if m != nil {
+		return m.Dealer
+	}
+	return ""
+}
+
+func (m *DkgDeal) GetCommitments() []string {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *DkgDeal) GetEncryptedShares() []*EncryptedKeyShare {
+	if m != nil {
+		return m.EncryptedShares
+	}
+	return nil
+}
+
+func (m *DkgDeal) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
+// DkgComplaint is filed by a participant against a dealer whose share does not match the deal commitments
+type DkgComplaint struct {
+	RoundId    uint64 `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Dealer     string `protobuf:"bytes,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	Complainer string `protobuf:"bytes,3,opt,name=complainer,proto3" json:"complainer,omitempty"`
+	Justified  bool   `protobuf:"varint,4,opt,name=justified,proto3" json:"justified,omitempty"`
+	// hex encoded share revealed by the dealer to answer the complaint
+	RevealedShare string `protobuf:"bytes,5,opt,name=revealedShare,proto3" json:"revealedShare,omitempty"`
+}
+
+func (m *DkgComplaint) Reset()         { *m = DkgComplaint{} }
+func (m *DkgComplaint) String() string { return proto.CompactTextString(m) }
+func (*DkgComplaint) ProtoMessage()    {}
+func (*DkgComplaint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{2}
+}
+func (m *DkgComplaint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgComplaint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgComplaint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgComplaint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgComplaint.Merge(m, src)
+}
+func (m *DkgComplaint) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgComplaint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgComplaint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgComplaint proto.InternalMessageInfo
+
+func (m *DkgComplaint) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *DkgComplaint) GetDealer() string {
+	if m != nil {
+		return m.Dealer
+	}
+	return ""
+}
+
+func (m *DkgComplaint) GetComplainer() string {
+	if m != nil {
+		return m.Complainer
+	}
+	return ""
+}
+
+func (m *DkgComplaint) GetJustified() bool {
+	if m != nil {
+		return m.Justified
+	}
+	return false
+}
+
+func (m *DkgComplaint) GetRevealedShare() string {
+	if m != nil {
+		return m.RevealedShare
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DkgRound)(nil), "fairyring.keyshare.DkgRound")
+	proto.RegisterType((*DkgDeal)(nil), "fairyring.keyshare.DkgDeal")
+	proto.RegisterType((*DkgComplaint)(nil), "fairyring.keyshare.DkgComplaint")
+}
+
+func init() { proto.RegisterFile("fairyring/keyshare/dkg.proto", fileDescriptor_2eb681380d9b9683) }
+
+var fileDescriptor_2eb681380d9b9683 = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x24, 0xcd, 0x9f, 0x4d, 0xda, 0x8a, 0x3d, 0x54, 0x2b, 0x54, 0x59, 0x56, 0x04,
+	0x52, 0x0e, 0xc8, 0x91, 0x40, 0xe2, 0x01, 0x20, 0x45, 0x54, 0x3d, 0x20, 0x19, 0x4e, 0x5c, 0xd0,
+	0xc6, 0x3b, 0x71, 0x16, 0x3b, 0x5e, 0xb3, 0xbb, 0xae, 0xf0, 0x8d, 0x47, 0xe0, 0x21, 0x78, 0x18,
+	0x8e, 0x15, 0x27, 0x8e, 0x28, 0x79, 0x11, 0xb4, 0x1b, 0xbb, 0x9b, 0xd0, 0x72, 0xe9, 0x71, 0x7e,
+	0xdf, 0xcc, 0xd8, 0x33, 0xf3, 0x2d, 0x3a, 0x5f, 0x52, 0x2e, 0x2b, 0xc9, 0xf3, 0x64, 0x96, 0x42,
+	0xa5, 0x56, 0x54, 0xc2, 0x8c, 0xa5, 0x49, 0x58, 0x48, 0xa1, 0x05, 0xc6, 0xb7, 0x6a, 0xd8, 0xa8,
+	0x8f, 0x83, 0x7b, 0x2a, 0x8a, 0x72, 0xf1, 0x29, 0x85, 0x6a, 0x57, 0x35, 0xf9, 0xd6, 0x45, 0x83,
+	0x79, 0x9a, 0x44, 0xa2, 0xcc, 0x19, 0x3e, 0x41, 0x6d, 0xce, 0x88, 0x17, 0x78, 0xd3, 0x6e, 0xd4,
+	0xe6, 0x0c, 0x13, 0xd4, 0x8f, 0x25, 0x50, 0x2d, 0x24, 0x69, 0x07, 0xde, 0x74, 0x18, 0x35, 0x21,
+	0x9e, 0xa0, 0x71, 0x41, 0xa5, 0xe6, 0x31, 0x2f, 0x68, 0xae, 0x15, 0xe9, 0x04, 0x9d, 0xe9, 0x30,
+	0x3a, 0x60, 0xf8, 0x1c, 0x0d, 0xf5, 0x4a, 0x82, 0x5a, 0x89, 0x8c, 0x91, 0xae, 0x6d, 0xea, 0x00,
+	0x0e, 0xd0, 0x48, 0x69, 0x2a, 0xf5, 0x5b, 0xe0, 0xc9, 0x4a, 0x93, 0x23, 0xab, 0xef, 0x23, 0xfc,
+	0x04, 0x1d, 0x33, 0xa0, 0xd9, 0x45, 0xce, 0xea, 0x9c, 0x9e, 0xcd, 0x39, 0x84, 0x38, 0x44, 0x38,
+	0x16, 0xeb, 0x22, 0xa3, 0x3c, 0xd7, 0x2e, 0xb5, 0x6f, 0x53, 0xef, 0x51, 0xf0, 0x4b, 0x74, 0xf6,
+	0xb9, 0x54, 0x9a, 0x2f, 0x79, 0x4c, 0x35, 0x17, 0xb9, 0xab, 0x19, 0xd8, 0x9a, 0xff, 0xa8, 0xf8,
+	0x0c, 0xf5, 0x94, 0xa6, 0xba, 0x54, 0x64, 0x68, 0x57, 0x51, 0x47, 0x66, 0xca, 0x2f, 0x25, 0xcd,
+	0xf8, 0x92, 0x03, 0x23, 0xc8, 0xae, 0xc1, 0x01, 0xa3, 0x16, 0xe5, 0x22, 0xe3, 0xf1, 0x15, 0x54,
+	0x64, 0x64, 0x0b, 0x1d, 0xc0, 0x3e, 0x42, 0x4b, 0xca, 0xb3, 0x08, 0xa8, 0x12, 0x39, 0x19, 0x5b,
+	0x79, 0x8f, 0x98, 0x6a, 0xb3, 0x2e, 0x6a, 0x0e, 0x48, 0x8e, 0x03, 0x6f, 0x3a, 0x88, 0x1c, 0x30,
+	0xd7, 0x31, 0xab, 0x00, 0xa9, 0xc8, 0x89, 0xfd, 0x6e, 0x13, 0xe2, 0x67, 0xe8, 0x51, 0x21, 0xe1,
+	0x9a, 0x8b, 0x52, 0x7d, 0xb8, 0xbd, 0xc0, 0xa9, 0x1d, 0xef, 0xae, 0x30, 0xf9, 0xe5, 0xa1, 0xfe,
+	0x3c, 0x4d, 0xe6, 0x40, 0x33, 0xd3, 0x53, 0x1a, 0x2b, 0x5c, 0x36, 0x36, 0x68, 0x42, 0x33, 0xff,
+	0xae, 0x7d, 0x6d, 0x85, 0x3a, 0x32, 0x77, 0x8c, 0xc5, 0x7a, 0xcd, 0xf5, 0x1a, 0x9c, 0x11, 0xf6,
+	0x11, 0x7e, 0x87, 0x4e, 0x21, 0x8f, 0x65, 0x55, 0x68, 0x60, 0xef, 0x8d, 0x05, 0x15, 0xe9, 0x06,
+	0x9d, 0xe9, 0xe8, 0xf9, 0xd3, 0xf0, 0xae, 0x65, 0xc3, 0x8b, 0x26, 0xf5, 0x0a, 0x2a, 0x9b, 0x1d,
+	0xfd, 0x5b, 0x6d, 0xcc, 0xc7, 0xb8, 0x72, 0x5b, 0x3f, 0xb2, 0x9b, 0x39, 0x60, 0x93, 0x1f, 0x1e,
+	0x1a, 0xcf, 0xd3, 0xe4, 0x75, 0x63, 0x80, 0x07, 0x4c, 0xe6, 0x23, 0xd4, 0xf8, 0x07, 0x24, 0xe9,
+	0xec, 0xae, 0xe3, 0x88, 0xb9, 0x4e, 0xed, 0x15, 0xd8, 0xf9, 0x7b, 0x10, 0x39, 0x60, 0xdc, 0x2b,
+	0xe1, 0xda, 0x74, 0xda, 0xfd, 0xb6, 0xfd, 0xcb, 0x61, 0x74, 0x08, 0x5f, 0x5d, 0xfe, 0xdc, 0xf8,
+	0xde, 0xcd, 0xc6, 0xf7, 0xfe, 0x6c, 0x7c, 0xef, 0xfb, 0xd6, 0x6f, 0xdd, 0x6c, 0xfd, 0xd6, 0xef,
+	0xad, 0xdf, 0xfa, 0x38, 0x4b, 0xb8, 0x5e, 0x95, 0x8b, 0x30, 0x16, 0xeb, 0xd9, 0x1b, 0xca, 0xe5,
+	0x22, 0x13, 0x71, 0x3a, 0x73, 0xef, 0xf9, 0xab, 0x7b, 0xd1, 0xba, 0x2a, 0x40, 0x2d, 0x7a, 0xf6,
+	0x41, 0xbf, 0xf8, 0x3b, 0x00, 0xb5, 0x83, 0x5d, 0x6b, 0x26, 0x04, 0x00, 0x00,
+}
+
+func (m *DkgRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousThreshold != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.PreviousThreshold))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Dealers) > 0 {
+		for iNdEx := len(m.Dealers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dealers[iNdEx])
+			copy(dAtA[i:], m.Dealers[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Dealers[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Resharing {
+		i--
+		if m.Resharing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.FailReason) > 0 {
+		i -= len(m.FailReason)
+		copy(dAtA[i:], m.FailReason)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.FailReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Qualified) > 0 {
+		for iNdEx := len(m.Qualified) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Qualified[iNdEx])
+			copy(dAtA[i:], m.Qualified[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Qualified[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.JustificationEndHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.JustificationEndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ComplaintEndHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.ComplaintEndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DealEndHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.DealEndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgDeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgDeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgDeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disqualified {
+		i--
+		if m.Disqualified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EncryptedShares) > 0 {
+		for iNdEx := len(m.EncryptedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDkg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgComplaint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgComplaint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgComplaint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevealedShare) > 0 {
+		i -= len(m.RevealedShare)
+		copy(dAtA[i:], m.RevealedShare)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.RevealedShare)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Justified {
+		i--
+		if m.Justified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Complainer) > 0 {
+		i -= len(m.Complainer)
+		copy(dAtA[i:], m.Complainer)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Complainer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDkg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDkg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DkgRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDkg(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovDkg(uint64(m.Threshold))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDkg(uint64(m.StartHeight))
+	}
+	if m.DealEndHeight != 0 {
+		n += 1 + sovDkg(uint64(m.DealEndHeight))
+	}
+	if m.ComplaintEndHeight != 0 {
+		n += 1 + sovDkg(uint64(m.ComplaintEndHeight))
+	}
+	if m.JustificationEndHeight != 0 {
+		n += 1 + sovDkg(uint64(m.JustificationEndHeight))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.Qualified) > 0 {
+		for _, s := range m.Qualified {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	l = len(m.FailReason)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if m.Resharing {
+		n += 2
+	}
+	if len(m.Dealers) > 0 {
+		for _, s := range m.Dealers {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if m.PreviousThreshold != 0 {
+		n += 1 + sovDkg(uint64(m.PreviousThreshold))
+	}
+	return n
+}
+
+func (m *DkgDeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoundId != 0 {
+		n += 1 + sovDkg(uint64(m.RoundId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, s := range m.Commitments {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if len(m.EncryptedShares) > 0 {
+		for _, e := range m.EncryptedShares {
+			l = e.Size()
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if m.Disqualified {
+		n += 2
+	}
+	return n
+}
+
+func (m *DkgComplaint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoundId != 0 {
+		n += 1 + sovDkg(uint64(m.RoundId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	l = len(m.Complainer)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if m.Justified {
+		n += 2
+	}
+	l = len(m.RevealedShare)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	return n
+}
+
+func sovDkg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDkg(x uint64) (n int) {
+	return sovDkg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DkgRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealEndHeight", wireType)
+			}
+			m.DealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplaintEndHeight", wireType)
+			}
+			m.ComplaintEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComplaintEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustificationEndHeight", wireType)
+			}
+			m.JustificationEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustificationEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qualified", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Qualified = append(m.Qualified, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resharing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resharing = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealers = append(m.Dealers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousThreshold", wireType)
+			}
+			m.PreviousThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgDeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgDeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgDeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedShares = append(m.EncryptedShares, &EncryptedKeyShare{})
+			if err := m.EncryptedShares[len(m.EncryptedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disqualified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disqualified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgComplaint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgComplaint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgComplaint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complainer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Complainer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Justified = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevealedShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDkg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDkg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDkg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDkg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDkg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDkg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDkg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -38,6 +38,31 @@ var (
 	ErrNotMatchNumOfCommits            = sdkerrors.Register(ModuleName, 1128, "provided number of commitments does not match number of validators")
 	ErrNotMatchNumOfEncryptedKeyShares = sdkerrors.Register(ModuleName, 1129, "provided number of commitments does not match number of encrypted key shares")
 	ErrInvalidEncryptedShareData       = sdkerrors.Register(ModuleName, 1130, "invalid encrypted share data")
+	ErrInvalidThreshold                = sdkerrors.Register(ModuleName, 1131, "invalid threshold")
+	ErrThresholdBelowMinimum           = sdkerrors.Register(ModuleName, 1132, "threshold is below the minimum threshold ratio")
+	ErrCommitmentsNotMatchThreshold    = sdkerrors.Register(ModuleName, 1133, "commitments do not match the public key with the given threshold")
+	ErrDkgRoundInProgress              = sdkerrors.Register(ModuleName, 1134, "a dkg round is already in progress")
+	ErrDkgRoundNotFound                = sdkerrors.Register(ModuleName, 1135, "dkg round not found")
+	ErrNotDkgParticipant               = sdkerrors.Register(ModuleName, 1136, "address is not a participant of the dkg round")
+	ErrInvalidDkgPhase                 = sdkerrors.Register(ModuleName, 1137, "dkg round is not in the required phase")
+	ErrDkgDealAlreadyExists            = sdkerrors.Register(ModuleName, 1138, "dealer already submitted a deal for the dkg round")
+	ErrDkgDealNotFound                 = sdkerrors.Register(ModuleName, 1139, "dkg deal not found")
+	ErrInvalidDkgDeal                  = sdkerrors.Register(ModuleName, 1140, "invalid dkg deal")
+	ErrDkgComplaintAlreadyExists       = sdkerrors.Register(ModuleName, 1141, "complaint against the dealer already submitted")
+	ErrDkgComplaintNotFound            = sdkerrors.Register(ModuleName, 1142, "dkg complaint not found")
+	ErrNotEnoughDkgParticipants        = sdkerrors.Register(ModuleName, 1143, "not enough registered validators to run a dkg round")
+	ErrValidatorJailed                 = sdkerrors.Register(ModuleName, 1144, "validator is jailed from the keyshare set")
+	ErrValidatorNotJailed              = sdkerrors.Register(ModuleName, 1145, "validator is not jailed from the keyshare set")
+	ErrValidatorStillJailed            = sdkerrors.Register(ModuleName, 1146, "validator is still in its jail period")
+	ErrSigningInfoNotFound             = sdkerrors.Register(ModuleName, 1147, "keyshare signing info not found")
+	ErrInvalidKeyshareEvidence         = sdkerrors.Register(ModuleName, 1148, "invalid keyshare evidence")
+	ErrKeyshareEvidenceAlreadyExists   = sdkerrors.Register(ModuleName, 1149, "keyshare evidence already exists for the validator and identity")
+	ErrKeyshareEvidenceNotFound        = sdkerrors.Register(ModuleName, 1150, "keyshare evidence not found")
+	ErrInvalidKeyshareSignature        = sdkerrors.Register(ModuleName, 1151, "invalid keyshare signature")
+	ErrNoKeyshareRewards               = sdkerrors.Register(ModuleName, 1152, "no keyshare rewards to claim")
+	ErrInvalidKeyshareBatch            = sdkerrors.Register(ModuleName, 1153, "invalid keyshare batch")
+	ErrInvalidVoteExtension            = sdkerrors.Register(ModuleName, 1154, "invalid keyshare vote extension")
+	ErrInvalidKeyshareInjection        = sdkerrors.Register(ModuleName, 1155, "invalid aggregated keyshare injection")
 	ErrAddressAlreadyAuthorized        = sdkerrors.Register(ModuleName, 1900, "address is already authorized")
 	ErrAuthorizedAddrNotFound          = sdkerrors.Register(ModuleName, 1901, "target authorized address not found")
 	ErrNotAuthorizedAddrCreator        = sdkerrors.Register(ModuleName, 1902, "sender is not the creator of target authorized address")
@@ -30,6 +30,10 @@ type GeneralKeyShare struct {
 	KeyShareIndex       uint64 `protobuf:"varint,5,opt,name=keyShareIndex,proto3" json:"keyShareIndex,omitempty"`
 	ReceivedTimestamp   uint64 `protobuf:"varint,6,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
 	ReceivedBlockHeight uint64 `protobuf:"varint,7,opt,name=receivedBlockHeight,proto3" json:"receivedBlockHeight,omitempty"`
+	// consensus power of the validator when the keyshare was submitted
+	Power int64 `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
+	// active public key the keyshare was verified for when it was submitted
+	PubKey string `protobuf:"bytes,9,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 }
 
 func (m *GeneralKeyShare) Reset()         { *m = GeneralKeyShare{} }
@@ -114,6 +118,20 @@ func (m *GeneralKeyShare) GetReceivedBlockHeight() uint64 {
 	return 0
 }
 
+func (m *GeneralKeyShare) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *GeneralKeyShare) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
 type ValidatorEncryptedKeyShare struct {
 	Validator           string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Requester           string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
@@ -122,6 +140,8 @@ type ValidatorEncryptedKeyShare struct {
 	ReceivedTimestamp   uint64 `protobuf:"varint,5,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
 	ReceivedBlockHeight uint64 `protobuf:"varint,6,opt,name=receivedBlockHeight,proto3" json:"receivedBlockHeight,omitempty"`
 	Identity            string `protobuf:"bytes,7,opt,name=identity,proto3" json:"identity,omitempty"`
+	// consensus power of the validator when the keyshare was submitted
+	Power int64 `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
 }
 
 func (m *ValidatorEncryptedKeyShare) Reset()         { *m = ValidatorEncryptedKeyShare{} }
@@ -206,6 +226,13 @@ func (m *ValidatorEncryptedKeyShare) GetIdentity() string {
 	return ""
 }
 
+func (m *ValidatorEncryptedKeyShare) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GeneralKeyShare)(nil), "fairyring.keyshare.GeneralKeyShare")
 	proto.RegisterType((*ValidatorEncryptedKeyShare)(nil), "fairyring.keyshare.ValidatorEncryptedKeyShare")
@@ -216,29 +243,31 @@ func init() {
 }
 
 var fileDescriptor_05ce460a69fa2745 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6a, 0xf2, 0x40,
-	0x14, 0xc7, 0x8d, 0x97, 0xf8, 0x65, 0xe0, 0xa3, 0x74, 0x0a, 0x65, 0x10, 0x19, 0x44, 0xba, 0x90,
-	0x52, 0x4c, 0xa1, 0x6f, 0x20, 0xf4, 0x22, 0xdd, 0x59, 0x71, 0xd1, 0x8d, 0x8c, 0x99, 0xd3, 0x38,
-	0x24, 0x26, 0xe9, 0x64, 0x14, 0x67, 0xdd, 0x17, 0xe8, 0xaa, 0xcf, 0xd4, 0xa5, 0xcb, 0x2e, 0x8b,
-	0xbe, 0x48, 0x71, 0x6a, 0x0c, 0xd2, 0x96, 0xda, 0xdd, 0xfc, 0x2f, 0x1c, 0xe6, 0x77, 0x38, 0xe8,
-	0xf4, 0x81, 0x09, 0xa9, 0xa5, 0x88, 0x7c, 0x37, 0x00, 0x9d, 0x8e, 0x99, 0x04, 0xd7, 0x87, 0x08,
-	0x24, 0x0b, 0x87, 0x01, 0xe8, 0xa1, 0x71, 0xda, 0x89, 0x8c, 0x55, 0x8c, 0xf1, 0xb6, 0xdb, 0xce,
-	0xba, 0xcd, 0xa7, 0x22, 0x3a, 0xb8, 0xfe, 0xec, 0xdf, 0x82, 0xbe, 0x5b, 0x7b, 0xb8, 0x8e, 0x9c,
-	0x19, 0x0b, 0x05, 0x67, 0x2a, 0x96, 0xc4, 0x6a, 0x58, 0x2d, 0xa7, 0x97, 0x1b, 0xf8, 0x18, 0xd9,
-	0x82, 0xf7, 0x75, 0x02, 0xa4, 0x68, 0xa2, 0x8d, 0xc2, 0x04, 0x55, 0x05, 0x1f, 0xb0, 0x70, 0x0a,
-	0xa4, 0x64, 0x82, 0x4c, 0xe2, 0x1a, 0xfa, 0x17, 0x6c, 0x66, 0x93, 0xb2, 0x89, 0xb6, 0x1a, 0x9f,
-	0xa0, 0xff, 0xd9, 0xbb, 0x1b, 0x71, 0x98, 0x93, 0x4a, 0xc3, 0x6a, 0x95, 0x7b, 0xbb, 0x26, 0x3e,
-	0x43, 0x87, 0x12, 0x3c, 0x10, 0x33, 0xe0, 0x7d, 0x31, 0x81, 0x54, 0xb1, 0x49, 0x42, 0x6c, 0xd3,
-	0xfc, 0x1a, 0xe0, 0x73, 0x74, 0x94, 0x99, 0x9d, 0x30, 0xf6, 0x82, 0x1b, 0x10, 0xfe, 0x58, 0x91,
-	0xaa, 0xe9, 0x7f, 0x17, 0x35, 0x5f, 0x8a, 0xa8, 0x36, 0xc8, 0x08, 0x2f, 0x23, 0x4f, 0xea, 0x44,
-	0x01, 0xdf, 0x73, 0x21, 0x75, 0xe4, 0x48, 0x78, 0x9c, 0x42, 0xaa, 0x40, 0x6e, 0x76, 0x92, 0x1b,
-	0x3b, 0xf0, 0xa5, 0xdf, 0xe0, 0xcb, 0x7b, 0xc3, 0x57, 0xfe, 0x08, 0x6f, 0xff, 0x08, 0xbf, 0xfe,
-	0xa1, 0xe0, 0x10, 0x29, 0xa1, 0xb4, 0xd9, 0x91, 0xd3, 0xdb, 0xea, 0x4e, 0xf7, 0x75, 0x49, 0xad,
-	0xc5, 0x92, 0x5a, 0xef, 0x4b, 0x6a, 0x3d, 0xaf, 0x68, 0x61, 0xb1, 0xa2, 0x85, 0xb7, 0x15, 0x2d,
-	0xdc, 0xbb, 0xbe, 0x50, 0xe3, 0xe9, 0xa8, 0xed, 0xc5, 0x13, 0xf7, 0x8a, 0x09, 0x39, 0x5a, 0x4f,
-	0x74, 0xf3, 0x6b, 0x9c, 0xe7, 0xf7, 0xa8, 0x74, 0x02, 0xe9, 0xc8, 0x36, 0x47, 0x78, 0xf1, 0x11,
-	0x00, 0x00, 0xff, 0xff, 0xb0, 0x19, 0x6e, 0x0c, 0xb2, 0x02, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6a, 0xe2, 0x40,
+	0x1c, 0xc7, 0x8d, 0x7f, 0xa2, 0x19, 0x58, 0x96, 0x9d, 0x5d, 0x96, 0x41, 0x24, 0x88, 0xec, 0x41,
+	0x96, 0xc5, 0x2c, 0xec, 0x1b, 0x08, 0xbb, 0x5b, 0xf1, 0x96, 0x8a, 0x87, 0x5e, 0x64, 0x92, 0xfc,
+	0x1a, 0x87, 0xc4, 0x24, 0x9d, 0x4c, 0xac, 0xf3, 0x16, 0x7d, 0x90, 0x5e, 0xfa, 0x16, 0x3d, 0x7a,
+	0xec, 0xb1, 0xe8, 0x8b, 0x14, 0x27, 0xc6, 0x20, 0xb5, 0xd4, 0xde, 0xf2, 0xfd, 0x43, 0x86, 0xdf,
+	0x87, 0x2f, 0xfa, 0x79, 0x4d, 0x19, 0x97, 0x9c, 0x45, 0xbe, 0x15, 0x80, 0x4c, 0xe7, 0x94, 0x83,
+	0xe5, 0x43, 0x04, 0x9c, 0x86, 0xb3, 0x00, 0xe4, 0x4c, 0x39, 0x83, 0x84, 0xc7, 0x22, 0xc6, 0xf8,
+	0xd0, 0x1d, 0x14, 0xdd, 0xde, 0x43, 0x15, 0x7d, 0xfe, 0x9f, 0xf7, 0xc7, 0x20, 0x2f, 0x77, 0x1e,
+	0xee, 0x20, 0x63, 0x49, 0x43, 0xe6, 0x51, 0x11, 0x73, 0xa2, 0x75, 0xb5, 0xbe, 0x61, 0x97, 0x06,
+	0xfe, 0x8e, 0x74, 0xe6, 0x4d, 0x64, 0x02, 0xa4, 0xaa, 0xa2, 0xbd, 0xc2, 0x04, 0x35, 0x99, 0x37,
+	0xa5, 0x61, 0x06, 0xa4, 0xa6, 0x82, 0x42, 0xe2, 0x36, 0x6a, 0x05, 0xfb, 0x7f, 0x93, 0xba, 0x8a,
+	0x0e, 0x1a, 0xff, 0x40, 0x9f, 0x8a, 0xef, 0x51, 0xe4, 0xc1, 0x8a, 0x34, 0xba, 0x5a, 0xbf, 0x6e,
+	0x1f, 0x9b, 0xf8, 0x17, 0xfa, 0xc2, 0xc1, 0x05, 0xb6, 0x04, 0x6f, 0xc2, 0x16, 0x90, 0x0a, 0xba,
+	0x48, 0x88, 0xae, 0x9a, 0xaf, 0x03, 0xfc, 0x1b, 0x7d, 0x2d, 0xcc, 0x61, 0x18, 0xbb, 0xc1, 0x05,
+	0x30, 0x7f, 0x2e, 0x48, 0x53, 0xf5, 0x4f, 0x45, 0xf8, 0x1b, 0x6a, 0x24, 0xf1, 0x2d, 0x70, 0xd2,
+	0xea, 0x6a, 0xfd, 0x9a, 0x9d, 0x8b, 0xdd, 0xa5, 0x49, 0xe6, 0x8c, 0x41, 0x12, 0x23, 0xbf, 0x34,
+	0x57, 0xbd, 0xfb, 0x2a, 0x6a, 0x4f, 0x0b, 0x1e, 0x7f, 0x23, 0x97, 0xcb, 0x44, 0x80, 0x77, 0x26,
+	0xbe, 0x0e, 0x32, 0x38, 0xdc, 0x64, 0x90, 0x0a, 0xe0, 0x7b, 0x82, 0xa5, 0x71, 0x84, 0xaa, 0xf6,
+	0x1e, 0xaa, 0xfa, 0xd9, 0xa8, 0x1a, 0x1f, 0x44, 0xa5, 0xbf, 0x8d, 0xaa, 0x8d, 0x5a, 0xcc, 0x83,
+	0x48, 0x30, 0x21, 0x15, 0x51, 0xc3, 0x3e, 0xe8, 0xd3, 0x18, 0x87, 0xa3, 0xc7, 0x8d, 0xa9, 0xad,
+	0x37, 0xa6, 0xf6, 0xbc, 0x31, 0xb5, 0xbb, 0xad, 0x59, 0x59, 0x6f, 0xcd, 0xca, 0xd3, 0xd6, 0xac,
+	0x5c, 0x59, 0x3e, 0x13, 0xf3, 0xcc, 0x19, 0xb8, 0xf1, 0xc2, 0xfa, 0x47, 0x19, 0x77, 0x76, 0xef,
+	0x58, 0xe5, 0xa2, 0x57, 0xe5, 0xa6, 0x85, 0x4c, 0x20, 0x75, 0x74, 0x35, 0xe4, 0x3f, 0x2f, 0x03,
+	0x00, 0x3e, 0x45, 0x7b, 0xfc, 0xf6, 0x02, 0x00, 0x00,
 }
 
 func (m *GeneralKeyShare) Marshal() (dAtA []byte, err error) {
@@ -261,6 +290,18 @@ func (m *GeneralKeyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintGeneralKeyShare(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Power != 0 {
+		i = encodeVarintGeneralKeyShare(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.ReceivedBlockHeight != 0 {
 		i = encodeVarintGeneralKeyShare(dAtA, i, uint64(m.ReceivedBlockHeight))
 		i--
@@ -327,6 +368,11 @@ func (m *ValidatorEncryptedKeyShare) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Power != 0 {
+		i = encodeVarintGeneralKeyShare(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
@@ -415,6 +461,13 @@ func (m *GeneralKeyShare) Size() (n int) {
 	if m.ReceivedBlockHeight != 0 {
 		n += 1 + sovGeneralKeyShare(uint64(m.ReceivedBlockHeight))
 	}
+	if m.Power != 0 {
+		n += 1 + sovGeneralKeyShare(uint64(m.Power))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovGeneralKeyShare(uint64(l))
+	}
 	return n
 }
 
@@ -449,6 +502,9 @@ func (m *ValidatorEncryptedKeyShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGeneralKeyShare(uint64(l))
 	}
+	if m.Power != 0 {
+		n += 1 + sovGeneralKeyShare(uint64(m.Power))
+	}
 	return n
 }
 
@@ -672,6 +728,57 @@ func (m *GeneralKeyShare) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeneralKeyShare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeneralKeyShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGeneralKeyShare(dAtA[iNdEx:])
@@ -907,6 +1014,25 @@ func (m *ValidatorEncryptedKeyShare) Unmarshal(dAtA []byte) error {
 			}
 			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGeneralKeyShare(dAtA[iNdEx:])
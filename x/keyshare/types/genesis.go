@@ -12,12 +12,20 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PortId:                 PortID,
-		AggregatedKeyShareList: []AggregatedKeyShare{},
-		ValidatorSetList:       []ValidatorSet{},
-		KeyShareList:           []KeyShare{},
-		AuthorizedAddressList:  []AuthorizedAddress{},
-		GeneralKeyShareList:    []GeneralKeyShare{},
+		PortId:                    PortID,
+		AggregatedKeyShareList:    []AggregatedKeyShare{},
+		ValidatorSetList:          []ValidatorSet{},
+		KeyShareList:              []KeyShare{},
+		AuthorizedAddressList:     []AuthorizedAddress{},
+		GeneralKeyShareList:       []GeneralKeyShare{},
+		KeyshareRewardCountList:   []KeyshareRewardCount{},
+		KeyshareRewardList:        []KeyshareReward{},
+		KeyshareSigningInfoList:   []KeyshareSigningInfo{},
+		MissedKeysharesBitmapList: []MissedKeysharesBitmap{},
+		KeyshareEvidenceList:      []KeyshareEvidence{},
+		DkgRoundList:              []DkgRound{},
+		DkgDealList:               []DkgDeal{},
+		DkgComplaintList:          []DkgComplaint{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -94,6 +102,100 @@ func (gs GenesisState) Validate() error {
 		}
 		generalKeyShareIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated validator in keyshareRewardCount
+	keyshareRewardCountIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyshareRewardCountList {
+		if _, ok := keyshareRewardCountIndexMap[elem.Validator]; ok {
+			return fmt.Errorf("duplicated validator for keyshareRewardCount")
+		}
+		keyshareRewardCountIndexMap[elem.Validator] = struct{}{}
+	}
+	// Check for duplicated validator and invalid amount in keyshareReward
+	keyshareRewardIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyshareRewardList {
+		if _, ok := keyshareRewardIndexMap[elem.Validator]; ok {
+			return fmt.Errorf("duplicated validator for keyshareReward")
+		}
+		keyshareRewardIndexMap[elem.Validator] = struct{}{}
+
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid keyshare reward of %s: %w", elem.Validator, err)
+		}
+	}
+	// Check for duplicated validator in keyshareSigningInfo
+	keyshareSigningInfoIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyshareSigningInfoList {
+		index := string(KeyshareSigningInfoKey(elem.Validator))
+		if _, ok := keyshareSigningInfoIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for keyshareSigningInfo")
+		}
+		keyshareSigningInfoIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated validator in missedKeysharesBitmap
+	missedKeysharesBitmapIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MissedKeysharesBitmapList {
+		index := string(KeyshareSigningInfoKey(elem.Validator))
+		if _, ok := missedKeysharesBitmapIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for missedKeysharesBitmap")
+		}
+		missedKeysharesBitmapIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in keyshareEvidence
+	keyshareEvidenceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyshareEvidenceList {
+		index := string(KeyshareEvidenceKey(elem.Validator, elem.Identity))
+		if _, ok := keyshareEvidenceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for keyshareEvidence")
+		}
+		keyshareEvidenceIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in dkgRound and rounds after the latest round id
+	dkgRoundIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DkgRoundList {
+		index := string(DkgRoundKey(elem.Id))
+		if _, ok := dkgRoundIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dkgRound")
+		}
+		dkgRoundIndexMap[index] = struct{}{}
+
+		if elem.Id == 0 || elem.Id > gs.DkgRoundCount {
+			return fmt.Errorf("dkgRound id %d should be between 1 and dkgRoundCount %d", elem.Id, gs.DkgRoundCount)
+		}
+	}
+	// Check for duplicated index in dkgDeal and deals of unknown rounds
+	dkgDealIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DkgDealList {
+		index := string(DkgDealKey(elem.RoundId, elem.Dealer))
+		if _, ok := dkgDealIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dkgDeal")
+		}
+		dkgDealIndexMap[index] = struct{}{}
+
+		if _, ok := dkgRoundIndexMap[string(DkgRoundKey(elem.RoundId))]; !ok {
+			return fmt.Errorf("dkgDeal of unknown dkgRound %d", elem.RoundId)
+		}
+	}
+	// Check for duplicated index in dkgComplaint and complaints of unknown rounds
+	dkgComplaintIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DkgComplaintList {
+		index := string(DkgComplaintKey(elem.RoundId, elem.Dealer, elem.Complainer))
+		if _, ok := dkgComplaintIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dkgComplaint")
+		}
+		dkgComplaintIndexMap[index] = struct{}{}
+
+		if _, ok := dkgRoundIndexMap[string(DkgRoundKey(elem.RoundId))]; !ok {
+			return fmt.Errorf("dkgComplaint of unknown dkgRound %d", elem.RoundId)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
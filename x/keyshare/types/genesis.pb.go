@@ -32,12 +32,22 @@ type GenesisState struct {
 	ValidatorSetList []ValidatorSet `protobuf:"bytes,3,rep,name=validatorSetList,proto3" json:"validatorSetList"`
 	KeyShareList     []KeyShare     `protobuf:"bytes,4,rep,name=keyShareList,proto3" json:"keyShareList"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	AggregatedKeyShareList []AggregatedKeyShare `protobuf:"bytes,5,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
-	ActivePubKey           ActivePubKey         `protobuf:"bytes,6,opt,name=activePubKey,proto3" json:"activePubKey"`
-	QueuedPubKey           QueuedPubKey         `protobuf:"bytes,7,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
-	AuthorizedAddressList  []AuthorizedAddress  `protobuf:"bytes,8,rep,name=authorizedAddressList,proto3" json:"authorizedAddressList"`
-	RequestCount           uint64               `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	GeneralKeyShareList    []GeneralKeyShare    `protobuf:"bytes,10,rep,name=generalKeyShareList,proto3" json:"generalKeyShareList"`
+	AggregatedKeyShareList    []AggregatedKeyShare    `protobuf:"bytes,5,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
+	ActivePubKey              ActivePubKey            `protobuf:"bytes,6,opt,name=activePubKey,proto3" json:"activePubKey"`
+	QueuedPubKey              QueuedPubKey            `protobuf:"bytes,7,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
+	AuthorizedAddressList     []AuthorizedAddress     `protobuf:"bytes,8,rep,name=authorizedAddressList,proto3" json:"authorizedAddressList"`
+	RequestCount              uint64                  `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	GeneralKeyShareList       []GeneralKeyShare       `protobuf:"bytes,10,rep,name=generalKeyShareList,proto3" json:"generalKeyShareList"`
+	KeyshareRewardCountList   []KeyshareRewardCount   `protobuf:"bytes,11,rep,name=keyshareRewardCountList,proto3" json:"keyshareRewardCountList"`
+	KeyshareRewardList        []KeyshareReward        `protobuf:"bytes,12,rep,name=keyshareRewardList,proto3" json:"keyshareRewardList"`
+	KeyshareSigningInfoList   []KeyshareSigningInfo   `protobuf:"bytes,13,rep,name=keyshareSigningInfoList,proto3" json:"keyshareSigningInfoList"`
+	MissedKeysharesBitmapList []MissedKeysharesBitmap `protobuf:"bytes,14,rep,name=missedKeysharesBitmapList,proto3" json:"missedKeysharesBitmapList"`
+	KeyshareEvidenceList      []KeyshareEvidence      `protobuf:"bytes,15,rep,name=keyshareEvidenceList,proto3" json:"keyshareEvidenceList"`
+	DkgRoundList              []DkgRound              `protobuf:"bytes,16,rep,name=dkgRoundList,proto3" json:"dkgRoundList"`
+	// id of the latest dkg round
+	DkgRoundCount    uint64         `protobuf:"varint,17,opt,name=dkgRoundCount,proto3" json:"dkgRoundCount,omitempty"`
+	DkgDealList      []DkgDeal      `protobuf:"bytes,18,rep,name=dkgDealList,proto3" json:"dkgDealList"`
+	DkgComplaintList []DkgComplaint `protobuf:"bytes,19,rep,name=dkgComplaintList,proto3" json:"dkgComplaintList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -143,6 +153,69 @@ func (m *GenesisState) GetGeneralKeyShareList() []GeneralKeyShare {
 	return nil
 }
 
+func (m *GenesisState) GetKeyshareRewardCountList() []KeyshareRewardCount {
+	if m != nil {
+		return m.KeyshareRewardCountList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyshareRewardList() []KeyshareReward {
+	if m != nil {
+		return m.KeyshareRewardList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyshareSigningInfoList() []KeyshareSigningInfo {
+	if m != nil {
+		return m.KeyshareSigningInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedKeysharesBitmapList() []MissedKeysharesBitmap {
+	if m != nil {
+		return m.MissedKeysharesBitmapList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyshareEvidenceList() []KeyshareEvidence {
+	if m != nil {
+		return m.KeyshareEvidenceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkgRoundList() []DkgRound {
+	if m != nil {
+		return m.DkgRoundList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkgRoundCount() uint64 {
+	if m != nil {
+		return m.DkgRoundCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDkgDealList() []DkgDeal {
+	if m != nil {
+		return m.DkgDealList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkgComplaintList() []DkgComplaint {
+	if m != nil {
+		return m.DkgComplaintList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fairyring.keyshare.GenesisState")
 }
@@ -150,40 +223,54 @@ func init() {
 func init() { proto.RegisterFile("fairyring/keyshare/genesis.proto", fileDescriptor_6629804056e1ba8d) }
 
 var fileDescriptor_6629804056e1ba8d = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xdf, 0xba, 0xee, 0x57, 0xaf, 0x48, 0x60, 0xfe, 0x45, 0x15, 0xca, 0xa2, 0x4d,
-	0x4c, 0xd5, 0x10, 0x89, 0x34, 0xce, 0x1c, 0x5a, 0xa4, 0x4d, 0xa3, 0x1c, 0x46, 0x2a, 0x71, 0x80,
-	0x43, 0xe4, 0xd4, 0x0f, 0xa9, 0xd5, 0x3f, 0xce, 0x1c, 0x67, 0x22, 0xbc, 0x0a, 0x5e, 0x06, 0x47,
-	0x5e, 0xc6, 0x8e, 0x3b, 0x72, 0x42, 0xa8, 0x15, 0xe2, 0x6d, 0xa0, 0xb8, 0xee, 0x9a, 0xb6, 0xee,
-	0xa5, 0x72, 0xad, 0xcf, 0xf7, 0xe3, 0xe7, 0x79, 0x62, 0x23, 0xf7, 0x33, 0x61, 0x22, 0x17, 0x6c,
-	0x12, 0xfb, 0x43, 0xc8, 0xd3, 0x01, 0x11, 0xe0, 0xc7, 0x30, 0x81, 0x94, 0xa5, 0x5e, 0x22, 0xb8,
-	0xe4, 0x18, 0xdf, 0x11, 0xde, 0x82, 0x68, 0x3e, 0x20, 0x63, 0x36, 0xe1, 0xbe, 0xfa, 0x9d, 0x63,
-	0xcd, 0x47, 0x31, 0x8f, 0xb9, 0x5a, 0xfa, 0xc5, 0x4a, 0xef, 0x1e, 0x18, 0xf4, 0x09, 0x11, 0x64,
-	0xac, 0xed, 0xcd, 0x63, 0x03, 0x70, 0x4d, 0x46, 0x8c, 0x12, 0xc9, 0x45, 0x98, 0x82, 0xd4, 0xdc,
-	0xa1, 0x81, 0x1b, 0x42, 0x1e, 0xaa, 0x95, 0x66, 0x5e, 0x1a, 0x18, 0x12, 0xc7, 0x02, 0x62, 0x22,
-	0x81, 0x86, 0xeb, 0xb8, 0xa9, 0xf5, 0x24, 0x8b, 0x0a, 0x4e, 0x13, 0x2f, 0x4c, 0xc2, 0x4c, 0x0e,
-	0xb8, 0x60, 0x5f, 0x81, 0x86, 0x84, 0x52, 0x01, 0xe9, 0xa2, 0x93, 0x93, 0x2d, 0x93, 0x14, 0x64,
-	0xb4, 0x7e, 0xf4, 0xe1, 0x9f, 0x5d, 0xd4, 0x38, 0x9f, 0x4f, 0xb9, 0x27, 0x89, 0x04, 0xfc, 0x1a,
-	0xd5, 0xe6, 0x63, 0xb1, 0x2d, 0xd7, 0x6a, 0xed, 0x9f, 0x36, 0xbd, 0xcd, 0xa9, 0x7b, 0x97, 0x8a,
-	0xe8, 0xd4, 0x6f, 0x7e, 0x1d, 0x54, 0xbe, 0xff, 0xfd, 0x71, 0x62, 0x05, 0x3a, 0x84, 0x9f, 0xa2,
-	0xbd, 0x84, 0x0b, 0x19, 0x32, 0x6a, 0xff, 0xe7, 0x5a, 0xad, 0x7a, 0x50, 0x2b, 0xfe, 0x5e, 0x50,
-	0x1c, 0xa0, 0xfb, 0x77, 0xd3, 0xec, 0x81, 0x7c, 0xc7, 0x52, 0x69, 0xef, 0xb8, 0x3b, 0xad, 0xfd,
-	0x53, 0xd7, 0x74, 0xc2, 0x87, 0x12, 0xdb, 0xa9, 0x16, 0xe7, 0x04, 0x1b, 0x79, 0x7c, 0x86, 0x1a,
-	0x43, 0xc8, 0x7b, 0x45, 0x40, 0xf9, 0xaa, 0xca, 0xf7, 0xcc, 0xe4, 0xeb, 0x6a, 0x4e, 0xbb, 0x56,
-	0x72, 0x98, 0xa2, 0x27, 0xcb, 0xaf, 0xd3, 0x2d, 0x1b, 0x77, 0x95, 0xf1, 0xd8, 0x64, 0x6c, 0x6f,
-	0x24, 0xb4, 0x7b, 0x8b, 0x0b, 0xbf, 0x45, 0x0d, 0xd2, 0x97, 0xec, 0x1a, 0x2e, 0xb3, 0xa8, 0x0b,
-	0xb9, 0x5d, 0x53, 0xf3, 0x35, 0x76, 0xdf, 0x2e, 0x71, 0x8b, 0x8a, 0xcb, 0xd9, 0xc2, 0x75, 0x95,
-	0x41, 0x06, 0x54, 0xbb, 0xf6, 0xb6, 0xbb, 0xde, 0x97, 0xb8, 0x85, 0xab, 0x9c, 0xc5, 0x04, 0x3d,
-	0x5e, 0x5e, 0xa5, 0xf6, 0xfc, 0x26, 0xa9, 0xe6, 0xff, 0x57, 0xcd, 0x3f, 0x37, 0x16, 0xb8, 0x1e,
-	0xd0, 0x66, 0xb3, 0x09, 0x1f, 0xa1, 0x7b, 0x02, 0xae, 0x32, 0x48, 0x65, 0xd8, 0xe7, 0xd9, 0x44,
-	0xda, 0x75, 0xd7, 0x6a, 0x55, 0x83, 0x86, 0xde, 0x7c, 0x53, 0xec, 0xe1, 0x4f, 0xe8, 0xa1, 0xbe,
-	0xa5, 0x2b, 0x9f, 0x00, 0xa9, 0x2a, 0x8e, 0x4c, 0x55, 0x9c, 0xaf, 0xe2, 0xba, 0x06, 0x93, 0xa5,
-	0x73, 0x71, 0x33, 0x75, 0xac, 0xdb, 0xa9, 0x63, 0xfd, 0x9e, 0x3a, 0xd6, 0xb7, 0x99, 0x53, 0xb9,
-	0x9d, 0x39, 0x95, 0x9f, 0x33, 0xa7, 0xf2, 0xd1, 0x8f, 0x99, 0x1c, 0x64, 0x91, 0xd7, 0xe7, 0x63,
-	0xff, 0x8c, 0x30, 0x11, 0x8d, 0x78, 0x7f, 0xe8, 0x2f, 0x9f, 0xd0, 0x97, 0xe5, 0x23, 0x92, 0x79,
-	0x02, 0x69, 0x54, 0x53, 0x2f, 0xe7, 0xd5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0xa0, 0x8c,
-	0x1c, 0xb1, 0x04, 0x00, 0x00,
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xc1, 0x4e, 0x1b, 0x39,
+	0x18, 0xc7, 0x33, 0x0b, 0x1b, 0x16, 0x27, 0xec, 0x82, 0x61, 0x97, 0x6c, 0x8a, 0x42, 0x04, 0x94,
+	0xa6, 0x54, 0x4d, 0x24, 0x7a, 0xee, 0x81, 0x40, 0x41, 0x94, 0x56, 0xa2, 0x89, 0x54, 0x55, 0xad,
+	0xd4, 0x91, 0x13, 0x1b, 0x63, 0x4d, 0x32, 0x0e, 0x1e, 0x0f, 0x6d, 0xfa, 0x14, 0x7d, 0x8c, 0x1e,
+	0xfb, 0x18, 0x1c, 0x39, 0xf6, 0x54, 0x55, 0x70, 0xe0, 0x35, 0xaa, 0xf9, 0xe2, 0x21, 0x93, 0xc4,
+	0x03, 0x17, 0xe4, 0x78, 0xfe, 0xff, 0xdf, 0xdf, 0x1f, 0xfe, 0xe6, 0x1b, 0x54, 0x3e, 0x21, 0x42,
+	0xf5, 0x95, 0xf0, 0x79, 0xcd, 0x63, 0xfd, 0xe0, 0x94, 0x28, 0x56, 0xe3, 0xcc, 0x67, 0x81, 0x08,
+	0xaa, 0x3d, 0x25, 0xb5, 0xc4, 0xf8, 0x56, 0x51, 0x8d, 0x15, 0xc5, 0x05, 0xd2, 0x15, 0xbe, 0xac,
+	0xc1, 0xdf, 0x81, 0xac, 0xb8, 0xc4, 0x25, 0x97, 0xb0, 0xac, 0x45, 0x2b, 0xb3, 0xbb, 0x6a, 0xc1,
+	0xf7, 0x88, 0x22, 0x5d, 0x43, 0x2f, 0x6e, 0x5a, 0x04, 0xe7, 0xa4, 0x23, 0x28, 0xd1, 0x52, 0xb9,
+	0x01, 0xd3, 0x46, 0xb7, 0x66, 0xd1, 0x79, 0xac, 0xef, 0xc2, 0xca, 0x68, 0x9e, 0x5a, 0x34, 0x84,
+	0x73, 0xc5, 0x38, 0xd1, 0x8c, 0xba, 0xe3, 0x72, 0x5b, 0xe9, 0xbd, 0xb0, 0x15, 0xe9, 0x8c, 0xe2,
+	0x89, 0x0d, 0x18, 0xea, 0x53, 0xa9, 0xc4, 0x17, 0x46, 0x5d, 0x42, 0xa9, 0x62, 0x41, 0x5c, 0xc9,
+	0x56, 0xca, 0x7f, 0x52, 0x91, 0xce, 0x44, 0x74, 0xc5, 0x5e, 0x0d, 0x2c, 0x5c, 0xc5, 0x3e, 0x11,
+	0x45, 0x8d, 0xb2, 0x7a, 0x97, 0x32, 0x10, 0xdc, 0x17, 0x3e, 0x77, 0x85, 0x7f, 0x22, 0xef, 0x38,
+	0xc5, 0xad, 0x9e, 0x9d, 0x0b, 0xca, 0xfc, 0x76, 0x7c, 0x8a, 0x15, 0x8b, 0x96, 0x7a, 0x7c, 0xf0,
+	0x74, 0xed, 0x26, 0x87, 0xf2, 0x07, 0x83, 0x4e, 0x68, 0x6a, 0xa2, 0x19, 0x7e, 0x8e, 0xb2, 0x83,
+	0xab, 0x2b, 0x38, 0x65, 0xa7, 0x92, 0xdb, 0x2e, 0x56, 0x27, 0x3b, 0xa3, 0x7a, 0x0c, 0x8a, 0xfa,
+	0xec, 0xc5, 0xcf, 0xd5, 0xcc, 0xb7, 0x9b, 0xef, 0x5b, 0x4e, 0xc3, 0x98, 0xf0, 0x32, 0x9a, 0xe9,
+	0x49, 0xa5, 0x5d, 0x41, 0x0b, 0x7f, 0x94, 0x9d, 0xca, 0x6c, 0x23, 0x1b, 0xfd, 0x3c, 0xa4, 0xb8,
+	0x81, 0xe6, 0x6f, 0x6f, 0xbc, 0xc9, 0xf4, 0x2b, 0x11, 0xe8, 0xc2, 0x54, 0x79, 0xaa, 0x92, 0xdb,
+	0x2e, 0xdb, 0x12, 0xde, 0x26, 0xb4, 0xf5, 0xe9, 0x28, 0xa7, 0x31, 0xe1, 0xc7, 0xfb, 0x28, 0xef,
+	0xb1, 0x7e, 0x33, 0x32, 0x00, 0x6f, 0x1a, 0x78, 0x2b, 0x36, 0xde, 0x91, 0xd1, 0x19, 0xd6, 0x88,
+	0x0f, 0x53, 0xf4, 0xdf, 0xb0, 0x83, 0x8e, 0x92, 0xc4, 0x3f, 0x81, 0xb8, 0x69, 0x23, 0xee, 0x4c,
+	0x38, 0x0c, 0x3b, 0x85, 0x85, 0x5f, 0xa2, 0x3c, 0x69, 0x6b, 0x71, 0xce, 0x8e, 0xc3, 0xd6, 0x11,
+	0xeb, 0x17, 0xb2, 0x65, 0x27, 0xad, 0xfa, 0x9d, 0x84, 0x2e, 0x3e, 0x71, 0xd2, 0x1b, 0xb1, 0xce,
+	0x42, 0x16, 0x32, 0x6a, 0x58, 0x33, 0xe9, 0xac, 0x37, 0x09, 0x5d, 0xcc, 0x4a, 0x7a, 0x31, 0x41,
+	0xff, 0x0e, 0xdb, 0x7d, 0x67, 0xd0, 0xed, 0x50, 0xfc, 0x5f, 0x50, 0xfc, 0x43, 0xeb, 0x01, 0xc7,
+	0x0d, 0x86, 0x6c, 0x27, 0xe1, 0x75, 0x34, 0xa7, 0xd8, 0x59, 0xc8, 0x02, 0xed, 0xb6, 0x65, 0xe8,
+	0xeb, 0xc2, 0x6c, 0xd9, 0xa9, 0x4c, 0x37, 0xf2, 0x66, 0x73, 0x37, 0xda, 0xc3, 0x1f, 0xd0, 0xa2,
+	0x79, 0x93, 0x46, 0xae, 0x00, 0xc1, 0x29, 0xd6, 0x6d, 0xa7, 0x38, 0x18, 0x95, 0x9b, 0x33, 0xd8,
+	0x28, 0x98, 0xa3, 0xe5, 0xd8, 0xd6, 0x80, 0x37, 0x0f, 0x32, 0x21, 0x20, 0x07, 0x01, 0x8f, 0x52,
+	0xba, 0x66, 0xdc, 0x62, 0x42, 0xd2, 0x68, 0xf8, 0x1d, 0xc2, 0xa3, 0x8f, 0x20, 0x23, 0x0f, 0x19,
+	0x6b, 0xf7, 0x67, 0x18, 0xbc, 0x85, 0x91, 0x2c, 0xa1, 0x39, 0x18, 0x09, 0x87, 0xfe, 0x89, 0x04,
+	0xfc, 0xdc, 0xfd, 0x25, 0x24, 0x2c, 0xe3, 0x25, 0x8c, 0xd1, 0x70, 0x17, 0xfd, 0xdf, 0x15, 0x41,
+	0x00, 0xed, 0x0b, 0x82, 0xa0, 0x2e, 0x74, 0x97, 0xf4, 0x20, 0xea, 0x6f, 0x88, 0x7a, 0x6c, 0x8b,
+	0x7a, 0x6d, 0x33, 0x99, 0xb0, 0x74, 0x22, 0xfe, 0x88, 0x96, 0x62, 0xc4, 0x0b, 0x33, 0xba, 0x20,
+	0xe9, 0x1f, 0x48, 0xda, 0xb8, 0xab, 0xa8, 0x58, 0x6f, 0x42, 0xac, 0x9c, 0x68, 0x4a, 0x50, 0x8f,
+	0x37, 0x64, 0xe8, 0x0f, 0xee, 0x62, 0x3e, 0x7d, 0x4a, 0xec, 0x19, 0x5d, 0xfc, 0x9e, 0x24, 0x7d,
+	0x78, 0x03, 0xcd, 0xc5, 0xbf, 0xe1, 0xba, 0x0b, 0x0b, 0xd0, 0xc4, 0xa3, 0x9b, 0x78, 0x17, 0xe5,
+	0xa8, 0xc7, 0xf7, 0x18, 0xe9, 0x40, 0x18, 0x86, 0xb0, 0x07, 0x29, 0x61, 0x91, 0xcc, 0x64, 0x25,
+	0x5d, 0xd1, 0xb0, 0xa4, 0x1e, 0xdf, 0x95, 0xdd, 0x5e, 0x87, 0x08, 0xd3, 0xa6, 0x8b, 0xe9, 0xc3,
+	0x72, 0x2f, 0xa1, 0x8d, 0x87, 0xe5, 0xb8, 0xbf, 0x7e, 0x78, 0x71, 0x55, 0x72, 0x2e, 0xaf, 0x4a,
+	0xce, 0xaf, 0xab, 0x92, 0xf3, 0xf5, 0xba, 0x94, 0xb9, 0xbc, 0x2e, 0x65, 0x7e, 0x5c, 0x97, 0x32,
+	0xef, 0x6b, 0x5c, 0xe8, 0xd3, 0xb0, 0x55, 0x6d, 0xcb, 0x6e, 0x6d, 0x9f, 0x08, 0xd5, 0xea, 0xc8,
+	0xb6, 0x57, 0x1b, 0x7e, 0x36, 0x3e, 0x0f, 0x3f, 0x1c, 0xba, 0xdf, 0x63, 0x41, 0x2b, 0x0b, 0xdf,
+	0x8e, 0x67, 0xbf, 0x07, 0x00, 0x46, 0xdf, 0x50, 0xc8, 0x57, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +293,131 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DkgComplaintList) > 0 {
+		for iNdEx := len(m.DkgComplaintList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgComplaintList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DkgDealList) > 0 {
+		for iNdEx := len(m.DkgDealList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgDealList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.DkgRoundCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DkgRoundCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.DkgRoundList) > 0 {
+		for iNdEx := len(m.DkgRoundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgRoundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.KeyshareEvidenceList) > 0 {
+		for iNdEx := len(m.KeyshareEvidenceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareEvidenceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MissedKeysharesBitmapList) > 0 {
+		for iNdEx := len(m.MissedKeysharesBitmapList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedKeysharesBitmapList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.KeyshareSigningInfoList) > 0 {
+		for iNdEx := len(m.KeyshareSigningInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareSigningInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.KeyshareRewardList) > 0 {
+		for iNdEx := len(m.KeyshareRewardList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareRewardList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.KeyshareRewardCountList) > 0 {
+		for iNdEx := len(m.KeyshareRewardCountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareRewardCountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.GeneralKeyShareList) > 0 {
 		for iNdEx := len(m.GeneralKeyShareList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -381,6 +593,57 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.KeyshareRewardCountList) > 0 {
+		for _, e := range m.KeyshareRewardCountList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyshareRewardList) > 0 {
+		for _, e := range m.KeyshareRewardList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyshareSigningInfoList) > 0 {
+		for _, e := range m.KeyshareSigningInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedKeysharesBitmapList) > 0 {
+		for _, e := range m.MissedKeysharesBitmapList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyshareEvidenceList) > 0 {
+		for _, e := range m.KeyshareEvidenceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DkgRoundList) > 0 {
+		for _, e := range m.DkgRoundList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DkgRoundCount != 0 {
+		n += 2 + sovGenesis(uint64(m.DkgRoundCount))
+	}
+	if len(m.DkgDealList) > 0 {
+		for _, e := range m.DkgDealList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DkgComplaintList) > 0 {
+		for _, e := range m.DkgComplaintList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -739,6 +1002,297 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareRewardCountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyshareRewardCountList = append(m.KeyshareRewardCountList, KeyshareRewardCount{})
+			if err := m.KeyshareRewardCountList[len(m.KeyshareRewardCountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareRewardList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyshareRewardList = append(m.KeyshareRewardList, KeyshareReward{})
+			if err := m.KeyshareRewardList[len(m.KeyshareRewardList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareSigningInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyshareSigningInfoList = append(m.KeyshareSigningInfoList, KeyshareSigningInfo{})
+			if err := m.KeyshareSigningInfoList[len(m.KeyshareSigningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedKeysharesBitmapList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedKeysharesBitmapList = append(m.MissedKeysharesBitmapList, MissedKeysharesBitmap{})
+			if err := m.MissedKeysharesBitmapList[len(m.MissedKeysharesBitmapList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareEvidenceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyshareEvidenceList = append(m.KeyshareEvidenceList, KeyshareEvidence{})
+			if err := m.KeyshareEvidenceList[len(m.KeyshareEvidenceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRoundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgRoundList = append(m.DkgRoundList, DkgRound{})
+			if err := m.DkgRoundList[len(m.DkgRoundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRoundCount", wireType)
+			}
+			m.DkgRoundCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgRoundCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgDealList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgDealList = append(m.DkgDealList, DkgDeal{})
+			if err := m.DkgDealList[len(m.DkgDealList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgComplaintList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgComplaintList = append(m.DkgComplaintList, DkgComplaint{})
+			if err := m.DkgComplaintList[len(m.DkgComplaintList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				AggregatedKeyShareList: []types.AggregatedKeyShare{
 					{
@@ -49,6 +50,159 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated keyshareRewardCount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				KeyshareRewardCountList: []types.KeyshareRewardCount{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated keyshareReward",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				KeyshareRewardList: []types.KeyshareReward{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated keyshareSigningInfo",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				KeyshareSigningInfoList: []types.KeyshareSigningInfo{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated missedKeysharesBitmap",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				MissedKeysharesBitmapList: []types.MissedKeysharesBitmap{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated keyshareEvidence",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				KeyshareEvidenceList: []types.KeyshareEvidence{
+					{
+						Validator: "0",
+						Identity:  "1",
+					},
+					{
+						Validator: "0",
+						Identity:  "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated dkgRound",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				DkgRoundList: []types.DkgRound{
+					{
+						Id: 1,
+					},
+					{
+						Id: 1,
+					},
+				},
+				DkgRoundCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "dkgRound after dkgRoundCount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				DkgRoundList: []types.DkgRound{
+					{
+						Id: 2,
+					},
+				},
+				DkgRoundCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "dkgDeal of unknown dkgRound",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				DkgDealList: []types.DkgDeal{
+					{
+						RoundId: 1,
+						Dealer:  "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated dkgComplaint",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				DkgRoundList: []types.DkgRound{
+					{
+						Id: 1,
+					},
+				},
+				DkgRoundCount: 1,
+				DkgComplaintList: []types.DkgComplaint{
+					{
+						RoundId:    1,
+						Dealer:     "0",
+						Complainer: "1",
+					},
+					{
+						RoundId:    1,
+						Dealer:     "0",
+						Complainer: "1",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,104 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// DkgRoundKeyPrefix is the prefix to retrieve all DkgRound
+	DkgRoundKeyPrefix = "DkgRound/value/"
+	// DkgRoundCountKey is the key of the id of the latest DkgRound
+	DkgRoundCountKey = "DkgRound/count/"
+	// DkgDealKeyPrefix is the prefix to retrieve all DkgDeal
+	DkgDealKeyPrefix = "DkgDeal/value/"
+	// DkgComplaintKeyPrefix is the prefix to retrieve all DkgComplaint
+	DkgComplaintKeyPrefix = "DkgComplaint/value/"
+)
+
+const (
+	DkgRoundStatusInProgress = "in-progress"
+	DkgRoundStatusFinalized  = "finalized"
+	DkgRoundStatusFailed     = "failed"
+)
+
+const (
+	DkgPhaseDeal          = "deal"
+	DkgPhaseComplaint     = "complaint"
+	DkgPhaseJustification = "justification"
+	DkgPhaseFinalizing    = "finalizing"
+)
+
+// DkgRoundKey returns the store key to retrieve a DkgRound from the index fields
+func DkgRoundKey(
+	id uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DkgDealKey returns the store key to retrieve a DkgDeal from the index fields
+func DkgDealKey(
+	roundID uint64,
+	dealer string,
+) []byte {
+	key := DkgRoundKey(roundID)
+
+	dealerBytes := []byte(dealer)
+	key = append(key, dealerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DkgComplaintKey returns the store key to retrieve a DkgComplaint from the index fields
+func DkgComplaintKey(
+	roundID uint64,
+	dealer string,
+	complainer string,
+) []byte {
+	key := DkgDealKey(roundID, dealer)
+
+	complainerBytes := []byte(complainer)
+	key = append(key, complainerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// Phase returns the phase the round is in at the given block height
+func (r DkgRound) Phase(height uint64) string {
+	switch {
+	case r.Status != DkgRoundStatusInProgress:
+		return r.Status
+	case height <= r.DealEndHeight:
+		return DkgPhaseDeal
+	case height <= r.ComplaintEndHeight:
+		return DkgPhaseComplaint
+	case height <= r.JustificationEndHeight:
+		return DkgPhaseJustification
+	default:
+		return DkgPhaseFinalizing
+	}
+}
+
+// ParticipantIndex returns the keyshare index of the participant, 0 if the address is not a participant
+func (r DkgRound) ParticipantIndex(address string) uint64 {
+	for i, p := range r.Participants {
+		if p == address {
+			return uint64(i) + 1
+		}
+	}
+	return 0
+}
+
+// DealerIndex returns the index of the dealer in the round dealers, 0 if the address is not a dealer.
+// In a resharing round it is the keyshare index of the dealer in the active key.
+func (r DkgRound) DealerIndex(address string) uint64 {
+	for i, d := range r.Dealers {
+		if d == address {
+			return uint64(i) + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// KeyshareEvidenceKeyPrefix is the prefix to retrieve all KeyshareEvidence
+	KeyshareEvidenceKeyPrefix = "KeyshareEvidence/value/"
+)
+
+// KeyshareEvidenceKey returns the store key to retrieve a KeyshareEvidence from the index fields
+func KeyshareEvidenceKey(
+	validator string,
+	identity string,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	identityBytes := []byte(identity)
+	key = append(key, identityBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// KeyshareSignBytes returns the bytes a submitter signs to vouch for a keyshare it produced off-chain,
+// the public key binds the keyshare to a key so shares of a replaced key never conflict with the current ones
+func KeyshareSignBytes(chainID, pubKey, identity, keyshare string, keyShareIndex uint64) []byte {
+	return []byte(strings.Join([]string{
+		ModuleName,
+		chainID,
+		pubKey,
+		identity,
+		strconv.FormatUint(keyShareIndex, 10),
+		strings.ToLower(keyshare),
+	}, "/"))
+}
+
+// ConflictsWith returns true if the keyshares are different for the same identity
+func (s SignedKeyshare) ConflictsWith(other SignedKeyshare) bool {
+	return s.KeyShareIndex != other.KeyShareIndex || !strings.EqualFold(s.Keyshare, other.Keyshare)
+}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// KeyshareRewardCountKeyPrefix is the prefix to retrieve all KeyshareRewardCount
+	KeyshareRewardCountKeyPrefix = "KeyshareRewardCount/value/"
+	// KeyshareRewardKeyPrefix is the prefix to retrieve all KeyshareReward
+	KeyshareRewardKeyPrefix = "KeyshareReward/value/"
+)
+
+// KeyshareRewardKey returns the store key to retrieve a KeyshareReward or a KeyshareRewardCount from the index fields
+func KeyshareRewardKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// KeyshareSigningInfoKeyPrefix is the prefix to retrieve all KeyshareSigningInfo
+	KeyshareSigningInfoKeyPrefix = "KeyshareSigningInfo/value/"
+	// MissedKeysharesBitmapKeyPrefix is the prefix to retrieve the missed keyshares bitmap of a validator
+	MissedKeysharesBitmapKeyPrefix = "MissedKeysharesBitmap/value/"
+)
+
+// KeyshareSigningInfoKey returns the store key to retrieve a KeyshareSigningInfo from the index fields
+func KeyshareSigningInfoKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
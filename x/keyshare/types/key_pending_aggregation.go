@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// PendingAggregationKeyPrefix is the prefix to retrieve all PendingAggregation
+	PendingAggregationKeyPrefix = "PendingAggregation/value/"
+)
+
+// PendingAggregationKey returns the store key to retrieve a PendingAggregation from the index fields,
+// block heights are sorted before general identities
+func PendingAggregationKey(
+	pending PendingAggregation,
+) []byte {
+	var key []byte
+
+	idTypeBytes := []byte(pending.IdType)
+	key = append(key, idTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	if pending.IsGeneral() {
+		key = append(key, []byte(pending.IdValue)...)
+	} else {
+		heightBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(heightBytes, pending.Height)
+		key = append(key, heightBytes...)
+	}
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// IsGeneral returns true for a general identity, false for a block height
+func (p PendingAggregation) IsGeneral() bool {
+	return p.IdType != ""
+}
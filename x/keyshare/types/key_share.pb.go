@@ -29,6 +29,10 @@ type KeyShare struct {
 	KeyShareIndex       uint64 `protobuf:"varint,4,opt,name=keyShareIndex,proto3" json:"keyShareIndex,omitempty"`
 	ReceivedTimestamp   uint64 `protobuf:"varint,5,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
 	ReceivedBlockHeight uint64 `protobuf:"varint,6,opt,name=receivedBlockHeight,proto3" json:"receivedBlockHeight,omitempty"`
+	// consensus power of the validator when the keyshare was submitted
+	Power int64 `protobuf:"varint,7,opt,name=power,proto3" json:"power,omitempty"`
+	// active public key the keyshare was verified for when it was submitted
+	PubKey string `protobuf:"bytes,8,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 }
 
 func (m *KeyShare) Reset()         { *m = KeyShare{} }
@@ -106,6 +110,20 @@ func (m *KeyShare) GetReceivedBlockHeight() uint64 {
 	return 0
 }
 
+func (m *KeyShare) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *KeyShare) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*KeyShare)(nil), "fairyring.keyshare.KeyShare")
 }
@@ -115,23 +133,25 @@ func init() {
 }
 
 var fileDescriptor_cb45212b5123dd29 = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4b, 0xcc, 0x2c,
 	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xce, 0x48, 0x2c, 0x4a, 0x05, 0x31,
 	0xe2, 0xc1, 0x2c, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x21, 0xb8, 0x1a, 0x3d, 0x98, 0x1a,
-	0xa5, 0x37, 0x8c, 0x5c, 0x1c, 0xde, 0xa9, 0x95, 0xc1, 0x20, 0x8e, 0x90, 0x0c, 0x17, 0x67, 0x59,
+	0xa5, 0x59, 0x4c, 0x5c, 0x1c, 0xde, 0xa9, 0x95, 0xc1, 0x20, 0x8e, 0x90, 0x0c, 0x17, 0x67, 0x59,
 	0x62, 0x4e, 0x66, 0x4a, 0x62, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x42,
 	0x40, 0x48, 0x81, 0x8b, 0x3b, 0x29, 0x27, 0x3f, 0x39, 0xdb, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44,
 	0x82, 0x49, 0x81, 0x51, 0x83, 0x25, 0x08, 0x59, 0x48, 0x48, 0x8a, 0x8b, 0x23, 0x1b, 0x6a, 0x96,
 	0x04, 0x33, 0x58, 0x3b, 0x9c, 0x2f, 0xa4, 0xc2, 0xc5, 0x0b, 0x63, 0x7b, 0xe6, 0xa5, 0xa4, 0x56,
 	0x48, 0xb0, 0x80, 0xf5, 0xa3, 0x0a, 0x0a, 0xe9, 0x70, 0x09, 0x16, 0xa5, 0x26, 0xa7, 0x66, 0x96,
 	0xa5, 0xa6, 0x84, 0x64, 0xe6, 0xa6, 0x16, 0x97, 0x24, 0xe6, 0x16, 0x48, 0xb0, 0x82, 0x55, 0x62,
-	0x4a, 0x08, 0x19, 0x70, 0x09, 0xc3, 0x04, 0x9d, 0x90, 0x5c, 0xc6, 0x06, 0x56, 0x8f, 0x4d, 0xca,
-	0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xdd, 0x12, 0x33, 0x8b, 0xc0, 0xfe, 0xd2, 0x47,
-	0x84, 0x6a, 0x05, 0x22, 0x5c, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x6a, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xf4, 0xc1, 0xf3, 0x7a, 0x01, 0x00, 0x00,
+	0x4a, 0x08, 0x19, 0x70, 0x09, 0xc3, 0x04, 0x9d, 0x90, 0x5c, 0xc6, 0x06, 0x56, 0x8f, 0x4d, 0x4a,
+	0x48, 0x84, 0x8b, 0xb5, 0x20, 0xbf, 0x3c, 0xb5, 0x48, 0x82, 0x5d, 0x81, 0x51, 0x83, 0x39, 0x08,
+	0xc2, 0x11, 0x12, 0xe3, 0x62, 0x2b, 0x28, 0x4d, 0xf2, 0x4e, 0xad, 0x94, 0xe0, 0x00, 0xbb, 0x1a,
+	0xca, 0x73, 0xf2, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
+	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0xf4,
+	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb7, 0xc4, 0xcc, 0x22, 0x70, 0x28,
+	0xe8, 0x23, 0xe2, 0xa0, 0x02, 0x11, 0x0b, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x28,
+	0x30, 0x06, 0x0c, 0x00, 0x0b, 0x59, 0x88, 0xc0, 0xa8, 0x01, 0x00, 0x00,
 }
 
 func (m *KeyShare) Marshal() (dAtA []byte, err error) {
@@ -154,6 +174,18 @@ func (m *KeyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintKeyShare(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Power != 0 {
+		i = encodeVarintKeyShare(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.ReceivedBlockHeight != 0 {
 		i = encodeVarintKeyShare(dAtA, i, uint64(m.ReceivedBlockHeight))
 		i--
@@ -228,6 +260,13 @@ func (m *KeyShare) Size() (n int) {
 	if m.ReceivedBlockHeight != 0 {
 		n += 1 + sovKeyShare(uint64(m.ReceivedBlockHeight))
 	}
+	if m.Power != 0 {
+		n += 1 + sovKeyShare(uint64(m.Power))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovKeyShare(uint64(l))
+	}
 	return n
 }
 
@@ -406,6 +445,57 @@ func (m *KeyShare) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyShare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyShare(dAtA[iNdEx:])
@@ -28,11 +28,6 @@ var (
 	RequestsCountKey = KeyPrefix("keyshare-request-count-")
 )
 
-const (
-	KeyAggregationThresholdNumerator   = 2
-	KeyAggregationThresholdDenominator = 3
-)
-
 const (
 	SlashPower int64 = 100
 )
@@ -97,6 +92,7 @@ const (
 	QueuedPubKeyCreatedEventCreator                  = "creator"
 	QueuedPubKeyCreatedEventPubkey                   = "pubkey"
 	QueuedPubKeyCreatedEventNumberOfValidators       = "number-of-validators"
+	QueuedPubKeyCreatedEventThreshold                = "threshold"
 	QueuedPubKeyCreatedEventEncryptedShares          = "encrypted-shares"
 )
 
@@ -107,11 +103,104 @@ const (
 	PubKeyOverrodeEventCreator                  = "creator"
 	PubKeyOverrodeEventPubkey                   = "pubkey"
 	PubKeyOverrodeEventNumberOfValidators       = "number-of-validators"
+	PubKeyOverrodeEventThreshold                = "threshold"
 	PubKeyOverrodeEventEncryptedShares          = "encrypted-shares"
 )
 
+const (
+	DkgRoundStartedEventType             = "dkg-round-started"
+	DkgRoundStartedEventRoundID          = "round-id"
+	DkgRoundStartedEventResharing        = "resharing"
+	DkgRoundStartedEventDealers          = "dealers"
+	DkgRoundStartedEventThreshold        = "threshold"
+	DkgRoundStartedEventParticipants     = "participants"
+	DkgRoundStartedEventDealEnd          = "deal-end-height"
+	DkgRoundStartedEventComplaintEnd     = "complaint-end-height"
+	DkgRoundStartedEventJustificationEnd = "justification-end-height"
+)
+
+const (
+	DkgDealSubmittedEventType    = "dkg-deal-submitted"
+	DkgDealSubmittedEventRoundID = "round-id"
+	DkgDealSubmittedEventDealer  = "dealer"
+)
+
+const (
+	DkgComplaintSubmittedEventType       = "dkg-complaint-submitted"
+	DkgComplaintSubmittedEventRoundID    = "round-id"
+	DkgComplaintSubmittedEventDealer     = "dealer"
+	DkgComplaintSubmittedEventComplainer = "complainer"
+)
+
+const (
+	DkgJustificationSubmittedEventType       = "dkg-justification-submitted"
+	DkgJustificationSubmittedEventRoundID    = "round-id"
+	DkgJustificationSubmittedEventDealer     = "dealer"
+	DkgJustificationSubmittedEventComplainer = "complainer"
+	DkgJustificationSubmittedEventShare      = "share"
+)
+
+const (
+	DkgDealerDisqualifiedEventType    = "dkg-dealer-disqualified"
+	DkgDealerDisqualifiedEventRoundID = "round-id"
+	DkgDealerDisqualifiedEventDealer  = "dealer"
+	DkgDealerDisqualifiedEventReason  = "reason"
+)
+
+const (
+	DkgRoundFinalizedEventType      = "dkg-round-finalized"
+	DkgRoundFinalizedEventRoundID   = "round-id"
+	DkgRoundFinalizedEventStatus    = "status"
+	DkgRoundFinalizedEventPubkey    = "pubkey"
+	DkgRoundFinalizedEventQualified = "qualified"
+	DkgRoundFinalizedEventReason    = "reason"
+)
+
+const (
+	PubKeyResharedEventType               = "pubkey-reshared"
+	PubKeyResharedEventRoundID            = "round-id"
+	PubKeyResharedEventPubkey             = "pubkey"
+	PubKeyResharedEventNumberOfValidators = "number-of-validators"
+	PubKeyResharedEventThreshold          = "threshold"
+)
+
+const (
+	KeyshareLivenessEventType            = "keyshare-liveness"
+	KeyshareLivenessEventValidator       = "validator"
+	KeyshareLivenessEventMissedKeyshares = "missed-keyshares"
+	KeyshareLivenessEventJailedUntil     = "jailed-until"
+)
+
+const (
+	UnjailedValidatorEventType    = "keyshare-validator-unjailed"
+	UnjailedValidatorEventCreator = "creator"
+)
+
+const (
+	KeyshareEquivocationEventType        = "keyshare-equivocation"
+	KeyshareEquivocationEventValidator   = "validator"
+	KeyshareEquivocationEventIdentity    = "identity"
+	KeyshareEquivocationEventReporter    = "reporter"
+	KeyshareEquivocationEventJailedUntil = "jailed-until"
+)
+
+const (
+	KeyshareRewardsDistributedEventType       = "keyshare-rewards-distributed"
+	KeyshareRewardsDistributedEventPool       = "pool"
+	KeyshareRewardsDistributedEventKeyshares  = "keyshares"
+	KeyshareRewardsDistributedEventValidators = "validators"
+)
+
+const (
+	KeyshareRewardsClaimedEventType      = "keyshare-rewards-claimed"
+	KeyshareRewardsClaimedEventValidator = "validator"
+	KeyshareRewardsClaimedEventAmount    = "amount"
+)
+
 const (
 	KeyTotalIdleValSlashed           = "total_idle_validator_slashed"
+	KeyTotalIdleValJailed            = "total_idle_validator_jailed"
+	KeyTotalEquivocationSlashed      = "total_equivocation_validator_slashed"
 	KeyTotalValidKeyShareSubmitted   = "total_valid_key_share"
 	KeyTotalInvalidKeyShareSubmitted = "total_invalid_key_share"
 )
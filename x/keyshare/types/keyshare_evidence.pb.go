@@ -0,0 +1,900 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/keyshare_evidence.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignedKeyshare is a keyshare together with the address that produced it.
+// A keyshare seen off-chain carries the signature of the submitter account over its sign bytes,
+// a keyshare submitted on-chain is authenticated by its transaction and has no signature.
+type SignedKeyshare struct {
+	Keyshare      string `protobuf:"bytes,1,opt,name=keyshare,proto3" json:"keyshare,omitempty"`
+	KeyShareIndex uint64 `protobuf:"varint,2,opt,name=keyShareIndex,proto3" json:"keyShareIndex,omitempty"`
+	// validator or the address authorized by the validator
+	Submitter string `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// base64 encoded signature of the submitter account over the keyshare sign bytes
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedKeyshare) Reset()         { *m = SignedKeyshare{} }
+func (m *SignedKeyshare) String() string { return proto.CompactTextString(m) }
+func (*SignedKeyshare) ProtoMessage()    {}
+func (*SignedKeyshare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_776b4c298d193384, []int{0}
+}
+func (m *SignedKeyshare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedKeyshare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedKeyshare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedKeyshare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedKeyshare.Merge(m, src)
+}
+func (m *SignedKeyshare) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedKeyshare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedKeyshare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedKeyshare proto.InternalMessageInfo
+
+func (m *SignedKeyshare) GetKeyshare() string {
+	if m != nil {
+		return m.Keyshare
+	}
+	return ""
+}
+
+func (m *SignedKeyshare) GetKeyShareIndex() uint64 {
+	if m != nil {
+		return m.KeyShareIndex
+	}
+	return 0
+}
+
+func (m *SignedKeyshare) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *SignedKeyshare) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// KeyshareEvidence proves a validator produced two conflicting keyshares for the same identity
+type KeyshareEvidence struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// block height of a keyshare or the id value of a general keyshare
+	Identity string          `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	First    *SignedKeyshare `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	Second   *SignedKeyshare `protobuf:"bytes,4,opt,name=second,proto3" json:"second,omitempty"`
+	// address submitting the evidence, empty when the conflict was detected on-chain
+	Reporter string `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// block height the evidence was handled at
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *KeyshareEvidence) Reset()         { *m = KeyshareEvidence{} }
+func (m *KeyshareEvidence) String() string { return proto.CompactTextString(m) }
+func (*KeyshareEvidence) ProtoMessage()    {}
+func (*KeyshareEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_776b4c298d193384, []int{1}
+}
+func (m *KeyshareEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyshareEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyshareEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyshareEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyshareEvidence.Merge(m, src)
+}
+func (m *KeyshareEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyshareEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyshareEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyshareEvidence proto.InternalMessageInfo
+
+func (m *KeyshareEvidence) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *KeyshareEvidence) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *KeyshareEvidence) GetFirst() *SignedKeyshare {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *KeyshareEvidence) GetSecond() *SignedKeyshare {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+func (m *KeyshareEvidence) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *KeyshareEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SignedKeyshare)(nil), "fairyring.keyshare.SignedKeyshare")
+	proto.RegisterType((*KeyshareEvidence)(nil), "fairyring.keyshare.KeyshareEvidence")
+}
+
+func init() {
+	proto.RegisterFile("fairyring/keyshare/keyshare_evidence.proto", fileDescriptor_776b4c298d193384)
+}
+
+var fileDescriptor_776b4c298d193384 = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4f, 0x4b, 0xf3, 0x40,
+	0x10, 0xc6, 0xbb, 0xef, 0xdb, 0x06, 0xbb, 0xa2, 0xc8, 0x1e, 0x24, 0x88, 0x84, 0x52, 0x3c, 0x14,
+	0x0f, 0x09, 0xe8, 0x45, 0x3c, 0x0a, 0x0a, 0xc5, 0x5b, 0x7a, 0xf3, 0x22, 0xf9, 0x33, 0x4d, 0x86,
+	0xb6, 0xbb, 0x65, 0xb3, 0x2d, 0xcd, 0x97, 0x10, 0x3f, 0x96, 0xc7, 0x1e, 0x3d, 0x4a, 0xf3, 0x1d,
+	0x3c, 0xcb, 0x6e, 0x37, 0x09, 0xc5, 0x8b, 0xb7, 0x7d, 0x9e, 0x99, 0x67, 0xf9, 0xcd, 0x0c, 0xbd,
+	0x9e, 0x46, 0x28, 0x4b, 0x89, 0x3c, 0x0b, 0x66, 0x50, 0x16, 0x79, 0x24, 0xa1, 0x79, 0xbc, 0xc2,
+	0x1a, 0x53, 0xe0, 0x09, 0xf8, 0x4b, 0x29, 0x94, 0x60, 0xac, 0xe9, 0xf5, 0xeb, 0x96, 0xe1, 0x1b,
+	0xa1, 0xa7, 0x13, 0xcc, 0x38, 0xa4, 0xcf, 0xd6, 0x62, 0x17, 0xf4, 0xa8, 0x2e, 0xbb, 0x64, 0x40,
+	0x46, 0xfd, 0xb0, 0xd1, 0xec, 0x8a, 0x9e, 0xcc, 0xa0, 0x9c, 0xe8, 0xf7, 0x98, 0xa7, 0xb0, 0x71,
+	0xff, 0x0d, 0xc8, 0xa8, 0x1b, 0x1e, 0x9a, 0xec, 0x92, 0xf6, 0x8b, 0x55, 0xbc, 0x40, 0xa5, 0x40,
+	0xba, 0xff, 0xcd, 0x17, 0xad, 0x61, 0xaa, 0x98, 0xf1, 0x48, 0xad, 0x24, 0xb8, 0x5d, 0x5b, 0xad,
+	0x8d, 0xe1, 0x37, 0xa1, 0x67, 0x35, 0xca, 0xa3, 0xe5, 0xd7, 0x91, 0x75, 0x34, 0xc7, 0x34, 0x52,
+	0x42, 0x5a, 0xa6, 0xd6, 0xd0, 0xc0, 0xba, 0x4f, 0xa1, 0x2a, 0x0d, 0x4f, 0x3f, 0x6c, 0x34, 0xbb,
+	0xa3, 0xbd, 0x29, 0xca, 0x42, 0x19, 0x8c, 0xe3, 0x9b, 0xa1, 0xff, 0x7b, 0x07, 0xfe, 0xe1, 0xfc,
+	0xe1, 0x3e, 0xc0, 0xee, 0xa9, 0x53, 0x40, 0x22, 0x78, 0xea, 0x76, 0xff, 0x1c, 0xb5, 0x09, 0x4d,
+	0x24, 0x61, 0x29, 0xa4, 0x9e, 0xbf, 0xb7, 0x27, 0xaa, 0x35, 0x3b, 0xa7, 0x4e, 0x0e, 0x98, 0xe5,
+	0xca, 0x75, 0xcc, 0xee, 0xac, 0x7a, 0x18, 0x7f, 0xec, 0x3c, 0xb2, 0xdd, 0x79, 0xe4, 0x6b, 0xe7,
+	0x91, 0xf7, 0xca, 0xeb, 0x6c, 0x2b, 0xaf, 0xf3, 0x59, 0x79, 0x9d, 0x97, 0x20, 0x43, 0x95, 0xaf,
+	0x62, 0x3f, 0x11, 0x8b, 0xe0, 0x29, 0x42, 0x19, 0xcf, 0x45, 0x32, 0x0b, 0xda, 0xc3, 0x6f, 0xda,
+	0xd3, 0xab, 0x72, 0x09, 0x45, 0xec, 0x98, 0x7b, 0xdf, 0xfe, 0x0c, 0x00, 0xa8, 0x1d, 0x74, 0x3a,
+	0x1d, 0x02, 0x00, 0x00,
+}
+
+func (m *SignedKeyshare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedKeyshare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedKeyshare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyShareIndex != 0 {
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(m.KeyShareIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Keyshare) > 0 {
+		i -= len(m.Keyshare)
+		copy(dAtA[i:], m.Keyshare)
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(len(m.Keyshare)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyshareEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyshareEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyshareEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Second != nil {
+		{
+			size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeyshareEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.First != nil {
+		{
+			size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeyshareEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintKeyshareEvidence(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeyshareEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeyshareEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignedKeyshare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keyshare)
+	if l > 0 {
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	if m.KeyShareIndex != 0 {
+		n += 1 + sovKeyshareEvidence(uint64(m.KeyShareIndex))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyshareEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	if m.First != nil {
+		l = m.First.Size()
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	if m.Second != nil {
+		l = m.Second.Size()
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovKeyshareEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovKeyshareEvidence(uint64(m.Height))
+	}
+	return n
+}
+
+func sovKeyshareEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyshareEvidence(x uint64) (n int) {
+	return sovKeyshareEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignedKeyshare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshareEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedKeyshare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedKeyshare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyshare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyshare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareIndex", wireType)
+			}
+			m.KeyShareIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyShareIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshareEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyshareEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshareEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyshareEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyshareEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.First == nil {
+				m.First = &SignedKeyshare{}
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Second == nil {
+				m.Second = &SignedKeyshare{}
+			}
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshareEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshareEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeyshareEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeyshareEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshareEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeyshareEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeyshareEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeyshareEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeyshareEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeyshareEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeyshareEvidence = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,787 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/keyshare_signing_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// KeyshareSigningInfo tracks the keyshare liveness of a validator over the signed keyshares window
+type KeyshareSigningInfo struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ConsAddr  string `protobuf:"bytes,2,opt,name=consAddr,proto3" json:"consAddr,omitempty"`
+	// height the current liveness window of the validator started at
+	StartHeight uint64 `protobuf:"varint,3,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	// number of blocks tracked in the current window, the bitmap index of a block is the offset modulo the window
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=indexOffset,proto3" json:"indexOffset,omitempty"`
+	Jailed      bool   `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// block height until which the validator can not unjail
+	JailedUntil uint64 `protobuf:"varint,6,opt,name=jailedUntil,proto3" json:"jailedUntil,omitempty"`
+	// number of blocks without a keyshare in the current window
+	MissedKeysharesCounter uint64 `protobuf:"varint,7,opt,name=missedKeysharesCounter,proto3" json:"missedKeysharesCounter,omitempty"`
+}
+
+func (m *KeyshareSigningInfo) Reset()         { *m = KeyshareSigningInfo{} }
+func (m *KeyshareSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*KeyshareSigningInfo) ProtoMessage()    {}
+func (*KeyshareSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0250efa1793ae3, []int{0}
+}
+func (m *KeyshareSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyshareSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyshareSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyshareSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyshareSigningInfo.Merge(m, src)
+}
+func (m *KeyshareSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyshareSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyshareSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyshareSigningInfo proto.InternalMessageInfo
+
+func (m *KeyshareSigningInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *KeyshareSigningInfo) GetConsAddr() string {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return ""
+}
+
+func (m *KeyshareSigningInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *KeyshareSigningInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *KeyshareSigningInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *KeyshareSigningInfo) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *KeyshareSigningInfo) GetMissedKeysharesCounter() uint64 {
+	if m != nil {
+		return m.MissedKeysharesCounter
+	}
+	return 0
+}
+
+// MissedKeysharesBitmap is the missed keyshares bitmap of a validator over the signed keyshares window
+type MissedKeysharesBitmap struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Bitmap    []byte `protobuf:"bytes,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (m *MissedKeysharesBitmap) Reset()         { *m = MissedKeysharesBitmap{} }
+func (m *MissedKeysharesBitmap) String() string { return proto.CompactTextString(m) }
+func (*MissedKeysharesBitmap) ProtoMessage()    {}
+func (*MissedKeysharesBitmap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c0250efa1793ae3, []int{1}
+}
+func (m *MissedKeysharesBitmap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedKeysharesBitmap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedKeysharesBitmap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedKeysharesBitmap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedKeysharesBitmap.Merge(m, src)
+}
+func (m *MissedKeysharesBitmap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedKeysharesBitmap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedKeysharesBitmap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedKeysharesBitmap proto.InternalMessageInfo
+
+func (m *MissedKeysharesBitmap) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MissedKeysharesBitmap) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KeyshareSigningInfo)(nil), "fairyring.keyshare.KeyshareSigningInfo")
+	proto.RegisterType((*MissedKeysharesBitmap)(nil), "fairyring.keyshare.MissedKeysharesBitmap")
+}
+
+func init() {
+	proto.RegisterFile("fairyring/keyshare/keyshare_signing_info.proto", fileDescriptor_0c0250efa1793ae3)
+}
+
+var fileDescriptor_0c0250efa1793ae3 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4f, 0x3a, 0x31,
+	0x1c, 0xc5, 0x29, 0x3f, 0x7e, 0x27, 0x54, 0xa7, 0x1a, 0x49, 0x63, 0x4c, 0x73, 0x61, 0x62, 0xba,
+	0x1b, 0x4c, 0xdc, 0xc5, 0xc4, 0x48, 0x0c, 0x31, 0x39, 0xe3, 0xe2, 0x42, 0x7a, 0xb4, 0x77, 0x7c,
+	0xe5, 0x68, 0x49, 0x5b, 0x0c, 0xfc, 0x01, 0xee, 0xfe, 0x59, 0x8e, 0x8c, 0x8e, 0x06, 0xfe, 0x11,
+	0xc3, 0x1d, 0x70, 0xe7, 0x60, 0xdc, 0xfa, 0xde, 0xfb, 0xbc, 0xa1, 0xdf, 0x87, 0x83, 0x84, 0x83,
+	0x59, 0x1a, 0x50, 0x69, 0x38, 0x91, 0x4b, 0x3b, 0xe6, 0x46, 0x1e, 0x1e, 0x43, 0x0b, 0xa9, 0x02,
+	0x95, 0x0e, 0x41, 0x25, 0x3a, 0x98, 0x19, 0xed, 0x34, 0x21, 0x07, 0x3e, 0xd8, 0x63, 0x9d, 0xb7,
+	0x3a, 0x3e, 0xbd, 0xdf, 0x89, 0xc7, 0xa2, 0xd2, 0x57, 0x89, 0x26, 0x17, 0xb8, 0xf5, 0xca, 0x33,
+	0x10, 0xdc, 0x69, 0x43, 0x91, 0x8f, 0xba, 0xad, 0xa8, 0x34, 0xc8, 0x39, 0x6e, 0x8e, 0xb4, 0xb2,
+	0xd7, 0x42, 0x18, 0x5a, 0xcf, 0xc3, 0x83, 0x26, 0x3e, 0x3e, 0xb6, 0x8e, 0x1b, 0x77, 0x27, 0x21,
+	0x1d, 0x3b, 0xfa, 0xcf, 0x47, 0xdd, 0x46, 0x54, 0xb5, 0xb6, 0x04, 0x28, 0x21, 0x17, 0x0f, 0x49,
+	0x62, 0xa5, 0xa3, 0x8d, 0x82, 0xa8, 0x58, 0xa4, 0x8d, 0xbd, 0x17, 0x0e, 0x99, 0x14, 0xf4, 0xbf,
+	0x8f, 0xba, 0xcd, 0x68, 0xa7, 0xb6, 0xcd, 0xe2, 0xf5, 0xa4, 0x1c, 0x64, 0xd4, 0x2b, 0x9a, 0x15,
+	0x8b, 0x5c, 0xe1, 0xf6, 0x14, 0xac, 0x95, 0x62, 0xff, 0x29, 0x7b, 0xa3, 0xe7, 0xca, 0x49, 0x43,
+	0x8f, 0x72, 0xf8, 0x97, 0xb4, 0x33, 0xc0, 0x67, 0x83, 0x9f, 0x49, 0x0f, 0xdc, 0x94, 0xcf, 0xfe,
+	0x38, 0x44, 0x1b, 0x7b, 0x71, 0xce, 0xe5, 0x67, 0x38, 0x89, 0x76, 0xaa, 0xd7, 0xff, 0x58, 0x33,
+	0xb4, 0x5a, 0x33, 0xf4, 0xb5, 0x66, 0xe8, 0x7d, 0xc3, 0x6a, 0xab, 0x0d, 0xab, 0x7d, 0x6e, 0x58,
+	0xed, 0x39, 0x4c, 0xc1, 0x8d, 0xe7, 0x71, 0x30, 0xd2, 0xd3, 0xf0, 0x96, 0x83, 0x89, 0x33, 0x3d,
+	0x9a, 0x84, 0xe5, 0x92, 0x8b, 0x72, 0x4b, 0xb7, 0x9c, 0x49, 0x1b, 0x7b, 0xf9, 0x78, 0x97, 0xdf,
+	0x03, 0x00, 0x27, 0x17, 0xea, 0x5e, 0xee, 0x01, 0x00, 0x00,
+}
+
+func (m *KeyshareSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyshareSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyshareSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedKeysharesCounter != 0 {
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(m.MissedKeysharesCounter))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedKeysharesBitmap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedKeysharesBitmap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedKeysharesBitmap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintKeyshareSigningInfo(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeyshareSigningInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeyshareSigningInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeyshareSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovKeyshareSigningInfo(uint64(l))
+	}
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovKeyshareSigningInfo(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovKeyshareSigningInfo(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovKeyshareSigningInfo(uint64(m.IndexOffset))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovKeyshareSigningInfo(uint64(m.JailedUntil))
+	}
+	if m.MissedKeysharesCounter != 0 {
+		n += 1 + sovKeyshareSigningInfo(uint64(m.MissedKeysharesCounter))
+	}
+	return n
+}
+
+func (m *MissedKeysharesBitmap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovKeyshareSigningInfo(uint64(l))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovKeyshareSigningInfo(uint64(l))
+	}
+	return n
+}
+
+func sovKeyshareSigningInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyshareSigningInfo(x uint64) (n int) {
+	return sovKeyshareSigningInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyshareSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshareSigningInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyshareSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyshareSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedKeysharesCounter", wireType)
+			}
+			m.MissedKeysharesCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedKeysharesCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshareSigningInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedKeysharesBitmap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshareSigningInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedKeysharesBitmap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedKeysharesBitmap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshareSigningInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshareSigningInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeyshareSigningInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeyshareSigningInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshareSigningInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeyshareSigningInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeyshareSigningInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeyshareSigningInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeyshareSigningInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeyshareSigningInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeyshareSigningInfo = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgStartDkg{}
+	_ sdk.Msg = &MsgSubmitDkgDeal{}
+	_ sdk.Msg = &MsgSubmitDkgComplaint{}
+	_ sdk.Msg = &MsgSubmitDkgJustification{}
+	_ sdk.Msg = &MsgStartResharing{}
+)
+
+func NewMsgStartDkg(creator string, threshold uint64) *MsgStartDkg {
+	return &MsgStartDkg{
+		Creator:   creator,
+		Threshold: threshold,
+	}
+}
+
+func (msg *MsgStartDkg) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Threshold == 0 {
+		return ErrInvalidThreshold.Wrap("expected threshold to be at least 1")
+	}
+	return nil
+}
+
+func NewMsgSubmitDkgDeal(
+	creator string,
+	roundID uint64,
+	commitments []string,
+	encryptedShares []*EncryptedKeyShare,
+) *MsgSubmitDkgDeal {
+	return &MsgSubmitDkgDeal{
+		Creator:         creator,
+		RoundId:         roundID,
+		Commitments:     commitments,
+		EncryptedShares: encryptedShares,
+	}
+}
+
+func (msg *MsgSubmitDkgDeal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Commitments) == 0 {
+		return ErrEmptyCommitments
+	}
+	if len(msg.EncryptedShares) == 0 {
+		return ErrEmptyEncryptedShares
+	}
+
+	for _, c := range msg.Commitments {
+		if len(c) != CommitmentHexLength {
+			return ErrInvalidCommitmentLength.Wrapf("expected hex encoding commitment to be length: %d", CommitmentHexLength)
+		}
+		if _, err = hex.DecodeString(c); err != nil {
+			return ErrInvalidCommitment.Wrapf("expected hex encoded commitment, got: %s", c)
+		}
+	}
+
+	for i, v := range msg.EncryptedShares {
+		if v == nil {
+			return ErrEmptyEncryptedShares
+		}
+
+		_, err := sdk.AccAddressFromBech32(v.Validator)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid validator address in encrypted share array index: [%d], Error: (%s)", i, err)
+		}
+
+		if _, err = base64.StdEncoding.DecodeString(v.Data); err != nil || len(v.Data) == 0 {
+			return ErrInvalidEncryptedShareData.Wrapf("expected base64 encoded encrypted share, got: %s", v.Data)
+		}
+	}
+	return nil
+}
+
+func NewMsgSubmitDkgComplaint(creator string, roundID uint64, dealer string) *MsgSubmitDkgComplaint {
+	return &MsgSubmitDkgComplaint{
+		Creator: creator,
+		RoundId: roundID,
+		Dealer:  dealer,
+	}
+}
+
+func (msg *MsgSubmitDkgComplaint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Dealer)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid dealer address (%s)", err)
+	}
+	if msg.Creator == msg.Dealer {
+		return ErrInvalidDkgDeal.Wrap("dealer can not complain about its own deal")
+	}
+	return nil
+}
+
+func NewMsgSubmitDkgJustification(
+	creator string,
+	roundID uint64,
+	complainer string,
+	share string,
+) *MsgSubmitDkgJustification {
+	return &MsgSubmitDkgJustification{
+		Creator:    creator,
+		RoundId:    roundID,
+		Complainer: complainer,
+		Share:      share,
+	}
+}
+
+func (msg *MsgSubmitDkgJustification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Complainer)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid complainer address (%s)", err)
+	}
+	if _, err = hex.DecodeString(msg.Share); err != nil || len(msg.Share) == 0 {
+		return ErrInvalidShare.Wrapf("expected hex encoded share, got: %s", msg.Share)
+	}
+	return nil
+}
+
+func NewMsgStartResharing(creator string, threshold uint64) *MsgStartResharing {
+	return &MsgStartResharing{
+		Creator:   creator,
+		Threshold: threshold,
+	}
+}
+
+func (msg *MsgStartResharing) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Threshold == 0 {
+		return ErrInvalidThreshold.Wrap("expected threshold to be at least 1")
+	}
+	return nil
+}
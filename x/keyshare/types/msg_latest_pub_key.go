@@ -21,6 +21,7 @@ func NewMsgCreateLatestPubKey(
 	publicKey string,
 	commitments []string,
 	numberOfValidators uint64,
+	threshold uint64,
 	encryptedKeyShares []*EncryptedKeyShare,
 ) *MsgCreateLatestPubKey {
 	return &MsgCreateLatestPubKey{
@@ -28,6 +29,7 @@ func NewMsgCreateLatestPubKey(
 		PublicKey:          publicKey,
 		Commitments:        commitments,
 		NumberOfValidators: numberOfValidators,
+		Threshold:          threshold,
 		EncryptedKeyShares: encryptedKeyShares,
 	}
 }
@@ -52,6 +54,9 @@ func (msg *MsgCreateLatestPubKey) ValidateBasic() error {
 	if msg.NumberOfValidators != uint64(len(msg.Commitments)) {
 		return ErrNotMatchNumOfCommits.Wrapf("expected number of validators: %d, match number of commitments: %d", msg.NumberOfValidators, len(msg.Commitments))
 	}
+	if msg.Threshold == 0 || msg.Threshold > msg.NumberOfValidators {
+		return ErrInvalidThreshold.Wrapf("expected threshold between 1 and number of validators: %d, got: %d", msg.NumberOfValidators, msg.Threshold)
+	}
 	if len(msg.EncryptedKeyShares) != len(msg.Commitments) {
 		return ErrNotMatchNumOfEncryptedKeyShares.Wrapf("expected number of encrypted key shares: %d, match number of commitments: %d", len(msg.EncryptedKeyShares), len(msg.Commitments))
 	}
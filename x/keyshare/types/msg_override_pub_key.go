@@ -16,6 +16,7 @@ func NewMsgOverrideLatestPubKey(
 	publicKey string,
 	commitments []string,
 	numberOfValidators uint64,
+	threshold uint64,
 	encryptedKeyShares []*EncryptedKeyShare,
 ) *MsgOverrideLatestPubKey {
 	return &MsgOverrideLatestPubKey{
@@ -23,6 +24,7 @@ func NewMsgOverrideLatestPubKey(
 		PublicKey:          publicKey,
 		Commitments:        commitments,
 		NumberOfValidators: numberOfValidators,
+		Threshold:          threshold,
 		EncryptedKeyShares: encryptedKeyShares,
 	}
 }
@@ -47,6 +49,9 @@ func (msg *MsgOverrideLatestPubKey) ValidateBasic() error {
 	if msg.NumberOfValidators != uint64(len(msg.Commitments)) {
 		return ErrNotMatchNumOfCommits.Wrapf("expected number of validators: %d, match number of commitments: %d", msg.NumberOfValidators, len(msg.Commitments))
 	}
+	if msg.Threshold == 0 || msg.Threshold > msg.NumberOfValidators {
+		return ErrInvalidThreshold.Wrapf("expected threshold between 1 and number of validators: %d, got: %d", msg.NumberOfValidators, msg.Threshold)
+	}
 	if len(msg.EncryptedKeyShares) != len(msg.Commitments) {
 		return ErrNotMatchNumOfEncryptedKeyShares.Wrapf("expected number of encrypted key shares: %d, match number of commitments: %d", len(msg.EncryptedKeyShares), len(msg.Commitments))
 	}
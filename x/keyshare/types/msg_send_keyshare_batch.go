@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxKeyshareBatchSize = 100
+)
+
+var _ sdk.Msg = &MsgSendKeyshareBatch{}
+
+func NewMsgSendKeyshareBatch(creator string, keyshares []*KeyshareBatchItem) *MsgSendKeyshareBatch {
+	return &MsgSendKeyshareBatch{
+		Creator:   creator,
+		Keyshares: keyshares,
+	}
+}
+
+func (msg *MsgSendKeyshareBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Keyshares) == 0 {
+		return ErrInvalidKeyshareBatch.Wrap("expected at least one keyshare")
+	}
+	if len(msg.Keyshares) > MaxKeyshareBatchSize {
+		return ErrInvalidKeyshareBatch.Wrapf("expected at most %d keyshares, got: %d", MaxKeyshareBatchSize, len(msg.Keyshares))
+	}
+	for _, item := range msg.Keyshares {
+		if item == nil {
+			return ErrInvalidKeyshareBatch.Wrap("keyshare can not be empty")
+		}
+		if err = item.validateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsGeneral returns true for a general keyshare, false for the keyshare of a block height
+func (item KeyshareBatchItem) IsGeneral() bool {
+	return item.IdType != ""
+}
+
+func (item KeyshareBatchItem) validateBasic() error {
+	if len(item.KeyShare) != KeyShareHexLen {
+		return ErrInvalidKeyShareLength.Wrapf("expected hex encoded key share length to be %d", KeyShareHexLen)
+	}
+	if _, err := hex.DecodeString(item.KeyShare); err != nil {
+		return ErrInvalidShare.Wrapf("expected hex encoded key share, got: %s", item.KeyShare)
+	}
+	if item.KeyShareIndex < 1 {
+		return ErrInvalidShare.Wrapf("expected key share index to be at least 1, got: %d", item.KeyShareIndex)
+	}
+	if item.IsGeneral() && item.IdValue == "" {
+		return ErrInvalidKeyshareBatch.Wrap("general keyshare id value can not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitKeyshareEvidence{}
+
+func NewMsgSubmitKeyshareEvidence(
+	creator string,
+	validator string,
+	identity string,
+	first *SignedKeyshare,
+	second *SignedKeyshare,
+) *MsgSubmitKeyshareEvidence {
+	return &MsgSubmitKeyshareEvidence{
+		Creator:   creator,
+		Validator: validator,
+		Identity:  identity,
+		First:     first,
+		Second:    second,
+	}
+}
+
+func (msg *MsgSubmitKeyshareEvidence) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if len(msg.Identity) == 0 {
+		return ErrInvalidKeyshareEvidence.Wrap("identity can not be empty")
+	}
+
+	for _, s := range []*SignedKeyshare{msg.First, msg.Second} {
+		if s == nil {
+			return ErrInvalidKeyshareEvidence.Wrap("expected two keyshares")
+		}
+		if err = s.validateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if !msg.First.ConflictsWith(*msg.Second) {
+		return ErrInvalidKeyshareEvidence.Wrap("keyshares are not conflicting")
+	}
+
+	return nil
+}
+
+func (s SignedKeyshare) validateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Submitter); err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid keyshare submitter address (%s)", err)
+	}
+	if s.KeyShareIndex < 1 {
+		return ErrInvalidKeyShareIndex.Wrap("expected keyshare index to be at least 1")
+	}
+	if _, err := hex.DecodeString(s.Keyshare); err != nil || len(s.Keyshare) == 0 {
+		return ErrDecodingKeyShare.Wrapf("expected hex encoded keyshare, got: %s", s.Keyshare)
+	}
+	if _, err := base64.StdEncoding.DecodeString(s.Signature); err != nil {
+		return ErrInvalidKeyshareSignature.Wrap("expected base64 encoded signature")
+	}
+	return nil
+}
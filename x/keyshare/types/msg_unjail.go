@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUnjail{}
+
+func NewMsgUnjail(creator string) *MsgUnjail {
+	return &MsgUnjail{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgUnjail) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
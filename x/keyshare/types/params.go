@@ -40,6 +40,53 @@ var (
 	DefaultMaxIdledBlock uint64 = 10
 )
 
+var (
+	KeyKeyShareRetentionBlocks                      = []byte("KeyShareRetentionBlocks")
+	DefaultKeyShareRetentionBlocks           uint64 = 0
+	KeyAggregatedKeyShareRetentionBlocks            = []byte("AggregatedKeyShareRetentionBlocks")
+	DefaultAggregatedKeyShareRetentionBlocks uint64 = 0
+	KeyMaxPrunedEntriesPerBlock                     = []byte("MaxPrunedEntriesPerBlock")
+	DefaultMaxPrunedEntriesPerBlock          uint64 = 100
+)
+
+var (
+	KeyMinimumThresholdRatio     = []byte("MinimumThresholdRatio")
+	DefaultMinimumThresholdRatio = math.LegacyNewDec(2).QuoInt64(3) // 2/3
+)
+
+var (
+	KeyStakeWeightedThresholdRatio     = []byte("StakeWeightedThresholdRatio")
+	DefaultStakeWeightedThresholdRatio = math.LegacyZeroDec() // disabled
+)
+
+var (
+	KeyDkgDealPhaseBlocks                     = []byte("DkgDealPhaseBlocks")
+	DefaultDkgDealPhaseBlocks          uint64 = 20
+	KeyDkgComplaintPhaseBlocks                = []byte("DkgComplaintPhaseBlocks")
+	DefaultDkgComplaintPhaseBlocks     uint64 = 10
+	KeyDkgJustificationPhaseBlocks            = []byte("DkgJustificationPhaseBlocks")
+	DefaultDkgJustificationPhaseBlocks uint64 = 10
+)
+
+var (
+	KeySignedKeysharesWindow            = []byte("SignedKeysharesWindow")
+	DefaultSignedKeysharesWindow uint64 = 100
+	KeyMinSubmittedPerWindow            = []byte("MinSubmittedPerWindow")
+	DefaultMinSubmittedPerWindow        = math.LegacyNewDecWithPrec(5, 1) // 0.5
+	KeyDowntimeJailBlocks               = []byte("DowntimeJailBlocks")
+	DefaultDowntimeJailBlocks    uint64 = 100
+)
+
+var (
+	KeySlashFractionEquivocation     = []byte("SlashFractionEquivocation")
+	DefaultSlashFractionEquivocation = math.LegacyNewDecWithPrec(5, 1) // 0.5
+)
+
+var (
+	KeyRewardEpochBlocks            = []byte("RewardEpochBlocks")
+	DefaultRewardEpochBlocks uint64 = 100
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -53,14 +100,40 @@ func NewParams(
 	noKeyShareFraction math.LegacyDec,
 	wrongKeyShareFraction math.LegacyDec,
 	maxIdledBlock uint64,
+	keyShareRetentionBlocks uint64,
+	aggregatedKeyShareRetentionBlocks uint64,
+	maxPrunedEntriesPerBlock uint64,
+	minimumThresholdRatio math.LegacyDec,
+	stakeWeightedThresholdRatio math.LegacyDec,
+	dkgDealPhaseBlocks uint64,
+	dkgComplaintPhaseBlocks uint64,
+	dkgJustificationPhaseBlocks uint64,
+	signedKeysharesWindow uint64,
+	minSubmittedPerWindow math.LegacyDec,
+	downtimeJailBlocks uint64,
+	equivocationFraction math.LegacyDec,
+	rewardEpochBlocks uint64,
 ) Params {
 	return Params{
-		KeyExpiry:                  keyExp,
-		TrustedAddresses:           trAddrs,
-		SlashFractionNoKeyshare:    noKeyShareFraction,
-		SlashFractionWrongKeyshare: wrongKeyShareFraction,
-		MaxIdledBlock:              maxIdledBlock,
-		MinimumBonded:              minimumBonded,
+		KeyExpiry:                         keyExp,
+		TrustedAddresses:                  trAddrs,
+		SlashFractionNoKeyshare:           noKeyShareFraction,
+		SlashFractionWrongKeyshare:        wrongKeyShareFraction,
+		MaxIdledBlock:                     maxIdledBlock,
+		MinimumBonded:                     minimumBonded,
+		KeyShareRetentionBlocks:           keyShareRetentionBlocks,
+		AggregatedKeyShareRetentionBlocks: aggregatedKeyShareRetentionBlocks,
+		MaxPrunedEntriesPerBlock:          maxPrunedEntriesPerBlock,
+		MinimumThresholdRatio:             minimumThresholdRatio,
+		StakeWeightedThresholdRatio:       stakeWeightedThresholdRatio,
+		DkgDealPhaseBlocks:                dkgDealPhaseBlocks,
+		DkgComplaintPhaseBlocks:           dkgComplaintPhaseBlocks,
+		DkgJustificationPhaseBlocks:       dkgJustificationPhaseBlocks,
+		SignedKeysharesWindow:             signedKeysharesWindow,
+		MinSubmittedPerWindow:             minSubmittedPerWindow,
+		DowntimeJailBlocks:                downtimeJailBlocks,
+		SlashFractionEquivocation:         equivocationFraction,
+		RewardEpochBlocks:                 rewardEpochBlocks,
 	}
 }
 
@@ -73,6 +146,19 @@ func DefaultParams() Params {
 		DefaultSlashFractionNoKeyShare,
 		DefaultSlashFractionWrongKeyShare,
 		DefaultMaxIdledBlock,
+		DefaultKeyShareRetentionBlocks,
+		DefaultAggregatedKeyShareRetentionBlocks,
+		DefaultMaxPrunedEntriesPerBlock,
+		DefaultMinimumThresholdRatio,
+		DefaultStakeWeightedThresholdRatio,
+		DefaultDkgDealPhaseBlocks,
+		DefaultDkgComplaintPhaseBlocks,
+		DefaultDkgJustificationPhaseBlocks,
+		DefaultSignedKeysharesWindow,
+		DefaultMinSubmittedPerWindow,
+		DefaultDowntimeJailBlocks,
+		DefaultSlashFractionEquivocation,
+		DefaultRewardEpochBlocks,
 	)
 }
 
@@ -85,6 +171,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashFractionNoKeyShare, &p.SlashFractionNoKeyshare, validateSlashFractionNoKeyshare),
 		paramtypes.NewParamSetPair(KeySlashFractionWrongKeyShare, &p.SlashFractionWrongKeyshare, validateSlashFractionWrongKeyshare),
 		paramtypes.NewParamSetPair(KeyMaxIdledBlock, &p.MaxIdledBlock, validateMaxIdledBlock),
+		paramtypes.NewParamSetPair(KeyKeyShareRetentionBlocks, &p.KeyShareRetentionBlocks, validateRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyAggregatedKeyShareRetentionBlocks, &p.AggregatedKeyShareRetentionBlocks, validateRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyMaxPrunedEntriesPerBlock, &p.MaxPrunedEntriesPerBlock, validateMaxPrunedEntriesPerBlock),
+		paramtypes.NewParamSetPair(KeyMinimumThresholdRatio, &p.MinimumThresholdRatio, validateMinimumThresholdRatio),
+		paramtypes.NewParamSetPair(KeyStakeWeightedThresholdRatio, &p.StakeWeightedThresholdRatio, validateStakeWeightedThresholdRatio),
+		paramtypes.NewParamSetPair(KeyDkgDealPhaseBlocks, &p.DkgDealPhaseBlocks, validateDkgPhaseBlocks),
+		paramtypes.NewParamSetPair(KeyDkgComplaintPhaseBlocks, &p.DkgComplaintPhaseBlocks, validateDkgPhaseBlocks),
+		paramtypes.NewParamSetPair(KeyDkgJustificationPhaseBlocks, &p.DkgJustificationPhaseBlocks, validateDkgPhaseBlocks),
+		paramtypes.NewParamSetPair(KeySignedKeysharesWindow, &p.SignedKeysharesWindow, validateSignedKeysharesWindow),
+		paramtypes.NewParamSetPair(KeyMinSubmittedPerWindow, &p.MinSubmittedPerWindow, validateMinSubmittedPerWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailBlocks, &p.DowntimeJailBlocks, validateDowntimeJailBlocks),
+		paramtypes.NewParamSetPair(KeySlashFractionEquivocation, &p.SlashFractionEquivocation, validateSlashFractionEquivocation),
+		paramtypes.NewParamSetPair(KeyRewardEpochBlocks, &p.RewardEpochBlocks, validateRewardEpochBlocks),
 	}
 }
 
@@ -101,6 +200,58 @@ func (p Params) Validate() error {
 	if err := validateMinimumBonded(p.MinimumBonded); err != nil {
 		return err
 	}
+
+	if err := validateRetentionBlocks(p.KeyShareRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateRetentionBlocks(p.AggregatedKeyShareRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxPrunedEntriesPerBlock(p.MaxPrunedEntriesPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMinimumThresholdRatio(p.MinimumThresholdRatio); err != nil {
+		return err
+	}
+
+	if err := validateStakeWeightedThresholdRatio(p.StakeWeightedThresholdRatio); err != nil {
+		return err
+	}
+
+	if err := validateDkgPhaseBlocks(p.DkgDealPhaseBlocks); err != nil {
+		return err
+	}
+
+	if err := validateDkgPhaseBlocks(p.DkgComplaintPhaseBlocks); err != nil {
+		return err
+	}
+
+	if err := validateDkgPhaseBlocks(p.DkgJustificationPhaseBlocks); err != nil {
+		return err
+	}
+
+	if err := validateSignedKeysharesWindow(p.SignedKeysharesWindow); err != nil {
+		return err
+	}
+
+	if err := validateMinSubmittedPerWindow(p.MinSubmittedPerWindow); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailBlocks(p.DowntimeJailBlocks); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionEquivocation(p.SlashFractionEquivocation); err != nil {
+		return err
+	}
+
+	if err := validateRewardEpochBlocks(p.RewardEpochBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -176,3 +327,117 @@ func validateMaxIdledBlock(v interface{}) error {
 
 	return nil
 }
+
+// validateRetentionBlocks validates the KeyShareRetentionBlocks and AggregatedKeyShareRetentionBlocks params
+func validateRetentionBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxPrunedEntriesPerBlock validates the MaxPrunedEntriesPerBlock param
+func validateMaxPrunedEntriesPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMinimumThresholdRatio validates the MinimumThresholdRatio param
+func validateMinimumThresholdRatio(v interface{}) error {
+	val, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.LTE(math.LegacyZeroDec()) || val.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, not including 0, got %v", val)
+	}
+	return nil
+}
+
+// validateStakeWeightedThresholdRatio validates the StakeWeightedThresholdRatio param
+func validateStakeWeightedThresholdRatio(v interface{}) error {
+	val, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+	return nil
+}
+
+// validateDkgPhaseBlocks validates the DkgDealPhaseBlocks, DkgComplaintPhaseBlocks and DkgJustificationPhaseBlocks params
+func validateDkgPhaseBlocks(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("invalid parameter value, expected at least 1 block, got %d", val)
+	}
+	return nil
+}
+
+// validateSignedKeysharesWindow validates the SignedKeysharesWindow param
+func validateSignedKeysharesWindow(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("invalid parameter value, expected at least 1 block, got %d", val)
+	}
+	return nil
+}
+
+// validateMinSubmittedPerWindow validates the MinSubmittedPerWindow param
+func validateMinSubmittedPerWindow(v interface{}) error {
+	val, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+	return nil
+}
+
+// validateDowntimeJailBlocks validates the DowntimeJailBlocks param
+func validateDowntimeJailBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateSlashFractionEquivocation validates the SlashFractionEquivocation param
+func validateSlashFractionEquivocation(v interface{}) error {
+	val, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.LTE(math.LegacyNewDec(0)) || val.GT(math.LegacyNewDec(1)) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, not including 0, got %v", val)
+	}
+	return nil
+}
+
+// validateRewardEpochBlocks validates the RewardEpochBlocks param
+func validateRewardEpochBlocks(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("invalid parameter value, expected at least 1 block, got %d", val)
+	}
+	return nil
+}
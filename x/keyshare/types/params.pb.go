@@ -27,12 +27,39 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	KeyExpiry                  uint64                      `protobuf:"varint,1,opt,name=key_expiry,json=keyExpiry,proto3" json:"key_expiry,omitempty" yaml:"key_expiry"`
-	MinimumBonded              uint64                      `protobuf:"varint,2,opt,name=minimum_bonded,json=minimumBonded,proto3" json:"minimum_bonded,omitempty" yaml:"minimum_bonded"`
+	KeyExpiry     uint64 `protobuf:"varint,1,opt,name=key_expiry,json=keyExpiry,proto3" json:"key_expiry,omitempty" yaml:"key_expiry"`
+	MinimumBonded uint64 `protobuf:"varint,2,opt,name=minimum_bonded,json=minimumBonded,proto3" json:"minimum_bonded,omitempty" yaml:"minimum_bonded"`
+	// deprecated, keyshare liveness is tracked over the signed keyshares window
 	MaxIdledBlock              uint64                      `protobuf:"varint,3,opt,name=max_idled_block,json=maxIdledBlock,proto3" json:"max_idled_block,omitempty" yaml:"max_idled_block"`
 	TrustedAddresses           []string                    `protobuf:"bytes,4,rep,name=trusted_addresses,json=trustedAddresses,proto3" json:"trusted_addresses,omitempty" yaml:"trusted_addresses"`
 	SlashFractionNoKeyshare    cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_no_keyshare,json=slashFractionNoKeyshare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_no_keyshare" yaml:"slash_fraction_no_keyshare"`
 	SlashFractionWrongKeyshare cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_fraction_wrong_keyshare,json=slashFractionWrongKeyshare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_wrong_keyshare" yaml:"slash_fraction_wrong_keyshare"`
+	// number of blocks for which individual keyshares are kept, 0 keeps them forever
+	KeyShareRetentionBlocks uint64 `protobuf:"varint,7,opt,name=key_share_retention_blocks,json=keyShareRetentionBlocks,proto3" json:"key_share_retention_blocks,omitempty" yaml:"key_share_retention_blocks"`
+	// number of blocks for which aggregated keyshares are kept, 0 keeps them forever
+	AggregatedKeyShareRetentionBlocks uint64 `protobuf:"varint,8,opt,name=aggregated_key_share_retention_blocks,json=aggregatedKeyShareRetentionBlocks,proto3" json:"aggregated_key_share_retention_blocks,omitempty" yaml:"aggregated_key_share_retention_blocks"`
+	// maximum number of entries removed from each pruned store per block
+	MaxPrunedEntriesPerBlock uint64 `protobuf:"varint,9,opt,name=max_pruned_entries_per_block,json=maxPrunedEntriesPerBlock,proto3" json:"max_pruned_entries_per_block,omitempty" yaml:"max_pruned_entries_per_block"`
+	// minimum ratio of the key threshold to the number of validators a new public key can be created with
+	MinimumThresholdRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=minimum_threshold_ratio,json=minimumThresholdRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minimum_threshold_ratio" yaml:"minimum_threshold_ratio"`
+	// minimum ratio of the keyshare validator set power the keyshares of a key have to be backed by before it is aggregated, 0 disables it
+	StakeWeightedThresholdRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=stake_weighted_threshold_ratio,json=stakeWeightedThresholdRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stake_weighted_threshold_ratio" yaml:"stake_weighted_threshold_ratio"`
+	// number of blocks a dkg round accepts deals for
+	DkgDealPhaseBlocks uint64 `protobuf:"varint,12,opt,name=dkg_deal_phase_blocks,json=dkgDealPhaseBlocks,proto3" json:"dkg_deal_phase_blocks,omitempty" yaml:"dkg_deal_phase_blocks"`
+	// number of blocks a dkg round accepts complaints for after the deal phase
+	DkgComplaintPhaseBlocks uint64 `protobuf:"varint,13,opt,name=dkg_complaint_phase_blocks,json=dkgComplaintPhaseBlocks,proto3" json:"dkg_complaint_phase_blocks,omitempty" yaml:"dkg_complaint_phase_blocks"`
+	// number of blocks dealers have to answer complaints after the complaint phase
+	DkgJustificationPhaseBlocks uint64 `protobuf:"varint,14,opt,name=dkg_justification_phase_blocks,json=dkgJustificationPhaseBlocks,proto3" json:"dkg_justification_phase_blocks,omitempty" yaml:"dkg_justification_phase_blocks"`
+	// number of blocks the keyshare liveness of a validator is tracked over
+	SignedKeysharesWindow uint64 `protobuf:"varint,15,opt,name=signed_keyshares_window,json=signedKeysharesWindow,proto3" json:"signed_keyshares_window,omitempty" yaml:"signed_keyshares_window"`
+	// minimum ratio of blocks in the window a validator has to submit a keyshare for
+	MinSubmittedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=min_submitted_per_window,json=minSubmittedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_submitted_per_window" yaml:"min_submitted_per_window"`
+	// number of blocks a validator is jailed from the keyshare set for downtime
+	DowntimeJailBlocks uint64 `protobuf:"varint,17,opt,name=downtime_jail_blocks,json=downtimeJailBlocks,proto3" json:"downtime_jail_blocks,omitempty" yaml:"downtime_jail_blocks"`
+	// fraction of the stake slashed from a validator producing conflicting keyshares for the same identity
+	SlashFractionEquivocation cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=slash_fraction_equivocation,json=slashFractionEquivocation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_equivocation" yaml:"slash_fraction_equivocation"`
+	// number of blocks after which the keyshare reward pool is paid out to the keyshare submitters
+	RewardEpochBlocks uint64 `protobuf:"varint,19,opt,name=reward_epoch_blocks,json=rewardEpochBlocks,proto3" json:"reward_epoch_blocks,omitempty" yaml:"reward_epoch_blocks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +123,69 @@ func (m *Params) GetTrustedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetKeyShareRetentionBlocks() uint64 {
+	if m != nil {
+		return m.KeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAggregatedKeyShareRetentionBlocks() uint64 {
+	if m != nil {
+		return m.AggregatedKeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedEntriesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedEntriesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDkgDealPhaseBlocks() uint64 {
+	if m != nil {
+		return m.DkgDealPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDkgComplaintPhaseBlocks() uint64 {
+	if m != nil {
+		return m.DkgComplaintPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDkgJustificationPhaseBlocks() uint64 {
+	if m != nil {
+		return m.DkgJustificationPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSignedKeysharesWindow() uint64 {
+	if m != nil {
+		return m.SignedKeysharesWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailBlocks() uint64 {
+	if m != nil {
+		return m.DowntimeJailBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRewardEpochBlocks() uint64 {
+	if m != nil {
+		return m.RewardEpochBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fairyring.keyshare.Params")
 }
@@ -103,36 +193,66 @@ func init() {
 func init() { proto.RegisterFile("fairyring/keyshare/params.proto", fileDescriptor_09ef7bd565425b36) }
 
 var fileDescriptor_09ef7bd565425b36 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x1c, 0xed, 0xb8, 0xb5, 0xb0, 0x83, 0xff, 0x1a, 0x5c, 0x8d, 0x51, 0x33, 0x35, 0x78, 0x28, 0x1e,
-	0x12, 0x41, 0x2f, 0xee, 0x49, 0x83, 0x2e, 0x96, 0x15, 0x91, 0x5c, 0x04, 0x2f, 0x61, 0x9a, 0x99,
-	0x4d, 0x87, 0x74, 0x32, 0x65, 0x26, 0xc5, 0xce, 0x07, 0xf0, 0xe2, 0x41, 0xfc, 0x08, 0x7e, 0x04,
-	0x3f, 0xc6, 0x1e, 0xf7, 0x28, 0x1e, 0x82, 0xb4, 0x07, 0x3d, 0xe7, 0x13, 0x48, 0x26, 0xa9, 0xa5,
-	0xea, 0x42, 0x2f, 0xe1, 0xf7, 0x7b, 0xef, 0xfd, 0x5e, 0x1e, 0xc9, 0x83, 0xe8, 0x04, 0x33, 0xa9,
-	0x25, 0xcb, 0xd3, 0x20, 0xa3, 0x5a, 0x4d, 0xb0, 0xa4, 0xc1, 0x0c, 0x4b, 0xcc, 0x95, 0x3f, 0x93,
-	0xa2, 0x10, 0x96, 0xf5, 0x47, 0xe0, 0xaf, 0x05, 0x4e, 0x1f, 0x73, 0x96, 0x8b, 0xc0, 0x3c, 0x1b,
-	0x99, 0x73, 0x3d, 0x15, 0xa9, 0x30, 0x63, 0x50, 0x4f, 0x0d, 0xea, 0xad, 0xba, 0xb0, 0xf7, 0xc6,
-	0xb8, 0x59, 0x8f, 0x21, 0xcc, 0xa8, 0x8e, 0xe9, 0x62, 0xc6, 0xa4, 0xb6, 0xc1, 0x00, 0x0c, 0xbb,
-	0xe1, 0x41, 0x55, 0xa2, 0xbe, 0xc6, 0x7c, 0x7a, 0xe8, 0x6d, 0x38, 0x2f, 0xda, 0xcf, 0xa8, 0x7e,
-	0x61, 0x66, 0xeb, 0x29, 0xbc, 0xc2, 0x59, 0xce, 0xf8, 0x9c, 0xc7, 0x63, 0x91, 0x13, 0x4a, 0xec,
-	0x0b, 0xe6, 0xf2, 0x56, 0x55, 0xa2, 0x83, 0xe6, 0x72, 0x9b, 0xf7, 0xa2, 0xcb, 0x2d, 0x10, 0x9a,
-	0xdd, 0x0a, 0xe1, 0x55, 0x8e, 0x17, 0x31, 0x23, 0x53, 0x4a, 0xe2, 0xf1, 0x54, 0x24, 0x99, 0xbd,
-	0x67, 0x2c, 0x9c, 0xaa, 0x44, 0x37, 0x5a, 0x8b, 0x6d, 0x41, 0xed, 0x81, 0x17, 0xa3, 0x1a, 0x08,
-	0xeb, 0xdd, 0x1a, 0xc1, 0x7e, 0x21, 0xe7, 0xaa, 0xa0, 0x24, 0xc6, 0x84, 0x48, 0xaa, 0x14, 0x55,
-	0x76, 0x77, 0xb0, 0x37, 0xdc, 0x0f, 0xef, 0x54, 0x25, 0xb2, 0x1b, 0x97, 0x7f, 0x24, 0x5e, 0x74,
-	0xad, 0xc5, 0x9e, 0xad, 0x21, 0xeb, 0x03, 0x80, 0x8e, 0x9a, 0x62, 0x35, 0x89, 0x4f, 0x24, 0x4e,
-	0x0a, 0x26, 0xf2, 0x38, 0x17, 0xf1, 0xfa, 0xcb, 0xda, 0x17, 0x07, 0x60, 0x78, 0x29, 0x7c, 0x79,
-	0x5a, 0xa2, 0xce, 0xf7, 0x12, 0xdd, 0x4e, 0x84, 0xe2, 0x42, 0x29, 0x92, 0xf9, 0x4c, 0x04, 0x1c,
-	0x17, 0x13, 0xff, 0x15, 0x4d, 0x71, 0xa2, 0x9f, 0xd3, 0xa4, 0x2a, 0xd1, 0xbd, 0xe6, 0xbd, 0xe7,
-	0xdb, 0x79, 0xd1, 0x4d, 0x43, 0x1e, 0xb5, 0xdc, 0x6b, 0x71, 0xdc, 0x32, 0xd6, 0x27, 0x00, 0xef,
-	0xfe, 0x75, 0xf8, 0x5e, 0x8a, 0x3c, 0xdd, 0x44, 0xe9, 0x99, 0x28, 0xc7, 0xbb, 0x45, 0xb9, 0xff,
-	0xdf, 0x28, 0xdb, 0x8e, 0x5e, 0xe4, 0x6c, 0xa5, 0x79, 0x5b, 0xb3, 0xeb, 0x40, 0x87, 0x4f, 0x7e,
-	0x7d, 0x41, 0xe0, 0xe3, 0xcf, 0xaf, 0x0f, 0x1e, 0xa6, 0xac, 0x98, 0xcc, 0xc7, 0x7e, 0x22, 0x78,
-	0x70, 0x84, 0x99, 0x34, 0xbf, 0x24, 0xd8, 0xd4, 0x74, 0xb1, 0x29, 0x6a, 0x53, 0xad, 0x70, 0x74,
-	0xba, 0x74, 0xc1, 0xd9, 0xd2, 0x05, 0x3f, 0x96, 0x2e, 0xf8, 0xbc, 0x72, 0x3b, 0x67, 0x2b, 0xb7,
-	0xf3, 0x6d, 0xe5, 0x76, 0xde, 0x05, 0xbb, 0x7b, 0x15, 0x7a, 0x46, 0xd5, 0xb8, 0x67, 0x7a, 0xfb,
-	0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x30, 0x72, 0x12, 0x17, 0x03, 0x00, 0x00,
+	// 938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xd2, 0x12, 0xc8, 0xd0, 0x36, 0xcd, 0xb6, 0xc6, 0x5b, 0x3b, 0xec, 0xa6, 0x03, 0x11,
+	0x01, 0x21, 0xbb, 0x12, 0x5c, 0xe8, 0x09, 0x4c, 0x1d, 0x91, 0xa6, 0xaa, 0xcc, 0x04, 0x29, 0x52,
+	0x2f, 0xa3, 0xb1, 0x67, 0xb2, 0x9e, 0xee, 0xc7, 0x98, 0x99, 0x35, 0xb6, 0x0f, 0x08, 0x09, 0x89,
+	0x03, 0x1c, 0x10, 0xfc, 0x03, 0x7e, 0x02, 0x3f, 0xa3, 0xc7, 0x1e, 0x11, 0x87, 0x15, 0x4a, 0x0e,
+	0x70, 0xde, 0x3b, 0x12, 0x9a, 0xd9, 0xdd, 0xf8, 0xa3, 0x76, 0xe4, 0x8b, 0xb5, 0xfb, 0x3e, 0xcf,
+	0x3c, 0xef, 0xd7, 0xb3, 0xeb, 0x05, 0xde, 0x19, 0xe1, 0x72, 0x22, 0x79, 0xec, 0x37, 0x03, 0x36,
+	0x51, 0x7d, 0x22, 0x59, 0x73, 0x40, 0x24, 0x89, 0x54, 0x63, 0x20, 0x45, 0x22, 0x6c, 0xfb, 0x92,
+	0xd0, 0x28, 0x09, 0xb5, 0x1d, 0x12, 0xf1, 0x58, 0x34, 0xcd, 0x6f, 0x4e, 0xab, 0xdd, 0xf5, 0x85,
+	0x2f, 0xcc, 0x65, 0x53, 0x5f, 0xe5, 0x51, 0xf8, 0xdf, 0x36, 0xd8, 0xec, 0x18, 0x35, 0xfb, 0x13,
+	0x00, 0x02, 0x36, 0xc1, 0x6c, 0x3c, 0xe0, 0x72, 0xe2, 0x58, 0x7b, 0xd6, 0xc1, 0xf5, 0x56, 0x25,
+	0x4b, 0xbd, 0x9d, 0x09, 0x89, 0xc2, 0x87, 0x70, 0x8a, 0x41, 0xb4, 0x15, 0xb0, 0x49, 0xdb, 0x5c,
+	0xdb, 0x9f, 0x81, 0x5b, 0x11, 0x8f, 0x79, 0x34, 0x8c, 0x70, 0x57, 0xc4, 0x94, 0x51, 0xe7, 0x35,
+	0x73, 0xf2, 0x5e, 0x96, 0x7a, 0x95, 0xfc, 0xe4, 0x3c, 0x0e, 0xd1, 0xcd, 0x22, 0xd0, 0x32, 0xf7,
+	0x76, 0x0b, 0x6c, 0x47, 0x64, 0x8c, 0x39, 0x0d, 0x19, 0xc5, 0xdd, 0x50, 0xf4, 0x02, 0xe7, 0x9a,
+	0x91, 0xa8, 0x65, 0xa9, 0xf7, 0x76, 0x21, 0x31, 0x4f, 0xd0, 0x1a, 0x64, 0x7c, 0xa4, 0x03, 0x2d,
+	0x7d, 0x6f, 0x1f, 0x81, 0x9d, 0x44, 0x0e, 0x55, 0xc2, 0x28, 0x26, 0x94, 0x4a, 0xa6, 0x14, 0x53,
+	0xce, 0xf5, 0xbd, 0x6b, 0x07, 0x5b, 0xad, 0xdd, 0x2c, 0xf5, 0x9c, 0x5c, 0xe5, 0x15, 0x0a, 0x44,
+	0xb7, 0x8b, 0xd8, 0xe7, 0x65, 0xc8, 0xfe, 0xd1, 0x02, 0x35, 0x15, 0x12, 0xd5, 0xc7, 0x67, 0x92,
+	0xf4, 0x12, 0x2e, 0x62, 0x1c, 0x0b, 0x5c, 0x4e, 0xd6, 0x79, 0x7d, 0xcf, 0x3a, 0xb8, 0xd1, 0xfa,
+	0xf2, 0x45, 0xea, 0x6d, 0xfc, 0x95, 0x7a, 0xf5, 0x9e, 0x50, 0x91, 0x50, 0x8a, 0x06, 0x0d, 0x2e,
+	0x9a, 0x11, 0x49, 0xfa, 0x8d, 0x27, 0xcc, 0x27, 0xbd, 0xc9, 0x23, 0xd6, 0xcb, 0x52, 0xef, 0x7e,
+	0x9e, 0x77, 0xb5, 0x1c, 0x44, 0x55, 0x03, 0x1e, 0x16, 0xd8, 0x53, 0x71, 0x5c, 0x20, 0xf6, 0x2f,
+	0x16, 0x78, 0x67, 0xe1, 0xe0, 0x48, 0x8a, 0xd8, 0x9f, 0x96, 0xb2, 0x69, 0x4a, 0x39, 0x5e, 0xaf,
+	0x94, 0xf7, 0x96, 0x96, 0x32, 0xaf, 0x08, 0x51, 0x6d, 0xae, 0x9a, 0x53, 0x8d, 0x5e, 0x16, 0xd4,
+	0x05, 0x35, 0xed, 0x01, 0x73, 0x83, 0x25, 0x4b, 0x58, 0x6c, 0x24, 0xcc, 0x42, 0x94, 0xf3, 0x86,
+	0x59, 0xd9, 0xfe, 0xb4, 0xe9, 0xd5, 0x5c, 0x88, 0xaa, 0x01, 0x9b, 0x9c, 0x68, 0x0c, 0x95, 0x90,
+	0x59, 0xa3, 0xb2, 0x7f, 0xb0, 0xc0, 0x3e, 0xf1, 0x7d, 0xc9, 0x7c, 0xa2, 0x17, 0x75, 0x45, 0xbe,
+	0x37, 0x4d, 0xbe, 0x07, 0x59, 0xea, 0x7d, 0x94, 0xe7, 0x5b, 0xeb, 0x18, 0x44, 0xf7, 0xa7, 0xbc,
+	0xe3, 0x15, 0x45, 0xf8, 0x60, 0x57, 0xfb, 0x6d, 0x20, 0x87, 0x31, 0xa3, 0x98, 0xc5, 0x89, 0xe4,
+	0x4c, 0xe1, 0x01, 0x93, 0x85, 0x3b, 0xb7, 0x4c, 0xea, 0xf7, 0xb3, 0xd4, 0x7b, 0x77, 0xea, 0xce,
+	0x55, 0x6c, 0x88, 0x9c, 0x88, 0x8c, 0x3b, 0x06, 0x6d, 0xe7, 0x60, 0x87, 0xc9, 0xdc, 0xb5, 0xdf,
+	0x81, 0x6a, 0xf9, 0x6c, 0x24, 0x7d, 0xc9, 0x54, 0x5f, 0x84, 0x14, 0x4b, 0x92, 0x70, 0xe1, 0x00,
+	0xb3, 0xdb, 0xf6, 0x7a, 0xbb, 0x75, 0xe7, 0x9f, 0xb3, 0x05, 0x2d, 0x88, 0x2a, 0x05, 0xf2, 0x75,
+	0x09, 0x20, 0x1d, 0xb7, 0x7f, 0xb3, 0x80, 0xab, 0x12, 0x12, 0x30, 0x3c, 0x62, 0xdc, 0xef, 0xeb,
+	0xc9, 0x2d, 0x96, 0xf1, 0x96, 0x29, 0xe3, 0xc9, 0x7a, 0x65, 0xec, 0x17, 0x16, 0xbb, 0x52, 0x12,
+	0xa2, 0xba, 0x21, 0x9c, 0x16, 0xf8, 0x42, 0x4d, 0x27, 0xa0, 0x42, 0x03, 0x1f, 0x53, 0x46, 0x42,
+	0x3c, 0xe8, 0x13, 0xc5, 0xca, 0x7d, 0xdf, 0x30, 0x43, 0xdf, 0xcb, 0x52, 0x6f, 0x37, 0x4f, 0xb3,
+	0x94, 0x06, 0x91, 0x4d, 0x03, 0xff, 0x11, 0x23, 0x61, 0x47, 0x47, 0x8b, 0x85, 0x76, 0x41, 0x4d,
+	0xb3, 0x7b, 0x22, 0x1a, 0x84, 0x84, 0xc7, 0xc9, 0xbc, 0xf2, 0xcd, 0x45, 0xe7, 0xae, 0xe6, 0x42,
+	0x54, 0xa5, 0x81, 0xff, 0x45, 0x89, 0xcd, 0xe6, 0x88, 0x81, 0xab, 0xcf, 0x3d, 0x1f, 0xaa, 0x84,
+	0x9f, 0xf1, 0x1e, 0x31, 0xa6, 0x9b, 0xcb, 0x73, 0xcb, 0xe4, 0xf9, 0x60, 0x3a, 0xa8, 0xab, 0xf9,
+	0x10, 0xd5, 0x69, 0xe0, 0x3f, 0x9e, 0xc5, 0x67, 0xf3, 0x3d, 0x03, 0x55, 0xc5, 0xfd, 0x38, 0x77,
+	0xbb, 0x31, 0xbb, 0xc2, 0x23, 0x1e, 0x53, 0x31, 0x72, 0xb6, 0x4d, 0x22, 0x38, 0x35, 0xc6, 0x0a,
+	0x22, 0x44, 0x95, 0x1c, 0x29, 0x1f, 0x71, 0x75, 0x6a, 0xe2, 0xf6, 0xf7, 0xc0, 0x89, 0x78, 0x8c,
+	0xd5, 0xb0, 0x1b, 0xf1, 0x44, 0xef, 0x50, 0xbb, 0xb9, 0x10, 0xbf, 0x6d, 0x1c, 0x71, 0xb8, 0x9e,
+	0x23, 0xbc, 0x4b, 0x63, 0x2e, 0x15, 0xcb, 0x9d, 0x79, 0x52, 0x22, 0x1d, 0x26, 0x8b, 0x02, 0xbe,
+	0x02, 0x77, 0xa9, 0x18, 0xc5, 0x09, 0x8f, 0x18, 0x7e, 0x4e, 0x78, 0x58, 0x8e, 0x70, 0xc7, 0x74,
+	0xe6, 0x65, 0xa9, 0x57, 0x2f, 0x46, 0xb8, 0x84, 0xa5, 0x3d, 0x50, 0x84, 0x1f, 0x13, 0x1e, 0x16,
+	0xf3, 0xfa, 0xc9, 0x02, 0xf5, 0x85, 0x97, 0x1f, 0xfb, 0x66, 0xc8, 0xbf, 0x15, 0xf9, 0x68, 0x1d,
+	0xdb, 0xf4, 0x75, 0xb4, 0x5e, 0x5f, 0x70, 0xe9, 0xcb, 0x74, 0x56, 0x0f, 0xa2, 0x7b, 0x73, 0xaf,
+	0xd2, 0xf6, 0x0c, 0x66, 0x3f, 0x05, 0x77, 0x24, 0x1b, 0x11, 0x49, 0x31, 0x1b, 0x88, 0x5e, 0xbf,
+	0xec, 0xee, 0x8e, 0xe9, 0xce, 0xcd, 0x52, 0xaf, 0x96, 0xeb, 0x2f, 0x21, 0x41, 0xb4, 0x93, 0x47,
+	0xdb, 0x3a, 0x98, 0xf7, 0xf6, 0xf0, 0xd3, 0x7f, 0x7f, 0xf7, 0xac, 0x9f, 0xff, 0xf9, 0xe3, 0xc3,
+	0x07, 0x3e, 0x4f, 0xfa, 0xc3, 0x6e, 0xa3, 0x27, 0xa2, 0xe6, 0x21, 0xe1, 0xd2, 0x9c, 0x69, 0x4e,
+	0x3f, 0x20, 0xc6, 0xd3, 0x4f, 0x88, 0xfc, 0x4f, 0xbf, 0x75, 0xf4, 0xe2, 0xdc, 0xb5, 0x5e, 0x9e,
+	0xbb, 0xd6, 0xdf, 0xe7, 0xae, 0xf5, 0xeb, 0x85, 0xbb, 0xf1, 0xf2, 0xc2, 0xdd, 0xf8, 0xf3, 0xc2,
+	0xdd, 0x78, 0xd6, 0x5c, 0x5f, 0x2b, 0x99, 0x0c, 0x98, 0xea, 0x6e, 0x9a, 0x2f, 0x8a, 0x8f, 0xff,
+	0x1f, 0x00, 0xbf, 0x3c, 0xf2, 0xaa, 0xb1, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -177,6 +297,45 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionWrongKeyshare.Equal(that1.SlashFractionWrongKeyshare) {
 		return false
 	}
+	if this.KeyShareRetentionBlocks != that1.KeyShareRetentionBlocks {
+		return false
+	}
+	if this.AggregatedKeyShareRetentionBlocks != that1.AggregatedKeyShareRetentionBlocks {
+		return false
+	}
+	if this.MaxPrunedEntriesPerBlock != that1.MaxPrunedEntriesPerBlock {
+		return false
+	}
+	if !this.MinimumThresholdRatio.Equal(that1.MinimumThresholdRatio) {
+		return false
+	}
+	if !this.StakeWeightedThresholdRatio.Equal(that1.StakeWeightedThresholdRatio) {
+		return false
+	}
+	if this.DkgDealPhaseBlocks != that1.DkgDealPhaseBlocks {
+		return false
+	}
+	if this.DkgComplaintPhaseBlocks != that1.DkgComplaintPhaseBlocks {
+		return false
+	}
+	if this.DkgJustificationPhaseBlocks != that1.DkgJustificationPhaseBlocks {
+		return false
+	}
+	if this.SignedKeysharesWindow != that1.SignedKeysharesWindow {
+		return false
+	}
+	if !this.MinSubmittedPerWindow.Equal(that1.MinSubmittedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailBlocks != that1.DowntimeJailBlocks {
+		return false
+	}
+	if !this.SlashFractionEquivocation.Equal(that1.SlashFractionEquivocation) {
+		return false
+	}
+	if this.RewardEpochBlocks != that1.RewardEpochBlocks {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -199,6 +358,99 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.SlashFractionEquivocation.Size()
+		i -= size
+		if _, err := m.SlashFractionEquivocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.DowntimeJailBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DowntimeJailBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MinSubmittedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSubmittedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.SignedKeysharesWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedKeysharesWindow))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DkgJustificationPhaseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DkgJustificationPhaseBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DkgComplaintPhaseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DkgComplaintPhaseBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DkgDealPhaseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DkgDealPhaseBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.StakeWeightedThresholdRatio.Size()
+		i -= size
+		if _, err := m.StakeWeightedThresholdRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinimumThresholdRatio.Size()
+		i -= size
+		if _, err := m.MinimumThresholdRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MaxPrunedEntriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedEntriesPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AggregatedKeyShareRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggregatedKeyShareRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.KeyShareRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyShareRetentionBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.SlashFractionWrongKeyshare.Size()
 		i -= size
@@ -282,6 +534,41 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.SlashFractionWrongKeyshare.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.KeyShareRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.KeyShareRetentionBlocks))
+	}
+	if m.AggregatedKeyShareRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AggregatedKeyShareRetentionBlocks))
+	}
+	if m.MaxPrunedEntriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedEntriesPerBlock))
+	}
+	l = m.MinimumThresholdRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.StakeWeightedThresholdRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DkgDealPhaseBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DkgDealPhaseBlocks))
+	}
+	if m.DkgComplaintPhaseBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DkgComplaintPhaseBlocks))
+	}
+	if m.DkgJustificationPhaseBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DkgJustificationPhaseBlocks))
+	}
+	if m.SignedKeysharesWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedKeysharesWindow))
+	}
+	l = m.MinSubmittedPerWindow.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.DowntimeJailBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DowntimeJailBlocks))
+	}
+	l = m.SlashFractionEquivocation.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.RewardEpochBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RewardEpochBlocks))
+	}
 	return n
 }
 
@@ -475,6 +762,309 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRetentionBlocks", wireType)
+			}
+			m.KeyShareRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareRetentionBlocks", wireType)
+			}
+			m.AggregatedKeyShareRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregatedKeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedEntriesPerBlock", wireType)
+			}
+			m.MaxPrunedEntriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedEntriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumThresholdRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumThresholdRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeightedThresholdRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeWeightedThresholdRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgDealPhaseBlocks", wireType)
+			}
+			m.DkgDealPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgDealPhaseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgComplaintPhaseBlocks", wireType)
+			}
+			m.DkgComplaintPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgComplaintPhaseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgJustificationPhaseBlocks", wireType)
+			}
+			m.DkgJustificationPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgJustificationPhaseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedKeysharesWindow", wireType)
+			}
+			m.SignedKeysharesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedKeysharesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubmittedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSubmittedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailBlocks", wireType)
+			}
+			m.DowntimeJailBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEquivocation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEquivocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochBlocks", wireType)
+			}
+			m.RewardEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -80,6 +80,8 @@ type ActivePubKey struct {
 	Expiry             uint64               `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 	NumberOfValidators uint64               `protobuf:"varint,4,opt,name=numberOfValidators,proto3" json:"numberOfValidators,omitempty"`
 	EncryptedKeyShares []*EncryptedKeyShare `protobuf:"bytes,5,rep,name=encryptedKeyShares,proto3" json:"encryptedKeyShares,omitempty"`
+	// number of keyshares required to aggregate a key
+	Threshold uint64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (m *ActivePubKey) Reset()         { *m = ActivePubKey{} }
@@ -150,12 +152,21 @@ func (m *ActivePubKey) GetEncryptedKeyShares() []*EncryptedKeyShare {
 	return nil
 }
 
+func (m *ActivePubKey) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 type QueuedPubKey struct {
 	PublicKey          string               `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 	Creator            string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	Expiry             uint64               `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 	NumberOfValidators uint64               `protobuf:"varint,4,opt,name=numberOfValidators,proto3" json:"numberOfValidators,omitempty"`
 	EncryptedKeyShares []*EncryptedKeyShare `protobuf:"bytes,5,rep,name=encryptedKeyShares,proto3" json:"encryptedKeyShares,omitempty"`
+	// number of keyshares required to aggregate a key
+	Threshold uint64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (m *QueuedPubKey) Reset()         { *m = QueuedPubKey{} }
@@ -226,6 +237,13 @@ func (m *QueuedPubKey) GetEncryptedKeyShares() []*EncryptedKeyShare {
 	return nil
 }
 
+func (m *QueuedPubKey) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EncryptedKeyShare)(nil), "fairyring.keyshare.EncryptedKeyShare")
 	proto.RegisterType((*ActivePubKey)(nil), "fairyring.keyshare.ActivePubKey")
@@ -235,27 +253,27 @@ func init() {
 func init() { proto.RegisterFile("fairyring/keyshare/pub_key.proto", fileDescriptor_2c1c9675c7c2f3c4) }
 
 var fileDescriptor_2c1c9675c7c2f3c4 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x92, 0xcf, 0x4a, 0x33, 0x31,
-	0x14, 0xc5, 0x9b, 0xaf, 0xfd, 0x2a, 0x8d, 0x6e, 0xcc, 0x42, 0xb2, 0x90, 0x30, 0x14, 0x84, 0xae,
-	0x32, 0xa0, 0x4f, 0xa0, 0x50, 0x41, 0xba, 0x50, 0x2b, 0xba, 0x70, 0x23, 0x49, 0xe6, 0xb6, 0x0d,
-	0xfd, 0x33, 0x21, 0x93, 0x94, 0xe6, 0x2d, 0x7c, 0x2c, 0x97, 0x5d, 0xba, 0x94, 0x19, 0x7c, 0x0f,
-	0xe9, 0xd0, 0x71, 0xc4, 0xf6, 0x09, 0xdc, 0xe5, 0x9e, 0xfb, 0xe3, 0x84, 0x73, 0x39, 0x38, 0x1a,
-	0x09, 0x6d, 0x83, 0xd5, 0x8b, 0x71, 0x3c, 0x85, 0x90, 0x4d, 0x84, 0x85, 0xd8, 0x78, 0xf9, 0x32,
-	0x85, 0xc0, 0x8d, 0x4d, 0x5d, 0x4a, 0xc8, 0x37, 0xc1, 0x2b, 0xa2, 0xdb, 0xc7, 0xc7, 0xfd, 0x85,
-	0xb2, 0xc1, 0x38, 0x48, 0x06, 0x10, 0x1e, 0x36, 0x22, 0x21, 0xb8, 0x95, 0x08, 0x27, 0x28, 0x8a,
-	0x50, 0xaf, 0x33, 0x2c, 0xdf, 0xe4, 0x14, 0x77, 0x96, 0x62, 0xa6, 0x13, 0xe1, 0x52, 0x4b, 0xff,
-	0x95, 0x8b, 0x5a, 0xe8, 0x7e, 0x22, 0x7c, 0x74, 0xa9, 0x9c, 0x5e, 0xc2, 0x9d, 0x97, 0x03, 0x08,
-	0x1b, 0xdc, 0x78, 0x39, 0xd3, 0x6a, 0x00, 0x61, 0xeb, 0x53, 0x0b, 0x84, 0xe2, 0x03, 0x65, 0xe1,
-	0x87, 0x55, 0x35, 0x92, 0x13, 0xdc, 0x86, 0x95, 0xd1, 0x36, 0xd0, 0x66, 0x84, 0x7a, 0xad, 0xe1,
-	0x76, 0x22, 0x1c, 0x93, 0x85, 0x9f, 0x4b, 0xb0, 0xb7, 0xa3, 0xa7, 0xea, 0xd7, 0x8c, 0xb6, 0x4a,
-	0x66, 0xcf, 0x86, 0x3c, 0x62, 0x02, 0xbf, 0x73, 0x65, 0xf4, 0x7f, 0xd4, 0xec, 0x1d, 0x9e, 0x9f,
-	0xf1, 0xdd, 0x43, 0xf0, 0x9d, 0x2b, 0x0c, 0xf7, 0x18, 0x94, 0x39, 0xef, 0x3d, 0x78, 0x48, 0xfe,
-	0x74, 0xce, 0xab, 0x9b, 0xb7, 0x9c, 0xa1, 0x75, 0xce, 0xd0, 0x47, 0xce, 0xd0, 0x6b, 0xc1, 0x1a,
-	0xeb, 0x82, 0x35, 0xde, 0x0b, 0xd6, 0x78, 0x8e, 0xc7, 0xda, 0x4d, 0xbc, 0xe4, 0x2a, 0x9d, 0xc7,
-	0xd7, 0x42, 0x5b, 0x39, 0x4b, 0xd5, 0x34, 0xae, 0xbb, 0xb7, 0xaa, 0xdb, 0xe7, 0x82, 0x81, 0x4c,
-	0xb6, 0xcb, 0xf2, 0x5d, 0x7c, 0x05, 0x00, 0x00, 0xff, 0xff, 0xda, 0x09, 0xa8, 0x6d, 0xa0, 0x02,
-	0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x52, 0xc1, 0x4a, 0x03, 0x31,
+	0x14, 0xec, 0xb6, 0xb5, 0xd2, 0xe8, 0xc5, 0x1c, 0x24, 0x07, 0x59, 0x96, 0x82, 0xd0, 0x53, 0x16,
+	0xf4, 0x0b, 0x14, 0x2a, 0x48, 0x0f, 0x6a, 0x45, 0x0f, 0x5e, 0x24, 0xc9, 0xbe, 0x76, 0x43, 0xb7,
+	0x4d, 0xc8, 0x26, 0xa5, 0x39, 0xfb, 0x03, 0x7e, 0x96, 0xc7, 0x1e, 0x3d, 0x4a, 0xfb, 0x23, 0xd2,
+	0xd0, 0xba, 0x62, 0xfb, 0x07, 0xde, 0xde, 0x9b, 0x37, 0xcc, 0x63, 0x86, 0x41, 0xc9, 0x90, 0x49,
+	0xe3, 0x8d, 0x9c, 0x8e, 0xd2, 0x31, 0xf8, 0x32, 0x67, 0x06, 0x52, 0xed, 0xf8, 0xeb, 0x18, 0x3c,
+	0xd5, 0x46, 0x59, 0x85, 0xf1, 0x0f, 0x83, 0x6e, 0x19, 0x9d, 0x1e, 0x3a, 0xe9, 0x4d, 0x85, 0xf1,
+	0xda, 0x42, 0xd6, 0x07, 0xff, 0xb8, 0x06, 0x31, 0x46, 0xcd, 0x8c, 0x59, 0x46, 0xa2, 0x24, 0xea,
+	0xb6, 0x07, 0x61, 0xc6, 0x67, 0xa8, 0x3d, 0x63, 0x85, 0xcc, 0x98, 0x55, 0x86, 0xd4, 0xc3, 0xa1,
+	0x02, 0x3a, 0x6f, 0x75, 0x74, 0x7c, 0x25, 0xac, 0x9c, 0xc1, 0xbd, 0xe3, 0x7d, 0xf0, 0x6b, 0xba,
+	0x76, 0xbc, 0x90, 0xa2, 0x0f, 0x7e, 0xa3, 0x53, 0x01, 0x98, 0xa0, 0x43, 0x61, 0xe0, 0x97, 0xd4,
+	0x76, 0xc5, 0xa7, 0xa8, 0x05, 0x73, 0x2d, 0x8d, 0x27, 0x8d, 0x24, 0xea, 0x36, 0x07, 0x9b, 0x0d,
+	0x53, 0x84, 0xa7, 0x6e, 0xc2, 0xc1, 0xdc, 0x0d, 0x9f, 0xb7, 0x5f, 0x4b, 0xd2, 0x0c, 0x9c, 0x3d,
+	0x17, 0xfc, 0x84, 0x30, 0xfc, 0xf5, 0x55, 0x92, 0x83, 0xa4, 0xd1, 0x3d, 0xba, 0x38, 0xa7, 0xbb,
+	0x41, 0xd0, 0x9d, 0x14, 0x06, 0x7b, 0x04, 0xd6, 0xb6, 0x6c, 0x6e, 0xa0, 0xcc, 0x55, 0x91, 0x91,
+	0x56, 0xf8, 0x5e, 0x01, 0x21, 0x85, 0x07, 0x07, 0x0e, 0xb2, 0x7f, 0x9c, 0xc2, 0xf5, 0xed, 0xc7,
+	0x32, 0x8e, 0x16, 0xcb, 0x38, 0xfa, 0x5a, 0xc6, 0xd1, 0xfb, 0x2a, 0xae, 0x2d, 0x56, 0x71, 0xed,
+	0x73, 0x15, 0xd7, 0x5e, 0xd2, 0x91, 0xb4, 0xb9, 0xe3, 0x54, 0xa8, 0x49, 0x7a, 0xc3, 0xa4, 0xe1,
+	0x85, 0x12, 0xe3, 0xb4, 0xea, 0xed, 0xbc, 0x6a, 0xae, 0xf5, 0x1a, 0x4a, 0xde, 0x0a, 0xc5, 0xbd,
+	0xfc, 0x1e, 0x00, 0x46, 0xe6, 0xe9, 0x4c, 0xdc, 0x02, 0x00, 0x00,
 }
 
 func (m *EncryptedKeyShare) Marshal() (dAtA []byte, err error) {
@@ -315,6 +333,11 @@ func (m *ActivePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintPubKey(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.EncryptedKeyShares) > 0 {
 		for iNdEx := len(m.EncryptedKeyShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -376,6 +399,11 @@ func (m *QueuedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintPubKey(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.EncryptedKeyShares) > 0 {
 		for iNdEx := len(m.EncryptedKeyShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -471,6 +499,9 @@ func (m *ActivePubKey) Size() (n int) {
 			n += 1 + l + sovPubKey(uint64(l))
 		}
 	}
+	if m.Threshold != 0 {
+		n += 1 + sovPubKey(uint64(m.Threshold))
+	}
 	return n
 }
 
@@ -500,6 +531,9 @@ func (m *QueuedPubKey) Size() (n int) {
 			n += 1 + l + sovPubKey(uint64(l))
 		}
 	}
+	if m.Threshold != 0 {
+		n += 1 + sovPubKey(uint64(m.Threshold))
+	}
 	return n
 }
 
@@ -788,6 +822,25 @@ func (m *ActivePubKey) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPubKey(dAtA[iNdEx:])
@@ -974,6 +1027,25 @@ func (m *QueuedPubKey) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPubKey(dAtA[iNdEx:])
@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -119,22 +122,21 @@ func (m *QueryVerifiableRandomnessResponse) GetRound() uint64 {
 	return 0
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryOldestRetainedHeightRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryOldestRetainedHeightRequest) Reset()         { *m = QueryOldestRetainedHeightRequest{} }
+func (m *QueryOldestRetainedHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestRetainedHeightRequest) ProtoMessage()    {}
+func (*QueryOldestRetainedHeightRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOldestRetainedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOldestRetainedHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOldestRetainedHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -144,36 +146,35 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryOldestRetainedHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestRetainedHeightRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryOldestRetainedHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryOldestRetainedHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestRetainedHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOldestRetainedHeightRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+type QueryOldestRetainedHeightResponse struct {
+	KeyShareHeight           uint64 `protobuf:"varint,1,opt,name=keyShareHeight,proto3" json:"keyShareHeight,omitempty"`
+	AggregatedKeyShareHeight uint64 `protobuf:"varint,2,opt,name=aggregatedKeyShareHeight,proto3" json:"aggregatedKeyShareHeight,omitempty"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryOldestRetainedHeightResponse) Reset()         { *m = QueryOldestRetainedHeightResponse{} }
+func (m *QueryOldestRetainedHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestRetainedHeightResponse) ProtoMessage()    {}
+func (*QueryOldestRetainedHeightResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOldestRetainedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOldestRetainedHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOldestRetainedHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -183,40 +184,49 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryOldestRetainedHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestRetainedHeightResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryOldestRetainedHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryOldestRetainedHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestRetainedHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOldestRetainedHeightResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryOldestRetainedHeightResponse) GetKeyShareHeight() uint64 {
 	if m != nil {
-		return m.Params
+		return m.KeyShareHeight
 	}
-	return Params{}
+	return 0
 }
 
-type QueryCommitmentsRequest struct {
+func (m *QueryOldestRetainedHeightResponse) GetAggregatedKeyShareHeight() uint64 {
+	if m != nil {
+		return m.AggregatedKeyShareHeight
+	}
+	return 0
 }
 
-func (m *QueryCommitmentsRequest) Reset()         { *m = QueryCommitmentsRequest{} }
-func (m *QueryCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCommitmentsRequest) ProtoMessage()    {}
-func (*QueryCommitmentsRequest) Descriptor() ([]byte, []int) {
+type QueryKeyshareParticipationRequest struct {
+	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *QueryKeyshareParticipationRequest) Reset()         { *m = QueryKeyshareParticipationRequest{} }
+func (m *QueryKeyshareParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareParticipationRequest) ProtoMessage()    {}
+func (*QueryKeyshareParticipationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{4}
 }
-func (m *QueryCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryKeyshareParticipationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryKeyshareParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryKeyshareParticipationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -226,35 +236,54 @@ func (m *QueryCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommitmentsRequest.Merge(m, src)
+func (m *QueryKeyshareParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareParticipationRequest.Merge(m, src)
 }
-func (m *QueryCommitmentsRequest) XXX_Size() int {
+func (m *QueryKeyshareParticipationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryKeyshareParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareParticipationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryKeyshareParticipationRequest proto.InternalMessageInfo
 
-type QueryCommitmentsResponse struct {
-	ActiveCommitments *Commitments `protobuf:"bytes,1,opt,name=activeCommitments,proto3" json:"activeCommitments,omitempty"`
-	QueuedCommitments *Commitments `protobuf:"bytes,2,opt,name=queuedCommitments,proto3" json:"queuedCommitments,omitempty"`
+func (m *QueryKeyshareParticipationRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryCommitmentsResponse) Reset()         { *m = QueryCommitmentsResponse{} }
-func (m *QueryCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCommitmentsResponse) ProtoMessage()    {}
-func (*QueryCommitmentsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryKeyshareParticipationRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type QueryKeyshareParticipationResponse struct {
+	SubmittedKeyshares uint64                      `protobuf:"varint,1,opt,name=submittedKeyshares,proto3" json:"submittedKeyshares,omitempty"`
+	Threshold          uint64                      `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ParticipatingPower int64                       `protobuf:"varint,3,opt,name=participatingPower,proto3" json:"participatingPower,omitempty"`
+	TotalPower         int64                       `protobuf:"varint,4,opt,name=totalPower,proto3" json:"totalPower,omitempty"`
+	RequiredPower      int64                       `protobuf:"varint,5,opt,name=requiredPower,proto3" json:"requiredPower,omitempty"`
+	ParticipationRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=participationRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"participationRatio"`
+	Aggregated         bool                        `protobuf:"varint,7,opt,name=aggregated,proto3" json:"aggregated,omitempty"`
+}
+
+func (m *QueryKeyshareParticipationResponse) Reset()         { *m = QueryKeyshareParticipationResponse{} }
+func (m *QueryKeyshareParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareParticipationResponse) ProtoMessage()    {}
+func (*QueryKeyshareParticipationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{5}
 }
-func (m *QueryCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryKeyshareParticipationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryKeyshareParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryKeyshareParticipationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -264,48 +293,76 @@ func (m *QueryCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommitmentsResponse.Merge(m, src)
+func (m *QueryKeyshareParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareParticipationResponse.Merge(m, src)
 }
-func (m *QueryCommitmentsResponse) XXX_Size() int {
+func (m *QueryKeyshareParticipationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryKeyshareParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareParticipationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommitmentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryKeyshareParticipationResponse proto.InternalMessageInfo
 
-func (m *QueryCommitmentsResponse) GetActiveCommitments() *Commitments {
+func (m *QueryKeyshareParticipationResponse) GetSubmittedKeyshares() uint64 {
 	if m != nil {
-		return m.ActiveCommitments
+		return m.SubmittedKeyshares
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryCommitmentsResponse) GetQueuedCommitments() *Commitments {
+func (m *QueryKeyshareParticipationResponse) GetThreshold() uint64 {
 	if m != nil {
-		return m.QueuedCommitments
+		return m.Threshold
 	}
-	return nil
+	return 0
 }
 
-type QueryGetValidatorSetRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+func (m *QueryKeyshareParticipationResponse) GetParticipatingPower() int64 {
+	if m != nil {
+		return m.ParticipatingPower
+	}
+	return 0
 }
 
-func (m *QueryGetValidatorSetRequest) Reset()         { *m = QueryGetValidatorSetRequest{} }
-func (m *QueryGetValidatorSetRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetValidatorSetRequest) ProtoMessage()    {}
-func (*QueryGetValidatorSetRequest) Descriptor() ([]byte, []int) {
+func (m *QueryKeyshareParticipationResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *QueryKeyshareParticipationResponse) GetRequiredPower() int64 {
+	if m != nil {
+		return m.RequiredPower
+	}
+	return 0
+}
+
+func (m *QueryKeyshareParticipationResponse) GetAggregated() bool {
+	if m != nil {
+		return m.Aggregated
+	}
+	return false
+}
+
+type QueryDkgRoundRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDkgRoundRequest) Reset()         { *m = QueryDkgRoundRequest{} }
+func (m *QueryDkgRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDkgRoundRequest) ProtoMessage()    {}
+func (*QueryDkgRoundRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{6}
 }
-func (m *QueryGetValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDkgRoundRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDkgRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetValidatorSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDkgRoundRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -315,41 +372,44 @@ func (m *QueryGetValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetValidatorSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetValidatorSetRequest.Merge(m, src)
+func (m *QueryDkgRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDkgRoundRequest.Merge(m, src)
 }
-func (m *QueryGetValidatorSetRequest) XXX_Size() int {
+func (m *QueryDkgRoundRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetValidatorSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetValidatorSetRequest.DiscardUnknown(m)
+func (m *QueryDkgRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDkgRoundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetValidatorSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDkgRoundRequest proto.InternalMessageInfo
 
-func (m *QueryGetValidatorSetRequest) GetIndex() string {
+func (m *QueryDkgRoundRequest) GetId() uint64 {
 	if m != nil {
-		return m.Index
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-type QueryGetValidatorSetResponse struct {
-	ValidatorSet ValidatorSet `protobuf:"bytes,1,opt,name=validatorSet,proto3" json:"validatorSet"`
+type QueryDkgRoundResponse struct {
+	Round      DkgRound       `protobuf:"bytes,1,opt,name=round,proto3" json:"round"`
+	Phase      string         `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Deals      []DkgDeal      `protobuf:"bytes,3,rep,name=deals,proto3" json:"deals"`
+	Complaints []DkgComplaint `protobuf:"bytes,4,rep,name=complaints,proto3" json:"complaints"`
 }
 
-func (m *QueryGetValidatorSetResponse) Reset()         { *m = QueryGetValidatorSetResponse{} }
-func (m *QueryGetValidatorSetResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetValidatorSetResponse) ProtoMessage()    {}
-func (*QueryGetValidatorSetResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDkgRoundResponse) Reset()         { *m = QueryDkgRoundResponse{} }
+func (m *QueryDkgRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDkgRoundResponse) ProtoMessage()    {}
+func (*QueryDkgRoundResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{7}
 }
-func (m *QueryGetValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDkgRoundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDkgRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetValidatorSetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDkgRoundResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -359,41 +419,62 @@ func (m *QueryGetValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetValidatorSetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetValidatorSetResponse.Merge(m, src)
+func (m *QueryDkgRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDkgRoundResponse.Merge(m, src)
 }
-func (m *QueryGetValidatorSetResponse) XXX_Size() int {
+func (m *QueryDkgRoundResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetValidatorSetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetValidatorSetResponse.DiscardUnknown(m)
+func (m *QueryDkgRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDkgRoundResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetValidatorSetResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDkgRoundResponse proto.InternalMessageInfo
 
-func (m *QueryGetValidatorSetResponse) GetValidatorSet() ValidatorSet {
+func (m *QueryDkgRoundResponse) GetRound() DkgRound {
 	if m != nil {
-		return m.ValidatorSet
+		return m.Round
 	}
-	return ValidatorSet{}
+	return DkgRound{}
 }
 
-type QueryAllValidatorSetRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryDkgRoundResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
 }
 
-func (m *QueryAllValidatorSetRequest) Reset()         { *m = QueryAllValidatorSetRequest{} }
-func (m *QueryAllValidatorSetRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllValidatorSetRequest) ProtoMessage()    {}
-func (*QueryAllValidatorSetRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDkgRoundResponse) GetDeals() []DkgDeal {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+func (m *QueryDkgRoundResponse) GetComplaints() []DkgComplaint {
+	if m != nil {
+		return m.Complaints
+	}
+	return nil
+}
+
+type QueryKeyshareSigningInfoRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *QueryKeyshareSigningInfoRequest) Reset()         { *m = QueryKeyshareSigningInfoRequest{} }
+func (m *QueryKeyshareSigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareSigningInfoRequest) ProtoMessage()    {}
+func (*QueryKeyshareSigningInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{8}
 }
-func (m *QueryAllValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryKeyshareSigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryKeyshareSigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllValidatorSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryKeyshareSigningInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -403,42 +484,41 @@ func (m *QueryAllValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllValidatorSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllValidatorSetRequest.Merge(m, src)
+func (m *QueryKeyshareSigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareSigningInfoRequest.Merge(m, src)
 }
-func (m *QueryAllValidatorSetRequest) XXX_Size() int {
+func (m *QueryKeyshareSigningInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllValidatorSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllValidatorSetRequest.DiscardUnknown(m)
+func (m *QueryKeyshareSigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareSigningInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllValidatorSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryKeyshareSigningInfoRequest proto.InternalMessageInfo
 
-func (m *QueryAllValidatorSetRequest) GetPagination() *query.PageRequest {
+func (m *QueryKeyshareSigningInfoRequest) GetValidator() string {
 	if m != nil {
-		return m.Pagination
+		return m.Validator
 	}
-	return nil
+	return ""
 }
 
-type QueryAllValidatorSetResponse struct {
-	ValidatorSet []ValidatorSet      `protobuf:"bytes,1,rep,name=validatorSet,proto3" json:"validatorSet"`
-	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryKeyshareSigningInfoResponse struct {
+	SigningInfo KeyshareSigningInfo `protobuf:"bytes,1,opt,name=signingInfo,proto3" json:"signingInfo"`
 }
 
-func (m *QueryAllValidatorSetResponse) Reset()         { *m = QueryAllValidatorSetResponse{} }
-func (m *QueryAllValidatorSetResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllValidatorSetResponse) ProtoMessage()    {}
-func (*QueryAllValidatorSetResponse) Descriptor() ([]byte, []int) {
+func (m *QueryKeyshareSigningInfoResponse) Reset()         { *m = QueryKeyshareSigningInfoResponse{} }
+func (m *QueryKeyshareSigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareSigningInfoResponse) ProtoMessage()    {}
+func (*QueryKeyshareSigningInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{9}
 }
-func (m *QueryAllValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryKeyshareSigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryKeyshareSigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllValidatorSetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryKeyshareSigningInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -448,49 +528,41 @@ func (m *QueryAllValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllValidatorSetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllValidatorSetResponse.Merge(m, src)
+func (m *QueryKeyshareSigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareSigningInfoResponse.Merge(m, src)
 }
-func (m *QueryAllValidatorSetResponse) XXX_Size() int {
+func (m *QueryKeyshareSigningInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllValidatorSetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllValidatorSetResponse.DiscardUnknown(m)
+func (m *QueryKeyshareSigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareSigningInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllValidatorSetResponse proto.InternalMessageInfo
-
-func (m *QueryAllValidatorSetResponse) GetValidatorSet() []ValidatorSet {
-	if m != nil {
-		return m.ValidatorSet
-	}
-	return nil
-}
+var xxx_messageInfo_QueryKeyshareSigningInfoResponse proto.InternalMessageInfo
 
-func (m *QueryAllValidatorSetResponse) GetPagination() *query.PageResponse {
+func (m *QueryKeyshareSigningInfoResponse) GetSigningInfo() KeyshareSigningInfo {
 	if m != nil {
-		return m.Pagination
+		return m.SigningInfo
 	}
-	return nil
+	return KeyshareSigningInfo{}
 }
 
-type QueryGetKeyShareRequest struct {
-	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	BlockHeight uint64 `protobuf:"varint,2,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+type QueryAllKeyshareSigningInfoRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetKeyShareRequest) Reset()         { *m = QueryGetKeyShareRequest{} }
-func (m *QueryGetKeyShareRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetKeyShareRequest) ProtoMessage()    {}
-func (*QueryGetKeyShareRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllKeyshareSigningInfoRequest) Reset()         { *m = QueryAllKeyshareSigningInfoRequest{} }
+func (m *QueryAllKeyshareSigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyshareSigningInfoRequest) ProtoMessage()    {}
+func (*QueryAllKeyshareSigningInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{10}
 }
-func (m *QueryGetKeyShareRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllKeyshareSigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllKeyshareSigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetKeyShareRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllKeyshareSigningInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -500,48 +572,42 @@ func (m *QueryGetKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetKeyShareRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetKeyShareRequest.Merge(m, src)
+func (m *QueryAllKeyshareSigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyshareSigningInfoRequest.Merge(m, src)
 }
-func (m *QueryGetKeyShareRequest) XXX_Size() int {
+func (m *QueryAllKeyshareSigningInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetKeyShareRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetKeyShareRequest.DiscardUnknown(m)
+func (m *QueryAllKeyshareSigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyshareSigningInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetKeyShareRequest proto.InternalMessageInfo
-
-func (m *QueryGetKeyShareRequest) GetValidator() string {
-	if m != nil {
-		return m.Validator
-	}
-	return ""
-}
+var xxx_messageInfo_QueryAllKeyshareSigningInfoRequest proto.InternalMessageInfo
 
-func (m *QueryGetKeyShareRequest) GetBlockHeight() uint64 {
+func (m *QueryAllKeyshareSigningInfoRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.BlockHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryGetKeyShareResponse struct {
-	KeyShare KeyShare `protobuf:"bytes,1,opt,name=keyShare,proto3" json:"keyShare"`
+type QueryAllKeyshareSigningInfoResponse struct {
+	SigningInfo []KeyshareSigningInfo `protobuf:"bytes,1,rep,name=signingInfo,proto3" json:"signingInfo"`
+	Pagination  *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetKeyShareResponse) Reset()         { *m = QueryGetKeyShareResponse{} }
-func (m *QueryGetKeyShareResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetKeyShareResponse) ProtoMessage()    {}
-func (*QueryGetKeyShareResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllKeyshareSigningInfoResponse) Reset()         { *m = QueryAllKeyshareSigningInfoResponse{} }
+func (m *QueryAllKeyshareSigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyshareSigningInfoResponse) ProtoMessage()    {}
+func (*QueryAllKeyshareSigningInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{11}
 }
-func (m *QueryGetKeyShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllKeyshareSigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllKeyshareSigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetKeyShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllKeyshareSigningInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,41 +617,48 @@ func (m *QueryGetKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryGetKeyShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetKeyShareResponse.Merge(m, src)
+func (m *QueryAllKeyshareSigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyshareSigningInfoResponse.Merge(m, src)
 }
-func (m *QueryGetKeyShareResponse) XXX_Size() int {
+func (m *QueryAllKeyshareSigningInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetKeyShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetKeyShareResponse.DiscardUnknown(m)
+func (m *QueryAllKeyshareSigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyshareSigningInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetKeyShareResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllKeyshareSigningInfoResponse proto.InternalMessageInfo
 
-func (m *QueryGetKeyShareResponse) GetKeyShare() KeyShare {
+func (m *QueryAllKeyshareSigningInfoResponse) GetSigningInfo() []KeyshareSigningInfo {
 	if m != nil {
-		return m.KeyShare
+		return m.SigningInfo
 	}
-	return KeyShare{}
+	return nil
 }
 
-type QueryAllKeyShareRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryAllKeyshareSigningInfoResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllKeyShareRequest) Reset()         { *m = QueryAllKeyShareRequest{} }
-func (m *QueryAllKeyShareRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllKeyShareRequest) ProtoMessage()    {}
-func (*QueryAllKeyShareRequest) Descriptor() ([]byte, []int) {
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{12}
 }
-func (m *QueryAllKeyShareRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllKeyShareRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -595,42 +668,36 @@ func (m *QueryAllKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllKeyShareRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllKeyShareRequest.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *QueryAllKeyShareRequest) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllKeyShareRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllKeyShareRequest.DiscardUnknown(m)
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllKeyShareRequest proto.InternalMessageInfo
-
-func (m *QueryAllKeyShareRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-type QueryAllKeyShareResponse struct {
-	KeyShare   []KeyShare          `protobuf:"bytes,1,rep,name=keyShare,proto3" json:"keyShare"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryAllKeyShareResponse) Reset()         { *m = QueryAllKeyShareResponse{} }
-func (m *QueryAllKeyShareResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllKeyShareResponse) ProtoMessage()    {}
-func (*QueryAllKeyShareResponse) Descriptor() ([]byte, []int) {
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{13}
 }
-func (m *QueryAllKeyShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllKeyShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -640,49 +707,40 @@ func (m *QueryAllKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryAllKeyShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllKeyShareResponse.Merge(m, src)
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-func (m *QueryAllKeyShareResponse) XXX_Size() int {
+func (m *QueryParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllKeyShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllKeyShareResponse.DiscardUnknown(m)
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllKeyShareResponse proto.InternalMessageInfo
-
-func (m *QueryAllKeyShareResponse) GetKeyShare() []KeyShare {
-	if m != nil {
-		return m.KeyShare
-	}
-	return nil
-}
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
-func (m *QueryAllKeyShareResponse) GetPagination() *query.PageResponse {
+func (m *QueryParamsResponse) GetParams() Params {
 	if m != nil {
-		return m.Pagination
+		return m.Params
 	}
-	return nil
+	return Params{}
 }
 
-// this line is used by starport scaffolding # 3
-type QueryGetAggregatedKeyShareRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+type QueryCommitmentsRequest struct {
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) Reset()         { *m = QueryGetAggregatedKeyShareRequest{} }
-func (m *QueryGetAggregatedKeyShareRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAggregatedKeyShareRequest) ProtoMessage()    {}
-func (*QueryGetAggregatedKeyShareRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCommitmentsRequest) Reset()         { *m = QueryCommitmentsRequest{} }
+func (m *QueryCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentsRequest) ProtoMessage()    {}
+func (*QueryCommitmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{14}
 }
-func (m *QueryGetAggregatedKeyShareRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAggregatedKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAggregatedKeyShareRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -692,41 +750,35 @@ func (m *QueryGetAggregatedKeyShareRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAggregatedKeyShareRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAggregatedKeyShareRequest.Merge(m, src)
+func (m *QueryCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentsRequest.Merge(m, src)
 }
-func (m *QueryGetAggregatedKeyShareRequest) XXX_Size() int {
+func (m *QueryCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAggregatedKeyShareRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAggregatedKeyShareRequest.DiscardUnknown(m)
+func (m *QueryCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAggregatedKeyShareRequest proto.InternalMessageInfo
-
-func (m *QueryGetAggregatedKeyShareRequest) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
-	}
-	return 0
-}
+var xxx_messageInfo_QueryCommitmentsRequest proto.InternalMessageInfo
 
-type QueryGetAggregatedKeyShareResponse struct {
-	AggregatedKeyShare AggregatedKeyShare `protobuf:"bytes,1,opt,name=aggregatedKeyShare,proto3" json:"aggregatedKeyShare"`
+type QueryCommitmentsResponse struct {
+	ActiveCommitments *Commitments `protobuf:"bytes,1,opt,name=activeCommitments,proto3" json:"activeCommitments,omitempty"`
+	QueuedCommitments *Commitments `protobuf:"bytes,2,opt,name=queuedCommitments,proto3" json:"queuedCommitments,omitempty"`
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) Reset()         { *m = QueryGetAggregatedKeyShareResponse{} }
-func (m *QueryGetAggregatedKeyShareResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAggregatedKeyShareResponse) ProtoMessage()    {}
-func (*QueryGetAggregatedKeyShareResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCommitmentsResponse) Reset()         { *m = QueryCommitmentsResponse{} }
+func (m *QueryCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentsResponse) ProtoMessage()    {}
+func (*QueryCommitmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{15}
 }
-func (m *QueryGetAggregatedKeyShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAggregatedKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAggregatedKeyShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -736,41 +788,48 @@ func (m *QueryGetAggregatedKeyShareResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAggregatedKeyShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAggregatedKeyShareResponse.Merge(m, src)
+func (m *QueryCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentsResponse.Merge(m, src)
 }
-func (m *QueryGetAggregatedKeyShareResponse) XXX_Size() int {
+func (m *QueryCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAggregatedKeyShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAggregatedKeyShareResponse.DiscardUnknown(m)
+func (m *QueryCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAggregatedKeyShareResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCommitmentsResponse proto.InternalMessageInfo
 
-func (m *QueryGetAggregatedKeyShareResponse) GetAggregatedKeyShare() AggregatedKeyShare {
+func (m *QueryCommitmentsResponse) GetActiveCommitments() *Commitments {
 	if m != nil {
-		return m.AggregatedKeyShare
+		return m.ActiveCommitments
 	}
-	return AggregatedKeyShare{}
+	return nil
 }
 
-type QueryAllAggregatedKeyShareRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryCommitmentsResponse) GetQueuedCommitments() *Commitments {
+	if m != nil {
+		return m.QueuedCommitments
+	}
+	return nil
 }
 
-func (m *QueryAllAggregatedKeyShareRequest) Reset()         { *m = QueryAllAggregatedKeyShareRequest{} }
-func (m *QueryAllAggregatedKeyShareRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAggregatedKeyShareRequest) ProtoMessage()    {}
-func (*QueryAllAggregatedKeyShareRequest) Descriptor() ([]byte, []int) {
+type QueryGetValidatorSetRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryGetValidatorSetRequest) Reset()         { *m = QueryGetValidatorSetRequest{} }
+func (m *QueryGetValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetValidatorSetRequest) ProtoMessage()    {}
+func (*QueryGetValidatorSetRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{16}
 }
-func (m *QueryAllAggregatedKeyShareRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetValidatorSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAggregatedKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAggregatedKeyShareRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetValidatorSetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -780,42 +839,41 @@ func (m *QueryAllAggregatedKeyShareRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAggregatedKeyShareRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAggregatedKeyShareRequest.Merge(m, src)
+func (m *QueryGetValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetValidatorSetRequest.Merge(m, src)
 }
-func (m *QueryAllAggregatedKeyShareRequest) XXX_Size() int {
+func (m *QueryGetValidatorSetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAggregatedKeyShareRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAggregatedKeyShareRequest.DiscardUnknown(m)
+func (m *QueryGetValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetValidatorSetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAggregatedKeyShareRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetValidatorSetRequest proto.InternalMessageInfo
 
-func (m *QueryAllAggregatedKeyShareRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetValidatorSetRequest) GetIndex() string {
 	if m != nil {
-		return m.Pagination
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-type QueryAllAggregatedKeyShareResponse struct {
-	AggregatedKeyShare []AggregatedKeyShare `protobuf:"bytes,1,rep,name=aggregatedKeyShare,proto3" json:"aggregatedKeyShare"`
-	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetValidatorSetResponse struct {
+	ValidatorSet ValidatorSet `protobuf:"bytes,1,opt,name=validatorSet,proto3" json:"validatorSet"`
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) Reset()         { *m = QueryAllAggregatedKeyShareResponse{} }
-func (m *QueryAllAggregatedKeyShareResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAggregatedKeyShareResponse) ProtoMessage()    {}
-func (*QueryAllAggregatedKeyShareResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetValidatorSetResponse) Reset()         { *m = QueryGetValidatorSetResponse{} }
+func (m *QueryGetValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetValidatorSetResponse) ProtoMessage()    {}
+func (*QueryGetValidatorSetResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{17}
 }
-func (m *QueryAllAggregatedKeyShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetValidatorSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAggregatedKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAggregatedKeyShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetValidatorSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -825,47 +883,41 @@ func (m *QueryAllAggregatedKeyShareResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAggregatedKeyShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAggregatedKeyShareResponse.Merge(m, src)
+func (m *QueryGetValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetValidatorSetResponse.Merge(m, src)
 }
-func (m *QueryAllAggregatedKeyShareResponse) XXX_Size() int {
+func (m *QueryGetValidatorSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAggregatedKeyShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAggregatedKeyShareResponse.DiscardUnknown(m)
+func (m *QueryGetValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetValidatorSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAggregatedKeyShareResponse proto.InternalMessageInfo
-
-func (m *QueryAllAggregatedKeyShareResponse) GetAggregatedKeyShare() []AggregatedKeyShare {
-	if m != nil {
-		return m.AggregatedKeyShare
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetValidatorSetResponse proto.InternalMessageInfo
 
-func (m *QueryAllAggregatedKeyShareResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetValidatorSetResponse) GetValidatorSet() ValidatorSet {
 	if m != nil {
-		return m.Pagination
+		return m.ValidatorSet
 	}
-	return nil
+	return ValidatorSet{}
 }
 
-type QueryPubKeyRequest struct {
+type QueryAllValidatorSetRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPubKeyRequest) Reset()         { *m = QueryPubKeyRequest{} }
-func (m *QueryPubKeyRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPubKeyRequest) ProtoMessage()    {}
-func (*QueryPubKeyRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllValidatorSetRequest) Reset()         { *m = QueryAllValidatorSetRequest{} }
+func (m *QueryAllValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllValidatorSetRequest) ProtoMessage()    {}
+func (*QueryAllValidatorSetRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{18}
 }
-func (m *QueryPubKeyRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllValidatorSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPubKeyRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllValidatorSetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -875,35 +927,42 @@ func (m *QueryPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryPubKeyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPubKeyRequest.Merge(m, src)
+func (m *QueryAllValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllValidatorSetRequest.Merge(m, src)
 }
-func (m *QueryPubKeyRequest) XXX_Size() int {
+func (m *QueryAllValidatorSetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPubKeyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPubKeyRequest.DiscardUnknown(m)
+func (m *QueryAllValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllValidatorSetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPubKeyRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllValidatorSetRequest proto.InternalMessageInfo
 
-type QueryPubKeyResponse struct {
-	ActivePubKey ActivePubKey `protobuf:"bytes,1,opt,name=activePubKey,proto3" json:"activePubKey"`
-	QueuedPubKey QueuedPubKey `protobuf:"bytes,2,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
+func (m *QueryAllValidatorSetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPubKeyResponse) Reset()         { *m = QueryPubKeyResponse{} }
-func (m *QueryPubKeyResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPubKeyResponse) ProtoMessage()    {}
-func (*QueryPubKeyResponse) Descriptor() ([]byte, []int) {
+type QueryAllValidatorSetResponse struct {
+	ValidatorSet []ValidatorSet      `protobuf:"bytes,1,rep,name=validatorSet,proto3" json:"validatorSet"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllValidatorSetResponse) Reset()         { *m = QueryAllValidatorSetResponse{} }
+func (m *QueryAllValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllValidatorSetResponse) ProtoMessage()    {}
+func (*QueryAllValidatorSetResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{19}
 }
-func (m *QueryPubKeyResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllValidatorSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPubKeyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllValidatorSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -913,48 +972,49 @@ func (m *QueryPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryPubKeyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPubKeyResponse.Merge(m, src)
+func (m *QueryAllValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllValidatorSetResponse.Merge(m, src)
 }
-func (m *QueryPubKeyResponse) XXX_Size() int {
+func (m *QueryAllValidatorSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPubKeyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPubKeyResponse.DiscardUnknown(m)
+func (m *QueryAllValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllValidatorSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPubKeyResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllValidatorSetResponse proto.InternalMessageInfo
 
-func (m *QueryPubKeyResponse) GetActivePubKey() ActivePubKey {
+func (m *QueryAllValidatorSetResponse) GetValidatorSet() []ValidatorSet {
 	if m != nil {
-		return m.ActivePubKey
+		return m.ValidatorSet
 	}
-	return ActivePubKey{}
+	return nil
 }
 
-func (m *QueryPubKeyResponse) GetQueuedPubKey() QueuedPubKey {
+func (m *QueryAllValidatorSetResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.QueuedPubKey
+		return m.Pagination
 	}
-	return QueuedPubKey{}
+	return nil
 }
 
-type QueryGetAuthorizedAddressRequest struct {
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+type QueryGetKeyShareRequest struct {
+	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
 }
 
-func (m *QueryGetAuthorizedAddressRequest) Reset()         { *m = QueryGetAuthorizedAddressRequest{} }
-func (m *QueryGetAuthorizedAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAuthorizedAddressRequest) ProtoMessage()    {}
-func (*QueryGetAuthorizedAddressRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetKeyShareRequest) Reset()         { *m = QueryGetKeyShareRequest{} }
+func (m *QueryGetKeyShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyShareRequest) ProtoMessage()    {}
+func (*QueryGetKeyShareRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{20}
 }
-func (m *QueryGetAuthorizedAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAuthorizedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAuthorizedAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetKeyShareRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -964,41 +1024,48 @@ func (m *QueryGetAuthorizedAddressRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAuthorizedAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAuthorizedAddressRequest.Merge(m, src)
+func (m *QueryGetKeyShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyShareRequest.Merge(m, src)
 }
-func (m *QueryGetAuthorizedAddressRequest) XXX_Size() int {
+func (m *QueryGetKeyShareRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAuthorizedAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAuthorizedAddressRequest.DiscardUnknown(m)
+func (m *QueryGetKeyShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyShareRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAuthorizedAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetKeyShareRequest proto.InternalMessageInfo
 
-func (m *QueryGetAuthorizedAddressRequest) GetTarget() string {
+func (m *QueryGetKeyShareRequest) GetValidator() string {
 	if m != nil {
-		return m.Target
+		return m.Validator
 	}
 	return ""
 }
 
-type QueryGetAuthorizedAddressResponse struct {
-	AuthorizedAddress AuthorizedAddress `protobuf:"bytes,1,opt,name=authorizedAddress,proto3" json:"authorizedAddress"`
+func (m *QueryGetKeyShareRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *QueryGetAuthorizedAddressResponse) Reset()         { *m = QueryGetAuthorizedAddressResponse{} }
-func (m *QueryGetAuthorizedAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAuthorizedAddressResponse) ProtoMessage()    {}
-func (*QueryGetAuthorizedAddressResponse) Descriptor() ([]byte, []int) {
+type QueryGetKeyShareResponse struct {
+	KeyShare KeyShare `protobuf:"bytes,1,opt,name=keyShare,proto3" json:"keyShare"`
+}
+
+func (m *QueryGetKeyShareResponse) Reset()         { *m = QueryGetKeyShareResponse{} }
+func (m *QueryGetKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyShareResponse) ProtoMessage()    {}
+func (*QueryGetKeyShareResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{21}
 }
-func (m *QueryGetAuthorizedAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAuthorizedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAuthorizedAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetKeyShareResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1008,41 +1075,41 @@ func (m *QueryGetAuthorizedAddressResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAuthorizedAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAuthorizedAddressResponse.Merge(m, src)
+func (m *QueryGetKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyShareResponse.Merge(m, src)
 }
-func (m *QueryGetAuthorizedAddressResponse) XXX_Size() int {
+func (m *QueryGetKeyShareResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAuthorizedAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAuthorizedAddressResponse.DiscardUnknown(m)
+func (m *QueryGetKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyShareResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAuthorizedAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetKeyShareResponse proto.InternalMessageInfo
 
-func (m *QueryGetAuthorizedAddressResponse) GetAuthorizedAddress() AuthorizedAddress {
+func (m *QueryGetKeyShareResponse) GetKeyShare() KeyShare {
 	if m != nil {
-		return m.AuthorizedAddress
+		return m.KeyShare
 	}
-	return AuthorizedAddress{}
+	return KeyShare{}
 }
 
-type QueryAllAuthorizedAddressRequest struct {
+type QueryAllKeyShareRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllAuthorizedAddressRequest) Reset()         { *m = QueryAllAuthorizedAddressRequest{} }
-func (m *QueryAllAuthorizedAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAuthorizedAddressRequest) ProtoMessage()    {}
-func (*QueryAllAuthorizedAddressRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllKeyShareRequest) Reset()         { *m = QueryAllKeyShareRequest{} }
+func (m *QueryAllKeyShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyShareRequest) ProtoMessage()    {}
+func (*QueryAllKeyShareRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{22}
 }
-func (m *QueryAllAuthorizedAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAuthorizedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAuthorizedAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllKeyShareRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,42 +1119,42 @@ func (m *QueryAllAuthorizedAddressRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAuthorizedAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAuthorizedAddressRequest.Merge(m, src)
+func (m *QueryAllKeyShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyShareRequest.Merge(m, src)
 }
-func (m *QueryAllAuthorizedAddressRequest) XXX_Size() int {
+func (m *QueryAllKeyShareRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAuthorizedAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAuthorizedAddressRequest.DiscardUnknown(m)
+func (m *QueryAllKeyShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyShareRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAuthorizedAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllKeyShareRequest proto.InternalMessageInfo
 
-func (m *QueryAllAuthorizedAddressRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllKeyShareRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllAuthorizedAddressResponse struct {
-	AuthorizedAddress []AuthorizedAddress `protobuf:"bytes,1,rep,name=authorizedAddress,proto3" json:"authorizedAddress"`
-	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllKeyShareResponse struct {
+	KeyShare   []KeyShare          `protobuf:"bytes,1,rep,name=keyShare,proto3" json:"keyShare"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllAuthorizedAddressResponse) Reset()         { *m = QueryAllAuthorizedAddressResponse{} }
-func (m *QueryAllAuthorizedAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAuthorizedAddressResponse) ProtoMessage()    {}
-func (*QueryAllAuthorizedAddressResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllKeyShareResponse) Reset()         { *m = QueryAllKeyShareResponse{} }
+func (m *QueryAllKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyShareResponse) ProtoMessage()    {}
+func (*QueryAllKeyShareResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{23}
 }
-func (m *QueryAllAuthorizedAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAuthorizedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAuthorizedAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllKeyShareResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1097,50 +1164,49 @@ func (m *QueryAllAuthorizedAddressResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAuthorizedAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAuthorizedAddressResponse.Merge(m, src)
+func (m *QueryAllKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyShareResponse.Merge(m, src)
 }
-func (m *QueryAllAuthorizedAddressResponse) XXX_Size() int {
+func (m *QueryAllKeyShareResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAuthorizedAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAuthorizedAddressResponse.DiscardUnknown(m)
+func (m *QueryAllKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyShareResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAuthorizedAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllKeyShareResponse proto.InternalMessageInfo
 
-func (m *QueryAllAuthorizedAddressResponse) GetAuthorizedAddress() []AuthorizedAddress {
+func (m *QueryAllKeyShareResponse) GetKeyShare() []KeyShare {
 	if m != nil {
-		return m.AuthorizedAddress
+		return m.KeyShare
 	}
 	return nil
 }
 
-func (m *QueryAllAuthorizedAddressResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllKeyShareResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetGeneralKeyShareRequest struct {
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	IdType    string `protobuf:"bytes,2,opt,name=idType,proto3" json:"idType,omitempty"`
-	IdValue   string `protobuf:"bytes,3,opt,name=idValue,proto3" json:"idValue,omitempty"`
+// this line is used by starport scaffolding # 3
+type QueryGetAggregatedKeyShareRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryGetGeneralKeyShareRequest) Reset()         { *m = QueryGetGeneralKeyShareRequest{} }
-func (m *QueryGetGeneralKeyShareRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetGeneralKeyShareRequest) ProtoMessage()    {}
-func (*QueryGetGeneralKeyShareRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetAggregatedKeyShareRequest) Reset()         { *m = QueryGetAggregatedKeyShareRequest{} }
+func (m *QueryGetAggregatedKeyShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAggregatedKeyShareRequest) ProtoMessage()    {}
+func (*QueryGetAggregatedKeyShareRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{24}
 }
-func (m *QueryGetGeneralKeyShareRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAggregatedKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetGeneralKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAggregatedKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetGeneralKeyShareRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAggregatedKeyShareRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1150,55 +1216,41 @@ func (m *QueryGetGeneralKeyShareRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetGeneralKeyShareRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetGeneralKeyShareRequest.Merge(m, src)
+func (m *QueryGetAggregatedKeyShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAggregatedKeyShareRequest.Merge(m, src)
 }
-func (m *QueryGetGeneralKeyShareRequest) XXX_Size() int {
+func (m *QueryGetAggregatedKeyShareRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetGeneralKeyShareRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetGeneralKeyShareRequest.DiscardUnknown(m)
+func (m *QueryGetAggregatedKeyShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAggregatedKeyShareRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetGeneralKeyShareRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAggregatedKeyShareRequest proto.InternalMessageInfo
 
-func (m *QueryGetGeneralKeyShareRequest) GetValidator() string {
+func (m *QueryGetAggregatedKeyShareRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Validator
-	}
-	return ""
-}
-
-func (m *QueryGetGeneralKeyShareRequest) GetIdType() string {
-	if m != nil {
-		return m.IdType
-	}
-	return ""
-}
-
-func (m *QueryGetGeneralKeyShareRequest) GetIdValue() string {
-	if m != nil {
-		return m.IdValue
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-type QueryGetGeneralKeyShareResponse struct {
-	GeneralKeyShare GeneralKeyShare `protobuf:"bytes,1,opt,name=generalKeyShare,proto3" json:"generalKeyShare"`
+type QueryGetAggregatedKeyShareResponse struct {
+	AggregatedKeyShare AggregatedKeyShare `protobuf:"bytes,1,opt,name=aggregatedKeyShare,proto3" json:"aggregatedKeyShare"`
 }
 
-func (m *QueryGetGeneralKeyShareResponse) Reset()         { *m = QueryGetGeneralKeyShareResponse{} }
-func (m *QueryGetGeneralKeyShareResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetGeneralKeyShareResponse) ProtoMessage()    {}
-func (*QueryGetGeneralKeyShareResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetAggregatedKeyShareResponse) Reset()         { *m = QueryGetAggregatedKeyShareResponse{} }
+func (m *QueryGetAggregatedKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAggregatedKeyShareResponse) ProtoMessage()    {}
+func (*QueryGetAggregatedKeyShareResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{25}
 }
-func (m *QueryGetGeneralKeyShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAggregatedKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetGeneralKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAggregatedKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetGeneralKeyShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAggregatedKeyShareResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1208,41 +1260,41 @@ func (m *QueryGetGeneralKeyShareResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetGeneralKeyShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetGeneralKeyShareResponse.Merge(m, src)
+func (m *QueryGetAggregatedKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAggregatedKeyShareResponse.Merge(m, src)
 }
-func (m *QueryGetGeneralKeyShareResponse) XXX_Size() int {
+func (m *QueryGetAggregatedKeyShareResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetGeneralKeyShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetGeneralKeyShareResponse.DiscardUnknown(m)
+func (m *QueryGetAggregatedKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAggregatedKeyShareResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetGeneralKeyShareResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAggregatedKeyShareResponse proto.InternalMessageInfo
 
-func (m *QueryGetGeneralKeyShareResponse) GetGeneralKeyShare() GeneralKeyShare {
+func (m *QueryGetAggregatedKeyShareResponse) GetAggregatedKeyShare() AggregatedKeyShare {
 	if m != nil {
-		return m.GeneralKeyShare
+		return m.AggregatedKeyShare
 	}
-	return GeneralKeyShare{}
+	return AggregatedKeyShare{}
 }
 
-type QueryAllGeneralKeyShareRequest struct {
+type QueryAllAggregatedKeyShareRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllGeneralKeyShareRequest) Reset()         { *m = QueryAllGeneralKeyShareRequest{} }
-func (m *QueryAllGeneralKeyShareRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllGeneralKeyShareRequest) ProtoMessage()    {}
-func (*QueryAllGeneralKeyShareRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllAggregatedKeyShareRequest) Reset()         { *m = QueryAllAggregatedKeyShareRequest{} }
+func (m *QueryAllAggregatedKeyShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAggregatedKeyShareRequest) ProtoMessage()    {}
+func (*QueryAllAggregatedKeyShareRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{26}
 }
-func (m *QueryAllGeneralKeyShareRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllAggregatedKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllGeneralKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllAggregatedKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllGeneralKeyShareRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllAggregatedKeyShareRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1252,42 +1304,42 @@ func (m *QueryAllGeneralKeyShareRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAllGeneralKeyShareRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllGeneralKeyShareRequest.Merge(m, src)
+func (m *QueryAllAggregatedKeyShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAggregatedKeyShareRequest.Merge(m, src)
 }
-func (m *QueryAllGeneralKeyShareRequest) XXX_Size() int {
+func (m *QueryAllAggregatedKeyShareRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllGeneralKeyShareRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllGeneralKeyShareRequest.DiscardUnknown(m)
+func (m *QueryAllAggregatedKeyShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAggregatedKeyShareRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllGeneralKeyShareRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllAggregatedKeyShareRequest proto.InternalMessageInfo
 
-func (m *QueryAllGeneralKeyShareRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllAggregatedKeyShareRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllGeneralKeyShareResponse struct {
-	GeneralKeyShare []GeneralKeyShare   `protobuf:"bytes,1,rep,name=generalKeyShare,proto3" json:"generalKeyShare"`
-	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllAggregatedKeyShareResponse struct {
+	AggregatedKeyShare []AggregatedKeyShare `protobuf:"bytes,1,rep,name=aggregatedKeyShare,proto3" json:"aggregatedKeyShare"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllGeneralKeyShareResponse) Reset()         { *m = QueryAllGeneralKeyShareResponse{} }
-func (m *QueryAllGeneralKeyShareResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllGeneralKeyShareResponse) ProtoMessage()    {}
-func (*QueryAllGeneralKeyShareResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllAggregatedKeyShareResponse) Reset()         { *m = QueryAllAggregatedKeyShareResponse{} }
+func (m *QueryAllAggregatedKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAggregatedKeyShareResponse) ProtoMessage()    {}
+func (*QueryAllAggregatedKeyShareResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_572603c2d521bf14, []int{27}
 }
-func (m *QueryAllGeneralKeyShareResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllAggregatedKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllGeneralKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllAggregatedKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllGeneralKeyShareResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllAggregatedKeyShareResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1297,2127 +1349,6081 @@ func (m *QueryAllGeneralKeyShareResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryAllGeneralKeyShareResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllGeneralKeyShareResponse.Merge(m, src)
+func (m *QueryAllAggregatedKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAggregatedKeyShareResponse.Merge(m, src)
 }
-func (m *QueryAllGeneralKeyShareResponse) XXX_Size() int {
+func (m *QueryAllAggregatedKeyShareResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllGeneralKeyShareResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllGeneralKeyShareResponse.DiscardUnknown(m)
+func (m *QueryAllAggregatedKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAggregatedKeyShareResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllGeneralKeyShareResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllAggregatedKeyShareResponse proto.InternalMessageInfo
 
-func (m *QueryAllGeneralKeyShareResponse) GetGeneralKeyShare() []GeneralKeyShare {
+func (m *QueryAllAggregatedKeyShareResponse) GetAggregatedKeyShare() []AggregatedKeyShare {
 	if m != nil {
-		return m.GeneralKeyShare
+		return m.AggregatedKeyShare
 	}
 	return nil
 }
 
-func (m *QueryAllGeneralKeyShareResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllAggregatedKeyShareResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryVerifiableRandomnessQuery)(nil), "fairyring.keyshare.QueryVerifiableRandomnessQuery")
-	proto.RegisterType((*QueryVerifiableRandomnessResponse)(nil), "fairyring.keyshare.QueryVerifiableRandomnessResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "fairyring.keyshare.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fairyring.keyshare.QueryParamsResponse")
-	proto.RegisterType((*QueryCommitmentsRequest)(nil), "fairyring.keyshare.QueryCommitmentsRequest")
-	proto.RegisterType((*QueryCommitmentsResponse)(nil), "fairyring.keyshare.QueryCommitmentsResponse")
-	proto.RegisterType((*QueryGetValidatorSetRequest)(nil), "fairyring.keyshare.QueryGetValidatorSetRequest")
-	proto.RegisterType((*QueryGetValidatorSetResponse)(nil), "fairyring.keyshare.QueryGetValidatorSetResponse")
-	proto.RegisterType((*QueryAllValidatorSetRequest)(nil), "fairyring.keyshare.QueryAllValidatorSetRequest")
-	proto.RegisterType((*QueryAllValidatorSetResponse)(nil), "fairyring.keyshare.QueryAllValidatorSetResponse")
-	proto.RegisterType((*QueryGetKeyShareRequest)(nil), "fairyring.keyshare.QueryGetKeyShareRequest")
-	proto.RegisterType((*QueryGetKeyShareResponse)(nil), "fairyring.keyshare.QueryGetKeyShareResponse")
-	proto.RegisterType((*QueryAllKeyShareRequest)(nil), "fairyring.keyshare.QueryAllKeyShareRequest")
-	proto.RegisterType((*QueryAllKeyShareResponse)(nil), "fairyring.keyshare.QueryAllKeyShareResponse")
-	proto.RegisterType((*QueryGetAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareRequest")
-	proto.RegisterType((*QueryGetAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareResponse")
-	proto.RegisterType((*QueryAllAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareRequest")
-	proto.RegisterType((*QueryAllAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareResponse")
-	proto.RegisterType((*QueryPubKeyRequest)(nil), "fairyring.keyshare.QueryPubKeyRequest")
-	proto.RegisterType((*QueryPubKeyResponse)(nil), "fairyring.keyshare.QueryPubKeyResponse")
-	proto.RegisterType((*QueryGetAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressRequest")
-	proto.RegisterType((*QueryGetAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressResponse")
-	proto.RegisterType((*QueryAllAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressRequest")
-	proto.RegisterType((*QueryAllAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressResponse")
-	proto.RegisterType((*QueryGetGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareRequest")
-	proto.RegisterType((*QueryGetGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareResponse")
-	proto.RegisterType((*QueryAllGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareRequest")
-	proto.RegisterType((*QueryAllGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareResponse")
+type QueryPubKeyRequest struct {
 }
 
-func init() { proto.RegisterFile("fairyring/keyshare/query.proto", fileDescriptor_572603c2d521bf14) }
-
-var fileDescriptor_572603c2d521bf14 = []byte{
-	// 1423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x24, 0x6d, 0x68, 0x26, 0x95, 0xaa, 0x0c, 0xa1, 0x04, 0x37, 0x75, 0xdc, 0x29, 0x4d,
-	0xd2, 0x86, 0x7a, 0x9a, 0xa4, 0xa5, 0x2a, 0x08, 0x90, 0x0b, 0x6a, 0x80, 0x82, 0xd4, 0xba, 0x55,
-	0xa5, 0x56, 0x48, 0xd1, 0x38, 0x9e, 0xae, 0xb7, 0x59, 0xef, 0xba, 0xbb, 0xeb, 0xa8, 0x26, 0x72,
-	0x0f, 0xfd, 0x02, 0x80, 0xf8, 0x00, 0x1c, 0x40, 0x88, 0x03, 0x48, 0x48, 0xe5, 0x50, 0x0e, 0xc0,
-	0x91, 0x4a, 0x5c, 0x2a, 0x71, 0xe1, 0x84, 0x50, 0x83, 0xc4, 0xd7, 0x40, 0x3b, 0xfb, 0x76, 0xbd,
-	0xf6, 0xce, 0x8e, 0xed, 0xd6, 0x17, 0xcb, 0x3b, 0xf3, 0xfe, 0xfc, 0x7e, 0xef, 0xcd, 0xce, 0xfc,
-	0x76, 0x70, 0xfe, 0x36, 0x37, 0xdd, 0x96, 0x6b, 0xda, 0x06, 0xdb, 0x16, 0x2d, 0xaf, 0xc6, 0x5d,
-	0xc1, 0xee, 0x36, 0x85, 0xdb, 0x2a, 0x36, 0x5c, 0xc7, 0x77, 0x08, 0x89, 0xe7, 0x8b, 0xd1, 0x7c,
-	0x6e, 0x86, 0xd7, 0x4d, 0xdb, 0x61, 0xf2, 0x37, 0x34, 0xcb, 0xcd, 0x1a, 0x8e, 0xe1, 0xc8, 0xbf,
-	0x2c, 0xf8, 0x07, 0xa3, 0xf3, 0x86, 0xe3, 0x18, 0x96, 0x60, 0xbc, 0x61, 0x32, 0x6e, 0xdb, 0x8e,
-	0xcf, 0x7d, 0xd3, 0xb1, 0x3d, 0x98, 0x3d, 0xb5, 0xe5, 0x78, 0x75, 0xc7, 0x63, 0x15, 0xee, 0x41,
-	0x4e, 0xb6, 0xb3, 0x5a, 0x11, 0x3e, 0x5f, 0x65, 0x0d, 0x6e, 0x98, 0xb6, 0x34, 0x06, 0xdb, 0x05,
-	0x05, 0xcc, 0x06, 0x77, 0x79, 0x3d, 0x0a, 0xb6, 0xa8, 0x30, 0xd8, 0xe1, 0x96, 0x59, 0xe5, 0xbe,
-	0xe3, 0x6e, 0x7a, 0xc2, 0x07, 0x3b, 0xaa, 0xb0, 0xdb, 0x16, 0xad, 0x4d, 0xf9, 0x0f, 0x6c, 0x4e,
-	0x2b, 0x6c, 0xb8, 0x61, 0xb8, 0xc2, 0xe0, 0xbe, 0xa8, 0x6e, 0xf6, 0x9a, 0x17, 0x54, 0xd8, 0x9a,
-	0x95, 0xc0, 0x0e, 0x2c, 0x56, 0x54, 0x01, 0x9b, 0x7e, 0xcd, 0x71, 0xcd, 0x4f, 0x45, 0x75, 0x93,
-	0x57, 0xab, 0xae, 0xf0, 0xe2, 0xb2, 0x28, 0x8c, 0x0d, 0x61, 0x0b, 0x97, 0x5b, 0xa9, 0xd4, 0xaf,
-	0x2a, 0x6c, 0xb7, 0x9c, 0x7a, 0xdd, 0xf4, 0xeb, 0xc2, 0xf6, 0x21, 0x22, 0x2d, 0xe0, 0xfc, 0xd5,
-	0xa0, 0xbc, 0x37, 0x84, 0x6b, 0xde, 0x36, 0x79, 0xc5, 0x12, 0x65, 0x6e, 0x57, 0x9d, 0xba, 0x2d,
-	0x3c, 0x4f, 0x4e, 0xd0, 0x9b, 0xf8, 0x58, 0xa6, 0x45, 0x59, 0x78, 0x0d, 0xc7, 0xf6, 0x04, 0xc9,
-	0x63, 0xec, 0xc6, 0xa3, 0x73, 0xa8, 0x80, 0x96, 0xa7, 0xca, 0x89, 0x11, 0x32, 0x8b, 0xf7, 0xbb,
-	0x4e, 0xd3, 0xae, 0xce, 0x8d, 0x17, 0xd0, 0xf2, 0xbe, 0x72, 0xf8, 0x40, 0x67, 0x31, 0x91, 0xa1,
-	0xaf, 0xc8, 0x6e, 0x95, 0xc5, 0xdd, 0xa6, 0xf0, 0x7c, 0x7a, 0x1d, 0xbf, 0xd8, 0x35, 0x0a, 0x29,
-	0xde, 0xc2, 0x93, 0x61, 0x57, 0x65, 0xf8, 0xe9, 0xb5, 0x5c, 0x31, 0xbd, 0xfc, 0x8a, 0xa1, 0xcf,
-	0xc5, 0xa9, 0xc7, 0x7f, 0x2f, 0x8c, 0x7d, 0xf7, 0xdf, 0x8f, 0xa7, 0x50, 0x19, 0x9c, 0xe8, 0x2b,
-	0xf8, 0x65, 0x19, 0xf5, 0xdd, 0x4e, 0x09, 0xa2, 0x84, 0x8f, 0x10, 0x9e, 0x4b, 0xcf, 0x41, 0xda,
-	0x8f, 0xf1, 0x0c, 0xdf, 0xf2, 0xcd, 0x1d, 0x91, 0x98, 0x04, 0x04, 0x0b, 0x2a, 0x04, 0xc9, 0x18,
-	0x69, 0xcf, 0x20, 0xdc, 0xdd, 0xa6, 0x68, 0x8a, 0x6a, 0x32, 0xdc, 0xf8, 0x80, 0xe1, 0x52, 0x9e,
-	0x74, 0x1d, 0x1f, 0x91, 0xc8, 0x37, 0x84, 0x7f, 0x23, 0x5a, 0xd1, 0xd7, 0x84, 0x0f, 0xcc, 0x82,
-	0xb2, 0x9b, 0x76, 0x55, 0xdc, 0x83, 0x8e, 0x84, 0x0f, 0xf4, 0x0e, 0x9e, 0x57, 0x3b, 0x01, 0xe5,
-	0x0f, 0xf1, 0xc1, 0x9d, 0xc4, 0x38, 0xb0, 0x2d, 0xa8, 0xe0, 0x25, 0xfd, 0x2f, 0xee, 0x0b, 0xaa,
-	0x5e, 0xee, 0xf2, 0xa5, 0x02, 0x00, 0x96, 0x2c, 0x4b, 0x05, 0xf0, 0x12, 0xc6, 0x9d, 0xf7, 0x19,
-	0x12, 0x2d, 0x16, 0xc3, 0x97, 0xbf, 0x18, 0xbc, 0xfc, 0xc5, 0x70, 0xc3, 0x81, 0x97, 0xbf, 0x78,
-	0x85, 0x1b, 0x02, 0x7c, 0xcb, 0x09, 0x4f, 0xfa, 0x10, 0x01, 0xa7, 0x54, 0x9e, 0x4c, 0x4e, 0x13,
-	0xcf, 0xca, 0x89, 0x6c, 0x74, 0x81, 0x0e, 0x9b, 0xb7, 0xd4, 0x17, 0x74, 0x08, 0xa4, 0x0b, 0xf5,
-	0x4d, 0x58, 0x93, 0x1b, 0xc2, 0xbf, 0x2c, 0x5a, 0xd7, 0x82, 0xec, 0x51, 0x61, 0xe6, 0xf1, 0x54,
-	0x9c, 0x13, 0xba, 0xd7, 0x19, 0x20, 0x05, 0x3c, 0x5d, 0xb1, 0x9c, 0xad, 0xed, 0xf7, 0x85, 0x69,
-	0xd4, 0x7c, 0x78, 0xa9, 0x92, 0x43, 0xf4, 0x16, 0x2c, 0xe9, 0xae, 0xd0, 0x50, 0x8b, 0xb7, 0xf1,
-	0x81, 0x6d, 0x18, 0x83, 0x92, 0xcf, 0xab, 0xea, 0x10, 0xf9, 0x41, 0x0d, 0x62, 0x1f, 0xca, 0x01,
-	0x76, 0xc9, 0xb2, 0x7a, 0x61, 0x8f, 0xaa, 0x9f, 0x5f, 0x47, 0xaf, 0x64, 0x57, 0x0e, 0x25, 0xfe,
-	0x89, 0x61, 0xf1, 0x8f, 0xae, 0x7f, 0x6f, 0xc2, 0xd6, 0xb8, 0x21, 0xfc, 0x52, 0x7c, 0x06, 0xf4,
-	0x96, 0xe4, 0x30, 0x9e, 0xac, 0x85, 0x6d, 0x42, 0xb2, 0x4d, 0xf0, 0x44, 0x1f, 0x20, 0x4c, 0x75,
-	0xde, 0x40, 0xf6, 0x13, 0x4c, 0x78, 0x6a, 0x36, 0xae, 0xac, 0x82, 0x76, 0x3a, 0x16, 0x14, 0x40,
-	0x11, 0x87, 0x6e, 0x03, 0x83, 0x92, 0x65, 0x65, 0x33, 0x18, 0x55, 0x53, 0xff, 0x88, 0x18, 0x67,
-	0x64, 0xeb, 0xc3, 0x78, 0x62, 0x14, 0x8c, 0x47, 0xd7, 0xfc, 0xf8, 0xf0, 0x6a, 0x56, 0x2e, 0x8b,
-	0x56, 0x74, 0x96, 0xfc, 0x80, 0xa2, 0xd3, 0x0b, 0x86, 0x3b, 0xfb, 0x4f, 0x78, 0x18, 0x84, 0xe3,
-	0xba, 0x3d, 0xb5, 0x94, 0xb0, 0x8b, 0xf6, 0x9f, 0xa4, 0x6f, 0x10, 0x2b, 0x3c, 0x09, 0x20, 0xd6,
-	0x78, 0x76, 0xac, 0xab, 0x09, 0xbb, 0x28, 0x56, 0xd2, 0x97, 0xbe, 0x81, 0x0b, 0xf1, 0x22, 0x8c,
-	0x55, 0x47, 0x29, 0x14, 0x1d, 0x89, 0x15, 0xec, 0x73, 0xd7, 0x80, 0x93, 0x60, 0xaa, 0x0c, 0x4f,
-	0xf4, 0x7e, 0x62, 0xf9, 0xa7, 0x7d, 0x81, 0xf8, 0x4d, 0x3c, 0xc3, 0x7b, 0x27, 0x81, 0xfd, 0x09,
-	0x25, 0xfb, 0x5e, 0x63, 0x80, 0x9d, 0x8e, 0x42, 0xef, 0x00, 0xf6, 0x60, 0x39, 0x65, 0x61, 0x1f,
-	0xd5, 0xda, 0xfd, 0x1d, 0x25, 0xde, 0x94, 0x61, 0xc9, 0x4e, 0x3c, 0x3f, 0xd9, 0xd1, 0xad, 0xdb,
-	0x06, 0x28, 0xbe, 0x0d, 0xe1, 0x6f, 0x84, 0xd2, 0x71, 0xb8, 0xb3, 0xe7, 0x30, 0x9e, 0x34, 0xab,
-	0xd7, 0x5b, 0x0d, 0x21, 0x41, 0x4c, 0x95, 0xe1, 0x89, 0xcc, 0xe1, 0x17, 0xcc, 0xea, 0x0d, 0x6e,
-	0x35, 0xc5, 0xdc, 0x84, 0x9c, 0x88, 0x1e, 0xe9, 0x0e, 0x5e, 0xc8, 0xcc, 0x08, 0x85, 0xbb, 0x86,
-	0x0f, 0x19, 0xdd, 0x53, 0xd0, 0xab, 0xe3, 0xaa, 0xb2, 0xf5, 0x44, 0x81, 0xa2, 0xf5, 0x46, 0xa0,
-	0x35, 0x60, 0x5a, 0xb2, 0xac, 0x0c, 0xa6, 0xa3, 0x5a, 0x1d, 0xbf, 0x21, 0xa0, 0xa8, 0x4a, 0xa5,
-	0xa3, 0x38, 0xf1, 0x7c, 0x14, 0x47, 0xb6, 0x2a, 0xd6, 0x7e, 0x21, 0x78, 0xbf, 0x64, 0x40, 0xda,
-	0x78, 0x32, 0x54, 0xd1, 0x64, 0x31, 0x63, 0x47, 0xe9, 0x11, 0xec, 0xb9, 0xa5, 0xbe, 0x76, 0x61,
-	0x42, 0x4a, 0x1f, 0xfc, 0xf9, 0xef, 0x97, 0xe3, 0xf3, 0x24, 0xc7, 0x32, 0x3f, 0xd9, 0xc8, 0x17,
-	0x08, 0x4f, 0x27, 0x05, 0xf3, 0x4a, 0x66, 0xf0, 0xb4, 0x92, 0xcf, 0xbd, 0x36, 0x98, 0x31, 0xc0,
-	0x59, 0x92, 0x70, 0x8e, 0x91, 0x05, 0xa6, 0xff, 0x54, 0x22, 0xdf, 0x20, 0x7c, 0x30, 0xa9, 0x0a,
-	0x09, 0xcb, 0xcc, 0xa3, 0x16, 0xe2, 0xb9, 0x33, 0x83, 0x3b, 0x00, 0xb8, 0x55, 0x09, 0x6e, 0x85,
-	0x9c, 0x64, 0xfd, 0xbe, 0x5e, 0xd9, 0xae, 0x94, 0xf5, 0x6d, 0xf2, 0x15, 0xc2, 0x87, 0x92, 0xb1,
-	0x4a, 0x96, 0xa5, 0x41, 0xaa, 0x56, 0xe4, 0x1a, 0xa4, 0x19, 0xd2, 0x9a, 0x9e, 0x94, 0x48, 0x8f,
-	0x93, 0x63, 0x7d, 0x91, 0x92, 0x6f, 0x11, 0x3e, 0x10, 0xaf, 0xdd, 0x15, 0x5d, 0x4d, 0x7a, 0xde,
-	0x54, 0x4d, 0x67, 0x15, 0x0a, 0x97, 0xbe, 0x23, 0x21, 0x5d, 0x20, 0xe7, 0x99, 0xee, 0x93, 0x9e,
-	0xed, 0xc6, 0xe8, 0xda, 0x6c, 0x37, 0xa1, 0x9e, 0xdb, 0xe4, 0x33, 0x84, 0xa7, 0xa3, 0xa8, 0x41,
-	0x19, 0x57, 0x74, 0x55, 0x19, 0x1c, 0xab, 0x42, 0xcd, 0xd2, 0x13, 0x12, 0xeb, 0x02, 0x39, 0xaa,
-	0xc5, 0x4a, 0x7e, 0x45, 0x98, 0xa4, 0x85, 0x0e, 0x39, 0xa7, 0xab, 0x4b, 0xa6, 0xa4, 0xcb, 0xbd,
-	0x3e, 0xac, 0x1b, 0x80, 0xbd, 0x20, 0xc1, 0xae, 0x93, 0x55, 0x36, 0xe0, 0x3d, 0x08, 0xdb, 0xad,
-	0x41, 0x49, 0x1f, 0x21, 0xfc, 0x52, 0x3a, 0x72, 0x50, 0xdc, 0x73, 0xba, 0x7a, 0x3d, 0x0b, 0x07,
-	0xad, 0xbe, 0xa4, 0x67, 0x24, 0x87, 0x53, 0x64, 0x79, 0x50, 0x0e, 0xe4, 0x3e, 0x9e, 0x04, 0xe9,
-	0xa5, 0xd9, 0x12, 0x93, 0x32, 0x50, 0xb7, 0x25, 0x76, 0xe9, 0x42, 0x7a, 0x5c, 0x82, 0x39, 0x4a,
-	0x8e, 0xb0, 0xec, 0x9b, 0x22, 0xf2, 0x33, 0xc2, 0x33, 0x29, 0xa9, 0x40, 0xce, 0x6a, 0x7b, 0x98,
-	0x21, 0x88, 0x72, 0xe7, 0x86, 0xf4, 0x02, 0x9c, 0xe7, 0x25, 0xce, 0x55, 0xc2, 0xd8, 0x40, 0xf7,
-	0x55, 0x6c, 0x37, 0xd4, 0x88, 0x6d, 0xf2, 0x10, 0xe1, 0xd9, 0x54, 0xd8, 0xa0, 0xeb, 0x67, 0xb5,
-	0xed, 0x1b, 0x1e, 0xbe, 0x4e, 0x98, 0xd1, 0xa2, 0x84, 0xbf, 0x4c, 0x16, 0x07, 0x83, 0x4f, 0x1e,
-	0x23, 0x7c, 0xa8, 0xe7, 0x08, 0x26, 0x6b, 0xba, 0xca, 0xa9, 0x05, 0x46, 0x6e, 0x7d, 0x28, 0x1f,
-	0x00, 0xfb, 0x91, 0x04, 0x7b, 0x89, 0xbc, 0xc7, 0x06, 0xb9, 0xee, 0xeb, 0xde, 0xc5, 0x42, 0x31,
-	0x26, 0xff, 0x48, 0xf1, 0xd5, 0x26, 0xdf, 0x23, 0x4c, 0x7a, 0x32, 0x05, 0xe5, 0x5f, 0xd3, 0x15,
-	0x72, 0x68, 0x36, 0xd9, 0xba, 0x87, 0x9e, 0x96, 0x6c, 0x96, 0xc8, 0x89, 0x81, 0xd8, 0x90, 0x9f,
-	0x10, 0x9e, 0x55, 0x5d, 0x35, 0x6a, 0x00, 0x67, 0xde, 0x5d, 0x6a, 0x56, 0x8b, 0xee, 0x36, 0xb3,
-	0xcf, 0xd9, 0x1b, 0x7b, 0x6e, 0x76, 0x2e, 0x38, 0x2f, 0x7e, 0xf0, 0xf8, 0x69, 0x1e, 0x3d, 0x79,
-	0x9a, 0x47, 0xff, 0x3c, 0xcd, 0xa3, 0xcf, 0xf7, 0xf2, 0x63, 0x4f, 0xf6, 0xf2, 0x63, 0x7f, 0xed,
-	0xe5, 0xc7, 0x6e, 0x31, 0xc3, 0xf4, 0x6b, 0xcd, 0x4a, 0x71, 0xcb, 0xa9, 0xb3, 0x4b, 0xdc, 0x74,
-	0xe5, 0x39, 0x93, 0x08, 0x7c, 0xaf, 0x13, 0xda, 0x6f, 0x35, 0x84, 0x57, 0x99, 0x94, 0x37, 0xb3,
-	0xeb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x16, 0x81, 0xab, 0x7f, 0x17, 0x00, 0x00,
+func (m *QueryPubKeyRequest) Reset()         { *m = QueryPubKeyRequest{} }
+func (m *QueryPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRequest) ProtoMessage()    {}
+func (*QueryPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{28}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error)
-	// Queries a ValidatorSet by index.
-	ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error)
-	// Queries a list of ValidatorSet items.
-	ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error)
-	// Queries a KeyShare by index.
-	KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error)
-	// Queries a list of KeyShare items.
-	KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error)
-	// Queries a list of AggregatedKeyShare items.
-	AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error)
-	AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error)
-	// Queries the public keys
-	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
-	// Queries a list of AuthorizedAddress items.
-	AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error)
-	AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error)
-	// Queries a list of GeneralKeyShare items.
-	GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error)
-	GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error)
-	VerifiableRandomness(ctx context.Context, in *QueryVerifiableRandomnessQuery, opts ...grpc.CallOption) (*QueryVerifiableRandomnessResponse, error)
+func (m *QueryPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error) {
-	out := new(QueryCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Commitments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error) {
-	out := new(QueryGetValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryPubKeyRequest proto.InternalMessageInfo
 
-func (c *queryClient) ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error) {
-	out := new(QueryAllValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSetAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPubKeyResponse struct {
+	ActivePubKey ActivePubKey `protobuf:"bytes,1,opt,name=activePubKey,proto3" json:"activePubKey"`
+	QueuedPubKey QueuedPubKey `protobuf:"bytes,2,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
 }
 
-func (c *queryClient) KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error) {
-	out := new(QueryGetKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShare", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyResponse) Reset()         { *m = QueryPubKeyResponse{} }
+func (m *QueryPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyResponse) ProtoMessage()    {}
+func (*QueryPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{29}
 }
-
-func (c *queryClient) KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error) {
-	out := new(QueryAllKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error) {
-	out := new(QueryGetAggregatedKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error) {
-	out := new(QueryAllAggregatedKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShareAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyResponse.Merge(m, src)
 }
-
-func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error) {
-	out := new(QueryPubKeyResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/PubKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error) {
-	out := new(QueryGetAuthorizedAddressResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error) {
-	out := new(QueryAllAuthorizedAddressResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddressAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPubKeyResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyResponse) GetActivePubKey() ActivePubKey {
+	if m != nil {
+		return m.ActivePubKey
 	}
-	return out, nil
+	return ActivePubKey{}
 }
 
-func (c *queryClient) GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error) {
-	out := new(QueryGetGeneralKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPubKeyResponse) GetQueuedPubKey() QueuedPubKey {
+	if m != nil {
+		return m.QueuedPubKey
 	}
-	return out, nil
+	return QueuedPubKey{}
 }
 
-func (c *queryClient) GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error) {
-	out := new(QueryAllGeneralKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShareAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetAuthorizedAddressRequest struct {
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (c *queryClient) VerifiableRandomness(ctx context.Context, in *QueryVerifiableRandomnessQuery, opts ...grpc.CallOption) (*QueryVerifiableRandomnessResponse, error) {
-	out := new(QueryVerifiableRandomnessResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/VerifiableRandomness", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetAuthorizedAddressRequest) Reset()         { *m = QueryGetAuthorizedAddressRequest{} }
+func (m *QueryGetAuthorizedAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuthorizedAddressRequest) ProtoMessage()    {}
+func (*QueryGetAuthorizedAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{30}
+}
+func (m *QueryGetAuthorizedAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuthorizedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuthorizedAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetAuthorizedAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuthorizedAddressRequest.Merge(m, src)
+}
+func (m *QueryGetAuthorizedAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuthorizedAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuthorizedAddressRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	Commitments(context.Context, *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error)
-	// Queries a ValidatorSet by index.
-	ValidatorSet(context.Context, *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error)
-	// Queries a list of ValidatorSet items.
-	ValidatorSetAll(context.Context, *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error)
-	// Queries a KeyShare by index.
-	KeyShare(context.Context, *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error)
-	// Queries a list of KeyShare items.
-	KeyShareAll(context.Context, *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error)
-	// Queries a list of AggregatedKeyShare items.
-	AggregatedKeyShare(context.Context, *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error)
-	AggregatedKeyShareAll(context.Context, *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error)
-	// Queries the public keys
-	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
-	// Queries a list of AuthorizedAddress items.
-	AuthorizedAddress(context.Context, *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error)
-	AuthorizedAddressAll(context.Context, *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error)
-	// Queries a list of GeneralKeyShare items.
-	GeneralKeyShare(context.Context, *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error)
-	GeneralKeyShareAll(context.Context, *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error)
-	VerifiableRandomness(context.Context, *QueryVerifiableRandomnessQuery) (*QueryVerifiableRandomnessResponse, error)
+var xxx_messageInfo_QueryGetAuthorizedAddressRequest proto.InternalMessageInfo
+
+func (m *QueryGetAuthorizedAddressRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryGetAuthorizedAddressResponse struct {
+	AuthorizedAddress AuthorizedAddress `protobuf:"bytes,1,opt,name=authorizedAddress,proto3" json:"authorizedAddress"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetAuthorizedAddressResponse) Reset()         { *m = QueryGetAuthorizedAddressResponse{} }
+func (m *QueryGetAuthorizedAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuthorizedAddressResponse) ProtoMessage()    {}
+func (*QueryGetAuthorizedAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{31}
 }
-func (*UnimplementedQueryServer) Commitments(ctx context.Context, req *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Commitments not implemented")
+func (m *QueryGetAuthorizedAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+func (m *QueryGetAuthorizedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuthorizedAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ValidatorSetAll(ctx context.Context, req *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetAll not implemented")
+func (m *QueryGetAuthorizedAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuthorizedAddressResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) KeyShare(ctx context.Context, req *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyShare not implemented")
+func (m *QueryGetAuthorizedAddressResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) KeyShareAll(ctx context.Context, req *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyShareAll not implemented")
+func (m *QueryGetAuthorizedAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuthorizedAddressResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AggregatedKeyShare(ctx context.Context, req *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShare not implemented")
+
+var xxx_messageInfo_QueryGetAuthorizedAddressResponse proto.InternalMessageInfo
+
+func (m *QueryGetAuthorizedAddressResponse) GetAuthorizedAddress() AuthorizedAddress {
+	if m != nil {
+		return m.AuthorizedAddress
+	}
+	return AuthorizedAddress{}
 }
-func (*UnimplementedQueryServer) AggregatedKeyShareAll(ctx context.Context, req *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShareAll not implemented")
+
+type QueryAllAuthorizedAddressRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+
+func (m *QueryAllAuthorizedAddressRequest) Reset()         { *m = QueryAllAuthorizedAddressRequest{} }
+func (m *QueryAllAuthorizedAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAuthorizedAddressRequest) ProtoMessage()    {}
+func (*QueryAllAuthorizedAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{32}
 }
-func (*UnimplementedQueryServer) AuthorizedAddress(ctx context.Context, req *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddress not implemented")
+func (m *QueryAllAuthorizedAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AuthorizedAddressAll(ctx context.Context, req *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddressAll not implemented")
+func (m *QueryAllAuthorizedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAuthorizedAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GeneralKeyShare(ctx context.Context, req *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShare not implemented")
+func (m *QueryAllAuthorizedAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAuthorizedAddressRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GeneralKeyShareAll(ctx context.Context, req *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShareAll not implemented")
+func (m *QueryAllAuthorizedAddressRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) VerifiableRandomness(ctx context.Context, req *QueryVerifiableRandomnessQuery) (*QueryVerifiableRandomnessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifiableRandomness not implemented")
+func (m *QueryAllAuthorizedAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAuthorizedAddressRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAllAuthorizedAddressRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllAuthorizedAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Commitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Commitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/Commitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Commitments(ctx, req.(*QueryCommitmentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllAuthorizedAddressResponse struct {
+	AuthorizedAddress []AuthorizedAddress `protobuf:"bytes,1,rep,name=authorizedAddress,proto3" json:"authorizedAddress"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/ValidatorSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryGetValidatorSetRequest))
+func (m *QueryAllAuthorizedAddressResponse) Reset()         { *m = QueryAllAuthorizedAddressResponse{} }
+func (m *QueryAllAuthorizedAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAuthorizedAddressResponse) ProtoMessage()    {}
+func (*QueryAllAuthorizedAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{33}
+}
+func (m *QueryAllAuthorizedAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAuthorizedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAuthorizedAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllAuthorizedAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAuthorizedAddressResponse.Merge(m, src)
+}
+func (m *QueryAllAuthorizedAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAuthorizedAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAuthorizedAddressResponse.DiscardUnknown(m)
 }
 
-func _Query_ValidatorSetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSetAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/ValidatorSetAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSetAll(ctx, req.(*QueryAllValidatorSetRequest))
+var xxx_messageInfo_QueryAllAuthorizedAddressResponse proto.InternalMessageInfo
+
+func (m *QueryAllAuthorizedAddressResponse) GetAuthorizedAddress() []AuthorizedAddress {
+	if m != nil {
+		return m.AuthorizedAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_KeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/KeyShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyShare(ctx, req.(*QueryGetKeyShareRequest))
+func (m *QueryAllAuthorizedAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_KeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyShareAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/KeyShareAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyShareAll(ctx, req.(*QueryAllKeyShareRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetGeneralKeyShareRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	IdType    string `protobuf:"bytes,2,opt,name=idType,proto3" json:"idType,omitempty"`
+	IdValue   string `protobuf:"bytes,3,opt,name=idValue,proto3" json:"idValue,omitempty"`
 }
 
-func _Query_AggregatedKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAggregatedKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatedKeyShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatedKeyShare(ctx, req.(*QueryGetAggregatedKeyShareRequest))
+func (m *QueryGetGeneralKeyShareRequest) Reset()         { *m = QueryGetGeneralKeyShareRequest{} }
+func (m *QueryGetGeneralKeyShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGeneralKeyShareRequest) ProtoMessage()    {}
+func (*QueryGetGeneralKeyShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{34}
+}
+func (m *QueryGetGeneralKeyShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGeneralKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGeneralKeyShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetGeneralKeyShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGeneralKeyShareRequest.Merge(m, src)
+}
+func (m *QueryGetGeneralKeyShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGeneralKeyShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGeneralKeyShareRequest.DiscardUnknown(m)
 }
 
-func _Query_AggregatedKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAggregatedKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatedKeyShareAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShareAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatedKeyShareAll(ctx, req.(*QueryAllAggregatedKeyShareRequest))
+var xxx_messageInfo_QueryGetGeneralKeyShareRequest proto.InternalMessageInfo
+
+func (m *QueryGetGeneralKeyShareRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPubKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PubKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/PubKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PubKey(ctx, req.(*QueryPubKeyRequest))
+func (m *QueryGetGeneralKeyShareRequest) GetIdType() string {
+	if m != nil {
+		return m.IdType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AuthorizedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAuthorizedAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AuthorizedAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AuthorizedAddress(ctx, req.(*QueryGetAuthorizedAddressRequest))
+func (m *QueryGetGeneralKeyShareRequest) GetIdValue() string {
+	if m != nil {
+		return m.IdValue
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AuthorizedAddressAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAuthorizedAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AuthorizedAddressAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddressAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AuthorizedAddressAll(ctx, req.(*QueryAllAuthorizedAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetGeneralKeyShareResponse struct {
+	GeneralKeyShare GeneralKeyShare `protobuf:"bytes,1,opt,name=generalKeyShare,proto3" json:"generalKeyShare"`
 }
 
-func _Query_GeneralKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGeneralKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeneralKeyShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeneralKeyShare(ctx, req.(*QueryGetGeneralKeyShareRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetGeneralKeyShareResponse) Reset()         { *m = QueryGetGeneralKeyShareResponse{} }
+func (m *QueryGetGeneralKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGeneralKeyShareResponse) ProtoMessage()    {}
+func (*QueryGetGeneralKeyShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{35}
 }
-
-func _Query_GeneralKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGeneralKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeneralKeyShareAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShareAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeneralKeyShareAll(ctx, req.(*QueryAllGeneralKeyShareRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetGeneralKeyShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_VerifiableRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerifiableRandomnessQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerifiableRandomness(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/VerifiableRandomness",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerifiableRandomness(ctx, req.(*QueryVerifiableRandomnessQuery))
+func (m *QueryGetGeneralKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGeneralKeyShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fairyring.keyshare.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Commitments",
-			Handler:    _Query_Commitments_Handler,
-		},
-		{
-			MethodName: "ValidatorSet",
-			Handler:    _Query_ValidatorSet_Handler,
-		},
-		{
-			MethodName: "ValidatorSetAll",
-			Handler:    _Query_ValidatorSetAll_Handler,
-		},
-		{
-			MethodName: "KeyShare",
-			Handler:    _Query_KeyShare_Handler,
-		},
-		{
-			MethodName: "KeyShareAll",
-			Handler:    _Query_KeyShareAll_Handler,
-		},
-		{
-			MethodName: "AggregatedKeyShare",
-			Handler:    _Query_AggregatedKeyShare_Handler,
-		},
-		{
-			MethodName: "AggregatedKeyShareAll",
-			Handler:    _Query_AggregatedKeyShareAll_Handler,
-		},
-		{
-			MethodName: "PubKey",
-			Handler:    _Query_PubKey_Handler,
-		},
-		{
-			MethodName: "AuthorizedAddress",
-			Handler:    _Query_AuthorizedAddress_Handler,
-		},
-		{
-			MethodName: "AuthorizedAddressAll",
-			Handler:    _Query_AuthorizedAddressAll_Handler,
-		},
-		{
-			MethodName: "GeneralKeyShare",
-			Handler:    _Query_GeneralKeyShare_Handler,
-		},
-		{
-			MethodName: "GeneralKeyShareAll",
-			Handler:    _Query_GeneralKeyShareAll_Handler,
-		},
-		{
-			MethodName: "VerifiableRandomness",
-			Handler:    _Query_VerifiableRandomness_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fairyring/keyshare/query.proto",
+func (m *QueryGetGeneralKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGeneralKeyShareResponse.Merge(m, src)
 }
-
-func (m *QueryVerifiableRandomnessQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetGeneralKeyShareResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryVerifiableRandomnessQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetGeneralKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGeneralKeyShareResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVerifiableRandomnessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryGetGeneralKeyShareResponse proto.InternalMessageInfo
 
-func (m *QueryVerifiableRandomnessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetGeneralKeyShareResponse) GetGeneralKeyShare() GeneralKeyShare {
+	if m != nil {
+		return m.GeneralKeyShare
 	}
-	return dAtA[:n], nil
+	return GeneralKeyShare{}
 }
 
-func (m *QueryVerifiableRandomnessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllGeneralKeyShareRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVerifiableRandomnessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Round != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Randomness) > 0 {
-		i -= len(m.Randomness)
-		copy(dAtA[i:], m.Randomness)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Randomness)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAllGeneralKeyShareRequest) Reset()         { *m = QueryAllGeneralKeyShareRequest{} }
+func (m *QueryAllGeneralKeyShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGeneralKeyShareRequest) ProtoMessage()    {}
+func (*QueryAllGeneralKeyShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{36}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllGeneralKeyShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGeneralKeyShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGeneralKeyShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllGeneralKeyShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGeneralKeyShareRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllGeneralKeyShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGeneralKeyShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGeneralKeyShareRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllGeneralKeyShareRequest proto.InternalMessageInfo
+
+func (m *QueryAllGeneralKeyShareRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllGeneralKeyShareResponse struct {
+	GeneralKeyShare []GeneralKeyShare   `protobuf:"bytes,1,rep,name=generalKeyShare,proto3" json:"generalKeyShare"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllGeneralKeyShareResponse) Reset()         { *m = QueryAllGeneralKeyShareResponse{} }
+func (m *QueryAllGeneralKeyShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGeneralKeyShareResponse) ProtoMessage()    {}
+func (*QueryAllGeneralKeyShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{37}
+}
+func (m *QueryAllGeneralKeyShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGeneralKeyShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGeneralKeyShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllGeneralKeyShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGeneralKeyShareResponse.Merge(m, src)
 }
-
-func (m *QueryCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllGeneralKeyShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGeneralKeyShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGeneralKeyShareResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAllGeneralKeyShareResponse proto.InternalMessageInfo
+
+func (m *QueryAllGeneralKeyShareResponse) GetGeneralKeyShare() []GeneralKeyShare {
+	if m != nil {
+		return m.GeneralKeyShare
+	}
+	return nil
 }
 
-func (m *QueryCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllGeneralKeyShareResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKeyshareEvidenceRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Identity  string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (m *QueryCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueuedCommitments != nil {
-		{
-			size, err := m.QueuedCommitments.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ActiveCommitments != nil {
-		{
-			size, err := m.ActiveCommitments.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryKeyshareEvidenceRequest) Reset()         { *m = QueryKeyshareEvidenceRequest{} }
+func (m *QueryKeyshareEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareEvidenceRequest) ProtoMessage()    {}
+func (*QueryKeyshareEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{38}
+}
+func (m *QueryKeyshareEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyshareEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyshareEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryKeyshareEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareEvidenceRequest.Merge(m, src)
 }
-
-func (m *QueryGetValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyshareEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyshareEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareEvidenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryKeyshareEvidenceRequest proto.InternalMessageInfo
+
+func (m *QueryKeyshareEvidenceRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryKeyshareEvidenceRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKeyshareEvidenceResponse struct {
+	Evidence KeyshareEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence"`
 }
 
-func (m *QueryGetValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryKeyshareEvidenceResponse) Reset()         { *m = QueryKeyshareEvidenceResponse{} }
+func (m *QueryKeyshareEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareEvidenceResponse) ProtoMessage()    {}
+func (*QueryKeyshareEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{39}
+}
+func (m *QueryKeyshareEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyshareEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyshareEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyshareEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareEvidenceResponse.Merge(m, src)
+}
+func (m *QueryKeyshareEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyshareEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareEvidenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyshareEvidenceResponse proto.InternalMessageInfo
+
+func (m *QueryKeyshareEvidenceResponse) GetEvidence() KeyshareEvidence {
+	if m != nil {
+		return m.Evidence
 	}
-	return dAtA[:n], nil
+	return KeyshareEvidence{}
 }
 
-func (m *QueryAllValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllKeyshareEvidenceRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllKeyshareEvidenceRequest) Reset()         { *m = QueryAllKeyshareEvidenceRequest{} }
+func (m *QueryAllKeyshareEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyshareEvidenceRequest) ProtoMessage()    {}
+func (*QueryAllKeyshareEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{40}
+}
+func (m *QueryAllKeyshareEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllKeyshareEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllKeyshareEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllKeyshareEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyshareEvidenceRequest.Merge(m, src)
+}
+func (m *QueryAllKeyshareEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllKeyshareEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyshareEvidenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllKeyshareEvidenceRequest proto.InternalMessageInfo
+
+func (m *QueryAllKeyshareEvidenceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllKeyshareEvidenceResponse struct {
+	Evidence   []KeyshareEvidence  `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorSet) > 0 {
-		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllKeyshareEvidenceResponse) Reset()         { *m = QueryAllKeyshareEvidenceResponse{} }
+func (m *QueryAllKeyshareEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyshareEvidenceResponse) ProtoMessage()    {}
+func (*QueryAllKeyshareEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{41}
+}
+func (m *QueryAllKeyshareEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllKeyshareEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllKeyshareEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllKeyshareEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyshareEvidenceResponse.Merge(m, src)
 }
-
-func (m *QueryGetKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllKeyshareEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllKeyshareEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyshareEvidenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllKeyshareEvidenceResponse proto.InternalMessageInfo
+
+func (m *QueryAllKeyshareEvidenceResponse) GetEvidence() []KeyshareEvidence {
+	if m != nil {
+		return m.Evidence
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllKeyshareEvidenceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKeyshareRewardsRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryGetKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.KeyShare.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryKeyshareRewardsRequest) Reset()         { *m = QueryKeyshareRewardsRequest{} }
+func (m *QueryKeyshareRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareRewardsRequest) ProtoMessage()    {}
+func (*QueryKeyshareRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{42}
+}
+func (m *QueryKeyshareRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyshareRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyshareRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyshareRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareRewardsRequest.Merge(m, src)
+}
+func (m *QueryKeyshareRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyshareRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyshareRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryKeyshareRewardsRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKeyshareRewardsResponse struct {
+	// rewards of the previous epochs that can be claimed
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// number of valid keyshares submitted in the current epoch
+	EpochKeyshares uint64 `protobuf:"varint,2,opt,name=epochKeyshares,proto3" json:"epochKeyshares,omitempty"`
 }
 
-func (m *QueryAllKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryKeyshareRewardsResponse) Reset()         { *m = QueryKeyshareRewardsResponse{} }
+func (m *QueryKeyshareRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareRewardsResponse) ProtoMessage()    {}
+func (*QueryKeyshareRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{43}
+}
+func (m *QueryKeyshareRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyshareRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyshareRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyshareRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareRewardsResponse.Merge(m, src)
+}
+func (m *QueryKeyshareRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyshareRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyshareRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryKeyshareRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyshareRewardsResponse) GetEpochKeyshares() uint64 {
+	if m != nil {
+		return m.EpochKeyshares
+	}
+	return 0
 }
 
-func (m *QueryAllKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryKeyshareRewardPoolRequest struct {
+}
+
+func (m *QueryKeyshareRewardPoolRequest) Reset()         { *m = QueryKeyshareRewardPoolRequest{} }
+func (m *QueryKeyshareRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareRewardPoolRequest) ProtoMessage()    {}
+func (*QueryKeyshareRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{44}
+}
+func (m *QueryKeyshareRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyshareRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyshareRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.KeyShare) > 0 {
-		for iNdEx := len(m.KeyShare) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.KeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryKeyshareRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryKeyshareRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyshareRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareRewardPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKeyshareRewardPoolRequest proto.InternalMessageInfo
+
+type QueryKeyshareRewardPoolResponse struct {
+	// amount paid out at the end of the current epoch
+	Pool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool"`
+	// rewards paid out in previous epochs and not claimed yet
+	Unclaimed       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unclaimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unclaimed"`
+	NextEpochHeight uint64                                   `protobuf:"varint,3,opt,name=nextEpochHeight,proto3" json:"nextEpochHeight,omitempty"`
+}
+
+func (m *QueryKeyshareRewardPoolResponse) Reset()         { *m = QueryKeyshareRewardPoolResponse{} }
+func (m *QueryKeyshareRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyshareRewardPoolResponse) ProtoMessage()    {}
+func (*QueryKeyshareRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{45}
+}
+func (m *QueryKeyshareRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyshareRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyshareRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyshareRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyshareRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryKeyshareRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyshareRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyshareRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyshareRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryKeyshareRewardPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyshareRewardPoolResponse) GetUnclaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unclaimed
+	}
+	return nil
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryKeyshareRewardPoolResponse) GetNextEpochHeight() uint64 {
+	if m != nil {
+		return m.NextEpochHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryVerifiableRandomnessQuery)(nil), "fairyring.keyshare.QueryVerifiableRandomnessQuery")
+	proto.RegisterType((*QueryVerifiableRandomnessResponse)(nil), "fairyring.keyshare.QueryVerifiableRandomnessResponse")
+	proto.RegisterType((*QueryOldestRetainedHeightRequest)(nil), "fairyring.keyshare.QueryOldestRetainedHeightRequest")
+	proto.RegisterType((*QueryOldestRetainedHeightResponse)(nil), "fairyring.keyshare.QueryOldestRetainedHeightResponse")
+	proto.RegisterType((*QueryKeyshareParticipationRequest)(nil), "fairyring.keyshare.QueryKeyshareParticipationRequest")
+	proto.RegisterType((*QueryKeyshareParticipationResponse)(nil), "fairyring.keyshare.QueryKeyshareParticipationResponse")
+	proto.RegisterType((*QueryDkgRoundRequest)(nil), "fairyring.keyshare.QueryDkgRoundRequest")
+	proto.RegisterType((*QueryDkgRoundResponse)(nil), "fairyring.keyshare.QueryDkgRoundResponse")
+	proto.RegisterType((*QueryKeyshareSigningInfoRequest)(nil), "fairyring.keyshare.QueryKeyshareSigningInfoRequest")
+	proto.RegisterType((*QueryKeyshareSigningInfoResponse)(nil), "fairyring.keyshare.QueryKeyshareSigningInfoResponse")
+	proto.RegisterType((*QueryAllKeyshareSigningInfoRequest)(nil), "fairyring.keyshare.QueryAllKeyshareSigningInfoRequest")
+	proto.RegisterType((*QueryAllKeyshareSigningInfoResponse)(nil), "fairyring.keyshare.QueryAllKeyshareSigningInfoResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "fairyring.keyshare.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fairyring.keyshare.QueryParamsResponse")
+	proto.RegisterType((*QueryCommitmentsRequest)(nil), "fairyring.keyshare.QueryCommitmentsRequest")
+	proto.RegisterType((*QueryCommitmentsResponse)(nil), "fairyring.keyshare.QueryCommitmentsResponse")
+	proto.RegisterType((*QueryGetValidatorSetRequest)(nil), "fairyring.keyshare.QueryGetValidatorSetRequest")
+	proto.RegisterType((*QueryGetValidatorSetResponse)(nil), "fairyring.keyshare.QueryGetValidatorSetResponse")
+	proto.RegisterType((*QueryAllValidatorSetRequest)(nil), "fairyring.keyshare.QueryAllValidatorSetRequest")
+	proto.RegisterType((*QueryAllValidatorSetResponse)(nil), "fairyring.keyshare.QueryAllValidatorSetResponse")
+	proto.RegisterType((*QueryGetKeyShareRequest)(nil), "fairyring.keyshare.QueryGetKeyShareRequest")
+	proto.RegisterType((*QueryGetKeyShareResponse)(nil), "fairyring.keyshare.QueryGetKeyShareResponse")
+	proto.RegisterType((*QueryAllKeyShareRequest)(nil), "fairyring.keyshare.QueryAllKeyShareRequest")
+	proto.RegisterType((*QueryAllKeyShareResponse)(nil), "fairyring.keyshare.QueryAllKeyShareResponse")
+	proto.RegisterType((*QueryGetAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareRequest")
+	proto.RegisterType((*QueryGetAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareResponse")
+	proto.RegisterType((*QueryAllAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareRequest")
+	proto.RegisterType((*QueryAllAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareResponse")
+	proto.RegisterType((*QueryPubKeyRequest)(nil), "fairyring.keyshare.QueryPubKeyRequest")
+	proto.RegisterType((*QueryPubKeyResponse)(nil), "fairyring.keyshare.QueryPubKeyResponse")
+	proto.RegisterType((*QueryGetAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressRequest")
+	proto.RegisterType((*QueryGetAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressResponse")
+	proto.RegisterType((*QueryAllAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressRequest")
+	proto.RegisterType((*QueryAllAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressResponse")
+	proto.RegisterType((*QueryGetGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareRequest")
+	proto.RegisterType((*QueryGetGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareResponse")
+	proto.RegisterType((*QueryAllGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareRequest")
+	proto.RegisterType((*QueryAllGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareResponse")
+	proto.RegisterType((*QueryKeyshareEvidenceRequest)(nil), "fairyring.keyshare.QueryKeyshareEvidenceRequest")
+	proto.RegisterType((*QueryKeyshareEvidenceResponse)(nil), "fairyring.keyshare.QueryKeyshareEvidenceResponse")
+	proto.RegisterType((*QueryAllKeyshareEvidenceRequest)(nil), "fairyring.keyshare.QueryAllKeyshareEvidenceRequest")
+	proto.RegisterType((*QueryAllKeyshareEvidenceResponse)(nil), "fairyring.keyshare.QueryAllKeyshareEvidenceResponse")
+	proto.RegisterType((*QueryKeyshareRewardsRequest)(nil), "fairyring.keyshare.QueryKeyshareRewardsRequest")
+	proto.RegisterType((*QueryKeyshareRewardsResponse)(nil), "fairyring.keyshare.QueryKeyshareRewardsResponse")
+	proto.RegisterType((*QueryKeyshareRewardPoolRequest)(nil), "fairyring.keyshare.QueryKeyshareRewardPoolRequest")
+	proto.RegisterType((*QueryKeyshareRewardPoolResponse)(nil), "fairyring.keyshare.QueryKeyshareRewardPoolResponse")
+}
+
+func init() { proto.RegisterFile("fairyring/keyshare/query.proto", fileDescriptor_572603c2d521bf14) }
+
+var fileDescriptor_572603c2d521bf14 = []byte{
+	// 2326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0xf7, 0x68, 0x65, 0x59, 0x7a, 0x72, 0xe3, 0x7a, 0x2c, 0x3b, 0x1b, 0x5a, 0xde, 0x5d, 0xd1,
+	0xb6, 0xbc, 0xfe, 0x5a, 0xea, 0xc3, 0xb2, 0x93, 0xb4, 0x49, 0x20, 0xdb, 0xb1, 0x9a, 0x3a, 0x45,
+	0x94, 0x55, 0xe0, 0xd6, 0x41, 0x81, 0x05, 0xb5, 0x1c, 0x73, 0x19, 0x71, 0xc9, 0x35, 0xc9, 0x55,
+	0xbc, 0x15, 0x94, 0x43, 0x2e, 0x3d, 0xf4, 0xd0, 0x16, 0x3d, 0xb4, 0xb7, 0x1e, 0x5a, 0x14, 0x45,
+	0xd1, 0x34, 0x05, 0x52, 0xa0, 0x69, 0xd1, 0x36, 0xc7, 0x1a, 0xe8, 0x25, 0x40, 0x2f, 0x45, 0x81,
+	0xa6, 0x85, 0x5d, 0xa0, 0xfd, 0x33, 0x02, 0x0e, 0x1f, 0x77, 0xb9, 0xdc, 0x21, 0x97, 0x6b, 0x6f,
+	0x2e, 0x12, 0x39, 0xf3, 0x3e, 0x7e, 0xbf, 0x37, 0x8f, 0x33, 0xf3, 0x66, 0x16, 0x0a, 0xf7, 0x54,
+	0xc3, 0xe9, 0x38, 0x86, 0xa5, 0x2b, 0x3b, 0xac, 0xe3, 0x36, 0x54, 0x87, 0x29, 0xf7, 0xdb, 0xcc,
+	0xe9, 0x54, 0x5a, 0x8e, 0xed, 0xd9, 0x94, 0x76, 0xfb, 0x2b, 0x61, 0xbf, 0x74, 0x54, 0x6d, 0x1a,
+	0x96, 0xad, 0xf0, 0xbf, 0x81, 0x98, 0x34, 0xa7, 0xdb, 0xba, 0xcd, 0x1f, 0x15, 0xff, 0x09, 0x5b,
+	0xe7, 0x75, 0xdb, 0xd6, 0x4d, 0xa6, 0xa8, 0x2d, 0x43, 0x51, 0x2d, 0xcb, 0xf6, 0x54, 0xcf, 0xb0,
+	0x2d, 0x17, 0x7b, 0x2f, 0xd4, 0x6d, 0xb7, 0x69, 0xbb, 0xca, 0xb6, 0xea, 0xa2, 0x4f, 0x65, 0x77,
+	0x79, 0x9b, 0x79, 0xea, 0xb2, 0xd2, 0x52, 0x75, 0xc3, 0xe2, 0xc2, 0x28, 0x5b, 0x14, 0xc0, 0x6c,
+	0xa9, 0x8e, 0xda, 0x0c, 0x8d, 0x2d, 0x0a, 0x04, 0x76, 0x55, 0xd3, 0xd0, 0x54, 0xcf, 0x76, 0x6a,
+	0x2e, 0xf3, 0x50, 0x4e, 0x16, 0xc8, 0xed, 0xb0, 0x4e, 0x8d, 0x3f, 0xa1, 0xcc, 0x65, 0x81, 0x8c,
+	0xaa, 0xeb, 0x0e, 0xd3, 0x55, 0x8f, 0x69, 0xb5, 0xb8, 0x78, 0x49, 0x84, 0xad, 0xbd, 0xed, 0xcb,
+	0xa1, 0xc4, 0x45, 0x91, 0xc1, 0xb6, 0xd7, 0xb0, 0x1d, 0xe3, 0x3b, 0x4c, 0xab, 0xa9, 0x9a, 0xe6,
+	0x30, 0xb7, 0x1b, 0x16, 0x81, 0xb0, 0xce, 0x2c, 0xe6, 0xa8, 0xe6, 0x80, 0xeb, 0x33, 0x02, 0xd9,
+	0xba, 0xdd, 0x6c, 0x1a, 0x5e, 0x93, 0x59, 0x5e, 0x68, 0x71, 0x5e, 0x20, 0xa5, 0xed, 0xe8, 0xd8,
+	0x5b, 0x11, 0x47, 0x84, 0x3f, 0xd4, 0x5c, 0x43, 0xb7, 0x0c, 0x4b, 0xaf, 0x19, 0xd6, 0x3d, 0x3b,
+	0x05, 0x5f, 0x57, 0x9e, 0xed, 0x1a, 0x1a, 0xb3, 0xea, 0x21, 0xbe, 0x42, 0x74, 0x88, 0xc3, 0xc1,
+	0xad, 0xdb, 0x06, 0x0e, 0xab, 0x5c, 0x82, 0xc2, 0x9b, 0xfe, 0xc0, 0xdf, 0x61, 0x8e, 0x71, 0xcf,
+	0x50, 0xb7, 0x4d, 0x56, 0x55, 0x2d, 0xcd, 0x6e, 0x5a, 0xcc, 0x75, 0x79, 0x87, 0x7c, 0x17, 0x16,
+	0x12, 0x25, 0xaa, 0xcc, 0x6d, 0xd9, 0x96, 0xcb, 0x68, 0x01, 0xc0, 0xe9, 0xb6, 0xe6, 0x49, 0x89,
+	0x94, 0x67, 0xaa, 0x91, 0x16, 0x3a, 0x07, 0x07, 0x1d, 0xbb, 0x6d, 0x69, 0xf9, 0x89, 0x12, 0x29,
+	0x4f, 0x56, 0x83, 0x17, 0x59, 0x86, 0x12, 0x37, 0xfd, 0x86, 0xa9, 0x31, 0xd7, 0xab, 0x32, 0x4f,
+	0x35, 0x2c, 0xa6, 0x7d, 0x8d, 0x19, 0x7a, 0xc3, 0xab, 0xb2, 0xfb, 0x6d, 0xe6, 0x7a, 0xf2, 0x77,
+	0x09, 0x2c, 0xa4, 0x08, 0xa1, 0xff, 0x45, 0x78, 0x66, 0x87, 0x75, 0xb6, 0xfc, 0x08, 0x04, 0x3d,
+	0x1c, 0xc3, 0x64, 0x35, 0xd6, 0x4a, 0x5f, 0x84, 0x7c, 0x2f, 0x8f, 0x6e, 0xf7, 0x6b, 0x04, 0xd0,
+	0x12, 0xfb, 0xe5, 0x6f, 0x22, 0x90, 0xdb, 0x18, 0xea, 0x4d, 0xd5, 0xf1, 0x8c, 0xba, 0xd1, 0xe2,
+	0x5f, 0x09, 0xc2, 0xa5, 0x27, 0x60, 0xaa, 0x11, 0x05, 0x80, 0x6f, 0x54, 0x82, 0x69, 0x7f, 0x5c,
+	0x3c, 0xc3, 0xeb, 0x70, 0x47, 0x33, 0xd5, 0xee, 0xbb, 0xfc, 0xaf, 0x09, 0x90, 0xd3, 0x2c, 0x23,
+	0xc7, 0x0a, 0x50, 0xb7, 0xbd, 0xdd, 0x34, 0xbc, 0x00, 0x1a, 0x97, 0x74, 0xd1, 0x8d, 0xa0, 0x87,
+	0xce, 0xc3, 0x8c, 0xd7, 0x70, 0x98, 0xdb, 0xb0, 0xcd, 0x30, 0xee, 0xbd, 0x06, 0xdf, 0x5a, 0xab,
+	0xe7, 0xc6, 0xd2, 0x37, 0xed, 0x77, 0x99, 0x93, 0xcf, 0x95, 0x48, 0x39, 0x57, 0x15, 0xf4, 0xf8,
+	0x23, 0xec, 0xd9, 0x9e, 0x6a, 0x06, 0x72, 0x93, 0x5c, 0x2e, 0xd2, 0x42, 0xcf, 0xc0, 0x97, 0x1c,
+	0x76, 0xbf, 0x6d, 0x38, 0x4c, 0x0b, 0x44, 0x0e, 0x72, 0x91, 0xfe, 0x46, 0xba, 0xd5, 0xe7, 0xd5,
+	0xb6, 0xaa, 0xfe, 0xdf, 0xfc, 0x54, 0x89, 0x94, 0x0f, 0x5f, 0x3f, 0xfd, 0xf0, 0xb3, 0xe2, 0x81,
+	0x7f, 0x7e, 0x56, 0x3c, 0x19, 0xa4, 0xac, 0xab, 0xed, 0x54, 0x0c, 0x5b, 0x69, 0xaa, 0x5e, 0xa3,
+	0xf2, 0x3a, 0xd3, 0xd5, 0x7a, 0xe7, 0x26, 0xab, 0x57, 0x05, 0xea, 0x3e, 0xb4, 0xde, 0xa0, 0xe5,
+	0x0f, 0x95, 0x48, 0x79, 0xba, 0x1a, 0x69, 0x91, 0x17, 0x61, 0x8e, 0x87, 0xf7, 0xe6, 0x8e, 0x5e,
+	0xf5, 0xf3, 0x2e, 0x1c, 0xab, 0x67, 0x60, 0xc2, 0xd0, 0x30, 0x80, 0x13, 0x86, 0x26, 0xff, 0x9f,
+	0xc0, 0xf1, 0x98, 0x20, 0x86, 0xfe, 0xf9, 0x30, 0x7d, 0x7d, 0xe1, 0xd9, 0x95, 0xf9, 0xca, 0xe0,
+	0x9c, 0x5c, 0x09, 0x95, 0xae, 0x4f, 0xfa, 0x3c, 0x30, 0xc5, 0xfd, 0xc4, 0x6f, 0x35, 0x54, 0x97,
+	0xe1, 0xa0, 0x07, 0x2f, 0xf4, 0x1a, 0x1c, 0xd4, 0x98, 0x6a, 0xba, 0xf9, 0x5c, 0x29, 0x57, 0x9e,
+	0x5d, 0x39, 0x99, 0x60, 0xef, 0x26, 0x53, 0xcd, 0xd0, 0x1c, 0x97, 0xa7, 0xb7, 0x00, 0xea, 0x76,
+	0xb3, 0x65, 0xaa, 0x86, 0xe5, 0xb9, 0xf9, 0x49, 0xae, 0x5d, 0x4a, 0xd0, 0xbe, 0x11, 0x0a, 0xa2,
+	0x89, 0x88, 0xa6, 0xfc, 0x0a, 0x14, 0xfb, 0x32, 0x6e, 0x2b, 0x98, 0x65, 0x5e, 0xb3, 0xee, 0xd9,
+	0x61, 0x74, 0xe6, 0x61, 0xa6, 0x3b, 0x7d, 0xe3, 0x17, 0xdd, 0x6b, 0x90, 0x5d, 0x28, 0x25, 0x1b,
+	0xc0, 0xa8, 0xbd, 0x01, 0xb3, 0x6e, 0xaf, 0x19, 0x63, 0x77, 0x4e, 0x84, 0x56, 0x60, 0x05, 0x41,
+	0x47, 0x2d, 0xc8, 0x26, 0x7e, 0x27, 0xeb, 0xa6, 0x99, 0x02, 0xfc, 0x16, 0x40, 0x6f, 0xf5, 0x42,
+	0xaf, 0x8b, 0x95, 0x20, 0xa9, 0x2a, 0xfe, 0x3c, 0x58, 0x09, 0x96, 0x57, 0x9c, 0x0d, 0x2b, 0x9b,
+	0xaa, 0xce, 0x50, 0xb7, 0x1a, 0xd1, 0x94, 0x3f, 0x21, 0x70, 0x3a, 0xd5, 0x5d, 0x12, 0xcd, 0xdc,
+	0xd3, 0xd1, 0xa4, 0x1b, 0x7d, 0x04, 0x26, 0x30, 0x6c, 0xc3, 0x08, 0x04, 0x68, 0xfa, 0x18, 0xcc,
+	0x01, 0xe5, 0x04, 0x36, 0xf9, 0x3a, 0x1d, 0xce, 0xa8, 0x6f, 0xc1, 0xb1, 0xbe, 0x56, 0xa4, 0xf1,
+	0x12, 0x4c, 0x05, 0xeb, 0x39, 0x86, 0x4c, 0x12, 0x31, 0x08, 0x74, 0xae, 0xcf, 0xf8, 0xa0, 0x7f,
+	0xf9, 0xbf, 0xdf, 0x5e, 0x20, 0x55, 0x54, 0x92, 0x9f, 0x83, 0x67, 0xb9, 0xd5, 0x1b, 0xbd, 0xc5,
+	0x2f, 0x74, 0xf8, 0x31, 0x81, 0xfc, 0x60, 0x1f, 0xba, 0xfd, 0x06, 0x1c, 0x55, 0xeb, 0x9e, 0xb1,
+	0xcb, 0x22, 0x9d, 0x88, 0xa0, 0x28, 0x42, 0x10, 0xb5, 0x31, 0xa8, 0xe9, 0x9b, 0xbb, 0xdf, 0x66,
+	0x6d, 0xa6, 0x45, 0xcd, 0x4d, 0x64, 0x34, 0x37, 0xa0, 0x29, 0xaf, 0xc2, 0x49, 0x8e, 0x7c, 0x83,
+	0x79, 0x77, 0xc2, 0xdc, 0xdf, 0x62, 0xe1, 0xe2, 0xe4, 0x7f, 0xdd, 0x86, 0xa5, 0xb1, 0x07, 0xf8,
+	0x7d, 0x04, 0x2f, 0xf2, 0x3b, 0x30, 0x2f, 0x56, 0x42, 0xca, 0x5f, 0x87, 0xc3, 0xbb, 0x91, 0x76,
+	0x64, 0x2b, 0xfc, 0x8c, 0xa3, 0xfa, 0x98, 0x2a, 0x7d, 0xba, 0x32, 0x43, 0x80, 0xeb, 0xa6, 0x29,
+	0x02, 0x38, 0xae, 0x6f, 0xe1, 0x23, 0x02, 0xf3, 0x62, 0x3f, 0x89, 0x9c, 0x72, 0x4f, 0xca, 0x69,
+	0x7c, 0xf9, 0x7f, 0x17, 0x73, 0x72, 0x83, 0x79, 0xe1, 0x5a, 0x9e, 0x69, 0x76, 0xa3, 0x25, 0x98,
+	0xdd, 0x36, 0xed, 0xfa, 0x4e, 0xdf, 0xce, 0x20, 0xda, 0x24, 0xbf, 0x0d, 0xf9, 0x41, 0xd3, 0x18,
+	0x8b, 0x97, 0x61, 0x3a, 0xdc, 0x76, 0xa4, 0x2d, 0x18, 0xa1, 0x1e, 0xc6, 0xa0, 0xab, 0x23, 0xab,
+	0xf0, 0x6c, 0x64, 0xde, 0xe9, 0x83, 0x3d, 0xae, 0xf1, 0xfc, 0x59, 0xf8, 0x49, 0xf6, 0xf9, 0x10,
+	0xe2, 0xcf, 0x8d, 0x8a, 0x7f, 0x7c, 0xe3, 0xf7, 0x15, 0xdc, 0x71, 0x6d, 0x30, 0x6f, 0x7d, 0x60,
+	0x57, 0x36, 0x64, 0xc7, 0x25, 0xbf, 0x4f, 0x40, 0x4e, 0xd3, 0x46, 0xb2, 0xdf, 0x06, 0x3a, 0xb8,
+	0xe3, 0xeb, 0x46, 0x56, 0x40, 0x7b, 0xd0, 0x16, 0x06, 0x40, 0x60, 0x47, 0xde, 0x41, 0x06, 0xeb,
+	0xa6, 0x99, 0xcc, 0x60, 0x5c, 0x83, 0xfa, 0x37, 0x02, 0x72, 0x9a, 0xb7, 0x21, 0x8c, 0x73, 0xe3,
+	0x60, 0xfc, 0x05, 0x2c, 0x5e, 0xed, 0xed, 0xdb, 0xac, 0x13, 0xae, 0x25, 0x1f, 0x10, 0x38, 0xd6,
+	0xd7, 0xdc, 0x9b, 0x7f, 0x82, 0xc5, 0x20, 0x68, 0x4f, 0x9b, 0x53, 0xd7, 0x23, 0x72, 0xe1, 0xfc,
+	0x13, 0xd5, 0xf5, 0x6d, 0x05, 0x2b, 0x01, 0xda, 0x9a, 0x48, 0xb6, 0xf5, 0x66, 0x44, 0x2e, 0xb4,
+	0x15, 0xd5, 0x95, 0x5f, 0x84, 0x52, 0x37, 0x09, 0xbb, 0xf5, 0xe6, 0x7a, 0x50, 0x6e, 0x46, 0x32,
+	0xd8, 0x53, 0x1d, 0x1d, 0x57, 0x82, 0x99, 0x2a, 0xbe, 0xc9, 0xef, 0xc1, 0x42, 0x8a, 0x2e, 0x12,
+	0xbf, 0x0b, 0x47, 0xd5, 0x78, 0x27, 0xb2, 0x3f, 0x2b, 0x64, 0x1f, 0x17, 0x46, 0xd8, 0x83, 0x56,
+	0xe4, 0x77, 0xa0, 0xd4, 0x4d, 0xa7, 0x24, 0xec, 0xe3, 0xca, 0xdd, 0xbf, 0x12, 0x58, 0x48, 0x71,
+	0x96, 0x4e, 0x36, 0xf7, 0xf4, 0x64, 0xc7, 0x97, 0xb7, 0x2d, 0xac, 0xa8, 0x37, 0x98, 0xb7, 0x11,
+	0x1c, 0x1a, 0x8c, 0xb6, 0xf6, 0x9c, 0x80, 0x29, 0x43, 0x7b, 0xab, 0xd3, 0x0a, 0x4b, 0x06, 0x7c,
+	0xa3, 0x79, 0x38, 0x64, 0x68, 0x77, 0x54, 0xb3, 0xcd, 0x78, 0x95, 0x36, 0x53, 0x0d, 0x5f, 0xe5,
+	0x5d, 0x28, 0x26, 0x7a, 0xc4, 0xc0, 0x6d, 0xc1, 0x11, 0xbd, 0xbf, 0x0b, 0xc7, 0xea, 0xb4, 0x28,
+	0x6c, 0x31, 0x2b, 0x18, 0xb4, 0xb8, 0x05, 0xb9, 0x81, 0x4c, 0xd7, 0x4d, 0x33, 0x81, 0xe9, 0x18,
+	0xb7, 0xe2, 0xc5, 0x44, 0x57, 0x69, 0x14, 0x73, 0x4f, 0x47, 0x71, 0x7c, 0x59, 0xf1, 0x2d, 0xdc,
+	0x3f, 0x85, 0x25, 0xc0, 0xab, 0x78, 0x4c, 0x93, 0x2d, 0x27, 0xd2, 0x4e, 0x0f, 0x74, 0x38, 0x95,
+	0x60, 0x19, 0x03, 0x73, 0x0b, 0xa6, 0xc3, 0x43, 0x21, 0x1c, 0x82, 0x33, 0x69, 0xc5, 0x49, 0xa8,
+	0x1f, 0x2e, 0xeb, 0xa1, 0xae, 0x6c, 0xf4, 0xc6, 0x20, 0x89, 0xc5, 0x18, 0xb7, 0x9b, 0xa5, 0x64,
+	0x5f, 0x42, 0x5e, 0xb9, 0x27, 0xe5, 0x35, 0xce, 0xed, 0xca, 0xc9, 0xbe, 0x91, 0xa8, 0xb2, 0x77,
+	0x55, 0x47, 0x73, 0xb3, 0x15, 0xd4, 0x1f, 0x10, 0x98, 0x17, 0x6b, 0x23, 0x5d, 0x06, 0x87, 0x9c,
+	0xa0, 0x09, 0xd9, 0x3e, 0xd7, 0x87, 0x31, 0x44, 0x77, 0xc3, 0x36, 0xac, 0xeb, 0x4b, 0x3e, 0xc5,
+	0x5f, 0xfd, 0xbb, 0x58, 0xd6, 0x0d, 0xaf, 0xd1, 0xde, 0xae, 0xd4, 0xed, 0xa6, 0x12, 0x08, 0xe3,
+	0xbf, 0xcb, 0xae, 0xb6, 0xa3, 0x78, 0x9d, 0x16, 0x73, 0xb9, 0x82, 0x5b, 0x0d, 0x6d, 0xfb, 0x27,
+	0x69, 0xac, 0x65, 0xd7, 0x1b, 0xbd, 0x13, 0xa6, 0x60, 0xf7, 0x1b, 0x6b, 0xed, 0x1e, 0x1c, 0xf6,
+	0xc3, 0xdd, 0xb4, 0x6d, 0x33, 0x5c, 0xaa, 0x7f, 0x3c, 0x01, 0xc5, 0x44, 0x11, 0x24, 0x55, 0x83,
+	0xc9, 0x96, 0x6d, 0x9b, 0x5f, 0x04, 0x23, 0x6e, 0x98, 0x1a, 0x30, 0xd3, 0xb6, 0xea, 0xa6, 0x6a,
+	0x34, 0x99, 0x7f, 0x08, 0x36, 0x76, 0x2f, 0x3d, 0xeb, 0xb4, 0x0c, 0x47, 0x2c, 0xf6, 0xc0, 0x7b,
+	0xd5, 0x8f, 0x13, 0x16, 0x0e, 0x39, 0x1e, 0xba, 0x78, 0xf3, 0xca, 0x4f, 0x8a, 0x70, 0x90, 0x47,
+	0x86, 0xee, 0xc3, 0x54, 0x50, 0x52, 0xd3, 0xc5, 0x84, 0xed, 0x45, 0xac, 0x7a, 0x97, 0xce, 0x0d,
+	0x95, 0x0b, 0x42, 0x2b, 0xcb, 0xef, 0xff, 0xfd, 0xbf, 0x3f, 0x9a, 0x98, 0xa7, 0x92, 0x92, 0x78,
+	0x72, 0x4f, 0x7f, 0x48, 0x60, 0x36, 0x5a, 0x3d, 0x5f, 0x4c, 0x34, 0x3e, 0x58, 0xd6, 0x4b, 0x97,
+	0xb2, 0x09, 0x23, 0x9c, 0x73, 0x1c, 0xce, 0x02, 0x2d, 0x2a, 0xe9, 0x27, 0xe6, 0xf4, 0xe7, 0x04,
+	0x0e, 0x47, 0x4b, 0x44, 0xaa, 0x24, 0xfa, 0x11, 0x57, 0xe5, 0xd2, 0x52, 0x76, 0x05, 0x04, 0xb7,
+	0xcc, 0xc1, 0x5d, 0xa4, 0xe7, 0x95, 0x61, 0x97, 0x18, 0xca, 0x1e, 0xaf, 0xf1, 0xf7, 0xe9, 0x4f,
+	0x09, 0x1c, 0x89, 0xda, 0x5a, 0x37, 0xcd, 0x14, 0xa4, 0xe2, 0xf2, 0x5c, 0x5a, 0xca, 0xae, 0x80,
+	0x48, 0xcf, 0x73, 0xa4, 0xa7, 0xe9, 0xc2, 0x50, 0xa4, 0xf4, 0x17, 0x04, 0xa6, 0xbb, 0x0b, 0xd9,
+	0xc5, 0xb4, 0x98, 0xc4, 0x96, 0x6d, 0xe9, 0x52, 0x36, 0x61, 0x84, 0xf4, 0x0a, 0x87, 0xf4, 0x02,
+	0xbd, 0xa6, 0xa4, 0xdd, 0xec, 0x28, 0x7b, 0x5d, 0x74, 0xfb, 0xca, 0x5e, 0xa4, 0x94, 0xde, 0xa7,
+	0xdf, 0x27, 0x30, 0x1b, 0x5a, 0xf5, 0xc3, 0x78, 0x31, 0x2d, 0x2a, 0xd9, 0xb1, 0x0a, 0x4a, 0x5b,
+	0xf9, 0x2c, 0xc7, 0x5a, 0xa4, 0xa7, 0x52, 0xb1, 0xd2, 0xbf, 0x10, 0xa0, 0x83, 0x55, 0x0f, 0x5d,
+	0x4b, 0x8b, 0x4b, 0x62, 0x7d, 0x27, 0x5d, 0x1d, 0x55, 0x0d, 0xc1, 0xbe, 0xc0, 0xc1, 0xae, 0xd2,
+	0x65, 0x25, 0xe3, 0x75, 0x98, 0xb2, 0xd7, 0xc0, 0x90, 0x7e, 0x4c, 0xe0, 0xf8, 0xa0, 0x65, 0x3f,
+	0xb8, 0x6b, 0x69, 0xf1, 0x7a, 0x12, 0x0e, 0xa9, 0xc5, 0xa6, 0xbc, 0xc4, 0x39, 0x5c, 0xa0, 0xe5,
+	0xac, 0x1c, 0xe8, 0x7b, 0x30, 0x85, 0x75, 0x58, 0xca, 0x94, 0x18, 0xad, 0x09, 0xa5, 0x73, 0x43,
+	0xe5, 0x10, 0xcc, 0x69, 0x0e, 0xe6, 0x14, 0x3d, 0xa9, 0x24, 0x5f, 0x18, 0xd2, 0x3f, 0x10, 0x38,
+	0x3a, 0x50, 0x37, 0xd0, 0x2b, 0xa9, 0x63, 0x98, 0x50, 0x1d, 0x49, 0x6b, 0x23, 0x6a, 0x21, 0xce,
+	0x6b, 0x1c, 0xe7, 0x32, 0x55, 0x94, 0x4c, 0xd7, 0x96, 0xca, 0x5e, 0x50, 0x30, 0xee, 0xd3, 0x8f,
+	0x08, 0xcc, 0x0d, 0x98, 0xf5, 0x47, 0xfd, 0x4a, 0xea, 0xf0, 0x8d, 0x0e, 0x3f, 0xad, 0x4a, 0x93,
+	0x2b, 0x1c, 0x7e, 0x99, 0x2e, 0x66, 0x83, 0x4f, 0x1f, 0x12, 0x38, 0x12, 0xdb, 0x8f, 0xd3, 0x95,
+	0xb4, 0xc8, 0x89, 0xab, 0x0d, 0x69, 0x75, 0x24, 0x1d, 0x04, 0xfb, 0x3a, 0x07, 0x7b, 0x8b, 0xde,
+	0x54, 0xb2, 0xdc, 0xfa, 0xf6, 0xcf, 0x62, 0x41, 0x65, 0xc6, 0x1f, 0x78, 0x25, 0xb6, 0x4f, 0x7f,
+	0x4d, 0x80, 0xc6, 0x3c, 0xf9, 0xe1, 0x5f, 0x49, 0x0b, 0xe4, 0xc8, 0x6c, 0x92, 0x8b, 0x20, 0xf9,
+	0x32, 0x67, 0x73, 0x8e, 0x9e, 0xcd, 0xc4, 0x86, 0xfe, 0x8e, 0xc0, 0x9c, 0xe8, 0x5e, 0x37, 0x05,
+	0x70, 0xe2, 0x45, 0xb1, 0xb4, 0x36, 0x92, 0x4e, 0xc6, 0xb5, 0xb7, 0xab, 0x59, 0x8b, 0xdc, 0x26,
+	0xff, 0x9e, 0xc0, 0x9c, 0xe8, 0x3a, 0x38, 0x25, 0xcd, 0x53, 0xae, 0x98, 0xa5, 0xb5, 0x11, 0xb5,
+	0x10, 0xf8, 0x0a, 0x07, 0x7e, 0x89, 0x5e, 0x10, 0x01, 0xb7, 0xb9, 0x66, 0xcd, 0x41, 0xd5, 0x1a,
+	0x5e, 0x03, 0xff, 0x91, 0xc0, 0x71, 0xe1, 0x2d, 0x6f, 0xca, 0xbc, 0x9c, 0x76, 0xdf, 0x2c, 0x5d,
+	0x1d, 0x55, 0x2d, 0x0b, 0xf8, 0xf0, 0xa1, 0xd6, 0x77, 0xd9, 0x4a, 0xbf, 0x47, 0x60, 0x3a, 0xbc,
+	0xe5, 0xa4, 0xe5, 0x44, 0xc7, 0xb1, 0x6b, 0x56, 0xe9, 0x7c, 0x06, 0x49, 0x44, 0x75, 0x81, 0xa3,
+	0x3a, 0x43, 0x65, 0x45, 0xfc, 0x83, 0x89, 0x1a, 0xbf, 0x54, 0xf5, 0xbf, 0xb7, 0x7d, 0xfa, 0x09,
+	0x81, 0x63, 0x82, 0x0b, 0x35, 0xba, 0x3a, 0x34, 0x22, 0x83, 0x77, 0x86, 0xd2, 0x95, 0xd1, 0x94,
+	0x10, 0xee, 0x4b, 0x1c, 0xee, 0x35, 0xba, 0xa6, 0x64, 0xfd, 0x05, 0x47, 0x74, 0xfe, 0xa0, 0x7f,
+	0x22, 0x70, 0x42, 0x60, 0xde, 0x9f, 0x30, 0xae, 0x0e, 0xd9, 0xd5, 0x24, 0xf1, 0xb8, 0x36, 0xb2,
+	0x5e, 0x96, 0xaf, 0x50, 0x48, 0x85, 0xfe, 0x99, 0xc0, 0x97, 0xe3, 0xc5, 0x35, 0x5d, 0x1a, 0x1a,
+	0xc8, 0xd8, 0x99, 0x81, 0xb4, 0x3c, 0x82, 0x06, 0x82, 0xbd, 0xc9, 0xc1, 0xbe, 0x4c, 0xbf, 0xaa,
+	0x64, 0xf9, 0x25, 0x4c, 0x7c, 0xce, 0x0e, 0xce, 0x4d, 0xf6, 0xe9, 0x87, 0x91, 0x04, 0x0a, 0x5d,
+	0xf8, 0xb1, 0x5f, 0xcd, 0x12, 0xc3, 0x38, 0x8b, 0x2b, 0xa3, 0x29, 0x65, 0x99, 0xae, 0x07, 0x88,
+	0xd0, 0xdf, 0x10, 0x38, 0x12, 0x3b, 0x1e, 0x48, 0xa9, 0x39, 0xc4, 0xc7, 0x10, 0xd2, 0x52, 0x76,
+	0x05, 0x44, 0xf9, 0x3c, 0x47, 0xb9, 0x42, 0x97, 0x52, 0x51, 0xe2, 0x01, 0x42, 0x5f, 0x86, 0x7f,
+	0x48, 0x80, 0x0e, 0x56, 0xff, 0x29, 0xab, 0x4b, 0xe2, 0x69, 0x82, 0xb4, 0x3a, 0x92, 0x4e, 0x96,
+	0xdd, 0x67, 0x0c, 0x79, 0xcd, 0x3f, 0x2f, 0xb8, 0xfe, 0xda, 0xc3, 0x47, 0x05, 0xf2, 0xe9, 0xa3,
+	0x02, 0xf9, 0xcf, 0xa3, 0x02, 0xf9, 0xc1, 0xe3, 0xc2, 0x81, 0x4f, 0x1f, 0x17, 0x0e, 0xfc, 0xe3,
+	0x71, 0xe1, 0xc0, 0xdb, 0x4a, 0xe4, 0x4c, 0xe0, 0x96, 0x6a, 0x38, 0xbc, 0x84, 0x89, 0xd8, 0x7d,
+	0xd0, 0xb3, 0xcc, 0x0f, 0x08, 0xb6, 0xa7, 0xf8, 0x2f, 0xac, 0x56, 0x3f, 0x1f, 0x00, 0x39, 0x7f,
+	0x74, 0x24, 0xe1, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error)
+	// Queries a ValidatorSet by index.
+	ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error)
+	// Queries a list of ValidatorSet items.
+	ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error)
+	// Queries a KeyShare by index.
+	KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error)
+	// Queries a list of KeyShare items.
+	KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error)
+	// Queries a list of AggregatedKeyShare items.
+	AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error)
+	AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error)
+	// Queries the public keys
+	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
+	// Queries a list of AuthorizedAddress items.
+	AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error)
+	AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error)
+	// Queries a list of GeneralKeyShare items.
+	GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error)
+	GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error)
+	VerifiableRandomness(ctx context.Context, in *QueryVerifiableRandomnessQuery, opts ...grpc.CallOption) (*QueryVerifiableRandomnessResponse, error)
+	// Queries the oldest height still retained for keyshares and aggregated keyshares
+	OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the stake weighted keyshare participation for a block height or a general identity
+	KeyshareParticipation(ctx context.Context, in *QueryKeyshareParticipationRequest, opts ...grpc.CallOption) (*QueryKeyshareParticipationResponse, error)
+	// Queries a dkg round with its deals and complaints, the latest round is returned when the id is 0
+	DkgRound(ctx context.Context, in *QueryDkgRoundRequest, opts ...grpc.CallOption) (*QueryDkgRoundResponse, error)
+	// Queries the keyshare liveness of a validator
+	KeyshareSigningInfo(ctx context.Context, in *QueryKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryKeyshareSigningInfoResponse, error)
+	// Queries the keyshare liveness of all validators
+	KeyshareSigningInfoAll(ctx context.Context, in *QueryAllKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryAllKeyshareSigningInfoResponse, error)
+	// Queries the keyshare equivocation evidence of a validator for an identity
+	KeyshareEvidence(ctx context.Context, in *QueryKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryKeyshareEvidenceResponse, error)
+	// Queries a list of keyshare equivocation evidences
+	KeyshareEvidenceAll(ctx context.Context, in *QueryAllKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryAllKeyshareEvidenceResponse, error)
+	// Queries the unclaimed keyshare rewards of a validator
+	KeyshareRewards(ctx context.Context, in *QueryKeyshareRewardsRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardsResponse, error)
+	// Queries the keyshare reward pool to be paid out at the end of the epoch
+	KeyshareRewardPool(ctx context.Context, in *QueryKeyshareRewardPoolRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardPoolResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error) {
+	out := new(QueryCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Commitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatedKeyShare.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error) {
+	out := new(QueryGetValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error) {
+	out := new(QueryAllValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSetAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error) {
+	out := new(QueryGetKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error) {
+	out := new(QueryAllKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error) {
+	out := new(QueryGetAggregatedKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShare", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error) {
+	out := new(QueryAllAggregatedKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShareAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AggregatedKeyShare) > 0 {
-		for iNdEx := len(m.AggregatedKeyShare) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatedKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error) {
+	out := new(QueryPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/PubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPubKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error) {
+	out := new(QueryGetAuthorizedAddressResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error) {
+	out := new(QueryAllAuthorizedAddressResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddressAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error) {
+	out := new(QueryGetGeneralKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPubKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error) {
+	out := new(QueryAllGeneralKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShareAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ActivePubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) VerifiableRandomness(ctx context.Context, in *QueryVerifiableRandomnessQuery, opts ...grpc.CallOption) (*QueryVerifiableRandomnessResponse, error) {
+	out := new(QueryVerifiableRandomnessResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/VerifiableRandomness", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error) {
+	out := new(QueryOldestRetainedHeightResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/OldestRetainedHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) KeyshareParticipation(ctx context.Context, in *QueryKeyshareParticipationRequest, opts ...grpc.CallOption) (*QueryKeyshareParticipationResponse, error) {
+	out := new(QueryKeyshareParticipationResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DkgRound(ctx context.Context, in *QueryDkgRoundRequest, opts ...grpc.CallOption) (*QueryDkgRoundResponse, error) {
+	out := new(QueryDkgRoundResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/DkgRound", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AuthorizedAddress.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) KeyshareSigningInfo(ctx context.Context, in *QueryKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryKeyshareSigningInfoResponse, error) {
+	out := new(QueryKeyshareSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareSigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyshareSigningInfoAll(ctx context.Context, in *QueryAllKeyshareSigningInfoRequest, opts ...grpc.CallOption) (*QueryAllKeyshareSigningInfoResponse, error) {
+	out := new(QueryAllKeyshareSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareSigningInfoAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) KeyshareEvidence(ctx context.Context, in *QueryKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryKeyshareEvidenceResponse, error) {
+	out := new(QueryKeyshareEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyshareEvidenceAll(ctx context.Context, in *QueryAllKeyshareEvidenceRequest, opts ...grpc.CallOption) (*QueryAllKeyshareEvidenceResponse, error) {
+	out := new(QueryAllKeyshareEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareEvidenceAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AuthorizedAddress) > 0 {
-		for iNdEx := len(m.AuthorizedAddress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AuthorizedAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) KeyshareRewards(ctx context.Context, in *QueryKeyshareRewardsRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardsResponse, error) {
+	out := new(QueryKeyshareRewardsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyshareRewardPool(ctx context.Context, in *QueryKeyshareRewardPoolRequest, opts ...grpc.CallOption) (*QueryKeyshareRewardPoolResponse, error) {
+	out := new(QueryKeyshareRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareRewardPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Commitments(context.Context, *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error)
+	// Queries a ValidatorSet by index.
+	ValidatorSet(context.Context, *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error)
+	// Queries a list of ValidatorSet items.
+	ValidatorSetAll(context.Context, *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error)
+	// Queries a KeyShare by index.
+	KeyShare(context.Context, *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error)
+	// Queries a list of KeyShare items.
+	KeyShareAll(context.Context, *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error)
+	// Queries a list of AggregatedKeyShare items.
+	AggregatedKeyShare(context.Context, *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error)
+	AggregatedKeyShareAll(context.Context, *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error)
+	// Queries the public keys
+	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
+	// Queries a list of AuthorizedAddress items.
+	AuthorizedAddress(context.Context, *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error)
+	AuthorizedAddressAll(context.Context, *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error)
+	// Queries a list of GeneralKeyShare items.
+	GeneralKeyShare(context.Context, *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error)
+	GeneralKeyShareAll(context.Context, *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error)
+	VerifiableRandomness(context.Context, *QueryVerifiableRandomnessQuery) (*QueryVerifiableRandomnessResponse, error)
+	// Queries the oldest height still retained for keyshares and aggregated keyshares
+	OldestRetainedHeight(context.Context, *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the stake weighted keyshare participation for a block height or a general identity
+	KeyshareParticipation(context.Context, *QueryKeyshareParticipationRequest) (*QueryKeyshareParticipationResponse, error)
+	// Queries a dkg round with its deals and complaints, the latest round is returned when the id is 0
+	DkgRound(context.Context, *QueryDkgRoundRequest) (*QueryDkgRoundResponse, error)
+	// Queries the keyshare liveness of a validator
+	KeyshareSigningInfo(context.Context, *QueryKeyshareSigningInfoRequest) (*QueryKeyshareSigningInfoResponse, error)
+	// Queries the keyshare liveness of all validators
+	KeyshareSigningInfoAll(context.Context, *QueryAllKeyshareSigningInfoRequest) (*QueryAllKeyshareSigningInfoResponse, error)
+	// Queries the keyshare equivocation evidence of a validator for an identity
+	KeyshareEvidence(context.Context, *QueryKeyshareEvidenceRequest) (*QueryKeyshareEvidenceResponse, error)
+	// Queries a list of keyshare equivocation evidences
+	KeyshareEvidenceAll(context.Context, *QueryAllKeyshareEvidenceRequest) (*QueryAllKeyshareEvidenceResponse, error)
+	// Queries the unclaimed keyshare rewards of a validator
+	KeyshareRewards(context.Context, *QueryKeyshareRewardsRequest) (*QueryKeyshareRewardsResponse, error)
+	// Queries the keyshare reward pool to be paid out at the end of the epoch
+	KeyshareRewardPool(context.Context, *QueryKeyshareRewardPoolRequest) (*QueryKeyshareRewardPoolResponse, error)
 }
 
-func (m *QueryGetGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IdValue) > 0 {
-		i -= len(m.IdValue)
-		copy(dAtA[i:], m.IdValue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdValue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.IdType) > 0 {
-		i -= len(m.IdType)
-		copy(dAtA[i:], m.IdType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Commitments(ctx context.Context, req *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commitments not implemented")
 }
-
-func (m *QueryGetGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GeneralKeyShare.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
 }
-
-func (m *QueryAllGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ValidatorSetAll(ctx context.Context, req *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetAll not implemented")
 }
-
-func (m *QueryAllGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) KeyShare(ctx context.Context, req *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShare not implemented")
 }
-
-func (m *QueryAllGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) KeyShareAll(ctx context.Context, req *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShareAll not implemented")
 }
-
-func (m *QueryAllGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) AggregatedKeyShare(ctx context.Context, req *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShare not implemented")
+}
+func (*UnimplementedQueryServer) AggregatedKeyShareAll(ctx context.Context, req *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShareAll not implemented")
+}
+func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedAddress(ctx context.Context, req *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddress not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedAddressAll(ctx context.Context, req *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddressAll not implemented")
+}
+func (*UnimplementedQueryServer) GeneralKeyShare(ctx context.Context, req *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShare not implemented")
+}
+func (*UnimplementedQueryServer) GeneralKeyShareAll(ctx context.Context, req *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShareAll not implemented")
+}
+func (*UnimplementedQueryServer) VerifiableRandomness(ctx context.Context, req *QueryVerifiableRandomnessQuery) (*QueryVerifiableRandomnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableRandomness not implemented")
+}
+func (*UnimplementedQueryServer) OldestRetainedHeight(ctx context.Context, req *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OldestRetainedHeight not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareParticipation(ctx context.Context, req *QueryKeyshareParticipationRequest) (*QueryKeyshareParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareParticipation not implemented")
+}
+func (*UnimplementedQueryServer) DkgRound(ctx context.Context, req *QueryDkgRoundRequest) (*QueryDkgRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DkgRound not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareSigningInfo(ctx context.Context, req *QueryKeyshareSigningInfoRequest) (*QueryKeyshareSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareSigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareSigningInfoAll(ctx context.Context, req *QueryAllKeyshareSigningInfoRequest) (*QueryAllKeyshareSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareSigningInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareEvidence(ctx context.Context, req *QueryKeyshareEvidenceRequest) (*QueryKeyshareEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareEvidence not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareEvidenceAll(ctx context.Context, req *QueryAllKeyshareEvidenceRequest) (*QueryAllKeyshareEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareEvidenceAll not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareRewards(ctx context.Context, req *QueryKeyshareRewardsRequest) (*QueryKeyshareRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareRewards not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareRewardPool(ctx context.Context, req *QueryKeyshareRewardPoolRequest) (*QueryKeyshareRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareRewardPool not implemented")
 }
 
-func (m *QueryAllGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GeneralKeyShare) > 0 {
-		for iNdEx := len(m.GeneralKeyShare) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GeneralKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/Params",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryVerifiableRandomnessQuery) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifiableRandomnessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Commitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Randomness)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Commitments(ctx, in)
 	}
-	if m.Round != 0 {
-		n += 1 + sovQuery(uint64(m.Round))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/Commitments",
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Commitments(ctx, req.(*QueryCommitmentsRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSet(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/ValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryGetValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorSetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ActiveCommitments != nil {
-		l = m.ActiveCommitments.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetAll(ctx, in)
 	}
-	if m.QueuedCommitments != nil {
-		l = m.QueuedCommitments.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/ValidatorSetAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetAll(ctx, req.(*QueryAllValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShare(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShare",
 	}
-	var l int
-	_ = l
-	l = m.ValidatorSet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShare(ctx, req.(*QueryGetKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShareAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShareAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShareAll(ctx, req.(*QueryAllKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AggregatedKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAggregatedKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorSet) > 0 {
-		for _, e := range m.ValidatorSet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedKeyShare(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShare",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedKeyShare(ctx, req.(*QueryGetAggregatedKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AggregatedKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAggregatedKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedKeyShareAll(ctx, in)
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShareAll",
 	}
-	return n
-}
-
-func (m *QueryGetKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedKeyShareAll(ctx, req.(*QueryAllAggregatedKeyShareRequest))
 	}
-	var l int
-	_ = l
-	l = m.KeyShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PubKey(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/PubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKey(ctx, req.(*QueryPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AuthorizedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuthorizedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.KeyShare) > 0 {
-		for _, e := range m.KeyShare {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedAddress(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedAddress(ctx, req.(*QueryGetAuthorizedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AuthorizedAddressAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAuthorizedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedAddressAll(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetAggregatedKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddressAll",
 	}
-	var l int
-	_ = l
-	l = m.AggregatedKeyShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedAddressAll(ctx, req.(*QueryAllAuthorizedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAggregatedKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GeneralKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGeneralKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralKeyShare(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralKeyShare(ctx, req.(*QueryGetGeneralKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GeneralKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGeneralKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AggregatedKeyShare) > 0 {
-		for _, e := range m.AggregatedKeyShare {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralKeyShareAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShareAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralKeyShareAll(ctx, req.(*QueryAllGeneralKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VerifiableRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifiableRandomnessQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).VerifiableRandomness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/VerifiableRandomness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifiableRandomness(ctx, req.(*QueryVerifiableRandomnessQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OldestRetainedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOldestRetainedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ActivePubKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QueuedPubKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/OldestRetainedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, req.(*QueryOldestRetainedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAuthorizedAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareParticipation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareParticipation(ctx, req.(*QueryKeyshareParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAuthorizedAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DkgRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDkgRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorizedAddress.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).DkgRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/DkgRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DkgRound(ctx, req.(*QueryDkgRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAuthorizedAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareSigningInfo(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareSigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareSigningInfo(ctx, req.(*QueryKeyshareSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAuthorizedAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareSigningInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyshareSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AuthorizedAddress) > 0 {
-		for _, e := range m.AuthorizedAddress {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareSigningInfoAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareSigningInfoAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareSigningInfoAll(ctx, req.(*QueryAllKeyshareSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGeneralKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareEvidence(ctx, in)
 	}
-	l = len(m.IdType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareEvidence",
 	}
-	l = len(m.IdValue)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareEvidence(ctx, req.(*QueryKeyshareEvidenceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGeneralKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareEvidenceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyshareEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GeneralKeyShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareEvidenceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareEvidenceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareEvidenceAll(ctx, req.(*QueryAllKeyshareEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGeneralKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareRewards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareRewards(ctx, req.(*QueryKeyshareRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGeneralKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.GeneralKeyShare) > 0 {
-		for _, e := range m.GeneralKeyShare {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareRewardPool(ctx, req.(*QueryKeyshareRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fairyring.keyshare.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Commitments",
+			Handler:    _Query_Commitments_Handler,
+		},
+		{
+			MethodName: "ValidatorSet",
+			Handler:    _Query_ValidatorSet_Handler,
+		},
+		{
+			MethodName: "ValidatorSetAll",
+			Handler:    _Query_ValidatorSetAll_Handler,
+		},
+		{
+			MethodName: "KeyShare",
+			Handler:    _Query_KeyShare_Handler,
+		},
+		{
+			MethodName: "KeyShareAll",
+			Handler:    _Query_KeyShareAll_Handler,
+		},
+		{
+			MethodName: "AggregatedKeyShare",
+			Handler:    _Query_AggregatedKeyShare_Handler,
+		},
+		{
+			MethodName: "AggregatedKeyShareAll",
+			Handler:    _Query_AggregatedKeyShareAll_Handler,
+		},
+		{
+			MethodName: "PubKey",
+			Handler:    _Query_PubKey_Handler,
+		},
+		{
+			MethodName: "AuthorizedAddress",
+			Handler:    _Query_AuthorizedAddress_Handler,
+		},
+		{
+			MethodName: "AuthorizedAddressAll",
+			Handler:    _Query_AuthorizedAddressAll_Handler,
+		},
+		{
+			MethodName: "GeneralKeyShare",
+			Handler:    _Query_GeneralKeyShare_Handler,
+		},
+		{
+			MethodName: "GeneralKeyShareAll",
+			Handler:    _Query_GeneralKeyShareAll_Handler,
+		},
+		{
+			MethodName: "VerifiableRandomness",
+			Handler:    _Query_VerifiableRandomness_Handler,
+		},
+		{
+			MethodName: "OldestRetainedHeight",
+			Handler:    _Query_OldestRetainedHeight_Handler,
+		},
+		{
+			MethodName: "KeyshareParticipation",
+			Handler:    _Query_KeyshareParticipation_Handler,
+		},
+		{
+			MethodName: "DkgRound",
+			Handler:    _Query_DkgRound_Handler,
+		},
+		{
+			MethodName: "KeyshareSigningInfo",
+			Handler:    _Query_KeyshareSigningInfo_Handler,
+		},
+		{
+			MethodName: "KeyshareSigningInfoAll",
+			Handler:    _Query_KeyshareSigningInfoAll_Handler,
+		},
+		{
+			MethodName: "KeyshareEvidence",
+			Handler:    _Query_KeyshareEvidence_Handler,
+		},
+		{
+			MethodName: "KeyshareEvidenceAll",
+			Handler:    _Query_KeyshareEvidenceAll_Handler,
+		},
+		{
+			MethodName: "KeyshareRewards",
+			Handler:    _Query_KeyshareRewards_Handler,
+		},
+		{
+			MethodName: "KeyshareRewardPool",
+			Handler:    _Query_KeyshareRewardPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fairyring/keyshare/query.proto",
+}
+
+func (m *QueryVerifiableRandomnessQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiableRandomnessQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiableRandomnessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifiableRandomnessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiableRandomnessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiableRandomnessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Randomness) > 0 {
+		i -= len(m.Randomness)
+		copy(dAtA[i:], m.Randomness)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Randomness)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestRetainedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestRetainedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestRetainedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestRetainedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestRetainedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestRetainedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AggregatedKeyShareHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AggregatedKeyShareHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyShareHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyShareHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Aggregated {
+		i--
+		if m.Aggregated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.ParticipationRatio.Size()
+		i -= size
+		if _, err := m.ParticipationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RequiredPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequiredPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParticipatingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParticipatingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmittedKeyshares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmittedKeyshares))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDkgRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDkgRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDkgRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDkgRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDkgRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDkgRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Complaints) > 0 {
+		for iNdEx := len(m.Complaints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Complaints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Deals) > 0 {
+		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Round.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareSigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareSigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareSigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareSigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareSigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareSigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareSigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareSigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareSigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareSigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareSigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareSigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigningInfo) > 0 {
+		for iNdEx := len(m.SigningInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedCommitments != nil {
+		{
+			size, err := m.QueuedCommitments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ActiveCommitments != nil {
+		{
+			size, err := m.ActiveCommitments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeyShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyShare) > 0 {
+		for iNdEx := len(m.KeyShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatedKeyShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AggregatedKeyShare) > 0 {
+		for iNdEx := len(m.AggregatedKeyShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatedKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ActivePubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorizedAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthorizedAddress) > 0 {
+		for iNdEx := len(m.AuthorizedAddress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizedAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IdValue) > 0 {
+		i -= len(m.IdValue)
+		copy(dAtA[i:], m.IdValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IdType) > 0 {
+		i -= len(m.IdType)
+		copy(dAtA[i:], m.IdType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GeneralKeyShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GeneralKeyShare) > 0 {
+		for iNdEx := len(m.GeneralKeyShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GeneralKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochKeyshares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochKeyshares))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextEpochHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Unclaimed) > 0 {
+		for iNdEx := len(m.Unclaimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unclaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVerifiableRandomnessQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVerifiableRandomnessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Randomness)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovQuery(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *QueryOldestRetainedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOldestRetainedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyShareHeight != 0 {
+		n += 1 + sovQuery(uint64(m.KeyShareHeight))
+	}
+	if m.AggregatedKeyShareHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AggregatedKeyShareHeight))
+	}
+	return n
+}
+
+func (m *QueryKeyshareParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmittedKeyshares != 0 {
+		n += 1 + sovQuery(uint64(m.SubmittedKeyshares))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.ParticipatingPower != 0 {
+		n += 1 + sovQuery(uint64(m.ParticipatingPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.RequiredPower != 0 {
+		n += 1 + sovQuery(uint64(m.RequiredPower))
+	}
+	l = m.ParticipationRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Aggregated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDkgRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDkgRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Round.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Deals) > 0 {
+		for _, e := range m.Deals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Complaints) > 0 {
+		for _, e := range m.Complaints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryKeyshareSigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareSigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeyshareSigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyshareSigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfo) > 0 {
+		for _, e := range m.SigningInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveCommitments != nil {
+		l = m.ActiveCommitments.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueuedCommitments != nil {
+		l = m.QueuedCommitments.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSet) > 0 {
+		for _, e := range m.ValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryGetKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyShare) > 0 {
+		for _, e := range m.KeyShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatedKeyShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatedKeyShare) > 0 {
+		for _, e := range m.AggregatedKeyShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActivePubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QueuedPubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetAuthorizedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuthorizedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorizedAddress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAuthorizedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAuthorizedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizedAddress) > 0 {
+		for _, e := range m.AuthorizedAddress {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGeneralKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IdType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IdValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGeneralKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeneralKeyShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGeneralKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGeneralKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GeneralKeyShare) > 0 {
+		for _, e := range m.GeneralKeyShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Evidence.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeyshareEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyshareEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EpochKeyshares != 0 {
+		n += 1 + sovQuery(uint64(m.EpochKeyshares))
+	}
+	return n
+}
+
+func (m *QueryKeyshareRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryKeyshareRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unclaimed) > 0 {
+		for _, e := range m.Unclaimed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextEpochHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVerifiableRandomnessQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiableRandomnessQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiableRandomnessQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifiableRandomnessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiableRandomnessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiableRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Randomness = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOldestRetainedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOldestRetainedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOldestRetainedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOldestRetainedHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOldestRetainedHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOldestRetainedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareHeight", wireType)
+			}
+			m.KeyShareHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyShareHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareHeight", wireType)
+			}
+			m.AggregatedKeyShareHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregatedKeyShareHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyshareParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyshareParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyshareParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyshareParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyshareParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyshareParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedKeyshares", wireType)
+			}
+			m.SubmittedKeyshares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedKeyshares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatingPower", wireType)
+			}
+			m.ParticipatingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipatingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPower", wireType)
+			}
+			m.RequiredPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aggregated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDkgRoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDkgRoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDkgRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDkgRoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDkgRoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDkgRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Round.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deals = append(m.Deals, DkgDeal{})
+			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complaints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Complaints = append(m.Complaints, DkgComplaint{})
+			if err := m.Complaints[len(m.Complaints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyshareSigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyshareSigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyshareSigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyshareSigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyshareSigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyshareSigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeyshareSigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyshareSigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyshareSigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeyshareSigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyshareSigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyshareSigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfo = append(m.SigningInfo, KeyshareSigningInfo{})
+			if err := m.SigningInfo[len(m.SigningInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveCommitments == nil {
+				m.ActiveCommitments = &Commitments{}
+			}
+			if err := m.ActiveCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedCommitments == nil {
+				m.QueuedCommitments = &Commitments{}
+			}
+			if err := m.QueuedCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryVerifiableRandomnessQuery) Unmarshal(dAtA []byte) error {
+func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3440,12 +7446,48 @@ func (m *QueryVerifiableRandomnessQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifiableRandomnessQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllValidatorSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifiableRandomnessQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3467,7 +7509,7 @@ func (m *QueryVerifiableRandomnessQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifiableRandomnessResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,17 +7532,17 @@ func (m *QueryVerifiableRandomnessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifiableRandomnessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifiableRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Randomness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3510,29 +7552,31 @@ func (m *QueryVerifiableRandomnessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Randomness = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSet = append(m.ValidatorSet, ValidatorSet{})
+			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Round = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3542,11 +7586,28 @@ func (m *QueryVerifiableRandomnessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3568,7 +7629,7 @@ func (m *QueryVerifiableRandomnessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3591,12 +7652,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3618,7 +7730,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3641,15 +7753,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3676,7 +7788,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3701,7 +7813,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3714,22 +7826,58 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3751,7 +7899,7 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3774,15 +7922,15 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCommitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3809,16 +7957,14 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActiveCommitments == nil {
-				m.ActiveCommitments = &Commitments{}
-			}
-			if err := m.ActiveCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyShare = append(m.KeyShare, KeyShare{})
+			if err := m.KeyShare[len(m.KeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCommitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3845,10 +7991,10 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedCommitments == nil {
-				m.QueuedCommitments = &Commitments{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.QueuedCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3873,7 +8019,7 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3896,17 +8042,17 @@ func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3916,24 +8062,11 @@ func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3955,7 +8088,7 @@ func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3978,15 +8111,15 @@ func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4013,7 +8146,7 @@ func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AggregatedKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4038,7 +8171,7 @@ func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4061,10 +8194,10 @@ func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4124,7 +8257,7 @@ func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4147,15 +8280,15 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4182,8 +8315,8 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSet = append(m.ValidatorSet, ValidatorSet{})
-			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggregatedKeyShare = append(m.AggregatedKeyShare, AggregatedKeyShare{})
+			if err := m.AggregatedKeyShare[len(m.AggregatedKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4244,7 +8377,7 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4267,17 +8400,67 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4287,29 +8470,30 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if err := m.ActivePubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4319,11 +8503,107 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4345,7 +8625,7 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4368,15 +8648,15 @@ func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4403,7 +8683,7 @@ func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthorizedAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4428,7 +8708,7 @@ func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4451,10 +8731,10 @@ func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4514,7 +8794,7 @@ func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4537,15 +8817,15 @@ func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4572,8 +8852,8 @@ func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyShare = append(m.KeyShare, KeyShare{})
-			if err := m.KeyShare[len(m.KeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AuthorizedAddress = append(m.AuthorizedAddress, AuthorizedAddress{})
+			if err := m.AuthorizedAddress[len(m.AuthorizedAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4634,7 +8914,7 @@ func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4657,17 +8937,17 @@ func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4677,66 +8957,61 @@ func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdType", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4746,24 +9021,23 @@ func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatedKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IdValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4786,7 +9060,7 @@ func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4809,15 +9083,15 @@ func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4844,10 +9118,7 @@ func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeneralKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4872,7 +9143,7 @@ func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,47 +9166,13 @@ func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AggregatedKeyShare = append(m.AggregatedKeyShare, AggregatedKeyShare{})
-			if err := m.AggregatedKeyShare[len(m.AggregatedKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4950,77 +9187,27 @@ func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 				b := dAtA[iNdEx]
 				iNdEx++
 				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5042,7 +9229,7 @@ func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5065,15 +9252,15 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5100,13 +9287,14 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActivePubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GeneralKeyShare = append(m.GeneralKeyShare, GeneralKeyShare{})
+			if err := m.GeneralKeyShare[len(m.GeneralKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5133,7 +9321,10 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QueuedPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5158,7 +9349,7 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyshareEvidenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5181,15 +9372,15 @@ func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyshareEvidenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyshareEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5217,7 +9408,39 @@ func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5240,7 +9463,7 @@ func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyshareEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5263,15 +9486,15 @@ func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyshareEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyshareEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5298,7 +9521,7 @@ func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorizedAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5323,7 +9546,7 @@ func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyshareEvidenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5346,10 +9569,10 @@ func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyshareEvidenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyshareEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5409,7 +9632,7 @@ func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyshareEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5432,15 +9655,15 @@ func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyshareEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyshareEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5467,8 +9690,8 @@ func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizedAddress = append(m.AuthorizedAddress, AuthorizedAddress{})
-			if err := m.AuthorizedAddress[len(m.AuthorizedAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Evidence = append(m.Evidence, KeyshareEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5529,7 +9752,7 @@ func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyshareRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5552,10 +9775,10 @@ func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyshareRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyshareRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5590,70 +9813,6 @@ func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5675,7 +9834,7 @@ func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyshareRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,15 +9857,15 @@ func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyshareRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyshareRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5733,10 +9892,30 @@ func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeneralKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochKeyshares", wireType)
+			}
+			m.EpochKeyshares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochKeyshares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5758,7 +9937,7 @@ func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyshareRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5781,48 +9960,12 @@ func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyshareRewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyshareRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5844,7 +9987,7 @@ func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyshareRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5867,15 +10010,15 @@ func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyshareRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyshareRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5902,14 +10045,14 @@ func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GeneralKeyShare = append(m.GeneralKeyShare, GeneralKeyShare{})
-			if err := m.GeneralKeyShare[len(m.GeneralKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, types.Coin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unclaimed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5936,13 +10079,30 @@ func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unclaimed = append(m.Unclaimed, types.Coin{})
+			if err := m.Unclaimed[len(m.Unclaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochHeight", wireType)
+			}
+			m.NextEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,29 @@
+package types
+
+import "bytes"
+
+// AggregatedKeyshareInjectionPrefix marks the injected tx carrying the aggregated key,
+// it can not be decoded as a regular tx
+var AggregatedKeyshareInjectionPrefix = []byte("fairyring/keyshare/injection/")
+
+// EncodeAggregatedKeyshareInjection encodes the injection into the bytes of the injected tx
+func EncodeAggregatedKeyshareInjection(injection AggregatedKeyshareInjection) ([]byte, error) {
+	bz, err := injection.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, AggregatedKeyshareInjectionPrefix...), bz...), nil
+}
+
+// DecodeAggregatedKeyshareInjection decodes the injection from the bytes of a tx,
+// it returns false if the tx is not an injected one
+func DecodeAggregatedKeyshareInjection(tx []byte) (AggregatedKeyshareInjection, bool, error) {
+	var injection AggregatedKeyshareInjection
+	if !bytes.HasPrefix(tx, AggregatedKeyshareInjectionPrefix) {
+		return injection, false, nil
+	}
+	if err := injection.Unmarshal(tx[len(AggregatedKeyshareInjectionPrefix):]); err != nil {
+		return injection, true, ErrInvalidKeyshareInjection.Wrap(err.Error())
+	}
+	return injection, true, nil
+}
@@ -34,6 +34,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPrivateKeyshare())
 	cmd.AddCommand(CmdRegisterContract())
 	cmd.AddCommand(CmdUnregisterContract())
+	cmd.AddCommand(CmdCancelEncryptedTx())
+	cmd.AddCommand(CmdCancelGeneralEncryptedTx())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
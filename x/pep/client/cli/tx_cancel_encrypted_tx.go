@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCancelEncryptedTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-encrypted-tx [target-height] [index]",
+		Short: "Cancel a pending encrypted transaction by its target height and index",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTargetHeight, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argIndex, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelEncryptedTx(
+				clientCtx.GetFromAddress().String(),
+				argTargetHeight,
+				"",
+				argIndex,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelGeneralEncryptedTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-general-encrypted-tx [req-id] [index]",
+		Short: "Cancel a pending general encrypted transaction by its req-id and index",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReqId := args[0]
+
+			argIndex, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelEncryptedTx(
+				clientCtx.GetFromAddress().String(),
+				0,
+				argReqId,
+				argIndex,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
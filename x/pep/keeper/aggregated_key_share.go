@@ -86,6 +86,11 @@ func (k Keeper) OnRecvAggrKeyshareDataPacket(ctx context.Context, packet channel
 		return packetAck, errors.New("request not found for this id")
 	}
 
+	// the key is verified for the identity and public key of the request, not the ones in the packet
+	if err := k.VerifyAggregatedKeyShare(entry.Pubkey, entry.Identity, data.AggrKeyshare); err != nil {
+		return packetAck, err
+	}
+
 	entry.AggrKeyshare = data.AggrKeyshare
 
 	k.SetExecutionQueueEntry(ctx, entry)
@@ -3,9 +3,13 @@ package keeper_test
 import (
 	"github.com/Fairblock/fairyring/testutil/random"
 	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/testutil/shares"
 	"strconv"
 	"testing"
 
+	kstypes "github.com/Fairblock/fairyring/x/keyshare/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
 	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
 	"github.com/Fairblock/fairyring/testutil/nullify"
 	"github.com/Fairblock/fairyring/x/pep/keeper"
@@ -66,3 +70,49 @@ func TestAggregatedKeyShareGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllAggregatedKeyShare(ctx)),
 	)
 }
+
+func TestOnRecvAggrKeyshareDataPacketVerification(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	out, err := random.GeneratePubKeyAndShares(1)
+	require.NoError(t, err)
+
+	requestID := random.RandHex(32)
+	identity := random.RandHex(32)
+	keeper.SetEntry(ctx, types.GenEncTxExecutionQueue{
+		Creator:   sample.AccAddress(),
+		RequestId: requestID,
+		Identity:  identity,
+		Pubkey:    out.MasterPublicKey,
+	})
+
+	otherKey, err := shares.DeriveShare(out.GeneratedShare[0].Share, 1, "another identity")
+	require.NoError(t, err)
+	validKey, err := shares.DeriveShare(out.GeneratedShare[0].Share, 1, identity)
+	require.NoError(t, err)
+
+	// the packet identity is ignored, the key is verified for the identity of the request
+	_, err = keeper.OnRecvAggrKeyshareDataPacket(ctx, channeltypes.Packet{}, kstypes.AggrKeyshareDataPacketData{
+		Identity:     "another identity",
+		Pubkey:       out.MasterPublicKey,
+		AggrKeyshare: otherKey,
+		RequestId:    requestID,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAggregatedKey)
+
+	entry, found := keeper.GetEntry(ctx, requestID)
+	require.True(t, found)
+	require.Empty(t, entry.AggrKeyshare)
+
+	_, err = keeper.OnRecvAggrKeyshareDataPacket(ctx, channeltypes.Packet{}, kstypes.AggrKeyshareDataPacketData{
+		Identity:     identity,
+		Pubkey:       out.MasterPublicKey,
+		AggrKeyshare: validKey,
+		RequestId:    requestID,
+	})
+	require.NoError(t, err)
+
+	entry, found = keeper.GetEntry(ctx, requestID)
+	require.True(t, found)
+	require.Equal(t, validKey, entry.AggrKeyshare)
+}
@@ -34,6 +34,8 @@ func (k Keeper) AppendEncryptedTx(
 		encryptedTx.TargetHeight,
 	), parsedEncryptedTxArr)
 
+	k.SetEncryptedTxCreatorIndex(ctx, encryptedTx.Creator, encryptedTx.TargetHeight, "", encryptedTx.Index)
+
 	return encryptedTx.Index
 }
 
@@ -70,6 +72,23 @@ func (k Keeper) SetEncryptedTxProcessedHeight(
 	k.SetEncryptedTx(ctx, height, arr)
 }
 
+// SetEncryptedTxCancelled marks a specific encryptedTx as cancelled by its creator
+func (k Keeper) SetEncryptedTxCancelled(
+	ctx context.Context,
+	height uint64,
+	index uint64,
+) {
+	arr := k.GetEncryptedTxAllFromHeight(ctx, height)
+
+	if index >= uint64(len(arr.EncryptedTx)) {
+		return
+	}
+
+	arr.EncryptedTx[index].Cancelled = true
+
+	k.SetEncryptedTx(ctx, height, arr)
+}
+
 func (k Keeper) SetAllEncryptedTxExpired(
 	ctx context.Context,
 	height uint64,
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetEncryptedTxCreatorIndex indexes an encrypted tx by its creator,
+// reqID is only set for general encrypted txs
+func (k Keeper) SetEncryptedTxCreatorIndex(
+	ctx context.Context,
+	creator string,
+	targetHeight uint64,
+	reqID string,
+	index uint64,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxCreatorKeyPrefix))
+
+	b := k.cdc.MustMarshal(&types.EncryptedTxCreatorIndex{
+		TargetHeight: targetHeight,
+		ReqId:        reqID,
+		Index:        index,
+	})
+	store.Set(types.EncryptedTxCreatorKey(creator, targetHeight, reqID, index), b)
+}
+
+// RemoveEncryptedTxCreatorIndex removes an encrypted tx from the creator index
+func (k Keeper) RemoveEncryptedTxCreatorIndex(
+	ctx context.Context,
+	creator string,
+	targetHeight uint64,
+	reqID string,
+	index uint64,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxCreatorKeyPrefix))
+	store.Delete(types.EncryptedTxCreatorKey(creator, targetHeight, reqID, index))
+}
+
+// GetEncryptedTxWithStatus resolves a creator index entry to the encrypted tx it points to
+// along with its current status
+func (k Keeper) GetEncryptedTxWithStatus(
+	ctx context.Context,
+	entry types.EncryptedTxCreatorIndex,
+) (val types.EncryptedTxWithStatus, found bool) {
+	if len(entry.ReqId) == 0 {
+		encTx, found := k.GetEncryptedTx(ctx, entry.TargetHeight, entry.Index)
+		if !found {
+			return val, false
+		}
+
+		status := types.EncryptedTxStatusPending
+		if result, found := k.GetEncryptedTxResult(ctx, encTx.TargetHeight, encTx.Index); found {
+			status = result.Status
+		} else if encTx.Cancelled {
+			status = types.EncryptedTxStatusCancelled
+		} else if encTx.Expired {
+			status = types.EncryptedTxStatusExpired
+		}
+
+		return types.EncryptedTxWithStatus{
+			TargetHeight: encTx.TargetHeight,
+			Index:        encTx.Index,
+			Data:         encTx.Data,
+			Creator:      encTx.Creator,
+			ChargedGas:   encTx.ChargedGas,
+			Status:       status,
+		}, true
+	}
+
+	genEntry, found := k.GetEntry(ctx, entry.ReqId)
+	if !found || genEntry.TxList == nil || uint64(len(genEntry.TxList.EncryptedTx)) <= entry.Index {
+		return val, false
+	}
+	encTx := genEntry.TxList.EncryptedTx[entry.Index]
+
+	status := types.EncryptedTxStatusPending
+	if result, found := k.GetGeneralEncryptedTxResult(ctx, encTx.Identity, encTx.Index); found {
+		status = result.Status
+	} else if encTx.Cancelled {
+		status = types.EncryptedTxStatusCancelled
+	} else if encTx.Refunded != nil {
+		status = types.EncryptedTxStatusExpired
+	}
+
+	return types.EncryptedTxWithStatus{
+		ReqId:      entry.ReqId,
+		Identity:   encTx.Identity,
+		Index:      encTx.Index,
+		Data:       encTx.Data,
+		Creator:    encTx.Creator,
+		ChargedGas: encTx.ChargedGas,
+		Status:     status,
+	}, true
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetEncryptedTxOrder records the execution order of the encrypted txs of a target height
+func (k Keeper) SetEncryptedTxOrder(ctx context.Context, order types.EncryptedTxOrder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&order)
+	store.Set(types.EncryptedTxAllFromHeightKey(order.TargetHeight), b)
+}
+
+// GetEncryptedTxOrder returns the recorded execution order of the encrypted txs of a target height
+func (k Keeper) GetEncryptedTxOrder(ctx context.Context, targetHeight uint64) (val types.EncryptedTxOrder, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxOrderKeyPrefix))
+
+	b := store.Get(types.EncryptedTxAllFromHeightKey(targetHeight))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// ComputeEncryptedTxOrder permutes the indexes of all the encrypted txs of a target height, cancelled ones included,
+// with a seed derived from the aggregated key of the height
+func (k Keeper) ComputeEncryptedTxOrder(ctx context.Context, targetHeight uint64, aggrKey string) (types.EncryptedTxOrder, error) {
+	seed, err := types.EncryptedTxOrderingSeed(aggrKey, targetHeight)
+	if err != nil {
+		return types.EncryptedTxOrder{}, types.ErrInvalidAggregatedKey.Wrap(err.Error())
+	}
+
+	arr := k.GetEncryptedTxAllFromHeight(ctx, targetHeight)
+	indexes := make([]uint64, len(arr.EncryptedTx))
+	for i, encTx := range arr.EncryptedTx {
+		indexes[i] = encTx.Index
+	}
+
+	return types.EncryptedTxOrder{
+		TargetHeight: targetHeight,
+		Seed:         hex.EncodeToString(seed),
+		Indexes:      types.PermuteEncryptedTxIndexes(seed, indexes),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// GetEncryptedTxQueueSequence returns the sequence the next carry-over queue entry will be stored with
+func (k Keeper) GetEncryptedTxQueueSequence(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.EncryptedTxQueueSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetEncryptedTxQueueSequence sets the sequence the next carry-over queue entry will be stored with
+func (k Keeper) SetEncryptedTxQueueSequence(ctx context.Context, sequence uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	store.Set(types.EncryptedTxQueueSequenceKey, bz)
+}
+
+// setEncryptedTxQueueDepth sets the number of entries in the carry-over queue
+func (k Keeper) setEncryptedTxQueueDepth(ctx context.Context, depth uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, depth)
+	store.Set(types.EncryptedTxQueueDepthKey, bz)
+}
+
+// EnqueueEncryptedTx appends an encrypted tx to the end of the carry-over execution queue
+func (k Keeper) EnqueueEncryptedTx(
+	ctx context.Context,
+	entry types.EncryptedTxQueueEntry,
+) uint64 {
+	sequence := k.GetEncryptedTxQueueSequence(ctx)
+	entry.Sequence = sequence
+
+	k.SetEncryptedTxQueueEntry(ctx, entry)
+	k.SetEncryptedTxQueueSequence(ctx, sequence+1)
+
+	return sequence
+}
+
+// SetEncryptedTxQueueEntry sets a carry-over queue entry by its sequence
+func (k Keeper) SetEncryptedTxQueueEntry(
+	ctx context.Context,
+	entry types.EncryptedTxQueueEntry,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxQueueKeyPrefix))
+
+	key := types.EncryptedTxQueueKey(entry.Sequence)
+	if !store.Has(key) {
+		k.setEncryptedTxQueueDepth(ctx, k.GetEncryptedTxQueueDepth(ctx)+1)
+	}
+
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(key, b)
+}
+
+// RemoveEncryptedTxQueueEntry removes a carry-over queue entry from the store
+func (k Keeper) RemoveEncryptedTxQueueEntry(
+	ctx context.Context,
+	sequence uint64,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxQueueKeyPrefix))
+	key := types.EncryptedTxQueueKey(sequence)
+	if !store.Has(key) {
+		return
+	}
+
+	store.Delete(key)
+	k.setEncryptedTxQueueDepth(ctx, k.GetEncryptedTxQueueDepth(ctx)-1)
+}
+
+// GetFirstEncryptedTxQueueEntry returns the oldest entry of the carry-over queue
+// without reading the rest of the queue
+func (k Keeper) GetFirstEncryptedTxQueueEntry(ctx context.Context) (val types.EncryptedTxQueueEntry, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxQueueKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetAllEncryptedTxQueueEntry returns all carry-over queue entries in the order they were queued
+func (k Keeper) GetAllEncryptedTxQueueEntry(ctx context.Context) (list []types.EncryptedTxQueueEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxQueueKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EncryptedTxQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetEncryptedTxQueueDepth returns the number of entries in the carry-over queue
+func (k Keeper) GetEncryptedTxQueueDepth(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.EncryptedTxQueueDepthKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
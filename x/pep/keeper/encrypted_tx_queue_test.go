@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/nullify"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/pep/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNEncryptedTxQueueEntry(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.EncryptedTxQueueEntry {
+	items := make([]types.EncryptedTxQueueEntry, n)
+	for i := range items {
+		items[i].Identity = strconv.Itoa(i + 1)
+		items[i].TargetHeight = uint64(i + 1)
+		items[i].Creator = sample.AccAddress()
+		items[i].Data = random.RandHex(32)
+		items[i].Sequence = keeper.EnqueueEncryptedTx(ctx, items[i])
+	}
+	return items
+}
+
+func TestEncryptedTxQueueEnqueue(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	items := createNEncryptedTxQueueEntry(&keeper, ctx, 10)
+
+	for i, item := range items {
+		require.Equal(t, uint64(i), item.Sequence)
+	}
+	require.Equal(t, uint64(10), keeper.GetEncryptedTxQueueSequence(ctx))
+	require.Equal(t, uint64(10), keeper.GetEncryptedTxQueueDepth(ctx))
+}
+
+func TestEncryptedTxQueueGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	items := createNEncryptedTxQueueEntry(&keeper, ctx, 10)
+
+	// entries are returned in the order they were queued
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllEncryptedTxQueueEntry(ctx)),
+	)
+}
+
+func TestEncryptedTxQueueRemove(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	items := createNEncryptedTxQueueEntry(&keeper, ctx, 10)
+
+	// overwriting or removing a missing entry does not change the depth
+	keeper.SetEncryptedTxQueueEntry(ctx, items[0])
+	keeper.RemoveEncryptedTxQueueEntry(ctx, uint64(len(items)))
+	require.Equal(t, uint64(len(items)), keeper.GetEncryptedTxQueueDepth(ctx))
+
+	for i, item := range items {
+		first, found := keeper.GetFirstEncryptedTxQueueEntry(ctx)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&item), nullify.Fill(&first))
+
+		keeper.RemoveEncryptedTxQueueEntry(ctx, item.Sequence)
+		require.Equal(t, uint64(len(items)-i-1), keeper.GetEncryptedTxQueueDepth(ctx))
+	}
+	_, found := keeper.GetFirstEncryptedTxQueueEntry(ctx)
+	require.False(t, found)
+
+	// removing entries does not reuse sequences
+	entry := types.EncryptedTxQueueEntry{Creator: sample.AccAddress()}
+	require.Equal(t, uint64(10), keeper.EnqueueEncryptedTx(ctx, entry))
+}
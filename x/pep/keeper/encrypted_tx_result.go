@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetEncryptedTxResult stores the execution result of an encrypted tx,
+// results with a target height of 0 belong to general encrypted txs
+func (k Keeper) SetEncryptedTxResult(ctx context.Context, result types.EncryptedTxExecutionResult) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&result)
+
+	if result.TargetHeight == 0 {
+		store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.GenEncTxResultKeyPrefix))
+		store.Set(types.GenEncTxResultKey(result.Identity, result.Index), b)
+		return
+	}
+
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxResultKeyPrefix))
+	store.Set(types.EncryptedTxResultKey(result.TargetHeight, result.Index), b)
+}
+
+// GetEncryptedTxResult returns the execution result of a height-targeted encrypted tx
+func (k Keeper) GetEncryptedTxResult(
+	ctx context.Context,
+	targetHeight uint64,
+	index uint64,
+) (val types.EncryptedTxExecutionResult, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxResultKeyPrefix))
+
+	b := store.Get(types.EncryptedTxResultKey(targetHeight, index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetGeneralEncryptedTxResult returns the execution result of a general encrypted tx
+func (k Keeper) GetGeneralEncryptedTxResult(
+	ctx context.Context,
+	identity string,
+	index uint64,
+) (val types.EncryptedTxExecutionResult, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.GenEncTxResultKeyPrefix))
+
+	b := store.Get(types.GenEncTxResultKey(identity, index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncryptedTxResultQuery(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	feeCharged := sdk.NewCoin("ufairy", math.NewInt(150))
+	feeRefunded := sdk.NewCoin("ufairy", math.NewInt(50))
+
+	result := types.EncryptedTxExecutionResult{
+		Identity:              "10",
+		TargetHeight:          10,
+		Index:                 1,
+		Creator:               sample.AccAddress(),
+		Status:                types.EncryptedTxStatusExecuted,
+		GasUsed:               150,
+		FeeCharged:            &feeCharged,
+		FeeRefunded:           &feeRefunded,
+		MsgTypes:              []string{"/cosmos.bank.v1beta1.MsgSend"},
+		ExecutedAtChainHeight: 11,
+	}
+	k.SetEncryptedTxResult(ctx, result)
+
+	generalResult := types.EncryptedTxExecutionResult{
+		Identity:   "req-1/identity",
+		Index:      0,
+		Creator:    sample.AccAddress(),
+		Status:     types.EncryptedTxStatusReverted,
+		FailReason: "invalid pep nonce",
+	}
+	k.SetEncryptedTxResult(ctx, generalResult)
+	k.SetEntry(ctx, types.GenEncTxExecutionQueue{
+		RequestId: "req-1",
+		Identity:  "req-1/identity",
+	})
+
+	resp, err := k.EncryptedTxResult(ctx, &types.QueryEncryptedTxResultRequest{TargetHeight: 10, Index: 1})
+	require.NoError(t, err)
+	require.Equal(t, result, resp.Result)
+
+	_, err = k.EncryptedTxResult(ctx, &types.QueryEncryptedTxResultRequest{TargetHeight: 10, Index: 0})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	generalResp, err := k.GeneralEncryptedTxResult(ctx, &types.QueryGeneralEncryptedTxResultRequest{ReqId: "req-1", Index: 0})
+	require.NoError(t, err)
+	require.Equal(t, generalResult.FailReason, generalResp.Result.FailReason)
+	require.Equal(t, types.EncryptedTxStatusReverted, generalResp.Result.Status)
+
+	_, err = k.GeneralEncryptedTxResult(ctx, &types.QueryGeneralEncryptedTxResultRequest{ReqId: "unknown", Index: 0})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "request not found"))
+
+	_, err = k.EncryptedTxResult(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestPruneEncryptedTxResults(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	for h := uint64(1); h <= 4; h++ {
+		k.SetEncryptedTxResult(ctx, types.EncryptedTxExecutionResult{
+			TargetHeight: h,
+			Status:       types.EncryptedTxStatusExecuted,
+		})
+	}
+
+	require.Equal(t, uint64(2), k.PruneEncryptedTxResults(ctx, 3, 10))
+	_, found := k.GetEncryptedTxResult(ctx, 2, 0)
+	require.False(t, found)
+	_, found = k.GetEncryptedTxResult(ctx, 3, 0)
+	require.True(t, found)
+}
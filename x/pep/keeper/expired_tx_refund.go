@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExpireEncryptedTxsFromHeight marks all the encrypted txs of a height as expired
+// and refunds the configured fraction of the gas charged to their creators
+func (k Keeper) ExpireEncryptedTxsFromHeight(
+	ctx context.Context,
+	height uint64,
+) sdk.Coins {
+	arr := k.GetEncryptedTxAllFromHeight(ctx, height)
+	identity := strconv.FormatUint(height, 10)
+	total := sdk.NewCoins()
+
+	for i := range arr.EncryptedTx {
+		encTx := &arr.EncryptedTx[i]
+		encTx.Expired = true
+
+		if encTx.Cancelled || encTx.Refunded != nil {
+			continue
+		}
+
+		refund, err := k.refundChargedGas(ctx, identity, encTx.Index, encTx.Creator, encTx.ChargedGas)
+		if err != nil {
+			k.Logger().Error(fmt.Sprintf("unable to refund expired encrypted tx: %s", err.Error()))
+			continue
+		}
+
+		encTx.Refunded = &refund
+		total = total.Add(refund)
+	}
+
+	k.SetEncryptedTx(ctx, height, arr)
+	return total
+}
+
+// RefundGeneralEncryptedTxs refunds the configured fraction of the gas charged for all
+// the general encrypted txs of a request that will never be executed
+func (k Keeper) RefundGeneralEncryptedTxs(
+	ctx context.Context,
+	reqID string,
+) sdk.Coins {
+	total := sdk.NewCoins()
+
+	entry, found := k.GetEntry(ctx, reqID)
+	if !found || entry.TxList == nil {
+		return total
+	}
+
+	for i := range entry.TxList.EncryptedTx {
+		encTx := &entry.TxList.EncryptedTx[i]
+		if encTx.Cancelled || encTx.Refunded != nil {
+			continue
+		}
+
+		refund, err := k.refundChargedGas(ctx, entry.Identity, encTx.Index, encTx.Creator, encTx.ChargedGas)
+		if err != nil {
+			k.Logger().Error(fmt.Sprintf("unable to refund general encrypted tx: %s", err.Error()))
+			continue
+		}
+
+		encTx.Refunded = &refund
+		total = total.Add(refund)
+	}
+
+	k.SetEntry(ctx, entry)
+	return total
+}
+
+// ExpireGeneralEncryptedTxRequests marks the general encrypted tx requests whose public key expired
+// before height without delivering their decryption key as expired and refunds their encrypted txs
+func (k Keeper) ExpireGeneralEncryptedTxRequests(ctx context.Context, height uint64) sdk.Coins {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.GenEncTxExpiryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys, reqIDs [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) < 8 || binary.BigEndian.Uint64(key[:8]) >= height {
+			break
+		}
+		keys = append(keys, key)
+		reqIDs = append(reqIDs, iterator.Value())
+	}
+	iterator.Close()
+
+	total := sdk.NewCoins()
+	for i, key := range keys {
+		entry, found := k.GetEntry(ctx, string(reqIDs[i]))
+		if !found || entry.Expired || entry.AggrKeyshare != "" {
+			store.Delete(key)
+			continue
+		}
+
+		refunded := k.RefundGeneralEncryptedTxs(ctx, entry.RequestId)
+		entry, _ = k.GetEntry(ctx, entry.RequestId)
+		entry.Expired = true
+		k.SetEntry(ctx, entry)
+		store.Delete(key)
+
+		k.Logger().Info(fmt.Sprintf("Request %s expired without its decryption key, refunded: %s", entry.RequestId, refunded.String()))
+		total = total.Add(refunded...)
+	}
+
+	return total
+}
+
+// GeneralRequestExpiry returns the expiry height of the public key a general encrypted tx request is bound to,
+// the latest expiry of the known public keys is used if the key is neither the active nor the queued one
+func (k Keeper) GeneralRequestExpiry(ctx context.Context, pubKey string) uint64 {
+	var expiry uint64
+	if ak, found := k.GetActivePubKey(ctx); found {
+		if ak.PublicKey == pubKey {
+			return ak.Expiry
+		}
+		expiry = ak.Expiry
+	}
+	if qk, found := k.GetQueuedPubKey(ctx); found {
+		if qk.PublicKey == pubKey || qk.Expiry > expiry {
+			return qk.Expiry
+		}
+	}
+	return expiry
+}
+
+// refundChargedGas sends the ExpiredTxRefundFraction of the charged gas back to the creator
+func (k Keeper) refundChargedGas(
+	ctx context.Context,
+	identity string,
+	index uint64,
+	creator string,
+	chargedGas *sdk.Coin,
+) (sdk.Coin, error) {
+	if chargedGas == nil {
+		return sdk.Coin{}, errors.New("charged gas not found")
+	}
+
+	params := k.GetParams(ctx)
+	refund := sdk.NewCoin(
+		chargedGas.Denom,
+		params.ExpiredTxRefundFraction.MulInt(chargedGas.Amount).TruncateInt(),
+	)
+
+	if refund.IsPositive() {
+		creatorAddr, err := sdk.AccAddressFromBech32(creator)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.NewCoins(refund)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EncryptedTxRefundedEventType,
+			sdk.NewAttribute(types.EncryptedTxRefundedEventCreator, creator),
+			sdk.NewAttribute(types.EncryptedTxRefundedEventIdentity, identity),
+			sdk.NewAttribute(types.EncryptedTxRefundedEventIndex, strconv.FormatUint(index, 10)),
+			sdk.NewAttribute(types.EncryptedTxRefundedEventAmount, refund.String()),
+		),
+	)
+
+	return refund, nil
+}
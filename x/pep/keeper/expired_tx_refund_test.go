@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	commontypes "github.com/Fairblock/fairyring/x/common/types"
+	"github.com/Fairblock/fairyring/x/pep/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireEncryptedTxsFromHeight(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.ExpiredTxRefundFraction = math.LegacyZeroDec()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	chargedGas := sdk.NewCoin("ufairy", math.NewInt(1000))
+	for i := 0; i < 3; i++ {
+		k.AppendEncryptedTx(ctx, types.EncryptedTx{
+			TargetHeight: 10,
+			Data:         random.RandHex(32),
+			Creator:      sample.AccAddress(),
+			ChargedGas:   &chargedGas,
+		})
+	}
+	k.SetEncryptedTxCancelled(ctx, 10, 1)
+
+	refunded := k.ExpireEncryptedTxsFromHeight(ctx, 10)
+	require.True(t, refunded.IsZero())
+
+	arr := k.GetEncryptedTxAllFromHeight(ctx, 10)
+	for _, encTx := range arr.EncryptedTx {
+		require.True(t, encTx.Expired)
+		if encTx.Cancelled {
+			require.Nil(t, encTx.Refunded)
+			continue
+		}
+		require.NotNil(t, encTx.Refunded)
+		require.Equal(t, sdk.NewCoin("ufairy", math.ZeroInt()), *encTx.Refunded)
+	}
+}
+
+func TestRefundGeneralEncryptedTxs(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	chargedGas := sdk.NewCoin("ufairy", math.NewInt(0))
+	entry := types.GenEncTxExecutionQueue{
+		Creator:   sample.AccAddress(),
+		RequestId: "req-1",
+		Identity:  "req-1/identity",
+		TxList: &types.GeneralEncryptedTxArray{
+			EncryptedTx: []types.GeneralEncryptedTx{
+				{Identity: "req-1/identity", Index: 0, Creator: sample.AccAddress(), ChargedGas: &chargedGas},
+				{Identity: "req-1/identity", Index: 1, Creator: sample.AccAddress(), ChargedGas: &chargedGas, Cancelled: true},
+			},
+		},
+	}
+	k.SetEntry(ctx, entry)
+
+	refunded := k.RefundGeneralEncryptedTxs(ctx, entry.RequestId)
+	require.True(t, refunded.IsZero())
+
+	stored, found := k.GetEntry(ctx, entry.RequestId)
+	require.True(t, found)
+	require.NotNil(t, stored.TxList.EncryptedTx[0].Refunded)
+	require.Nil(t, stored.TxList.EncryptedTx[1].Refunded)
+
+	require.True(t, k.RefundGeneralEncryptedTxs(ctx, "unknown").IsZero())
+}
+
+func TestExpireGeneralEncryptedTxRequests(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	chargedGas := sdk.NewCoin("ufairy", math.NewInt(0))
+	newEntry := func(reqID string, expiry uint64) types.GenEncTxExecutionQueue {
+		return types.GenEncTxExecutionQueue{
+			Creator:   sample.AccAddress(),
+			RequestId: reqID,
+			Identity:  reqID + "/identity",
+			Expiry:    expiry,
+			TxList: &types.GeneralEncryptedTxArray{
+				EncryptedTx: []types.GeneralEncryptedTx{
+					{Identity: reqID + "/identity", Creator: sample.AccAddress(), ChargedGas: &chargedGas},
+				},
+			},
+		}
+	}
+
+	k.SetEntry(ctx, newEntry("req-1", 10))
+	k.SetEntry(ctx, newEntry("req-2", 20))
+	delivered := newEntry("req-3", 10)
+	k.SetEntry(ctx, delivered)
+	delivered.AggrKeyshare = random.RandHex(96)
+	k.SetEntry(ctx, delivered)
+
+	// requests are only expired once their public key expired
+	k.ExpireGeneralEncryptedTxRequests(ctx, 10)
+	stored, _ := k.GetEntry(ctx, "req-1")
+	require.False(t, stored.Expired)
+
+	k.ExpireGeneralEncryptedTxRequests(ctx, 11)
+	stored, _ = k.GetEntry(ctx, "req-1")
+	require.True(t, stored.Expired)
+	require.NotNil(t, stored.TxList.EncryptedTx[0].Refunded)
+
+	stored, _ = k.GetEntry(ctx, "req-2")
+	require.False(t, stored.Expired)
+	stored, _ = k.GetEntry(ctx, "req-3")
+	require.False(t, stored.Expired)
+	require.Nil(t, stored.TxList.EncryptedTx[0].Refunded)
+
+	// expired requests do not accept new encrypted txs
+	srv := keeper.NewMsgServerImpl(k)
+	_, err := srv.SubmitGeneralEncryptedTx(ctx, &types.MsgSubmitGeneralEncryptedTx{
+		Creator: sample.AccAddress(),
+		ReqId:   "req-1",
+		Data:    random.RandHex(32),
+	})
+	require.ErrorIs(t, err, types.ErrRequestExpired)
+}
+
+func TestGeneralRequestExpiry(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+	require.Equal(t, uint64(0), k.GeneralRequestExpiry(ctx, "unknown"))
+
+	k.SetActivePubKey(ctx, commontypes.ActivePublicKey{PublicKey: "active", Expiry: 100})
+	k.SetQueuedPubKey(ctx, commontypes.QueuedPublicKey{PublicKey: "queued", Expiry: 200})
+
+	require.Equal(t, uint64(100), k.GeneralRequestExpiry(ctx, "active"))
+	require.Equal(t, uint64(200), k.GeneralRequestExpiry(ctx, "queued"))
+	require.Equal(t, uint64(200), k.GeneralRequestExpiry(ctx, "unknown"))
+}
@@ -42,6 +42,11 @@ func (k Keeper) SetEntry(
 		types.GenEncTxQueueKey(val.RequestId),
 		entry,
 	)
+
+	if val.Expiry != 0 && !val.Expired && val.AggrKeyshare == "" {
+		expiryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.GenEncTxExpiryKeyPrefix))
+		expiryStore.Set(types.GenEncTxExpiryKey(val.Expiry, val.RequestId), []byte(val.RequestId))
+	}
 }
 
 // RemoveEntry removes an entry from the store
@@ -83,6 +88,8 @@ func (k Keeper) AppendTxToEntry(ctx context.Context, reqID string, encTx types.G
 
 	val.TxList = &list
 	k.SetEntry(ctx, val)
+
+	k.SetEncryptedTxCreatorIndex(ctx, encTx.Creator, 0, reqID, index)
 	return uint64(index)
 }
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -8,6 +9,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +41,15 @@ type (
 		bankKeeper     types.BankKeeper
 		contractKeeper types.ContractKeeper
 		wasmKeeper     types.WasmKeeper
+
+		// msgRouter and txConfig are only used to simulate underlying txs in queries,
+		// they are set after the keeper is created with SetTxSimulationHandlers
+		msgRouter baseapp.MessageRouter
+		txConfig  client.TxConfig
+
+		// registeredLanes are the block lanes of the app with their default config, they are set
+		// once the lanes are created and shared by the copies of the keeper
+		registeredLanes *[]*types.LaneParams
 	}
 )
 
@@ -71,9 +83,27 @@ func NewKeeper(
 		bankKeeper:     bankKeeper,
 		contractKeeper: contractKeeper,
 		wasmKeeper:     wasmKeeper,
+
+		registeredLanes: new([]*types.LaneParams),
 	}
 }
 
+// SetTxSimulationHandlers sets the msg router and tx config used by the SimulateEncryptedTx query
+func (k *Keeper) SetTxSimulationHandlers(msgRouter baseapp.MessageRouter, txConfig client.TxConfig) {
+	k.msgRouter = msgRouter
+	k.txConfig = txConfig
+}
+
+// SetRegisteredLanes sets the block lanes of the app with their default config
+func (k Keeper) SetRegisteredLanes(lanes []*types.LaneParams) {
+	*k.registeredLanes = lanes
+}
+
+// EffectiveLaneParams returns the lane config used to build and verify proposals
+func (k Keeper) EffectiveLaneParams(ctx context.Context) []*types.LaneParams {
+	return types.EffectiveLanes(k.GetParams(ctx).Lanes, *k.registeredLanes)
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
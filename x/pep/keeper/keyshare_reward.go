@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	kstypes "github.com/Fairblock/fairyring/x/keyshare/types"
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundKeyshareRewardPool sends the keyshare reward share of a fee kept by the pep module
+// to the keyshare reward pool, it returns the amount sent
+func (k Keeper) FundKeyshareRewardPool(ctx context.Context, fee sdk.Coin) sdk.Coin {
+	reward := sdk.NewCoin(fee.Denom, k.GetParams(ctx).KeyshareRewardRatio.MulInt(fee.Amount).TruncateInt())
+	if !reward.IsPositive() {
+		return sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, kstypes.ModuleName, sdk.NewCoins(reward)); err != nil {
+		k.Logger().Error(fmt.Sprintf("Error on funding keyshare reward pool with %s: %s", reward.String(), err.Error()))
+		return sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+
+	return reward
+}
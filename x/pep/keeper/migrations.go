@@ -3,6 +3,7 @@ package keeper
 import (
 	v2 "github.com/Fairblock/fairyring/x/pep/migrations/v2"
 	v3 "github.com/Fairblock/fairyring/x/pep/migrations/v3"
+	v4 "github.com/Fairblock/fairyring/x/pep/migrations/v4"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -25,3 +26,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/x/pep/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+func TestMigrations(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+	m := keeper.NewMigrator(k)
+
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.NoError(t, m.Migrate3to4(ctx))
+
+	// the params added after version 3 are set to their defaults
+	params := k.GetParams(ctx)
+	require.True(t, params.IsSourceChain)
+	require.Equal(t, types.DefaultMaxPrunedEntriesPerBlock, params.MaxPrunedEntriesPerBlock)
+	require.Equal(t, types.DefaultExpiredTxRefundFraction, params.ExpiredTxRefundFraction)
+	require.Equal(t, types.DefaultLanes, params.Lanes)
+	require.Equal(t, types.DefaultEncryptedTxOrdering, params.EncryptedTxOrdering)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelEncryptedTx cancels a pending encrypted tx before its decryption key is available
+// and refunds the gas charged to the creator on submission
+func (k msgServer) CancelEncryptedTx(goCtx context.Context, msg *types.MsgCancelEncryptedTx) (*types.MsgCancelEncryptedTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidMsgCreator
+	}
+
+	var (
+		identity   string
+		chargedGas *sdk.Coin
+	)
+
+	if len(msg.ReqId) > 0 {
+		entry, found := k.GetEntry(ctx, msg.ReqId)
+		if !found || entry.TxList == nil || uint64(len(entry.TxList.EncryptedTx)) <= msg.Index {
+			return nil, types.ErrEncryptedTxNotFound
+		}
+
+		encTx := &entry.TxList.EncryptedTx[msg.Index]
+		if encTx.Creator != msg.Creator {
+			return nil, types.ErrUnauthorizedCancel
+		}
+
+		// an expired request was already refunded when it expired
+		if encTx.Cancelled || entry.Expired || encTx.Refunded != nil || entry.AggrKeyshare != "" {
+			return nil, types.ErrEncryptedTxNotPending
+		}
+
+		encTx.Cancelled = true
+		k.SetEntry(ctx, entry)
+
+		identity = entry.Identity
+		chargedGas = encTx.ChargedGas
+	} else {
+		encTx, found := k.GetEncryptedTx(ctx, msg.TargetHeight, msg.Index)
+		if !found {
+			return nil, types.ErrEncryptedTxNotFound
+		}
+
+		if encTx.Creator != msg.Creator {
+			return nil, types.ErrUnauthorizedCancel
+		}
+
+		lastExecutedHeight, _ := strconv.ParseUint(k.GetLastExecutedHeight(ctx), 10, 64)
+		_, keyFound := k.GetAggregatedKeyShare(ctx, msg.TargetHeight)
+		if encTx.Cancelled || encTx.Expired || keyFound || msg.TargetHeight <= lastExecutedHeight {
+			return nil, types.ErrEncryptedTxNotPending
+		}
+
+		k.SetEncryptedTxCancelled(ctx, msg.TargetHeight, msg.Index)
+
+		identity = strconv.FormatUint(msg.TargetHeight, 10)
+		chargedGas = encTx.ChargedGas
+	}
+
+	refund := sdk.NewCoins()
+	if chargedGas != nil && chargedGas.IsPositive() {
+		refund = refund.Add(*chargedGas)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EncryptedTxCancelledEventType,
+			sdk.NewAttribute(types.EncryptedTxCancelledEventCreator, msg.Creator),
+			sdk.NewAttribute(types.EncryptedTxCancelledEventIdentity, identity),
+			sdk.NewAttribute(types.EncryptedTxCancelledEventIndex, strconv.FormatUint(msg.Index, 10)),
+			sdk.NewAttribute(types.EncryptedTxCancelledEventRefund, refund.String()),
+		),
+	)
+
+	return &types.MsgCancelEncryptedTxResponse{}, nil
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/pep/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelEncryptedTx(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := sample.AccAddress()
+	chargedGas := sdk.NewCoin("ufairy", math.NewInt(0))
+
+	k.AppendEncryptedTx(ctx, types.EncryptedTx{
+		TargetHeight: 10,
+		Data:         random.RandHex(32),
+		Creator:      creator,
+		ChargedGas:   &chargedGas,
+	})
+	k.AppendEncryptedTx(ctx, types.EncryptedTx{
+		TargetHeight: 11,
+		Data:         random.RandHex(32),
+		Creator:      creator,
+		ChargedGas:   &chargedGas,
+	})
+	k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{Height: 11, Data: random.RandHex(96)})
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCancelEncryptedTx
+		err     error
+	}{
+		{
+			desc:    "EncryptedTxNotFound",
+			request: types.NewMsgCancelEncryptedTx(creator, 10, "", 1),
+			err:     types.ErrEncryptedTxNotFound,
+		},
+		{
+			desc:    "NotCreator",
+			request: types.NewMsgCancelEncryptedTx(sample.AccAddress(), 10, "", 0),
+			err:     types.ErrUnauthorizedCancel,
+		},
+		{
+			desc:    "DecryptionKeyAlreadyExists",
+			request: types.NewMsgCancelEncryptedTx(creator, 11, "", 0),
+			err:     types.ErrEncryptedTxNotPending,
+		},
+		{
+			desc:    "Valid",
+			request: types.NewMsgCancelEncryptedTx(creator, 10, "", 0),
+		},
+		{
+			desc:    "AlreadyCancelled",
+			request: types.NewMsgCancelEncryptedTx(creator, 10, "", 0),
+			err:     types.ErrEncryptedTxNotPending,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CancelEncryptedTx(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	encTx, found := k.GetEncryptedTx(ctx, 10, 0)
+	require.True(t, found)
+	require.True(t, encTx.Cancelled)
+}
+
+func TestCancelGeneralEncryptedTx(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := sample.AccAddress()
+	chargedGas := sdk.NewCoin("ufairy", math.NewInt(0))
+
+	k.SetEntry(ctx, types.GenEncTxExecutionQueue{
+		Creator:   creator,
+		RequestId: "pending_req",
+		Identity:  "pending_req",
+	})
+	k.AppendTxToEntry(ctx, "pending_req", types.GeneralEncryptedTx{
+		Identity:   "pending_req",
+		Data:       random.RandHex(32),
+		Creator:    creator,
+		ChargedGas: &chargedGas,
+	})
+
+	k.SetEntry(ctx, types.GenEncTxExecutionQueue{
+		Creator:      creator,
+		RequestId:    "ready_req",
+		Identity:     "ready_req",
+		AggrKeyshare: random.RandHex(96),
+	})
+	k.AppendTxToEntry(ctx, "ready_req", types.GeneralEncryptedTx{
+		Identity:   "ready_req",
+		Data:       random.RandHex(32),
+		Creator:    creator,
+		ChargedGas: &chargedGas,
+	})
+
+	_, err := srv.CancelEncryptedTx(ctx, types.NewMsgCancelEncryptedTx(creator, 0, "ready_req", 0))
+	require.ErrorIs(t, err, types.ErrEncryptedTxNotPending)
+
+	_, err = srv.CancelEncryptedTx(ctx, types.NewMsgCancelEncryptedTx(sample.AccAddress(), 0, "pending_req", 0))
+	require.ErrorIs(t, err, types.ErrUnauthorizedCancel)
+
+	_, err = srv.CancelEncryptedTx(ctx, types.NewMsgCancelEncryptedTx(creator, 0, "pending_req", 0))
+	require.NoError(t, err)
+
+	entry, found := k.GetEntry(ctx, "pending_req")
+	require.True(t, found)
+	require.True(t, entry.TxList.EncryptedTx[0].Cancelled)
+}
+
+func TestCancelExpiredGeneralEncryptedTx(t *testing.T) {
+	k, ctx, bankKeeper := keepertest.PepKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	creator := sample.AccAddress()
+	chargedGas := sdk.NewCoin("ufairy", math.NewInt(1000))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(chargedGas)))
+
+	k.SetEntry(ctx, types.GenEncTxExecutionQueue{
+		Creator:   creator,
+		RequestId: "expired_req",
+		Identity:  "expired_req",
+		Expiry:    10,
+	})
+	k.AppendTxToEntry(ctx, "expired_req", types.GeneralEncryptedTx{
+		Identity:   "expired_req",
+		Data:       random.RandHex(32),
+		Creator:    creator,
+		ChargedGas: &chargedGas,
+	})
+
+	refunded := k.ExpireGeneralEncryptedTxRequests(ctx, 11)
+	require.False(t, refunded.IsZero())
+
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	balance := bankKeeper.GetAllBalances(ctx, creatorAddr)
+	require.Equal(t, refunded, balance)
+
+	// the expiry refund can not be followed by the cancel refund
+	_, err := srv.CancelEncryptedTx(ctx, types.NewMsgCancelEncryptedTx(creator, 0, "expired_req", 0))
+	require.ErrorIs(t, err, types.ErrEncryptedTxNotPending)
+	require.Equal(t, balance, bankKeeper.GetAllBalances(ctx, creatorAddr))
+}
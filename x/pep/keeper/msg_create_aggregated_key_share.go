@@ -1,18 +1,14 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/Fairblock/fairyring/x/pep/types"
 
-	enc "github.com/FairBlock/DistributedIBE/encryption"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	bls "github.com/drand/kyber-bls12381"
 )
 
 func (k msgServer) CreateAggregatedKeyShare(goCtx context.Context, msg *types.MsgCreateAggregatedKeyShare) (*types.MsgCreateAggregatedKeyShareResponse, error) {
@@ -36,12 +32,6 @@ func (k msgServer) CreateAggregatedKeyShare(goCtx context.Context, msg *types.Ms
 		return nil, errors.New("msg not from trusted source")
 	}
 
-	var dummyData = "test data"
-	var encryptedDataBytes bytes.Buffer
-	var dummyDataBuffer bytes.Buffer
-	dummyDataBuffer.Write([]byte(dummyData))
-	var decryptedDataBytes bytes.Buffer
-
 	ak, found := k.GetActivePubKey(ctx)
 	if !found {
 		k.Logger().Error("Active key not found")
@@ -53,28 +43,8 @@ func (k msgServer) CreateAggregatedKeyShare(goCtx context.Context, msg *types.Ms
 		return nil, errors.New("active key not found")
 	}
 
-	keyByte, _ := hex.DecodeString(msg.Data)
-	publicKeyByte, _ := hex.DecodeString(ak.PublicKey)
-
-	suite := bls.NewBLS12381Suite()
-	publicKeyPoint := suite.G1().Point()
-	if err := publicKeyPoint.UnmarshalBinary(publicKeyByte); err != nil {
-		return nil, err
-	}
-
-	skPoint := suite.G2().Point()
-	if err := skPoint.UnmarshalBinary(keyByte); err != nil {
-		return nil, err
-	}
-
-	processHeightStr := strconv.FormatUint(msg.Height, 10)
-	if err := enc.Encrypt(publicKeyPoint, []byte(processHeightStr), &encryptedDataBytes, &dummyDataBuffer); err != nil {
-		return nil, err
-	}
-
-	err := enc.Decrypt(publicKeyPoint, skPoint, &decryptedDataBytes, &encryptedDataBytes)
-	if err != nil {
-		k.Logger().Error("Decryption error when verifying aggregated keyshare")
+	if err := k.VerifyAggregatedKeyShare(ak.PublicKey, strconv.FormatUint(msg.Height, 10), msg.Data); err != nil {
+		k.Logger().Error("Error when verifying aggregated keyshare")
 		k.Logger().Error(err.Error())
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(types.KeyShareVerificationType,
@@ -86,18 +56,6 @@ func (k msgServer) CreateAggregatedKeyShare(goCtx context.Context, msg *types.Ms
 		return nil, err
 	}
 
-	if decryptedDataBytes.String() != dummyData {
-		k.Logger().Error("Decrypted data does not match original data")
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(types.KeyShareVerificationType,
-				sdk.NewAttribute(types.KeyShareVerificationCreator, msg.Creator),
-				sdk.NewAttribute(types.KeyShareVerificationHeight, strconv.FormatUint(msg.Height, 10)),
-				sdk.NewAttribute(types.KeyShareVerificationReason, "decrypted data does not match original data"),
-			),
-		)
-		return nil, err
-	}
-
 	k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{
 		Height:  msg.Height,
 		Data:    msg.Data,
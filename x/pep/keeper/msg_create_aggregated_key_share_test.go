@@ -87,13 +87,13 @@ func TestAggregatedKeyShareMsgServerCreate(t *testing.T) {
 			errMsg: "input string length must be equal to 96 bytes",
 		},
 		{
-			desc: "DecryptError",
+			desc: "AggregatedKeyShareForAnotherHeight",
 			request: &types.MsgCreateAggregatedKeyShare{
 				Creator: trustedAddr,
 				Height:  999,
 				Data:    incorrectDerived,
 			},
-			errMsg: "age decrypt: errNoMatch",
+			err: types.ErrInvalidAggregatedKey,
 		},
 		{
 			desc: "ValidAggregatedKeyShare",
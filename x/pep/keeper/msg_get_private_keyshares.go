@@ -48,6 +48,7 @@ func (k msgServer) GetPrivateKeyshares(goCtx context.Context, msg *types.MsgGetP
 			k.Logger().Info(fmt.Sprintf("Error on sending coins: %v", err.Error()))
 			return nil, err
 		}
+		k.FundKeyshareRewardPool(ctx, *params.PrivateKeysharePrice)
 	}
 
 	if params.IsSourceChain {
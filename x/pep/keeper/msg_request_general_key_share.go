@@ -125,6 +125,7 @@ func (k Keeper) OnAcknowledgementRequestAggrKeysharePacket(ctx sdk.Context, pack
 			RequestId: data.GetRequestId(),
 			Identity:  packetAck.GetIdentity(),
 			Pubkey:    packetAck.GetPubkey(),
+			Expiry:    k.GeneralRequestExpiry(ctx, packetAck.GetPubkey()),
 		}
 
 		_, found := k.GetEntry(ctx, entry.RequestId)
@@ -13,18 +13,8 @@ import (
 
 func (k msgServer) SubmitEncryptedTx(goCtx context.Context, msg *types.MsgSubmitEncryptedTx) (*types.MsgSubmitEncryptedTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	params := k.GetParams(ctx)
 
-	height := uint64(ctx.BlockHeight())
-
-	if !params.IsSourceChain {
-		strHeight := k.GetLatestHeight(ctx)
-		latestHeight, err := strconv.ParseUint(strHeight, 10, 64)
-
-		if err == nil {
-			height = latestHeight
-		}
-	}
+	height, maxHeight, err := k.EncryptedTxTargetHeightRange(ctx)
 
 	if msg.TargetBlockHeight <= height {
 		ctx.EventManager().EmitEvent(
@@ -38,24 +28,16 @@ func (k msgServer) SubmitEncryptedTx(goCtx context.Context, msg *types.MsgSubmit
 		return nil, types.ErrInvalidTargetBlockHeight
 	}
 
-	var maxHeight uint64
-	queuedKey, found := k.GetQueuedPubKey(ctx)
-	if !found || (queuedKey.Expiry == 0 && len(queuedKey.PublicKey) == 0) {
-		activeKey, foundActiveKey := k.GetActivePubKey(ctx)
-		if !foundActiveKey {
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(types.EncryptedTxRevertedEventType,
-					sdk.NewAttribute(types.EncryptedTxRevertedEventCreator, msg.Creator),
-					sdk.NewAttribute(types.EncryptedTxRevertedEventHeight, strconv.FormatUint(msg.TargetBlockHeight, 10)),
-					sdk.NewAttribute(types.EncryptedTxRevertedEventReason, "Active Public key not found"),
-					sdk.NewAttribute(types.EncryptedTxRevertedEventIndex, "0"),
-				),
-			)
-			return nil, types.ErrActivePubKeyNotFound
-		}
-		maxHeight = activeKey.Expiry
-	} else {
-		maxHeight = queuedKey.Expiry
+	if err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EncryptedTxRevertedEventType,
+				sdk.NewAttribute(types.EncryptedTxRevertedEventCreator, msg.Creator),
+				sdk.NewAttribute(types.EncryptedTxRevertedEventHeight, strconv.FormatUint(msg.TargetBlockHeight, 10)),
+				sdk.NewAttribute(types.EncryptedTxRevertedEventReason, "Active Public key not found"),
+				sdk.NewAttribute(types.EncryptedTxRevertedEventIndex, "0"),
+			),
+		)
+		return nil, err
 	}
 
 	if msg.TargetBlockHeight > maxHeight {
@@ -116,3 +98,31 @@ func (k msgServer) SubmitEncryptedTx(goCtx context.Context, msg *types.MsgSubmit
 
 	return &types.MsgSubmitEncryptedTxResponse{}, nil
 }
+
+// EncryptedTxTargetHeightRange returns the last executed height and the expiry of the queued public key,
+// or of the active one if no key is queued. Encrypted txs must target a height above the executed height
+// and up to the expiry. The executed height is returned even if no public key is found.
+func (k Keeper) EncryptedTxTargetHeightRange(ctx sdk.Context) (uint64, uint64, error) {
+	height := uint64(ctx.BlockHeight())
+
+	if !k.GetParams(ctx).IsSourceChain {
+		strHeight := k.GetLatestHeight(ctx)
+		latestHeight, err := strconv.ParseUint(strHeight, 10, 64)
+
+		if err == nil {
+			height = latestHeight
+		}
+	}
+
+	queuedKey, found := k.GetQueuedPubKey(ctx)
+	if found && (queuedKey.Expiry != 0 || len(queuedKey.PublicKey) != 0) {
+		return height, queuedKey.Expiry, nil
+	}
+
+	activeKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return height, 0, types.ErrActivePubKeyNotFound
+	}
+
+	return height, activeKey.Expiry, nil
+}
@@ -27,6 +27,10 @@ func (k msgServer) SubmitGeneralEncryptedTx(goCtx context.Context, msg *types.Ms
 		return nil, types.ErrInvalidIdentity
 	}
 
+	if entry.Expired {
+		return nil, types.ErrRequestExpired.Wrapf("request id: %s", msg.ReqId)
+	}
+
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return nil, types.ErrInvalidMsgCreator
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// PruneHistoricalData removes the encrypted txs and aggregated keyshares of heights that
+// are older than their retention window, removing at most MaxPrunedEntriesPerBlock entries of each store
+func (k Keeper) PruneHistoricalData(ctx context.Context) {
+	params := k.GetParams(ctx)
+	lastExecutedHeight, err := strconv.ParseUint(k.GetLastExecutedHeight(ctx), 10, 64)
+	if err != nil {
+		return
+	}
+
+	if cutoff, ok := pruneCutoff(lastExecutedHeight, params.EncryptedTxRetentionBlocks); ok {
+		pruned := k.PruneEncryptedTxs(ctx, cutoff, params.MaxPrunedEntriesPerBlock)
+		if pruned > 0 {
+			k.Logger().Info("pruned encrypted txs", "heights", pruned, "cutoff", cutoff)
+		}
+		pruned = k.PruneEncryptedTxResults(ctx, cutoff, params.MaxPrunedEntriesPerBlock)
+		if pruned > 0 {
+			k.Logger().Info("pruned encrypted tx results", "entries", pruned, "cutoff", cutoff)
+		}
+		pruned = k.PruneEncryptedTxOrders(ctx, cutoff, params.MaxPrunedEntriesPerBlock)
+		if pruned > 0 {
+			k.Logger().Info("pruned encrypted tx orders", "heights", pruned, "cutoff", cutoff)
+		}
+	}
+
+	if cutoff, ok := pruneCutoff(lastExecutedHeight, params.AggregatedKeyShareRetentionBlocks); ok {
+		pruned := k.PruneAggregatedKeyShares(ctx, cutoff, params.MaxPrunedEntriesPerBlock)
+		if pruned > 0 {
+			k.Logger().Info("pruned aggregated keyshares", "heights", pruned, "cutoff", cutoff)
+		}
+	}
+}
+
+// PruneEncryptedTxs removes up to limit encrypted tx arrays with a target height lower than cutoff
+func (k Keeper) PruneEncryptedTxs(ctx context.Context, cutoff, limit uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxKeyPrefix))
+	return pruneHeightIndexedStore(store, cutoff, limit, func(value []byte) {
+		var arr types.EncryptedTxArray
+		k.cdc.MustUnmarshal(value, &arr)
+		for _, encTx := range arr.EncryptedTx {
+			k.RemoveEncryptedTxCreatorIndex(ctx, encTx.Creator, encTx.TargetHeight, "", encTx.Index)
+		}
+	})
+}
+
+// PruneEncryptedTxResults removes up to limit execution results of encrypted txs with a target height lower than cutoff
+func (k Keeper) PruneEncryptedTxResults(ctx context.Context, cutoff, limit uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxResultKeyPrefix))
+	return pruneHeightIndexedStore(store, cutoff, limit, nil)
+}
+
+// PruneEncryptedTxOrders removes up to limit recorded execution orders with a target height lower than cutoff
+func (k Keeper) PruneEncryptedTxOrders(ctx context.Context, cutoff, limit uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxOrderKeyPrefix))
+	return pruneHeightIndexedStore(store, cutoff, limit, nil)
+}
+
+// PruneAggregatedKeyShares removes up to limit aggregated keyshares with a height lower than cutoff
+func (k Keeper) PruneAggregatedKeyShares(ctx context.Context, cutoff, limit uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AggregatedKeyShareKeyPrefix))
+	return pruneHeightIndexedStore(store, cutoff, limit, nil)
+}
+
+// GetOldestEncryptedTxHeight returns the lowest target height that still has encrypted txs stored
+func (k Keeper) GetOldestEncryptedTxHeight(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxKeyPrefix))
+	return oldestHeightInStore(store)
+}
+
+// GetOldestAggregatedKeyShareHeight returns the lowest height that still has an aggregated keyshare stored
+func (k Keeper) GetOldestAggregatedKeyShareHeight(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AggregatedKeyShareKeyPrefix))
+	return oldestHeightInStore(store)
+}
+
+// pruneCutoff returns the height below which entries can be pruned,
+// a retention of 0 disables pruning
+func pruneCutoff(height, retention uint64) (uint64, bool) {
+	if retention == 0 || height+1 <= retention {
+		return 0, false
+	}
+	return height + 1 - retention, true
+}
+
+// pruneHeightIndexedStore deletes up to limit entries of a store keyed by big endian heights
+// whose height is lower than cutoff, onDelete is called with the value of each deleted entry
+func pruneHeightIndexedStore(store prefix.Store, cutoff, limit uint64, onDelete func(value []byte)) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		key := iterator.Key()
+		if len(key) < 8 || binary.BigEndian.Uint64(key[:8]) >= cutoff {
+			break
+		}
+		keys = append(keys, key)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		if onDelete != nil {
+			onDelete(store.Get(key))
+		}
+		store.Delete(key)
+	}
+	return uint64(len(keys))
+}
+
+// oldestHeightInStore returns the height of the first entry of a store keyed by big endian heights
+func oldestHeightInStore(store prefix.Store) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	if !iterator.Valid() || len(iterator.Key()) < 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(iterator.Key()[:8])
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneHistoricalData(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	for h := uint64(1); h <= 10; h++ {
+		k.AppendEncryptedTx(ctx, types.EncryptedTx{
+			TargetHeight: h,
+			Data:         random.RandHex(32),
+			Creator:      sample.AccAddress(),
+		})
+		k.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{
+			Height:  h,
+			Data:    random.RandHex(32),
+			Creator: sample.AccAddress(),
+		})
+	}
+	k.SetLastExecutedHeight(ctx, "10")
+
+	require.Equal(t, uint64(1), k.GetOldestEncryptedTxHeight(ctx))
+	require.Equal(t, uint64(1), k.GetOldestAggregatedKeyShareHeight(ctx))
+
+	params := k.GetParams(ctx)
+	params.EncryptedTxRetentionBlocks = 3
+	params.AggregatedKeyShareRetentionBlocks = 5
+	params.MaxPrunedEntriesPerBlock = 4
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.PruneHistoricalData(ctx)
+	require.Equal(t, uint64(5), k.GetOldestEncryptedTxHeight(ctx))
+	require.Equal(t, uint64(5), k.GetOldestAggregatedKeyShareHeight(ctx))
+
+	k.PruneHistoricalData(ctx)
+	require.Equal(t, uint64(8), k.GetOldestEncryptedTxHeight(ctx))
+	require.Equal(t, uint64(6), k.GetOldestAggregatedKeyShareHeight(ctx))
+
+	resp, err := k.OldestRetainedHeight(ctx, &types.QueryOldestRetainedHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryOldestRetainedHeightResponse{
+		EncryptedTxHeight:        8,
+		AggregatedKeyShareHeight: 6,
+	}, resp)
+
+	_, err = k.OldestRetainedHeight(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestPruneHistoricalDataDisabled(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	for h := uint64(1); h <= 5; h++ {
+		k.AppendEncryptedTx(ctx, types.EncryptedTx{
+			TargetHeight: h,
+			Data:         random.RandHex(32),
+			Creator:      sample.AccAddress(),
+		})
+	}
+	k.SetLastExecutedHeight(ctx, "5")
+
+	k.PruneHistoricalData(ctx)
+	require.Equal(t, uint64(1), k.GetOldestEncryptedTxHeight(ctx))
+}
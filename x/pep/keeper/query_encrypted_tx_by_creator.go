@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncryptedTxByCreator returns the paginated list of encrypted txs submitted by a creator,
+// optionally filtered by their status
+func (k Keeper) EncryptedTxByCreator(c context.Context, req *types.QueryEncryptedTxByCreatorRequest) (*types.QueryEncryptedTxByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid creator address")
+	}
+
+	switch req.Status {
+	case "",
+		types.EncryptedTxStatusPending,
+		types.EncryptedTxStatusExecuted,
+		types.EncryptedTxStatusReverted,
+		types.EncryptedTxStatusExpired,
+		types.EncryptedTxStatusCancelled:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status filter: %s", req.Status)
+	}
+
+	var encryptedTxs []types.EncryptedTxWithStatus
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptedTxCreatorKeyPrefix))
+	creatorStore := prefix.NewStore(store, types.EncryptedTxCreatorPrefix(req.Creator))
+
+	pageRes, err := query.FilteredPaginate(creatorStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var entry types.EncryptedTxCreatorIndex
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		encTx, found := k.GetEncryptedTxWithStatus(ctx, entry)
+		if !found || (len(req.Status) > 0 && encTx.Status != req.Status) {
+			return false, nil
+		}
+
+		if accumulate {
+			encryptedTxs = append(encryptedTxs, encTx)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEncryptedTxByCreatorResponse{EncryptedTx: encryptedTxs, Pagination: pageRes}, nil
+}
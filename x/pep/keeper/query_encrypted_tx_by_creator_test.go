@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncryptedTxByCreatorQuery(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+
+	creator := sample.AccAddress()
+	for h := uint64(1); h <= 4; h++ {
+		k.AppendEncryptedTx(ctx, types.EncryptedTx{TargetHeight: h, Data: random.RandHex(32), Creator: creator})
+	}
+	// a tx from another creator must not be listed
+	k.AppendEncryptedTx(ctx, types.EncryptedTx{TargetHeight: 1, Data: random.RandHex(32), Creator: sample.AccAddress()})
+
+	k.SetEncryptedTxResult(ctx, types.EncryptedTxExecutionResult{TargetHeight: 1, Index: 0, Status: types.EncryptedTxStatusExecuted})
+	k.SetEncryptedTxResult(ctx, types.EncryptedTxExecutionResult{TargetHeight: 2, Index: 0, Status: types.EncryptedTxStatusReverted})
+	k.SetAllEncryptedTxExpired(ctx, 3)
+
+	k.SetEntry(ctx, types.GenEncTxExecutionQueue{RequestId: "req-1", Identity: "req-1/identity"})
+	k.AppendTxToEntry(ctx, "req-1", types.GeneralEncryptedTx{Identity: "req-1/identity", Data: random.RandHex(32), Creator: creator})
+
+	for _, tc := range []struct {
+		desc     string
+		status   string
+		expected []string
+	}{
+		{desc: "All", expected: []string{
+			types.EncryptedTxStatusExecuted,
+			types.EncryptedTxStatusReverted,
+			types.EncryptedTxStatusExpired,
+			types.EncryptedTxStatusPending,
+			types.EncryptedTxStatusPending,
+		}},
+		{desc: "Pending", status: types.EncryptedTxStatusPending, expected: []string{
+			types.EncryptedTxStatusPending,
+			types.EncryptedTxStatusPending,
+		}},
+		{desc: "Executed", status: types.EncryptedTxStatusExecuted, expected: []string{types.EncryptedTxStatusExecuted}},
+		{desc: "Reverted", status: types.EncryptedTxStatusReverted, expected: []string{types.EncryptedTxStatusReverted}},
+		{desc: "Expired", status: types.EncryptedTxStatusExpired, expected: []string{types.EncryptedTxStatusExpired}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := k.EncryptedTxByCreator(ctx, &types.QueryEncryptedTxByCreatorRequest{
+				Creator: creator,
+				Status:  tc.status,
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.EncryptedTx, len(tc.expected))
+			for i, encTx := range resp.EncryptedTx {
+				require.Equal(t, creator, encTx.Creator)
+				require.Equal(t, tc.expected[i], encTx.Status)
+			}
+		})
+	}
+
+	t.Run("Paginated", func(t *testing.T) {
+		resp, err := k.EncryptedTxByCreator(ctx, &types.QueryEncryptedTxByCreatorRequest{
+			Creator:    creator,
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.EncryptedTx, 2)
+		require.Equal(t, uint64(5), resp.Pagination.Total)
+
+		resp, err = k.EncryptedTxByCreator(ctx, &types.QueryEncryptedTxByCreatorRequest{
+			Creator:    creator,
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 10},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.EncryptedTx, 3)
+		require.Equal(t, "req-1", resp.EncryptedTx[2].ReqId)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		_, err := k.EncryptedTxByCreator(ctx, &types.QueryEncryptedTxByCreatorRequest{Creator: creator, Status: "unknown"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid status filter: unknown"))
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := k.EncryptedTxByCreator(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncryptedTxOrder returns the recorded execution order of the encrypted txs of a target height,
+// the order is derived again from the aggregated key of the height so anyone can check it
+func (k Keeper) EncryptedTxOrder(goCtx context.Context, req *types.QueryEncryptedTxOrderRequest) (*types.QueryEncryptedTxOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, found := k.GetEncryptedTxOrder(ctx, req.TargetHeight)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	key, found := k.GetAggregatedKeyShare(ctx, req.TargetHeight)
+	if !found {
+		return nil, status.Error(codes.NotFound, "aggregated key not found")
+	}
+
+	recomputed, err := k.ComputeEncryptedTxOrder(ctx, req.TargetHeight, key.Data)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEncryptedTxOrderResponse{
+		Order:             order,
+		RecomputedIndexes: recomputed.Indexes,
+		Valid:             recomputed.Seed == order.Seed && slices.Equal(recomputed.Indexes, order.Indexes),
+	}, nil
+}
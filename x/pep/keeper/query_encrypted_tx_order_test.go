@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+func TestEncryptedTxOrderQuery(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	for i := 0; i < 10; i++ {
+		keeper.AppendEncryptedTx(ctx, types.EncryptedTx{TargetHeight: 5, Creator: sample.AccAddress()})
+	}
+	keeper.SetAggregatedKeyShare(ctx, types.AggregatedKeyShare{Height: 5, Data: random.RandHex(96)})
+
+	_, err := keeper.EncryptedTxOrder(ctx, &types.QueryEncryptedTxOrderRequest{TargetHeight: 5})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	key, _ := keeper.GetAggregatedKeyShare(ctx, 5)
+	order, err := keeper.ComputeEncryptedTxOrder(ctx, 5, key.Data)
+	require.NoError(t, err)
+	require.Len(t, order.Indexes, 10)
+	keeper.SetEncryptedTxOrder(ctx, order)
+
+	response, err := keeper.EncryptedTxOrder(ctx, &types.QueryEncryptedTxOrderRequest{TargetHeight: 5})
+	require.NoError(t, err)
+	require.True(t, response.Valid)
+	require.Equal(t, order, response.Order)
+	require.Equal(t, order.Indexes, response.RecomputedIndexes)
+
+	// a tampered order does not match the one derived from the aggregated key
+	tampered := order
+	tampered.Indexes = append([]uint64{order.Indexes[1], order.Indexes[0]}, order.Indexes[2:]...)
+	keeper.SetEncryptedTxOrder(ctx, tampered)
+
+	response, err = keeper.EncryptedTxOrder(ctx, &types.QueryEncryptedTxOrderRequest{TargetHeight: 5})
+	require.NoError(t, err)
+	require.False(t, response.Valid)
+	require.Equal(t, order.Indexes, response.RecomputedIndexes)
+
+	_, err = keeper.EncryptedTxOrder(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
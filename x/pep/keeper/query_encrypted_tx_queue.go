@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncryptedTxQueueDepth returns the number of encrypted txs waiting in the carry-over execution queue
+func (k Keeper) EncryptedTxQueueDepth(goCtx context.Context, req *types.QueryEncryptedTxQueueDepthRequest) (*types.QueryEncryptedTxQueueDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryEncryptedTxQueueDepthResponse{
+		Depth: k.GetEncryptedTxQueueDepth(ctx),
+	}, nil
+}
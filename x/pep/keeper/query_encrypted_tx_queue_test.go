@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncryptedTxQueueDepthQuery(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	createNEncryptedTxQueueEntry(&keeper, ctx, 5)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryEncryptedTxQueueDepthRequest
+		response *types.QueryEncryptedTxQueueDepthResponse
+		err      error
+	}{
+		{
+			desc:     "ValidRequest",
+			request:  &types.QueryEncryptedTxQueueDepthRequest{},
+			response: &types.QueryEncryptedTxQueueDepthResponse{Depth: 5},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.EncryptedTxQueueDepth(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncryptedTxResult returns the execution result of a height-targeted encrypted tx
+func (k Keeper) EncryptedTxResult(goCtx context.Context, req *types.QueryEncryptedTxResultRequest) (*types.QueryEncryptedTxResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetEncryptedTxResult(ctx, req.TargetHeight, req.Index)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryEncryptedTxResultResponse{Result: val}, nil
+}
+
+// GeneralEncryptedTxResult returns the execution result of a general encrypted tx
+func (k Keeper) GeneralEncryptedTxResult(goCtx context.Context, req *types.QueryGeneralEncryptedTxResultRequest) (*types.QueryGeneralEncryptedTxResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entry, found := k.GetEntry(ctx, req.ReqId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "request not found")
+	}
+
+	val, found := k.GetGeneralEncryptedTxResult(ctx, entry.Identity, req.Index)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGeneralEncryptedTxResultResponse{Result: val}, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Lanes returns the effective lane configuration used to build and verify block proposals
+func (k Keeper) Lanes(ctx context.Context, req *types.QueryLanesRequest) (*types.QueryLanesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryLanesResponse{Lanes: k.EffectiveLaneParams(ctx)}, nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+func TestLanesQuery(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	params := types.DefaultParams()
+	params.Lanes = []*types.LaneParams{{Name: "encrypted", MaxBlockSpace: math.LegacyMustNewDecFromStr("0.4"), MaxTxs: 50}}
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	// without registered lanes the configured ones are returned
+	response, err := keeper.Lanes(ctx, &types.QueryLanesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.Lanes, response.Lanes)
+
+	keyshareLane := &types.LaneParams{Name: "keyshare", MaxBlockSpace: math.LegacyMustNewDecFromStr("0.3")}
+	defaultLane := &types.LaneParams{Name: "default", MaxBlockSpace: math.LegacyZeroDec()}
+	keeper.SetRegisteredLanes([]*types.LaneParams{
+		keyshareLane,
+		{Name: "encrypted", MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2")},
+		defaultLane,
+	})
+
+	response, err = keeper.Lanes(ctx, &types.QueryLanesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.LaneParams{params.Lanes[0], keyshareLane, defaultLane}, response.Lanes)
+
+	_, err = keeper.Lanes(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
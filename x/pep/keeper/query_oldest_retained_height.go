@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OldestRetainedHeight returns the oldest height that still has encrypted txs and aggregated keyshares stored
+func (k Keeper) OldestRetainedHeight(goCtx context.Context, req *types.QueryOldestRetainedHeightRequest) (*types.QueryOldestRetainedHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryOldestRetainedHeightResponse{
+		EncryptedTxHeight:        k.GetOldestEncryptedTxHeight(ctx),
+		AggregatedKeyShareHeight: k.GetOldestAggregatedKeyShareHeight(ctx),
+	}, nil
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	cosmosmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	enc "github.com/FairBlock/DistributedIBE/encryption"
+	"github.com/Fairblock/fairyring/x/pep/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bls "github.com/drand/kyber-bls12381"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateEncryptedTx estimates the gas and the fee of an encrypted tx by encrypting the given plaintext
+// underlying tx to the given identity and executing its messages on a branch of the current state.
+// The signer of the underlying tx is assumed to be the creator of the encrypted tx paying the escrow.
+func (k Keeper) SimulateEncryptedTx(goCtx context.Context, req *types.QuerySimulateEncryptedTxRequest) (*types.QuerySimulateEncryptedTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx bytes can not be empty")
+	}
+
+	if req.Identity == "" {
+		return nil, status.Error(codes.InvalidArgument, "identity can not be empty")
+	}
+
+	if k.msgRouter == nil || k.txConfig == nil {
+		return nil, status.Error(codes.Unavailable, "encrypted tx simulation is not configured")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "active public key not found")
+	}
+
+	suite := bls.NewBLS12381Suite()
+	publicKeyPoint, err := k.GetPubKeyPoint(activePubKey.PublicKey, suite)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var cipherData bytes.Buffer
+	if err = enc.Encrypt(publicKeyPoint, []byte(req.Identity), &cipherData, bytes.NewBuffer(req.TxBytes)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error encrypting tx: %s", err.Error())
+	}
+	encryptedData := hex.EncodeToString(cipherData.Bytes())
+
+	decodedTx, err := k.txConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		decodedTx, err = k.txConfig.TxJSONDecoder()(req.TxBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to decode tx data to Cosmos Tx: %s", err.Error())
+		}
+	}
+
+	wrappedTx, err := k.txConfig.WrapTxBuilder(decodedTx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error wrapping decoded tx: %s", err.Error())
+	}
+
+	// same as the execution, a fee payer other than the signer signs the underlying tx as well
+	signers, err := wrappedTx.GetTx().GetSigners()
+	feePayerSigned := err == nil && len(signers) == 2 &&
+		sdk.AccAddress(signers[1]).Equals(sdk.AccAddress(wrappedTx.GetTx().FeePayer()))
+	if err != nil || (len(signers) != 1 && !feePayerSigned) {
+		return nil, status.Error(codes.InvalidArgument, "underlying tx must be signed by exactly one signer")
+	}
+	signerAddr := sdk.AccAddress(signers[0])
+
+	txMsgs := wrappedTx.GetTx().GetMsgs()
+	if len(txMsgs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "underlying tx does not contain any message")
+	}
+
+	gasLimit := wrappedTx.GetTx().GetGas()
+
+	// Same as the execution in BeginBlock, the decryption and the messages are metered against
+	// the gas limit of the underlying tx, which is capped so the query can not run unbounded.
+	simGasLimit := min(gasLimit, types.MaxEncryptedTxSimulationGas, ctx.GasMeter().GasRemaining())
+	if maxGas := k.GetParams(ctx).MaxEncryptedTxGasPerBlock; maxGas > 0 {
+		simGasLimit = min(simGasLimit, maxGas)
+	}
+
+	simCtx, _ := ctx.CacheContext()
+
+	// The decryption overhead covers the store access done for the encrypted tx before its
+	// messages are executed, it scales with the ciphertext size for height targeted txs
+	// since their whole array is rewritten when the tx is marked as processed.
+	markProcessed := func(sdk.Context) {}
+	if targetHeight, err := strconv.ParseUint(req.Identity, 10, 64); err == nil {
+		arr := k.GetEncryptedTxAllFromHeight(simCtx, targetHeight)
+		index := uint64(len(arr.EncryptedTx))
+		arr.EncryptedTx = append(arr.EncryptedTx, types.EncryptedTx{
+			TargetHeight: targetHeight,
+			Index:        index,
+			Data:         encryptedData,
+			Creator:      signerAddr.String(),
+		})
+		k.SetEncryptedTx(simCtx, targetHeight, arr)
+
+		markProcessed = func(execCtx sdk.Context) {
+			k.SetEncryptedTxProcessedHeight(execCtx, targetHeight, index, uint64(ctx.BlockHeight()))
+		}
+	}
+
+	simGasMeter := storetypes.NewGasMeter(simGasLimit)
+	decryptionGas, err := k.simulateEncryptedTxExecution(simCtx.WithGasMeter(simGasMeter), markProcessed, signerAddr, txMsgs)
+	outOfGas := simGasMeter.IsOutOfGas()
+	if err != nil && !outOfGas {
+		return nil, status.Errorf(codes.FailedPrecondition, "underlying tx execution failed: %s", err.Error())
+	}
+
+	totalGas := simGasMeter.GasConsumedToLimit()
+	underlyingGas := totalGas - decryptionGas
+
+	escrow := k.MinGasPrice(ctx)
+	fee := sdk.NewCoin(escrow.Denom, cosmosmath.ZeroInt())
+	feeDeducted := sdk.NewCoin(escrow.Denom, cosmosmath.ZeroInt())
+	feeRefunded := sdk.NewCoin(escrow.Denom, cosmosmath.ZeroInt())
+
+	// Same as the execution in BeginBlock, an underlying tx without fee is not charged
+	// and its escrow is kept, otherwise the gas used is priced at the gas price of the underlying tx
+	txFee := wrappedTx.GetTx().GetFee()
+	if !txFee.Empty() && gasLimit > 0 {
+		if txFee[0].Denom != escrow.Denom {
+			return nil, status.Errorf(codes.InvalidArgument, "underlying tx gas denom does not match charged gas denom, got: %s, expect: %s", txFee[0].Denom, escrow.Denom)
+		}
+
+		gasUsed := totalGas
+		if gasUsed > gasLimit {
+			gasUsed = gasLimit
+		}
+
+		fee.Amount = txFee[0].Amount.Quo(cosmosmath.NewIntFromUint64(gasLimit)).Mul(cosmosmath.NewIntFromUint64(gasUsed))
+		if fee.Amount.GT(escrow.Amount) {
+			feeDeducted.Amount = fee.Amount.Sub(escrow.Amount)
+		} else {
+			feeRefunded.Amount = escrow.Amount.Sub(fee.Amount)
+		}
+	}
+
+	return &types.QuerySimulateEncryptedTxResponse{
+		UnderlyingGas:  underlyingGas,
+		DecryptionGas:  decryptionGas,
+		TotalGas:       totalGas,
+		GasLimit:       gasLimit,
+		CiphertextSize: uint64(len(encryptedData)),
+		Escrow:         escrow,
+		Fee:            fee,
+		FeeDeducted:    feeDeducted,
+		FeeRefunded:    feeRefunded,
+		OutOfGas:       outOfGas,
+	}, nil
+}
+
+// simulateEncryptedTxExecution marks the encrypted tx as processed, runs the decryption store access and
+// executes the underlying msgs, it returns the gas consumed before the msgs are executed.
+// Running out of gas is returned as an error instead of a panic.
+func (k Keeper) simulateEncryptedTxExecution(
+	ctx sdk.Context,
+	markProcessed func(sdk.Context),
+	signerAddr sdk.AccAddress,
+	txMsgs []sdk.Msg,
+) (decryptionGas uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			if decryptionGas == 0 {
+				decryptionGas = ctx.GasMeter().GasConsumedToLimit()
+			}
+			err = fmt.Errorf("out of gas in location: %s, gas limit: %d", outOfGas.Descriptor, ctx.GasMeter().Limit())
+		}
+	}()
+
+	markProcessed(ctx)
+	k.accountKeeper.GetAccount(ctx, signerAddr)
+	k.GetPepNonce(ctx, signerAddr.String())
+	k.IncreasePepNonce(ctx, signerAddr.String())
+	decryptionGas = ctx.GasMeter().GasConsumed()
+
+	return decryptionGas, k.simulateUnderlyingMsgs(ctx, txMsgs)
+}
+
+// simulateUnderlyingMsgs routes every message of the underlying tx through the msg router in order
+func (k Keeper) simulateUnderlyingMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return fmt.Errorf("no message handler found for message %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		if _, err := handler(ctx, msg); err != nil {
+			return fmt.Errorf("error when handling tx message %d (%s): %s", i, sdk.MsgTypeURL(msg), err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	commontypes "github.com/Fairblock/fairyring/x/common/types"
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+const simulatedMsgGas = 50000
+
+// gasConsumingRouter routes every message to a handler consuming a fixed amount of gas
+type gasConsumingRouter struct{}
+
+func (gasConsumingRouter) Handler(sdk.Msg) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(simulatedMsgGas, "simulated msg")
+		return &sdk.Result{}, nil
+	}
+}
+
+func (r gasConsumingRouter) HandlerByTypeURL(string) baseapp.MsgServiceHandler {
+	return r.Handler(nil)
+}
+
+func TestSimulateEncryptedTxQuery(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	txConfig := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}).TxConfig
+
+	out, err := random.GeneratePubKeyAndShares(1)
+	require.NoError(t, err)
+
+	buildTx := func(fee sdk.Coins, gasLimit uint64, msgCount int) []byte {
+		builder := txConfig.NewTxBuilder()
+		msgs := make([]sdk.Msg, 0, msgCount)
+		from := sample.AccAddress()
+		for i := 0; i < msgCount; i++ {
+			msgs = append(msgs, banktypes.NewMsgSend(
+				sdk.MustAccAddressFromBech32(from),
+				sdk.MustAccAddressFromBech32(sample.AccAddress()),
+				sdk.NewCoins(sdk.NewCoin("ufairy", cosmosmath.NewInt(1))),
+			))
+		}
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(fee)
+		builder.SetGasLimit(gasLimit)
+		txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	minGasPrice := keeper.MinGasPrice(ctx)
+	cheapTx := buildTx(sdk.NewCoins(sdk.NewCoin(minGasPrice.Denom, cosmosmath.NewInt(1000000))), 1000000, 1)
+	expensiveTx := buildTx(sdk.NewCoins(sdk.NewCoin(minGasPrice.Denom, cosmosmath.NewInt(100000000))), 1000000, 2)
+	underfundedTx := buildTx(sdk.NewCoins(sdk.NewCoin(minGasPrice.Denom, cosmosmath.NewInt(1000000))), simulatedMsgGas, 2)
+
+	_, err = keeper.SimulateEncryptedTx(ctx, &types.QuerySimulateEncryptedTxRequest{TxBytes: cheapTx, Identity: "100"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	keeper.SetTxSimulationHandlers(gasConsumingRouter{}, txConfig)
+
+	_, err = keeper.SimulateEncryptedTx(ctx, &types.QuerySimulateEncryptedTxRequest{TxBytes: cheapTx, Identity: "100"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	keeper.SetActivePubKey(ctx, commontypes.ActivePublicKey{
+		PublicKey: out.MasterPublicKey,
+		Creator:   sample.AccAddress(),
+		Expiry:    1000,
+	})
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QuerySimulateEncryptedTxRequest
+		msgGas   uint64
+		outOfGas bool
+		code     codes.Code
+	}{
+		{
+			desc:    "HeightTargeted",
+			request: &types.QuerySimulateEncryptedTxRequest{TxBytes: cheapTx, Identity: "100"},
+			msgGas:  simulatedMsgGas,
+		},
+		{
+			desc:    "GeneralIdentity",
+			request: &types.QuerySimulateEncryptedTxRequest{TxBytes: expensiveTx, Identity: "general-identity"},
+			msgGas:  2 * simulatedMsgGas,
+		},
+		{
+			desc:     "OutOfGas",
+			request:  &types.QuerySimulateEncryptedTxRequest{TxBytes: underfundedTx, Identity: "100"},
+			outOfGas: true,
+		},
+		{
+			desc:    "InvalidTxBytes",
+			request: &types.QuerySimulateEncryptedTxRequest{TxBytes: []byte("invalid tx"), Identity: "100"},
+			code:    codes.InvalidArgument,
+		},
+		{
+			desc:    "EmptyIdentity",
+			request: &types.QuerySimulateEncryptedTxRequest{TxBytes: cheapTx},
+			code:    codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidRequest",
+			code: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.SimulateEncryptedTx(ctx, tc.request)
+			if tc.code != codes.OK {
+				require.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.outOfGas, response.OutOfGas)
+			if tc.outOfGas {
+				require.Equal(t, response.GasLimit, response.TotalGas)
+			} else {
+				require.Equal(t, tc.msgGas, response.UnderlyingGas)
+			}
+			require.Positive(t, response.DecryptionGas)
+			require.Positive(t, response.CiphertextSize)
+			require.Equal(t, response.UnderlyingGas+response.DecryptionGas, response.TotalGas)
+			require.Equal(t, minGasPrice, response.Escrow)
+
+			if response.Fee.Amount.GT(response.Escrow.Amount) {
+				require.True(t, response.FeeRefunded.Amount.IsZero())
+				require.Equal(t, response.Fee.Amount.Sub(response.Escrow.Amount), response.FeeDeducted.Amount)
+			} else {
+				require.True(t, response.FeeDeducted.Amount.IsZero())
+				require.Equal(t, response.Escrow.Amount.Sub(response.Fee.Amount), response.FeeRefunded.Amount)
+			}
+		})
+	}
+
+	// the simulation must not leave any state behind
+	require.Empty(t, keeper.GetEncryptedTxAllFromHeight(ctx, 100).EncryptedTx)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"github.com/Fairblock/fairyring/x/pep/types"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+)
+
+// VerifyAggregatedKeyShare checks the aggregated key is the private key of the identity under the public key,
+// the extracted key sk = s * H(id) of the master secret s is valid when e(g1, sk) = e(s * g1, H(id))
+func (k Keeper) VerifyAggregatedKeyShare(pubKey string, identity string, aggrKeyshare string) error {
+	suite := bls.NewBLS12381Suite()
+
+	publicKeyPoint, err := k.GetPubKeyPoint(pubKey, suite)
+	if err != nil {
+		return err
+	}
+
+	skPoint, err := k.GetSKPoint(aggrKeyshare, suite)
+	if err != nil {
+		return err
+	}
+
+	hG2, ok := suite.G2().Point().(kyber.HashablePoint)
+	if !ok {
+		return types.ErrInvalidAggregatedKey.Wrap("unable to hash the identity")
+	}
+	qid := hG2.Hash([]byte(identity))
+
+	if !suite.Pair(suite.G1().Point().Base(), skPoint).Equal(suite.Pair(publicKeyPoint, qid)) {
+		return types.ErrInvalidAggregatedKey.Wrapf("identity: %s", identity)
+	}
+
+	return nil
+}
@@ -9,20 +9,21 @@ import (
 
 // MigrateStore migrates the x/pep module state from the consensus version 1 to version 2.
 func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
-	currParams := types.NewParams(
-		[]string{"fairy1yhpqdugfmfuhlvekkurnkstf2vl82063ajmfe5", "fairy1r6q07ne3deq64ezcjwkedcfe6669f0ewpwnxy9"},
-		[]*types.TrustedCounterParty{
+	// params added after version 2 are set by the later migrations
+	currParams := types.Params{
+		TrustedAddresses: []string{"fairy1yhpqdugfmfuhlvekkurnkstf2vl82063ajmfe5", "fairy1r6q07ne3deq64ezcjwkedcfe6669f0ewpwnxy9"},
+		TrustedCounterParties: []*types.TrustedCounterParty{
 			{
 				ClientId:     "07-tendermint-0",
 				ConnectionId: "connection-0",
 				ChannelId:    "channel-1",
 			},
 		},
-		types.DefaultKeyshareChannelID,
-		&types.DefaultMinGasPrice,
-		true,
-		&types.DefaultKeysharePrice,
-	)
+		KeyshareChannelId:    types.DefaultKeyshareChannelID,
+		MinGasPrice:          &types.DefaultMinGasPrice,
+		IsSourceChain:        true,
+		PrivateKeysharePrice: &types.DefaultKeysharePrice,
+	}
 
 	bz, err := cdc.Marshal(&currParams)
 	if err != nil {
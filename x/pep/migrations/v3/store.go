@@ -19,14 +19,15 @@ func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.
 		return err
 	}
 
-	currParams := types.NewParams(
-		currentParams.TrustedAddresses,
-		currentParams.TrustedCounterParties,
-		currentParams.KeyshareChannelId,
-		currentParams.MinGasPrice,
-		currentParams.IsSourceChain,
-		&types.DefaultKeysharePrice,
-	)
+	// params added after version 3 are set by the later migrations
+	currParams := types.Params{
+		TrustedAddresses:      currentParams.TrustedAddresses,
+		TrustedCounterParties: currentParams.TrustedCounterParties,
+		KeyshareChannelId:     currentParams.KeyshareChannelId,
+		MinGasPrice:           currentParams.MinGasPrice,
+		IsSourceChain:         currentParams.IsSourceChain,
+		PrivateKeysharePrice:  &types.DefaultKeysharePrice,
+	}
 
 	bz, err := cdc.Marshal(&currParams)
 	if err != nil {
@@ -79,6 +79,51 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query decrypt-data",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pubkey"}, {ProtoField: "aggr_keyshare"}, {ProtoField: "encrypted_data"}},
 				},
+				{
+					RpcMethod: "EncryptedTxQueueDepth",
+					Use:       "encrypted-tx-queue-depth",
+					Short:     "Query the number of encrypted txs waiting in the carry-over execution queue",
+				},
+				{
+					RpcMethod: "OldestRetainedHeight",
+					Use:       "oldest-retained-height",
+					Short:     "Query the oldest height still retained for encrypted txs and aggregated keyshares",
+				},
+				{
+					RpcMethod: "Lanes",
+					Use:       "lanes",
+					Short:     "Query the effective lane configuration used to build block proposals",
+				},
+				{
+					RpcMethod:      "EncryptedTxResult",
+					Use:            "show-encrypted-tx-result [target-height] [index]",
+					Short:          "Show the execution result of an encrypted tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "targetHeight"}, {ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "EncryptedTxOrder",
+					Use:            "show-encrypted-tx-order [target-height]",
+					Short:          "Show the execution order of the encrypted txs of a target height and check it against the one derived from its aggregated key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "targetHeight"}},
+				},
+				{
+					RpcMethod:      "GeneralEncryptedTxResult",
+					Use:            "show-general-encrypted-tx-result [req-id] [index]",
+					Short:          "Show the execution result of a general encrypted tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "req_id"}, {ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "EncryptedTxByCreator",
+					Use:            "list-encrypted-tx-by-creator [creator]",
+					Short:          "list the encrypted transactions submitted by a creator, optionally filtered with --status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "SimulateEncryptedTx",
+					Use:            "simulate-encrypted-tx [tx-bytes] [identity]",
+					Short:          "estimate the gas and fee of an encrypted tx from its signed underlying tx, given as a file, hex or base64",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tx_bytes"}, {ProtoField: "identity"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
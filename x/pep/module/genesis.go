@@ -17,6 +17,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			continue
 		}
 		k.SetEncryptedTx(ctx, elem.EncryptedTx[0].TargetHeight, elem)
+		for _, encTx := range elem.EncryptedTx {
+			k.SetEncryptedTxCreatorIndex(ctx, encTx.Creator, encTx.TargetHeight, "", encTx.Index)
+		}
 	}
 	// Set all the pepNonce
 	for _, elem := range genState.PepNonceList {
@@ -34,6 +37,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.RequestIdList {
 		k.SetRequestId(ctx, elem)
 	}
+	// Set all the carried over encrypted txs
+	var nextQueueSequence uint64
+	for _, elem := range genState.EncryptedTxQueue {
+		k.SetEncryptedTxQueueEntry(ctx, elem)
+		if elem.Sequence >= nextQueueSequence {
+			nextQueueSequence = elem.Sequence + 1
+		}
+	}
+	k.SetEncryptedTxQueueSequence(ctx, nextQueueSequence)
 	// this line is used by starport scaffolding # genesis/module/init
 
 	// this line is used by starport scaffolding # genesis/module/init
@@ -68,6 +80,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.EncryptedTxArray = k.GetAllEncryptedArray(ctx)
 	genesis.PepNonceList = k.GetAllPepNonce(ctx)
 	genesis.AggregatedKeyShareList = k.GetAllAggregatedKeyShare(ctx)
+	genesis.EncryptedTxQueue = k.GetAllEncryptedTxQueueEntry(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 	akey, found := k.GetActivePubKey(ctx)
 	if found {
@@ -23,6 +23,14 @@ func TestGenesis(t *testing.T) {
 				Creator: "1",
 			},
 		},
+		EncryptedTxQueue: []types.EncryptedTxQueueEntry{
+			{
+				Sequence: 0,
+			},
+			{
+				Sequence: 1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -37,5 +45,6 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PortId, got.PortId)
 
 	require.ElementsMatch(t, genesisState.RequestIdList, got.RequestIdList)
+	require.ElementsMatch(t, genesisState.EncryptedTxQueue, got.EncryptedTxQueue)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
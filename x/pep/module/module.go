@@ -13,6 +13,7 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	cosmosmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
 	enc "github.com/FairBlock/DistributedIBE/encryption"
 	commontypes "github.com/Fairblock/fairyring/x/common/types"
@@ -23,13 +24,16 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/drand/kyber"
 	bls "github.com/drand/kyber-bls12381"
+	"github.com/drand/kyber/pairing"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -53,7 +57,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/pep module consensus version.
-const ConsensusVersion = 3
+const ConsensusVersion = 4
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -119,9 +123,10 @@ func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper        keeper.Keeper
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
+	keeper         keeper.Keeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper types.FeegrantKeeper
 
 	msgServiceRouter *baseapp.MsgServiceRouter
 	txConfig         client.TxConfig
@@ -133,6 +138,7 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
 	msgServiceRouter *baseapp.MsgServiceRouter,
 	txConfig client.TxConfig,
 	simCheck func(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error),
@@ -142,6 +148,7 @@ func NewAppModule(
 		keeper:           keeper,
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
+		feegrantKeeper:   feegrantKeeper,
 		msgServiceRouter: msgServiceRouter,
 		txConfig:         txConfig,
 		simCheck:         simCheck,
@@ -159,6 +166,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
 		panic(fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Errorf("failed to migrate x/%s from version 3 to 4: %w", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -207,6 +217,16 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 	am.keeper.Logger().Info(fmt.Sprintf("Last executed Height: %d", lastExecutedHeight))
 	am.keeper.Logger().Info(fmt.Sprintf("Latest height from fairyring: %s", strHeight))
 
+	suite := bls.NewBLS12381Suite()
+	params := am.keeper.GetParams(ctx)
+	budget := &encryptedTxBudget{
+		maxGas: params.MaxEncryptedTxGasPerBlock,
+		maxTxs: params.MaxEncryptedTxPerBlock,
+	}
+
+	// encrypted txs carried over from the previous blocks are executed first
+	am.processEncryptedTxQueue(ctx, suite, budget)
+
 	activePubkey, found := am.keeper.GetActivePubKey(ctx)
 	if !found {
 		am.keeper.Logger().Error("Active public key does not exists")
@@ -218,8 +238,6 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 		return nil
 	}
 
-	suite := bls.NewBLS12381Suite()
-
 	publicKeyPoint, err := am.keeper.GetPubKeyPoint(activePubkey.PublicKey, suite)
 	if err != nil {
 		am.keeper.Logger().Error("Unabe to get Pubkey Point with suite")
@@ -236,8 +254,8 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 			am.keeper.Logger().Error(fmt.Sprintf("Decryption key not found for block height: %d, Removing all the encrypted txs...", h))
 			encryptedTxs := am.keeper.GetEncryptedTxAllFromHeight(ctx, h)
 			if len(encryptedTxs.EncryptedTx) > 0 {
-				am.keeper.SetAllEncryptedTxExpired(ctx, h)
-				am.keeper.Logger().Info(fmt.Sprintf("Updated total %d encrypted txs at block %d to expired", len(encryptedTxs.EncryptedTx), h))
+				refunded := am.keeper.ExpireEncryptedTxsFromHeight(ctx, h)
+				am.keeper.Logger().Info(fmt.Sprintf("Updated total %d encrypted txs at block %d to expired, refunded: %s", len(encryptedTxs.EncryptedTx), h, refunded.String()))
 				indexes := make([]string, len(encryptedTxs.EncryptedTx))
 				for _, v := range encryptedTxs.EncryptedTx {
 					indexes = append(indexes, strconv.FormatUint(v.Index, 10))
@@ -275,11 +293,25 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 			continue
 		}
 
+		if params.EncryptedTxOrdering == types.EncryptedTxOrderingRandomized {
+			arr = am.orderEncryptedTxs(ctx, h, arr, key.Data)
+		}
+
 		for _, eachTx := range arr.EncryptedTx {
+			if eachTx.Cancelled {
+				continue
+			}
+
+			tx := convertEncTxToDecryptionTx(eachTx)
+			if budget.exhausted() {
+				am.enqueueEncryptedTx(ctx, tx, eachTx.TargetHeight, activePubkey.PublicKey, key.Data)
+				continue
+			}
+
 			startConsumedGas := ctx.GasMeter().GasConsumed()
 			am.keeper.SetEncryptedTxProcessedHeight(ctx, eachTx.TargetHeight, eachTx.Index, uint64(ctx.BlockHeight()))
-			tx := convertEncTxToDecryptionTx(eachTx)
 			err := am.decryptAndExecuteTx(ctx, tx, startConsumedGas, publicKeyPoint, skPoint)
+			budget.consume(ctx.GasMeter().GasConsumed() - startConsumedGas)
 			if err != nil {
 				continue
 			}
@@ -290,9 +322,17 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 	// loop over all entries in the general enc tx queue
 	entries := am.keeper.GetAllGenEncTxExecutionQueueEntry(ctx)
 	for _, entry := range entries {
+		if entry.Expired {
+			am.keeper.Logger().Info(fmt.Sprintf("Decryption key delivered after the expiry of entry with req-id: %s", entry.RequestId))
+			am.keeper.RemoveExecutionQueueEntry(ctx, entry.RequestId)
+			continue
+		}
+
 		if entry.AggrKeyshare == "" {
 			am.keeper.Logger().Error("aggregated keyshare not found in entry with req-id: ", entry.RequestId)
-			am.keeper.RemoveExecutionQueueEntry(ctx, entry.Identity)
+			refunded := am.keeper.RefundGeneralEncryptedTxs(ctx, entry.RequestId)
+			am.keeper.Logger().Info(fmt.Sprintf("Refunded %s for encrypted txs of entry with req-id: %s", refunded.String(), entry.RequestId))
+			am.keeper.RemoveExecutionQueueEntry(ctx, entry.RequestId)
 			continue
 		}
 
@@ -329,10 +369,19 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 
 		// loop over all txs in the entry
 		for _, eachTx := range entry.TxList.EncryptedTx {
-			startConsumedGas := ctx.GasMeter().GasConsumed()
+			if eachTx.Cancelled {
+				continue
+			}
 
 			tx := convertGenEncTxToDecryptionTx(eachTx)
+			if budget.exhausted() {
+				am.enqueueEncryptedTx(ctx, tx, 0, activePubkey.PublicKey, entry.AggrKeyshare)
+				continue
+			}
+
+			startConsumedGas := ctx.GasMeter().GasConsumed()
 			err := am.decryptAndExecuteTx(ctx, tx, startConsumedGas, publicKeyPoint, skPoint)
+			budget.consume(ctx.GasMeter().GasConsumed() - startConsumedGas)
 			if err != nil {
 				continue
 			}
@@ -346,6 +395,117 @@ func (am AppModule) BeginBlock(cctx context.Context) error {
 	return nil
 }
 
+// orderEncryptedTxs permutes the encrypted txs of a target height with a seed derived from its aggregated key
+// and records the permutation, the txs are kept in submission order if the key can not be decoded
+func (am AppModule) orderEncryptedTxs(ctx sdk.Context, height uint64, arr types.EncryptedTxArray, aggrKey string) types.EncryptedTxArray {
+	if len(arr.EncryptedTx) == 0 {
+		return arr
+	}
+
+	order, err := am.keeper.ComputeEncryptedTxOrder(ctx, height, aggrKey)
+	if err != nil {
+		am.keeper.Logger().Error(fmt.Sprintf("Unable to order the encrypted txs of block %d: %s", height, err.Error()))
+		return arr
+	}
+	am.keeper.SetEncryptedTxOrder(ctx, order)
+
+	byIndex := make(map[uint64]types.EncryptedTx, len(arr.EncryptedTx))
+	for _, encTx := range arr.EncryptedTx {
+		byIndex[encTx.Index] = encTx
+	}
+
+	ordered := types.EncryptedTxArray{EncryptedTx: make([]types.EncryptedTx, 0, len(order.Indexes))}
+	indexes := make([]string, 0, len(order.Indexes))
+	for _, index := range order.Indexes {
+		ordered.EncryptedTx = append(ordered.EncryptedTx, byIndex[index])
+		indexes = append(indexes, strconv.FormatUint(index, 10))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EncryptedTxOrderedEventType,
+			sdk.NewAttribute(types.EncryptedTxOrderedEventHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.EncryptedTxOrderedEventSeed, order.Seed),
+			sdk.NewAttribute(types.EncryptedTxOrderedEventTxIDs, strings.Join(indexes, ",")),
+		),
+	)
+
+	return ordered
+}
+
+// encryptedTxBudget tracks the gas used and the number of encrypted txs executed in the current block
+type encryptedTxBudget struct {
+	maxGas  uint64
+	maxTxs  uint64
+	gasUsed uint64
+	txCount uint64
+}
+
+// exhausted returns true when no more encrypted txs are allowed to be executed in the current block
+func (b *encryptedTxBudget) exhausted() bool {
+	return (b.maxGas > 0 && b.gasUsed >= b.maxGas) || (b.maxTxs > 0 && b.txCount >= b.maxTxs)
+}
+
+// consume records the execution of an encrypted tx that used the given amount of gas
+func (b *encryptedTxBudget) consume(gas uint64) {
+	b.gasUsed += gas
+	b.txCount++
+}
+
+// enqueueEncryptedTx stores an encrypted tx that does not fit in the current block budget
+// with everything needed to decrypt it, so it can be executed in one of the following blocks
+func (am AppModule) enqueueEncryptedTx(ctx sdk.Context, tx DecryptionTx, targetHeight uint64, pubkey string, aggrKeyshare string) {
+	sequence := am.keeper.EnqueueEncryptedTx(ctx, types.EncryptedTxQueueEntry{
+		Identity:     tx.Identity,
+		TargetHeight: targetHeight,
+		Index:        tx.Index,
+		Data:         tx.Data,
+		Creator:      tx.Creator,
+		ChargedGas:   tx.ChargedGas,
+		Pubkey:       pubkey,
+		AggrKeyshare: aggrKeyshare,
+	})
+	am.keeper.Logger().Info(fmt.Sprintf("Encrypted tx budget exhausted, queued tx with identity: %s, index: %d at sequence %d", tx.Identity, tx.Index, sequence))
+}
+
+// processEncryptedTxQueue executes the carried over encrypted txs in the order they were queued
+// until the block budget is exhausted
+func (am AppModule) processEncryptedTxQueue(ctx sdk.Context, suite pairing.Suite, budget *encryptedTxBudget) {
+	for !budget.exhausted() {
+		entry, found := am.keeper.GetFirstEncryptedTxQueueEntry(ctx)
+		if !found {
+			return
+		}
+
+		am.keeper.RemoveEncryptedTxQueueEntry(ctx, entry.Sequence)
+
+		tx := convertQueueEntryToDecryptionTx(entry)
+		startConsumedGas := ctx.GasMeter().GasConsumed()
+
+		publicKeyPoint, err := am.keeper.GetPubKeyPoint(entry.Pubkey, suite)
+		if err != nil {
+			am.processFailedEncryptedTx(ctx, tx, fmt.Sprintf("error parsing queued tx public key: %s", err.Error()), startConsumedGas)
+			continue
+		}
+
+		skPoint, err := am.keeper.GetSKPoint(entry.AggrKeyshare, suite)
+		if err != nil {
+			am.processFailedEncryptedTx(ctx, tx, fmt.Sprintf("error parsing queued tx decryption key: %s", err.Error()), startConsumedGas)
+			continue
+		}
+
+		if entry.TargetHeight != 0 {
+			am.keeper.SetEncryptedTxProcessedHeight(ctx, entry.TargetHeight, entry.Index, uint64(ctx.BlockHeight()))
+		}
+
+		err = am.decryptAndExecuteTx(ctx, tx, startConsumedGas, publicKeyPoint, skPoint)
+		budget.consume(ctx.GasMeter().GasConsumed() - startConsumedGas)
+		if err != nil {
+			continue
+		}
+		telemetry.IncrCounter(1, types.KeyTotalSuccessEncryptedTx)
+	}
+}
+
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
 func (am AppModule) EndBlock(cctx context.Context) error {
@@ -358,6 +518,9 @@ func (am AppModule) EndBlock(cctx context.Context) error {
 			am.keeper.Logger().Error(err.Error())
 		}
 	}
+
+	am.keeper.PruneHistoricalData(ctx)
+
 	strHeight := am.keeper.GetLatestHeight(ctx)
 	height, err := strconv.ParseUint(strHeight, 10, 64)
 	if err != nil {
@@ -365,6 +528,11 @@ func (am AppModule) EndBlock(cctx context.Context) error {
 		return nil
 	}
 
+	refunded := am.keeper.ExpireGeneralEncryptedTxRequests(ctx, height)
+	if !refunded.IsZero() {
+		am.keeper.Logger().Info(fmt.Sprintf("Refunded %s for expired general encrypted tx requests", refunded.String()))
+	}
+
 	ak, found := am.keeper.GetActivePubKey(ctx)
 	if found {
 		if ak.Expiry <= height {
@@ -464,17 +632,20 @@ func (am AppModule) IsAppModule() {}
 
 type DecryptionTx struct {
 	Identity               string
+	TargetHeight           uint64
 	Index                  uint64
 	Data                   string
 	Creator                string
 	ChargedGas             *sdk.Coin
 	ProcessedAtChainHeight uint64
 	Expired                bool
+	MsgTypes               []string
 }
 
 func convertEncTxToDecryptionTx(tx types.EncryptedTx) DecryptionTx {
 	dtx := DecryptionTx{
 		Identity:               strconv.FormatUint(tx.TargetHeight, 10),
+		TargetHeight:           tx.TargetHeight,
 		Index:                  tx.Index,
 		Data:                   tx.Data,
 		Creator:                tx.Creator,
@@ -496,13 +667,35 @@ func convertGenEncTxToDecryptionTx(tx types.GeneralEncryptedTx) DecryptionTx {
 	return dtx
 }
 
-func (am AppModule) handleGasConsumption(ctx sdk.Context, recipient sdk.AccAddress, gasUsed cosmosmath.Int, gasCharged *sdk.Coin) {
+func convertQueueEntryToDecryptionTx(entry types.EncryptedTxQueueEntry) DecryptionTx {
+	dtx := DecryptionTx{
+		Identity:     entry.Identity,
+		TargetHeight: entry.TargetHeight,
+		Index:        entry.Index,
+		Data:         entry.Data,
+		Creator:      entry.Creator,
+		ChargedGas:   entry.ChargedGas,
+	}
+	return dtx
+}
+
+// handleGasConsumption charges the gas consumed by a failed encrypted tx and returns
+// the fee finally charged to the creator and the amount refunded from the escrow
+func (am AppModule) handleGasConsumption(
+	ctx sdk.Context,
+	recipient sdk.AccAddress,
+	gasUsed cosmosmath.Int,
+	gasCharged *sdk.Coin,
+) (feeCharged sdk.Coin, feeRefunded sdk.Coin) {
 	creatorAccount := am.accountKeeper.GetAccount(ctx, recipient)
 
 	if gasCharged == nil {
-		gasCharged = &sdk.Coin{}
+		gasCharged = &sdk.Coin{Amount: cosmosmath.ZeroInt()}
 	}
 
+	feeCharged = *gasCharged
+	feeRefunded = sdk.Coin{Denom: gasCharged.Denom, Amount: cosmosmath.ZeroInt()}
+
 	if gasUsed.GT(gasCharged.Amount) {
 		deductFeeErr := ante.DeductFees(
 			am.bankKeeper,
@@ -519,12 +712,13 @@ func (am AppModule) handleGasConsumption(ctx sdk.Context, recipient sdk.AccAddre
 			am.keeper.Logger().Error(deductFeeErr.Error())
 		} else {
 			am.keeper.Logger().Info("failed tx fee deducted without error")
+			feeCharged.Amount = gasUsed
 		}
 	} else {
 		amount := gasCharged.Amount.Sub(gasUsed)
 		if amount.IsZero() {
 			am.keeper.Logger().Info("refund failed tx fee amount is zero, no need to refund...")
-			return
+			return feeCharged, feeRefunded
 		}
 		refundFeeErr := am.bankKeeper.SendCoinsFromModuleToAccount(
 			ctx,
@@ -537,8 +731,12 @@ func (am AppModule) handleGasConsumption(ctx sdk.Context, recipient sdk.AccAddre
 			am.keeper.Logger().Error(refundFeeErr.Error())
 		} else {
 			am.keeper.Logger().Info("failed tx fee refunded without error")
+			feeCharged.Amount = gasUsed
+			feeRefunded.Amount = amount
 		}
 	}
+
+	return feeCharged, feeRefunded
 }
 
 type UnderlyingTxEvent struct {
@@ -546,18 +744,20 @@ type UnderlyingTxEvent struct {
 	Attributes []EventAttribute `json:"attributes"`
 }
 
+type UnderlyingMsgResult struct {
+	MsgIndex uint64              `json:"msg_index"`
+	MsgType  string              `json:"msg_type"`
+	Events   []UnderlyingTxEvent `json:"events"`
+}
+
 type EventAttribute struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 	Index bool   `json:"index"`
 }
 
-func (am AppModule) processFailedEncryptedTx(
-	ctx sdk.Context,
-	tx DecryptionTx,
-	failReason string,
-	startConsumedGas uint64,
-) {
+// revertEncryptedTx emits the reverted event for an encrypted tx that could not be executed
+func (am AppModule) revertEncryptedTx(ctx sdk.Context, tx DecryptionTx, failReason string) {
 	am.keeper.Logger().Error(fmt.Sprintf("failed to process encrypted tx: %s", failReason))
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EncryptedTxRevertedEventType,
@@ -567,20 +767,213 @@ func (am AppModule) processFailedEncryptedTx(
 			sdk.NewAttribute(types.EncryptedTxRevertedEventIndex, strconv.FormatUint(tx.Index, 10)),
 		),
 	)
+	telemetry.IncrCounter(1, types.KeyTotalFailedEncryptedTx)
+}
 
-	creatorAddr, err := sdk.AccAddressFromBech32(tx.Creator)
-	if err != nil {
-		am.keeper.Logger().Error("error while trying to parse tx creator address when processing failed encrypted tx")
-		am.keeper.Logger().Error(err.Error())
+// settleUnderlyingTxFee charges the gas used by the underlying tx at the gas price it declared.
+// When the underlying tx declares a fee payer or a fee granter other than the creator, the fee is
+// charged to them and the escrow of the creator is refunded. Otherwise, the escrowed charged gas
+// is used first, any remaining fee is deducted from the creator account, or the unused part of
+// the escrow is refunded.
+// It returns the fee finally charged and the amount refunded from the escrow.
+func (am AppModule) settleUnderlyingTxFee(
+	ctx sdk.Context,
+	eachTx DecryptionTx,
+	creatorAccount sdk.AccountI,
+	feeTx sdk.FeeTx,
+	gasUsed uint64,
+) (feeCharged sdk.Coin, feeRefunded sdk.Coin) {
+	refundAmount := cosmosmath.NewIntFromUint64(0)
+	txFee := feeTx.GetFee()[0]
+
+	usedGasFee := sdk.NewCoin(
+		txFee.Denom,
+		// Tx Fee Amount Divide Provide Gas => provided gas price
+		// Provided Gas Price * Gas Used => Amount to deduct as gas fee
+		txFee.Amount.Quo(cosmosmath.NewIntFromUint64(feeTx.GetGas())).Mul(cosmosmath.NewIntFromUint64(gasUsed)),
+	)
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	feeGranter := sdk.AccAddress(feeTx.FeeGranter())
+	if !feeGranter.Empty() || !feePayer.Equals(creatorAccount.GetAddress()) {
+		charged, refunded, err := am.chargeUnderlyingTxFeePayer(ctx, eachTx, creatorAccount, feePayer, feeGranter, usedGasFee, feeTx.GetMsgs())
+		if err == nil {
+			return charged, refunded
+		}
+		am.keeper.Logger().Error(fmt.Sprintf("unable to charge underlying tx fee payer, charging the creator instead: %s", err.Error()))
+	}
+
+	feeCharged = *eachTx.ChargedGas
+	feeRefunded = sdk.Coin{Denom: txFee.Denom, Amount: cosmosmath.ZeroInt()}
+	totalFee := usedGasFee.Amount
+
+	if usedGasFee.Amount.GT(eachTx.ChargedGas.Amount) {
+		usedGasFee.Amount = usedGasFee.Amount.Sub(eachTx.ChargedGas.Amount)
+	} else { // less than or equals to
+		refundAmount = eachTx.ChargedGas.Amount.Sub(usedGasFee.Amount)
+		usedGasFee.Amount = cosmosmath.NewIntFromUint64(0)
+	}
+
+	am.keeper.Logger().Info(fmt.Sprintf("Deduct fee amount: %v | Refund amount: %v", usedGasFee, refundAmount))
+
+	if refundAmount.IsZero() {
+		if usedGasFee.Amount.IsZero() {
+			return feeCharged, feeRefunded
+		}
+		deductFeeErr := ante.DeductFees(am.bankKeeper, ctx, creatorAccount, sdk.NewCoins(usedGasFee))
+		if deductFeeErr != nil {
+			am.keeper.Logger().Error("Deduct fee Err")
+			am.keeper.Logger().Error(deductFeeErr.Error())
+		} else {
+			am.keeper.Logger().Info("Fee deducted without error")
+			feeCharged.Amount = totalFee
+		}
+		return feeCharged, feeRefunded
+	}
+
+	refundFeeErr := am.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		creatorAccount.GetAddress(),
+		sdk.NewCoins(sdk.NewCoin(txFee.Denom, refundAmount)),
+	)
+	if refundFeeErr != nil {
+		am.keeper.Logger().Error("Refund fee Err")
+		am.keeper.Logger().Error(refundFeeErr.Error())
+	} else {
+		am.keeper.Logger().Info("Fee refunded without error")
+		feeCharged.Amount = totalFee
+		feeRefunded.Amount = refundAmount
+	}
+
+	return feeCharged, feeRefunded
+}
+
+// chargeUnderlyingTxFeePayer deducts the fee of the underlying tx from its fee payer, or from its
+// fee granter through x/feegrant, then refunds the escrow of the creator.
+// No state is changed when the fee could not be charged.
+func (am AppModule) chargeUnderlyingTxFeePayer(
+	ctx sdk.Context,
+	eachTx DecryptionTx,
+	creatorAccount sdk.AccountI,
+	feePayer sdk.AccAddress,
+	feeGranter sdk.AccAddress,
+	fee sdk.Coin,
+	msgs []sdk.Msg,
+) (feeCharged sdk.Coin, feeRefunded sdk.Coin, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	deductFrom := feePayer
+	if !feeGranter.Empty() {
+		if am.feegrantKeeper == nil {
+			return feeCharged, feeRefunded, errors.New("fee grants are not enabled")
+		}
+		if !feeGranter.Equals(feePayer) {
+			if err := am.feegrantKeeper.UseGrantedFees(cacheCtx, feeGranter, feePayer, sdk.NewCoins(fee), msgs); err != nil {
+				return feeCharged, feeRefunded, err
+			}
+		}
+		deductFrom = feeGranter
+	}
+
+	deductFromAccount := am.accountKeeper.GetAccount(cacheCtx, deductFrom)
+	if deductFromAccount == nil {
+		return feeCharged, feeRefunded, fmt.Errorf("fee payer account not found: %s", deductFrom.String())
+	}
+
+	if fee.IsPositive() {
+		if err := ante.DeductFees(am.bankKeeper, cacheCtx, deductFromAccount, sdk.NewCoins(fee)); err != nil {
+			return feeCharged, feeRefunded, err
+		}
+	}
+
+	feeRefunded = sdk.Coin{Denom: eachTx.ChargedGas.Denom, Amount: cosmosmath.ZeroInt()}
+	if eachTx.ChargedGas.IsPositive() {
+		if err := am.bankKeeper.SendCoinsFromModuleToAccount(
+			cacheCtx,
+			types.ModuleName,
+			creatorAccount.GetAddress(),
+			sdk.NewCoins(*eachTx.ChargedGas),
+		); err != nil {
+			return feeCharged, feeRefunded, err
+		}
+		feeRefunded = *eachTx.ChargedGas
+	}
+
+	writeCache()
+	am.keeper.Logger().Info(fmt.Sprintf("Fee %s charged to %s, escrow %s refunded to creator", fee.String(), deductFrom.String(), feeRefunded.String()))
+
+	return fee, feeRefunded, nil
+}
+
+// recordExecutionResult stores the outcome of an encrypted tx, so it remains queryable
+// after the events of the block are no longer available
+func (am AppModule) recordExecutionResult(
+	ctx sdk.Context,
+	tx DecryptionTx,
+	status string,
+	failReason string,
+	gasUsed uint64,
+	feeCharged sdk.Coin,
+	feeRefunded sdk.Coin,
+) {
+	am.keeper.SetEncryptedTxResult(ctx, types.EncryptedTxExecutionResult{
+		Identity:              tx.Identity,
+		TargetHeight:          tx.TargetHeight,
+		Index:                 tx.Index,
+		Creator:               tx.Creator,
+		Status:                status,
+		FailReason:            failReason,
+		GasUsed:               gasUsed,
+		FeeCharged:            &feeCharged,
+		FeeRefunded:           &feeRefunded,
+		MsgTypes:              tx.MsgTypes,
+		ExecutedAtChainHeight: uint64(ctx.BlockHeight()),
+	})
+}
+
+// fundKeyshareRewardPool sends the keyshare reward share of the escrow kept by the pep module
+// once the fee of an encrypted tx is settled
+func (am AppModule) fundKeyshareRewardPool(ctx sdk.Context, tx DecryptionTx, feeRefunded sdk.Coin) {
+	if tx.ChargedGas == nil || !tx.ChargedGas.IsPositive() {
 		return
 	}
 
+	kept := *tx.ChargedGas
+	if feeRefunded.Denom == kept.Denom {
+		if feeRefunded.Amount.GTE(kept.Amount) {
+			return
+		}
+		kept = kept.Sub(feeRefunded)
+	}
+
+	am.keeper.FundKeyshareRewardPool(ctx, kept)
+}
+
+func (am AppModule) processFailedEncryptedTx(
+	ctx sdk.Context,
+	tx DecryptionTx,
+	failReason string,
+	startConsumedGas uint64,
+) {
+	am.revertEncryptedTx(ctx, tx, failReason)
+
 	var actualGasConsumed uint64 = 0
 	if ctx.GasMeter().GasConsumed() > startConsumedGas {
 		actualGasConsumed = ctx.GasMeter().GasConsumed() - startConsumedGas
 	}
-	defer telemetry.IncrCounter(1, types.KeyTotalFailedEncryptedTx)
-	am.handleGasConsumption(ctx, creatorAddr, cosmosmath.NewIntFromUint64(actualGasConsumed), tx.ChargedGas)
+
+	creatorAddr, err := sdk.AccAddressFromBech32(tx.Creator)
+	if err != nil {
+		am.keeper.Logger().Error("error while trying to parse tx creator address when processing failed encrypted tx")
+		am.keeper.Logger().Error(err.Error())
+		am.recordExecutionResult(ctx, tx, types.EncryptedTxStatusReverted, failReason, actualGasConsumed, sdk.Coin{}, sdk.Coin{})
+		return
+	}
+
+	feeCharged, feeRefunded := am.handleGasConsumption(ctx, creatorAddr, cosmosmath.NewIntFromUint64(actualGasConsumed), tx.ChargedGas)
+	am.fundKeyshareRewardPool(ctx, tx, feeRefunded)
+	am.recordExecutionResult(ctx, tx, types.EncryptedTxStatusReverted, failReason, actualGasConsumed, feeCharged, feeRefunded)
 }
 
 func (am AppModule) decryptAndExecuteTx(
@@ -590,13 +983,6 @@ func (am AppModule) decryptAndExecuteTx(
 	publicKeyPoint kyber.Point,
 	skPoint kyber.Point,
 ) error {
-	if currentNonce, found := am.keeper.GetPepNonce(ctx, eachTx.Creator); found && currentNonce.Nonce == math.MaxUint64 {
-		am.processFailedEncryptedTx(ctx, eachTx, "invalid pep nonce", startConsumedGas)
-		return errors.New("invalid pep nonce")
-	}
-
-	newExecutedNonce := am.keeper.IncreasePepNonce(ctx, eachTx.Creator)
-
 	creatorAddr, err := sdk.AccAddressFromBech32(eachTx.Creator)
 	if err != nil {
 		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error parsing creator address: %s", err.Error()), startConsumedGas)
@@ -659,17 +1045,16 @@ func (am AppModule) decryptAndExecuteTx(
 		return err
 	}
 
+	for _, msg := range wrappedTx.GetTx().GetMsgs() {
+		eachTx.MsgTypes = append(eachTx.MsgTypes, sdk.MsgTypeURL(msg))
+	}
+
 	sigs, err := wrappedTx.GetTx().GetSignaturesV2()
 	if err != nil {
 		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error getting decoded tx signatures: %s", err.Error()), startConsumedGas)
 		return err
 	}
 
-	if len(sigs) != 1 {
-		am.processFailedEncryptedTx(ctx, eachTx, "number of provided signatures is more than one", startConsumedGas)
-		return err
-	}
-
 	signers, err := wrappedTx.GetTx().GetSigners()
 	if err != nil {
 		am.processFailedEncryptedTx(ctx, eachTx, "not able to get signature signers", startConsumedGas)
@@ -678,31 +1063,56 @@ func (am AppModule) decryptAndExecuteTx(
 
 	if len(sigs) != len(signers) {
 		am.processFailedEncryptedTx(ctx, eachTx, "number of signature not equals to number of signers", startConsumedGas)
-		return err
+		return errors.New("number of signature not equals to number of signers")
+	}
+
+	// The underlying tx is signed by exactly one signer, and by its fee payer when the tx declares
+	// another account as fee payer, which is always the last of the signers
+	feePayerSigned := len(signers) == 2 && sdk.AccAddress(signers[1]).Equals(sdk.AccAddress(wrappedTx.GetTx().FeePayer()))
+	if len(signers) != 1 && !feePayerSigned {
+		am.processFailedEncryptedTx(ctx, eachTx, "underlying tx must be signed by exactly one signer, use a multisig account for multiple parties", startConsumedGas)
+		return errors.New("invalid number of signatures")
 	}
 
 	txMsgs := wrappedTx.GetTx().GetMsgs()
 
-	if len(sigs) != len(txMsgs) {
-		am.processFailedEncryptedTx(ctx, eachTx, "number of provided signatures is not equals to number of tx messages", startConsumedGas)
-		return err
+	if len(txMsgs) == 0 {
+		am.processFailedEncryptedTx(ctx, eachTx, "underlying tx does not contain any message", startConsumedGas)
+		return errors.New("underlying tx does not contain any message")
 	}
 
-	if !sigs[0].PubKey.Equals(creatorAccount.GetPubKey()) {
-		am.processFailedEncryptedTx(ctx, eachTx, "tx signer is not tx sender", startConsumedGas)
+	// The underlying tx signer does not have to be the encrypted tx creator, the signer can be
+	// a legacy amino multisig account while one of its members submits the encrypted tx
+	signerAddr := sdk.AccAddress(signers[0])
+	signerAccount := am.accountKeeper.GetAccount(ctx, signerAddr)
+	if signerAccount == nil {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("tx signer account not found: %s", signerAddr.String()), startConsumedGas)
+		return errors.New("tx signer account not found")
+	}
+
+	signerPubKey, err := underlyingTxSignerPubKey(signerAccount, sigs[0])
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, err.Error(), startConsumedGas)
 		return err
 	}
 
+	// pep nonces are tracked for the actual signer of the underlying tx
+	if currentNonce, found := am.keeper.GetPepNonce(ctx, signerAddr.String()); found && currentNonce.Nonce == math.MaxUint64 {
+		am.processFailedEncryptedTx(ctx, eachTx, "invalid pep nonce", startConsumedGas)
+		return errors.New("invalid pep nonce")
+	}
+
+	newExecutedNonce := am.keeper.IncreasePepNonce(ctx, signerAddr.String())
 	expectingNonce := newExecutedNonce - 1
 
 	if sigs[0].Sequence < expectingNonce {
 		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("Incorrect Nonce sequence, Provided: %d, Expecting: %d", sigs[0].Sequence, expectingNonce), startConsumedGas)
-		return err
+		return errors.New("incorrect pep nonce sequence")
 	}
 
 	if sigs[0].Sequence > expectingNonce {
 		am.keeper.SetPepNonce(ctx, types.PepNonce{
-			Address: eachTx.Creator,
+			Address: signerAddr.String(),
 			Nonce:   sigs[0].Sequence,
 		})
 	}
@@ -710,43 +1120,40 @@ func (am AppModule) decryptAndExecuteTx(
 	verifiableTx, ok := wrappedTx.GetTx().(authsigning.V2AdaptableTx)
 	if !ok {
 		am.processFailedEncryptedTx(ctx, eachTx, "Unable to parse tx to V2AdaptableTx", startConsumedGas)
-		return err
+		return errors.New("unable to parse tx to V2AdaptableTx")
 	}
 
-	anyPk, err := cdctypes.NewAnyWithValue(sigs[0].PubKey)
+	err = am.verifyUnderlyingTxSignature(ctx, verifiableTx, signerAccount, signerPubKey, sigs[0])
 	if err != nil {
-		am.processFailedEncryptedTx(ctx, eachTx, "Unable to parse signature public key to anypb.Any", startConsumedGas)
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when verifying signature: invalid signature: %s", err.Error()), startConsumedGas)
 		return err
 	}
 
-	signingData := txsigning.SignerData{
-		Address:       creatorAddr.String(),
-		ChainID:       ctx.ChainID(),
-		AccountNumber: creatorAccount.GetAccountNumber(),
-		Sequence:      sigs[0].Sequence,
-		PubKey: &anypb.Any{
-			TypeUrl: anyPk.TypeUrl,
-			Value:   anyPk.Value,
-		},
-	}
+	// The fee payer signature is verified at the sequence it declares,
+	// a replay of the underlying tx is already rejected by the pep nonce of the signer
+	if feePayerSigned {
+		feePayerAccount := am.accountKeeper.GetAccount(ctx, signers[1])
+		if feePayerAccount == nil {
+			am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("tx fee payer account not found: %s", sdk.AccAddress(signers[1]).String()), startConsumedGas)
+			return errors.New("tx fee payer account not found")
+		}
 
-	err = authsigning.VerifySignature(
-		ctx,
-		creatorAccount.GetPubKey(),
-		signingData,
-		sigs[0].Data,
-		am.txConfig.SignModeHandler(),
-		verifiableTx.GetSigningTxData(),
-	)
+		feePayerPubKey, err := underlyingTxSignerPubKey(feePayerAccount, sigs[1])
+		if err != nil {
+			am.processFailedEncryptedTx(ctx, eachTx, err.Error(), startConsumedGas)
+			return err
+		}
 
-	if err != nil {
-		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when verifying signature: invalid signature: %s", err.Error()), startConsumedGas)
-		return err
+		err = am.verifyUnderlyingTxSignature(ctx, verifiableTx, feePayerAccount, feePayerPubKey, sigs[1])
+		if err != nil {
+			am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when verifying fee payer signature: invalid signature: %s", err.Error()), startConsumedGas)
+			return err
+		}
 	}
 
 	decryptionConsumed := ctx.GasMeter().GasConsumed() - startConsumedGas
-	simCheckGas, _, err := am.simCheck(am.txConfig.TxEncoder(), txDecoderTx)
-	// We are using SimCheck() to only estimate gas for the underlying transaction
+	_, _, err = am.simCheck(am.txConfig.TxEncoder(), txDecoderTx)
+	// We are using SimCheck() to only validate the underlying transaction
 	// Since user is supposed to sign the underlying transaction with Pep Nonce,
 	// is expected that we gets 'account sequence mismatch' error
 	// however, the underlying tx is not expected to get other errors
@@ -757,93 +1164,67 @@ func (am AppModule) decryptAndExecuteTx(
 	}
 
 	txFee := wrappedTx.GetTx().GetFee()
+	gasLimit := wrappedTx.GetTx().GetGas()
 
-	// If it passes the CheckTx but Tx Fee is empty,
-	// that means the minimum-gas-prices for the validator is 0
-	// therefore, we are not charging for the tx execution
-	if !txFee.Empty() {
-		gasProvided := cosmosmath.NewIntFromUint64(wrappedTx.GetTx().GetGas())
-		// Underlying tx consumed gas + gas consumed on decrypting & decoding tx
-		am.keeper.Logger().Info(fmt.Sprintf("Underlying tx consumed: %d, decryption consumed: %d", simCheckGas.GasUsed, decryptionConsumed))
-		gasUsedInBig := cosmosmath.NewIntFromUint64(simCheckGas.GasUsed).Add(cosmosmath.NewIntFromUint64(decryptionConsumed))
-		newCoins := make([]sdk.Coin, len(txFee))
-		refundDenom := txFee[0].Denom
-		refundAmount := cosmosmath.NewIntFromUint64(0)
-
-		usedGasFee := sdk.NewCoin(
-			txFee[0].Denom,
-			// Tx Fee Amount Divide Provide Gas => provided gas price
-			// Provided Gas Price * Gas Used => Amount to deduct as gas fee
-			txFee[0].Amount.Quo(gasProvided).Mul(gasUsedInBig),
-		)
-
-		if eachTx.ChargedGas == nil {
-			eachTx.ChargedGas = &sdk.Coin{}
-		}
+	if eachTx.ChargedGas == nil {
+		eachTx.ChargedGas = &sdk.Coin{}
+	}
 
-		if usedGasFee.Denom != eachTx.ChargedGas.Denom {
-			am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("underlying tx gas denom does not match charged gas denom, got: %s, expect: %s", usedGasFee.Denom, eachTx.ChargedGas.Denom), startConsumedGas)
-			return errors.New("underlying tx gas denom does not match charged gas denom")
-		}
+	if !txFee.Empty() && txFee[0].Denom != eachTx.ChargedGas.Denom {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("underlying tx gas denom does not match charged gas denom, got: %s, expect: %s", txFee[0].Denom, eachTx.ChargedGas.Denom), startConsumedGas)
+		return errors.New("underlying tx gas denom does not match charged gas denom")
+	}
 
-		if usedGasFee.Amount.GT(eachTx.ChargedGas.Amount) {
-			usedGasFee.Amount = usedGasFee.Amount.Sub(eachTx.ChargedGas.Amount)
-		} else { // less than or equals to
-			refundAmount = eachTx.ChargedGas.Amount.Sub(usedGasFee.Amount)
-			usedGasFee.Amount = cosmosmath.NewIntFromUint64(0)
-		}
+	if decryptionConsumed >= gasLimit {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("out of gas: decryption consumed %d, gas limit %d", decryptionConsumed, gasLimit), startConsumedGas)
+		return errors.New("out of gas while decrypting tx")
+	}
 
-		am.keeper.Logger().Info(fmt.Sprintf("Deduct fee amount: %v | Refund amount: %v", newCoins, refundAmount))
+	// The underlying tx is executed on a branch of the block state with its own gas meter,
+	// capped at the gas limit declared in the underlying tx. Gas spent on decrypting and
+	// decoding the tx is counted against the same limit.
+	execCtx, writeCache := ctx.CacheContext()
+	execGasMeter := storetypes.NewGasMeter(gasLimit)
+	execGasMeter.ConsumeGas(decryptionConsumed, "decrypt encrypted tx")
+	execCtx = execCtx.WithGasMeter(execGasMeter)
+
+	msgResults, execErr := am.executeUnderlyingMsgs(execCtx, txMsgs)
+	gasUsed := execGasMeter.GasConsumedToLimit()
+
+	am.keeper.Logger().Info(fmt.Sprintf("Underlying tx consumed: %d, decryption consumed: %d", gasUsed-decryptionConsumed, decryptionConsumed))
+	// account the execution gas on the block gas meter as well, so it counts toward the block budget
+	ctx.GasMeter().ConsumeGas(gasUsed-decryptionConsumed, "execute underlying encrypted tx")
+
+	// State changes are only committed when every message of the underlying tx succeeded,
+	// the fee is settled afterward based on the gas actually used either way
+	if execErr == nil {
+		writeCache()
+	}
 
-		if refundAmount.IsZero() {
-			deductFeeErr := ante.DeductFees(am.bankKeeper, ctx, creatorAccount, sdk.NewCoins(usedGasFee))
-			if deductFeeErr != nil {
-				am.keeper.Logger().Error("Deduct fee Err")
-				am.keeper.Logger().Error(deductFeeErr.Error())
-			} else {
-				am.keeper.Logger().Info("Fee deducted without error")
-			}
-		} else {
-			refundFeeErr := am.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.ModuleName,
-				creatorAddr,
-				sdk.NewCoins(sdk.NewCoin(refundDenom, refundAmount)),
-			)
-			if refundFeeErr != nil {
-				am.keeper.Logger().Error("Refund fee Err")
-				am.keeper.Logger().Error(refundFeeErr.Error())
-			} else {
-				am.keeper.Logger().Info("Fee refunded without error")
-			}
-		}
+	// If it passes the CheckTx but Tx Fee is empty,
+	// that means the minimum-gas-prices for the validator is 0
+	// therefore, we are not charging for the tx execution
+	feeCharged, feeRefunded := *eachTx.ChargedGas, sdk.Coin{Denom: eachTx.ChargedGas.Denom, Amount: cosmosmath.ZeroInt()}
+	if !txFee.Empty() {
+		feeCharged, feeRefunded = am.settleUnderlyingTxFee(ctx, eachTx, creatorAccount, wrappedTx.GetTx(), gasUsed)
 	}
+	am.fundKeyshareRewardPool(ctx, eachTx, feeRefunded)
 
-	handler := am.msgServiceRouter.Handler(txMsgs[0])
-	handlerResult, err := handler(ctx, txMsgs[0])
-	if err != nil {
-		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when handling tx message: %s", err.Error()), startConsumedGas)
-		return err
+	if execErr != nil {
+		am.revertEncryptedTx(ctx, eachTx, execErr.Error())
+		am.recordExecutionResult(ctx, eachTx, types.EncryptedTxStatusReverted, execErr.Error(), gasUsed, feeCharged, feeRefunded)
+		return execErr
 	}
 
-	underlyingTxEvents := make([]UnderlyingTxEvent, 0)
+	am.recordExecutionResult(ctx, eachTx, types.EncryptedTxStatusExecuted, "", gasUsed, feeCharged, feeRefunded)
 
-	for _, e := range handlerResult.Events {
-		eventAttributes := make([]EventAttribute, 0)
-		for _, ea := range e.Attributes {
-			eventAttributes = append(eventAttributes, EventAttribute{
-				Key:   ea.Key,
-				Value: ea.Value,
-				Index: ea.Index,
-			})
-		}
-		underlyingTxEvents = append(underlyingTxEvents, UnderlyingTxEvent{
-			Type:       e.Type,
-			Attributes: eventAttributes,
-		})
+	underlyingTxEvents := make([]UnderlyingTxEvent, 0)
+	for _, result := range msgResults {
+		underlyingTxEvents = append(underlyingTxEvents, result.Events...)
 	}
 
 	eventStrArrJson, _ := json.Marshal(underlyingTxEvents)
+	resultStrArrJson, _ := json.Marshal(msgResults)
 
 	am.keeper.Logger().Info("! Encrypted Tx Decrypted & Decoded & Executed successfully !")
 
@@ -855,7 +1236,113 @@ func (am AppModule) decryptAndExecuteTx(
 			sdk.NewAttribute(types.EncryptedTxExecutedEventIndex, strconv.FormatUint(eachTx.Index, 10)),
 			sdk.NewAttribute(types.EncryptedTxExecutedEventMemo, wrappedTx.GetTx().GetMemo()),
 			sdk.NewAttribute(types.EncryptedTxExecutedEventUnderlyingEvents, string(eventStrArrJson)),
+			sdk.NewAttribute(types.EncryptedTxExecutedEventMsgResults, string(resultStrArrJson)),
 		),
 	)
 	return nil
 }
+
+// underlyingTxSignerPubKey returns the public key a signature of the underlying tx is verified with,
+// the public key of the signer account or the one of the signature for accounts that never signed a tx
+// on chain, such as a new multisig
+func underlyingTxSignerPubKey(account sdk.AccountI, sig signing.SignatureV2) (cryptotypes.PubKey, error) {
+	pubKey := account.GetPubKey()
+	if pubKey == nil {
+		pubKey = sig.PubKey
+	}
+
+	if sig.PubKey == nil || !sig.PubKey.Equals(pubKey) || !account.GetAddress().Equals(sdk.AccAddress(pubKey.Address())) {
+		return nil, errors.New("tx signature public key does not match the tx signer")
+	}
+
+	return pubKey, nil
+}
+
+// verifyUnderlyingTxSignature verifies a signature of the underlying tx made by the given account
+func (am AppModule) verifyUnderlyingTxSignature(
+	ctx sdk.Context,
+	verifiableTx authsigning.V2AdaptableTx,
+	account sdk.AccountI,
+	pubKey cryptotypes.PubKey,
+	sig signing.SignatureV2,
+) error {
+	anyPk, err := cdctypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return fmt.Errorf("unable to parse signature public key to anypb.Any: %w", err)
+	}
+
+	signingData := txsigning.SignerData{
+		Address:       account.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      sig.Sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	return authsigning.VerifySignature(
+		ctx,
+		pubKey,
+		signingData,
+		sig.Data,
+		am.txConfig.SignModeHandler(),
+		verifiableTx.GetSigningTxData(),
+	)
+}
+
+// executeUnderlyingMsgs routes every message of the decrypted tx through the msg service router in order.
+// The given context is expected to be a branch of the block state, it is discarded by the caller
+// unless every message succeeded.
+func (am AppModule) executeUnderlyingMsgs(ctx sdk.Context, msgs []sdk.Msg) (results []UnderlyingMsgResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			results = nil
+			err = fmt.Errorf("out of gas in location: %s, gas limit: %d, gas used: %d",
+				outOfGas.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumedToLimit())
+		}
+	}()
+
+	results = make([]UnderlyingMsgResult, 0, len(msgs))
+
+	for i, msg := range msgs {
+		handler := am.msgServiceRouter.Handler(msg)
+		if handler == nil {
+			return nil, fmt.Errorf("no message handler found for message %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		handlerResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("error when handling tx message %d (%s): %s", i, sdk.MsgTypeURL(msg), err.Error())
+		}
+
+		msgEvents := make([]UnderlyingTxEvent, 0)
+		for _, e := range handlerResult.Events {
+			eventAttributes := make([]EventAttribute, 0)
+			for _, ea := range e.Attributes {
+				eventAttributes = append(eventAttributes, EventAttribute{
+					Key:   ea.Key,
+					Value: ea.Value,
+					Index: ea.Index,
+				})
+			}
+			msgEvents = append(msgEvents, UnderlyingTxEvent{
+				Type:       e.Type,
+				Attributes: eventAttributes,
+			})
+		}
+
+		results = append(results, UnderlyingMsgResult{
+			MsgIndex: uint64(i),
+			MsgType:  sdk.MsgTypeURL(msg),
+			Events:   msgEvents,
+		})
+	}
+
+	return results, nil
+}
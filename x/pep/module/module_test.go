@@ -0,0 +1,314 @@
+package pep
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	enc "github.com/FairBlock/DistributedIBE/encryption"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Fairblock/fairyring/testutil/keeper"
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/testutil/sample"
+	"github.com/Fairblock/fairyring/testutil/shares"
+	"github.com/Fairblock/fairyring/x/pep/keeper"
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+const (
+	testDenom        = "ufairy"
+	testTargetHeight = 10
+	testGasLimit     = 200000
+	// gas price of 1, the fee charged for the underlying tx equals its gas used
+	testTxFee = 200000
+	// pep nonce expected from a signer executing its first encrypted tx
+	testPepNonce = 1
+
+	testEscrow     = 300000
+	testFeeBalance = 1000000
+	testSent       = 1
+)
+
+type underlyingTxFixture struct {
+	am             AppModule
+	ctx            sdk.Context
+	keeper         keeper.Keeper
+	accountKeeper  authkeeper.AccountKeeper
+	bankKeeper     bankkeeper.BaseKeeper
+	feegrantKeeper feegrantkeeper.Keeper
+	txConfig       client.TxConfig
+}
+
+func newUnderlyingTxFixture(t *testing.T) underlyingTxFixture {
+	k, ctx, accountKeeper, bankKeeper, feegrantKeeper := keepertest.PepKeeperWithAccounts(t)
+	ctx = ctx.WithChainID("fairyring").WithBlockHeight(testTargetHeight)
+	require.NoError(t, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(bankKeeper))
+
+	// the ante handler is out of the scope of the module, the underlying tx always passes the check
+	simCheck := func(sdk.TxEncoder, sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
+		return sdk.GasInfo{}, nil, nil
+	}
+
+	return underlyingTxFixture{
+		am:             NewAppModule(encCfg.Codec, k, accountKeeper, bankKeeper, feegrantKeeper, router, encCfg.TxConfig, simCheck),
+		ctx:            ctx,
+		keeper:         k,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		feegrantKeeper: feegrantKeeper,
+		txConfig:       encCfg.TxConfig,
+	}
+}
+
+// fundAccount creates the account and mints it the given amount
+func (f underlyingTxFixture) fundAccount(t *testing.T, addr sdk.AccAddress, amount int64) {
+	f.accountKeeper.SetAccount(f.ctx, f.accountKeeper.NewAccountWithAddress(f.ctx, addr))
+	if amount == 0 {
+		return
+	}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, types.ModuleName, coins))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, addr, coins))
+}
+
+// escrow mints the gas charged to the encrypted tx creator to the pep module
+func (f underlyingTxFixture) escrow(t *testing.T) *sdk.Coin {
+	charged := sdk.NewInt64Coin(testDenom, testEscrow)
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, types.ModuleName, sdk.NewCoins(charged)))
+	return &charged
+}
+
+func (f underlyingTxFixture) balance(addr sdk.AccAddress) int64 {
+	return f.bankKeeper.GetBalance(f.ctx, addr, testDenom).Amount.Int64()
+}
+
+// newSendTxBuilder returns an underlying tx sending testSent from the signer to a new account
+func (f underlyingTxFixture) newSendTxBuilder(t *testing.T, signer sdk.AccAddress) client.TxBuilder {
+	recipient, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+
+	txBuilder := f.txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(signer, recipient, sdk.NewCoins(sdk.NewInt64Coin(testDenom, testSent)))))
+	txBuilder.SetGasLimit(testGasLimit)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(testDenom, testTxFee)))
+	return txBuilder
+}
+
+func (f underlyingTxFixture) signerData(addr sdk.AccAddress, pubKey cryptotypes.PubKey) authsigning.SignerData {
+	return authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       f.ctx.ChainID(),
+		AccountNumber: f.accountKeeper.GetAccount(f.ctx, addr).GetAccountNumber(),
+		Sequence:      testPepNonce,
+		PubKey:        pubKey,
+	}
+}
+
+// signDirect signs the underlying tx with one key per signer, in the signers order
+func (f underlyingTxFixture) signDirect(t *testing.T, txBuilder client.TxBuilder, privs ...cryptotypes.PrivKey) {
+	sigs := make([]signing.SignatureV2, len(privs))
+	for i, priv := range privs {
+		sigs[i] = signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: testPepNonce,
+		}
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+
+	for i, priv := range privs {
+		sig, err := clienttx.SignWithPrivKey(
+			f.ctx, signing.SignMode_SIGN_MODE_DIRECT,
+			f.signerData(sdk.AccAddress(priv.PubKey().Address()), priv.PubKey()),
+			txBuilder, priv, f.txConfig, testPepNonce,
+		)
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+}
+
+// decryptAndExecute encrypts the underlying tx to the target height, executes it as an encrypted tx
+// of the creator and returns its execution result
+func (f underlyingTxFixture) decryptAndExecute(t *testing.T, txBuilder client.TxBuilder, creator sdk.AccAddress, chargedGas *sdk.Coin) types.EncryptedTxExecutionResult {
+	txBytes, err := f.txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	out, err := random.GeneratePubKeyAndShares(1)
+	require.NoError(t, err)
+
+	identity := "10"
+	derived, err := shares.DeriveShare(out.GeneratedShare[0].Share, 1, identity)
+	require.NoError(t, err)
+
+	suite := bls.NewBLS12381Suite()
+	publicKeyPoint, err := f.keeper.GetPubKeyPoint(out.MasterPublicKey, suite)
+	require.NoError(t, err)
+	skPoint, err := f.keeper.GetSKPoint(derived, suite)
+	require.NoError(t, err)
+
+	var cipherData bytes.Buffer
+	require.NoError(t, enc.Encrypt(publicKeyPoint, []byte(identity), &cipherData, bytes.NewBuffer(txBytes)))
+
+	tx := convertEncTxToDecryptionTx(types.EncryptedTx{
+		TargetHeight: testTargetHeight,
+		Data:         hex.EncodeToString(cipherData.Bytes()),
+		Creator:      creator.String(),
+		ChargedGas:   chargedGas,
+	})
+	require.NoError(t, f.am.decryptAndExecuteTx(f.ctx, tx, f.ctx.GasMeter().GasConsumed(), publicKeyPoint, skPoint))
+
+	result, found := f.keeper.GetEncryptedTxResult(f.ctx, testTargetHeight, 0)
+	require.True(t, found)
+	require.Equal(t, types.EncryptedTxStatusExecuted, result.Status)
+	return result
+}
+
+// requireFeeCharged checks the fee of the underlying tx is its gas used
+// and the whole escrow of the creator is refunded
+func requireFeeCharged(t *testing.T, result types.EncryptedTxExecutionResult, escrow *sdk.Coin) int64 {
+	require.Equal(t, testDenom, result.FeeCharged.Denom)
+	require.Equal(t, int64(result.GasUsed), result.FeeCharged.Amount.Int64())
+	require.True(t, result.FeeCharged.IsPositive())
+	require.Equal(t, *escrow, *result.FeeRefunded)
+	return result.FeeCharged.Amount.Int64()
+}
+
+func TestDecryptAndExecuteMultisigSignedTx(t *testing.T) {
+	f := newUnderlyingTxFixture(t)
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]cryptotypes.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPubKey.Address())
+
+	// a member of the multisig submits the encrypted tx
+	creator := sdk.AccAddress(pubKeys[0].Address())
+	f.fundAccount(t, creator, 0)
+	f.fundAccount(t, multisigAddr, testFeeBalance)
+	escrow := f.escrow(t)
+
+	txBuilder := f.newSendTxBuilder(t, multisigAddr)
+	multisigData := multisig.NewMultisig(len(pubKeys))
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     multisigData,
+		Sequence: testPepNonce,
+	}))
+	for _, priv := range privs[:2] {
+		sig, err := clienttx.SignWithPrivKey(
+			f.ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			f.signerData(multisigAddr, multisigPubKey),
+			txBuilder, priv, f.txConfig, testPepNonce,
+		)
+		require.NoError(t, err)
+		require.NoError(t, multisig.AddSignatureV2(multisigData, sig, pubKeys))
+	}
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     multisigData,
+		Sequence: testPepNonce,
+	}))
+
+	result := f.decryptAndExecute(t, txBuilder, creator, escrow)
+	fee := requireFeeCharged(t, result, escrow)
+
+	// the multisig is the fee payer of its tx, the escrow of the member is refunded
+	require.Equal(t, int64(testFeeBalance-testSent)-fee, f.balance(multisigAddr))
+	require.Equal(t, int64(testEscrow), f.balance(creator))
+	require.True(t, f.bankKeeper.GetAllBalances(f.ctx, f.accountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	// the pep nonce of the multisig is used, the one of the member is left at its initial value
+	nonce, found := f.keeper.GetPepNonce(f.ctx, multisigAddr.String())
+	require.True(t, found)
+	require.Equal(t, uint64(testPepNonce+1), nonce.Nonce)
+	nonce, found = f.keeper.GetPepNonce(f.ctx, creator.String())
+	require.True(t, found)
+	require.Equal(t, uint64(testPepNonce), nonce.Nonce)
+}
+
+func TestDecryptAndExecuteFeePayerTx(t *testing.T) {
+	f := newUnderlyingTxFixture(t)
+
+	signerPriv, feePayerPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(signerPriv.PubKey().Address())
+	feePayer := sdk.AccAddress(feePayerPriv.PubKey().Address())
+
+	f.fundAccount(t, signer, testSent)
+	f.fundAccount(t, feePayer, testFeeBalance)
+	escrow := f.escrow(t)
+
+	txBuilder := f.newSendTxBuilder(t, signer)
+	txBuilder.SetFeePayer(feePayer)
+	f.signDirect(t, txBuilder, signerPriv, feePayerPriv)
+
+	result := f.decryptAndExecute(t, txBuilder, signer, escrow)
+	fee := requireFeeCharged(t, result, escrow)
+
+	// the declared fee payer is charged, the escrow of the signer submitting the encrypted tx is refunded
+	require.Equal(t, int64(testFeeBalance)-fee, f.balance(feePayer))
+	require.Equal(t, int64(testEscrow), f.balance(signer))
+	require.True(t, f.bankKeeper.GetAllBalances(f.ctx, f.accountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestDecryptAndExecuteFeeGrantedTx(t *testing.T) {
+	f := newUnderlyingTxFixture(t)
+
+	signerPriv := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(signerPriv.PubKey().Address())
+	granter, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+
+	f.fundAccount(t, signer, testSent)
+	f.fundAccount(t, granter, testFeeBalance)
+	escrow := f.escrow(t)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(testDenom, testTxFee))
+	require.NoError(t, f.feegrantKeeper.GrantAllowance(f.ctx, granter, signer, &feegrant.BasicAllowance{SpendLimit: spendLimit}))
+
+	txBuilder := f.newSendTxBuilder(t, signer)
+	txBuilder.SetFeeGranter(granter)
+	f.signDirect(t, txBuilder, signerPriv)
+
+	result := f.decryptAndExecute(t, txBuilder, signer, escrow)
+	fee := requireFeeCharged(t, result, escrow)
+
+	// the granter is charged through the allowance, the escrow of the grantee is refunded
+	require.Equal(t, int64(testFeeBalance)-fee, f.balance(granter))
+	require.Equal(t, int64(testEscrow), f.balance(signer))
+	require.True(t, f.bankKeeper.GetAllBalances(f.ctx, f.accountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	allowance, err := f.feegrantKeeper.GetAllowance(f.ctx, granter, signer)
+	require.NoError(t, err)
+	require.Equal(t, int64(testTxFee)-fee, allowance.(*feegrant.BasicAllowance).SpendLimit.AmountOf(testDenom).Int64())
+}
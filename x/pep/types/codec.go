@@ -40,6 +40,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitGeneralEncryptedTx{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelEncryptedTx{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -32,6 +32,8 @@ type EncryptedTx struct {
 	ChargedGas             *types.Coin `protobuf:"bytes,5,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
 	ProcessedAtChainHeight uint64      `protobuf:"varint,6,opt,name=processedAtChainHeight,proto3" json:"processedAtChainHeight,omitempty"`
 	Expired                bool        `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	Cancelled              bool        `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Refunded               *types.Coin `protobuf:"bytes,9,opt,name=refunded,proto3" json:"refunded,omitempty"`
 }
 
 func (m *EncryptedTx) Reset()         { *m = EncryptedTx{} }
@@ -116,6 +118,20 @@ func (m *EncryptedTx) GetExpired() bool {
 	return false
 }
 
+func (m *EncryptedTx) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *EncryptedTx) GetRefunded() *types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
 type EncryptedTxArray struct {
 	EncryptedTx []EncryptedTx `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx"`
 }
@@ -166,6 +182,8 @@ type GeneralEncryptedTx struct {
 	Data       string      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	Creator    string      `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
 	ChargedGas *types.Coin `protobuf:"bytes,5,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+	Cancelled  bool        `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Refunded   *types.Coin `protobuf:"bytes,7,opt,name=refunded,proto3" json:"refunded,omitempty"`
 }
 
 func (m *GeneralEncryptedTx) Reset()         { *m = GeneralEncryptedTx{} }
@@ -236,6 +254,20 @@ func (m *GeneralEncryptedTx) GetChargedGas() *types.Coin {
 	return nil
 }
 
+func (m *GeneralEncryptedTx) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *GeneralEncryptedTx) GetRefunded() *types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
 type GeneralEncryptedTxArray struct {
 	EncryptedTx []GeneralEncryptedTx `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx"`
 }
@@ -287,6 +319,10 @@ type GenEncTxExecutionQueue struct {
 	Pubkey       string                   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	TxList       *GeneralEncryptedTxArray `protobuf:"bytes,5,opt,name=tx_list,json=txList,proto3" json:"tx_list,omitempty"`
 	AggrKeyshare string                   `protobuf:"bytes,6,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
+	// expiry is the expiry height of the public key the identity is bound to,
+	// the request expires if its decryption key is not delivered by then
+	Expiry  uint64 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Expired bool   `protobuf:"varint,8,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
 func (m *GenEncTxExecutionQueue) Reset()         { *m = GenEncTxExecutionQueue{} }
@@ -364,503 +400,2946 @@ func (m *GenEncTxExecutionQueue) GetAggrKeyshare() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EncryptedTx)(nil), "fairyring.pep.EncryptedTx")
-	proto.RegisterType((*EncryptedTxArray)(nil), "fairyring.pep.EncryptedTxArray")
-	proto.RegisterType((*GeneralEncryptedTx)(nil), "fairyring.pep.GeneralEncryptedTx")
-	proto.RegisterType((*GeneralEncryptedTxArray)(nil), "fairyring.pep.GeneralEncryptedTxArray")
-	proto.RegisterType((*GenEncTxExecutionQueue)(nil), "fairyring.pep.GenEncTxExecutionQueue")
-}
-
-func init() { proto.RegisterFile("fairyring/pep/encrypted_tx.proto", fileDescriptor_7c124d687cde8326) }
-
-var fileDescriptor_7c124d687cde8326 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x4d, 0x9a, 0x34, 0x9b, 0x56, 0x42, 0xab, 0x2a, 0x98, 0x48, 0x18, 0x13, 0x24,
-	0x14, 0x71, 0xb0, 0xd5, 0x22, 0x21, 0x71, 0x42, 0x4d, 0x09, 0xa1, 0x82, 0x0b, 0x56, 0xc5, 0x81,
-	0x4b, 0xb4, 0xb6, 0x07, 0x67, 0x95, 0x74, 0xd7, 0xec, 0xae, 0x91, 0x7d, 0xe5, 0x09, 0x78, 0x13,
-	0x5e, 0xa3, 0xc7, 0x1e, 0x39, 0x21, 0x94, 0x1c, 0x79, 0x09, 0x94, 0xb5, 0xd3, 0x3a, 0x6d, 0x11,
-	0xc7, 0xde, 0xfc, 0xff, 0x33, 0xb3, 0xfa, 0xe7, 0x93, 0x07, 0x3b, 0x9f, 0x29, 0x93, 0xb9, 0x64,
-	0x3c, 0xf6, 0x12, 0x48, 0x3c, 0xe0, 0xa1, 0xcc, 0x13, 0x0d, 0xd1, 0x44, 0x67, 0x6e, 0x22, 0x85,
-	0x16, 0x64, 0xef, 0xb2, 0xc3, 0x4d, 0x20, 0xe9, 0xed, 0xc7, 0x22, 0x16, 0xa6, 0xe2, 0xad, 0xbe,
-	0x8a, 0xa6, 0x9e, 0x1d, 0x0a, 0x75, 0x26, 0x94, 0x17, 0x50, 0x05, 0xde, 0xd7, 0x83, 0x00, 0x34,
-	0x3d, 0xf0, 0x42, 0xc1, 0x78, 0x51, 0xef, 0x7f, 0xdb, 0xc2, 0x9d, 0xd1, 0xfa, 0xed, 0xd3, 0x8c,
-	0xf4, 0xf1, 0xae, 0xa6, 0x32, 0x06, 0xfd, 0x16, 0x58, 0x3c, 0xd5, 0x16, 0x72, 0xd0, 0xa0, 0xe1,
-	0x6f, 0x78, 0x64, 0x1f, 0x6f, 0x33, 0x1e, 0x41, 0x66, 0x6d, 0x99, 0x62, 0x21, 0x08, 0xc1, 0x8d,
-	0x88, 0x6a, 0x6a, 0xd5, 0x1d, 0x34, 0x68, 0xfb, 0xe6, 0x9b, 0x58, 0xb8, 0x15, 0x4a, 0xa0, 0x5a,
-	0x48, 0xab, 0x61, 0xec, 0xb5, 0x24, 0x2f, 0x31, 0x0e, 0xa7, 0xab, 0x47, 0xa3, 0x31, 0x55, 0xd6,
-	0xb6, 0x83, 0x06, 0x9d, 0xc3, 0x07, 0x6e, 0x11, 0xd6, 0x5d, 0x85, 0x75, 0xcb, 0xb0, 0xee, 0xb1,
-	0x60, 0xdc, 0xaf, 0x34, 0x93, 0x17, 0xb8, 0x9b, 0x48, 0x11, 0x82, 0x52, 0x10, 0x1d, 0xe9, 0xe3,
-	0x29, 0x65, 0xbc, 0x0c, 0xdb, 0x34, 0x79, 0xfe, 0x51, 0x5d, 0x85, 0x81, 0x2c, 0x61, 0x12, 0x22,
-	0xab, 0xe5, 0xa0, 0xc1, 0x8e, 0xbf, 0x96, 0xfd, 0x8f, 0xf8, 0x5e, 0x85, 0xc1, 0x91, 0x94, 0x34,
-	0x27, 0x43, 0xdc, 0x81, 0x2b, 0xcf, 0x42, 0x4e, 0x7d, 0xd0, 0x39, 0xec, 0xb9, 0x1b, 0xcc, 0xdd,
-	0xca, 0xd4, 0xb0, 0x71, 0xfe, 0xeb, 0x51, 0xcd, 0xaf, 0x0e, 0xf5, 0x7f, 0x20, 0x4c, 0xc6, 0xc0,
-	0x41, 0xd2, 0x79, 0x95, 0x71, 0x0f, 0xef, 0xb0, 0x08, 0xb8, 0x66, 0x3a, 0x37, 0x7c, 0xdb, 0xfe,
-	0xa5, 0xbe, 0x63, 0xb6, 0xfd, 0x08, 0xdf, 0xbf, 0x19, 0xb8, 0x00, 0x72, 0x72, 0x1b, 0x90, 0xc7,
-	0xd7, 0x80, 0xdc, 0x1c, 0xbe, 0x8d, 0xcb, 0x1f, 0x84, 0xbb, 0x63, 0xe0, 0x23, 0x1e, 0x9e, 0x66,
-	0xa3, 0x0c, 0xc2, 0x54, 0x33, 0xc1, 0x3f, 0xa4, 0x90, 0x42, 0x75, 0x2b, 0xb4, 0xb9, 0xd5, 0x43,
-	0x8c, 0x25, 0x7c, 0x49, 0x41, 0xe9, 0x09, 0x8b, 0x0c, 0x9e, 0xb6, 0xdf, 0x2e, 0x9d, 0x93, 0x68,
-	0x03, 0x6a, 0xfd, 0x1a, 0xd4, 0x2e, 0x6e, 0x26, 0x69, 0x30, 0x83, 0xbc, 0x24, 0x55, 0x2a, 0xf2,
-	0x0a, 0xb7, 0x74, 0x36, 0x99, 0x33, 0xa5, 0x4b, 0x4a, 0x4f, 0xff, 0xbb, 0x8e, 0x61, 0xe1, 0x37,
-	0x75, 0xf6, 0x9e, 0x29, 0x4d, 0x9e, 0xe0, 0x3d, 0x1a, 0xc7, 0x72, 0x32, 0x83, 0x5c, 0x4d, 0xa9,
-	0x04, 0xf3, 0x07, 0xb6, 0xfd, 0xdd, 0x95, 0xf9, 0xae, 0xf4, 0x86, 0xaf, 0xcf, 0x17, 0x36, 0xba,
-	0x58, 0xd8, 0xe8, 0xf7, 0xc2, 0x46, 0xdf, 0x97, 0x76, 0xed, 0x62, 0x69, 0xd7, 0x7e, 0x2e, 0xed,
-	0xda, 0xa7, 0x67, 0x31, 0xd3, 0xd3, 0x34, 0x70, 0x43, 0x71, 0xe6, 0xbd, 0xa1, 0x4c, 0x06, 0x73,
-	0x11, 0xce, 0xbc, 0xab, 0xc3, 0xcf, 0xcc, 0xe9, 0xeb, 0x3c, 0x01, 0x15, 0x34, 0xcd, 0xbd, 0x3e,
-	0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x64, 0xa5, 0xcd, 0xa6, 0x18, 0x04, 0x00, 0x00,
+func (m *GenEncTxExecutionQueue) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
 }
 
-func (m *EncryptedTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenEncTxExecutionQueue) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EncryptedTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EncryptedTxQueueEntry is an encrypted tx that did not fit in the per block
+// execution budget and is carried over to the following blocks
+type EncryptedTxQueueEntry struct {
+	Sequence     uint64      `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Identity     string      `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	TargetHeight uint64      `protobuf:"varint,3,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index        uint64      `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Data         string      `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Creator      string      `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChargedGas   *types.Coin `protobuf:"bytes,7,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+	Pubkey       string      `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	AggrKeyshare string      `protobuf:"bytes,9,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
 }
 
-func (m *EncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expired {
-		i--
-		if m.Expired {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EncryptedTxQueueEntry) Reset()         { *m = EncryptedTxQueueEntry{} }
+func (m *EncryptedTxQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxQueueEntry) ProtoMessage()    {}
+func (*EncryptedTxQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c124d687cde8326, []int{5}
+}
+func (m *EncryptedTxQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTxQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTxQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ProcessedAtChainHeight != 0 {
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.ProcessedAtChainHeight))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.ChargedGas != nil {
-		{
-			size, err := m.ChargedGas.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EncryptedTxQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxQueueEntry.Merge(m, src)
+}
+func (m *EncryptedTxQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTxQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedTxQueueEntry proto.InternalMessageInfo
+
+func (m *EncryptedTxQueueEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *EncryptedTxQueueEntry) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EncryptedTxQueueEntry) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
 	}
-	if m.Index != 0 {
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EncryptedTxQueueEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if m.TargetHeight != 0 {
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EncryptedTxQueueEntry) GetData() string {
+	if m != nil {
+		return m.Data
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EncryptedTxArray) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncryptedTxQueueEntry) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EncryptedTxArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EncryptedTxQueueEntry) GetChargedGas() *types.Coin {
+	if m != nil {
+		return m.ChargedGas
+	}
+	return nil
 }
 
-func (m *EncryptedTxArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EncryptedTx) > 0 {
-		for iNdEx := len(m.EncryptedTx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EncryptedTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *EncryptedTxQueueEntry) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GeneralEncryptedTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncryptedTxQueueEntry) GetAggrKeyshare() string {
+	if m != nil {
+		return m.AggrKeyshare
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GeneralEncryptedTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EncryptedTxExecutionResult is the outcome of executing a decrypted encrypted tx
+type EncryptedTxExecutionResult struct {
+	Identity              string      `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	TargetHeight          uint64      `protobuf:"varint,2,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index                 uint64      `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Creator               string      `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status                string      `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	FailReason            string      `protobuf:"bytes,6,opt,name=failReason,proto3" json:"failReason,omitempty"`
+	GasUsed               uint64      `protobuf:"varint,7,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	FeeCharged            *types.Coin `protobuf:"bytes,8,opt,name=feeCharged,proto3" json:"feeCharged,omitempty"`
+	FeeRefunded           *types.Coin `protobuf:"bytes,9,opt,name=feeRefunded,proto3" json:"feeRefunded,omitempty"`
+	MsgTypes              []string    `protobuf:"bytes,10,rep,name=msgTypes,proto3" json:"msgTypes,omitempty"`
+	ExecutedAtChainHeight uint64      `protobuf:"varint,11,opt,name=executedAtChainHeight,proto3" json:"executedAtChainHeight,omitempty"`
 }
 
-func (m *GeneralEncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChargedGas != nil {
-		{
-			size, err := m.ChargedGas.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+func (m *EncryptedTxExecutionResult) Reset()         { *m = EncryptedTxExecutionResult{} }
+func (m *EncryptedTxExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxExecutionResult) ProtoMessage()    {}
+func (*EncryptedTxExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c124d687cde8326, []int{6}
+}
+func (m *EncryptedTxExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTxExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTxExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Index != 0 {
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EncryptedTxExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxExecutionResult.Merge(m, src)
+}
+func (m *EncryptedTxExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTxExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxExecutionResult.DiscardUnknown(m)
 }
 
-func (m *GeneralEncryptedTxArray) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EncryptedTxExecutionResult proto.InternalMessageInfo
+
+func (m *EncryptedTxExecutionResult) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GeneralEncryptedTxArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EncryptedTxExecutionResult) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
 }
 
-func (m *GeneralEncryptedTxArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EncryptedTx) > 0 {
-		for iNdEx := len(m.EncryptedTx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EncryptedTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *EncryptedTxExecutionResult) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenEncTxExecutionQueue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncryptedTxExecutionResult) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenEncTxExecutionQueue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EncryptedTxExecutionResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *GenEncTxExecutionQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggrKeyshare) > 0 {
-		i -= len(m.AggrKeyshare)
-		copy(dAtA[i:], m.AggrKeyshare)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.AggrKeyshare)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TxList != nil {
-		{
-			size, err := m.TxList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *EncryptedTxExecutionResult) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEncryptedTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEncryptedTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EncryptedTxExecutionResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EncryptedTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TargetHeight != 0 {
-		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+
+func (m *EncryptedTxExecutionResult) GetFeeCharged() *types.Coin {
+	if m != nil {
+		return m.FeeCharged
 	}
-	if m.Index != 0 {
-		n += 1 + sovEncryptedTx(uint64(m.Index))
+	return nil
+}
+
+func (m *EncryptedTxExecutionResult) GetFeeRefunded() *types.Coin {
+	if m != nil {
+		return m.FeeRefunded
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return nil
+}
+
+func (m *EncryptedTxExecutionResult) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return nil
+}
+
+func (m *EncryptedTxExecutionResult) GetExecutedAtChainHeight() uint64 {
+	if m != nil {
+		return m.ExecutedAtChainHeight
 	}
-	if m.ChargedGas != nil {
-		l = m.ChargedGas.Size()
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return 0
+}
+
+// EncryptedTxCreatorIndex points to an encrypted tx submitted by a creator,
+// req_id is only set for general encrypted txs
+type EncryptedTxCreatorIndex struct {
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	ReqId        string `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Index        uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *EncryptedTxCreatorIndex) Reset()         { *m = EncryptedTxCreatorIndex{} }
+func (m *EncryptedTxCreatorIndex) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxCreatorIndex) ProtoMessage()    {}
+func (*EncryptedTxCreatorIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c124d687cde8326, []int{7}
+}
+func (m *EncryptedTxCreatorIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTxCreatorIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTxCreatorIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ProcessedAtChainHeight != 0 {
-		n += 1 + sovEncryptedTx(uint64(m.ProcessedAtChainHeight))
+}
+func (m *EncryptedTxCreatorIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxCreatorIndex.Merge(m, src)
+}
+func (m *EncryptedTxCreatorIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTxCreatorIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxCreatorIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedTxCreatorIndex proto.InternalMessageInfo
+
+func (m *EncryptedTxCreatorIndex) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
 	}
-	if m.Expired {
-		n += 2
+	return 0
+}
+
+func (m *EncryptedTxCreatorIndex) GetReqId() string {
+	if m != nil {
+		return m.ReqId
 	}
-	return n
+	return ""
 }
 
-func (m *EncryptedTxArray) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EncryptedTxCreatorIndex) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	var l int
-	_ = l
-	if len(m.EncryptedTx) > 0 {
-		for _, e := range m.EncryptedTx {
-			l = e.Size()
-			n += 1 + l + sovEncryptedTx(uint64(l))
+	return 0
+}
+
+// EncryptedTxWithStatus is a height-targeted or general encrypted tx along with its current status
+type EncryptedTxWithStatus struct {
+	TargetHeight uint64      `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	ReqId        string      `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Identity     string      `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Index        uint64      `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Data         string      `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Creator      string      `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChargedGas   *types.Coin `protobuf:"bytes,7,opt,name=chargedGas,proto3" json:"chargedGas,omitempty"`
+	Status       string      `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *EncryptedTxWithStatus) Reset()         { *m = EncryptedTxWithStatus{} }
+func (m *EncryptedTxWithStatus) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxWithStatus) ProtoMessage()    {}
+func (*EncryptedTxWithStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c124d687cde8326, []int{8}
+}
+func (m *EncryptedTxWithStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTxWithStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTxWithStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EncryptedTxWithStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxWithStatus.Merge(m, src)
+}
+func (m *EncryptedTxWithStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTxWithStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxWithStatus.DiscardUnknown(m)
 }
 
-func (m *GeneralEncryptedTx) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EncryptedTxWithStatus proto.InternalMessageInfo
+
+func (m *EncryptedTxWithStatus) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return 0
+}
+
+func (m *EncryptedTxWithStatus) GetReqId() string {
+	if m != nil {
+		return m.ReqId
 	}
-	if m.Index != 0 {
-		n += 1 + sovEncryptedTx(uint64(m.Index))
+	return ""
+}
+
+func (m *EncryptedTxWithStatus) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return ""
+}
+
+func (m *EncryptedTxWithStatus) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return 0
+}
+
+func (m *EncryptedTxWithStatus) GetData() string {
+	if m != nil {
+		return m.Data
 	}
-	if m.ChargedGas != nil {
-		l = m.ChargedGas.Size()
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	return ""
+}
+
+func (m *EncryptedTxWithStatus) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *GeneralEncryptedTxArray) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EncryptedTxWithStatus) GetChargedGas() *types.Coin {
+	if m != nil {
+		return m.ChargedGas
 	}
-	var l int
-	_ = l
-	if len(m.EncryptedTx) > 0 {
-		for _, e := range m.EncryptedTx {
-			l = e.Size()
-			n += 1 + l + sovEncryptedTx(uint64(l))
+	return nil
+}
+
+func (m *EncryptedTxWithStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// EncryptedTxOrder is the permutation the encrypted txs of a target height were executed in,
+// seed is the hex encoded hash of the aggregated key of the height the permutation is derived from
+type EncryptedTxOrder struct {
+	TargetHeight uint64   `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Seed         string   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Indexes      []uint64 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *EncryptedTxOrder) Reset()         { *m = EncryptedTxOrder{} }
+func (m *EncryptedTxOrder) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxOrder) ProtoMessage()    {}
+func (*EncryptedTxOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c124d687cde8326, []int{9}
+}
+func (m *EncryptedTxOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTxOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTxOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EncryptedTxOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxOrder.Merge(m, src)
+}
+func (m *EncryptedTxOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTxOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxOrder.DiscardUnknown(m)
 }
 
-func (m *GenEncTxExecutionQueue) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EncryptedTxOrder proto.InternalMessageInfo
+
+func (m *EncryptedTxOrder) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+func (m *EncryptedTxOrder) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
+func (m *EncryptedTxOrder) GetIndexes() []uint64 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EncryptedTx)(nil), "fairyring.pep.EncryptedTx")
+	proto.RegisterType((*EncryptedTxArray)(nil), "fairyring.pep.EncryptedTxArray")
+	proto.RegisterType((*GeneralEncryptedTx)(nil), "fairyring.pep.GeneralEncryptedTx")
+	proto.RegisterType((*GeneralEncryptedTxArray)(nil), "fairyring.pep.GeneralEncryptedTxArray")
+	proto.RegisterType((*GenEncTxExecutionQueue)(nil), "fairyring.pep.GenEncTxExecutionQueue")
+	proto.RegisterType((*EncryptedTxQueueEntry)(nil), "fairyring.pep.EncryptedTxQueueEntry")
+	proto.RegisterType((*EncryptedTxExecutionResult)(nil), "fairyring.pep.EncryptedTxExecutionResult")
+	proto.RegisterType((*EncryptedTxCreatorIndex)(nil), "fairyring.pep.EncryptedTxCreatorIndex")
+	proto.RegisterType((*EncryptedTxWithStatus)(nil), "fairyring.pep.EncryptedTxWithStatus")
+	proto.RegisterType((*EncryptedTxOrder)(nil), "fairyring.pep.EncryptedTxOrder")
+}
+
+func init() { proto.RegisterFile("fairyring/pep/encrypted_tx.proto", fileDescriptor_7c124d687cde8326) }
+
+var fileDescriptor_7c124d687cde8326 = []byte{
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x38, 0xcd, 0x8f, 0x97, 0x5d, 0x09, 0x8d, 0xb6, 0x5d, 0x13, 0x41, 0x08, 0x46,
+	0x42, 0x11, 0x07, 0x47, 0xbb, 0xfc, 0x90, 0x10, 0x07, 0xb4, 0x2d, 0xa5, 0x54, 0x20, 0x21, 0x4c,
+	0x01, 0x89, 0x4b, 0x35, 0xf1, 0xbc, 0x38, 0x43, 0xd3, 0xb1, 0x3b, 0x33, 0x46, 0xf6, 0x1d, 0xee,
+	0xfc, 0x03, 0xdc, 0xb9, 0xf1, 0x6f, 0xec, 0x71, 0x6f, 0x70, 0x42, 0xa8, 0xfd, 0x47, 0x90, 0xc7,
+	0x4e, 0x62, 0xa7, 0xd9, 0xb4, 0x68, 0x0f, 0xbd, 0xf9, 0xfd, 0xb0, 0xfd, 0xe6, 0xf3, 0x7d, 0xef,
+	0xd9, 0x30, 0x9c, 0x52, 0x2e, 0x33, 0xc9, 0x45, 0x38, 0x8e, 0x31, 0x1e, 0xa3, 0x08, 0x64, 0x16,
+	0x6b, 0x64, 0x67, 0x3a, 0xf5, 0x62, 0x19, 0xe9, 0x88, 0x3c, 0x5c, 0x66, 0x78, 0x31, 0xc6, 0xfd,
+	0x47, 0x61, 0x14, 0x46, 0x26, 0x32, 0xce, 0xaf, 0x8a, 0xa4, 0xfe, 0x20, 0x88, 0xd4, 0x45, 0xa4,
+	0xc6, 0x13, 0xaa, 0x70, 0xfc, 0xf3, 0x93, 0x09, 0x6a, 0xfa, 0x64, 0x1c, 0x44, 0x5c, 0x14, 0x71,
+	0xf7, 0xaf, 0x06, 0xf4, 0x8e, 0x16, 0xcf, 0x3e, 0x4d, 0x89, 0x0b, 0x0f, 0x34, 0x95, 0x21, 0xea,
+	0x2f, 0x90, 0x87, 0x33, 0xed, 0x58, 0x43, 0x6b, 0xd4, 0xf4, 0x6b, 0x3e, 0xf2, 0x08, 0x76, 0xb9,
+	0x60, 0x98, 0x3a, 0x0d, 0x13, 0x2c, 0x0c, 0x42, 0xa0, 0xc9, 0xa8, 0xa6, 0x8e, 0x3d, 0xb4, 0x46,
+	0x5d, 0xdf, 0x5c, 0x13, 0x07, 0xda, 0x81, 0x44, 0xaa, 0x23, 0xe9, 0x34, 0x8d, 0x7b, 0x61, 0x92,
+	0x8f, 0x01, 0x82, 0x59, 0xfe, 0x50, 0x76, 0x4c, 0x95, 0xb3, 0x3b, 0xb4, 0x46, 0xbd, 0xa7, 0xaf,
+	0x7b, 0x45, 0xb1, 0x5e, 0x5e, 0xac, 0x57, 0x16, 0xeb, 0x1d, 0x46, 0x5c, 0xf8, 0x95, 0x64, 0xf2,
+	0x11, 0xec, 0xc7, 0x32, 0x0a, 0x50, 0x29, 0x64, 0xcf, 0xf4, 0xe1, 0x8c, 0x72, 0x51, 0x16, 0xdb,
+	0x32, 0xf5, 0xbc, 0x24, 0x9a, 0x17, 0x83, 0x69, 0xcc, 0x25, 0x32, 0xa7, 0x3d, 0xb4, 0x46, 0x1d,
+	0x7f, 0x61, 0x92, 0x37, 0xa0, 0x1b, 0x50, 0x11, 0xe0, 0x7c, 0x8e, 0xcc, 0xe9, 0x98, 0xd8, 0xca,
+	0x41, 0x3e, 0x84, 0x8e, 0xc4, 0x69, 0x22, 0x18, 0x32, 0xa7, 0x7b, 0x5b, 0xa1, 0xcb, 0x54, 0xf7,
+	0x7b, 0x78, 0xad, 0x02, 0xf6, 0x99, 0x94, 0x34, 0x23, 0x07, 0xd0, 0xc3, 0x95, 0xcf, 0xb1, 0x86,
+	0xf6, 0xa8, 0xf7, 0xb4, 0xef, 0xd5, 0x84, 0xf4, 0x2a, 0x77, 0x1d, 0x34, 0x9f, 0xff, 0xf3, 0xd6,
+	0x8e, 0x5f, 0xbd, 0xc9, 0xfd, 0xa5, 0x01, 0xe4, 0x18, 0x05, 0x4a, 0x3a, 0xaf, 0x0a, 0xd7, 0x87,
+	0x0e, 0x67, 0x28, 0x34, 0xd7, 0x99, 0x11, 0xad, 0xeb, 0x2f, 0xed, 0xfb, 0x16, 0xac, 0x86, 0xb7,
+	0xb5, 0x0d, 0x6f, 0xfb, 0xee, 0x78, 0x19, 0x3c, 0xbe, 0x49, 0xa1, 0xa0, 0x7c, 0xb2, 0x89, 0xf2,
+	0xdb, 0x6b, 0x94, 0x6f, 0xde, 0xbc, 0x09, 0xf6, 0xef, 0x0d, 0xd8, 0x3f, 0x46, 0x71, 0x24, 0x82,
+	0xd3, 0xf4, 0x28, 0xc5, 0x20, 0xd1, 0x3c, 0x12, 0xdf, 0x24, 0x98, 0x60, 0x15, 0x95, 0x55, 0x47,
+	0xf5, 0x26, 0x80, 0xc4, 0xcb, 0x04, 0x95, 0x3e, 0xe3, 0xcc, 0x30, 0xef, 0xfa, 0xdd, 0xd2, 0x73,
+	0xc2, 0x6a, 0x4a, 0xd9, 0x6b, 0x4a, 0xed, 0x43, 0x2b, 0x4e, 0x26, 0xe7, 0x98, 0x95, 0xf8, 0x4b,
+	0x8b, 0x7c, 0x0a, 0x6d, 0x9d, 0x9e, 0xcd, 0xb9, 0xd2, 0x25, 0xfa, 0x77, 0x6f, 0x3d, 0x8e, 0x61,
+	0xe1, 0xb7, 0x74, 0xfa, 0x15, 0x57, 0x9a, 0xbc, 0x03, 0x0f, 0x69, 0x18, 0xca, 0xb3, 0x73, 0xcc,
+	0xd4, 0x8c, 0x4a, 0x34, 0x3a, 0x74, 0xfd, 0x07, 0xb9, 0xf3, 0xcb, 0xd2, 0x97, 0xbf, 0xdd, 0x8c,
+	0x44, 0x66, 0x84, 0x68, 0xfa, 0xa5, 0x55, 0x9d, 0x9c, 0x4e, 0x6d, 0x72, 0xdc, 0x3f, 0x1b, 0xb0,
+	0x57, 0x79, 0xa7, 0x21, 0x73, 0x24, 0xb4, 0xcc, 0xf2, 0x53, 0xaa, 0xfc, 0xc8, 0x22, 0xc0, 0x72,
+	0x89, 0x2c, 0xed, 0x1a, 0x81, 0xc6, 0x1a, 0x81, 0xf5, 0x05, 0x64, 0x6f, 0x5b, 0x40, 0xcd, 0x4d,
+	0xfd, 0xbc, 0xbb, 0xb9, 0x9f, 0x5b, 0xdb, 0xfa, 0xb9, 0xfd, 0x7f, 0xfa, 0x79, 0x25, 0x52, 0xa7,
+	0x26, 0xd2, 0x0d, 0xc6, 0xdd, 0x9b, 0x8c, 0xdd, 0x3f, 0x6c, 0xe8, 0x57, 0x88, 0x2d, 0x9b, 0xca,
+	0x47, 0x95, 0xcc, 0xf5, 0xd6, 0x31, 0x5e, 0x47, 0xd3, 0xd8, 0x86, 0xc6, 0xae, 0xa2, 0x79, 0xf9,
+	0x58, 0xef, 0x43, 0x4b, 0x69, 0xaa, 0x13, 0x55, 0x62, 0x2b, 0x2d, 0x32, 0x00, 0x98, 0x52, 0x3e,
+	0xf7, 0x91, 0xaa, 0x48, 0x94, 0xec, 0x2a, 0x9e, 0xfc, 0x89, 0x21, 0x55, 0xdf, 0xa9, 0x72, 0x68,
+	0x9b, 0xfe, 0xc2, 0xcc, 0xc1, 0x4e, 0x11, 0x0f, 0x0b, 0x5c, 0x4e, 0xe7, 0x56, 0xb0, 0xab, 0x64,
+	0xf2, 0x09, 0xf4, 0xa6, 0x88, 0xfe, 0x9d, 0x97, 0x6d, 0x35, 0x3b, 0x27, 0x77, 0xa1, 0xc2, 0xd3,
+	0x2c, 0x46, 0xe5, 0xc0, 0xd0, 0xce, 0xc9, 0x2d, 0x6c, 0xf2, 0x01, 0xec, 0xa1, 0x01, 0xbd, 0xfe,
+	0xc5, 0xe8, 0x99, 0xda, 0x37, 0x07, 0xdd, 0x9f, 0xe0, 0x71, 0x45, 0xa9, 0xc3, 0x82, 0xd8, 0x89,
+	0x01, 0x7a, 0x97, 0xcf, 0xe4, 0x1e, 0xb4, 0x24, 0x5e, 0xae, 0x56, 0xc0, 0xae, 0xc4, 0xcb, 0x13,
+	0xb6, 0x59, 0x21, 0xf7, 0xd7, 0xfa, 0x20, 0xfd, 0xc0, 0xf5, 0xec, 0xdb, 0x42, 0x89, 0x57, 0x78,
+	0xd5, 0xb6, 0x4d, 0x73, 0xff, 0x33, 0x54, 0xf6, 0x5d, 0xa7, 0xda, 0x77, 0x2e, 0xab, 0x7d, 0x35,
+	0xbf, 0x96, 0x0c, 0xe5, 0x9d, 0x08, 0x10, 0x68, 0x2a, 0xc4, 0xc5, 0xf9, 0xcd, 0x75, 0x5e, 0xb8,
+	0x39, 0x15, 0x2a, 0xc7, 0x1e, 0xda, 0x79, 0x8f, 0x96, 0xe6, 0xc1, 0x67, 0xcf, 0xaf, 0x06, 0xd6,
+	0x8b, 0xab, 0x81, 0xf5, 0xef, 0xd5, 0xc0, 0xfa, 0xed, 0x7a, 0xb0, 0xf3, 0xe2, 0x7a, 0xb0, 0xf3,
+	0xf7, 0xf5, 0x60, 0xe7, 0xc7, 0xf7, 0x42, 0xae, 0x67, 0xc9, 0xc4, 0x0b, 0xa2, 0x8b, 0xf1, 0xe7,
+	0x94, 0xcb, 0xc9, 0x3c, 0x0a, 0xce, 0xc7, 0xab, 0x7f, 0xb1, 0xd4, 0xfc, 0x8d, 0xe9, 0xbc, 0xab,
+	0x26, 0x2d, 0xf3, 0x0b, 0xf5, 0xfe, 0x7f, 0x03, 0x00, 0x66, 0x80, 0xed, 0x3a, 0xab, 0x09, 0x00,
+	0x00,
+}
+
+func (m *EncryptedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	if m.Refunded != nil {
+		{
+			size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	if m.ProcessedAtChainHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.ProcessedAtChainHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.TxList != nil {
-		l = m.TxList.Size()
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	if m.ChargedGas != nil {
+		{
+			size, err := m.ChargedGas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.AggrKeyshare)
-	if l > 0 {
-		n += 1 + l + sovEncryptedTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEncryptedTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EncryptedTxArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozEncryptedTx(x uint64) (n int) {
-	return sovEncryptedTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EncryptedTxArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEncryptedTx
+
+func (m *EncryptedTxArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedTx) > 0 {
+		for iNdEx := len(m.EncryptedTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneralEncryptedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneralEncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneralEncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refunded != nil {
+		{
+			size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ChargedGas != nil {
+		{
+			size, err := m.ChargedGas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneralEncryptedTxArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneralEncryptedTxArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneralEncryptedTxArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedTx) > 0 {
+		for iNdEx := len(m.EncryptedTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenEncTxExecutionQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenEncTxExecutionQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenEncTxExecutionQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AggrKeyshare) > 0 {
+		i -= len(m.AggrKeyshare)
+		copy(dAtA[i:], m.AggrKeyshare)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.AggrKeyshare)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TxList != nil {
+		{
+			size, err := m.TxList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTxQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggrKeyshare) > 0 {
+		i -= len(m.AggrKeyshare)
+		copy(dAtA[i:], m.AggrKeyshare)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.AggrKeyshare)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ChargedGas != nil {
+		{
+			size, err := m.ChargedGas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTxExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedAtChainHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.ExecutedAtChainHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.FeeRefunded != nil {
+		{
+			size, err := m.FeeRefunded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FeeCharged != nil {
+		{
+			size, err := m.FeeCharged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FailReason) > 0 {
+		i -= len(m.FailReason)
+		copy(dAtA[i:], m.FailReason)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.FailReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTxCreatorIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxCreatorIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxCreatorIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTxWithStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxWithStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxWithStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ChargedGas != nil {
+		{
+			size, err := m.ChargedGas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncryptedTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTxOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA11 := make([]byte, len(m.Indexes)*10)
+		var j10 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEncryptedTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEncryptedTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.ChargedGas != nil {
+		l = m.ChargedGas.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.ProcessedAtChainHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.ProcessedAtChainHeight))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	if m.Refunded != nil {
+		l = m.Refunded.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptedTxArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EncryptedTx) > 0 {
+		for _, e := range m.EncryptedTx {
+			l = e.Size()
+			n += 1 + l + sovEncryptedTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GeneralEncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.ChargedGas != nil {
+		l = m.ChargedGas.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	if m.Refunded != nil {
+		l = m.Refunded.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	return n
+}
+
+func (m *GeneralEncryptedTxArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EncryptedTx) > 0 {
+		for _, e := range m.EncryptedTx {
+			l = e.Size()
+			n += 1 + l + sovEncryptedTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenEncTxExecutionQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.TxList != nil {
+		l = m.TxList.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.AggrKeyshare)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Expiry))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *EncryptedTxQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Sequence))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.TargetHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.ChargedGas != nil {
+		l = m.ChargedGas.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.AggrKeyshare)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptedTxExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.TargetHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Index))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.FailReason)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.GasUsed))
+	}
+	if m.FeeCharged != nil {
+		l = m.FeeCharged.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.FeeRefunded != nil {
+		l = m.FeeRefunded.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovEncryptedTx(uint64(l))
+		}
+	}
+	if m.ExecutedAtChainHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.ExecutedAtChainHeight))
+	}
+	return n
+}
+
+func (m *EncryptedTxCreatorIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *EncryptedTxWithStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if m.ChargedGas != nil {
+		l = m.ChargedGas.Size()
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptedTxOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.TargetHeight))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovEncryptedTx(uint64(l))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovEncryptedTx(uint64(e))
+		}
+		n += 1 + sovEncryptedTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovEncryptedTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEncryptedTx(x uint64) (n int) {
+	return sovEncryptedTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChargedGas == nil {
+				m.ChargedGas = &types.Coin{}
+			}
+			if err := m.ChargedGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedAtChainHeight", wireType)
+			}
+			m.ProcessedAtChainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedAtChainHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Refunded == nil {
+				m.Refunded = &types.Coin{}
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTxArray) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTxArray: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTxArray: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTx = append(m.EncryptedTx, EncryptedTx{})
+			if err := m.EncryptedTx[len(m.EncryptedTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneralEncryptedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneralEncryptedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneralEncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChargedGas == nil {
+				m.ChargedGas = &types.Coin{}
+			}
+			if err := m.ChargedGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Refunded == nil {
+				m.Refunded = &types.Coin{}
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneralEncryptedTxArray) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneralEncryptedTxArray: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneralEncryptedTxArray: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTx = append(m.EncryptedTx, GeneralEncryptedTx{})
+			if err := m.EncryptedTx[len(m.EncryptedTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenEncTxExecutionQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenEncTxExecutionQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxList == nil {
+				m.TxList = &GeneralEncryptedTxArray{}
+			}
+			if err := m.TxList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTxQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTxQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTxQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChargedGas == nil {
+				m.ChargedGas = &types.Coin{}
+			}
+			if err := m.ChargedGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTxExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTxExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTxExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptedTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
 			}
@@ -879,7 +3358,7 @@ func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
@@ -898,9 +3377,9 @@ func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -928,11 +3407,11 @@ func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -960,13 +3439,13 @@ func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEncryptedTx
@@ -976,52 +3455,29 @@ func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEncryptedTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEncryptedTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChargedGas == nil {
-				m.ChargedGas = &types.Coin{}
-			}
-			if err := m.ChargedGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedAtChainHeight", wireType)
-			}
-			m.ProcessedAtChainHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEncryptedTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProcessedAtChainHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var v int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEncryptedTx
@@ -1031,65 +3487,14 @@ func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Expired = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEncryptedTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EncryptedTxArray) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEncryptedTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptedTxArray: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptedTxArray: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCharged", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1116,66 +3521,18 @@ func (m *EncryptedTxArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedTx = append(m.EncryptedTx, EncryptedTx{})
-			if err := m.EncryptedTx[len(m.EncryptedTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.FeeCharged == nil {
+				m.FeeCharged = &types.Coin{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FeeCharged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEncryptedTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GeneralEncryptedTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEncryptedTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GeneralEncryptedTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneralEncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRefunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEncryptedTx
@@ -1185,78 +3542,31 @@ func (m *GeneralEncryptedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEncryptedTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEncryptedTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEncryptedTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEncryptedTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEncryptedTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEncryptedTx
+			if m.FeeRefunded == nil {
+				m.FeeRefunded = &types.Coin{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.FeeRefunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1284,13 +3594,13 @@ func (m *GeneralEncryptedTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAtChainHeight", wireType)
 			}
-			var msglen int
+			m.ExecutedAtChainHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEncryptedTx
@@ -1300,28 +3610,11 @@ func (m *GeneralEncryptedTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExecutedAtChainHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEncryptedTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEncryptedTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChargedGas == nil {
-				m.ChargedGas = &types.Coin{}
-			}
-			if err := m.ChargedGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
@@ -1343,7 +3636,7 @@ func (m *GeneralEncryptedTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneralEncryptedTxArray) Unmarshal(dAtA []byte) error {
+func (m *EncryptedTxCreatorIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1366,17 +3659,36 @@ func (m *GeneralEncryptedTxArray) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneralEncryptedTxArray: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptedTxCreatorIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneralEncryptedTxArray: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptedTxCreatorIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEncryptedTx
@@ -1386,26 +3698,43 @@ func (m *GeneralEncryptedTxArray) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEncryptedTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEncryptedTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedTx = append(m.EncryptedTx, GeneralEncryptedTx{})
-			if err := m.EncryptedTx[len(m.EncryptedTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
@@ -1427,7 +3756,7 @@ func (m *GeneralEncryptedTxArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
+func (m *EncryptedTxWithStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,15 +3779,34 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenEncTxExecutionQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptedTxWithStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenEncTxExecutionQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptedTxWithStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1486,11 +3834,11 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ReqId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1518,11 +3866,30 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1550,11 +3917,11 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1582,11 +3949,11 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1613,16 +3980,16 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxList == nil {
-				m.TxList = &GeneralEncryptedTxArray{}
+			if m.ChargedGas == nil {
+				m.ChargedGas = &types.Coin{}
 			}
-			if err := m.TxList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChargedGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1650,8 +4017,185 @@ func (m *GenEncTxExecutionQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTxOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTxOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTxOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEncryptedTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEncryptedTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEncryptedTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEncryptedTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEncryptedTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
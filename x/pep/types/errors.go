@@ -15,4 +15,9 @@ var (
 	ErrInvalidMsgCreator        = sdkerrors.Register(ModuleName, 1700, "Invalid msg creator address")
 	ErrActivePubKeyNotFound     = sdkerrors.Register(ModuleName, 1800, "Active public key not found")
 	ErrReqIDAlreadyExists       = sdkerrors.Register(ModuleName, 1900, "Request ID already exists")
+	ErrEncryptedTxNotFound      = sdkerrors.Register(ModuleName, 2000, "Encrypted tx not found")
+	ErrUnauthorizedCancel       = sdkerrors.Register(ModuleName, 2001, "Only the creator can cancel the encrypted tx")
+	ErrEncryptedTxNotPending    = sdkerrors.Register(ModuleName, 2002, "Encrypted tx is no longer pending")
+	ErrInvalidAggregatedKey     = sdkerrors.Register(ModuleName, 2003, "Aggregated key does not match the public key and identity")
+	ErrRequestExpired           = sdkerrors.Register(ModuleName, 2004, "Request expired without its decryption key")
 )
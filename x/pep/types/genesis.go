@@ -67,6 +67,16 @@ func (gs GenesisState) Validate() error {
 		}
 		aggregatedKeyShareIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated sequence in encryptedTxQueue
+	encryptedTxQueueIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.EncryptedTxQueue {
+		index := string(EncryptedTxQueueKey(elem.Sequence))
+		if _, ok := encryptedTxQueueIndexMap[index]; ok {
+			return fmt.Errorf("duplicated sequence for encryptedTxQueue")
+		}
+		encryptedTxQueueIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	// Check for valid addresses in NONCE
@@ -33,11 +33,12 @@ type GenesisState struct {
 	EncryptedTxArray []EncryptedTxArray `protobuf:"bytes,3,rep,name=encryptedTxArray,proto3" json:"encryptedTxArray"`
 	PepNonceList     []PepNonce         `protobuf:"bytes,4,rep,name=pepNonceList,proto3" json:"pepNonceList"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	AggregatedKeyShareList []AggregatedKeyShare  `protobuf:"bytes,6,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
-	ActivePubKey           types.ActivePublicKey `protobuf:"bytes,7,opt,name=activePubKey,proto3" json:"activePubKey"`
-	QueuedPubKey           types.QueuedPublicKey `protobuf:"bytes,8,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
-	RequestCount           uint64                `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	RequestIdList          []RequestId           `protobuf:"bytes,10,rep,name=requestIdList,proto3" json:"requestIdList"`
+	AggregatedKeyShareList []AggregatedKeyShare    `protobuf:"bytes,6,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
+	ActivePubKey           types.ActivePublicKey   `protobuf:"bytes,7,opt,name=activePubKey,proto3" json:"activePubKey"`
+	QueuedPubKey           types.QueuedPublicKey   `protobuf:"bytes,8,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
+	RequestCount           uint64                  `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	RequestIdList          []RequestId             `protobuf:"bytes,10,rep,name=requestIdList,proto3" json:"requestIdList"`
+	EncryptedTxQueue       []EncryptedTxQueueEntry `protobuf:"bytes,11,rep,name=encryptedTxQueue,proto3" json:"encryptedTxQueue"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -136,6 +137,13 @@ func (m *GenesisState) GetRequestIdList() []RequestId {
 	return nil
 }
 
+func (m *GenesisState) GetEncryptedTxQueue() []EncryptedTxQueueEntry {
+	if m != nil {
+		return m.EncryptedTxQueue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fairyring.pep.GenesisState")
 }
@@ -143,38 +151,39 @@ func init() {
 func init() { proto.RegisterFile("fairyring/pep/genesis.proto", fileDescriptor_c02ca82ac7a8fa8f) }
 
 var fileDescriptor_c02ca82ac7a8fa8f = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x56, 0x75, 0xd4, 0xeb, 0x24, 0x88, 0x80, 0x45, 0x45, 0x64, 0x81, 0x5d, 0xa2,
-	0x1d, 0x12, 0x69, 0x5c, 0xb8, 0xb6, 0x0c, 0xd0, 0x54, 0x84, 0xb6, 0x8c, 0x13, 0x97, 0xc8, 0x4d,
-	0xfe, 0x64, 0xd6, 0xd6, 0xd8, 0x73, 0x1c, 0xd4, 0xbc, 0x05, 0x8f, 0xc1, 0x91, 0xc7, 0xd8, 0x71,
-	0x47, 0x2e, 0x20, 0xd4, 0x1e, 0x78, 0x0d, 0x64, 0xc7, 0x69, 0xe7, 0x4c, 0xbd, 0x54, 0xae, 0xbf,
-	0xef, 0xfb, 0xd9, 0xfe, 0xf2, 0x47, 0xcf, 0xbf, 0x62, 0xc2, 0x2b, 0x4e, 0xf2, 0x2c, 0x64, 0xc0,
-	0xc2, 0x0c, 0x72, 0x28, 0x48, 0x11, 0x30, 0x4e, 0x05, 0xb5, 0x77, 0x57, 0x62, 0xc0, 0x80, 0x0d,
-	0x1f, 0xe3, 0x19, 0xc9, 0x69, 0xa8, 0x7e, 0x6b, 0xc7, 0xf0, 0x49, 0x46, 0x33, 0xaa, 0x96, 0xa1,
-	0x5c, 0xe9, 0xdd, 0xa1, 0x09, 0x65, 0x98, 0xe3, 0x99, 0x66, 0x0e, 0x3d, 0x53, 0x83, 0x3c, 0xe1,
-	0x15, 0x13, 0x90, 0xc6, 0x62, 0xae, 0x1d, 0x2f, 0x5a, 0x69, 0x60, 0x71, 0x4e, 0xf3, 0x04, 0xb4,
-	0xec, 0x9b, 0x32, 0xce, 0x32, 0x0e, 0x19, 0x96, 0x84, 0x4b, 0xa8, 0xe2, 0xe2, 0x02, 0xf3, 0xc6,
-	0x79, 0xb0, 0x76, 0x26, 0x74, 0x36, 0xa3, 0x79, 0xa8, 0xd4, 0x34, 0x16, 0x15, 0x83, 0xe6, 0x3e,
-	0xae, 0x89, 0xe3, 0x70, 0x5d, 0x42, 0x21, 0x62, 0x92, 0xd6, 0xfa, 0xab, 0xdf, 0x5d, 0x34, 0xf8,
-	0x50, 0xb7, 0x72, 0x2e, 0xb0, 0x00, 0xfb, 0x0d, 0xea, 0xd5, 0x0f, 0x72, 0x2c, 0xcf, 0xf2, 0x77,
-	0x8e, 0x9e, 0x06, 0x46, 0x4b, 0xc1, 0xa9, 0x12, 0xc7, 0xfd, 0x9b, 0x3f, 0xfb, 0x9d, 0x1f, 0xff,
-	0x7e, 0x1e, 0x5a, 0x91, 0xf6, 0xdb, 0x7b, 0x68, 0x9b, 0x51, 0x2e, 0xd9, 0xce, 0x03, 0xcf, 0xf2,
-	0xfb, 0x51, 0x4f, 0xfe, 0x3d, 0x49, 0xed, 0x33, 0xf4, 0x68, 0xd5, 0xc3, 0xe7, 0xf9, 0x88, 0x73,
-	0x5c, 0x39, 0x5b, 0xde, 0x96, 0xbf, 0x73, 0xb4, 0xdf, 0x82, 0xbf, 0x6b, 0xd9, 0xc6, 0x5d, 0x79,
-	0x4c, 0x74, 0x2f, 0x6e, 0x8f, 0xd0, 0x80, 0x01, 0xfb, 0x24, 0x7b, 0xfb, 0x48, 0x0a, 0xe1, 0x74,
-	0x15, 0x6e, 0xaf, 0x7d, 0x57, 0x6d, 0xd1, 0x18, 0x23, 0x62, 0xc7, 0xe8, 0xd9, 0xba, 0xdc, 0x09,
-	0x54, 0xe7, 0xb2, 0x3c, 0x05, 0xeb, 0x29, 0xd8, 0xcb, 0x16, 0x6c, 0x74, 0xcf, 0xac, 0xb1, 0x1b,
-	0x30, 0xf6, 0x04, 0x0d, 0x70, 0x22, 0xc8, 0x37, 0x38, 0x2d, 0xa7, 0x13, 0xa8, 0x9c, 0x6d, 0xd5,
-	0xe7, 0x5d, 0x6c, 0xfd, 0xd9, 0x82, 0x51, 0xe3, 0xba, 0x22, 0xc9, 0x04, 0x9a, 0x47, 0x1b, 0x61,
-	0x09, 0xbb, 0x2e, 0xa1, 0x84, 0x54, 0xc3, 0x1e, 0x6e, 0x82, 0x9d, 0x35, 0x2e, 0x13, 0x76, 0x37,
-	0x6c, 0x1f, 0xa0, 0xdd, 0x66, 0x10, 0x12, 0x5a, 0xe6, 0xc2, 0xe9, 0x7b, 0x96, 0xdf, 0x8d, 0x06,
-	0x7a, 0xf3, 0xad, 0xdc, 0xb3, 0x8f, 0x57, 0xa6, 0x93, 0x54, 0xd5, 0x82, 0x54, 0x2d, 0x4e, 0xab,
-	0x96, 0xa8, 0xf1, 0xe8, 0x93, 0xcc, 0xd0, 0xf8, 0xf8, 0x66, 0xe1, 0x5a, 0xb7, 0x0b, 0xd7, 0xfa,
-	0xbb, 0x70, 0xad, 0xef, 0x4b, 0xb7, 0x73, 0xbb, 0x74, 0x3b, 0xbf, 0x96, 0x6e, 0xe7, 0xcb, 0x61,
-	0x46, 0xc4, 0x45, 0x39, 0x95, 0xf7, 0x0e, 0xdf, 0x63, 0xc2, 0xa7, 0x57, 0x34, 0xb9, 0x0c, 0xd7,
-	0xe3, 0x3a, 0x57, 0x03, 0xab, 0x66, 0x79, 0xda, 0x53, 0xc3, 0xfa, 0xfa, 0x7f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x96, 0x34, 0xeb, 0x60, 0xcf, 0x03, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x36, 0x3a, 0xea, 0x76, 0x12, 0x58, 0xc0, 0xa2, 0x22, 0xb2, 0xc0, 0x38, 0x54,
+	0x3b, 0x34, 0xd2, 0xb8, 0x70, 0x6d, 0xd9, 0x40, 0x53, 0x11, 0xda, 0x3a, 0xc4, 0x81, 0x4b, 0xe4,
+	0x26, 0x1f, 0x99, 0xb5, 0x35, 0xf6, 0x1c, 0x07, 0x35, 0x6f, 0xc1, 0x63, 0x70, 0xe4, 0xc2, 0x3b,
+	0xec, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x03, 0xaf, 0x81, 0xec, 0x38, 0x2d, 0xce, 0xe8, 0x25, 0x72,
+	0xfc, 0xff, 0x7f, 0x3f, 0xdb, 0x7f, 0xfb, 0x43, 0x4f, 0x3e, 0x13, 0x2a, 0x0a, 0x41, 0xd3, 0x24,
+	0xe0, 0xc0, 0x83, 0x04, 0x52, 0xc8, 0x68, 0xd6, 0xe7, 0x82, 0x49, 0x86, 0xb7, 0x97, 0x62, 0x9f,
+	0x03, 0xef, 0x3e, 0x20, 0x53, 0x9a, 0xb2, 0x40, 0x7f, 0x4b, 0x47, 0xf7, 0x61, 0xc2, 0x12, 0xa6,
+	0x87, 0x81, 0x1a, 0x99, 0xd9, 0xae, 0x0d, 0xe5, 0x44, 0x90, 0xa9, 0x61, 0x76, 0x7d, 0x5b, 0x83,
+	0x34, 0x12, 0x05, 0x97, 0x10, 0x87, 0x72, 0x66, 0x1c, 0x4f, 0x6b, 0xd5, 0xc0, 0xc3, 0x94, 0xa5,
+	0x11, 0x18, 0xb9, 0x67, 0xcb, 0x24, 0x49, 0x04, 0x24, 0x44, 0x11, 0x2e, 0xa0, 0x08, 0xb3, 0x73,
+	0x22, 0x2a, 0xe7, 0xde, 0xca, 0x19, 0xb1, 0xe9, 0x94, 0xa5, 0x81, 0x56, 0xe3, 0x50, 0x16, 0x1c,
+	0xaa, 0xfd, 0x78, 0x36, 0x4e, 0xc0, 0x55, 0x0e, 0x99, 0x0c, 0x69, 0x5c, 0xea, 0xcf, 0x7f, 0xdc,
+	0x45, 0x9d, 0xb7, 0x65, 0x2a, 0x67, 0x92, 0x48, 0xc0, 0xaf, 0x50, 0xb3, 0x3c, 0x90, 0xeb, 0xf8,
+	0x4e, 0xaf, 0x7d, 0xf0, 0xa8, 0x6f, 0xa5, 0xd4, 0x3f, 0xd1, 0xe2, 0xb0, 0x75, 0xfd, 0x6b, 0xb7,
+	0xf1, 0xed, 0xcf, 0xf7, 0x7d, 0x67, 0x6c, 0xfc, 0x78, 0x07, 0x6d, 0x71, 0x26, 0x14, 0xdb, 0xbd,
+	0xe3, 0x3b, 0xbd, 0xd6, 0xb8, 0xa9, 0x7e, 0x8f, 0x63, 0x7c, 0x8a, 0xee, 0x2f, 0x73, 0xf8, 0x30,
+	0x1b, 0x08, 0x41, 0x0a, 0x77, 0xc3, 0xdf, 0xe8, 0xb5, 0x0f, 0x76, 0x6b, 0xf0, 0xa3, 0x9a, 0x6d,
+	0xb8, 0xa9, 0x96, 0x19, 0xdf, 0x2a, 0xc7, 0x03, 0xd4, 0xe1, 0xc0, 0xdf, 0xab, 0xdc, 0xde, 0xd1,
+	0x4c, 0xba, 0x9b, 0x1a, 0xb7, 0x53, 0xdf, 0xab, 0xb1, 0x18, 0x8c, 0x55, 0x82, 0x43, 0xf4, 0x78,
+	0x15, 0xee, 0x08, 0x8a, 0x33, 0x15, 0x9e, 0x86, 0x35, 0x35, 0xec, 0x59, 0x0d, 0x36, 0xb8, 0x65,
+	0x36, 0xd8, 0x35, 0x18, 0x3c, 0x42, 0x1d, 0x12, 0x49, 0xfa, 0x05, 0x4e, 0xf2, 0xc9, 0x08, 0x0a,
+	0x77, 0xcb, 0x77, 0x6a, 0xd8, 0xf2, 0xda, 0xfa, 0x83, 0xca, 0x75, 0x49, 0xa3, 0x11, 0x54, 0x87,
+	0xb6, 0x8a, 0x15, 0xec, 0x2a, 0x87, 0x1c, 0x62, 0x03, 0xbb, 0xb7, 0x0e, 0x76, 0x5a, 0xb9, 0x6c,
+	0xd8, 0xbf, 0xc5, 0x78, 0x0f, 0x6d, 0x57, 0x0f, 0x21, 0x62, 0x79, 0x2a, 0xdd, 0x96, 0xef, 0xf4,
+	0x36, 0xc7, 0x1d, 0x33, 0xf9, 0x5a, 0xcd, 0xe1, 0xc3, 0xa5, 0xe9, 0x38, 0xd6, 0xb1, 0x20, 0x1d,
+	0x8b, 0x5b, 0x8b, 0x65, 0x5c, 0x79, 0xcc, 0x4a, 0x76, 0x11, 0xfe, 0x68, 0xdd, 0xbd, 0xde, 0x9c,
+	0xdb, 0xd6, 0xa0, 0x17, 0xeb, 0xef, 0x5e, 0xdb, 0x8e, 0x52, 0x29, 0xfe, 0xf7, 0x00, 0xb4, 0x38,
+	0x3c, 0xbc, 0x9e, 0x7b, 0xce, 0xcd, 0xdc, 0x73, 0x7e, 0xcf, 0x3d, 0xe7, 0xeb, 0xc2, 0x6b, 0xdc,
+	0x2c, 0xbc, 0xc6, 0xcf, 0x85, 0xd7, 0xf8, 0xb4, 0x9f, 0x50, 0x79, 0x9e, 0x4f, 0x54, 0x1e, 0xc1,
+	0x1b, 0x42, 0xc5, 0xe4, 0x92, 0x45, 0x17, 0xc1, 0xaa, 0x0d, 0x66, 0xba, 0x11, 0x74, 0x8f, 0x4c,
+	0x9a, 0xba, 0x09, 0x5e, 0xfe, 0x1d, 0x00, 0xe8, 0x81, 0xbb, 0xc9, 0x27, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +206,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EncryptedTxQueue) > 0 {
+		for iNdEx := len(m.EncryptedTxQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTxQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.RequestIdList) > 0 {
 		for iNdEx := len(m.RequestIdList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -352,6 +375,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EncryptedTxQueue) > 0 {
+		for _, e := range m.EncryptedTxQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -676,6 +705,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTxQueue = append(m.EncryptedTxQueue, EncryptedTxQueueEntry{})
+			if err := m.EncryptedTxQueue[len(m.EncryptedTxQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -15,6 +15,19 @@ const (
 	GenEncTxReqQueueKeyPrefix    = "GenEncTxReqQueue/value/"
 	GenEncTxSignalQueueKeyPrefix = "GenEncTxSignalQueue/value/"
 	GenEncTxExeQueueKeyPrefix    = "GenEncTxExeQueue/value/"
+	EncryptedTxQueueKeyPrefix    = "EncryptedTxQueue/value/"
+	EncryptedTxResultKeyPrefix   = "EncryptedTxResult/value/"
+	GenEncTxResultKeyPrefix      = "GenEncTxResult/value/"
+	EncryptedTxCreatorKeyPrefix  = "EncryptedTxCreator/value/"
+	EncryptedTxOrderKeyPrefix    = "EncryptedTxOrder/value/"
+	GenEncTxExpiryKeyPrefix      = "GenEncTxExpiry/value/"
+)
+
+var (
+	// EncryptedTxQueueSequenceKey is the key to store the next sequence of the carry-over execution queue
+	EncryptedTxQueueSequenceKey = KeyPrefix("EncryptedTxQueue/sequence/")
+	// EncryptedTxQueueDepthKey is the key to store the number of entries in the carry-over execution queue
+	EncryptedTxQueueDepthKey = KeyPrefix("EncryptedTxQueue/depth/")
 )
 
 func EncryptedTxAllFromHeightKey(
@@ -40,3 +53,105 @@ func GenEncTxQueueKey(
 
 	return key
 }
+
+// EncryptedTxQueueKey returns the store key of a carry-over execution queue entry,
+// keys are ordered by the sequence the entry was queued with
+func EncryptedTxQueueKey(
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EncryptedTxResultKey returns the store key of the execution result of a height-targeted encrypted tx,
+// keys are ordered by target height so the results can be pruned along with the encrypted txs
+func EncryptedTxResultKey(
+	targetHeight uint64,
+	index uint64,
+) []byte {
+	key := EncryptedTxAllFromHeightKey(targetHeight)
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GenEncTxExpiryKey returns the store key of the expiry index of a general encrypted tx request,
+// keys are ordered by expiry height
+func GenEncTxExpiryKey(
+	expiry uint64,
+	reqID string,
+) []byte {
+	key := EncryptedTxAllFromHeightKey(expiry)
+
+	key = append(key, []byte(reqID)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GenEncTxResultKey returns the store key of the execution result of a general encrypted tx
+func GenEncTxResultKey(
+	identity string,
+	index uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(identity)...)
+	key = append(key, []byte("/")...)
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EncryptedTxCreatorPrefix returns the store prefix of all the encrypted txs submitted by a creator
+func EncryptedTxCreatorPrefix(
+	creator string,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(creator)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EncryptedTxCreatorKey returns the store key of the creator index of an encrypted tx,
+// height-targeted txs are ordered by target height and come before general txs
+func EncryptedTxCreatorKey(
+	creator string,
+	targetHeight uint64,
+	reqID string,
+	index uint64,
+) []byte {
+	key := EncryptedTxCreatorPrefix(creator)
+
+	if len(reqID) == 0 {
+		key = append(key, 0)
+		key = append(key, EncryptedTxAllFromHeightKey(targetHeight)...)
+	} else {
+		key = append(key, 1)
+		key = append(key, []byte(reqID)...)
+		key = append(key, []byte("/")...)
+	}
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
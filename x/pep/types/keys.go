@@ -18,6 +18,10 @@ const (
 
 	// KeushareChannelID is the default channel id that module will use to transmit IBC packets to keyshare module.
 	KeyshareChannelID = "channel-1"
+
+	// MaxEncryptedTxSimulationGas is the maximum gas an encrypted tx simulation can consume,
+	// the query gas limit of the node applies as well
+	MaxEncryptedTxSimulationGas uint64 = 100_000_000
 )
 
 var (
@@ -53,6 +57,7 @@ const (
 	EncryptedTxExecutedEventData             = "data"
 	EncryptedTxExecutedEventMemo             = "memo"
 	EncryptedTxExecutedEventUnderlyingEvents = "events"
+	EncryptedTxExecutedEventMsgResults       = "msg-results"
 )
 
 const (
@@ -70,6 +75,37 @@ const (
 	EncryptedTxDiscardedEventTxIDs  = "tx-ids"
 )
 
+const (
+	EncryptedTxOrderedEventType   = "ordered-encrypted-tx"
+	EncryptedTxOrderedEventHeight = "height"
+	EncryptedTxOrderedEventSeed   = "seed"
+	EncryptedTxOrderedEventTxIDs  = "tx-ids"
+)
+
+const (
+	EncryptedTxCancelledEventType     = "cancelled-encrypted-tx"
+	EncryptedTxCancelledEventCreator  = "creator"
+	EncryptedTxCancelledEventIdentity = "identity"
+	EncryptedTxCancelledEventIndex    = "index"
+	EncryptedTxCancelledEventRefund   = "refund"
+)
+
+const (
+	EncryptedTxStatusPending   = "pending"
+	EncryptedTxStatusExecuted  = "executed"
+	EncryptedTxStatusReverted  = "reverted"
+	EncryptedTxStatusExpired   = "expired"
+	EncryptedTxStatusCancelled = "cancelled"
+)
+
+const (
+	EncryptedTxRefundedEventType     = "refunded-encrypted-tx"
+	EncryptedTxRefundedEventCreator  = "creator"
+	EncryptedTxRefundedEventIdentity = "identity"
+	EncryptedTxRefundedEventIndex    = "index"
+	EncryptedTxRefundedEventAmount   = "amount"
+)
+
 const (
 	KeyShareVerificationType    = "keyshare-verification"
 	KeyShareVerificationCreator = "creator"
@@ -0,0 +1,40 @@
+package types
+
+// EffectiveLanes returns the lane config used to build proposals from the configured lanes and the
+// lanes registered in the app with their default config. The configured lanes that are registered
+// come first in their configured order, followed by the registered lanes that are not configured.
+// The last registered lane matches every tx so it always stays last. The configured lanes are
+// returned as is when no lane is registered.
+func EffectiveLanes(configured, registered []*LaneParams) []*LaneParams {
+	if len(registered) == 0 {
+		return configured
+	}
+
+	catchAll := registered[len(registered)-1]
+	isRegistered := make(map[string]bool, len(registered))
+	for _, lane := range registered {
+		isRegistered[lane.Name] = true
+	}
+
+	lanes := make([]*LaneParams, 0, len(registered))
+	isConfigured := make(map[string]bool, len(configured))
+	for _, lane := range configured {
+		isConfigured[lane.Name] = true
+		if !isRegistered[lane.Name] {
+			continue
+		}
+		if lane.Name == catchAll.Name {
+			catchAll = lane
+			continue
+		}
+		lanes = append(lanes, lane)
+	}
+
+	for _, lane := range registered[:len(registered)-1] {
+		if !isConfigured[lane.Name] {
+			lanes = append(lanes, lane)
+		}
+	}
+
+	return append(lanes, catchAll)
+}
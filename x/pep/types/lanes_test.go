@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+func TestEffectiveLanes(t *testing.T) {
+	lane := func(name string, maxBlockSpace string, maxTxs uint64) *types.LaneParams {
+		return &types.LaneParams{Name: name, MaxBlockSpace: math.LegacyMustNewDecFromStr(maxBlockSpace), MaxTxs: maxTxs}
+	}
+	names := func(lanes []*types.LaneParams) []string {
+		var names []string
+		for _, l := range lanes {
+			names = append(names, l.Name)
+		}
+		return names
+	}
+
+	registered := []*types.LaneParams{lane("keyshare", "0.3", 0), lane("encrypted", "0.2", 0), lane("default", "0", 0)}
+
+	// configured lanes come first, unknown lanes are dropped and the catch-all lane stays last
+	configured := []*types.LaneParams{lane("default", "0", 10), lane("encrypted", "0.5", 5), lane("unknown", "0.1", 0)}
+	effective := types.EffectiveLanes(configured, registered)
+	require.Equal(t, []string{"encrypted", "keyshare", "default"}, names(effective))
+	require.Equal(t, configured[1], effective[0])
+	require.Equal(t, registered[0], effective[1])
+	require.Equal(t, configured[0], effective[2])
+
+	require.Equal(t, registered, types.EffectiveLanes(nil, registered))
+	require.Equal(t, configured, types.EffectiveLanes(configured, nil))
+}
+
+func TestValidateLanes(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		lanes []*types.LaneParams
+		err   string
+	}{
+		{desc: "default", lanes: types.DefaultLanes},
+		{desc: "empty", lanes: nil},
+		{
+			desc:  "duplicate",
+			lanes: []*types.LaneParams{{Name: "a", MaxBlockSpace: math.LegacyZeroDec()}, {Name: "a", MaxBlockSpace: math.LegacyOneDec()}},
+			err:   "duplicate lane",
+		},
+		{
+			desc:  "empty name",
+			lanes: []*types.LaneParams{{MaxBlockSpace: math.LegacyZeroDec()}},
+			err:   "empty",
+		},
+		{
+			desc:  "above the block",
+			lanes: []*types.LaneParams{{Name: "a", MaxBlockSpace: math.LegacyMustNewDecFromStr("0.6")}, {Name: "b", MaxBlockSpace: math.LegacyMustNewDecFromStr("0.5")}},
+			err:   "less than or equal to 1",
+		},
+		{
+			desc:  "several remaining space lanes",
+			lanes: []*types.LaneParams{{Name: "a", MaxBlockSpace: math.LegacyZeroDec()}, {Name: "b", MaxBlockSpace: math.LegacyZeroDec()}},
+			err:   "only one lane",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.Lanes = tc.lanes
+			err := params.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelEncryptedTx = "cancel_encrypted_tx"
+
+var _ sdk.Msg = &MsgCancelEncryptedTx{}
+
+func NewMsgCancelEncryptedTx(creator string, targetHeight uint64, reqID string, index uint64) *MsgCancelEncryptedTx {
+	return &MsgCancelEncryptedTx{
+		Creator:      creator,
+		TargetHeight: targetHeight,
+		ReqId:        reqID,
+		Index:        index,
+	}
+}
+
+func (msg *MsgCancelEncryptedTx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if (msg.TargetHeight == 0) == (len(msg.ReqId) == 0) {
+		return sdkerrors.Wrap(cosmoserror.ErrInvalidRequest, "either target height or request id must be provided")
+	}
+	return nil
+}
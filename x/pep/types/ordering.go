@@ -0,0 +1,83 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+)
+
+const (
+	// EncryptedTxOrderingSubmission executes the encrypted txs of a target height in submission order
+	EncryptedTxOrderingSubmission = "submission"
+	// EncryptedTxOrderingRandomized executes the encrypted txs of a target height in a permutation
+	// derived from the aggregated key of the height, which is unknown when the txs are submitted
+	EncryptedTxOrderingRandomized = "randomized"
+)
+
+// encryptedTxOrderingDomain separates the ordering seed from other uses of the aggregated key
+var encryptedTxOrderingDomain = []byte("fairyring/pep/encrypted-tx-ordering/")
+
+// EncryptedTxOrderingSeed derives the seed of the encrypted tx permutation of a target height
+// from the hex encoded aggregated key of the height
+func EncryptedTxOrderingSeed(aggrKey string, targetHeight uint64) ([]byte, error) {
+	keyBytes, err := hex.DecodeString(aggrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, targetHeight)
+
+	hash := sha256.New()
+	hash.Write(encryptedTxOrderingDomain)
+	hash.Write(heightBytes)
+	hash.Write(keyBytes)
+	return hash.Sum(nil), nil
+}
+
+// PermuteEncryptedTxIndexes returns the indexes shuffled with a Fisher-Yates shuffle driven by the seed,
+// the same seed and indexes always result in the same permutation
+func PermuteEncryptedTxIndexes(seed []byte, indexes []uint64) []uint64 {
+	permuted := make([]uint64, len(indexes))
+	copy(permuted, indexes)
+
+	stream := &seedStream{seed: seed}
+	for i := len(permuted) - 1; i > 0; i-- {
+		j := stream.uniform(uint64(i) + 1)
+		permuted[i], permuted[j] = permuted[j], permuted[i]
+	}
+	return permuted
+}
+
+// seedStream is a deterministic stream of uint64 values, each block is the hash of the seed and a counter
+type seedStream struct {
+	seed    []byte
+	counter uint64
+	block   []byte
+}
+
+func (s *seedStream) next() uint64 {
+	if len(s.block) == 0 {
+		counterBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(counterBytes, s.counter)
+		s.counter++
+
+		hash := sha256.Sum256(append(append([]byte{}, s.seed...), counterBytes...))
+		s.block = hash[:]
+	}
+
+	v := binary.BigEndian.Uint64(s.block[:8])
+	s.block = s.block[8:]
+	return v
+}
+
+// uniform returns a value in [0, n) without modulo bias by rejecting the values above the largest multiple of n
+func (s *seedStream) uniform(n uint64) uint64 {
+	limit := math.MaxUint64 - math.MaxUint64%n
+	for {
+		if v := s.next(); v < limit {
+			return v % n
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fairblock/fairyring/testutil/random"
+	"github.com/Fairblock/fairyring/x/pep/types"
+)
+
+func TestPermuteEncryptedTxIndexes(t *testing.T) {
+	aggrKey := random.RandHex(96)
+
+	seed, err := types.EncryptedTxOrderingSeed(aggrKey, 10)
+	require.NoError(t, err)
+	otherHeightSeed, err := types.EncryptedTxOrderingSeed(aggrKey, 11)
+	require.NoError(t, err)
+	require.NotEqual(t, seed, otherHeightSeed)
+
+	_, err = types.EncryptedTxOrderingSeed("not hex", 10)
+	require.Error(t, err)
+
+	indexes := make([]uint64, 50)
+	for i := range indexes {
+		indexes[i] = uint64(i)
+	}
+
+	permuted := types.PermuteEncryptedTxIndexes(seed, indexes)
+	require.Equal(t, permuted, types.PermuteEncryptedTxIndexes(seed, indexes))
+	require.NotEqual(t, indexes, permuted)
+	require.NotEqual(t, permuted, types.PermuteEncryptedTxIndexes(otherHeightSeed, indexes))
+
+	// the permutation contains every index exactly once and leaves the input untouched
+	sorted := slices.Clone(permuted)
+	slices.Sort(sorted)
+	require.Equal(t, indexes, sorted)
+	require.Equal(t, uint64(0), indexes[0])
+
+	require.Empty(t, types.PermuteEncryptedTxIndexes(seed, nil))
+	require.Equal(t, []uint64{7}, types.PermuteEncryptedTxIndexes(seed, []uint64{7}))
+}
+
+func TestValidateEncryptedTxOrdering(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.EncryptedTxOrderingSubmission, params.EncryptedTxOrdering)
+	require.NoError(t, params.Validate())
+
+	params.EncryptedTxOrdering = types.EncryptedTxOrderingRandomized
+	require.NoError(t, params.Validate())
+
+	params.EncryptedTxOrdering = "fee"
+	require.ErrorContains(t, params.Validate(), "invalid encrypted tx ordering")
+}
@@ -27,6 +27,48 @@ var (
 	DefaultKeysharePrice = sdk.NewCoin("ufairy", cosmosmath.NewInt(300000))
 )
 
+var (
+	KeyMaxEncryptedTxGasPerBlock            = []byte("MaxEncryptedTxGasPerBlock")
+	DefaultMaxEncryptedTxGasPerBlock uint64 = 0
+	KeyMaxEncryptedTxPerBlock               = []byte("MaxEncryptedTxPerBlock")
+	DefaultMaxEncryptedTxPerBlock    uint64 = 0
+)
+
+var (
+	KeyExpiredTxRefundFraction     = []byte("ExpiredTxRefundFraction")
+	DefaultExpiredTxRefundFraction = cosmosmath.LegacyOneDec()
+)
+
+var (
+	KeyEncryptedTxRetentionBlocks                   = []byte("EncryptedTxRetentionBlocks")
+	DefaultEncryptedTxRetentionBlocks        uint64 = 0
+	KeyAggregatedKeyShareRetentionBlocks            = []byte("AggregatedKeyShareRetentionBlocks")
+	DefaultAggregatedKeyShareRetentionBlocks uint64 = 0
+	KeyMaxPrunedEntriesPerBlock                     = []byte("MaxPrunedEntriesPerBlock")
+	DefaultMaxPrunedEntriesPerBlock          uint64 = 100
+)
+
+var (
+	KeyKeyshareRewardRatio     = []byte("KeyshareRewardRatio")
+	DefaultKeyshareRewardRatio = cosmosmath.LegacyNewDecWithPrec(2, 1) // 0.2
+)
+
+var (
+	KeyLanes = []byte("Lanes")
+	// DefaultLanes are the keyshare, encrypted tx and default lanes of the app, the default lane
+	// consumes the block space left by the other lanes
+	DefaultLanes = []*LaneParams{
+		{Name: "keyshare", MaxBlockSpace: cosmosmath.LegacyNewDecWithPrec(3, 1), MaxTxs: 0},
+		{Name: "encrypted", MaxBlockSpace: cosmosmath.LegacyNewDecWithPrec(2, 1), MaxTxs: 0},
+		{Name: "default", MaxBlockSpace: cosmosmath.LegacyZeroDec(), MaxTxs: 0},
+	}
+)
+
+var (
+	KeyEncryptedTxOrdering     = []byte("EncryptedTxOrdering")
+	DefaultEncryptedTxOrdering = EncryptedTxOrderingSubmission
+)
+
 var (
 	KeyTrustedAddresses     = []byte("TrustedAddresses")
 	DefaultTrustedAddresses []string
@@ -50,14 +92,32 @@ func NewParams(
 	minGasPrice *sdk.Coin,
 	isSourceChain bool,
 	keysharePrice *sdk.Coin,
+	maxEncryptedTxGasPerBlock uint64,
+	maxEncryptedTxPerBlock uint64,
+	expiredTxRefundFraction cosmosmath.LegacyDec,
+	encryptedTxRetentionBlocks uint64,
+	aggregatedKeyShareRetentionBlocks uint64,
+	maxPrunedEntriesPerBlock uint64,
+	keyshareRewardRatio cosmosmath.LegacyDec,
+	lanes []*LaneParams,
+	encryptedTxOrdering string,
 ) Params {
 	return Params{
-		TrustedAddresses:      trAddrs,
-		TrustedCounterParties: trustedParties,
-		KeyshareChannelId:     keyshareChannelID,
-		MinGasPrice:           minGasPrice,
-		IsSourceChain:         isSourceChain,
-		PrivateKeysharePrice:  keysharePrice,
+		TrustedAddresses:                  trAddrs,
+		TrustedCounterParties:             trustedParties,
+		KeyshareChannelId:                 keyshareChannelID,
+		MinGasPrice:                       minGasPrice,
+		IsSourceChain:                     isSourceChain,
+		PrivateKeysharePrice:              keysharePrice,
+		MaxEncryptedTxGasPerBlock:         maxEncryptedTxGasPerBlock,
+		MaxEncryptedTxPerBlock:            maxEncryptedTxPerBlock,
+		ExpiredTxRefundFraction:           expiredTxRefundFraction,
+		EncryptedTxRetentionBlocks:        encryptedTxRetentionBlocks,
+		AggregatedKeyShareRetentionBlocks: aggregatedKeyShareRetentionBlocks,
+		MaxPrunedEntriesPerBlock:          maxPrunedEntriesPerBlock,
+		KeyshareRewardRatio:               keyshareRewardRatio,
+		Lanes:                             lanes,
+		EncryptedTxOrdering:               encryptedTxOrdering,
 	}
 }
 
@@ -70,6 +130,15 @@ func DefaultParams() Params {
 		&DefaultMinGasPrice,
 		DefaultIsSourceChain,
 		&DefaultKeysharePrice,
+		DefaultMaxEncryptedTxGasPerBlock,
+		DefaultMaxEncryptedTxPerBlock,
+		DefaultExpiredTxRefundFraction,
+		DefaultEncryptedTxRetentionBlocks,
+		DefaultAggregatedKeyShareRetentionBlocks,
+		DefaultMaxPrunedEntriesPerBlock,
+		DefaultKeyshareRewardRatio,
+		DefaultLanes,
+		DefaultEncryptedTxOrdering,
 	)
 }
 
@@ -82,6 +151,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(KeyIsSourceChain, &p.IsSourceChain, validateIsSourceChain),
 		paramtypes.NewParamSetPair(KeyKeysharePrice, &p.PrivateKeysharePrice, validateMinGasPrice),
+		paramtypes.NewParamSetPair(KeyMaxEncryptedTxGasPerBlock, &p.MaxEncryptedTxGasPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxEncryptedTxPerBlock, &p.MaxEncryptedTxPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyExpiredTxRefundFraction, &p.ExpiredTxRefundFraction, validateExpiredTxRefundFraction),
+		paramtypes.NewParamSetPair(KeyEncryptedTxRetentionBlocks, &p.EncryptedTxRetentionBlocks, validateUint64),
+		paramtypes.NewParamSetPair(KeyAggregatedKeyShareRetentionBlocks, &p.AggregatedKeyShareRetentionBlocks, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxPrunedEntriesPerBlock, &p.MaxPrunedEntriesPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyKeyshareRewardRatio, &p.KeyshareRewardRatio, validateKeyshareRewardRatio),
+		paramtypes.NewParamSetPair(KeyLanes, &p.Lanes, validateLanes),
+		paramtypes.NewParamSetPair(KeyEncryptedTxOrdering, &p.EncryptedTxOrdering, validateEncryptedTxOrdering),
 	}
 }
 
@@ -111,6 +189,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateUint64(p.MaxEncryptedTxGasPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.MaxEncryptedTxPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateExpiredTxRefundFraction(p.ExpiredTxRefundFraction); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.EncryptedTxRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.AggregatedKeyShareRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.MaxPrunedEntriesPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateKeyshareRewardRatio(p.KeyshareRewardRatio); err != nil {
+		return err
+	}
+
+	if err := validateLanes(p.Lanes); err != nil {
+		return err
+	}
+
+	if err := validateEncryptedTxOrdering(p.EncryptedTxOrdering); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -138,6 +252,98 @@ func validateIsSourceChain(v interface{}) error {
 	return nil
 }
 
+// validateUint64 validates an uint64 param, where 0 means the limit is disabled
+func validateUint64(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateExpiredTxRefundFraction validates the ExpiredTxRefundFraction param
+func validateExpiredTxRefundFraction(v interface{}) error {
+	val, ok := v.(cosmosmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(cosmosmath.LegacyOneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+	return nil
+}
+
+// validateKeyshareRewardRatio validates the KeyshareRewardRatio param
+func validateKeyshareRewardRatio(v interface{}) error {
+	val, ok := v.(cosmosmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(cosmosmath.LegacyOneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+	return nil
+}
+
+// validateLanes validates the Lanes param, the lane names must be unique, the block space shares
+// must not exceed the whole block and only one lane can consume the block space left by the others
+func validateLanes(v interface{}) error {
+	lanes, ok := v.([]*LaneParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(lanes))
+	sum := cosmosmath.LegacyZeroDec()
+	remainingSpaceLanes := 0
+	for i, lane := range lanes {
+		if lane == nil {
+			return fmt.Errorf("lane at index %d is null", i)
+		}
+		if len(lane.Name) < 1 {
+			return fmt.Errorf("lane name at index %d is empty", i)
+		}
+		if seen[lane.Name] {
+			return fmt.Errorf("duplicate lane: %s", lane.Name)
+		}
+		seen[lane.Name] = true
+
+		if lane.MaxBlockSpace.IsNil() || lane.MaxBlockSpace.IsNegative() || lane.MaxBlockSpace.GT(cosmosmath.LegacyOneDec()) {
+			return fmt.Errorf("invalid max block space of lane %s, expected value between 0 and 1, got %v", lane.Name, lane.MaxBlockSpace)
+		}
+		if lane.MaxBlockSpace.IsZero() {
+			remainingSpaceLanes++
+		}
+		sum = sum.Add(lane.MaxBlockSpace)
+	}
+
+	if sum.GT(cosmosmath.LegacyOneDec()) {
+		return fmt.Errorf("sum of the lane max block spaces must be less than or equal to 1, got %v", sum)
+	}
+	if remainingSpaceLanes > 1 {
+		return fmt.Errorf("only one lane can have a max block space of 0, got %d", remainingSpaceLanes)
+	}
+
+	return nil
+}
+
+// validateEncryptedTxOrdering validates the EncryptedTxOrdering param
+func validateEncryptedTxOrdering(v interface{}) error {
+	ordering, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	switch ordering {
+	case EncryptedTxOrderingSubmission, EncryptedTxOrderingRandomized:
+		return nil
+	default:
+		return fmt.Errorf("invalid encrypted tx ordering: %s, expected %s or %s",
+			ordering, EncryptedTxOrderingSubmission, EncryptedTxOrderingRandomized)
+	}
+}
+
 func validateMinGasPrice(v interface{}) error {
 
 	minGasPrice, ok := v.(*sdk.Coin)
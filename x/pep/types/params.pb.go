@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -33,6 +34,27 @@ type Params struct {
 	TrustedAddresses      []string               `protobuf:"bytes,4,rep,name=trusted_addresses,json=trustedAddresses,proto3" json:"trusted_addresses,omitempty" yaml:"trusted_addresses"`
 	MinGasPrice           *types.Coin            `protobuf:"bytes,5,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty" yaml:"min_gas_price"`
 	PrivateKeysharePrice  *types.Coin            `protobuf:"bytes,6,opt,name=private_keyshare_price,json=privateKeysharePrice,proto3" json:"private_keyshare_price,omitempty" yaml:"private_keyshare_price"`
+	// max_encrypted_tx_gas_per_block is the maximum gas that encrypted tx execution may use in a single block, 0 means unlimited
+	MaxEncryptedTxGasPerBlock uint64 `protobuf:"varint,7,opt,name=max_encrypted_tx_gas_per_block,json=maxEncryptedTxGasPerBlock,proto3" json:"max_encrypted_tx_gas_per_block,omitempty" yaml:"max_encrypted_tx_gas_per_block"`
+	// max_encrypted_tx_per_block is the maximum number of encrypted txs executed in a single block, 0 means unlimited
+	MaxEncryptedTxPerBlock uint64 `protobuf:"varint,8,opt,name=max_encrypted_tx_per_block,json=maxEncryptedTxPerBlock,proto3" json:"max_encrypted_tx_per_block,omitempty" yaml:"max_encrypted_tx_per_block"`
+	// expired_tx_refund_fraction is the fraction of the charged gas refunded for encrypted txs
+	// that expired or were discarded because their decryption key was never delivered
+	ExpiredTxRefundFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=expired_tx_refund_fraction,json=expiredTxRefundFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"expired_tx_refund_fraction" yaml:"expired_tx_refund_fraction"`
+	// number of heights below the last executed height for which encrypted txs are kept, 0 keeps them forever
+	EncryptedTxRetentionBlocks uint64 `protobuf:"varint,10,opt,name=encrypted_tx_retention_blocks,json=encryptedTxRetentionBlocks,proto3" json:"encrypted_tx_retention_blocks,omitempty" yaml:"encrypted_tx_retention_blocks"`
+	// number of heights below the last executed height for which aggregated keyshares are kept, 0 keeps them forever
+	AggregatedKeyShareRetentionBlocks uint64 `protobuf:"varint,11,opt,name=aggregated_key_share_retention_blocks,json=aggregatedKeyShareRetentionBlocks,proto3" json:"aggregated_key_share_retention_blocks,omitempty" yaml:"aggregated_key_share_retention_blocks"`
+	// maximum number of entries removed from each pruned store per block
+	MaxPrunedEntriesPerBlock uint64 `protobuf:"varint,12,opt,name=max_pruned_entries_per_block,json=maxPrunedEntriesPerBlock,proto3" json:"max_pruned_entries_per_block,omitempty" yaml:"max_pruned_entries_per_block"`
+	// share of the encrypted tx fees and private keyshare fees sent to the keyshare reward pool
+	KeyshareRewardRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=keyshare_reward_ratio,json=keyshareRewardRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"keyshare_reward_ratio" yaml:"keyshare_reward_ratio"`
+	// lanes defines the order, block space share and max tx count of the block lanes,
+	// the registered lanes that are not listed keep their default config
+	Lanes []*LaneParams `protobuf:"bytes,16,rep,name=lanes,proto3" json:"lanes,omitempty" yaml:"lanes"`
+	// encrypted_tx_ordering is the order the encrypted txs of a target height are executed in,
+	// "submission" executes them by index and "randomized" permutes them with a seed derived from the aggregated key
+	EncryptedTxOrdering string `protobuf:"bytes,17,opt,name=encrypted_tx_ordering,json=encryptedTxOrdering,proto3" json:"encrypted_tx_ordering,omitempty" yaml:"encrypted_tx_ordering"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -110,6 +132,55 @@ func (m *Params) GetPrivateKeysharePrice() *types.Coin {
 	return nil
 }
 
+func (m *Params) GetMaxEncryptedTxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEncryptedTxGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEncryptedTxPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEncryptedTxPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetEncryptedTxRetentionBlocks() uint64 {
+	if m != nil {
+		return m.EncryptedTxRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAggregatedKeyShareRetentionBlocks() uint64 {
+	if m != nil {
+		return m.AggregatedKeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedEntriesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedEntriesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetLanes() []*LaneParams {
+	if m != nil {
+		return m.Lanes
+	}
+	return nil
+}
+
+func (m *Params) GetEncryptedTxOrdering() string {
+	if m != nil {
+		return m.EncryptedTxOrdering
+	}
+	return ""
+}
+
 type TrustedCounterParty struct {
 	ClientId     string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -170,48 +241,133 @@ func (m *TrustedCounterParty) GetChannelId() string {
 	return ""
 }
 
+// LaneParams defines the block space share and max tx count of a block lane
+type LaneParams struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// share of the block space the lane can consume, 0 means the block space left by the previous lanes
+	MaxBlockSpace cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_block_space,json=maxBlockSpace,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_space" yaml:"max_block_space"`
+	// maximum number of txs the lane includes in a block, 0 means unlimited
+	MaxTxs uint64 `protobuf:"varint,3,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty" yaml:"max_txs"`
+}
+
+func (m *LaneParams) Reset()         { *m = LaneParams{} }
+func (m *LaneParams) String() string { return proto.CompactTextString(m) }
+func (*LaneParams) ProtoMessage()    {}
+func (*LaneParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a32cf7d58c7a431, []int{2}
+}
+func (m *LaneParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneParams.Merge(m, src)
+}
+func (m *LaneParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneParams proto.InternalMessageInfo
+
+func (m *LaneParams) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LaneParams) GetMaxTxs() uint64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fairyring.pep.Params")
 	proto.RegisterType((*TrustedCounterParty)(nil), "fairyring.pep.TrustedCounterParty")
+	proto.RegisterType((*LaneParams)(nil), "fairyring.pep.LaneParams")
 }
 
 func init() { proto.RegisterFile("fairyring/pep/params.proto", fileDescriptor_9a32cf7d58c7a431) }
 
 var fileDescriptor_9a32cf7d58c7a431 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0x12, 0x41,
-	0x1c, 0xc6, 0xd9, 0x52, 0x49, 0x19, 0x24, 0xca, 0x14, 0x71, 0xa5, 0x76, 0xc1, 0xf5, 0x42, 0x7a,
-	0xd8, 0x4d, 0xeb, 0xad, 0x37, 0x17, 0xa3, 0x21, 0x26, 0x86, 0xac, 0x26, 0x26, 0xbd, 0x6c, 0x86,
-	0xd9, 0x11, 0x26, 0x65, 0x67, 0x26, 0x33, 0x03, 0x91, 0x57, 0xf0, 0xe4, 0x8b, 0x98, 0xf8, 0x18,
-	0x1e, 0x7b, 0xf4, 0x44, 0x0c, 0x1c, 0xbc, 0xf3, 0x04, 0x86, 0x99, 0x85, 0xda, 0x96, 0xa4, 0x97,
-	0xcd, 0xcc, 0xf7, 0xff, 0xed, 0xff, 0x9b, 0x7c, 0xf9, 0x40, 0xf3, 0x0b, 0xa2, 0x72, 0x26, 0x29,
-	0x1b, 0x86, 0x82, 0x88, 0x50, 0x20, 0x89, 0x32, 0x15, 0x08, 0xc9, 0x35, 0x87, 0xd5, 0xed, 0x2c,
-	0x10, 0x44, 0x34, 0x6b, 0x28, 0xa3, 0x8c, 0x87, 0xe6, 0x6b, 0x89, 0x66, 0x7d, 0xc8, 0x87, 0xdc,
-	0x1c, 0xc3, 0xf5, 0x29, 0x57, 0x3d, 0xcc, 0x55, 0xc6, 0x55, 0x38, 0x40, 0x8a, 0x84, 0xd3, 0xd3,
-	0x01, 0xd1, 0xe8, 0x34, 0xc4, 0x9c, 0x32, 0x3b, 0xf7, 0x7f, 0xec, 0x83, 0x52, 0xdf, 0x18, 0xc1,
-	0x0f, 0xe0, 0xf0, 0x92, 0xcc, 0xd4, 0x08, 0x49, 0x92, 0xe0, 0x11, 0x62, 0x8c, 0x8c, 0x13, 0x9a,
-	0xba, 0x4e, 0xdb, 0xe9, 0x94, 0x23, 0x6f, 0x35, 0x6f, 0x35, 0x67, 0x28, 0x1b, 0x9f, 0xfb, 0x3b,
-	0x20, 0x3f, 0xae, 0x6d, 0xd4, 0xae, 0x15, 0x7b, 0x29, 0x8c, 0xc0, 0x23, 0xaa, 0x12, 0xc5, 0x27,
-	0x12, 0x1b, 0x96, 0x32, 0x77, 0xaf, 0xed, 0x74, 0x0e, 0xa2, 0xe6, 0x6a, 0xde, 0x6a, 0xd8, 0x5d,
-	0xb7, 0x00, 0x3f, 0xae, 0x52, 0xf5, 0xd1, 0x08, 0xdd, 0xf5, 0x1d, 0x5e, 0x80, 0xa7, 0x5a, 0x4e,
-	0x94, 0x26, 0x69, 0x82, 0xf9, 0x84, 0x69, 0x22, 0x13, 0x81, 0xa4, 0xa6, 0x44, 0xb9, 0xc5, 0x76,
-	0xb1, 0x53, 0x39, 0xf3, 0x83, 0x1b, 0xc1, 0x04, 0x9f, 0x2c, 0xdd, 0xb5, 0x70, 0x1f, 0x49, 0x3d,
-	0x8b, 0x9f, 0xe8, 0x3b, 0x22, 0x25, 0x0a, 0xf6, 0x40, 0x6d, 0xb3, 0x1b, 0xa5, 0xa9, 0x24, 0x4a,
-	0x11, 0xe5, 0xee, 0xb7, 0x8b, 0x9d, 0x72, 0xf4, 0x7c, 0x35, 0x6f, 0xb9, 0xf6, 0x85, 0x77, 0x10,
-	0x3f, 0x7e, 0x9c, 0x6b, 0xaf, 0x37, 0x12, 0xfc, 0x0c, 0xaa, 0x19, 0x65, 0xc9, 0x10, 0xa9, 0x44,
-	0x48, 0x8a, 0x89, 0xfb, 0xa0, 0xed, 0x74, 0x2a, 0x67, 0xcf, 0x02, 0x9b, 0x7e, 0xb0, 0x4e, 0x3f,
-	0xc8, 0xd3, 0x0f, 0xba, 0x9c, 0xb2, 0xc8, 0x5d, 0xcd, 0x5b, 0x75, 0xeb, 0x70, 0xe3, 0x4f, 0x3f,
-	0xae, 0x64, 0x94, 0xbd, 0x43, 0xaa, 0xbf, 0xbe, 0x41, 0x09, 0x1a, 0x42, 0xd2, 0x29, 0xd2, 0x24,
-	0xd9, 0xc6, 0x6e, 0x1d, 0x4a, 0xf7, 0x39, 0xbc, 0x58, 0xcd, 0x5b, 0xc7, 0xd6, 0x61, 0xf7, 0x0a,
-	0x3f, 0xae, 0xe7, 0x83, 0xf7, 0xb9, 0x6e, 0x3c, 0xcf, 0x8f, 0xbe, 0xfd, 0xfd, 0x79, 0xd2, 0xb8,
-	0xee, 0xe2, 0x57, 0xd3, 0x46, 0x5b, 0x12, 0x7f, 0x0a, 0x0e, 0x77, 0x44, 0x0c, 0x8f, 0x40, 0x19,
-	0x8f, 0x29, 0x61, 0x7a, 0xdb, 0x98, 0xf8, 0xc0, 0x0a, 0xbd, 0x14, 0xbe, 0x04, 0x55, 0xcc, 0x19,
-	0x23, 0x58, 0x53, 0xce, 0xd6, 0xc0, 0x9e, 0x01, 0x1e, 0x5e, 0x8b, 0xbd, 0x14, 0x1e, 0x03, 0xf0,
-	0x5f, 0xe9, 0x8a, 0x86, 0x28, 0xe3, 0x4d, 0x99, 0xa2, 0x37, 0xbf, 0x16, 0x9e, 0x73, 0xb5, 0xf0,
-	0x9c, 0x3f, 0x0b, 0xcf, 0xf9, 0xbe, 0xf4, 0x0a, 0x57, 0x4b, 0xaf, 0xf0, 0x7b, 0xe9, 0x15, 0x2e,
-	0x4e, 0x86, 0x54, 0x8f, 0x26, 0x83, 0x00, 0xf3, 0x2c, 0x7c, 0x8b, 0xa8, 0x1c, 0x8c, 0x39, 0xbe,
-	0x0c, 0x6f, 0x3f, 0x5f, 0xcf, 0x04, 0x51, 0x83, 0x92, 0x29, 0xfd, 0xab, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x6e, 0x7b, 0x9e, 0xa6, 0x6a, 0x03, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x4f, 0xdc, 0x46,
+	0x14, 0xc7, 0x61, 0x43, 0x60, 0x60, 0xc3, 0xe2, 0x00, 0x31, 0x0b, 0xac, 0x17, 0xa7, 0xa8, 0xdb,
+	0xb4, 0xf2, 0x36, 0xe9, 0x2d, 0x52, 0x0f, 0x31, 0x49, 0x5a, 0x92, 0xa8, 0x45, 0x03, 0x52, 0xa5,
+	0x5c, 0xac, 0xc1, 0x7e, 0x2c, 0x23, 0xd6, 0x63, 0x6b, 0x66, 0x96, 0x78, 0xaf, 0x95, 0x7a, 0xe9,
+	0xa9, 0x1f, 0xa5, 0x87, 0x7e, 0x88, 0x1c, 0x73, 0xac, 0x7a, 0xb0, 0x2a, 0x38, 0xf4, 0xee, 0x2f,
+	0xd0, 0xca, 0x33, 0xde, 0xf5, 0xf2, 0x2f, 0xe5, 0xb2, 0x5a, 0xff, 0xde, 0xef, 0xbd, 0xdf, 0xfb,
+	0xa7, 0x37, 0xa8, 0x79, 0x44, 0x28, 0x1f, 0x72, 0xca, 0x7a, 0xdd, 0x04, 0x92, 0x6e, 0x42, 0x38,
+	0x89, 0x84, 0x9b, 0xf0, 0x58, 0xc6, 0x66, 0x7d, 0x6c, 0x73, 0x13, 0x48, 0x9a, 0x4b, 0x24, 0xa2,
+	0x2c, 0xee, 0xaa, 0x5f, 0xcd, 0x68, 0x2e, 0xf7, 0xe2, 0x5e, 0xac, 0xfe, 0x76, 0x8b, 0x7f, 0x25,
+	0xda, 0x0a, 0x62, 0x11, 0xc5, 0xa2, 0x7b, 0x48, 0x04, 0x74, 0x4f, 0x9f, 0x1c, 0x82, 0x24, 0x4f,
+	0xba, 0x41, 0x4c, 0x99, 0xb6, 0x3b, 0xff, 0xce, 0xa3, 0x99, 0x3d, 0x25, 0x64, 0xfe, 0x80, 0x1e,
+	0x9c, 0xc0, 0x50, 0x1c, 0x13, 0x0e, 0x7e, 0x70, 0x4c, 0x18, 0x83, 0xbe, 0x4f, 0x43, 0xcb, 0x68,
+	0x1b, 0x9d, 0x39, 0xaf, 0x95, 0x67, 0x76, 0x73, 0x48, 0xa2, 0xfe, 0x33, 0xe7, 0x1a, 0x92, 0x83,
+	0x97, 0x46, 0xe8, 0x8e, 0x06, 0x77, 0x43, 0xd3, 0x43, 0x8b, 0x54, 0xf8, 0x22, 0x1e, 0xf0, 0x40,
+	0x71, 0x29, 0xb3, 0xee, 0xb4, 0x8d, 0xce, 0xac, 0xd7, 0xcc, 0x33, 0x7b, 0x55, 0xc7, 0xba, 0x44,
+	0x70, 0x70, 0x9d, 0x8a, 0x7d, 0x05, 0xec, 0x14, 0xdf, 0xe6, 0x3b, 0xf4, 0x50, 0xf2, 0x81, 0x90,
+	0x10, 0xfa, 0x41, 0x3c, 0x60, 0x12, 0xb8, 0x9f, 0x10, 0x2e, 0x29, 0x08, 0x6b, 0xba, 0x3d, 0xdd,
+	0x99, 0x7f, 0xea, 0xb8, 0x17, 0x1a, 0xe3, 0x1e, 0x68, 0xf6, 0x8e, 0x26, 0xef, 0x11, 0x2e, 0x87,
+	0x78, 0x45, 0x5e, 0x01, 0x29, 0x08, 0x73, 0x17, 0x2d, 0x8d, 0x62, 0x93, 0x30, 0xe4, 0x20, 0x04,
+	0x08, 0xab, 0xd6, 0x9e, 0xee, 0xcc, 0x79, 0x1b, 0x79, 0x66, 0x5b, 0x3a, 0xc3, 0x2b, 0x14, 0x07,
+	0x37, 0x4a, 0xec, 0xf9, 0x08, 0x32, 0x7f, 0x42, 0xf5, 0x88, 0x32, 0xbf, 0x47, 0x84, 0x9f, 0x70,
+	0x1a, 0x80, 0x75, 0xb7, 0x6d, 0x74, 0xe6, 0x9f, 0xae, 0xb9, 0xba, 0xfb, 0x6e, 0xd1, 0x7d, 0xb7,
+	0xec, 0xbe, 0xbb, 0x13, 0x53, 0xe6, 0x59, 0x79, 0x66, 0x2f, 0x6b, 0x85, 0x0b, 0x9e, 0x0e, 0x9e,
+	0x8f, 0x28, 0xfb, 0x8e, 0x88, 0xbd, 0xe2, 0xcb, 0xe4, 0x68, 0x35, 0xe1, 0xf4, 0x94, 0x48, 0xf0,
+	0xc7, 0x6d, 0xd7, 0x0a, 0x33, 0xff, 0xa7, 0xb0, 0x95, 0x67, 0xf6, 0xa6, 0x56, 0xb8, 0x3e, 0x84,
+	0x83, 0x97, 0x4b, 0xc3, 0x9b, 0x12, 0xd7, 0x9a, 0x7d, 0xd4, 0x8a, 0x48, 0xea, 0x03, 0x0b, 0xf8,
+	0x30, 0x29, 0x4a, 0x97, 0xa9, 0xce, 0x0f, 0xb8, 0x7f, 0xd8, 0x8f, 0x83, 0x13, 0xeb, 0x5e, 0xdb,
+	0xe8, 0xd4, 0xbc, 0x2f, 0xf2, 0xcc, 0xde, 0x2e, 0x4b, 0xf8, 0x24, 0xdf, 0xc1, 0x6b, 0x11, 0x49,
+	0x5f, 0x8e, 0xec, 0x07, 0x69, 0x51, 0x1e, 0x70, 0xaf, 0xb0, 0x99, 0x04, 0x35, 0xaf, 0x78, 0x57,
+	0x4a, 0xb3, 0x4a, 0x69, 0x3b, 0xcf, 0xec, 0xad, 0x1b, 0x94, 0x26, 0x54, 0x56, 0x2f, 0xaa, 0x8c,
+	0x25, 0x7e, 0x31, 0x50, 0x13, 0xd2, 0x84, 0x72, 0xed, 0xc1, 0xe1, 0x68, 0xc0, 0x42, 0xff, 0x88,
+	0x93, 0x40, 0xd2, 0x98, 0x59, 0x73, 0x6d, 0xa3, 0xb3, 0xe0, 0x7d, 0xff, 0x21, 0xb3, 0xa7, 0xfe,
+	0xca, 0xec, 0x75, 0xdd, 0x50, 0x11, 0x9e, 0xb8, 0x34, 0xee, 0x46, 0x44, 0x1e, 0xbb, 0x6f, 0xa1,
+	0x47, 0x82, 0xe1, 0x0b, 0x08, 0xaa, 0x34, 0x6e, 0x0e, 0xe7, 0xe0, 0x87, 0xa5, 0xf1, 0x20, 0xc5,
+	0xca, 0xf4, 0xaa, 0xb4, 0x98, 0x27, 0x68, 0xf3, 0x42, 0xea, 0x1c, 0x24, 0xb0, 0xc2, 0xa2, 0x0b,
+	0x10, 0x16, 0x52, 0xd5, 0x76, 0xf2, 0xcc, 0xfe, 0xac, 0x94, 0xf9, 0x14, 0xdd, 0xc1, 0x4d, 0xa8,
+	0xaa, 0xc5, 0x23, 0xab, 0xaa, 0x59, 0x98, 0x3f, 0x1b, 0x68, 0x9b, 0xf4, 0x7a, 0x1c, 0x7a, 0xa4,
+	0xf0, 0x3f, 0x81, 0xa1, 0xaf, 0x67, 0x7f, 0x45, 0x75, 0x5e, 0xa9, 0x7e, 0x9d, 0x67, 0xf6, 0x57,
+	0x5a, 0xf5, 0x56, 0x6e, 0x0e, 0xde, 0xaa, 0x78, 0x6f, 0x60, 0xb8, 0x5f, 0xb0, 0x2e, 0x27, 0xd1,
+	0x43, 0x1b, 0xc5, 0xc0, 0x12, 0x3e, 0x60, 0x10, 0xfa, 0xc0, 0x24, 0xa7, 0x30, 0xb9, 0x48, 0x0b,
+	0x4a, 0xfa, 0xf3, 0x3c, 0xb3, 0x1f, 0x55, 0xe3, 0xbd, 0x89, 0xed, 0x60, 0x2b, 0x22, 0xe9, 0x9e,
+	0xb2, 0xbe, 0xd4, 0xc6, 0xf1, 0x88, 0xdf, 0xa3, 0x95, 0xf1, 0x72, 0x73, 0x78, 0x4f, 0x78, 0xe8,
+	0x73, 0x22, 0x69, 0x6c, 0xd5, 0xd5, 0x70, 0x77, 0x6e, 0x37, 0xdc, 0x8d, 0x4b, 0x07, 0x6e, 0x32,
+	0x92, 0x83, 0xc7, 0xd7, 0x11, 0x2b, 0x18, 0x17, 0xa8, 0xf9, 0x1c, 0xdd, 0xed, 0x13, 0x06, 0xc2,
+	0x6a, 0xa8, 0x73, 0xb4, 0x76, 0xe9, 0x1c, 0xbd, 0x25, 0x0c, 0xf4, 0x79, 0xf5, 0x1a, 0x79, 0x66,
+	0x2f, 0x68, 0x01, 0xe5, 0xe1, 0x60, 0xed, 0x69, 0x1e, 0xa0, 0x95, 0x0b, 0x73, 0x8e, 0x79, 0x08,
+	0x45, 0x00, 0x6b, 0x49, 0x5d, 0xde, 0x76, 0x95, 0xd8, 0xb5, 0x34, 0x07, 0x3f, 0x98, 0x58, 0x83,
+	0x1f, 0x4b, 0xf4, 0xd9, 0xfa, 0xaf, 0xff, 0xfc, 0xfe, 0x78, 0xb5, 0x7a, 0x51, 0x52, 0xf5, 0xa6,
+	0xe8, 0x5c, 0x5e, 0xd7, 0x66, 0xef, 0x37, 0x16, 0x5f, 0xd7, 0x66, 0x17, 0x1b, 0x0d, 0xe7, 0x14,
+	0x3d, 0xb8, 0xe6, 0x68, 0x9a, 0xeb, 0x68, 0x2e, 0xe8, 0x53, 0x60, 0x72, 0xfc, 0x06, 0xe0, 0x59,
+	0x0d, 0xec, 0x86, 0xe6, 0x23, 0x54, 0x0f, 0x62, 0xc6, 0x40, 0xed, 0x75, 0x41, 0xb8, 0xa3, 0x08,
+	0x0b, 0x15, 0xb8, 0x1b, 0x9a, 0x9b, 0x08, 0x4d, 0x3c, 0x23, 0xd3, 0x8a, 0x31, 0x17, 0x8c, 0x9e,
+	0x07, 0xe7, 0x0f, 0x03, 0xa1, 0xaa, 0x3d, 0xa6, 0x89, 0x6a, 0x8c, 0x44, 0x50, 0x4a, 0xa9, 0xff,
+	0x26, 0xa0, 0xc5, 0x62, 0x21, 0xd4, 0xf4, 0x7d, 0x91, 0x90, 0x00, 0x94, 0xd0, 0x82, 0xf7, 0xed,
+	0xed, 0xe6, 0xb9, 0x5a, 0x2d, 0xd5, 0x44, 0x0c, 0x07, 0xd7, 0x23, 0x92, 0xaa, 0xbd, 0xd9, 0x2f,
+	0xbe, 0xcd, 0x2f, 0xd1, 0xbd, 0x82, 0x22, 0x53, 0xa1, 0xb2, 0xac, 0x79, 0x66, 0x9e, 0xd9, 0xf7,
+	0x2b, 0x5f, 0x99, 0x0a, 0x07, 0xcf, 0x44, 0x24, 0x3d, 0x48, 0x85, 0xf7, 0xe2, 0xc3, 0x59, 0xcb,
+	0xf8, 0x78, 0xd6, 0x32, 0xfe, 0x3e, 0x6b, 0x19, 0xbf, 0x9d, 0xb7, 0xa6, 0x3e, 0x9e, 0xb7, 0xa6,
+	0xfe, 0x3c, 0x6f, 0x4d, 0xbd, 0x7b, 0xdc, 0xa3, 0xf2, 0x78, 0x70, 0xe8, 0x06, 0x71, 0xd4, 0x7d,
+	0x45, 0x28, 0x57, 0x92, 0xdd, 0xcb, 0x13, 0x90, 0xc3, 0x04, 0xc4, 0xe1, 0x8c, 0x7a, 0x7d, 0xbf,
+	0xf9, 0x6f, 0x00, 0x06, 0xd9, 0x6c, 0x3d, 0xf3, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -234,6 +390,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EncryptedTxOrdering) > 0 {
+		i -= len(m.EncryptedTxOrdering)
+		copy(dAtA[i:], m.EncryptedTxOrdering)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EncryptedTxOrdering)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Lanes) > 0 {
+		for iNdEx := len(m.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.KeyshareRewardRatio.Size()
+		i -= size
+		if _, err := m.KeyshareRewardRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxPrunedEntriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedEntriesPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AggregatedKeyShareRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggregatedKeyShareRetentionBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EncryptedTxRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EncryptedTxRetentionBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.ExpiredTxRefundFraction.Size()
+		i -= size
+		if _, err := m.ExpiredTxRefundFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxEncryptedTxPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEncryptedTxPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxEncryptedTxGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEncryptedTxGasPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.PrivateKeysharePrice != nil {
 		{
 			size, err := m.PrivateKeysharePrice.MarshalToSizedBuffer(dAtA[:i])
@@ -345,6 +571,51 @@ func (m *TrustedCounterParty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LaneParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaneParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxBlockSpace.Size()
+		i -= size
+		if _, err := m.MaxBlockSpace.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -389,6 +660,35 @@ func (m *Params) Size() (n int) {
 		l = m.PrivateKeysharePrice.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxEncryptedTxGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxEncryptedTxGasPerBlock))
+	}
+	if m.MaxEncryptedTxPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxEncryptedTxPerBlock))
+	}
+	l = m.ExpiredTxRefundFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EncryptedTxRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.EncryptedTxRetentionBlocks))
+	}
+	if m.AggregatedKeyShareRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AggregatedKeyShareRetentionBlocks))
+	}
+	if m.MaxPrunedEntriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedEntriesPerBlock))
+	}
+	l = m.KeyshareRewardRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Lanes) > 0 {
+		for _, e := range m.Lanes {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.EncryptedTxOrdering)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -413,6 +713,24 @@ func (m *TrustedCounterParty) Size() (n int) {
 	return n
 }
 
+func (m *LaneParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxBlockSpace.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxTxs != 0 {
+		n += 1 + sovParams(uint64(m.MaxTxs))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -638,6 +956,233 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedTxGasPerBlock", wireType)
+			}
+			m.MaxEncryptedTxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEncryptedTxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedTxPerBlock", wireType)
+			}
+			m.MaxEncryptedTxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEncryptedTxPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredTxRefundFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiredTxRefundFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxRetentionBlocks", wireType)
+			}
+			m.EncryptedTxRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncryptedTxRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareRetentionBlocks", wireType)
+			}
+			m.AggregatedKeyShareRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregatedKeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedEntriesPerBlock", wireType)
+			}
+			m.MaxPrunedEntriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedEntriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareRewardRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyshareRewardRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lanes = append(m.Lanes, &LaneParams{})
+			if err := m.Lanes[len(m.Lanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxOrdering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTxOrdering = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -805,6 +1350,140 @@ func (m *TrustedCounterParty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LaneParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaneParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaneParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			m.MaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
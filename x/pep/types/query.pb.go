@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/Fairblock/fairyring/x/common/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1148,1812 +1148,5410 @@ func (m *QueryDecryptDataResponse) GetDecryptedData() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "fairyring.pep.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fairyring.pep.QueryParamsResponse")
-	proto.RegisterType((*QueryKeyshareRequest)(nil), "fairyring.pep.QueryKeyshareRequest")
-	proto.RegisterType((*QueryKeyshareResponse)(nil), "fairyring.pep.QueryKeyshareResponse")
-	proto.RegisterType((*QueryAllKeyshareRequest)(nil), "fairyring.pep.QueryAllKeyshareRequest")
-	proto.RegisterType((*QueryAllKeyshareResponse)(nil), "fairyring.pep.QueryAllKeyshareResponse")
-	proto.RegisterType((*QueryGetEncryptedTxRequest)(nil), "fairyring.pep.QueryGetEncryptedTxRequest")
-	proto.RegisterType((*QueryGetEncryptedTxResponse)(nil), "fairyring.pep.QueryGetEncryptedTxResponse")
-	proto.RegisterType((*QueryAllEncryptedTxRequest)(nil), "fairyring.pep.QueryAllEncryptedTxRequest")
-	proto.RegisterType((*QueryAllEncryptedTxResponse)(nil), "fairyring.pep.QueryAllEncryptedTxResponse")
-	proto.RegisterType((*QueryAllEncryptedTxFromHeightRequest)(nil), "fairyring.pep.QueryAllEncryptedTxFromHeightRequest")
-	proto.RegisterType((*QueryAllEncryptedTxFromHeightResponse)(nil), "fairyring.pep.QueryAllEncryptedTxFromHeightResponse")
-	proto.RegisterType((*QueryLatestHeightRequest)(nil), "fairyring.pep.QueryLatestHeightRequest")
-	proto.RegisterType((*QueryLatestHeightResponse)(nil), "fairyring.pep.QueryLatestHeightResponse")
-	proto.RegisterType((*QueryGetPepNonceRequest)(nil), "fairyring.pep.QueryGetPepNonceRequest")
-	proto.RegisterType((*QueryGetPepNonceResponse)(nil), "fairyring.pep.QueryGetPepNonceResponse")
-	proto.RegisterType((*QueryAllPepNonceRequest)(nil), "fairyring.pep.QueryAllPepNonceRequest")
-	proto.RegisterType((*QueryAllPepNonceResponse)(nil), "fairyring.pep.QueryAllPepNonceResponse")
-	proto.RegisterType((*QueryPubKeyRequest)(nil), "fairyring.pep.QueryPubKeyRequest")
-	proto.RegisterType((*QueryPubKeyResponse)(nil), "fairyring.pep.QueryPubKeyResponse")
-	proto.RegisterType((*QueryShowPrivateKeyshareReqRequest)(nil), "fairyring.pep.QueryShowPrivateKeyshareReqRequest")
-	proto.RegisterType((*QueryShowPrivateKeyshareReqResponse)(nil), "fairyring.pep.QueryShowPrivateKeyshareReqResponse")
-	proto.RegisterType((*QueryDecryptDataRequest)(nil), "fairyring.pep.QueryDecryptDataRequest")
-	proto.RegisterType((*QueryDecryptDataResponse)(nil), "fairyring.pep.QueryDecryptDataResponse")
+type QueryEncryptedTxQueueDepthRequest struct {
 }
 
-func init() { proto.RegisterFile("fairyring/pep/query.proto", fileDescriptor_dd36cf23112e8be0) }
-
-var fileDescriptor_dd36cf23112e8be0 = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xeb, 0x6e, 0x9b, 0x6d, 0x27, 0x6d, 0x05, 0xb3, 0xfd, 0x91, 0x7a, 0xb7, 0x69, 0x77,
-	0xd2, 0x5f, 0x44, 0x10, 0xab, 0xed, 0x1e, 0xa0, 0xe2, 0x92, 0xd2, 0x1f, 0x2c, 0x45, 0x6c, 0x1b,
-	0x0a, 0x48, 0x7b, 0x89, 0x26, 0xf6, 0xe0, 0x58, 0x4d, 0x6c, 0xc7, 0x76, 0xba, 0x09, 0x51, 0x24,
-	0xb4, 0x17, 0x2e, 0x1c, 0x90, 0xb8, 0x23, 0x8e, 0x7b, 0x83, 0x1b, 0xda, 0x33, 0x1c, 0xf6, 0xb8,
-	0x12, 0x17, 0x4e, 0x08, 0xb5, 0x48, 0xfc, 0x09, 0x5c, 0x91, 0xc7, 0xcf, 0x89, 0xed, 0x38, 0x49,
-	0x2b, 0x7a, 0xa9, 0xea, 0x99, 0xf7, 0xe3, 0xf3, 0xde, 0x8c, 0xdf, 0xd7, 0x2d, 0x5a, 0xfc, 0x92,
-	0x6a, 0x56, 0xd3, 0xd2, 0x74, 0x55, 0x32, 0x99, 0x29, 0xd5, 0xea, 0xcc, 0x6a, 0xe6, 0x4c, 0xcb,
-	0x70, 0x0c, 0x3c, 0xdd, 0xd9, 0xca, 0x99, 0xcc, 0x14, 0xdf, 0xa4, 0x55, 0x4d, 0x37, 0x24, 0xfe,
-	0xd3, 0xb3, 0x10, 0x67, 0x55, 0x43, 0x35, 0xf8, 0xaf, 0x92, 0xfb, 0x1b, 0xac, 0x3e, 0x50, 0x0d,
-	0x43, 0xad, 0x30, 0x89, 0x9a, 0x9a, 0x44, 0x75, 0xdd, 0x70, 0xa8, 0xa3, 0x19, 0xba, 0x0d, 0xbb,
-	0x59, 0xd9, 0xb0, 0xab, 0x86, 0x2d, 0x95, 0xa8, 0xcd, 0xbc, 0x74, 0xd2, 0xc5, 0x56, 0x89, 0x39,
-	0x74, 0x4b, 0x32, 0xa9, 0xaa, 0xe9, 0xdc, 0x18, 0x6c, 0xc5, 0x30, 0x9c, 0x49, 0x2d, 0x5a, 0xf5,
-	0xe3, 0xac, 0x84, 0xf7, 0x98, 0x2e, 0x5b, 0x4d, 0xd3, 0x61, 0x4a, 0xd1, 0x69, 0x80, 0xc5, 0x52,
-	0xc4, 0x9b, 0x99, 0x45, 0xdd, 0xd0, 0x65, 0x06, 0xdb, 0x9b, 0xe1, 0x6d, 0xaa, 0xaa, 0x16, 0x53,
-	0xa9, 0x1b, 0xe1, 0x9c, 0x35, 0x8b, 0x76, 0x99, 0x5a, 0xbe, 0x65, 0xa6, 0x6b, 0x29, 0x1b, 0xd5,
-	0xaa, 0xa1, 0x4b, 0x7c, 0x57, 0x29, 0x3a, 0x4d, 0x93, 0xf9, 0x3c, 0xe9, 0x60, 0x5d, 0x7e, 0x45,
-	0xb2, 0xa1, 0x41, 0x2d, 0x64, 0x16, 0xe1, 0x53, 0xb7, 0xda, 0x13, 0x5e, 0x44, 0x81, 0xd5, 0xea,
-	0xcc, 0x76, 0xc8, 0x13, 0x74, 0x2f, 0xb4, 0x6a, 0x9b, 0x86, 0x6e, 0x33, 0xfc, 0x2e, 0x4a, 0x78,
-	0xc5, 0xa6, 0x84, 0x15, 0x61, 0x33, 0xb9, 0x3d, 0x97, 0x0b, 0x9d, 0x45, 0xce, 0x33, 0xdf, 0x9b,
-	0x7c, 0xf5, 0xe7, 0xf2, 0xc8, 0x8b, 0x7f, 0x7e, 0xce, 0x0a, 0x05, 0xb0, 0x27, 0xef, 0xa0, 0x59,
-	0x1e, 0xf0, 0x98, 0x35, 0x39, 0x24, 0x24, 0xc2, 0x73, 0x28, 0x61, 0xb1, 0x5a, 0x51, 0x53, 0x78,
-	0xc4, 0xc9, 0xc2, 0xb8, 0xc5, 0x6a, 0x8f, 0x15, 0xf2, 0x14, 0xcd, 0x45, 0xcc, 0x81, 0x20, 0x8f,
-	0x26, 0xce, 0x61, 0x0d, 0x18, 0xd6, 0x22, 0x0c, 0x47, 0x4c, 0x3f, 0xd0, 0xe5, 0xb3, 0xc6, 0x41,
-	0x83, 0xc9, 0x75, 0xf7, 0xd0, 0x4e, 0xeb, 0xac, 0xce, 0x0a, 0x1d, 0x37, 0x42, 0xd1, 0x02, 0x8f,
-	0x9d, 0xaf, 0x54, 0xa2, 0x34, 0x87, 0x08, 0x75, 0x0f, 0x1b, 0xe2, 0xaf, 0xe7, 0xbc, 0x0e, 0xe6,
-	0xdc, 0x0e, 0xe6, 0xbc, 0x8b, 0x08, 0x7d, 0xcc, 0x9d, 0x50, 0xd5, 0xf7, 0x2d, 0x04, 0x3c, 0xc9,
-	0x0b, 0x01, 0xa5, 0x7a, 0x73, 0x40, 0x09, 0x1f, 0xa0, 0x49, 0x9f, 0xc5, 0xed, 0xe3, 0x9d, 0xeb,
-	0xd7, 0xd0, 0xf5, 0xc3, 0x47, 0x21, 0xd2, 0x51, 0x4e, 0xba, 0x31, 0x94, 0xd4, 0x23, 0x08, 0xa1,
-	0x7e, 0x8e, 0x44, 0x4e, 0x7a, 0xc4, 0x9c, 0x03, 0xff, 0xae, 0x9e, 0x35, 0xfc, 0x86, 0x10, 0x34,
-	0xe5, 0x50, 0x4b, 0x65, 0xce, 0x87, 0x4c, 0x53, 0xcb, 0x0e, 0x6f, 0xc9, 0x58, 0x21, 0xb4, 0x86,
-	0x67, 0xd1, 0xb8, 0xa6, 0x2b, 0xac, 0xc1, 0x29, 0xc6, 0x0a, 0xde, 0x03, 0xa1, 0xe8, 0x7e, 0x6c,
-	0x5c, 0x68, 0xc2, 0x1e, 0x4a, 0xb2, 0xee, 0x32, 0xb4, 0x5a, 0x8c, 0xb4, 0x21, 0xe0, 0xb8, 0x37,
-	0xe6, 0xde, 0xa9, 0x42, 0xd0, 0x89, 0x28, 0x80, 0x9e, 0xaf, 0x54, 0x62, 0xd0, 0x6f, 0xeb, 0x2c,
-	0x5f, 0x0a, 0x50, 0x49, 0x34, 0x0d, 0x54, 0x72, 0x8a, 0xde, 0x08, 0x40, 0xe5, 0x2d, 0x8b, 0x36,
-	0xe1, 0x54, 0x97, 0xfb, 0x97, 0xc3, 0xcd, 0xa0, 0xa6, 0x1e, 0xf7, 0xdb, 0x3b, 0xdc, 0x8f, 0xd0,
-	0x6a, 0x0c, 0xfa, 0xa1, 0x65, 0x54, 0xbd, 0xb3, 0xbb, 0xc1, 0x31, 0x93, 0xaf, 0xd0, 0xda, 0x90,
-	0x58, 0x03, 0x1b, 0x22, 0xfc, 0x8f, 0x86, 0x10, 0x11, 0x5e, 0xa7, 0x8f, 0xa9, 0xc3, 0x6c, 0x27,
-	0xc4, 0x4e, 0x76, 0xd0, 0x62, 0xcc, 0x1e, 0xb0, 0xcc, 0xa3, 0x44, 0x39, 0x58, 0x12, 0x3c, 0x91,
-	0x1d, 0x98, 0x01, 0x47, 0xcc, 0x39, 0x61, 0xe6, 0x27, 0xee, 0xf8, 0xf5, 0x7b, 0x91, 0x42, 0x77,
-	0xa9, 0xa2, 0x58, 0xcc, 0xb6, 0x61, 0x24, 0xf9, 0x8f, 0xe4, 0x33, 0xa0, 0x08, 0x39, 0x41, 0xa2,
-	0xf7, 0xd0, 0x84, 0x09, 0x6b, 0x50, 0xec, 0x42, 0x74, 0x36, 0xc2, 0x36, 0x14, 0xd9, 0x31, 0x0f,
-	0xce, 0xa3, 0x28, 0xcb, 0x6d, 0xdd, 0xe1, 0x1f, 0x02, 0xf3, 0x68, 0x08, 0xfa, 0x9d, 0x1b, 0xa0,
-	0xdf, 0xde, 0x45, 0xed, 0xa8, 0x50, 0xbd, 0x74, 0xcc, 0x9a, 0xfe, 0xd1, 0xfe, 0x24, 0xf8, 0x32,
-	0x04, 0xcb, 0x40, 0x7c, 0x8c, 0xa6, 0xa8, 0xec, 0x68, 0x17, 0xcc, 0x5b, 0x87, 0xc6, 0x3c, 0x0c,
-	0x50, 0x7b, 0x7a, 0x98, 0xcb, 0xfb, 0x56, 0x15, 0x4d, 0x3e, 0x66, 0xfe, 0xfd, 0x0a, 0x39, 0xbb,
-	0xc1, 0x6a, 0xee, 0x74, 0x55, 0x20, 0xd8, 0x68, 0xbf, 0x60, 0xa7, 0xbe, 0x55, 0x38, 0x58, 0xd0,
-	0x99, 0xec, 0x22, 0xc2, 0x81, 0x3f, 0x2d, 0x1b, 0xcf, 0x4e, 0x2c, 0xed, 0x82, 0x3a, 0x2c, 0xa0,
-	0x31, 0xfe, 0xb1, 0xce, 0x22, 0x4f, 0xe6, 0xc2, 0x9a, 0xf7, 0x9b, 0x80, 0x32, 0x03, 0x9d, 0xa1,
-	0xfa, 0x14, 0xba, 0x2b, 0x5b, 0x8c, 0x3a, 0x86, 0xe5, 0x5f, 0x50, 0x78, 0x0c, 0x88, 0xe9, 0x68,
-	0x20, 0xb0, 0xfb, 0x12, 0x98, 0xf5, 0xd2, 0x39, 0x6b, 0xa6, 0xee, 0xf0, 0x65, 0x78, 0xc2, 0x67,
-	0xe8, 0x5e, 0xf7, 0xf3, 0xa4, 0x2b, 0x49, 0xe3, 0xfc, 0x0e, 0x64, 0x7a, 0x1b, 0xd0, 0x79, 0x5d,
-	0x3b, 0x54, 0x98, 0x45, 0x97, 0x6c, 0xd2, 0x86, 0xeb, 0xbc, 0xcf, 0xf8, 0xd6, 0x3e, 0x75, 0xa8,
-	0x5f, 0x77, 0x17, 0x44, 0x08, 0x81, 0x64, 0xd0, 0xb4, 0xfb, 0x99, 0xd3, 0x61, 0x00, 0xfc, 0x29,
-	0x77, 0xd1, 0x0f, 0x8c, 0xd7, 0xd0, 0x4c, 0x97, 0x56, 0xa1, 0x0e, 0x85, 0x6a, 0xa6, 0x3b, 0xab,
-	0x6e, 0x2a, 0x92, 0x87, 0x9b, 0x1e, 0x4a, 0x0f, 0x9d, 0x5b, 0x43, 0x33, 0x0a, 0x0b, 0x85, 0xf0,
-	0x38, 0xa6, 0x3b, 0xab, 0xae, 0xf9, 0xf6, 0xbf, 0xd3, 0x68, 0x9c, 0xc7, 0xc0, 0x3a, 0x4a, 0x78,
-	0x9f, 0x34, 0xf8, 0x61, 0xe4, 0x95, 0xe8, 0xfd, 0x66, 0x12, 0xc9, 0x20, 0x13, 0x8f, 0x80, 0x2c,
-	0x3d, 0xff, 0xfd, 0xef, 0xef, 0x47, 0x17, 0xf0, 0x9c, 0x14, 0xf7, 0x09, 0x89, 0x7f, 0x14, 0x50,
-	0x32, 0x30, 0x14, 0xf1, 0x5b, 0x71, 0x21, 0x63, 0x95, 0x5a, 0xcc, 0x5e, 0xc7, 0x14, 0x28, 0x76,
-	0x39, 0xc5, 0x23, 0xbc, 0x2d, 0xf5, 0xff, 0x58, 0x95, 0x5a, 0xc1, 0xe9, 0xdf, 0x96, 0x5a, 0x5c,
-	0xd6, 0xdb, 0xf8, 0x5b, 0x01, 0xcd, 0x04, 0xe7, 0x76, 0xa5, 0x12, 0x4f, 0x19, 0x2b, 0xca, 0xf1,
-	0x94, 0xf1, 0xc2, 0x4a, 0x32, 0x9c, 0x72, 0x09, 0xdf, 0x1f, 0x40, 0x89, 0x5f, 0x0a, 0x28, 0x15,
-	0xc6, 0xe9, 0x2a, 0x12, 0xde, 0x19, 0x9e, 0xad, 0x47, 0x0b, 0xc5, 0x47, 0x37, 0x73, 0x02, 0xd8,
-	0x6d, 0x0e, 0xfb, 0x36, 0xce, 0x5e, 0xbf, 0xa5, 0xf8, 0x1b, 0x01, 0x4d, 0x05, 0x55, 0x0b, 0x6f,
-	0xc4, 0xa5, 0x8e, 0xd1, 0x3c, 0x71, 0x73, 0xb8, 0x21, 0x70, 0xad, 0x72, 0xae, 0x34, 0x7e, 0x10,
-	0xe1, 0xaa, 0x70, 0xe3, 0xa2, 0x27, 0x87, 0x2e, 0xc9, 0x84, 0x3f, 0xe4, 0xf1, 0x7a, 0x9f, 0x9b,
-	0x14, 0x11, 0x27, 0x71, 0x63, 0xa8, 0x1d, 0x30, 0x64, 0x39, 0xc3, 0x2a, 0x26, 0x52, 0x9f, 0xbf,
-	0x7c, 0xa4, 0x16, 0x48, 0x6c, 0x1b, 0x7f, 0x2d, 0xa0, 0xa4, 0x1f, 0xc0, 0xbd, 0x5b, 0xeb, 0x7d,
-	0x4e, 0xe3, 0x5a, 0x30, 0x31, 0x6a, 0x47, 0x56, 0x38, 0x8c, 0x88, 0x53, 0xfd, 0x60, 0xb0, 0x81,
-	0x12, 0x20, 0x0d, 0xf1, 0x2f, 0x7d, 0x50, 0xa2, 0xfa, 0xbc, 0xf4, 0x21, 0xb9, 0x22, 0x69, 0x9e,
-	0x32, 0x85, 0xe7, 0xa3, 0x29, 0xeb, 0x25, 0x77, 0xe4, 0xe1, 0xe7, 0x02, 0x4a, 0x06, 0x06, 0x3d,
-	0xce, 0xc4, 0xc5, 0x8c, 0xfc, 0xa9, 0x22, 0xae, 0x0e, 0x36, 0x82, 0xd4, 0x9b, 0x3c, 0x35, 0xc1,
-	0x2b, 0x91, 0xd4, 0xfe, 0xa4, 0x95, 0x5a, 0x9e, 0x60, 0xb4, 0x5d, 0x88, 0x99, 0x40, 0x8e, 0x41,
-	0xbd, 0x8f, 0xa2, 0x6c, 0x0c, 0xb5, 0x03, 0x9a, 0x65, 0x4e, 0xb3, 0x88, 0x17, 0xfa, 0xd0, 0xe0,
-	0x5f, 0x05, 0x34, 0x1f, 0xaf, 0x7e, 0x78, 0x2b, 0x2e, 0xc9, 0x40, 0x99, 0x15, 0xb7, 0x6f, 0xe2,
-	0x02, 0x88, 0x7b, 0x1c, 0xf1, 0x7d, 0xbc, 0x2b, 0x1d, 0x52, 0xcd, 0x2a, 0x55, 0x0c, 0xf9, 0x3c,
-	0x02, 0x6b, 0x97, 0x8d, 0x67, 0x45, 0xd3, 0x8b, 0xd0, 0x51, 0xac, 0xa2, 0xc5, 0x6a, 0xbc, 0x97,
-	0x8f, 0x95, 0x36, 0xfe, 0x45, 0x40, 0xc9, 0x80, 0xfc, 0xc4, 0xf7, 0xb1, 0x57, 0x1e, 0xe3, 0xfb,
-	0x18, 0xa3, 0x63, 0xe4, 0x0b, 0x0e, 0x79, 0x8a, 0x9f, 0xf4, 0x85, 0x04, 0x41, 0xe3, 0x22, 0x27,
-	0xb5, 0x3c, 0x99, 0x6d, 0x4b, 0xad, 0x90, 0xca, 0xb6, 0xa5, 0x56, 0x58, 0x50, 0xdb, 0x7b, 0xfb,
-	0xaf, 0x2e, 0xd3, 0xc2, 0xeb, 0xcb, 0xb4, 0xf0, 0xd7, 0x65, 0x5a, 0xf8, 0xee, 0x2a, 0x3d, 0xf2,
-	0xfa, 0x2a, 0x3d, 0xf2, 0xc7, 0x55, 0x7a, 0xe4, 0x69, 0x56, 0xd5, 0x9c, 0x72, 0xbd, 0xe4, 0x7e,
-	0x0a, 0xc4, 0x26, 0x6d, 0xf0, 0xb4, 0xfc, 0x1f, 0x0f, 0xa5, 0x04, 0xff, 0xcf, 0xc2, 0xce, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x33, 0xe3, 0x9f, 0xc4, 0x11, 0x00, 0x00,
+func (m *QueryEncryptedTxQueueDepthRequest) Reset()         { *m = QueryEncryptedTxQueueDepthRequest{} }
+func (m *QueryEncryptedTxQueueDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxQueueDepthRequest) ProtoMessage()    {}
+func (*QueryEncryptedTxQueueDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{24}
+}
+func (m *QueryEncryptedTxQueueDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxQueueDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxQueueDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptedTxQueueDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxQueueDepthRequest.Merge(m, src)
+}
+func (m *QueryEncryptedTxQueueDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxQueueDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxQueueDepthRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryEncryptedTxQueueDepthRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a EncryptedTx by index.
-	EncryptedTx(ctx context.Context, in *QueryGetEncryptedTxRequest, opts ...grpc.CallOption) (*QueryGetEncryptedTxResponse, error)
-	// Queries a list of EncryptedTx items.
-	EncryptedTxAll(ctx context.Context, in *QueryAllEncryptedTxRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxResponse, error)
-	// Queries a list of EncryptedTx items.
-	EncryptedTxAllFromHeight(ctx context.Context, in *QueryAllEncryptedTxFromHeightRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxFromHeightResponse, error)
-	// Queries a list of LatestHeight items.
-	LatestHeight(ctx context.Context, in *QueryLatestHeightRequest, opts ...grpc.CallOption) (*QueryLatestHeightResponse, error)
-	// Queries a PepNonce by index.
-	PepNonce(ctx context.Context, in *QueryGetPepNonceRequest, opts ...grpc.CallOption) (*QueryGetPepNonceResponse, error)
-	// Queries a list of PepNonce items.
-	PepNonceAll(ctx context.Context, in *QueryAllPepNonceRequest, opts ...grpc.CallOption) (*QueryAllPepNonceResponse, error)
-	// Queries the public keys
-	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
-	KeyshareReq(ctx context.Context, in *QueryKeyshareRequest, opts ...grpc.CallOption) (*QueryKeyshareResponse, error)
-	KeyshareReqAll(ctx context.Context, in *QueryAllKeyshareRequest, opts ...grpc.CallOption) (*QueryAllKeyshareResponse, error)
-	// Queries a list of ShowPrivateKeyshareReq items.
-	ShowPrivateKeyshareReq(ctx context.Context, in *QueryShowPrivateKeyshareReqRequest, opts ...grpc.CallOption) (*QueryShowPrivateKeyshareReqResponse, error)
-	// Queries a list of DecryptData items.
-	DecryptData(ctx context.Context, in *QueryDecryptDataRequest, opts ...grpc.CallOption) (*QueryDecryptDataResponse, error)
+type QueryEncryptedTxQueueDepthResponse struct {
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEncryptedTxQueueDepthResponse) Reset()         { *m = QueryEncryptedTxQueueDepthResponse{} }
+func (m *QueryEncryptedTxQueueDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxQueueDepthResponse) ProtoMessage()    {}
+func (*QueryEncryptedTxQueueDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{25}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEncryptedTxQueueDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEncryptedTxQueueDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxQueueDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) EncryptedTx(ctx context.Context, in *QueryGetEncryptedTxRequest, opts ...grpc.CallOption) (*QueryGetEncryptedTxResponse, error) {
-	out := new(QueryGetEncryptedTxResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEncryptedTxQueueDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxQueueDepthResponse.Merge(m, src)
 }
-
-func (c *queryClient) EncryptedTxAll(ctx context.Context, in *QueryAllEncryptedTxRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxResponse, error) {
-	out := new(QueryAllEncryptedTxResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEncryptedTxQueueDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxQueueDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxQueueDepthResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EncryptedTxAllFromHeight(ctx context.Context, in *QueryAllEncryptedTxFromHeightRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxFromHeightResponse, error) {
-	out := new(QueryAllEncryptedTxFromHeightResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxAllFromHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEncryptedTxQueueDepthResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxQueueDepthResponse) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LatestHeight(ctx context.Context, in *QueryLatestHeightRequest, opts ...grpc.CallOption) (*QueryLatestHeightResponse, error) {
-	out := new(QueryLatestHeightResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/LatestHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryOldestRetainedHeightRequest struct {
 }
 
-func (c *queryClient) PepNonce(ctx context.Context, in *QueryGetPepNonceRequest, opts ...grpc.CallOption) (*QueryGetPepNonceResponse, error) {
-	out := new(QueryGetPepNonceResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/PepNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOldestRetainedHeightRequest) Reset()         { *m = QueryOldestRetainedHeightRequest{} }
+func (m *QueryOldestRetainedHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestRetainedHeightRequest) ProtoMessage()    {}
+func (*QueryOldestRetainedHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{26}
+}
+func (m *QueryOldestRetainedHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOldestRetainedHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOldestRetainedHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOldestRetainedHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestRetainedHeightRequest.Merge(m, src)
+}
+func (m *QueryOldestRetainedHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOldestRetainedHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestRetainedHeightRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PepNonceAll(ctx context.Context, in *QueryAllPepNonceRequest, opts ...grpc.CallOption) (*QueryAllPepNonceResponse, error) {
-	out := new(QueryAllPepNonceResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/PepNonceAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryOldestRetainedHeightRequest proto.InternalMessageInfo
+
+type QueryOldestRetainedHeightResponse struct {
+	EncryptedTxHeight        uint64 `protobuf:"varint,1,opt,name=encryptedTxHeight,proto3" json:"encryptedTxHeight,omitempty"`
+	AggregatedKeyShareHeight uint64 `protobuf:"varint,2,opt,name=aggregatedKeyShareHeight,proto3" json:"aggregatedKeyShareHeight,omitempty"`
 }
 
-func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error) {
-	out := new(QueryPubKeyResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/PubKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOldestRetainedHeightResponse) Reset()         { *m = QueryOldestRetainedHeightResponse{} }
+func (m *QueryOldestRetainedHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestRetainedHeightResponse) ProtoMessage()    {}
+func (*QueryOldestRetainedHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{27}
+}
+func (m *QueryOldestRetainedHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOldestRetainedHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOldestRetainedHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOldestRetainedHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestRetainedHeightResponse.Merge(m, src)
+}
+func (m *QueryOldestRetainedHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOldestRetainedHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestRetainedHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) KeyshareReq(ctx context.Context, in *QueryKeyshareRequest, opts ...grpc.CallOption) (*QueryKeyshareResponse, error) {
-	out := new(QueryKeyshareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/KeyshareReq", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOldestRetainedHeightResponse proto.InternalMessageInfo
+
+func (m *QueryOldestRetainedHeightResponse) GetEncryptedTxHeight() uint64 {
+	if m != nil {
+		return m.EncryptedTxHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) KeyshareReqAll(ctx context.Context, in *QueryAllKeyshareRequest, opts ...grpc.CallOption) (*QueryAllKeyshareResponse, error) {
-	out := new(QueryAllKeyshareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/KeyshareReqAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOldestRetainedHeightResponse) GetAggregatedKeyShareHeight() uint64 {
+	if m != nil {
+		return m.AggregatedKeyShareHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ShowPrivateKeyshareReq(ctx context.Context, in *QueryShowPrivateKeyshareReqRequest, opts ...grpc.CallOption) (*QueryShowPrivateKeyshareReqResponse, error) {
-	out := new(QueryShowPrivateKeyshareReqResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/ShowPrivateKeyshareReq", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLanesRequest struct {
 }
 
-func (c *queryClient) DecryptData(ctx context.Context, in *QueryDecryptDataRequest, opts ...grpc.CallOption) (*QueryDecryptDataResponse, error) {
-	out := new(QueryDecryptDataResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/DecryptData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLanesRequest) Reset()         { *m = QueryLanesRequest{} }
+func (m *QueryLanesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLanesRequest) ProtoMessage()    {}
+func (*QueryLanesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{28}
+}
+func (m *QueryLanesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLanesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLanesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a EncryptedTx by index.
-	EncryptedTx(context.Context, *QueryGetEncryptedTxRequest) (*QueryGetEncryptedTxResponse, error)
-	// Queries a list of EncryptedTx items.
-	EncryptedTxAll(context.Context, *QueryAllEncryptedTxRequest) (*QueryAllEncryptedTxResponse, error)
-	// Queries a list of EncryptedTx items.
-	EncryptedTxAllFromHeight(context.Context, *QueryAllEncryptedTxFromHeightRequest) (*QueryAllEncryptedTxFromHeightResponse, error)
-	// Queries a list of LatestHeight items.
-	LatestHeight(context.Context, *QueryLatestHeightRequest) (*QueryLatestHeightResponse, error)
-	// Queries a PepNonce by index.
-	PepNonce(context.Context, *QueryGetPepNonceRequest) (*QueryGetPepNonceResponse, error)
-	// Queries a list of PepNonce items.
-	PepNonceAll(context.Context, *QueryAllPepNonceRequest) (*QueryAllPepNonceResponse, error)
-	// Queries the public keys
-	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
-	KeyshareReq(context.Context, *QueryKeyshareRequest) (*QueryKeyshareResponse, error)
-	KeyshareReqAll(context.Context, *QueryAllKeyshareRequest) (*QueryAllKeyshareResponse, error)
-	// Queries a list of ShowPrivateKeyshareReq items.
-	ShowPrivateKeyshareReq(context.Context, *QueryShowPrivateKeyshareReqRequest) (*QueryShowPrivateKeyshareReqResponse, error)
-	// Queries a list of DecryptData items.
-	DecryptData(context.Context, *QueryDecryptDataRequest) (*QueryDecryptDataResponse, error)
+func (m *QueryLanesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLanesRequest.Merge(m, src)
+}
+func (m *QueryLanesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLanesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLanesRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryLanesRequest proto.InternalMessageInfo
+
+type QueryLanesResponse struct {
+	// lanes in the order the proposals are built in
+	Lanes []*LaneParams `protobuf:"bytes,1,rep,name=lanes,proto3" json:"lanes,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLanesResponse) Reset()         { *m = QueryLanesResponse{} }
+func (m *QueryLanesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLanesResponse) ProtoMessage()    {}
+func (*QueryLanesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{29}
 }
-func (*UnimplementedQueryServer) EncryptedTx(ctx context.Context, req *QueryGetEncryptedTxRequest) (*QueryGetEncryptedTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTx not implemented")
+func (m *QueryLanesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EncryptedTxAll(ctx context.Context, req *QueryAllEncryptedTxRequest) (*QueryAllEncryptedTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxAll not implemented")
+func (m *QueryLanesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLanesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EncryptedTxAllFromHeight(ctx context.Context, req *QueryAllEncryptedTxFromHeightRequest) (*QueryAllEncryptedTxFromHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxAllFromHeight not implemented")
+func (m *QueryLanesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLanesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LatestHeight(ctx context.Context, req *QueryLatestHeightRequest) (*QueryLatestHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestHeight not implemented")
+func (m *QueryLanesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PepNonce(ctx context.Context, req *QueryGetPepNonceRequest) (*QueryGetPepNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PepNonce not implemented")
+func (m *QueryLanesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLanesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PepNonceAll(ctx context.Context, req *QueryAllPepNonceRequest) (*QueryAllPepNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PepNonceAll not implemented")
+
+var xxx_messageInfo_QueryLanesResponse proto.InternalMessageInfo
+
+func (m *QueryLanesResponse) GetLanes() []*LaneParams {
+	if m != nil {
+		return m.Lanes
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+
+type QueryEncryptedTxOrderRequest struct {
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
 }
-func (*UnimplementedQueryServer) KeyshareReq(ctx context.Context, req *QueryKeyshareRequest) (*QueryKeyshareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyshareReq not implemented")
+
+func (m *QueryEncryptedTxOrderRequest) Reset()         { *m = QueryEncryptedTxOrderRequest{} }
+func (m *QueryEncryptedTxOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxOrderRequest) ProtoMessage()    {}
+func (*QueryEncryptedTxOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{30}
 }
-func (*UnimplementedQueryServer) KeyshareReqAll(ctx context.Context, req *QueryAllKeyshareRequest) (*QueryAllKeyshareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyshareReqAll not implemented")
+func (m *QueryEncryptedTxOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ShowPrivateKeyshareReq(ctx context.Context, req *QueryShowPrivateKeyshareReqRequest) (*QueryShowPrivateKeyshareReqResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowPrivateKeyshareReq not implemented")
+func (m *QueryEncryptedTxOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DecryptData(ctx context.Context, req *QueryDecryptDataRequest) (*QueryDecryptDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecryptData not implemented")
+func (m *QueryEncryptedTxOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxOrderRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryEncryptedTxOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxOrderRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryEncryptedTxOrderRequest proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxOrderRequest) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_EncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEncryptedTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EncryptedTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/EncryptedTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EncryptedTx(ctx, req.(*QueryGetEncryptedTxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEncryptedTxOrderResponse struct {
+	Order EncryptedTxOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// recomputed_indexes is the permutation derived again from the aggregated key and the encrypted txs of the height
+	RecomputedIndexes []uint64 `protobuf:"varint,2,rep,packed,name=recomputed_indexes,json=recomputedIndexes,proto3" json:"recomputed_indexes,omitempty"`
+	// valid is true when the recomputed permutation matches the recorded one
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func _Query_EncryptedTxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEncryptedTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EncryptedTxAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/EncryptedTxAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EncryptedTxAll(ctx, req.(*QueryAllEncryptedTxRequest))
+func (m *QueryEncryptedTxOrderResponse) Reset()         { *m = QueryEncryptedTxOrderResponse{} }
+func (m *QueryEncryptedTxOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxOrderResponse) ProtoMessage()    {}
+func (*QueryEncryptedTxOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{31}
+}
+func (m *QueryEncryptedTxOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEncryptedTxOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxOrderResponse.Merge(m, src)
+}
+func (m *QueryEncryptedTxOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxOrderResponse.DiscardUnknown(m)
 }
 
-func _Query_EncryptedTxAllFromHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEncryptedTxFromHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EncryptedTxAllFromHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/EncryptedTxAllFromHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EncryptedTxAllFromHeight(ctx, req.(*QueryAllEncryptedTxFromHeightRequest))
+var xxx_messageInfo_QueryEncryptedTxOrderResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxOrderResponse) GetOrder() EncryptedTxOrder {
+	if m != nil {
+		return m.Order
 	}
-	return interceptor(ctx, in, info, handler)
+	return EncryptedTxOrder{}
 }
 
-func _Query_LatestHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/LatestHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestHeight(ctx, req.(*QueryLatestHeightRequest))
+func (m *QueryEncryptedTxOrderResponse) GetRecomputedIndexes() []uint64 {
+	if m != nil {
+		return m.RecomputedIndexes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PepNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPepNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PepNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/PepNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PepNonce(ctx, req.(*QueryGetPepNonceRequest))
+func (m *QueryEncryptedTxOrderResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_PepNonceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPepNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PepNonceAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/PepNonceAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PepNonceAll(ctx, req.(*QueryAllPepNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEncryptedTxResultRequest struct {
+	TargetHeight uint64 `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Index        uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPubKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PubKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/PubKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PubKey(ctx, req.(*QueryPubKeyRequest))
+func (m *QueryEncryptedTxResultRequest) Reset()         { *m = QueryEncryptedTxResultRequest{} }
+func (m *QueryEncryptedTxResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxResultRequest) ProtoMessage()    {}
+func (*QueryEncryptedTxResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{32}
+}
+func (m *QueryEncryptedTxResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEncryptedTxResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxResultRequest.Merge(m, src)
+}
+func (m *QueryEncryptedTxResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxResultRequest.DiscardUnknown(m)
 }
 
-func _Query_KeyshareReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKeyshareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyshareReq(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/KeyshareReq",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyshareReq(ctx, req.(*QueryKeyshareRequest))
+var xxx_messageInfo_QueryEncryptedTxResultRequest proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxResultRequest) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_KeyshareReqAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllKeyshareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyshareReqAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/KeyshareReqAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyshareReqAll(ctx, req.(*QueryAllKeyshareRequest))
+func (m *QueryEncryptedTxResultRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ShowPrivateKeyshareReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowPrivateKeyshareReqRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowPrivateKeyshareReq(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/ShowPrivateKeyshareReq",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowPrivateKeyshareReq(ctx, req.(*QueryShowPrivateKeyshareReqRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEncryptedTxResultResponse struct {
+	Result EncryptedTxExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 }
 
-func _Query_DecryptData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDecryptDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DecryptData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.pep.Query/DecryptData",
+func (m *QueryEncryptedTxResultResponse) Reset()         { *m = QueryEncryptedTxResultResponse{} }
+func (m *QueryEncryptedTxResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxResultResponse) ProtoMessage()    {}
+func (*QueryEncryptedTxResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{33}
+}
+func (m *QueryEncryptedTxResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DecryptData(ctx, req.(*QueryDecryptDataRequest))
+}
+func (m *QueryEncryptedTxResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxResultResponse.Merge(m, src)
+}
+func (m *QueryEncryptedTxResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptedTxResultResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxResultResponse) GetResult() EncryptedTxExecutionResult {
+	if m != nil {
+		return m.Result
 	}
-	return interceptor(ctx, in, info, handler)
+	return EncryptedTxExecutionResult{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fairyring.pep.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EncryptedTx",
-			Handler:    _Query_EncryptedTx_Handler,
-		},
-		{
-			MethodName: "EncryptedTxAll",
-			Handler:    _Query_EncryptedTxAll_Handler,
-		},
-		{
-			MethodName: "EncryptedTxAllFromHeight",
-			Handler:    _Query_EncryptedTxAllFromHeight_Handler,
-		},
-		{
-			MethodName: "LatestHeight",
-			Handler:    _Query_LatestHeight_Handler,
-		},
-		{
-			MethodName: "PepNonce",
-			Handler:    _Query_PepNonce_Handler,
-		},
-		{
-			MethodName: "PepNonceAll",
-			Handler:    _Query_PepNonceAll_Handler,
-		},
-		{
-			MethodName: "PubKey",
-			Handler:    _Query_PubKey_Handler,
-		},
-		{
-			MethodName: "KeyshareReq",
-			Handler:    _Query_KeyshareReq_Handler,
-		},
-		{
-			MethodName: "KeyshareReqAll",
-			Handler:    _Query_KeyshareReqAll_Handler,
-		},
-		{
-			MethodName: "ShowPrivateKeyshareReq",
-			Handler:    _Query_ShowPrivateKeyshareReq_Handler,
-		},
-		{
-			MethodName: "DecryptData",
-			Handler:    _Query_DecryptData_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fairyring/pep/query.proto",
+type QueryGeneralEncryptedTxResultRequest struct {
+	ReqId string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGeneralEncryptedTxResultRequest) Reset()         { *m = QueryGeneralEncryptedTxResultRequest{} }
+func (m *QueryGeneralEncryptedTxResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGeneralEncryptedTxResultRequest) ProtoMessage()    {}
+func (*QueryGeneralEncryptedTxResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{34}
+}
+func (m *QueryGeneralEncryptedTxResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGeneralEncryptedTxResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGeneralEncryptedTxResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGeneralEncryptedTxResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGeneralEncryptedTxResultRequest.Merge(m, src)
+}
+func (m *QueryGeneralEncryptedTxResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGeneralEncryptedTxResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGeneralEncryptedTxResultRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryGeneralEncryptedTxResultRequest proto.InternalMessageInfo
+
+func (m *QueryGeneralEncryptedTxResultRequest) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGeneralEncryptedTxResultRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGeneralEncryptedTxResultResponse struct {
+	Result EncryptedTxExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGeneralEncryptedTxResultResponse) Reset()         { *m = QueryGeneralEncryptedTxResultResponse{} }
+func (m *QueryGeneralEncryptedTxResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGeneralEncryptedTxResultResponse) ProtoMessage()    {}
+func (*QueryGeneralEncryptedTxResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{35}
+}
+func (m *QueryGeneralEncryptedTxResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGeneralEncryptedTxResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGeneralEncryptedTxResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGeneralEncryptedTxResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGeneralEncryptedTxResultResponse.Merge(m, src)
+}
+func (m *QueryGeneralEncryptedTxResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGeneralEncryptedTxResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGeneralEncryptedTxResultResponse.DiscardUnknown(m)
 }
 
-func (m *QueryKeyshareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGeneralEncryptedTxResultResponse proto.InternalMessageInfo
+
+func (m *QueryGeneralEncryptedTxResultResponse) GetResult() EncryptedTxExecutionResult {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return EncryptedTxExecutionResult{}
 }
 
-func (m *QueryKeyshareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEncryptedTxByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status     string             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryKeyshareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReqId) > 0 {
-		i -= len(m.ReqId)
-		copy(dAtA[i:], m.ReqId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEncryptedTxByCreatorRequest) Reset()         { *m = QueryEncryptedTxByCreatorRequest{} }
+func (m *QueryEncryptedTxByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxByCreatorRequest) ProtoMessage()    {}
+func (*QueryEncryptedTxByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{36}
+}
+func (m *QueryEncryptedTxByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEncryptedTxByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxByCreatorRequest.Merge(m, src)
+}
+func (m *QueryEncryptedTxByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxByCreatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKeyshareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEncryptedTxByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryKeyshareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEncryptedTxByCreatorRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *QueryKeyshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Keyshare != nil {
-		{
-			size, err := m.Keyshare.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEncryptedTxByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllKeyshareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryEncryptedTxByCreatorResponse struct {
+	EncryptedTx []EncryptedTxWithStatus `protobuf:"bytes,1,rep,name=encryptedTx,proto3" json:"encryptedTx"`
+	Pagination  *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllKeyshareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEncryptedTxByCreatorResponse) Reset()         { *m = QueryEncryptedTxByCreatorResponse{} }
+func (m *QueryEncryptedTxByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxByCreatorResponse) ProtoMessage()    {}
+func (*QueryEncryptedTxByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{37}
 }
-
-func (m *QueryAllKeyshareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEncryptedTxByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllKeyshareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEncryptedTxByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxByCreatorResponse.Merge(m, src)
 }
-
-func (m *QueryAllKeyshareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEncryptedTxByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxByCreatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllKeyshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Keyshares) > 0 {
-		for iNdEx := len(m.Keyshares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Keyshares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryEncryptedTxByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxByCreatorResponse) GetEncryptedTx() []EncryptedTxWithStatus {
+	if m != nil {
+		return m.EncryptedTx
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetEncryptedTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEncryptedTxByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetEncryptedTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySimulateEncryptedTxRequest struct {
+	// tx_bytes is the plaintext signed underlying tx, as it would be encrypted
+	TxBytes  []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (m *QueryGetEncryptedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TargetHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TargetHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySimulateEncryptedTxRequest) Reset()         { *m = QuerySimulateEncryptedTxRequest{} }
+func (m *QuerySimulateEncryptedTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateEncryptedTxRequest) ProtoMessage()    {}
+func (*QuerySimulateEncryptedTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{38}
+}
+func (m *QuerySimulateEncryptedTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateEncryptedTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateEncryptedTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateEncryptedTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateEncryptedTxRequest.Merge(m, src)
+}
+func (m *QuerySimulateEncryptedTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateEncryptedTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateEncryptedTxRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateEncryptedTxRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateEncryptedTxRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateEncryptedTxRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
 }
 
-func (m *QueryGetEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EncryptedTx.MarshalToSizedBuffer(dAtA[:i])
+type QuerySimulateEncryptedTxResponse struct {
+	UnderlyingGas  uint64      `protobuf:"varint,1,opt,name=underlying_gas,json=underlyingGas,proto3" json:"underlying_gas,omitempty"`
+	DecryptionGas  uint64      `protobuf:"varint,2,opt,name=decryption_gas,json=decryptionGas,proto3" json:"decryption_gas,omitempty"`
+	TotalGas       uint64      `protobuf:"varint,3,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	GasLimit       uint64      `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	CiphertextSize uint64      `protobuf:"varint,5,opt,name=ciphertext_size,json=ciphertextSize,proto3" json:"ciphertext_size,omitempty"`
+	Escrow         types1.Coin `protobuf:"bytes,6,opt,name=escrow,proto3" json:"escrow"`
+	Fee            types1.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	FeeDeducted    types1.Coin `protobuf:"bytes,8,opt,name=fee_deducted,json=feeDeducted,proto3" json:"fee_deducted"`
+	FeeRefunded    types1.Coin `protobuf:"bytes,9,opt,name=fee_refunded,json=feeRefunded,proto3" json:"fee_refunded"`
+	// out_of_gas is true when the execution ran out of the simulation gas limit,
+	// the gas limit of the underlying tx capped by the maximum simulation gas
+	OutOfGas bool `protobuf:"varint,10,opt,name=out_of_gas,json=outOfGas,proto3" json:"out_of_gas,omitempty"`
+}
+
+func (m *QuerySimulateEncryptedTxResponse) Reset()         { *m = QuerySimulateEncryptedTxResponse{} }
+func (m *QuerySimulateEncryptedTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateEncryptedTxResponse) ProtoMessage()    {}
+func (*QuerySimulateEncryptedTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{39}
+}
+func (m *QuerySimulateEncryptedTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateEncryptedTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateEncryptedTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateEncryptedTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateEncryptedTxResponse.Merge(m, src)
+}
+func (m *QuerySimulateEncryptedTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateEncryptedTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateEncryptedTxResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllEncryptedTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateEncryptedTxResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateEncryptedTxResponse) GetUnderlyingGas() uint64 {
+	if m != nil {
+		return m.UnderlyingGas
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllEncryptedTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateEncryptedTxResponse) GetDecryptionGas() uint64 {
+	if m != nil {
+		return m.DecryptionGas
+	}
+	return 0
 }
 
-func (m *QueryAllEncryptedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulateEncryptedTxResponse) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateEncryptedTxResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateEncryptedTxResponse) GetCiphertextSize() uint64 {
+	if m != nil {
+		return m.CiphertextSize
+	}
+	return 0
 }
 
-func (m *QueryAllEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySimulateEncryptedTxResponse) GetEscrow() types1.Coin {
+	if m != nil {
+		return m.Escrow
 	}
-	if len(m.EncryptedTxArray) > 0 {
-		for iNdEx := len(m.EncryptedTxArray) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EncryptedTxArray[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return types1.Coin{}
+}
+
+func (m *QuerySimulateEncryptedTxResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *QueryAllEncryptedTxFromHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateEncryptedTxResponse) GetFeeDeducted() types1.Coin {
+	if m != nil {
+		return m.FeeDeducted
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllEncryptedTxFromHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return types1.Coin{}
 }
 
-func (m *QueryAllEncryptedTxFromHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TargetHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySimulateEncryptedTxResponse) GetFeeRefunded() types1.Coin {
+	if m != nil {
+		return m.FeeRefunded
 	}
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *QueryAllEncryptedTxFromHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateEncryptedTxResponse) GetOutOfGas() bool {
+	if m != nil {
+		return m.OutOfGas
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryAllEncryptedTxFromHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "fairyring.pep.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fairyring.pep.QueryParamsResponse")
+	proto.RegisterType((*QueryKeyshareRequest)(nil), "fairyring.pep.QueryKeyshareRequest")
+	proto.RegisterType((*QueryKeyshareResponse)(nil), "fairyring.pep.QueryKeyshareResponse")
+	proto.RegisterType((*QueryAllKeyshareRequest)(nil), "fairyring.pep.QueryAllKeyshareRequest")
+	proto.RegisterType((*QueryAllKeyshareResponse)(nil), "fairyring.pep.QueryAllKeyshareResponse")
+	proto.RegisterType((*QueryGetEncryptedTxRequest)(nil), "fairyring.pep.QueryGetEncryptedTxRequest")
+	proto.RegisterType((*QueryGetEncryptedTxResponse)(nil), "fairyring.pep.QueryGetEncryptedTxResponse")
+	proto.RegisterType((*QueryAllEncryptedTxRequest)(nil), "fairyring.pep.QueryAllEncryptedTxRequest")
+	proto.RegisterType((*QueryAllEncryptedTxResponse)(nil), "fairyring.pep.QueryAllEncryptedTxResponse")
+	proto.RegisterType((*QueryAllEncryptedTxFromHeightRequest)(nil), "fairyring.pep.QueryAllEncryptedTxFromHeightRequest")
+	proto.RegisterType((*QueryAllEncryptedTxFromHeightResponse)(nil), "fairyring.pep.QueryAllEncryptedTxFromHeightResponse")
+	proto.RegisterType((*QueryLatestHeightRequest)(nil), "fairyring.pep.QueryLatestHeightRequest")
+	proto.RegisterType((*QueryLatestHeightResponse)(nil), "fairyring.pep.QueryLatestHeightResponse")
+	proto.RegisterType((*QueryGetPepNonceRequest)(nil), "fairyring.pep.QueryGetPepNonceRequest")
+	proto.RegisterType((*QueryGetPepNonceResponse)(nil), "fairyring.pep.QueryGetPepNonceResponse")
+	proto.RegisterType((*QueryAllPepNonceRequest)(nil), "fairyring.pep.QueryAllPepNonceRequest")
+	proto.RegisterType((*QueryAllPepNonceResponse)(nil), "fairyring.pep.QueryAllPepNonceResponse")
+	proto.RegisterType((*QueryPubKeyRequest)(nil), "fairyring.pep.QueryPubKeyRequest")
+	proto.RegisterType((*QueryPubKeyResponse)(nil), "fairyring.pep.QueryPubKeyResponse")
+	proto.RegisterType((*QueryShowPrivateKeyshareReqRequest)(nil), "fairyring.pep.QueryShowPrivateKeyshareReqRequest")
+	proto.RegisterType((*QueryShowPrivateKeyshareReqResponse)(nil), "fairyring.pep.QueryShowPrivateKeyshareReqResponse")
+	proto.RegisterType((*QueryDecryptDataRequest)(nil), "fairyring.pep.QueryDecryptDataRequest")
+	proto.RegisterType((*QueryDecryptDataResponse)(nil), "fairyring.pep.QueryDecryptDataResponse")
+	proto.RegisterType((*QueryEncryptedTxQueueDepthRequest)(nil), "fairyring.pep.QueryEncryptedTxQueueDepthRequest")
+	proto.RegisterType((*QueryEncryptedTxQueueDepthResponse)(nil), "fairyring.pep.QueryEncryptedTxQueueDepthResponse")
+	proto.RegisterType((*QueryOldestRetainedHeightRequest)(nil), "fairyring.pep.QueryOldestRetainedHeightRequest")
+	proto.RegisterType((*QueryOldestRetainedHeightResponse)(nil), "fairyring.pep.QueryOldestRetainedHeightResponse")
+	proto.RegisterType((*QueryLanesRequest)(nil), "fairyring.pep.QueryLanesRequest")
+	proto.RegisterType((*QueryLanesResponse)(nil), "fairyring.pep.QueryLanesResponse")
+	proto.RegisterType((*QueryEncryptedTxOrderRequest)(nil), "fairyring.pep.QueryEncryptedTxOrderRequest")
+	proto.RegisterType((*QueryEncryptedTxOrderResponse)(nil), "fairyring.pep.QueryEncryptedTxOrderResponse")
+	proto.RegisterType((*QueryEncryptedTxResultRequest)(nil), "fairyring.pep.QueryEncryptedTxResultRequest")
+	proto.RegisterType((*QueryEncryptedTxResultResponse)(nil), "fairyring.pep.QueryEncryptedTxResultResponse")
+	proto.RegisterType((*QueryGeneralEncryptedTxResultRequest)(nil), "fairyring.pep.QueryGeneralEncryptedTxResultRequest")
+	proto.RegisterType((*QueryGeneralEncryptedTxResultResponse)(nil), "fairyring.pep.QueryGeneralEncryptedTxResultResponse")
+	proto.RegisterType((*QueryEncryptedTxByCreatorRequest)(nil), "fairyring.pep.QueryEncryptedTxByCreatorRequest")
+	proto.RegisterType((*QueryEncryptedTxByCreatorResponse)(nil), "fairyring.pep.QueryEncryptedTxByCreatorResponse")
+	proto.RegisterType((*QuerySimulateEncryptedTxRequest)(nil), "fairyring.pep.QuerySimulateEncryptedTxRequest")
+	proto.RegisterType((*QuerySimulateEncryptedTxResponse)(nil), "fairyring.pep.QuerySimulateEncryptedTxResponse")
 }
 
-func (m *QueryAllEncryptedTxFromHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EncryptedTxArray.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("fairyring/pep/query.proto", fileDescriptor_dd36cf23112e8be0) }
+
+var fileDescriptor_dd36cf23112e8be0 = []byte{
+	// 2175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xdb, 0xb1, 0x63, 0x3f, 0x7f, 0xb0, 0x29, 0x7f, 0xa4, 0xdd, 0x71, 0x26, 0x76, 0xdb,
+	0x8e, 0x13, 0x93, 0x4c, 0xc7, 0x4e, 0x24, 0x76, 0x1d, 0x38, 0xd8, 0x71, 0x62, 0x82, 0x23, 0x1c,
+	0x8f, 0x03, 0x0b, 0x7b, 0x19, 0xd5, 0x4c, 0x97, 0xc7, 0x4d, 0xda, 0xdd, 0xed, 0xee, 0xea, 0xc4,
+	0x13, 0x6b, 0x24, 0xb4, 0x17, 0x2e, 0x20, 0x21, 0x71, 0x45, 0x08, 0x4e, 0x9b, 0x0b, 0x02, 0x69,
+	0x0f, 0x68, 0xb9, 0xc2, 0x61, 0x05, 0x97, 0x95, 0xb8, 0x70, 0x42, 0x28, 0x01, 0xf1, 0x6f, 0xa0,
+	0xae, 0x7e, 0x3d, 0xd3, 0xdd, 0xd3, 0x3d, 0x33, 0xde, 0xf8, 0x62, 0x4d, 0xbd, 0x7a, 0xef, 0xd5,
+	0xef, 0xbd, 0x7a, 0xfd, 0xaa, 0x7e, 0x65, 0x98, 0x39, 0xa0, 0x86, 0x5b, 0x77, 0x0d, 0xab, 0xa6,
+	0x39, 0xcc, 0xd1, 0x8e, 0x7d, 0xe6, 0xd6, 0x8b, 0x8e, 0x6b, 0x73, 0x9b, 0x8c, 0x35, 0xa7, 0x8a,
+	0x0e, 0x73, 0x94, 0xcb, 0xf4, 0xc8, 0xb0, 0x6c, 0x4d, 0xfc, 0x0d, 0x35, 0x94, 0xc9, 0x9a, 0x5d,
+	0xb3, 0xc5, 0x4f, 0x2d, 0xf8, 0x85, 0xd2, 0xd9, 0x9a, 0x6d, 0xd7, 0x4c, 0xa6, 0x51, 0xc7, 0xd0,
+	0xa8, 0x65, 0xd9, 0x9c, 0x72, 0xc3, 0xb6, 0x3c, 0x9c, 0x5d, 0xa9, 0xda, 0xde, 0x91, 0xed, 0x69,
+	0x15, 0xea, 0xb1, 0x70, 0x39, 0xed, 0xe5, 0x6a, 0x85, 0x71, 0xba, 0xaa, 0x39, 0xb4, 0x66, 0x58,
+	0x42, 0x19, 0x75, 0x95, 0x24, 0x38, 0x87, 0xba, 0xf4, 0x28, 0xf2, 0x33, 0x97, 0x9c, 0x63, 0x56,
+	0xd5, 0xad, 0x3b, 0x9c, 0xe9, 0x65, 0x7e, 0x82, 0x1a, 0xd7, 0x52, 0xd6, 0xcc, 0x29, 0x5b, 0xb6,
+	0x55, 0x65, 0x38, 0x7d, 0x33, 0x39, 0x4d, 0x6b, 0x35, 0x97, 0xd5, 0x68, 0xe0, 0xe1, 0x05, 0xab,
+	0x97, 0xbd, 0x43, 0xea, 0x46, 0x9a, 0x0b, 0x2d, 0xcd, 0xaa, 0x7d, 0x74, 0x64, 0x5b, 0x9a, 0x98,
+	0xd5, 0xcb, 0xbc, 0xee, 0xb0, 0x08, 0x4f, 0x21, 0x1e, 0x57, 0x14, 0x51, 0xd5, 0x36, 0x30, 0x16,
+	0x75, 0x12, 0xc8, 0x5e, 0x10, 0xed, 0x33, 0x11, 0x44, 0x89, 0x1d, 0xfb, 0xcc, 0xe3, 0xea, 0x2e,
+	0x4c, 0x24, 0xa4, 0x9e, 0x63, 0x5b, 0x1e, 0x23, 0x1f, 0xc2, 0x60, 0x18, 0xac, 0x2c, 0xcd, 0x49,
+	0x37, 0x47, 0xd6, 0xa6, 0x8a, 0x89, 0xbd, 0x28, 0x86, 0xea, 0x9b, 0xc3, 0x5f, 0xfe, 0xeb, 0xfa,
+	0x85, 0x37, 0xff, 0xfb, 0xe3, 0x8a, 0x54, 0x42, 0x7d, 0xf5, 0x0e, 0x4c, 0x0a, 0x87, 0x3b, 0xac,
+	0x2e, 0x40, 0xe2, 0x42, 0x64, 0x0a, 0x06, 0x5d, 0x76, 0x5c, 0x36, 0x74, 0xe1, 0x71, 0xb8, 0x34,
+	0xe0, 0xb2, 0xe3, 0x27, 0xba, 0xfa, 0x09, 0x4c, 0xa5, 0xd4, 0x11, 0xc1, 0x06, 0x0c, 0xbd, 0x40,
+	0x19, 0x62, 0x58, 0x4a, 0x61, 0xd8, 0x66, 0xd6, 0x23, 0xab, 0xfa, 0xfc, 0xe4, 0xd1, 0x09, 0xab,
+	0xfa, 0xc1, 0xa6, 0xed, 0xf9, 0xcc, 0x67, 0xa5, 0xa6, 0x99, 0x4a, 0xe1, 0x8a, 0xf0, 0xbd, 0x61,
+	0x9a, 0x69, 0x34, 0x8f, 0x01, 0x5a, 0x9b, 0x8d, 0xfe, 0x6f, 0x14, 0xc3, 0x0c, 0x16, 0x83, 0x0c,
+	0x16, 0xc3, 0x42, 0xc4, 0x3c, 0x16, 0x9f, 0xd1, 0x5a, 0x64, 0x5b, 0x8a, 0x59, 0xaa, 0x6f, 0x24,
+	0x90, 0xdb, 0xd7, 0xc0, 0x10, 0x1e, 0xc2, 0x70, 0x84, 0x25, 0xc8, 0x63, 0x7f, 0xef, 0x31, 0xb4,
+	0xec, 0xc8, 0x76, 0x02, 0x69, 0x9f, 0x40, 0xba, 0xdc, 0x15, 0x69, 0x88, 0x20, 0x01, 0xf5, 0x87,
+	0xa0, 0x08, 0xa4, 0xdb, 0x8c, 0x3f, 0x8a, 0x6a, 0xf5, 0xf9, 0x49, 0x94, 0x10, 0x15, 0x46, 0x39,
+	0x75, 0x6b, 0x8c, 0x7f, 0x97, 0x19, 0xb5, 0x43, 0x2e, 0x52, 0x72, 0xb1, 0x94, 0x90, 0x91, 0x49,
+	0x18, 0x30, 0x2c, 0x9d, 0x9d, 0x08, 0x14, 0x17, 0x4b, 0xe1, 0x40, 0xa5, 0x70, 0x35, 0xd3, 0x2f,
+	0x26, 0x61, 0x13, 0x46, 0x58, 0x4b, 0x8c, 0xa9, 0x56, 0x52, 0x69, 0x88, 0x19, 0x6e, 0x5e, 0x0c,
+	0x6a, 0xaa, 0x14, 0x37, 0x52, 0x75, 0x84, 0xbe, 0x61, 0x9a, 0x19, 0xd0, 0xcf, 0x6b, 0x2f, 0xbf,
+	0x90, 0xe0, 0x6a, 0xe6, 0x32, 0x18, 0xc9, 0x1e, 0x7c, 0x10, 0x03, 0xb5, 0xe1, 0xba, 0xb4, 0x8e,
+	0xbb, 0x7a, 0x3d, 0x3f, 0x1c, 0xa1, 0x86, 0x31, 0xb5, 0x99, 0x9f, 0xdf, 0xe6, 0x7e, 0x0f, 0x16,
+	0x33, 0xa0, 0x3f, 0x76, 0xed, 0xa3, 0x70, 0xef, 0xce, 0xb0, 0xcd, 0xea, 0x6b, 0x58, 0xea, 0xe2,
+	0xab, 0x63, 0x42, 0xa4, 0xf7, 0x48, 0x88, 0xaa, 0xe0, 0xe7, 0xf4, 0x94, 0x72, 0xe6, 0xf1, 0x04,
+	0x76, 0xf5, 0x1e, 0xcc, 0x64, 0xcc, 0x21, 0x96, 0x69, 0x18, 0x3c, 0x8c, 0x87, 0x84, 0x23, 0xf5,
+	0x1e, 0xf6, 0x80, 0x6d, 0xc6, 0x9f, 0x31, 0xe7, 0xfb, 0x41, 0xfb, 0x8d, 0x72, 0x21, 0xc3, 0x25,
+	0xaa, 0xeb, 0x2e, 0xf3, 0x3c, 0x6c, 0x49, 0xd1, 0x50, 0xfd, 0x01, 0xc8, 0xed, 0x46, 0xb8, 0xd0,
+	0x47, 0x30, 0xe4, 0xa0, 0x0c, 0x83, 0xbd, 0x92, 0xee, 0x8d, 0x38, 0x8d, 0x41, 0x36, 0xd5, 0xe3,
+	0xfd, 0x28, 0x8d, 0xe5, 0xbc, 0x6a, 0xf8, 0x37, 0xb1, 0x7e, 0xd4, 0x05, 0x7a, 0xff, 0x19, 0xa0,
+	0x9f, 0x5f, 0xa1, 0x36, 0x4f, 0x21, 0xbf, 0xb2, 0xc3, 0xea, 0xd1, 0xd6, 0xfe, 0x41, 0x82, 0x89,
+	0x84, 0x18, 0x11, 0xef, 0xc0, 0x28, 0xad, 0x72, 0xe3, 0x25, 0x0b, 0xe5, 0x98, 0x98, 0xf9, 0x18,
+	0xea, 0xf0, 0x3c, 0x2c, 0x6e, 0x44, 0x5a, 0xa6, 0x51, 0xdd, 0x61, 0x51, 0x7d, 0x25, 0x8c, 0x03,
+	0x67, 0xc7, 0x3e, 0xf3, 0x99, 0x8e, 0xce, 0xfa, 0xf2, 0x9c, 0xed, 0x45, 0x5a, 0x49, 0x67, 0x71,
+	0x63, 0x75, 0x1d, 0x54, 0x01, 0x78, 0xff, 0xd0, 0x7e, 0xf5, 0xcc, 0x35, 0x5e, 0x52, 0xce, 0x62,
+	0x67, 0x4c, 0xb4, 0xad, 0x93, 0x10, 0x1e, 0x73, 0xc9, 0x33, 0xef, 0xaf, 0x12, 0x2c, 0x74, 0x34,
+	0xc6, 0xe8, 0x65, 0xb8, 0x54, 0x75, 0x19, 0xe5, 0xb6, 0x1b, 0x15, 0x28, 0x0e, 0x63, 0x87, 0x69,
+	0x5f, 0xcc, 0x71, 0xf0, 0x11, 0x38, 0x7e, 0xe5, 0x05, 0xab, 0xcb, 0xfd, 0x42, 0x8c, 0x23, 0xf2,
+	0x1c, 0x26, 0x5a, 0xd7, 0x93, 0xd6, 0x91, 0x34, 0x20, 0x6a, 0x60, 0xa1, 0x3d, 0x01, 0xcd, 0xcf,
+	0xb5, 0x89, 0x8a, 0xb0, 0xb4, 0xc8, 0x53, 0x1b, 0x58, 0xce, 0x5b, 0x4c, 0x4c, 0x6d, 0x51, 0x4e,
+	0xa3, 0xb8, 0x5b, 0x40, 0xa4, 0x04, 0x90, 0x05, 0x18, 0x0b, 0xae, 0x39, 0x4d, 0x0c, 0x08, 0x7f,
+	0x34, 0x10, 0x46, 0x8e, 0xc9, 0x12, 0x8c, 0xb7, 0xd0, 0xea, 0x94, 0x53, 0x8c, 0x66, 0xac, 0x29,
+	0x0d, 0x96, 0x52, 0x37, 0x40, 0x6e, 0x5f, 0x1e, 0x33, 0xb7, 0x04, 0xe3, 0x3a, 0x4b, 0xb8, 0x08,
+	0x71, 0x8c, 0x35, 0xa5, 0xc2, 0xc5, 0x02, 0xcc, 0x0b, 0x17, 0xb1, 0xf6, 0x24, 0xf6, 0x7e, 0x8b,
+	0x39, 0xfc, 0x30, 0xaa, 0xcd, 0x68, 0xa7, 0x73, 0x94, 0x70, 0xc5, 0x49, 0x18, 0xd0, 0x03, 0x01,
+	0xb6, 0x9f, 0x70, 0xa0, 0xaa, 0x30, 0x27, 0x6c, 0x77, 0x4d, 0x3d, 0xf8, 0x52, 0x19, 0xa7, 0x86,
+	0xc5, 0xf4, 0x64, 0x5b, 0xfb, 0x85, 0x04, 0xf3, 0x1d, 0x94, 0xd0, 0xff, 0x6d, 0xb8, 0x1c, 0x6b,
+	0x96, 0x89, 0xee, 0xdd, 0x3e, 0x41, 0xd6, 0x41, 0x6e, 0x5d, 0x27, 0x77, 0x58, 0x7d, 0x3f, 0x48,
+	0x2c, 0x1a, 0x85, 0x87, 0x77, 0xee, 0xbc, 0x3a, 0x01, 0x97, 0xb1, 0xcd, 0x5a, 0xac, 0x79, 0x4d,
+	0x7c, 0x04, 0x24, 0x2e, 0x44, 0x50, 0x1a, 0x0c, 0x98, 0x81, 0x00, 0xbb, 0xc9, 0x4c, 0xaa, 0x9b,
+	0x04, 0xca, 0x78, 0xaf, 0x0c, 0xf5, 0xd4, 0x4d, 0x98, 0x4d, 0xe7, 0x72, 0xd7, 0xd5, 0x99, 0x7b,
+	0x96, 0xe3, 0xe9, 0x77, 0x12, 0x5c, 0xcb, 0x71, 0x82, 0xb0, 0x1e, 0xc0, 0x80, 0x1d, 0x08, 0xba,
+	0x1f, 0x46, 0xc2, 0x0e, 0xbf, 0xef, 0xd0, 0x86, 0xdc, 0x01, 0xe2, 0xb2, 0xaa, 0x7d, 0xe4, 0xf8,
+	0x41, 0xed, 0x88, 0x2b, 0x0e, 0xf3, 0xe4, 0xbe, 0xb9, 0xfe, 0x20, 0xd3, 0xad, 0x99, 0x27, 0xe1,
+	0x44, 0xb0, 0xef, 0x2f, 0xa9, 0x69, 0xe8, 0xa2, 0x46, 0x87, 0x4a, 0xe1, 0x40, 0xfd, 0x71, 0x3b,
+	0xc4, 0x12, 0xf3, 0x7c, 0x93, 0xbf, 0xff, 0x75, 0xcb, 0x80, 0x42, 0x9e, 0x6b, 0x0c, 0x7f, 0x3b,
+	0x68, 0x0e, 0x81, 0x04, 0xe3, 0xbf, 0x95, 0x1f, 0x7f, 0xf3, 0xda, 0x19, 0xba, 0xc0, 0x4c, 0xa0,
+	0xb9, 0xba, 0x8f, 0x97, 0x8a, 0x6d, 0x66, 0x31, 0x97, 0x9a, 0xb9, 0xc1, 0x64, 0x5f, 0xed, 0x73,
+	0xf0, 0x3b, 0xb0, 0xd4, 0xc5, 0xe9, 0x79, 0x87, 0xf1, 0x6b, 0x09, 0xbf, 0xc2, 0xf8, 0x2d, 0xb3,
+	0xfe, 0x30, 0x6c, 0xa5, 0xb1, 0xcb, 0x40, 0x4e, 0xaf, 0x9d, 0x86, 0x41, 0x8f, 0x53, 0xee, 0x7b,
+	0xd8, 0xac, 0x70, 0x94, 0x3a, 0xb2, 0xfb, 0xbf, 0xf6, 0x91, 0xfd, 0x67, 0x09, 0xe6, 0x3b, 0xc0,
+	0xc3, 0x6c, 0x3c, 0x4d, 0x5f, 0xa3, 0x83, 0x0f, 0x6e, 0x31, 0x3f, 0x25, 0x1f, 0x1b, 0xfc, 0x70,
+	0x5f, 0x00, 0xcd, 0xb8, 0x50, 0x9f, 0xdf, 0x71, 0xfe, 0x23, 0xb8, 0x1e, 0x9e, 0x64, 0xc6, 0x91,
+	0x6f, 0x52, 0xce, 0x32, 0xae, 0xe7, 0x33, 0x30, 0xc4, 0x4f, 0xca, 0x95, 0x3a, 0x67, 0xe1, 0x3d,
+	0x6b, 0xb4, 0x74, 0x89, 0x9f, 0x6c, 0x06, 0x43, 0xa2, 0xc0, 0x90, 0xa1, 0x33, 0x8b, 0x1b, 0xbc,
+	0x8e, 0xc9, 0x6d, 0x8e, 0xd5, 0xff, 0xf6, 0xc3, 0x5c, 0xbe, 0xeb, 0x56, 0x9f, 0xf7, 0x2d, 0x9d,
+	0xb9, 0x66, 0xdd, 0xb0, 0x6a, 0xe5, 0x1a, 0xf5, 0xf0, 0x43, 0x1a, 0x6b, 0x49, 0xb7, 0xa9, 0x17,
+	0x3b, 0x0e, 0x0c, 0xdb, 0x12, 0x6a, 0x61, 0x49, 0x8e, 0xb5, 0xa4, 0x81, 0xda, 0x55, 0x18, 0xe6,
+	0x36, 0xa7, 0xa6, 0xd0, 0xe8, 0x17, 0x1a, 0x43, 0x42, 0x80, 0x93, 0x35, 0xea, 0x95, 0x4d, 0xe3,
+	0xc8, 0xe0, 0xf2, 0xc5, 0x70, 0xb2, 0x46, 0xbd, 0xa7, 0xc1, 0x98, 0x2c, 0xc3, 0x37, 0xaa, 0x86,
+	0x73, 0xc8, 0x5c, 0xce, 0x4e, 0x78, 0xd9, 0x33, 0x5e, 0x33, 0x79, 0x40, 0xa8, 0x8c, 0xb7, 0xc4,
+	0xfb, 0xc6, 0x6b, 0x46, 0xbe, 0x05, 0x83, 0xcc, 0xab, 0xba, 0xf6, 0x2b, 0x79, 0x50, 0x24, 0x7d,
+	0x26, 0x91, 0xf4, 0x28, 0xdd, 0x0f, 0x6d, 0xc3, 0x8a, 0x8a, 0x38, 0x54, 0x27, 0xab, 0xd0, 0x7f,
+	0xc0, 0x98, 0x7c, 0xa9, 0x37, 0xab, 0x40, 0x97, 0x6c, 0xc2, 0xe8, 0x01, 0x63, 0x65, 0x9d, 0xe9,
+	0x7e, 0x95, 0x33, 0x5d, 0x1e, 0xea, 0xcd, 0x76, 0xe4, 0x80, 0xb1, 0x2d, 0xb4, 0x89, 0x7c, 0xb8,
+	0xec, 0x20, 0xc8, 0xa8, 0x2e, 0x0f, 0xf7, 0xee, 0xa3, 0x84, 0x36, 0x64, 0x16, 0xc0, 0xf6, 0x79,
+	0xd9, 0x3e, 0x10, 0x79, 0x05, 0xd1, 0x27, 0x87, 0x6c, 0x9f, 0xef, 0x1e, 0x6c, 0x53, 0x6f, 0xed,
+	0xef, 0x32, 0x0c, 0x88, 0x7d, 0x26, 0x16, 0x0c, 0x86, 0xa7, 0x05, 0x99, 0x4f, 0xd5, 0x75, 0xfb,
+	0xbb, 0x85, 0xa2, 0x76, 0x52, 0x09, 0xab, 0x43, 0xbd, 0xf6, 0xe9, 0x3f, 0xfe, 0xf3, 0xab, 0xbe,
+	0x2b, 0x64, 0x4a, 0xcb, 0x7a, 0xc6, 0x21, 0xbf, 0x95, 0x60, 0x24, 0x56, 0x54, 0xe4, 0x56, 0x96,
+	0xcb, 0x4c, 0xb6, 0xac, 0xac, 0xf4, 0xa2, 0x8a, 0x28, 0xd6, 0x05, 0x8a, 0xfb, 0x64, 0x4d, 0xcb,
+	0x7f, 0x30, 0xd2, 0x4e, 0xe3, 0x9d, 0xbf, 0xa1, 0x9d, 0x8a, 0x5e, 0xd9, 0x20, 0x3f, 0x97, 0x60,
+	0x3c, 0xce, 0x9d, 0x4c, 0x33, 0x1b, 0x65, 0x26, 0x31, 0x56, 0x56, 0x7a, 0x51, 0x45, 0x94, 0x0b,
+	0x02, 0xe5, 0x35, 0x72, 0xb5, 0x03, 0x4a, 0xf2, 0x85, 0x04, 0x72, 0x12, 0x4e, 0x8b, 0x15, 0x92,
+	0x7b, 0xdd, 0x57, 0x6b, 0xe3, 0xa3, 0xca, 0xfd, 0xb3, 0x19, 0x21, 0xd8, 0x35, 0x01, 0xf6, 0x36,
+	0x59, 0xe9, 0x3d, 0xa5, 0xe4, 0x67, 0x12, 0x8c, 0xc6, 0x99, 0x23, 0x59, 0xce, 0x5a, 0x3a, 0x83,
+	0x77, 0x2a, 0x37, 0xbb, 0x2b, 0x22, 0xae, 0x45, 0x81, 0xab, 0x40, 0x66, 0x53, 0xb8, 0x4c, 0xa1,
+	0x5c, 0x0e, 0x29, 0x69, 0x80, 0x64, 0x28, 0x22, 0x5a, 0xe4, 0x46, 0x4e, 0x25, 0xa5, 0x08, 0xa2,
+	0xb2, 0xdc, 0x55, 0x0f, 0x31, 0xac, 0x08, 0x0c, 0x8b, 0x44, 0xd5, 0x72, 0x5e, 0x1f, 0xb5, 0x53,
+	0xa4, 0xb9, 0x0d, 0xf2, 0x53, 0x09, 0x46, 0x22, 0x07, 0x41, 0x6d, 0xdd, 0xc8, 0xd9, 0x8d, 0x9e,
+	0xc0, 0x64, 0x30, 0x4e, 0x75, 0x4e, 0x80, 0x51, 0x88, 0x9c, 0x07, 0x86, 0xd8, 0x30, 0x88, 0xf4,
+	0x2c, 0xfb, 0xa3, 0x8f, 0xd3, 0x44, 0x45, 0xed, 0xa4, 0x82, 0x4b, 0x16, 0xc4, 0x92, 0x32, 0x99,
+	0x4e, 0x2f, 0xe9, 0x57, 0x02, 0xda, 0x41, 0x3e, 0x95, 0x60, 0x24, 0x46, 0xb6, 0xc8, 0x42, 0x96,
+	0xcf, 0xd4, 0x73, 0xa1, 0xb2, 0xd8, 0x59, 0x09, 0x97, 0xbe, 0x29, 0x96, 0x56, 0xc9, 0x5c, 0x6a,
+	0xe9, 0x88, 0xed, 0x68, 0xa7, 0xe1, 0x35, 0xa9, 0x11, 0x80, 0x18, 0x8f, 0xad, 0xd1, 0x29, 0xf7,
+	0x69, 0x28, 0xcb, 0x5d, 0xf5, 0x10, 0xcd, 0x75, 0x81, 0x66, 0x86, 0x5c, 0xc9, 0x41, 0x43, 0xfe,
+	0x22, 0xc1, 0x74, 0x36, 0x03, 0x25, 0xab, 0x59, 0x8b, 0x74, 0xa4, 0xba, 0xca, 0xda, 0x59, 0x4c,
+	0x10, 0xe2, 0xa6, 0x80, 0xf8, 0x6d, 0xb2, 0xae, 0x3d, 0xa6, 0x86, 0x5b, 0x31, 0xed, 0xea, 0x8b,
+	0x14, 0x58, 0xef, 0xd0, 0x7e, 0x55, 0x76, 0x42, 0x0f, 0x4d, 0xd6, 0x58, 0x76, 0xd9, 0xb1, 0xc8,
+	0xe5, 0x13, 0xbd, 0x41, 0xfe, 0x24, 0xc1, 0x48, 0x8c, 0x02, 0x66, 0xe7, 0xb1, 0x9d, 0xa2, 0x2a,
+	0xcb, 0x5d, 0xf5, 0x10, 0xe4, 0xc7, 0x02, 0xe4, 0x1e, 0xd9, 0xcd, 0x05, 0x89, 0xb7, 0x08, 0x41,
+	0x34, 0xb5, 0xd3, 0x90, 0xea, 0x36, 0xb4, 0xd3, 0x04, 0xd3, 0x6d, 0x68, 0xa7, 0x49, 0x52, 0xdb,
+	0x20, 0xbf, 0x97, 0x60, 0x2a, 0x93, 0x54, 0x92, 0xbb, 0x59, 0xd8, 0x3a, 0x91, 0x54, 0x65, 0xf5,
+	0x0c, 0x16, 0x18, 0x97, 0x26, 0xe2, 0xba, 0x45, 0x96, 0x3b, 0x34, 0xd1, 0xb2, 0x78, 0xf3, 0x28,
+	0x0b, 0x32, 0x4b, 0xde, 0x48, 0x30, 0x99, 0xc5, 0x51, 0x89, 0x96, 0xb5, 0x78, 0x07, 0xca, 0xab,
+	0xdc, 0xed, 0xdd, 0x00, 0xc1, 0xde, 0x11, 0x60, 0x97, 0xc9, 0x52, 0x0a, 0xac, 0x2d, 0x8c, 0xca,
+	0x2e, 0x5a, 0x45, 0x2d, 0xf6, 0x73, 0x09, 0x2e, 0xb7, 0x31, 0x0b, 0x72, 0xbb, 0x4b, 0x92, 0x12,
+	0xac, 0x46, 0xb9, 0xd3, 0xa3, 0x36, 0x22, 0xdc, 0x10, 0x08, 0x1f, 0x90, 0x8f, 0x3a, 0xa5, 0x33,
+	0x64, 0x24, 0x79, 0xa7, 0xfd, 0xdf, 0x24, 0x90, 0xf3, 0x68, 0x51, 0xf6, 0xf1, 0xda, 0x85, 0x99,
+	0x29, 0xf7, 0xcf, 0x66, 0x84, 0xa1, 0x3c, 0x14, 0xa1, 0x7c, 0x87, 0x3c, 0x48, 0x85, 0x52, 0x0b,
+	0x0d, 0xcb, 0x99, 0x21, 0x61, 0x6b, 0x6b, 0x06, 0xf3, 0xb9, 0x04, 0x93, 0x59, 0x8c, 0x26, 0xbb,
+	0x5a, 0x3a, 0x50, 0x33, 0xe5, 0x6e, 0xef, 0x06, 0x18, 0xc0, 0x87, 0x22, 0x80, 0x35, 0x72, 0xb7,
+	0xd3, 0x5e, 0x54, 0xea, 0x65, 0xa4, 0x78, 0xda, 0x29, 0xfe, 0x68, 0x90, 0x9f, 0xc0, 0x80, 0x78,
+	0xe2, 0x20, 0x73, 0xd9, 0x87, 0x7e, 0xeb, 0x49, 0x44, 0x99, 0xef, 0xa0, 0x81, 0x38, 0x66, 0x05,
+	0x8e, 0x69, 0x32, 0xd9, 0x76, 0x1f, 0x08, 0x96, 0xf8, 0x4c, 0x82, 0x0f, 0xd2, 0x6f, 0x11, 0xe4,
+	0x9b, 0x5d, 0x82, 0x8d, 0x3f, 0x97, 0x28, 0xb7, 0x7b, 0x53, 0x3e, 0x4b, 0x56, 0xc4, 0x23, 0x48,
+	0xfa, 0xee, 0xf4, 0x99, 0x04, 0x13, 0x19, 0x34, 0x8c, 0x14, 0x33, 0x7b, 0x7e, 0x2e, 0x15, 0x54,
+	0xb4, 0x9e, 0xf5, 0x93, 0x3d, 0x6a, 0x5d, 0x5a, 0x51, 0x17, 0xd3, 0x27, 0x03, 0x9a, 0x25, 0xaa,
+	0x71, 0x73, 0xeb, 0xcb, 0xb7, 0x05, 0xe9, 0xab, 0xb7, 0x05, 0xe9, 0xdf, 0x6f, 0x0b, 0xd2, 0x2f,
+	0xdf, 0x15, 0x2e, 0x7c, 0xf5, 0xae, 0x70, 0xe1, 0x9f, 0xef, 0x0a, 0x17, 0x3e, 0x59, 0xa9, 0x19,
+	0xfc, 0xd0, 0xaf, 0x04, 0x4f, 0x9c, 0x99, 0x8d, 0xfc, 0x44, 0x78, 0x15, 0xff, 0x50, 0xad, 0x0c,
+	0x8a, 0xff, 0x98, 0xde, 0xfb, 0xff, 0x00, 0x01, 0x0b, 0x84, 0x5d, 0x9c, 0x1e, 0x00, 0x00,
 }
 
-func (m *QueryLatestHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a EncryptedTx by index.
+	EncryptedTx(ctx context.Context, in *QueryGetEncryptedTxRequest, opts ...grpc.CallOption) (*QueryGetEncryptedTxResponse, error)
+	// Queries a list of EncryptedTx items.
+	EncryptedTxAll(ctx context.Context, in *QueryAllEncryptedTxRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxResponse, error)
+	// Queries a list of EncryptedTx items.
+	EncryptedTxAllFromHeight(ctx context.Context, in *QueryAllEncryptedTxFromHeightRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxFromHeightResponse, error)
+	// Queries a list of LatestHeight items.
+	LatestHeight(ctx context.Context, in *QueryLatestHeightRequest, opts ...grpc.CallOption) (*QueryLatestHeightResponse, error)
+	// Queries a PepNonce by index.
+	PepNonce(ctx context.Context, in *QueryGetPepNonceRequest, opts ...grpc.CallOption) (*QueryGetPepNonceResponse, error)
+	// Queries a list of PepNonce items.
+	PepNonceAll(ctx context.Context, in *QueryAllPepNonceRequest, opts ...grpc.CallOption) (*QueryAllPepNonceResponse, error)
+	// Queries the public keys
+	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
+	KeyshareReq(ctx context.Context, in *QueryKeyshareRequest, opts ...grpc.CallOption) (*QueryKeyshareResponse, error)
+	KeyshareReqAll(ctx context.Context, in *QueryAllKeyshareRequest, opts ...grpc.CallOption) (*QueryAllKeyshareResponse, error)
+	// Queries a list of ShowPrivateKeyshareReq items.
+	ShowPrivateKeyshareReq(ctx context.Context, in *QueryShowPrivateKeyshareReqRequest, opts ...grpc.CallOption) (*QueryShowPrivateKeyshareReqResponse, error)
+	// Queries a list of DecryptData items.
+	DecryptData(ctx context.Context, in *QueryDecryptDataRequest, opts ...grpc.CallOption) (*QueryDecryptDataResponse, error)
+	// Queries the number of encrypted txs waiting in the carry-over execution queue.
+	EncryptedTxQueueDepth(ctx context.Context, in *QueryEncryptedTxQueueDepthRequest, opts ...grpc.CallOption) (*QueryEncryptedTxQueueDepthResponse, error)
+	// Queries the oldest height still retained for encrypted txs and aggregated keyshares
+	OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the execution result of a height-targeted encrypted tx
+	EncryptedTxResult(ctx context.Context, in *QueryEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryEncryptedTxResultResponse, error)
+	// Queries the execution result of a general encrypted tx
+	GeneralEncryptedTxResult(ctx context.Context, in *QueryGeneralEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryGeneralEncryptedTxResultResponse, error)
+	// Queries a list of encrypted txs submitted by a creator, optionally filtered by status
+	EncryptedTxByCreator(ctx context.Context, in *QueryEncryptedTxByCreatorRequest, opts ...grpc.CallOption) (*QueryEncryptedTxByCreatorResponse, error)
+	// Queries the effective lane configuration used to build and verify block proposals
+	Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error)
+	// Queries the recorded execution order of the encrypted txs of a target height along with the recomputed one
+	EncryptedTxOrder(ctx context.Context, in *QueryEncryptedTxOrderRequest, opts ...grpc.CallOption) (*QueryEncryptedTxOrderResponse, error)
+	// Estimates the gas and fee of an encrypted tx from its plaintext signed underlying tx
+	SimulateEncryptedTx(ctx context.Context, in *QuerySimulateEncryptedTxRequest, opts ...grpc.CallOption) (*QuerySimulateEncryptedTxResponse, error)
 }
 
-func (m *QueryLatestHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryLatestHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryLatestHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLatestHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EncryptedTx(ctx context.Context, in *QueryGetEncryptedTxRequest, opts ...grpc.CallOption) (*QueryGetEncryptedTxResponse, error) {
+	out := new(QueryGetEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) EncryptedTxAll(ctx context.Context, in *QueryAllEncryptedTxRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxResponse, error) {
+	out := new(QueryAllEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPepNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EncryptedTxAllFromHeight(ctx context.Context, in *QueryAllEncryptedTxFromHeightRequest, opts ...grpc.CallOption) (*QueryAllEncryptedTxFromHeightResponse, error) {
+	out := new(QueryAllEncryptedTxFromHeightResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxAllFromHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPepNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestHeight(ctx context.Context, in *QueryLatestHeightRequest, opts ...grpc.CallOption) (*QueryLatestHeightResponse, error) {
+	out := new(QueryLatestHeightResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/LatestHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPepNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PepNonce(ctx context.Context, in *QueryGetPepNonceRequest, opts ...grpc.CallOption) (*QueryGetPepNonceResponse, error) {
+	out := new(QueryGetPepNonceResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/PepNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPepNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PepNonceAll(ctx context.Context, in *QueryAllPepNonceRequest, opts ...grpc.CallOption) (*QueryAllPepNonceResponse, error) {
+	out := new(QueryAllPepNonceResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/PepNonceAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPepNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error) {
+	out := new(QueryPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/PubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPepNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PepNonce.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) KeyshareReq(ctx context.Context, in *QueryKeyshareRequest, opts ...grpc.CallOption) (*QueryKeyshareResponse, error) {
+	out := new(QueryKeyshareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/KeyshareReq", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPepNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyshareReqAll(ctx context.Context, in *QueryAllKeyshareRequest, opts ...grpc.CallOption) (*QueryAllKeyshareResponse, error) {
+	out := new(QueryAllKeyshareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/KeyshareReqAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPepNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllPepNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ShowPrivateKeyshareReq(ctx context.Context, in *QueryShowPrivateKeyshareReqRequest, opts ...grpc.CallOption) (*QueryShowPrivateKeyshareReqResponse, error) {
+	out := new(QueryShowPrivateKeyshareReqResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/ShowPrivateKeyshareReq", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPepNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DecryptData(ctx context.Context, in *QueryDecryptDataRequest, opts ...grpc.CallOption) (*QueryDecryptDataResponse, error) {
+	out := new(QueryDecryptDataResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/DecryptData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllPepNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllPepNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PepNonce) > 0 {
-		for iNdEx := len(m.PepNonce) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PepNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) EncryptedTxQueueDepth(ctx context.Context, in *QueryEncryptedTxQueueDepthRequest, opts ...grpc.CallOption) (*QueryEncryptedTxQueueDepthResponse, error) {
+	out := new(QueryEncryptedTxQueueDepthResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxQueueDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPubKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OldestRetainedHeight(ctx context.Context, in *QueryOldestRetainedHeightRequest, opts ...grpc.CallOption) (*QueryOldestRetainedHeightResponse, error) {
+	out := new(QueryOldestRetainedHeightResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/OldestRetainedHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPubKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EncryptedTxResult(ctx context.Context, in *QueryEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryEncryptedTxResultResponse, error) {
+	out := new(QueryEncryptedTxResultResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxResult", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ActivePubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GeneralEncryptedTxResult(ctx context.Context, in *QueryGeneralEncryptedTxResultRequest, opts ...grpc.CallOption) (*QueryGeneralEncryptedTxResultResponse, error) {
+	out := new(QueryGeneralEncryptedTxResultResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/GeneralEncryptedTxResult", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryShowPrivateKeyshareReqRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EncryptedTxByCreator(ctx context.Context, in *QueryEncryptedTxByCreatorRequest, opts ...grpc.CallOption) (*QueryEncryptedTxByCreatorResponse, error) {
+	out := new(QueryEncryptedTxByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxByCreator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryShowPrivateKeyshareReqRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Lanes(ctx context.Context, in *QueryLanesRequest, opts ...grpc.CallOption) (*QueryLanesResponse, error) {
+	out := new(QueryLanesResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/Lanes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryShowPrivateKeyshareReqRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReqId) > 0 {
-		i -= len(m.ReqId)
-		copy(dAtA[i:], m.ReqId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EncryptedTxOrder(ctx context.Context, in *QueryEncryptedTxOrderRequest, opts ...grpc.CallOption) (*QueryEncryptedTxOrderResponse, error) {
+	out := new(QueryEncryptedTxOrderResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryShowPrivateKeyshareReqResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateEncryptedTx(ctx context.Context, in *QuerySimulateEncryptedTxRequest, opts ...grpc.CallOption) (*QuerySimulateEncryptedTxResponse, error) {
+	out := new(QuerySimulateEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/SimulateEncryptedTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryShowPrivateKeyshareReqResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a EncryptedTx by index.
+	EncryptedTx(context.Context, *QueryGetEncryptedTxRequest) (*QueryGetEncryptedTxResponse, error)
+	// Queries a list of EncryptedTx items.
+	EncryptedTxAll(context.Context, *QueryAllEncryptedTxRequest) (*QueryAllEncryptedTxResponse, error)
+	// Queries a list of EncryptedTx items.
+	EncryptedTxAllFromHeight(context.Context, *QueryAllEncryptedTxFromHeightRequest) (*QueryAllEncryptedTxFromHeightResponse, error)
+	// Queries a list of LatestHeight items.
+	LatestHeight(context.Context, *QueryLatestHeightRequest) (*QueryLatestHeightResponse, error)
+	// Queries a PepNonce by index.
+	PepNonce(context.Context, *QueryGetPepNonceRequest) (*QueryGetPepNonceResponse, error)
+	// Queries a list of PepNonce items.
+	PepNonceAll(context.Context, *QueryAllPepNonceRequest) (*QueryAllPepNonceResponse, error)
+	// Queries the public keys
+	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
+	KeyshareReq(context.Context, *QueryKeyshareRequest) (*QueryKeyshareResponse, error)
+	KeyshareReqAll(context.Context, *QueryAllKeyshareRequest) (*QueryAllKeyshareResponse, error)
+	// Queries a list of ShowPrivateKeyshareReq items.
+	ShowPrivateKeyshareReq(context.Context, *QueryShowPrivateKeyshareReqRequest) (*QueryShowPrivateKeyshareReqResponse, error)
+	// Queries a list of DecryptData items.
+	DecryptData(context.Context, *QueryDecryptDataRequest) (*QueryDecryptDataResponse, error)
+	// Queries the number of encrypted txs waiting in the carry-over execution queue.
+	EncryptedTxQueueDepth(context.Context, *QueryEncryptedTxQueueDepthRequest) (*QueryEncryptedTxQueueDepthResponse, error)
+	// Queries the oldest height still retained for encrypted txs and aggregated keyshares
+	OldestRetainedHeight(context.Context, *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error)
+	// Queries the execution result of a height-targeted encrypted tx
+	EncryptedTxResult(context.Context, *QueryEncryptedTxResultRequest) (*QueryEncryptedTxResultResponse, error)
+	// Queries the execution result of a general encrypted tx
+	GeneralEncryptedTxResult(context.Context, *QueryGeneralEncryptedTxResultRequest) (*QueryGeneralEncryptedTxResultResponse, error)
+	// Queries a list of encrypted txs submitted by a creator, optionally filtered by status
+	EncryptedTxByCreator(context.Context, *QueryEncryptedTxByCreatorRequest) (*QueryEncryptedTxByCreatorResponse, error)
+	// Queries the effective lane configuration used to build and verify block proposals
+	Lanes(context.Context, *QueryLanesRequest) (*QueryLanesResponse, error)
+	// Queries the recorded execution order of the encrypted txs of a target height along with the recomputed one
+	EncryptedTxOrder(context.Context, *QueryEncryptedTxOrderRequest) (*QueryEncryptedTxOrderResponse, error)
+	// Estimates the gas and fee of an encrypted tx from its plaintext signed underlying tx
+	SimulateEncryptedTx(context.Context, *QuerySimulateEncryptedTxRequest) (*QuerySimulateEncryptedTxResponse, error)
 }
 
-func (m *QueryShowPrivateKeyshareReqResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EncryptedKeyshares) > 0 {
-		for iNdEx := len(m.EncryptedKeyshares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EncryptedKeyshares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ReqId) > 0 {
-		i -= len(m.ReqId)
-		copy(dAtA[i:], m.ReqId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryDecryptDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryDecryptDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) EncryptedTx(ctx context.Context, req *QueryGetEncryptedTxRequest) (*QueryGetEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTx not implemented")
 }
-
-func (m *QueryDecryptDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EncryptedData) > 0 {
-		i -= len(m.EncryptedData)
-		copy(dAtA[i:], m.EncryptedData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncryptedData)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AggrKeyshare) > 0 {
-		i -= len(m.AggrKeyshare)
-		copy(dAtA[i:], m.AggrKeyshare)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggrKeyshare)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) EncryptedTxAll(ctx context.Context, req *QueryAllEncryptedTxRequest) (*QueryAllEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxAll not implemented")
 }
-
-func (m *QueryDecryptDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EncryptedTxAllFromHeight(ctx context.Context, req *QueryAllEncryptedTxFromHeightRequest) (*QueryAllEncryptedTxFromHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxAllFromHeight not implemented")
 }
-
-func (m *QueryDecryptDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LatestHeight(ctx context.Context, req *QueryLatestHeightRequest) (*QueryLatestHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestHeight not implemented")
 }
-
-func (m *QueryDecryptDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DecryptedData) > 0 {
-		i -= len(m.DecryptedData)
-		copy(dAtA[i:], m.DecryptedData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DecryptedData)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) PepNonce(ctx context.Context, req *QueryGetPepNonceRequest) (*QueryGetPepNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PepNonce not implemented")
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) PepNonceAll(ctx context.Context, req *QueryAllPepNonceRequest) (*QueryAllPepNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PepNonceAll not implemented")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareReq(ctx context.Context, req *QueryKeyshareRequest) (*QueryKeyshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareReq not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareReqAll(ctx context.Context, req *QueryAllKeyshareRequest) (*QueryAllKeyshareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareReqAll not implemented")
+}
+func (*UnimplementedQueryServer) ShowPrivateKeyshareReq(ctx context.Context, req *QueryShowPrivateKeyshareReqRequest) (*QueryShowPrivateKeyshareReqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPrivateKeyshareReq not implemented")
+}
+func (*UnimplementedQueryServer) DecryptData(ctx context.Context, req *QueryDecryptDataRequest) (*QueryDecryptDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecryptData not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedTxQueueDepth(ctx context.Context, req *QueryEncryptedTxQueueDepthRequest) (*QueryEncryptedTxQueueDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxQueueDepth not implemented")
+}
+func (*UnimplementedQueryServer) OldestRetainedHeight(ctx context.Context, req *QueryOldestRetainedHeightRequest) (*QueryOldestRetainedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OldestRetainedHeight not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedTxResult(ctx context.Context, req *QueryEncryptedTxResultRequest) (*QueryEncryptedTxResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxResult not implemented")
+}
+func (*UnimplementedQueryServer) GeneralEncryptedTxResult(ctx context.Context, req *QueryGeneralEncryptedTxResultRequest) (*QueryGeneralEncryptedTxResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralEncryptedTxResult not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedTxByCreator(ctx context.Context, req *QueryEncryptedTxByCreatorRequest) (*QueryEncryptedTxByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxByCreator not implemented")
+}
+func (*UnimplementedQueryServer) Lanes(ctx context.Context, req *QueryLanesRequest) (*QueryLanesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lanes not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedTxOrder(ctx context.Context, req *QueryEncryptedTxOrderRequest) (*QueryEncryptedTxOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateEncryptedTx(ctx context.Context, req *QuerySimulateEncryptedTxRequest) (*QuerySimulateEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEncryptedTx not implemented")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryKeyshareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ReqId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryKeyshareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/Params",
 	}
-	var l int
-	_ = l
-	if m.Keyshare != nil {
-		l = m.Keyshare.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllKeyshareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEncryptedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTx(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTx(ctx, req.(*QueryGetEncryptedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllKeyshareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EncryptedTxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEncryptedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Keyshares) > 0 {
-		for _, e := range m.Keyshares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxAll(ctx, req.(*QueryAllEncryptedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetEncryptedTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EncryptedTxAllFromHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEncryptedTxFromHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TargetHeight != 0 {
-		n += 1 + sovQuery(uint64(m.TargetHeight))
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxAllFromHeight(ctx, in)
 	}
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxAllFromHeight",
 	}
-	return n
-}
-
-func (m *QueryGetEncryptedTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxAllFromHeight(ctx, req.(*QueryAllEncryptedTxFromHeightRequest))
 	}
-	var l int
-	_ = l
-	l = m.EncryptedTx.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEncryptedTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LatestHeight(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/LatestHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestHeight(ctx, req.(*QueryLatestHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEncryptedTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PepNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPepNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.EncryptedTxArray) > 0 {
-		for _, e := range m.EncryptedTxArray {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PepNonce(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/PepNonce",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PepNonce(ctx, req.(*QueryGetPepNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEncryptedTxFromHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PepNonceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPepNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TargetHeight != 0 {
-		n += 1 + sovQuery(uint64(m.TargetHeight))
+	if interceptor == nil {
+		return srv.(QueryServer).PepNonceAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/PepNonceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PepNonceAll(ctx, req.(*QueryAllPepNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEncryptedTxFromHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EncryptedTxArray.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/PubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKey(ctx, req.(*QueryPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareReq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/KeyshareReq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareReq(ctx, req.(*QueryKeyshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareReqAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareReqAll(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/KeyshareReqAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareReqAll(ctx, req.(*QueryAllKeyshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPepNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ShowPrivateKeyshareReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowPrivateKeyshareReqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ShowPrivateKeyshareReq(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/ShowPrivateKeyshareReq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowPrivateKeyshareReq(ctx, req.(*QueryShowPrivateKeyshareReqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPepNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DecryptData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecryptDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PepNonce.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).DecryptData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/DecryptData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecryptData(ctx, req.(*QueryDecryptDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPepNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EncryptedTxQueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxQueueDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxQueueDepth(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxQueueDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxQueueDepth(ctx, req.(*QueryEncryptedTxQueueDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPepNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OldestRetainedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOldestRetainedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PepNonce) > 0 {
-		for _, e := range m.PepNonce {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/OldestRetainedHeight",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OldestRetainedHeight(ctx, req.(*QueryOldestRetainedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EncryptedTxResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxResult(ctx, req.(*QueryEncryptedTxResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GeneralEncryptedTxResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGeneralEncryptedTxResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralEncryptedTxResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/GeneralEncryptedTxResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralEncryptedTxResult(ctx, req.(*QueryGeneralEncryptedTxResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxByCreator(ctx, req.(*QueryEncryptedTxByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lanes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLanesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lanes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/Lanes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lanes(ctx, req.(*QueryLanesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxOrder(ctx, req.(*QueryEncryptedTxOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateEncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateEncryptedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateEncryptedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/SimulateEncryptedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateEncryptedTx(ctx, req.(*QuerySimulateEncryptedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fairyring.pep.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EncryptedTx",
+			Handler:    _Query_EncryptedTx_Handler,
+		},
+		{
+			MethodName: "EncryptedTxAll",
+			Handler:    _Query_EncryptedTxAll_Handler,
+		},
+		{
+			MethodName: "EncryptedTxAllFromHeight",
+			Handler:    _Query_EncryptedTxAllFromHeight_Handler,
+		},
+		{
+			MethodName: "LatestHeight",
+			Handler:    _Query_LatestHeight_Handler,
+		},
+		{
+			MethodName: "PepNonce",
+			Handler:    _Query_PepNonce_Handler,
+		},
+		{
+			MethodName: "PepNonceAll",
+			Handler:    _Query_PepNonceAll_Handler,
+		},
+		{
+			MethodName: "PubKey",
+			Handler:    _Query_PubKey_Handler,
+		},
+		{
+			MethodName: "KeyshareReq",
+			Handler:    _Query_KeyshareReq_Handler,
+		},
+		{
+			MethodName: "KeyshareReqAll",
+			Handler:    _Query_KeyshareReqAll_Handler,
+		},
+		{
+			MethodName: "ShowPrivateKeyshareReq",
+			Handler:    _Query_ShowPrivateKeyshareReq_Handler,
+		},
+		{
+			MethodName: "DecryptData",
+			Handler:    _Query_DecryptData_Handler,
+		},
+		{
+			MethodName: "EncryptedTxQueueDepth",
+			Handler:    _Query_EncryptedTxQueueDepth_Handler,
+		},
+		{
+			MethodName: "OldestRetainedHeight",
+			Handler:    _Query_OldestRetainedHeight_Handler,
+		},
+		{
+			MethodName: "EncryptedTxResult",
+			Handler:    _Query_EncryptedTxResult_Handler,
+		},
+		{
+			MethodName: "GeneralEncryptedTxResult",
+			Handler:    _Query_GeneralEncryptedTxResult_Handler,
+		},
+		{
+			MethodName: "EncryptedTxByCreator",
+			Handler:    _Query_EncryptedTxByCreator_Handler,
+		},
+		{
+			MethodName: "Lanes",
+			Handler:    _Query_Lanes_Handler,
+		},
+		{
+			MethodName: "EncryptedTxOrder",
+			Handler:    _Query_EncryptedTxOrder_Handler,
+		},
+		{
+			MethodName: "SimulateEncryptedTx",
+			Handler:    _Query_SimulateEncryptedTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fairyring/pep/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyshareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyshareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keyshare != nil {
+		{
+			size, err := m.Keyshare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keyshares) > 0 {
+		for iNdEx := len(m.Keyshares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keyshares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEncryptedTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEncryptedTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEncryptedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EncryptedTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEncryptedTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEncryptedTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEncryptedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EncryptedTxArray) > 0 {
+		for iNdEx := len(m.EncryptedTxArray) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTxArray[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEncryptedTxFromHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEncryptedTxFromHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEncryptedTxFromHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEncryptedTxFromHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEncryptedTxFromHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEncryptedTxFromHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EncryptedTxArray.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPepNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPepNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPepNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPepNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPepNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPepNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PepNonce.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPepNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPepNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPepNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPepNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPepNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPepNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PepNonce) > 0 {
+		for iNdEx := len(m.PepNonce) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PepNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ActivePubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowPrivateKeyshareReqRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowPrivateKeyshareReqRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowPrivateKeyshareReqRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowPrivateKeyshareReqResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowPrivateKeyshareReqResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowPrivateKeyshareReqResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedKeyshares) > 0 {
+		for iNdEx := len(m.EncryptedKeyshares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedKeyshares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecryptDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecryptDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecryptDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedData) > 0 {
+		i -= len(m.EncryptedData)
+		copy(dAtA[i:], m.EncryptedData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncryptedData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AggrKeyshare) > 0 {
+		i -= len(m.AggrKeyshare)
+		copy(dAtA[i:], m.AggrKeyshare)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggrKeyshare)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecryptDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecryptDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecryptDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecryptedData) > 0 {
+		i -= len(m.DecryptedData)
+		copy(dAtA[i:], m.DecryptedData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DecryptedData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxQueueDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxQueueDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxQueueDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxQueueDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxQueueDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxQueueDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestRetainedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestRetainedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestRetainedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestRetainedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestRetainedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestRetainedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AggregatedKeyShareHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AggregatedKeyShareHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EncryptedTxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EncryptedTxHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLanesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLanesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLanesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLanesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLanesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLanesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for iNdEx := len(m.Lanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecomputedIndexes) > 0 {
+		dAtA15 := make([]byte, len(m.RecomputedIndexes)*10)
+		var j14 int
+		for _, num := range m.RecomputedIndexes {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGeneralEncryptedTxResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGeneralEncryptedTxResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGeneralEncryptedTxResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGeneralEncryptedTxResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGeneralEncryptedTxResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGeneralEncryptedTxResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EncryptedTx) > 0 {
+		for iNdEx := len(m.EncryptedTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateEncryptedTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateEncryptedTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateEncryptedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutOfGas {
+		i--
+		if m.OutOfGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.FeeRefunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.FeeDeducted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CiphertextSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CiphertextSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DecryptionGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DecryptionGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnderlyingGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnderlyingGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryKeyshareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyshareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keyshare != nil {
+		l = m.Keyshare.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyshareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyshareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keyshares) > 0 {
+		for _, e := range m.Keyshares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEncryptedTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TargetHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryGetEncryptedTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EncryptedTx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEncryptedTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEncryptedTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EncryptedTxArray) > 0 {
+		for _, e := range m.EncryptedTxArray {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEncryptedTxFromHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TargetHeight))
+	}
+	return n
+}
+
+func (m *QueryAllEncryptedTxFromHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EncryptedTxArray.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryGetPepNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPepNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PepNonce.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPepNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPepNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PepNonce) > 0 {
+		for _, e := range m.PepNonce {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActivePubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QueuedPubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShowPrivateKeyshareReqRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowPrivateKeyshareReqResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.EncryptedKeyshares) > 0 {
+		for _, e := range m.EncryptedKeyshares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDecryptDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AggrKeyshare)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EncryptedData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecryptDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DecryptedData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxQueueDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEncryptedTxQueueDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryOldestRetainedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOldestRetainedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EncryptedTxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EncryptedTxHeight))
+	}
+	if m.AggregatedKeyShareHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AggregatedKeyShareHeight))
+	}
+	return n
+}
+
+func (m *QueryLanesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLanesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lanes) > 0 {
+		for _, e := range m.Lanes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TargetHeight))
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RecomputedIndexes) > 0 {
+		l = 0
+		for _, e := range m.RecomputedIndexes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TargetHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGeneralEncryptedTxResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryGeneralEncryptedTxResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEncryptedTxByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EncryptedTx) > 0 {
+		for _, e := range m.EncryptedTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateEncryptedTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateEncryptedTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnderlyingGas != 0 {
+		n += 1 + sovQuery(uint64(m.UnderlyingGas))
+	}
+	if m.DecryptionGas != 0 {
+		n += 1 + sovQuery(uint64(m.DecryptionGas))
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGas))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.CiphertextSize != 0 {
+		n += 1 + sovQuery(uint64(m.CiphertextSize))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeDeducted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeRefunded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OutOfGas {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyshareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyshareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyshareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyshareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyshareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyshare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Keyshare == nil {
+				m.Keyshare = &GenEncTxExecutionQueue{}
+			}
+			if err := m.Keyshare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeyshareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyshareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyshareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeyshareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyshareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyshares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyshares = append(m.Keyshares, &GenEncTxExecutionQueue{})
+			if err := m.Keyshares[len(m.Keyshares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEncryptedTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEncryptedTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEncryptedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEncryptedTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEncryptedTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EncryptedTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEncryptedTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEncryptedTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTxArray = append(m.EncryptedTxArray, EncryptedTxArray{})
+			if err := m.EncryptedTxArray[len(m.EncryptedTxArray)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEncryptedTxFromHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEncryptedTxFromHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EncryptedTxArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPepNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPepNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPepNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPepNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPepNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPepNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PepNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PepNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPepNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPepNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPepNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.ActivePubKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QueuedPubKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryShowPrivateKeyshareReqRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ReqId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryShowPrivateKeyshareReqResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ReqId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.EncryptedKeyshares) > 0 {
-		for _, e := range m.EncryptedKeyshares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllPepNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPepNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPepNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PepNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PepNonce = append(m.PepNonce, PepNonce{})
+			if err := m.PepNonce[len(m.PepNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryDecryptDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AggrKeyshare)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EncryptedData)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryDecryptDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DecryptedData)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2976,10 +6574,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3003,7 +6601,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3026,15 +6624,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3061,7 +6659,40 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActivePubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3086,7 +6717,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyshareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryShowPrivateKeyshareReqRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3109,10 +6740,10 @@ func (m *QueryKeyshareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyshareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyshareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3168,7 +6799,7 @@ func (m *QueryKeyshareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKeyshareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,15 +6822,111 @@ func (m *QueryKeyshareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKeyshareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKeyshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyshare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKeyshares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3226,10 +6953,8 @@ func (m *QueryKeyshareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Keyshare == nil {
-				m.Keyshare = &GenEncTxExecutionQueue{}
-			}
-			if err := m.Keyshare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EncryptedKeyshares = append(m.EncryptedKeyshares, &types.EncryptedKeyshare{})
+			if err := m.EncryptedKeyshares[len(m.EncryptedKeyshares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3254,7 +6979,7 @@ func (m *QueryKeyshareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeyshareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDecryptDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3277,17 +7002,17 @@ func (m *QueryAllKeyshareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeyshareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecryptDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeyshareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecryptDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3297,27 +7022,87 @@ func (m *QueryAllKeyshareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EncryptedData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3340,7 +7125,7 @@ func (m *QueryAllKeyshareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeyshareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDecryptDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3363,17 +7148,17 @@ func (m *QueryAllKeyshareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeyshareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecryptDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeyshareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecryptDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyshares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecryptedData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3383,62 +7168,74 @@ func (m *QueryAllKeyshareResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keyshares = append(m.Keyshares, &GenEncTxExecutionQueue{})
-			if err := m.Keyshares[len(m.Keyshares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DecryptedData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedTxQueueDepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedTxQueueDepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedTxQueueDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3460,7 +7257,7 @@ func (m *QueryAllKeyshareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEncryptedTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxQueueDepthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3483,36 +7280,17 @@ func (m *QueryGetEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEncryptedTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxQueueDepthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEncryptedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxQueueDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
-			}
-			m.TargetHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			m.Index = 0
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3522,7 +7300,7 @@ func (m *QueryGetEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Depth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3548,7 +7326,7 @@ func (m *QueryGetEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEncryptedTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOldestRetainedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3571,45 +7349,12 @@ func (m *QueryGetEncryptedTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEncryptedTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOldestRetainedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOldestRetainedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EncryptedTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3631,7 +7376,7 @@ func (m *QueryGetEncryptedTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEncryptedTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOldestRetainedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3654,17 +7399,17 @@ func (m *QueryAllEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOldestRetainedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOldestRetainedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxHeight", wireType)
 			}
-			var msglen int
+			m.EncryptedTxHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3674,28 +7419,30 @@ func (m *QueryAllEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EncryptedTxHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShareHeight", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AggregatedKeyShareHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregatedKeyShareHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3717,7 +7464,7 @@ func (m *QueryAllEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEncryptedTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLanesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3740,49 +7487,65 @@ func (m *QueryAllEncryptedTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLanesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLanesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxArray", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLanesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedTxArray = append(m.EncryptedTxArray, EncryptedTxArray{})
-			if err := m.EncryptedTxArray[len(m.EncryptedTxArray)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLanesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLanesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3809,10 +7572,8 @@ func (m *QueryAllEncryptedTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lanes = append(m.Lanes, &LaneParams{})
+			if err := m.Lanes[len(m.Lanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3837,7 +7598,7 @@ func (m *QueryAllEncryptedTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEncryptedTxFromHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3860,10 +7621,10 @@ func (m *QueryAllEncryptedTxFromHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3906,7 +7667,7 @@ func (m *QueryAllEncryptedTxFromHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEncryptedTxFromHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3929,15 +7690,15 @@ func (m *QueryAllEncryptedTxFromHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEncryptedTxFromHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3964,60 +7725,106 @@ func (m *QueryAllEncryptedTxFromHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EncryptedTxArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLatestHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RecomputedIndexes = append(m.RecomputedIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RecomputedIndexes) == 0 {
+					m.RecomputedIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RecomputedIndexes = append(m.RecomputedIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecomputedIndexes", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4039,7 +7846,7 @@ func (m *QueryLatestHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4062,17 +7869,17 @@ func (m *QueryLatestHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
 			}
-			m.Height = 0
+			m.TargetHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4082,7 +7889,26 @@ func (m *QueryLatestHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4108,7 +7934,7 @@ func (m *QueryLatestHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPepNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4131,17 +7957,17 @@ func (m *QueryGetPepNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPepNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPepNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4151,23 +7977,24 @@ func (m *QueryGetPepNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4190,7 +8017,7 @@ func (m *QueryGetPepNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPepNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGeneralEncryptedTxResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4213,17 +8040,17 @@ func (m *QueryGetPepNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPepNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGeneralEncryptedTxResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPepNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGeneralEncryptedTxResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PepNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4233,25 +8060,43 @@ func (m *QueryGetPepNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PepNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4273,7 +8118,7 @@ func (m *QueryGetPepNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPepNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGeneralEncryptedTxResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4296,15 +8141,15 @@ func (m *QueryAllPepNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPepNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGeneralEncryptedTxResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPepNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGeneralEncryptedTxResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4331,10 +8176,7 @@ func (m *QueryAllPepNonceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4359,7 +8201,7 @@ func (m *QueryAllPepNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPepNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxByCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4382,17 +8224,17 @@ func (m *QueryAllPepNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPepNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxByCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPepNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PepNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4402,27 +8244,57 @@ func (m *QueryAllPepNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PepNonce = append(m.PepNonce, PepNonce{})
-			if err := m.PepNonce[len(m.PepNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4452,7 +8324,7 @@ func (m *QueryAllPepNonceResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4479,57 +8351,7 @@ func (m *QueryAllPepNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEncryptedTxByCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4552,15 +8374,15 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEncryptedTxByCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEncryptedTxByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4587,13 +8409,14 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActivePubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EncryptedTx = append(m.EncryptedTx, EncryptedTxWithStatus{})
+			if err := m.EncryptedTx[len(m.EncryptedTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4620,7 +8443,10 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QueuedPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4645,7 +8471,7 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowPrivateKeyshareReqRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateEncryptedTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4668,15 +8494,49 @@ func (m *QueryShowPrivateKeyshareReqRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateEncryptedTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateEncryptedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4704,7 +8564,7 @@ func (m *QueryShowPrivateKeyshareReqRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReqId = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4727,7 +8587,7 @@ func (m *QueryShowPrivateKeyshareReqRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateEncryptedTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4750,17 +8610,17 @@ func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateEncryptedTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowPrivateKeyshareReqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingGas", wireType)
 			}
-			var stringLen uint64
+			m.UnderlyingGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4770,29 +8630,16 @@ func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UnderlyingGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecryptionGas", wireType)
 			}
-			var stringLen uint64
+			m.DecryptionGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4802,29 +8649,16 @@ func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DecryptionGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReqId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
 			}
-			var stringLen uint64
+			m.TotalGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4834,27 +8668,52 @@ func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Pubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CiphertextSize", wireType)
+			}
+			m.CiphertextSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CiphertextSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKeyshares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4881,66 +8740,15 @@ func (m *QueryShowPrivateKeyshareReqResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedKeyshares = append(m.EncryptedKeyshares, &types.EncryptedKeyshare{})
-			if err := m.EncryptedKeyshares[len(m.EncryptedKeyshares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDecryptDataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDecryptDataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDecryptDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4950,29 +8758,30 @@ func (m *QueryDecryptDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4982,29 +8791,30 @@ func (m *QueryDecryptDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeDeducted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRefunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5014,79 +8824,30 @@ func (m *QueryDecryptDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedData = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FeeRefunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDecryptDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDecryptDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDecryptDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecryptedData", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfGas", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5096,24 +8857,12 @@ func (m *QueryDecryptDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DecryptedData = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.OutOfGas = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
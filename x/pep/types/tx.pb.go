@@ -958,6 +958,112 @@ func (m *MsgUnregisterContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnregisterContractResponse proto.InternalMessageInfo
 
+// MsgCancelEncryptedTx cancels a pending encrypted tx, identified either by
+// its target height and index or by its request id and index
+type MsgCancelEncryptedTx struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TargetHeight uint64 `protobuf:"varint,2,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+	ReqId        string `protobuf:"bytes,3,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Index        uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgCancelEncryptedTx) Reset()         { *m = MsgCancelEncryptedTx{} }
+func (m *MsgCancelEncryptedTx) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelEncryptedTx) ProtoMessage()    {}
+func (*MsgCancelEncryptedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6953e463911e1ec, []int{19}
+}
+func (m *MsgCancelEncryptedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelEncryptedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelEncryptedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelEncryptedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelEncryptedTx.Merge(m, src)
+}
+func (m *MsgCancelEncryptedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelEncryptedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelEncryptedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelEncryptedTx proto.InternalMessageInfo
+
+func (m *MsgCancelEncryptedTx) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelEncryptedTx) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+func (m *MsgCancelEncryptedTx) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+func (m *MsgCancelEncryptedTx) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type MsgCancelEncryptedTxResponse struct {
+}
+
+func (m *MsgCancelEncryptedTxResponse) Reset()         { *m = MsgCancelEncryptedTxResponse{} }
+func (m *MsgCancelEncryptedTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelEncryptedTxResponse) ProtoMessage()    {}
+func (*MsgCancelEncryptedTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6953e463911e1ec, []int{20}
+}
+func (m *MsgCancelEncryptedTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelEncryptedTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelEncryptedTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelEncryptedTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelEncryptedTxResponse.Merge(m, src)
+}
+func (m *MsgCancelEncryptedTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelEncryptedTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelEncryptedTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelEncryptedTxResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "fairyring.pep.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "fairyring.pep.MsgUpdateParamsResponse")
@@ -978,72 +1084,77 @@ func init() {
 	proto.RegisterType((*MsgRegisterContractResponse)(nil), "fairyring.pep.MsgRegisterContractResponse")
 	proto.RegisterType((*MsgUnregisterContract)(nil), "fairyring.pep.MsgUnregisterContract")
 	proto.RegisterType((*MsgUnregisterContractResponse)(nil), "fairyring.pep.MsgUnregisterContractResponse")
+	proto.RegisterType((*MsgCancelEncryptedTx)(nil), "fairyring.pep.MsgCancelEncryptedTx")
+	proto.RegisterType((*MsgCancelEncryptedTxResponse)(nil), "fairyring.pep.MsgCancelEncryptedTxResponse")
 }
 
 func init() { proto.RegisterFile("fairyring/pep/tx.proto", fileDescriptor_f6953e463911e1ec) }
 
 var fileDescriptor_f6953e463911e1ec = []byte{
-	// 952 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x24, 0x0d, 0xdd, 0xd7, 0x42, 0x1a, 0x37, 0x49, 0x37, 0x86, 0x3a, 0x61, 0x4b,
-	0xd1, 0xb2, 0xb4, 0x6b, 0x12, 0x10, 0x42, 0xb9, 0x75, 0x1b, 0x48, 0xab, 0x6a, 0xa5, 0x68, 0x43,
-	0x2b, 0xc1, 0x65, 0x35, 0xb6, 0x5f, 0x67, 0xad, 0x64, 0x6d, 0x67, 0x66, 0x36, 0xc4, 0x9c, 0x2a,
-	0x84, 0x38, 0x70, 0xe2, 0xc8, 0x1f, 0xc0, 0x81, 0x63, 0x0e, 0xfc, 0x11, 0x3d, 0x80, 0x54, 0x71,
-	0xe2, 0x04, 0x28, 0x39, 0xe4, 0xdf, 0x40, 0xb6, 0xc7, 0xde, 0xac, 0x7f, 0x6c, 0x36, 0x5c, 0x7a,
-	0x49, 0x76, 0x66, 0xbe, 0xf3, 0xbe, 0x9f, 0xf7, 0x3c, 0xf3, 0x6c, 0x58, 0x7e, 0x4e, 0x1c, 0x16,
-	0x30, 0xc7, 0xa5, 0x86, 0x8f, 0xbe, 0x21, 0x8e, 0x9a, 0x3e, 0xf3, 0x84, 0xa7, 0xbe, 0x99, 0xce,
-	0x37, 0x7d, 0xf4, 0xb5, 0x05, 0xd2, 0x77, 0x5c, 0xcf, 0x88, 0xfe, 0xc6, 0x0a, 0xed, 0x96, 0xe5,
-	0xf1, 0xbe, 0xc7, 0x8d, 0x3e, 0xa7, 0xc6, 0xe1, 0x7a, 0xf8, 0x4f, 0x2e, 0xac, 0xc4, 0x0b, 0xdd,
-	0x68, 0x64, 0xc4, 0x03, 0xb9, 0xa4, 0x53, 0xcf, 0xa3, 0xfb, 0x68, 0x44, 0x23, 0x73, 0xf0, 0xdc,
-	0xb0, 0x07, 0x8c, 0x08, 0xc7, 0x73, 0xe5, 0xfa, 0x22, 0xf5, 0xa8, 0x17, 0xef, 0x0b, 0x7f, 0xc9,
-	0x59, 0x6d, 0x94, 0xd1, 0x27, 0x8c, 0xf4, 0x93, 0x88, 0xf5, 0xd1, 0x35, 0x42, 0x29, 0x43, 0x4a,
-	0x04, 0xda, 0xdd, 0x3d, 0x0c, 0xba, 0xbc, 0x47, 0x18, 0x26, 0xde, 0x92, 0xd7, 0x24, 0x1c, 0x8d,
-	0xc3, 0x75, 0x13, 0x05, 0x59, 0x37, 0x2c, 0xcf, 0x91, 0xde, 0xb5, 0xdf, 0x15, 0x98, 0x6f, 0x73,
-	0xfa, 0xd4, 0xb7, 0x89, 0xc0, 0x9d, 0xc8, 0x43, 0xfd, 0x14, 0x2a, 0x64, 0x20, 0x7a, 0x1e, 0x73,
-	0x44, 0x50, 0x55, 0xd6, 0x94, 0x7a, 0xa5, 0x55, 0xfd, 0xf3, 0xb7, 0xfb, 0x8b, 0x32, 0xa9, 0x07,
-	0xb6, 0xcd, 0x90, 0xf3, 0x5d, 0x11, 0xfa, 0x77, 0x86, 0x52, 0xf5, 0x33, 0x98, 0x8b, 0x29, 0xab,
-	0xd3, 0x6b, 0x4a, 0xfd, 0xda, 0xc6, 0x52, 0x73, 0xa4, 0x9c, 0xcd, 0x38, 0x7c, 0xab, 0xf2, 0xf2,
-	0xef, 0xd5, 0xa9, 0x5f, 0xcf, 0x8e, 0x1b, 0x4a, 0x47, 0xea, 0x37, 0xb7, 0xbf, 0x3b, 0x3b, 0x6e,
-	0x0c, 0x23, 0xfd, 0x78, 0x76, 0xdc, 0xf8, 0x84, 0x3a, 0xa2, 0x37, 0x30, 0x9b, 0x96, 0xd7, 0x37,
-	0xbe, 0x20, 0x0e, 0x33, 0xf7, 0x3d, 0x6b, 0xcf, 0x18, 0xe6, 0x7d, 0x14, 0x65, 0x9e, 0x41, 0xaf,
-	0xad, 0xc0, 0xad, 0xcc, 0x54, 0x07, 0xb9, 0xef, 0xb9, 0x1c, 0x6b, 0x2f, 0x14, 0x58, 0x6c, 0x73,
-	0xba, 0x3b, 0x30, 0xfb, 0x8e, 0xf8, 0xdc, 0xb5, 0x58, 0xe0, 0x0b, 0xb4, 0xbf, 0x3c, 0x52, 0xab,
-	0xf0, 0x86, 0xc5, 0x90, 0x08, 0x8f, 0xc5, 0xc9, 0x76, 0x92, 0xa1, 0xaa, 0xc2, 0xac, 0x4d, 0x04,
-	0x89, 0xd2, 0xa9, 0x74, 0xa2, 0xdf, 0xea, 0x3d, 0x58, 0x10, 0x84, 0x51, 0x14, 0xad, 0x10, 0xe8,
-	0x11, 0x3a, 0xb4, 0x27, 0xaa, 0x33, 0x6b, 0x4a, 0x7d, 0xb6, 0x93, 0x5f, 0xd8, 0xbc, 0x1e, 0x26,
-	0x96, 0xc4, 0xab, 0xf9, 0xf0, 0x76, 0x4a, 0xb0, 0x8d, 0x2e, 0x32, 0xb2, 0xff, 0xff, 0x41, 0x96,
-	0x60, 0x8e, 0xe1, 0x41, 0xd7, 0xb1, 0x23, 0xf7, 0x4a, 0xe7, 0x0a, 0xc3, 0x83, 0xc7, 0x76, 0xc6,
-	0x51, 0x87, 0x77, 0x8a, 0x72, 0x4e, 0x8b, 0x72, 0x10, 0x11, 0x3d, 0x0c, 0xd5, 0xf8, 0x20, 0x3d,
-	0x45, 0x4f, 0x30, 0xd8, 0x0d, 0xcf, 0xd0, 0x18, 0xa2, 0x65, 0x98, 0xeb, 0xc5, 0xb9, 0x4f, 0x47,
-	0xb9, 0xcb, 0x51, 0x4a, 0x3a, 0x33, 0x24, 0xcd, 0x20, 0xdd, 0x85, 0x3b, 0x63, 0x2c, 0x53, 0xb2,
-	0x5f, 0x14, 0x58, 0x69, 0x73, 0xda, 0xc1, 0x83, 0x01, 0xf2, 0xa4, 0x5a, 0x4f, 0x30, 0xe0, 0x17,
-	0x80, 0x3d, 0x82, 0x79, 0xe4, 0xc2, 0xe9, 0x47, 0xb7, 0xc1, 0xc6, 0x7d, 0x12, 0xc8, 0xd3, 0xb8,
-	0xd2, 0x8c, 0xaf, 0x61, 0x33, 0xb9, 0x86, 0xcd, 0x2d, 0x79, 0x0d, 0x5b, 0xb3, 0x3f, 0xff, 0xb3,
-	0xaa, 0x74, 0xde, 0x4a, 0xf7, 0x6d, 0x85, 0xdb, 0x26, 0x2b, 0xf0, 0x26, 0xbc, 0x5b, 0x4a, 0x99,
-	0xe4, 0x72, 0x2e, 0x92, 0x72, 0x2e, 0x52, 0xed, 0x19, 0x2c, 0xb5, 0x39, 0xdd, 0xc6, 0x4b, 0x64,
-	0x37, 0x8c, 0x34, 0x5d, 0xce, 0xb4, 0x0a, 0xb7, 0x0b, 0xe3, 0xa6, 0xb5, 0xfd, 0xea, 0x7c, 0x69,
-	0x77, 0x98, 0x73, 0x48, 0x04, 0x3e, 0xb6, 0xd1, 0x15, 0xe1, 0x2d, 0x2e, 0x37, 0x5f, 0x84, 0xd8,
-	0x6e, 0x9c, 0xf7, 0x48, 0x3d, 0x32, 0xa1, 0x2f, 0xaa, 0xc7, 0x37, 0xb0, 0x1c, 0x73, 0xcb, 0x7d,
-	0x09, 0x37, 0xbf, 0x2c, 0x93, 0xba, 0x0a, 0xd7, 0x38, 0x5a, 0x7e, 0xd7, 0x1f, 0x98, 0x7b, 0x18,
-	0xc8, 0xe7, 0x07, 0xe1, 0xd4, 0x4e, 0x34, 0x93, 0x81, 0x5e, 0x03, 0xbd, 0xd8, 0x38, 0xad, 0xd8,
-	0xf7, 0x0a, 0xdc, 0x8c, 0xf2, 0xa2, 0x0e, 0x17, 0xc8, 0x1e, 0x7a, 0xae, 0x60, 0xc4, 0x12, 0x63,
-	0xc0, 0x3e, 0x80, 0x1b, 0x96, 0x54, 0x75, 0x49, 0xdc, 0x31, 0x25, 0xe3, 0x7c, 0x32, 0x2f, 0x1b,
-	0xa9, 0xaa, 0xc1, 0x55, 0x47, 0x96, 0x48, 0xa2, 0xa6, 0xe3, 0x0c, 0xe8, 0xed, 0xe8, 0xba, 0x66,
-	0x29, 0x52, 0xca, 0x1f, 0x94, 0xe8, 0x44, 0x3d, 0x75, 0xd9, 0x6b, 0xe6, 0x8c, 0x4f, 0x60, 0x9e,
-	0x23, 0x21, 0xdd, 0xf8, 0xe3, 0x2a, 0xcc, 0xb4, 0x39, 0x55, 0x9f, 0xc1, 0xf5, 0x91, 0x57, 0x8f,
-	0x9e, 0x79, 0x65, 0x64, 0x9a, 0xb9, 0xf6, 0xfe, 0xf8, 0xf5, 0xf4, 0x84, 0x21, 0x2c, 0xe4, 0x1b,
-	0xfd, 0x9d, 0xfc, 0xe6, 0x9c, 0x48, 0xfb, 0x70, 0x02, 0x51, 0x6a, 0xc3, 0xa1, 0x5a, 0xda, 0xcd,
-	0x1b, 0x65, 0x81, 0xf2, 0xda, 0xcb, 0x99, 0x7e, 0x0b, 0xd5, 0xd2, 0x86, 0x5d, 0x60, 0x5a, 0xa6,
-	0xd5, 0x36, 0x26, 0xd7, 0xa6, 0xde, 0x02, 0x96, 0x4b, 0x3a, 0x72, 0x3d, 0x1f, 0xad, 0x58, 0xa9,
-	0x7d, 0x34, 0xa9, 0x32, 0x75, 0xed, 0x81, 0x5a, 0xd0, 0x25, 0xdf, 0xcb, 0xc7, 0xc9, 0xab, 0xb4,
-	0x7b, 0x93, 0xa8, 0x0a, 0xf2, 0xcb, 0xb6, 0xc5, 0xf2, 0xfc, 0x32, 0xca, 0x31, 0xf9, 0x95, 0xf5,
-	0xc3, 0x3d, 0xb8, 0x59, 0xd4, 0xf5, 0xee, 0x16, 0xa2, 0x67, 0x65, 0xda, 0xfd, 0x89, 0x64, 0xa9,
-	0x99, 0x09, 0x37, 0x72, 0x6d, 0xac, 0x56, 0x84, 0x3c, 0xaa, 0xd1, 0x1a, 0x17, 0x6b, 0xce, 0x3f,
-	0xb0, 0x82, 0x26, 0x54, 0xf0, 0xc0, 0xf2, 0xaa, 0xa2, 0x07, 0x56, 0xde, 0x48, 0xb4, 0x2b, 0x2f,
-	0xc2, 0x0f, 0xc9, 0xd6, 0xd6, 0xcb, 0x13, 0x5d, 0x79, 0x75, 0xa2, 0x2b, 0xff, 0x9e, 0xe8, 0xca,
-	0x4f, 0xa7, 0xfa, 0xd4, 0xab, 0x53, 0x7d, 0xea, 0xaf, 0x53, 0x7d, 0xea, 0xeb, 0xc6, 0x44, 0xdf,
-	0x91, 0x22, 0xf0, 0x91, 0x9b, 0x73, 0xd1, 0xa7, 0xc1, 0xc7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0xa6, 0x87, 0x12, 0xeb, 0x1f, 0x0c, 0x00, 0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x53, 0xdb, 0x46,
+	0x14, 0xc7, 0x11, 0x10, 0x5a, 0xbf, 0x90, 0x12, 0x14, 0x20, 0x46, 0x69, 0x04, 0x35, 0x4d, 0x87,
+	0x3a, 0xc4, 0x0a, 0xb4, 0xd3, 0xe9, 0x70, 0x8b, 0xa1, 0x25, 0x99, 0x8c, 0x67, 0x18, 0xd3, 0x64,
+	0xa6, 0xbd, 0x78, 0xd6, 0xd2, 0x8b, 0xac, 0x01, 0x4b, 0x62, 0x77, 0x4d, 0x71, 0x4f, 0x99, 0x4e,
+	0xa7, 0x87, 0x9e, 0x72, 0xec, 0x1f, 0xd0, 0x43, 0x8f, 0x1c, 0xf2, 0x47, 0xe4, 0xd0, 0x43, 0xa6,
+	0xa7, 0x9e, 0xda, 0x0e, 0x1c, 0xf8, 0x37, 0x3a, 0x92, 0x56, 0x6b, 0x5b, 0x3f, 0x40, 0xf4, 0x92,
+	0x8b, 0xad, 0xdd, 0xfd, 0xee, 0x7b, 0x9f, 0xf7, 0xb4, 0xef, 0xad, 0x60, 0xe1, 0x05, 0x71, 0x68,
+	0x9f, 0x3a, 0xae, 0x6d, 0xf8, 0xe8, 0x1b, 0xfc, 0xb8, 0xe6, 0x53, 0x8f, 0x7b, 0xea, 0x0d, 0x39,
+	0x5f, 0xf3, 0xd1, 0xd7, 0x66, 0x49, 0xd7, 0x71, 0x3d, 0x23, 0xfc, 0x8d, 0x14, 0xda, 0x6d, 0xd3,
+	0x63, 0x5d, 0x8f, 0x19, 0x5d, 0x66, 0x1b, 0x47, 0xeb, 0xc1, 0x9f, 0x58, 0x58, 0x8c, 0x16, 0x5a,
+	0xe1, 0xc8, 0x88, 0x06, 0x62, 0x49, 0xb7, 0x3d, 0xcf, 0x3e, 0x40, 0x23, 0x1c, 0xb5, 0x7b, 0x2f,
+	0x0c, 0xab, 0x47, 0x09, 0x77, 0x3c, 0x57, 0xac, 0xcf, 0xd9, 0x9e, 0xed, 0x45, 0xfb, 0x82, 0x27,
+	0x31, 0xab, 0x8d, 0x32, 0xfa, 0x84, 0x92, 0x6e, 0x6c, 0x71, 0x75, 0x74, 0x8d, 0xd8, 0x36, 0x45,
+	0x9b, 0x70, 0xb4, 0x5a, 0xfb, 0xd8, 0x6f, 0xb1, 0x0e, 0xa1, 0x18, 0xfb, 0x16, 0xbc, 0x6d, 0xc2,
+	0xd0, 0x38, 0x5a, 0x6f, 0x23, 0x27, 0xeb, 0x86, 0xe9, 0x39, 0xc2, 0x77, 0xe5, 0x0f, 0x05, 0x66,
+	0x1a, 0xcc, 0x7e, 0xe6, 0x5b, 0x84, 0xe3, 0x6e, 0xe8, 0x43, 0xfd, 0x02, 0x4a, 0xa4, 0xc7, 0x3b,
+	0x1e, 0x75, 0x78, 0xbf, 0xac, 0x2c, 0x2b, 0xab, 0xa5, 0x7a, 0xf9, 0xcf, 0xd7, 0x0f, 0xe6, 0x44,
+	0x50, 0x8f, 0x2c, 0x8b, 0x22, 0x63, 0x7b, 0x3c, 0xf0, 0xdf, 0x1c, 0x48, 0xd5, 0x2f, 0x61, 0x2a,
+	0xa2, 0x2c, 0x8f, 0x2f, 0x2b, 0xab, 0xd7, 0x37, 0xe6, 0x6b, 0x23, 0xe9, 0xac, 0x45, 0xe6, 0xeb,
+	0xa5, 0x37, 0x7f, 0x2f, 0x8d, 0xfd, 0x7e, 0x7e, 0x52, 0x55, 0x9a, 0x42, 0xbf, 0xb9, 0xf3, 0xe3,
+	0xf9, 0x49, 0x75, 0x60, 0xe9, 0x97, 0xf3, 0x93, 0xea, 0xe7, 0xb6, 0xc3, 0x3b, 0xbd, 0x76, 0xcd,
+	0xf4, 0xba, 0xc6, 0xd7, 0xc4, 0xa1, 0xed, 0x03, 0xcf, 0xdc, 0x37, 0x06, 0x71, 0x1f, 0x87, 0x91,
+	0x27, 0xd0, 0x2b, 0x8b, 0x70, 0x3b, 0x31, 0xd5, 0x44, 0xe6, 0x7b, 0x2e, 0xc3, 0xca, 0x4b, 0x05,
+	0xe6, 0x1a, 0xcc, 0xde, 0xeb, 0xb5, 0xbb, 0x0e, 0xff, 0xca, 0x35, 0x69, 0xdf, 0xe7, 0x68, 0x7d,
+	0x73, 0xac, 0x96, 0xe1, 0x3d, 0x93, 0x22, 0xe1, 0x1e, 0x8d, 0x82, 0x6d, 0xc6, 0x43, 0x55, 0x85,
+	0x49, 0x8b, 0x70, 0x12, 0x86, 0x53, 0x6a, 0x86, 0xcf, 0xea, 0x1a, 0xcc, 0x72, 0x42, 0x6d, 0xe4,
+	0xf5, 0x00, 0xe8, 0x31, 0x3a, 0x76, 0x87, 0x97, 0x27, 0x96, 0x95, 0xd5, 0xc9, 0x66, 0x7a, 0x61,
+	0x73, 0x3a, 0x08, 0x2c, 0xb6, 0x57, 0xf1, 0xe1, 0x8e, 0x24, 0xd8, 0x41, 0x17, 0x29, 0x39, 0xf8,
+	0xff, 0x20, 0xf3, 0x30, 0x45, 0xf1, 0xb0, 0xe5, 0x58, 0xa1, 0xf7, 0x52, 0xf3, 0x1a, 0xc5, 0xc3,
+	0x27, 0x56, 0xc2, 0xa3, 0x0e, 0x1f, 0x66, 0xc5, 0x2c, 0x93, 0x72, 0x18, 0x12, 0x6d, 0x05, 0x6a,
+	0x7c, 0x24, 0x4f, 0xd1, 0x53, 0xec, 0xef, 0x05, 0x67, 0xe8, 0x02, 0xa2, 0x05, 0x98, 0xea, 0x44,
+	0xb1, 0x8f, 0x87, 0xb1, 0x8b, 0x91, 0x24, 0x9d, 0x18, 0x90, 0x26, 0x90, 0xee, 0xc1, 0xca, 0x05,
+	0x2e, 0x25, 0xd9, 0x6f, 0x0a, 0x2c, 0x36, 0x98, 0xdd, 0xc4, 0xc3, 0x1e, 0xb2, 0x38, 0x5b, 0x4f,
+	0xb1, 0xcf, 0x2e, 0x01, 0x7b, 0x0c, 0x33, 0xc8, 0xb8, 0xd3, 0x0d, 0xab, 0xc1, 0xc2, 0x03, 0xd2,
+	0x17, 0xa7, 0x71, 0xb1, 0x16, 0x95, 0x61, 0x2d, 0x2e, 0xc3, 0xda, 0xb6, 0x28, 0xc3, 0xfa, 0xe4,
+	0xaf, 0xff, 0x2c, 0x29, 0xcd, 0x0f, 0xe4, 0xbe, 0xed, 0x60, 0x5b, 0xb1, 0x04, 0x6f, 0xc2, 0x47,
+	0xb9, 0x94, 0x71, 0x2c, 0x43, 0x96, 0x94, 0x21, 0x4b, 0x95, 0xe7, 0x30, 0xdf, 0x60, 0xf6, 0x0e,
+	0x5e, 0x21, 0xba, 0x81, 0xa5, 0xf1, 0x7c, 0xa6, 0x25, 0xb8, 0x9b, 0x69, 0x57, 0xe6, 0xf6, 0xdb,
+	0xe1, 0xd4, 0xee, 0x52, 0xe7, 0x88, 0x70, 0x7c, 0x62, 0xa1, 0xcb, 0x83, 0x2a, 0xce, 0x77, 0x3e,
+	0x07, 0x91, 0xbb, 0xf2, 0x78, 0xc1, 0x7c, 0x24, 0x4c, 0x5f, 0x96, 0x8f, 0xef, 0x61, 0x21, 0xe2,
+	0x16, 0xfb, 0x62, 0x6e, 0x76, 0x55, 0x26, 0x75, 0x09, 0xae, 0x33, 0x34, 0xfd, 0x96, 0xdf, 0x6b,
+	0xef, 0x63, 0x5f, 0xbc, 0x3f, 0x08, 0xa6, 0x76, 0xc3, 0x99, 0x04, 0xf4, 0x32, 0xe8, 0xd9, 0x8e,
+	0x65, 0xc6, 0x7e, 0x52, 0xe0, 0x56, 0x18, 0x97, 0xed, 0x30, 0x8e, 0x74, 0xcb, 0x73, 0x39, 0x25,
+	0x26, 0xbf, 0x00, 0xec, 0x53, 0xb8, 0x69, 0x0a, 0x55, 0x8b, 0x44, 0x1d, 0x53, 0x30, 0xce, 0xc4,
+	0xf3, 0xa2, 0x91, 0xaa, 0x1a, 0xbc, 0xef, 0x88, 0x14, 0x09, 0x54, 0x39, 0x4e, 0x80, 0xde, 0x85,
+	0x3b, 0x19, 0x14, 0x92, 0xf2, 0x67, 0x25, 0x3c, 0x51, 0xcf, 0x5c, 0xfa, 0x8e, 0x39, 0xa3, 0x13,
+	0x98, 0xe6, 0x90, 0xa4, 0xaf, 0xa2, 0x66, 0xbc, 0x45, 0x5c, 0x13, 0x0b, 0xf6, 0xc0, 0x15, 0xb8,
+	0x11, 0xf5, 0xd7, 0xd6, 0x48, 0xe3, 0x99, 0x8e, 0x26, 0xa3, 0x7e, 0x9b, 0x53, 0xb3, 0xc1, 0x29,
+	0x71, 0x5c, 0x0b, 0x8f, 0xcb, 0x93, 0xe1, 0x9e, 0x68, 0x90, 0xd9, 0x2a, 0x53, 0x44, 0x31, 0xf2,
+	0xc6, 0xeb, 0x12, 0x4c, 0x34, 0x98, 0xad, 0x3e, 0x87, 0xe9, 0x91, 0xdb, 0x52, 0x4f, 0xdc, 0x72,
+	0x89, 0xfb, 0x47, 0xfb, 0xe4, 0xe2, 0x75, 0x59, 0x14, 0x08, 0xb3, 0xe9, 0xbb, 0x69, 0x25, 0xbd,
+	0x39, 0x25, 0xd2, 0xee, 0x17, 0x10, 0x49, 0x37, 0x0c, 0xca, 0xb9, 0x17, 0x50, 0x35, 0xcf, 0x50,
+	0x5a, 0x7b, 0x35, 0xa7, 0x3f, 0x40, 0x39, 0xf7, 0x8e, 0xc9, 0x70, 0x9a, 0xa7, 0xd5, 0x36, 0x8a,
+	0x6b, 0xa5, 0x6f, 0x0e, 0x0b, 0x39, 0x97, 0xc8, 0x6a, 0xda, 0x5a, 0xb6, 0x52, 0x7b, 0x58, 0x54,
+	0x29, 0xbd, 0x76, 0x40, 0xcd, 0x68, 0xec, 0x1f, 0xa7, 0xed, 0xa4, 0x55, 0xda, 0x5a, 0x11, 0x55,
+	0x46, 0x7c, 0xc9, 0x4e, 0x9e, 0x1f, 0x5f, 0x42, 0xa9, 0x3d, 0x2c, 0xaa, 0x94, 0x5e, 0xf7, 0xe1,
+	0x56, 0x56, 0xa3, 0xbe, 0x97, 0x89, 0x9e, 0x94, 0x69, 0x0f, 0x0a, 0xc9, 0xa4, 0xb3, 0x36, 0xdc,
+	0x4c, 0x75, 0xde, 0x4a, 0x16, 0xf2, 0xa8, 0x46, 0xab, 0x5e, 0xae, 0x19, 0x7e, 0x61, 0x19, 0x7d,
+	0x33, 0xe3, 0x85, 0xa5, 0x55, 0xda, 0x5a, 0x11, 0xd5, 0x70, 0xa1, 0xa7, 0xfb, 0x5e, 0x46, 0xa1,
+	0xa7, 0x44, 0xda, 0xfd, 0x02, 0xa2, 0xd8, 0x8d, 0x76, 0xed, 0x65, 0xf0, 0x89, 0x5d, 0xdf, 0x7e,
+	0x73, 0xaa, 0x2b, 0x6f, 0x4f, 0x75, 0xe5, 0xdf, 0x53, 0x5d, 0x79, 0x75, 0xa6, 0x8f, 0xbd, 0x3d,
+	0xd3, 0xc7, 0xfe, 0x3a, 0xd3, 0xc7, 0xbe, 0xab, 0x16, 0xfa, 0xc2, 0xe6, 0x7d, 0x1f, 0x59, 0x7b,
+	0x2a, 0xfc, 0x68, 0xfa, 0xec, 0xbf, 0x01, 0x00, 0x28, 0x97, 0x3d, 0x9c, 0x39, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1071,6 +1182,7 @@ type MsgClient interface {
 	GetPrivateKeyshares(ctx context.Context, in *MsgGetPrivateKeyshares, opts ...grpc.CallOption) (*MsgGetPrivateKeysharesResponse, error)
 	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
 	UnregisterContract(ctx context.Context, in *MsgUnregisterContract, opts ...grpc.CallOption) (*MsgUnregisterContractResponse, error)
+	CancelEncryptedTx(ctx context.Context, in *MsgCancelEncryptedTx, opts ...grpc.CallOption) (*MsgCancelEncryptedTxResponse, error)
 }
 
 type msgClient struct {
@@ -1171,6 +1283,15 @@ func (c *msgClient) UnregisterContract(ctx context.Context, in *MsgUnregisterCon
 	return out, nil
 }
 
+func (c *msgClient) CancelEncryptedTx(ctx context.Context, in *MsgCancelEncryptedTx, opts ...grpc.CallOption) (*MsgCancelEncryptedTxResponse, error) {
+	out := new(MsgCancelEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Msg/CancelEncryptedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -1186,6 +1307,7 @@ type MsgServer interface {
 	GetPrivateKeyshares(context.Context, *MsgGetPrivateKeyshares) (*MsgGetPrivateKeysharesResponse, error)
 	RegisterContract(context.Context, *MsgRegisterContract) (*MsgRegisterContractResponse, error)
 	UnregisterContract(context.Context, *MsgUnregisterContract) (*MsgUnregisterContractResponse, error)
+	CancelEncryptedTx(context.Context, *MsgCancelEncryptedTx) (*MsgCancelEncryptedTxResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1222,6 +1344,9 @@ func (*UnimplementedMsgServer) RegisterContract(ctx context.Context, req *MsgReg
 func (*UnimplementedMsgServer) UnregisterContract(ctx context.Context, req *MsgUnregisterContract) (*MsgUnregisterContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterContract not implemented")
 }
+func (*UnimplementedMsgServer) CancelEncryptedTx(ctx context.Context, req *MsgCancelEncryptedTx) (*MsgCancelEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEncryptedTx not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1407,6 +1532,25 @@ func _Msg_UnregisterContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelEncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelEncryptedTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelEncryptedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Msg/CancelEncryptedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelEncryptedTx(ctx, req.(*MsgCancelEncryptedTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fairyring.pep.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1451,6 +1595,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterContract",
 			Handler:    _Msg_UnregisterContract_Handler,
 		},
+		{
+			MethodName: "CancelEncryptedTx",
+			Handler:    _Msg_CancelEncryptedTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fairyring/pep/tx.proto",
@@ -2098,6 +2246,76 @@ func (m *MsgUnregisterContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelEncryptedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelEncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelEncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TargetHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2392,6 +2610,38 @@ func (m *MsgUnregisterContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelEncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TargetHeight != 0 {
+		n += 1 + sovTx(uint64(m.TargetHeight))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *MsgCancelEncryptedTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4255,6 +4505,208 @@ func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelEncryptedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelEncryptedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelEncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHeight", wireType)
+			}
+			m.TargetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelEncryptedTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelEncryptedTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0